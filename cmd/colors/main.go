@@ -1,4 +1,6 @@
 // Package main provides a tool to display ANSI 256 colors and various theme palettes.
+//
+// Run `till theme check` to evaluate the contrast of the board theme your till config resolves.
 package main
 
 import (
@@ -7,6 +9,7 @@ import (
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/lipgloss/table"
+	"github.com/hylla/tillsyn/internal/tui"
 )
 
 func main() {
@@ -67,24 +70,14 @@ func displayColorBlock(start, end, perRow int) {
 
 // Helper function to determine contrast color for text
 func getContrastColor(colorIndex int) lipgloss.Color {
-	// Use white text for dark colors, black for light colors
-	switch {
-	case colorIndex < 16:
-		// For standard colors, use white for dark colors
-		if colorIndex == 0 || colorIndex == 1 || colorIndex == 4 || colorIndex == 5 || colorIndex == 8 {
-			return lipgloss.Color("15") // white
-		}
-		return lipgloss.Color("0") // black
-	case colorIndex >= 232:
-		// For grayscale
-		if colorIndex < 244 {
-			return lipgloss.Color("15") // white
-		}
-		return lipgloss.Color("0") // black
-	default:
-		// For 216 color cube, use a simple heuristic
-		return lipgloss.Color("15") // white (can be improved)
+	// Pick whichever of white or black text has the higher WCAG contrast on this background
+	bg := strconv.Itoa(colorIndex)
+	white, _ := tui.ContrastRatio("15", bg)
+	black, _ := tui.ContrastRatio("0", bg)
+	if white >= black {
+		return lipgloss.Color("15") // white
 	}
+	return lipgloss.Color("0") // black
 }
 
 func displayCharmTheme() {
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"charm.land/lipgloss/v2"
+	"github.com/hylla/tillsyn/internal/tui"
 )
 
 // defaultPreviewWidth defines the default render width for header previews.
@@ -52,13 +53,14 @@ func main() {
 	fmt.Println(renderSheet(palette, variants, renderWidth))
 }
 
-// buildPalette returns the default till-like color palette for previews.
+// buildPalette returns the built-in board theme colors for previews.
 func buildPalette() previewPalette {
+	theme := tui.ResolveTheme("", nil)
 	return previewPalette{
-		fg:       lipgloss.Color("252"),
-		accent:   lipgloss.Color("62"),
-		muted:    lipgloss.Color("241"),
-		dim:      lipgloss.Color("239"),
+		fg:       lipgloss.Color(theme.Text),
+		accent:   lipgloss.Color(theme.Accent),
+		muted:    lipgloss.Color(theme.Muted),
+		dim:      lipgloss.Color(theme.Dim),
 		surface:  lipgloss.Color("236"),
 		surface2: lipgloss.Color("235"),
 	}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	mango "github.com/muesli/mango-cobra"
+	"github.com/muesli/roff"
+	"github.com/spf13/cobra"
+)
+
+// completionShells lists the shells `till completion` can generate scripts for.
+var completionShells = []string{"bash", "zsh", "fish", "powershell"}
+
+// writeCompletionScript writes one shell completion script generated from the command tree.
+func writeCompletionScript(stdout io.Writer, root *cobra.Command, shell string) error {
+	var err error
+	switch shell {
+	case "bash":
+		err = root.GenBashCompletionV2(stdout, true)
+	case "zsh":
+		err = root.GenZshCompletion(stdout)
+	case "fish":
+		err = root.GenFishCompletion(stdout, true)
+	case "powershell":
+		err = root.GenPowerShellCompletionWithDesc(stdout)
+	default:
+		return fmt.Errorf("unsupported shell %q (want bash, zsh, fish, or powershell)", shell)
+	}
+	if err != nil {
+		return fmt.Errorf("write %s completion: %w", shell, err)
+	}
+	return nil
+}
+
+// writeManPage writes a section-1 roff man page generated from the command tree.
+// Hidden commands, such as the dev maintenance group, are left out.
+func writeManPage(stdout io.Writer, root *cobra.Command) error {
+	page, err := mango.NewManPage(1, root)
+	if err != nil {
+		return fmt.Errorf("build man page: %w", err)
+	}
+	page = page.WithSection("Files", "Config, data, and database locations are printed by `till paths`.")
+	if _, err := io.WriteString(stdout, page.Build(roff.NewDocument())); err != nil {
+		return fmt.Errorf("write man page: %w", err)
+	}
+	return nil
+}
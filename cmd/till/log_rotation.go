@@ -0,0 +1,172 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// rotatingLogFile appends to one dev log file and rolls over to a new -NNN suffixed file once it passes maxBytes.
+type rotatingLogFile struct {
+	mu         sync.Mutex
+	basePath   string
+	maxBytes   int64
+	maxBackups int
+	seq        int
+	file       *os.File
+	size       int64
+}
+
+// openRotatingLogFile opens the newest file in basePath's rotation sequence for appending.
+// A zero maxBytes disables rotation; a zero maxBackups keeps every rotated file.
+func openRotatingLogFile(basePath string, maxBytes int64, maxBackups int) (*rotatingLogFile, error) {
+	seqs, err := rotatedLogSeqs(basePath)
+	if err != nil {
+		return nil, err
+	}
+	r := &rotatingLogFile{
+		basePath:   basePath,
+		maxBytes:   maxBytes,
+		maxBackups: maxBackups,
+	}
+	if len(seqs) > 0 {
+		r.seq = seqs[len(seqs)-1]
+	}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Write appends p, rotating first when it would push a non-empty active file past the size limit.
+func (r *rotatingLogFile) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.file == nil {
+		return 0, os.ErrClosed
+	}
+	if r.maxBytes > 0 && r.size > 0 && r.size+int64(len(p)) > r.maxBytes {
+		if err := r.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := r.file.Write(p)
+	r.size += int64(n)
+	return n, err
+}
+
+// Path returns the active log file path.
+func (r *rotatingLogFile) Path() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return rotatedLogPath(r.basePath, r.seq)
+}
+
+// Close closes the active log file.
+func (r *rotatingLogFile) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+// open opens the file for the current sequence number and records its size.
+func (r *rotatingLogFile) open() error {
+	path := rotatedLogPath(r.basePath, r.seq)
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("open dev log file: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return fmt.Errorf("stat dev log file: %w", err)
+	}
+	r.file = file
+	r.size = info.Size()
+	return nil
+}
+
+// rotate closes the active file, opens the next suffixed file, and prunes rotated files past maxBackups.
+func (r *rotatingLogFile) rotate() error {
+	if err := r.file.Close(); err != nil {
+		return fmt.Errorf("close dev log file: %w", err)
+	}
+	r.file = nil
+	r.seq++
+	if err := r.open(); err != nil {
+		return err
+	}
+	return r.prune()
+}
+
+// prune deletes the oldest rotated files so at most maxBackups remain beside the active file.
+func (r *rotatingLogFile) prune() error {
+	if r.maxBackups <= 0 {
+		return nil
+	}
+	seqs, err := rotatedLogSeqs(r.basePath)
+	if err != nil {
+		return err
+	}
+	seqs = slices.DeleteFunc(seqs, func(seq int) bool { return seq == r.seq })
+	for len(seqs) > r.maxBackups {
+		if err := os.Remove(rotatedLogPath(r.basePath, seqs[0])); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("remove rotated dev log file: %w", err)
+		}
+		seqs = seqs[1:]
+	}
+	return nil
+}
+
+// rotatedLogPath returns the file for one rotation sequence; sequence zero is the unsuffixed base file.
+func rotatedLogPath(basePath string, seq int) string {
+	if seq == 0 {
+		return basePath
+	}
+	ext := filepath.Ext(basePath)
+	return fmt.Sprintf("%s-%03d%s", strings.TrimSuffix(basePath, ext), seq, ext)
+}
+
+// rotatedLogSeqs lists the existing sequence numbers of basePath's rotation set in ascending order.
+func rotatedLogSeqs(basePath string) ([]int, error) {
+	ext := filepath.Ext(basePath)
+	stem := strings.TrimSuffix(filepath.Base(basePath), ext)
+	entries, err := os.ReadDir(filepath.Dir(basePath))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("list dev log dir: %w", err)
+	}
+	var seqs []int
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ext) {
+			continue
+		}
+		if name == stem+ext {
+			seqs = append(seqs, 0)
+			continue
+		}
+		suffix, ok := strings.CutPrefix(strings.TrimSuffix(name, ext), stem+"-")
+		if !ok {
+			continue
+		}
+		seq, err := strconv.Atoi(suffix)
+		if err != nil || seq <= 0 {
+			continue
+		}
+		seqs = append(seqs, seq)
+	}
+	slices.Sort(seqs)
+	return seqs, nil
+}
@@ -1,23 +1,30 @@
 package main
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
 	"github.com/charmbracelet/fang"
 	charmLog "github.com/charmbracelet/log"
+	"github.com/go-logfmt/logfmt"
 	"github.com/google/uuid"
 	fantasyembed "github.com/hylla/tillsyn/internal/adapters/embeddings/fantasy"
 	serveradapter "github.com/hylla/tillsyn/internal/adapters/server"
@@ -25,6 +32,7 @@ import (
 	"github.com/hylla/tillsyn/internal/adapters/storage/sqlite"
 	"github.com/hylla/tillsyn/internal/app"
 	"github.com/hylla/tillsyn/internal/config"
+	"github.com/hylla/tillsyn/internal/domain"
 	"github.com/hylla/tillsyn/internal/platform"
 	"github.com/hylla/tillsyn/internal/tui"
 	"github.com/spf13/cobra"
@@ -52,6 +60,18 @@ var serveCommandRunner = func(ctx context.Context, cfg serveradapter.Config, dep
 // supportsStyledOutputFunc allows tests to force styled output mode.
 var supportsStyledOutputFunc = supportsStyledOutput
 
+// openPathFunc opens one path in the OS file manager; tests replace it to avoid launching one.
+var openPathFunc = platform.OpenPath
+
+// checkDatabaseWritableFunc probes the database path before opening; tests replace it to simulate unwritable paths.
+var checkDatabaseWritableFunc = platform.CheckDatabaseWritable
+
+// acquireInstanceLockFunc takes the TUI's single-instance lock; tests replace it to simulate lock failures.
+var acquireInstanceLockFunc = platform.AcquireInstanceLock
+
+// confirmReadOnlyFunc asks whether to open a database another instance holds read-only; tests replace it to answer.
+var confirmReadOnlyFunc = confirmReadOnly
+
 // loggingSectionHeaderPattern matches a [logging] TOML section header.
 var loggingSectionHeaderPattern = regexp.MustCompile(`(?m)^\[logging\][ \t]*$`)
 
@@ -74,25 +94,85 @@ type rootCommandOptions struct {
 	dbPath      string
 	appName     string
 	devMode     bool
+	homeDir     string
 	showVersion bool
+	timing      bool
+	readOnly    bool
+	quiet       bool
+	verbose     bool
+	profiling   profilingOptions
 }
 
 // serveCommandOptions stores serve subcommand option values.
 type serveCommandOptions struct {
-	httpBind    string
-	apiEndpoint string
-	mcpEndpoint string
+	httpBind        string
+	apiEndpoint     string
+	mcpEndpoint     string
+	shutdownTimeout time.Duration
+	apiToken        string
+	rateLimit       float64
+	rateBurst       int
 }
 
 // exportCommandOptions stores export subcommand option values.
 type exportCommandOptions struct {
 	outPath         string
 	includeArchived bool
+	taskID          string
+	subtasks        bool
+	format          string
+	projectSlug     string
 }
 
 // importCommandOptions stores import subcommand option values.
 type importCommandOptions struct {
-	inPath string
+	inPath          string
+	from            string
+	repairPositions bool
+	validate        bool
+	dryRun          bool
+	mode            string
+	inDir           string
+	failFast        bool
+}
+
+// repairPositionsCommandOptions stores repair-positions subcommand option values.
+type repairPositionsCommandOptions struct {
+	projectID string
+	dryRun    bool
+}
+
+// doctorCommandOptions stores doctor subcommand option values.
+type doctorCommandOptions struct {
+	projectID string
+	fix       string
+}
+
+// statsCommandOptions stores stats subcommand option values.
+type statsCommandOptions struct {
+	projectSlug     string
+	json            bool
+	includeArchived bool
+}
+
+// verifyResourcesCommandOptions stores verify-resources subcommand option values.
+type verifyResourcesCommandOptions struct {
+	projectSlug string
+	dryRun      bool
+}
+
+// purgeCommandOptions stores purge subcommand option values.
+type purgeCommandOptions struct {
+	olderThan string
+	dryRun    bool
+	force     bool
+}
+
+// devSeedCommandOptions stores dev seed subcommand option values.
+type devSeedCommandOptions struct {
+	projects        int
+	tasksPerProject int
+	seed            uint64
 }
 
 // run executes the CLI command tree through Fang+Cobra.
@@ -114,17 +194,29 @@ func run(ctx context.Context, args []string, stdout, stderr io.Writer) error {
 	if envApp := strings.TrimSpace(os.Getenv("TILL_APP_NAME")); envApp != "" {
 		rootOpts.appName = envApp
 	}
+	rootOpts.homeDir = strings.TrimSpace(os.Getenv("TILL_HOME"))
 
 	serveOpts := serveCommandOptions{
-		httpBind:    "127.0.0.1:5437",
-		apiEndpoint: "/api/v1",
-		mcpEndpoint: "/mcp",
+		httpBind:        "127.0.0.1:5437",
+		apiEndpoint:     "/api/v1",
+		mcpEndpoint:     "/mcp",
+		shutdownTimeout: 5 * time.Second,
 	}
 	exportOpts := exportCommandOptions{
 		outPath:         "-",
 		includeArchived: true,
 	}
 	importOpts := importCommandOptions{}
+	repairOpts := repairPositionsCommandOptions{}
+	doctorOpts := doctorCommandOptions{}
+	statsOpts := statsCommandOptions{}
+	verifyOpts := verifyResourcesCommandOptions{}
+	purgeOpts := purgeCommandOptions{}
+	devSeedOpts := devSeedCommandOptions{
+		projects:        3,
+		tasksPerProject: 200,
+		seed:            1,
+	}
 
 	rootCmd := &cobra.Command{
 		Use:           "till",
@@ -132,7 +224,7 @@ func run(ctx context.Context, args []string, stdout, stderr io.Writer) error {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			return executeCommandFlow(cmd.Context(), "", rootOpts, serveOpts, exportOpts, importOpts, stdout, stderr)
+			return executeCommandFlow(cmd.Context(), "", rootOpts, serveOpts, exportOpts, importOpts, repairOpts, doctorOpts, statsOpts, verifyOpts, purgeOpts, devSeedOpts, stdout, stderr)
 		},
 	}
 	rootCmd.SetOut(stdout)
@@ -144,40 +236,120 @@ func run(ctx context.Context, args []string, stdout, stderr io.Writer) error {
 	rootCmd.PersistentFlags().StringVar(&rootOpts.appName, "app", rootOpts.appName, "Application name for config/data path resolution")
 	rootCmd.PersistentFlags().BoolVar(&rootOpts.devMode, "dev", rootOpts.devMode, "Use dev mode paths (<app>-dev)")
 	rootCmd.PersistentFlags().BoolVar(&rootOpts.showVersion, "version", false, "Show version")
+	rootCmd.PersistentFlags().BoolVar(&rootOpts.timing, "timing", false, "Print per-phase startup timing to stderr")
+	rootCmd.PersistentFlags().BoolVar(&rootOpts.quiet, "quiet", false, "Log errors only, overriding logging.level")
+	rootCmd.PersistentFlags().BoolVar(&rootOpts.verbose, "verbose", false, "Log at debug level, overriding logging.level")
+	rootCmd.Flags().BoolVar(&rootOpts.readOnly, "read-only", false, "Open the TUI without allowing changes (skips the single-instance lock)")
+	rootCmd.PersistentFlags().StringVar(&rootOpts.profiling.pprofAddr, "pprof", "", "Serve net/http/pprof on this address (loopback only unless --dev)")
+	rootCmd.PersistentFlags().StringVar(&rootOpts.profiling.cpuProfile, "cpuprofile", "", "Write a CPU profile for this run to the given file")
+	rootCmd.PersistentFlags().StringVar(&rootOpts.profiling.memProfile, "memprofile", "", "Write a heap profile to the given file on exit")
 
 	serveCmd := &cobra.Command{
 		Use:   "serve",
 		Short: "Start HTTP and MCP endpoints",
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			return executeCommandFlow(cmd.Context(), "serve", rootOpts, serveOpts, exportOpts, importOpts, stdout, stderr)
+			return executeCommandFlow(cmd.Context(), "serve", rootOpts, serveOpts, exportOpts, importOpts, repairOpts, doctorOpts, statsOpts, verifyOpts, purgeOpts, devSeedOpts, stdout, stderr)
 		},
 	}
 	serveCmd.Flags().StringVar(&serveOpts.httpBind, "http", serveOpts.httpBind, "HTTP listen address")
 	serveCmd.Flags().StringVar(&serveOpts.apiEndpoint, "api-endpoint", serveOpts.apiEndpoint, "HTTP API base endpoint")
 	serveCmd.Flags().StringVar(&serveOpts.mcpEndpoint, "mcp-endpoint", serveOpts.mcpEndpoint, "MCP streamable HTTP endpoint")
+	serveCmd.Flags().DurationVar(&serveOpts.shutdownTimeout, "shutdown-timeout", serveOpts.shutdownTimeout, "How long SIGINT/SIGTERM waits for in-flight requests before forcing close")
+	serveCmd.Flags().StringVar(&serveOpts.apiToken, "api-token", "", "Require `Authorization: Bearer <token>` on API and MCP requests (default from TILL_API_TOKEN)")
+	serveCmd.Flags().Float64Var(&serveOpts.rateLimit, "rate-limit", 0, "Maximum API and MCP requests per second from one client IP (0 disables)")
+	serveCmd.Flags().IntVar(&serveOpts.rateBurst, "rate-burst", 0, "Requests one client IP may send at once before --rate-limit applies (0 uses the rate rounded up)")
 
 	exportCmd := &cobra.Command{
 		Use:   "export",
 		Short: "Export a snapshot JSON payload",
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			return executeCommandFlow(cmd.Context(), "export", rootOpts, serveOpts, exportOpts, importOpts, stdout, stderr)
+			return executeCommandFlow(cmd.Context(), "export", rootOpts, serveOpts, exportOpts, importOpts, repairOpts, doctorOpts, statsOpts, verifyOpts, purgeOpts, devSeedOpts, stdout, stderr)
 		},
 	}
 	exportCmd.Flags().StringVar(&exportOpts.outPath, "out", exportOpts.outPath, "Output file path ('-' for stdout)")
 	exportCmd.Flags().BoolVar(&exportOpts.includeArchived, "include-archived", exportOpts.includeArchived, "Include archived projects/columns/tasks")
+	exportCmd.Flags().StringVar(&exportOpts.taskID, "task", "", "Export only this task as a shareable card")
+	exportCmd.Flags().BoolVar(&exportOpts.subtasks, "subtasks", false, "Include subtasks in a --task export")
+	exportCmd.Flags().StringVar(&exportOpts.projectSlug, "project", "", "Export only the project with this slug as a standalone snapshot")
+	exportCmd.Flags().StringVar(&exportOpts.format, "format", "", "Export format: json|csv|markdown for snapshots (default json), markdown|json for --task cards (default markdown)")
 
 	importCmd := &cobra.Command{
 		Use:   "import",
-		Short: "Import a snapshot JSON payload",
+		Short: "Import a snapshot JSON payload or another tool's board export",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeCommandFlow(cmd.Context(), "import", rootOpts, serveOpts, exportOpts, importOpts, repairOpts, doctorOpts, statsOpts, verifyOpts, purgeOpts, devSeedOpts, stdout, stderr)
+		},
+	}
+	importCmd.Flags().StringVar(&importOpts.inPath, "in", "", "Input snapshot JSON file, or the export file named by --from")
+	importCmd.Flags().StringVar(&importOpts.from, "from", "snapshot", "Input format: snapshot, trello (a Trello board JSON export), or github (a GitHub issues JSON export); trello and github import as a new project")
+	importCmd.Flags().BoolVar(&importOpts.repairPositions, "repair-positions", false, "Renumber task positions in imported projects after import")
+	importCmd.Flags().BoolVar(&importOpts.validate, "validate", false, "Validate the snapshot against the JSON schema before importing")
+	importCmd.Flags().StringVar(&importOpts.mode, "mode", "", "How rows match stored data: replace (by id, the default) or merge (projects by slug, columns by name, tasks by id); --in-dir always merges")
+	importCmd.Flags().BoolVar(&importOpts.dryRun, "dry-run", false, "Validate and summarize the import without writing; exits non-zero on structural problems")
+	importCmd.Flags().StringVar(&importOpts.inDir, "in-dir", "", "Merge every *.json snapshot in this directory, in filename order")
+	importCmd.Flags().BoolVar(&importOpts.failFast, "fail-fast", false, "With --in-dir, stop at the first file that fails to import")
+
+	repairPositionsCmd := &cobra.Command{
+		Use:   "repair-positions",
+		Short: "Renumber task positions contiguously within each column",
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			return executeCommandFlow(cmd.Context(), "import", rootOpts, serveOpts, exportOpts, importOpts, stdout, stderr)
+			return executeCommandFlow(cmd.Context(), "repair-positions", rootOpts, serveOpts, exportOpts, importOpts, repairOpts, doctorOpts, statsOpts, verifyOpts, purgeOpts, devSeedOpts, stdout, stderr)
 		},
 	}
-	importCmd.Flags().StringVar(&importOpts.inPath, "in", "", "Input snapshot JSON file")
+	repairPositionsCmd.Flags().StringVar(&repairOpts.projectID, "project", "", "Project ID to repair (default: all projects)")
+	repairPositionsCmd.Flags().BoolVar(&repairOpts.dryRun, "dry-run", false, "Report position changes without writing them")
 
+	doctorCmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Check data integrity and list orphaned subtasks",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeCommandFlow(cmd.Context(), "doctor", rootOpts, serveOpts, exportOpts, importOpts, repairOpts, doctorOpts, statsOpts, verifyOpts, purgeOpts, devSeedOpts, stdout, stderr)
+		},
+	}
+	doctorCmd.Flags().StringVar(&doctorOpts.projectID, "project", "", "Project ID to check (default: all projects)")
+	doctorCmd.Flags().StringVar(&doctorOpts.fix, "fix", "", "Repair orphaned subtasks: reparent (move to project root) or delete")
+
+	statsCmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Print per-project board metrics from a read-only database",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeCommandFlow(cmd.Context(), "stats", rootOpts, serveOpts, exportOpts, importOpts, repairOpts, doctorOpts, statsOpts, verifyOpts, purgeOpts, devSeedOpts, stdout, stderr)
+		},
+	}
+	statsCmd.Flags().StringVar(&statsOpts.projectSlug, "project", "", "Project slug to report (default: all projects)")
+	statsCmd.Flags().BoolVar(&statsOpts.json, "json", false, "Write stats as JSON")
+	statsCmd.Flags().BoolVar(&statsOpts.includeArchived, "include-archived", false, "Include archived projects and tasks")
+
+	verifyResourcesCmd := &cobra.Command{
+		Use:   "verify-resources",
+		Short: "Check that local task resource refs still exist and flag missing ones",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeCommandFlow(cmd.Context(), "verify-resources", rootOpts, serveOpts, exportOpts, importOpts, repairOpts, doctorOpts, statsOpts, verifyOpts, purgeOpts, devSeedOpts, stdout, stderr)
+		},
+	}
+	verifyResourcesCmd.Flags().StringVar(&verifyOpts.projectSlug, "project", "", "Project slug to verify (default: all projects)")
+	verifyResourcesCmd.Flags().BoolVar(&verifyOpts.dryRun, "dry-run", false, "Report missing refs without updating verification timestamps")
+
+	purgeCmd := &cobra.Command{
+		Use:   "purge",
+		Short: "Permanently delete tasks archived before a cutoff",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return executeCommandFlow(cmd.Context(), "purge", rootOpts, serveOpts, exportOpts, importOpts, repairOpts, doctorOpts, statsOpts, verifyOpts, purgeOpts, devSeedOpts, stdout, stderr)
+		},
+	}
+	purgeCmd.Flags().StringVar(&purgeOpts.olderThan, "older-than", "", "Purge tasks archived longer ago than this age (for example 90d, 12w, or 720h)")
+	purgeCmd.Flags().BoolVar(&purgeOpts.dryRun, "dry-run", false, "List the tasks that would be purged without deleting them")
+	purgeCmd.Flags().BoolVar(&purgeOpts.force, "force", false, "Also purge tasks that other tasks still reference, detaching those references")
+
+	openDataDir := false
 	pathsCmd := &cobra.Command{
 		Use:   "paths",
 		Short: "Print resolved config/data/db paths",
@@ -189,13 +361,18 @@ func run(ctx context.Context, args []string, stdout, stderr io.Writer) error {
 			paths, err := platform.DefaultPathsWithOptions(platform.Options{
 				AppName: rootOpts.appName,
 				DevMode: rootOpts.devMode,
+				Home:    rootOpts.homeDir,
 			})
 			if err != nil {
 				return err
 			}
+			if openDataDir {
+				return openDataDirOutput(stdout, paths.DataDir)
+			}
 			return writePathsOutput(stdout, rootOpts, paths)
 		},
 	}
+	pathsCmd.Flags().BoolVar(&openDataDir, "open", false, "Open the data directory in the OS file manager (prints the path when headless)")
 
 	initDevConfigCmd := &cobra.Command{
 		Use:   "init-dev-config",
@@ -206,7 +383,117 @@ func run(ctx context.Context, args []string, stdout, stderr io.Writer) error {
 		},
 	}
 
-	rootCmd.AddCommand(serveCmd, exportCmd, importCmd, pathsCmd, initDevConfigCmd)
+	configCmd := &cobra.Command{
+		Use:   "config",
+		Short: "Inspect the config file",
+		Args:  cobra.NoArgs,
+	}
+	configCheckCmd := &cobra.Command{
+		Use:   "check",
+		Short: "Report unknown keys and invalid values in the config file; exits non-zero on problems",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return runConfigCheck(stdout, rootOpts)
+		},
+	}
+	configCmd.AddCommand(configCheckCmd)
+
+	themeCmd := &cobra.Command{
+		Use:   "theme",
+		Short: "Inspect the board theme",
+		Args:  cobra.NoArgs,
+	}
+	themePreviewWidth := defaultThemePreviewWidth
+	themePreviewCmd := &cobra.Command{
+		Use:   "preview",
+		Short: "Render a sample board, footer, and modal with the theme the config file resolves",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return runThemePreview(stdout, rootOpts, themePreviewWidth)
+		},
+	}
+	themePreviewCmd.Flags().IntVar(&themePreviewWidth, "width", themePreviewWidth, fmt.Sprintf("Preview width in cells (%d-%d)", minThemePreviewWidth, maxThemePreviewWidth))
+	themeCheckBackground := "0"
+	themeCheckStrict := false
+	themeCheckCmd := &cobra.Command{
+		Use:   "check",
+		Short: "Report WCAG contrast of the theme the config file resolves against a terminal background",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return runThemeCheck(stdout, rootOpts, themeCheckBackground, themeCheckStrict)
+		},
+	}
+	themeCheckCmd.Flags().StringVar(&themeCheckBackground, "bg", themeCheckBackground, "Terminal background color (ANSI index or #RRGGBB)")
+	themeCheckCmd.Flags().BoolVar(&themeCheckStrict, "strict", false, "Exit non-zero when any role is below AA normal-text contrast")
+	themeCmd.AddCommand(themePreviewCmd, themeCheckCmd)
+
+	devCmd := &cobra.Command{
+		Use:    "dev",
+		Short:  "Developer-only maintenance commands (requires dev mode)",
+		Hidden: true,
+		Args:   cobra.NoArgs,
+	}
+	devSeedCmd := &cobra.Command{
+		Use:   "seed",
+		Short: "Generate a deterministic synthetic board for performance testing",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			// Seeding writes bulk rows, so refuse to touch anything but dev-mode data roots.
+			if !rootOpts.devMode {
+				return fmt.Errorf("dev seed requires dev mode (--dev or TILL_DEV_MODE=true)")
+			}
+			return executeCommandFlow(cmd.Context(), "dev-seed", rootOpts, serveOpts, exportOpts, importOpts, repairOpts, doctorOpts, statsOpts, verifyOpts, purgeOpts, devSeedOpts, stdout, stderr)
+		},
+	}
+	devSeedCmd.Flags().IntVar(&devSeedOpts.projects, "projects", devSeedOpts.projects, "Number of synthetic projects to generate")
+	devSeedCmd.Flags().IntVar(&devSeedOpts.tasksPerProject, "tasks-per-project", devSeedOpts.tasksPerProject, "Number of synthetic tasks per project")
+	devSeedCmd.Flags().Uint64Var(&devSeedOpts.seed, "seed", devSeedOpts.seed, "Deterministic random seed (same seed yields the same board)")
+	devCmd.AddCommand(devSeedCmd)
+
+	// Fang's built-in completion and man commands are disabled below; these write to the injected stdout instead.
+	completionCmd := &cobra.Command{
+		Use:                   "completion bash|zsh|fish|powershell",
+		Short:                 "Generate a shell completion script",
+		Args:                  cobra.ExactArgs(1),
+		ValidArgs:             completionShells,
+		DisableFlagsInUseLine: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return writeCompletionScript(stdout, cmd.Root(), args[0])
+		},
+	}
+	manCmd := &cobra.Command{
+		Use:                   "man",
+		Short:                 "Generate a man page from the command tree",
+		Args:                  cobra.NoArgs,
+		DisableFlagsInUseLine: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return writeManPage(stdout, cmd.Root())
+		},
+	}
+
+	schemaCmd := &cobra.Command{
+		Use:   "schema",
+		Short: "Print JSON schemas for tillsyn file formats",
+		Args:  cobra.NoArgs,
+	}
+	schemaSnapshotCmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Print the JSON schema for export/import snapshots",
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			schema, err := app.SnapshotJSONSchema()
+			if err != nil {
+				return err
+			}
+			if _, err := stdout.Write(schema); err != nil {
+				return fmt.Errorf("write snapshot schema: %w", err)
+			}
+			return nil
+		},
+	}
+	schemaCmd.AddCommand(schemaSnapshotCmd)
+
+	rootCmd.AddCommand(serveCmd, exportCmd, importCmd, repairPositionsCmd, doctorCmd, statsCmd, verifyResourcesCmd, purgeCmd, pathsCmd, configCmd, themeCmd, initDevConfigCmd, schemaCmd, completionCmd, manCmd, devCmd)
 	return fang.Execute(
 		ctx,
 		rootCmd,
@@ -216,6 +503,65 @@ func run(ctx context.Context, args []string, stdout, stderr io.Writer) error {
 	)
 }
 
+// resolveConfigPath picks the config file from --config, then TILL_CONFIG, then the platform default.
+func resolveConfigPath(rootOpts rootCommandOptions, paths platform.Paths) string {
+	if rootOpts.configPath != "" {
+		return rootOpts.configPath
+	}
+	if envPath := strings.TrimSpace(os.Getenv("TILL_CONFIG")); envPath != "" {
+		return envPath
+	}
+	return paths.ConfigPath
+}
+
+// resolveDBPath picks the database from --db, then TILL_DB_PATH, then the platform default.
+// The flag reports whether the path was overridden, since an override wins over database.path in the config.
+func resolveDBPath(rootOpts rootCommandOptions, paths platform.Paths) (string, bool) {
+	if dbPath := rootOpts.dbPath; strings.TrimSpace(dbPath) != "" {
+		return dbPath, true
+	}
+	if envPath := strings.TrimSpace(os.Getenv("TILL_DB_PATH")); envPath != "" {
+		return envPath, true
+	}
+	return paths.DBPath, false
+}
+
+// runConfigCheck reports every problem in the resolved config file and fails when there are any.
+func runConfigCheck(stdout io.Writer, rootOpts rootCommandOptions) error {
+	paths, err := platform.DefaultPathsWithOptions(platform.Options{
+		AppName: rootOpts.appName,
+		DevMode: rootOpts.devMode,
+		Home:    rootOpts.homeDir,
+	})
+	if err != nil {
+		return err
+	}
+	configPath := resolveConfigPath(rootOpts, paths)
+	dbPath, _ := resolveDBPath(rootOpts, paths)
+	if _, err := os.Stat(configPath); errors.Is(err, os.ErrNotExist) {
+		if _, err := fmt.Fprintf(stdout, "%s: not found; built-in defaults apply\n", configPath); err != nil {
+			return fmt.Errorf("write config check output: %w", err)
+		}
+		return nil
+	}
+	problems, err := config.Check(configPath, config.Default(dbPath))
+	if err != nil {
+		return fmt.Errorf("check config %q: %w", configPath, err)
+	}
+	if len(problems) == 0 {
+		if _, err := fmt.Fprintf(stdout, "%s: ok\n", configPath); err != nil {
+			return fmt.Errorf("write config check output: %w", err)
+		}
+		return nil
+	}
+	for _, problem := range problems {
+		if _, err := fmt.Fprintf(stdout, "%s: %s\n", configPath, problem); err != nil {
+			return fmt.Errorf("write config check output: %w", err)
+		}
+	}
+	return fmt.Errorf("%d config problems found in %s", len(problems), configPath)
+}
+
 // writeVersion writes the current CLI version to stdout.
 func writeVersion(stdout io.Writer) error {
 	if _, err := fmt.Fprintf(stdout, "till %s\n", version); err != nil {
@@ -241,16 +587,22 @@ func writePathsOutput(stdout io.Writer, opts rootCommandOptions, paths platform.
 	valueStyle := lipgloss.NewStyle().
 		Foreground(colors.Description)
 
-	rows := []struct {
+	type pathsRow struct {
 		key   string
 		value string
-	}{
+	}
+	rows := []pathsRow{
 		{key: "app", value: opts.appName},
 		{key: "dev_mode", value: fmt.Sprintf("%t", opts.devMode)},
-		{key: "config", value: paths.ConfigPath},
-		{key: "data_dir", value: paths.DataDir},
-		{key: "db", value: paths.DBPath},
 	}
+	if opts.homeDir != "" {
+		rows = append(rows, pathsRow{key: "home", value: opts.homeDir})
+	}
+	rows = append(rows,
+		pathsRow{key: "config", value: paths.ConfigPath},
+		pathsRow{key: "data_dir", value: paths.DataDir},
+		pathsRow{key: "db", value: paths.DBPath},
+	)
 
 	maxKeyWidth := 0
 	for _, row := range rows {
@@ -277,6 +629,47 @@ func writePathsOutput(stdout io.Writer, opts rootCommandOptions, paths platform.
 	return nil
 }
 
+// openDataDirOutput opens the data directory in the OS file manager, printing its path when no opener is available.
+func openDataDirOutput(stdout io.Writer, dataDir string) error {
+	if _, err := os.Stat(dataDir); err != nil {
+		return fmt.Errorf("data dir %q: %w", dataDir, err)
+	}
+	if err := openPathFunc(dataDir); err != nil {
+		if !errors.Is(err, platform.ErrOpenUnavailable) {
+			return fmt.Errorf("open data dir %q: %w", dataDir, err)
+		}
+		// Headless sessions get the bare path so it can be used in scripts such as cd "$(till paths --open)".
+		if _, err := fmt.Fprintln(stdout, dataDir); err != nil {
+			return fmt.Errorf("write data dir output: %w", err)
+		}
+		return nil
+	}
+	if _, err := fmt.Fprintf(stdout, "opened data_dir: %s\n", dataDir); err != nil {
+		return fmt.Errorf("write data dir output: %w", err)
+	}
+	return nil
+}
+
+// canFallBackToReadOnlyDB reports whether an unwritable database may be browsed read-only instead.
+// Only the TUI and export are read-only safe, and only an existing database has anything to browse.
+func canFallBackToReadOnlyDB(command, dbPath string, allowed bool) bool {
+	if !allowed || (command != "" && command != "export") {
+		return false
+	}
+	return databaseFileExists(dbPath)
+}
+
+// databaseFileExists reports whether dbPath names an existing regular file.
+func databaseFileExists(dbPath string) bool {
+	info, err := os.Stat(dbPath)
+	return err == nil && info.Mode().IsRegular()
+}
+
+// unwritableDatabaseError explains how to point tillsyn at a writable database.
+func unwritableDatabaseError(dbPath, configPath string, err error) error {
+	return fmt.Errorf("database path %q is not writable; pass --db <path>, set TILL_DB_PATH, or change database.path in %s (set database.readonly_fallback = true to browse an existing database read-only): %w", dbPath, configPath, err)
+}
+
 // writePathsPlain renders resolved paths in stable key/value text for scripts.
 func writePathsPlain(stdout io.Writer, opts rootCommandOptions, paths platform.Paths) error {
 	if _, err := fmt.Fprintf(stdout, "app: %s\n", opts.appName); err != nil {
@@ -285,6 +678,11 @@ func writePathsPlain(stdout io.Writer, opts rootCommandOptions, paths platform.P
 	if _, err := fmt.Fprintf(stdout, "dev_mode: %t\n", opts.devMode); err != nil {
 		return fmt.Errorf("write paths dev output: %w", err)
 	}
+	if opts.homeDir != "" {
+		if _, err := fmt.Fprintf(stdout, "home: %s\n", opts.homeDir); err != nil {
+			return fmt.Errorf("write paths home output: %w", err)
+		}
+	}
 	if _, err := fmt.Fprintf(stdout, "config: %s\n", paths.ConfigPath); err != nil {
 		return fmt.Errorf("write paths config output: %w", err)
 	}
@@ -306,6 +704,7 @@ func runInitDevConfig(stdout io.Writer, opts rootCommandOptions) error {
 	paths, err := platform.DefaultPathsWithOptions(platform.Options{
 		AppName: opts.appName,
 		DevMode: true,
+		Home:    opts.homeDir,
 	})
 	if err != nil {
 		return fmt.Errorf("resolve dev paths: %w", err)
@@ -472,6 +871,21 @@ func supportsStyledOutput(w io.Writer) bool {
 	return term.IsTerminal(int(file.Fd()))
 }
 
+// confirmReadOnly offers read-only mode for a locked database on an interactive terminal and declines otherwise.
+func confirmReadOnly(dbPath string) bool {
+	if !term.IsTerminal(int(os.Stdin.Fd())) || !term.IsTerminal(int(os.Stderr.Fd())) {
+		return false
+	}
+	fmt.Fprintf(os.Stderr, "Database %s is in use by another tillsyn instance. Open it read-only instead? [y/N] ", dbPath)
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true
+	default:
+		return false
+	}
+}
+
 // executeCommandFlow runs the runtime setup + command-specific execution path.
 func executeCommandFlow(
 	ctx context.Context,
@@ -480,39 +894,35 @@ func executeCommandFlow(
 	serveOpts serveCommandOptions,
 	exportOpts exportCommandOptions,
 	importOpts importCommandOptions,
+	repairOpts repairPositionsCommandOptions,
+	doctorOpts doctorCommandOptions,
+	statsOpts statsCommandOptions,
+	verifyOpts verifyResourcesCommandOptions,
+	purgeOpts purgeCommandOptions,
+	devSeedOpts devSeedCommandOptions,
 	stdout io.Writer,
 	stderr io.Writer,
 ) error {
 	if rootOpts.showVersion {
 		return writeVersion(stdout)
 	}
+	if rootOpts.quiet && rootOpts.verbose {
+		return fmt.Errorf("--quiet and --verbose cannot be combined")
+	}
 
+	timer := newStartupTimer(time.Now)
 	paths, err := platform.DefaultPathsWithOptions(platform.Options{
 		AppName: rootOpts.appName,
 		DevMode: rootOpts.devMode,
+		Home:    rootOpts.homeDir,
 	})
 	if err != nil {
 		return err
 	}
+	timer.mark("paths")
 
-	configPath := rootOpts.configPath
-	dbPath := rootOpts.dbPath
-	dbOverridden := strings.TrimSpace(dbPath) != ""
-	if configPath == "" {
-		if envPath := strings.TrimSpace(os.Getenv("TILL_CONFIG")); envPath != "" {
-			configPath = envPath
-		} else {
-			configPath = paths.ConfigPath
-		}
-	}
-	if !dbOverridden {
-		if envPath := strings.TrimSpace(os.Getenv("TILL_DB_PATH")); envPath != "" {
-			dbPath = envPath
-			dbOverridden = true
-		} else {
-			dbPath = paths.DBPath
-		}
-	}
+	configPath := resolveConfigPath(rootOpts, paths)
+	dbPath, dbOverridden := resolveDBPath(rootOpts, paths)
 	if err := seedStartupConfigFromExampleIfMissing(command, configPath); err != nil {
 		return fmt.Errorf("seed startup config %q: %w", configPath, err)
 	}
@@ -525,12 +935,14 @@ func executeCommandFlow(
 	if dbOverridden {
 		cfg.Database.Path = dbPath
 	}
+	cfg.Logging.Level = logLevelOverride(cfg.Logging.Level, rootOpts)
 	if command == "" {
 		if err := ensureStartupIdentityActorID(configPath, &cfg); err != nil {
 			return fmt.Errorf("bootstrap identity.actor_id: %w", err)
 		}
 	}
 	bootstrapRequired := startupBootstrapRequired(cfg)
+	timer.mark("config_load")
 
 	logger, err := newRuntimeLogger(stderr, rootOpts.appName, rootOpts.devMode, cfg.Logging, time.Now)
 	if err != nil {
@@ -548,16 +960,84 @@ func executeCommandFlow(
 		}
 	}()
 	defer logger.RestoreDefault()
+	timer.mark("logger")
 
-	logger.Info("startup configuration resolved", "app", rootOpts.appName, "dev_mode", rootOpts.devMode, "command", command, "bootstrap_required", bootstrapRequired)
+	if rootOpts.profiling.enabled() {
+		prof, err := startProfiling(rootOpts.profiling, rootOpts.devMode)
+		if err != nil {
+			logger.Error("profiling setup failed", "err", err)
+			return fmt.Errorf("start profiling: %w", err)
+		}
+		defer func() {
+			if stopErr := prof.stop(); stopErr != nil {
+				logger.Warn("profiling shutdown failed", "err", stopErr)
+			}
+		}()
+		if prof.addr != "" {
+			logger.Info("pprof endpoint enabled", "url", "http://"+prof.addr+"/debug/pprof/")
+		}
+		logger.Info("profiling enabled", "cpu_profile", rootOpts.profiling.cpuProfile, "mem_profile", rootOpts.profiling.memProfile)
+		timer.mark("profiling")
+	}
+
+	logger.Info("startup configuration resolved", "app", rootOpts.appName, "dev_mode", rootOpts.devMode, "command", command, "bootstrap_required", bootstrapRequired, "read_only", rootOpts.readOnly)
 	logger.Debug("runtime paths resolved", "config_path", configPath, "data_dir", paths.DataDir, "db_path", dbPath)
 	logger.Info("configuration loaded", "config_path", configPath, "db_path", cfg.Database.Path, "log_level", cfg.Logging.Level)
 	if devPath := logger.DevLogPath(); devPath != "" {
 		logger.Info("dev file logging enabled", "path", devPath)
 	}
 
-	logger.Info("opening sqlite repository", "db_path", cfg.Database.Path)
-	repo, err := sqlite.Open(cfg.Database.Path)
+	// Probe before the lock and migrations so an unwritable path gets guidance instead of a raw SQLite error.
+	readOnlyDB := false
+	if command == "stats" && databaseFileExists(cfg.Database.Path) {
+		// Stats only reads, so an existing database is opened read-only without probing, locking, or migrating it.
+		readOnlyDB = true
+	} else if err := checkDatabaseWritableFunc(cfg.Database.Path); err != nil {
+		if !errors.Is(err, platform.ErrPathNotWritable) {
+			logger.Error("database path check failed", "db_path", cfg.Database.Path, "err", err)
+			return err
+		}
+		if !canFallBackToReadOnlyDB(command, cfg.Database.Path, cfg.Database.ReadOnlyFallback || rootOpts.readOnly) {
+			logger.Error("database path not writable", "db_path", cfg.Database.Path, "err", err)
+			return unwritableDatabaseError(cfg.Database.Path, configPath, err)
+		}
+		logger.Warn("database path not writable; opening existing database read-only", "db_path", cfg.Database.Path, "err", err)
+		readOnlyDB = true
+		rootOpts.readOnly = true
+	}
+
+	// Read-only sessions never write board data, so they may share a database another instance holds.
+	if command == "" && !rootOpts.readOnly {
+		lock, err := acquireInstanceLockFunc(cfg.Database.Path)
+		switch {
+		case errors.Is(err, platform.ErrInstanceLocked):
+			if !confirmReadOnlyFunc(cfg.Database.Path) {
+				logger.Error("instance lock held by another instance", "db_path", cfg.Database.Path, "err", err)
+				return fmt.Errorf("database is in use by another tillsyn instance (re-run with --read-only to browse it): %w", err)
+			}
+			logger.Warn("instance lock held by another instance; opening read-only", "db_path", cfg.Database.Path, "err", err)
+			rootOpts.readOnly = true
+		case err != nil:
+			// The lock is best-effort: filesystems without hard links must not keep the TUI from starting.
+			logger.Warn("instance lock unavailable; continuing without it", "db_path", cfg.Database.Path, "err", err)
+		default:
+			defer func() {
+				if releaseErr := lock.Release(); releaseErr != nil {
+					logger.Warn("instance lock release failed", "lock_path", lock.Path(), "err", releaseErr)
+				}
+			}()
+			logger.Info("instance lock acquired", "lock_path", lock.Path())
+		}
+		timer.mark("instance_lock")
+	}
+
+	logger.Info("opening sqlite repository", "db_path", cfg.Database.Path, "read_only", readOnlyDB)
+	var repo *sqlite.Repository
+	if readOnlyDB {
+		repo, err = sqlite.OpenReadOnly(cfg.Database.Path)
+	} else {
+		repo, err = sqlite.Open(cfg.Database.Path)
+	}
 	if err != nil {
 		logger.Error("sqlite open failed", "db_path", cfg.Database.Path, "err", err)
 		return fmt.Errorf("open sqlite repository: %w", err)
@@ -567,7 +1047,12 @@ func executeCommandFlow(
 			logger.Warn("sqlite close failed", "db_path", cfg.Database.Path, "err", closeErr)
 		}
 	}()
-	logger.Info("sqlite repository ready", "db_path", cfg.Database.Path, "migrations", "ensured")
+	migrations := "ensured"
+	if readOnlyDB {
+		migrations = "skipped (read-only)"
+	}
+	logger.Info("sqlite repository ready", "db_path", cfg.Database.Path, "migrations", migrations)
+	timer.mark("sqlite_open_migrate")
 
 	var embeddingGenerator app.EmbeddingGenerator
 	if cfg.Embeddings.Enabled {
@@ -588,20 +1073,31 @@ func executeCommandFlow(
 
 	svc := app.NewService(repo, uuid.NewString, nil, app.ServiceConfig{
 		DefaultDeleteMode:        app.DeleteMode(cfg.Delete.DefaultMode),
+		ParentDeletePolicy:       app.ParentDeletePolicy(cfg.Delete.ParentPolicy),
+		AutoCompleteParents:      cfg.Board.AutoCompleteParents,
 		AutoCreateProjectColumns: true,
+		DefaultColumnWIPLimits:   cfg.Board.Columns,
+		ProjectColumns:           projectColumnsFromConfig(cfg.ProjectProfiles),
 		EmbeddingGenerator:       embeddingGenerator,
 		SearchLexicalWeight:      cfg.Embeddings.LexicalWeight,
 		SearchSemanticWeight:     cfg.Embeddings.SemanticWeight,
 		SearchSemanticCandidates: cfg.Embeddings.QueryTopK,
 	})
 	logger.Debug("application service initialized", "default_delete_mode", cfg.Delete.DefaultMode)
+	timer.mark("service_init")
+	timer.logPhases(logger)
+	if rootOpts.timing {
+		if err := timer.writeSummary(stderr); err != nil {
+			return err
+		}
+	}
 
 	switch command {
 	case "":
 		logger.Info("command flow start", "command", "tui")
 	case "serve":
 		logger.Info("command flow start", "command", "serve")
-		if err := runServe(ctx, svc, rootOpts.appName, serveOpts); err != nil {
+		if err := runServe(ctx, svc, cfg, rootOpts.appName, serveOpts); err != nil {
 			logger.Error("command flow failed", "command", "serve", "err", err)
 			return fmt.Errorf("run serve command: %w", err)
 		}
@@ -616,13 +1112,61 @@ func executeCommandFlow(
 		logger.Info("command flow complete", "command", "export")
 		return nil
 	case "import":
-		logger.Info("command flow start", "command", "import")
-		if err := runImport(ctx, svc, importOpts); err != nil {
+		logger.Info("command flow start", "command", "import", "dry_run", importOpts.dryRun)
+		if err := runImport(ctx, svc, importOpts, stdout); err != nil {
 			logger.Error("command flow failed", "command", "import", "err", err)
 			return fmt.Errorf("run import command: %w", err)
 		}
 		logger.Info("command flow complete", "command", "import")
 		return nil
+	case "repair-positions":
+		logger.Info("command flow start", "command", "repair-positions", "project_id", repairOpts.projectID, "dry_run", repairOpts.dryRun)
+		if err := runRepairPositions(ctx, svc, repairOpts, stdout); err != nil {
+			logger.Error("command flow failed", "command", "repair-positions", "err", err)
+			return fmt.Errorf("run repair positions command: %w", err)
+		}
+		logger.Info("command flow complete", "command", "repair-positions")
+		return nil
+	case "doctor":
+		logger.Info("command flow start", "command", "doctor", "project_id", doctorOpts.projectID, "fix", doctorOpts.fix)
+		if err := runDoctor(ctx, svc, doctorOpts, stdout); err != nil {
+			logger.Error("command flow failed", "command", "doctor", "err", err)
+			return fmt.Errorf("run doctor command: %w", err)
+		}
+		logger.Info("command flow complete", "command", "doctor")
+		return nil
+	case "stats":
+		logger.Info("command flow start", "command", "stats", "project_slug", statsOpts.projectSlug, "json", statsOpts.json)
+		if err := runStats(ctx, svc, statsOpts, stdout); err != nil {
+			logger.Error("command flow failed", "command", "stats", "err", err)
+			return fmt.Errorf("run stats command: %w", err)
+		}
+		logger.Info("command flow complete", "command", "stats")
+		return nil
+	case "verify-resources":
+		logger.Info("command flow start", "command", "verify-resources", "project_slug", verifyOpts.projectSlug, "dry_run", verifyOpts.dryRun)
+		if err := runVerifyResources(ctx, svc, verifyOpts, cfg.ProjectRoots, stdout); err != nil {
+			logger.Error("command flow failed", "command", "verify-resources", "err", err)
+			return fmt.Errorf("run verify resources command: %w", err)
+		}
+		logger.Info("command flow complete", "command", "verify-resources")
+		return nil
+	case "purge":
+		logger.Info("command flow start", "command", "purge", "older_than", purgeOpts.olderThan, "dry_run", purgeOpts.dryRun, "force", purgeOpts.force)
+		if err := runPurge(ctx, svc, purgeOpts, time.Now(), stdout); err != nil {
+			logger.Error("command flow failed", "command", "purge", "err", err)
+			return fmt.Errorf("run purge command: %w", err)
+		}
+		logger.Info("command flow complete", "command", "purge")
+		return nil
+	case "dev-seed":
+		logger.Info("command flow start", "command", "dev-seed", "projects", devSeedOpts.projects, "tasks_per_project", devSeedOpts.tasksPerProject, "seed", devSeedOpts.seed)
+		if err := runDevSeed(ctx, svc, devSeedOpts, stdout); err != nil {
+			logger.Error("command flow failed", "command", "dev-seed", "err", err)
+			return fmt.Errorf("run dev seed command: %w", err)
+		}
+		logger.Info("command flow complete", "command", "dev-seed")
+		return nil
 	default:
 		return fmt.Errorf("unknown command: %s", command)
 	}
@@ -631,7 +1175,12 @@ func executeCommandFlow(
 		svc,
 		tui.WithLaunchProjectPicker(true),
 		tui.WithStartupBootstrap(bootstrapRequired),
-		tui.WithAutoRefreshInterval(2*time.Second),
+		tui.WithReadOnly(rootOpts.readOnly),
+		tui.WithReloadDebounce(60*time.Millisecond),
+		tui.WithDataDir(paths.DataDir),
+		tui.WithDraftDir(filepath.Join(filepath.Dir(cfg.Database.Path), "drafts")),
+		tui.WithLastSeenPath(filepath.Join(filepath.Dir(cfg.Database.Path), "last_seen.json")),
+		tui.WithViewStatePath(filepath.Join(filepath.Dir(cfg.Database.Path), "view_state.json")),
 		tui.WithRuntimeConfig(toTUIRuntimeConfig(cfg)),
 		tui.WithReloadConfigCallback(func() (tui.RuntimeConfig, error) {
 			logger.Info("runtime config reload requested", "config_path", configPath)
@@ -661,6 +1210,24 @@ func executeCommandFlow(
 			logger.Info("labels config update complete", "project_slug", projectSlug, "global_count", len(globalLabels), "project_count", len(projectLabels), "config_path", configPath)
 			return nil
 		}),
+		tui.WithSaveHighlightColorCallback(func(color string) error {
+			logger.Info("highlight color update requested", "color", color, "config_path", configPath)
+			if err := persistHighlightColor(configPath, color); err != nil {
+				logger.Error("highlight color update failed", "color", color, "config_path", configPath, "err", err)
+				return err
+			}
+			logger.Info("highlight color update complete", "color", color, "config_path", configPath)
+			return nil
+		}),
+		tui.WithSaveSavedSearchCallback(func(name string, search tui.SavedSearch) error {
+			logger.Info("saved search update requested", "name", name, "config_path", configPath)
+			if err := persistSavedSearch(configPath, name, search); err != nil {
+				logger.Error("saved search update failed", "name", name, "config_path", configPath, "err", err)
+				return err
+			}
+			logger.Info("saved search update complete", "name", name, "config_path", configPath)
+			return nil
+		}),
 		tui.WithSaveBootstrapConfigCallback(func(bootstrap tui.BootstrapConfig) error {
 			actorID := strings.TrimSpace(bootstrap.ActorID)
 			if actorID == "" {
@@ -693,14 +1260,40 @@ func executeCommandFlow(
 }
 
 // runServe runs the serve subcommand flow.
-func runServe(ctx context.Context, svc *app.Service, appName string, opts serveCommandOptions) error {
-	appAdapter := servercommon.NewAppServiceAdapter(svc)
+// SIGINT and SIGTERM cancel the server context so in-flight requests drain before the process exits.
+func runServe(ctx context.Context, svc *app.Service, cfg config.Config, appName string, opts serveCommandOptions) error {
+	if opts.shutdownTimeout < 0 {
+		return fmt.Errorf("--shutdown-timeout must be >= 0, got %s", opts.shutdownTimeout)
+	}
+	if opts.rateLimit < 0 {
+		return fmt.Errorf("--rate-limit must be >= 0, got %v", opts.rateLimit)
+	}
+	if opts.rateBurst < 0 {
+		return fmt.Errorf("--rate-burst must be >= 0, got %d", opts.rateBurst)
+	}
+	// The env var keeps the token out of shell history and process listings; an explicit flag still wins.
+	apiToken := strings.TrimSpace(opts.apiToken)
+	if apiToken == "" {
+		apiToken = strings.TrimSpace(os.Getenv("TILL_API_TOKEN"))
+	}
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// MCP task creates and updates honor the same label allowlist the TUI enforces.
+	appAdapter := servercommon.NewAppServiceAdapter(svc).WithLabelPolicy(servercommon.LabelPolicy{
+		Enforce: cfg.Labels.EnforceAllowed,
+		Allowed: cfg.AllowedLabels,
+	})
 	return serveCommandRunner(ctx, serveradapter.Config{
-		HTTPBind:      opts.httpBind,
-		APIEndpoint:   opts.apiEndpoint,
-		MCPEndpoint:   opts.mcpEndpoint,
-		ServerName:    appName,
-		ServerVersion: version,
+		HTTPBind:        opts.httpBind,
+		APIEndpoint:     opts.apiEndpoint,
+		MCPEndpoint:     opts.mcpEndpoint,
+		ServerName:      appName,
+		ServerVersion:   version,
+		ShutdownTimeout: opts.shutdownTimeout,
+		APIToken:        apiToken,
+		RateLimit:       opts.rateLimit,
+		RateBurst:       opts.rateBurst,
 	}, serveradapter.Dependencies{
 		CaptureState: appAdapter,
 		Attention:    appAdapter,
@@ -709,15 +1302,10 @@ func runServe(ctx context.Context, svc *app.Service, appName string, opts serveC
 
 // runExport runs the requested command flow.
 func runExport(ctx context.Context, svc *app.Service, opts exportCommandOptions, stdout io.Writer) error {
-	snap, err := svc.ExportSnapshot(ctx, opts.includeArchived)
+	encoded, err := encodeExport(ctx, svc, opts)
 	if err != nil {
-		return fmt.Errorf("export snapshot: %w", err)
-	}
-	encoded, err := json.MarshalIndent(snap, "", "  ")
-	if err != nil {
-		return fmt.Errorf("encode snapshot json: %w", err)
+		return err
 	}
-	encoded = append(encoded, '\n')
 
 	if opts.outPath == "-" {
 		if _, err := stdout.Write(encoded); err != nil {
@@ -734,23 +1322,594 @@ func runExport(ctx context.Context, svc *app.Service, opts exportCommandOptions,
 	return nil
 }
 
+// encodeExport encodes the full snapshot, one project with --project, or one task card with --task.
+func encodeExport(ctx context.Context, svc *app.Service, opts exportCommandOptions) ([]byte, error) {
+	projectSlug := strings.TrimSpace(opts.projectSlug)
+	if taskID := strings.TrimSpace(opts.taskID); taskID != "" {
+		if projectSlug != "" {
+			return nil, fmt.Errorf("--project and --task cannot be combined")
+		}
+		format, err := app.ParseTaskCardFormat(opts.format)
+		if err != nil {
+			return nil, err
+		}
+		card, err := svc.ExportTaskCard(ctx, app.ExportTaskCardInput{
+			TaskID:          taskID,
+			IncludeSubtasks: opts.subtasks,
+			Format:          format,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("export task card: %w", err)
+		}
+		return card, nil
+	}
+	if opts.subtasks {
+		return nil, fmt.Errorf("--subtasks requires --task")
+	}
+	format, err := app.ParseExportFormat(opts.format)
+	if err != nil {
+		return nil, err
+	}
+	var snap app.Snapshot
+	if projectSlug != "" {
+		snap, err = svc.ExportProjectSnapshot(ctx, projectSlug, opts.includeArchived)
+	} else {
+		snap, err = svc.ExportSnapshot(ctx, opts.includeArchived)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("export snapshot: %w", err)
+	}
+	switch format {
+	case app.ExportFormatCSV:
+		return app.EncodeSnapshotCSV(snap)
+	case app.ExportFormatMarkdown:
+		return app.EncodeSnapshotMarkdown(snap), nil
+	}
+	encoded, err := json.MarshalIndent(snap, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("encode snapshot json: %w", err)
+	}
+	return append(encoded, '\n'), nil
+}
+
 // runImport runs the requested command flow.
-func runImport(ctx context.Context, svc *app.Service, opts importCommandOptions) error {
+func runImport(ctx context.Context, svc *app.Service, opts importCommandOptions, stdout io.Writer) error {
+	if opts.inDir != "" {
+		return runImportDir(ctx, svc, opts, stdout)
+	}
 	if opts.inPath == "" {
-		return fmt.Errorf("--in is required")
+		return fmt.Errorf("--in or --in-dir is required")
+	}
+
+	source, err := app.ParseImportSource(opts.from)
+	if err != nil {
+		return err
+	}
+	if opts.validate && source != app.ImportSourceSnapshot {
+		return fmt.Errorf("--validate checks snapshot files only, not --from %s", source)
 	}
 
 	content, err := os.ReadFile(opts.inPath)
 	if err != nil {
 		return fmt.Errorf("read import file: %w", err)
 	}
+	if opts.validate {
+		if err := app.ValidateSnapshotJSON(content); err != nil {
+			return fmt.Errorf("validate snapshot: %w", err)
+		}
+	}
 	var snap app.Snapshot
-	if err := json.Unmarshal(content, &snap); err != nil {
-		return fmt.Errorf("decode snapshot json: %w", err)
+	var dropped []string
+	switch source {
+	case app.ImportSourceTrello:
+		converted, err := app.SnapshotFromTrello(content, uuid.NewString, time.Now())
+		if err != nil {
+			return fmt.Errorf("convert trello export: %w", err)
+		}
+		snap, dropped = converted.Snapshot, converted.Dropped
+	case app.ImportSourceGitHub:
+		converted, err := app.SnapshotFromGitHubIssues(content, uuid.NewString, time.Now())
+		if err != nil {
+			return fmt.Errorf("convert github issues export: %w", err)
+		}
+		snap, dropped = converted.Snapshot, converted.Dropped
+	default:
+		if err := json.Unmarshal(content, &snap); err != nil {
+			return fmt.Errorf("decode snapshot json: %w", err)
+		}
+	}
+	mode, err := app.ParseImportMode(opts.mode)
+	if err != nil {
+		return err
+	}
+	if opts.dryRun {
+		ctx = app.WithDryRun(ctx)
 	}
-	if err := svc.ImportSnapshot(ctx, snap); err != nil {
+	return importDecodedSnapshot(ctx, svc, snap, mode, source, dropped, opts.repairPositions, stdout)
+}
+
+// importDecodedSnapshot imports one decoded snapshot in mode, prints its summary and any conversion notes, and
+// optionally repairs positions in the projects it touched.
+func importDecodedSnapshot(ctx context.Context, svc *app.Service, snap app.Snapshot, mode app.ImportMode, source app.ImportSource, dropped []string, repairPositions bool, stdout io.Writer) error {
+	importFn := svc.ImportSnapshot
+	if mode == app.ImportModeMerge {
+		importFn = svc.ImportSnapshotMerge
+	}
+	summary, err := importFn(ctx, snap)
+	if err != nil {
 		return fmt.Errorf("import snapshot: %w", err)
 	}
+	if snap.ProjectSlug != "" {
+		if _, err := fmt.Fprintf(stdout, "single-project snapshot: %q\n", snap.ProjectSlug); err != nil {
+			return fmt.Errorf("write import output: %w", err)
+		}
+	}
+	if err := writeImportSummary(stdout, summary); err != nil {
+		return fmt.Errorf("write import output: %w", err)
+	}
+	for _, note := range dropped {
+		if _, err := fmt.Fprintf(stdout, "%s: %s\n", source, note); err != nil {
+			return fmt.Errorf("write import output: %w", err)
+		}
+	}
+	if summary.DryRun {
+		// Shared slugs and dangling dependencies import fine but leave ambiguous config or lost links behind,
+		// so a dry run fails on them to gate scripts.
+		problems := make([]string, 0, 2)
+		if len(summary.DuplicateSlugs) > 0 {
+			problems = append(problems, fmt.Sprintf("%d duplicate project slugs", len(summary.DuplicateSlugs)))
+		}
+		if len(summary.DroppedDependencies) > 0 {
+			problems = append(problems, fmt.Sprintf("%d dangling dependency references", len(summary.DroppedDependencies)))
+		}
+		if len(problems) > 0 {
+			return fmt.Errorf("dry run found %s", strings.Join(problems, " and "))
+		}
+		return nil
+	}
+	if !repairPositions {
+		return nil
+	}
+	// Merge imports may land in stored projects, so repair the ids the import reports rather than the snapshot's.
+	for _, projectID := range summary.ProjectIDs {
+		if _, err := svc.NormalizeColumnPositions(ctx, projectID); err != nil {
+			return fmt.Errorf("repair positions for project %q: %w", projectID, err)
+		}
+	}
+	return nil
+}
+
+// runImportDir merges every *.json snapshot in opts.inDir in filename order, reporting each file's outcome.
+// Other files and JSON that is not a snapshot are skipped with a warning; a file that fails to import is reported
+// and the rest still run unless opts.failFast is set, but any failure makes the command exit non-zero.
+func runImportDir(ctx context.Context, svc *app.Service, opts importCommandOptions, stdout io.Writer) error {
+	if opts.inPath != "" {
+		return fmt.Errorf("--in and --in-dir cannot be combined")
+	}
+	source, err := app.ParseImportSource(opts.from)
+	if err != nil {
+		return err
+	}
+	if source != app.ImportSourceSnapshot {
+		return fmt.Errorf("--in-dir imports snapshot files only, not --from %s", source)
+	}
+	if strings.TrimSpace(opts.mode) != "" {
+		mode, err := app.ParseImportMode(opts.mode)
+		if err != nil {
+			return err
+		}
+		if mode != app.ImportModeMerge {
+			return fmt.Errorf("--in-dir always merges, so --mode %s is not supported", mode)
+		}
+	}
+	entries, err := os.ReadDir(opts.inDir)
+	if err != nil {
+		return fmt.Errorf("read import directory: %w", err)
+	}
+	if opts.dryRun {
+		ctx = app.WithDryRun(ctx)
+	}
+
+	// os.ReadDir returns entries sorted by filename, which is the import order.
+	var seen, imported, skipped int
+	failed := make([]string, 0)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		seen++
+		name := entry.Name()
+		if !strings.EqualFold(filepath.Ext(name), ".json") {
+			skipped++
+			if _, err := fmt.Fprintf(stdout, "warning: skipping %s: not a .json file\n", name); err != nil {
+				return fmt.Errorf("write import output: %w", err)
+			}
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(opts.inDir, name))
+		if err != nil {
+			return fmt.Errorf("read import file %s: %w", name, err)
+		}
+		var snap app.Snapshot
+		decodeErr := json.Unmarshal(content, &snap)
+		if decodeErr == nil && strings.TrimSpace(snap.Version) == "" {
+			// Other JSON, such as a Trello export, decodes into an empty snapshot without a version.
+			decodeErr = errors.New("missing snapshot version")
+		}
+		if decodeErr != nil {
+			skipped++
+			if _, err := fmt.Fprintf(stdout, "warning: skipping %s: not a snapshot: %v\n", name, decodeErr); err != nil {
+				return fmt.Errorf("write import output: %w", err)
+			}
+			continue
+		}
+
+		if _, err := fmt.Fprintf(stdout, "%s:\n", name); err != nil {
+			return fmt.Errorf("write import output: %w", err)
+		}
+		var importErr error
+		if opts.validate {
+			if err := app.ValidateSnapshotJSON(content); err != nil {
+				importErr = fmt.Errorf("validate snapshot: %w", err)
+			}
+		}
+		if importErr == nil {
+			importErr = importDecodedSnapshot(ctx, svc, snap, app.ImportModeMerge, source, nil, opts.repairPositions, stdout)
+		}
+		if importErr != nil {
+			failed = append(failed, name)
+			if _, err := fmt.Fprintf(stdout, "%s: failed: %v\n", name, importErr); err != nil {
+				return fmt.Errorf("write import output: %w", err)
+			}
+			if opts.failFast {
+				return fmt.Errorf("import %s: %w", name, importErr)
+			}
+			continue
+		}
+		imported++
+	}
+
+	if _, err := fmt.Fprintf(stdout, "imported %d of %d files (%d skipped, %d failed)\n", imported, seen, skipped, len(failed)); err != nil {
+		return fmt.Errorf("write import output: %w", err)
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("%d snapshot files failed to import: %s", len(failed), strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// writeImportSummary prints per-section import counts followed by dropped references and slug collisions.
+func writeImportSummary(stdout io.Writer, summary app.ImportSummary) error {
+	created, updated, dropped := "created", "updated", "dropped"
+	if summary.DryRun {
+		created, updated, dropped = "would create", "would update", "would drop"
+		if _, err := fmt.Fprintln(stdout, "dry run: no changes written"); err != nil {
+			return err
+		}
+	}
+	for _, section := range []struct {
+		name   string
+		counts app.ImportCounts
+	}{
+		{"projects", summary.Projects},
+		{"columns", summary.Columns},
+		{"tasks", summary.Tasks},
+		{"comments", summary.Comments},
+	} {
+		if _, err := fmt.Fprintf(stdout, "%s: %s %d, %s %d\n", section.name, created, section.counts.Created, updated, section.counts.Updated); err != nil {
+			return err
+		}
+	}
+	for _, match := range summary.MatchedProjects {
+		if _, err := fmt.Fprintf(stdout, "merged project %s into %s (slug %q)\n", match.SnapshotID, match.StoredID, match.Slug); err != nil {
+			return err
+		}
+	}
+	for _, ref := range summary.DroppedDependencies {
+		if _, err := fmt.Fprintf(stdout, "%s dangling %s reference %q on task %s\n", dropped, ref.Field, ref.Ref, ref.TaskID); err != nil {
+			return err
+		}
+	}
+	for _, dup := range summary.DuplicateSlugs {
+		if _, err := fmt.Fprintf(stdout, "duplicate project slug %q: %s\n", dup.Slug, strings.Join(dup.ProjectIDs, ", ")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runRepairPositions renumbers task positions for one project or every project and reports changes.
+func runRepairPositions(ctx context.Context, svc *app.Service, opts repairPositionsCommandOptions, stdout io.Writer) error {
+	projectIDs := []string{strings.TrimSpace(opts.projectID)}
+	if projectIDs[0] == "" {
+		projects, err := svc.ListProjects(ctx, true)
+		if err != nil {
+			return fmt.Errorf("list projects: %w", err)
+		}
+		projectIDs = projectIDs[:0]
+		for _, project := range projects {
+			projectIDs = append(projectIDs, project.ID)
+		}
+	}
+	if opts.dryRun {
+		ctx = app.WithDryRun(ctx)
+	}
+	verb := "repositioned"
+	if opts.dryRun {
+		verb = "would reposition"
+	}
+	for _, projectID := range projectIDs {
+		changed, err := svc.NormalizeColumnPositions(ctx, projectID)
+		if err != nil {
+			return fmt.Errorf("repair positions for project %q: %w", projectID, err)
+		}
+		if _, err := fmt.Fprintf(stdout, "%s: %s %d tasks\n", projectID, verb, changed); err != nil {
+			return fmt.Errorf("write repair positions output: %w", err)
+		}
+	}
+	return nil
+}
+
+// runDoctor lists orphaned subtasks per project and optionally repairs them.
+func runDoctor(ctx context.Context, svc *app.Service, opts doctorCommandOptions, stdout io.Writer) error {
+	fixMode := app.OrphanRepairMode(strings.TrimSpace(strings.ToLower(opts.fix)))
+	switch fixMode {
+	case "", app.OrphanRepairReparent, app.OrphanRepairDelete:
+	default:
+		return fmt.Errorf("--fix must be reparent or delete, got %q", opts.fix)
+	}
+	projects, err := svc.ListProjects(ctx, true)
+	if err != nil {
+		return fmt.Errorf("list projects: %w", err)
+	}
+	if projectID := strings.TrimSpace(opts.projectID); projectID != "" {
+		projects = slices.DeleteFunc(projects, func(project domain.Project) bool {
+			return project.ID != projectID
+		})
+		if len(projects) == 0 {
+			return fmt.Errorf("project %q: %w", projectID, app.ErrNotFound)
+		}
+	}
+
+	total := 0
+	for _, project := range projects {
+		orphans, err := svc.ListOrphanedTasks(ctx, project.ID)
+		if err != nil {
+			return fmt.Errorf("check orphans for project %q: %w", project.ID, err)
+		}
+		for _, orphan := range orphans {
+			if _, err := fmt.Fprintf(stdout, "%s: orphaned %s %q (missing parent %s)\n", project.Slug, orphan.ID, orphan.Title, orphan.ParentID); err != nil {
+				return fmt.Errorf("write doctor output: %w", err)
+			}
+		}
+		total += len(orphans)
+		if fixMode == "" || len(orphans) == 0 {
+			continue
+		}
+		repaired, err := svc.RepairOrphanedTasks(ctx, project.ID, fixMode)
+		if err != nil {
+			return fmt.Errorf("repair orphans for project %q: %w", project.ID, err)
+		}
+		if _, err := fmt.Fprintf(stdout, "%s: %s %d orphaned tasks\n", project.Slug, doctorFixVerb(fixMode), len(repaired)); err != nil {
+			return fmt.Errorf("write doctor output: %w", err)
+		}
+	}
+	summary := fmt.Sprintf("%d orphaned subtasks found", total)
+	if total > 0 && fixMode == "" {
+		summary += " (rerun with --fix reparent or --fix delete)"
+	}
+	if _, err := fmt.Fprintln(stdout, summary); err != nil {
+		return fmt.Errorf("write doctor output: %w", err)
+	}
+	return nil
+}
+
+// doctorFixVerb returns the past-tense report verb for one orphan repair mode.
+func doctorFixVerb(mode app.OrphanRepairMode) string {
+	if mode == app.OrphanRepairDelete {
+		return "deleted"
+	}
+	return "reparented"
+}
+
+// runVerifyResources checks local resource refs per project, lists the missing ones, and fails when any are missing.
+func runVerifyResources(ctx context.Context, svc *app.Service, opts verifyResourcesCommandOptions, projectRoots map[string]string, stdout io.Writer) error {
+	projects, err := svc.ListProjects(ctx, true)
+	if err != nil {
+		return fmt.Errorf("list projects: %w", err)
+	}
+	if slug := strings.TrimSpace(opts.projectSlug); slug != "" {
+		projects = slices.DeleteFunc(projects, func(project domain.Project) bool {
+			return project.Slug != slug
+		})
+		if len(projects) == 0 {
+			return fmt.Errorf("project %q: %w", slug, app.ErrNotFound)
+		}
+	}
+	if opts.dryRun {
+		ctx = app.WithDryRun(ctx)
+	}
+
+	missing := 0
+	for _, project := range projects {
+		root := strings.TrimSpace(projectRoots[strings.ToLower(project.Slug)])
+		if root != "" {
+			if abs, err := filepath.Abs(root); err == nil {
+				root = abs
+			}
+		}
+		result, err := svc.VerifyResourceRefs(ctx, project.ID, root)
+		if err != nil {
+			return fmt.Errorf("verify resources for project %q: %w", project.Slug, err)
+		}
+		for _, ref := range result.Missing {
+			if _, err := fmt.Fprintf(stdout, "%s: missing %s on %s %q\n", project.Slug, ref.Location, ref.TaskID, ref.TaskTitle); err != nil {
+				return fmt.Errorf("write verify resources output: %w", err)
+			}
+		}
+		summary := fmt.Sprintf("%s: %d of %d local refs found", project.Slug, result.Verified, result.Checked)
+		if result.Unresolved > 0 {
+			summary += fmt.Sprintf(", %d relative refs skipped (no project_roots entry)", result.Unresolved)
+		}
+		if _, err := fmt.Fprintln(stdout, summary); err != nil {
+			return fmt.Errorf("write verify resources output: %w", err)
+		}
+		missing += len(result.Missing)
+	}
+	if missing > 0 {
+		return fmt.Errorf("%d resource refs missing", missing)
+	}
+	return nil
+}
+
+// runPurge hard-deletes tasks archived longer ago than --older-than and lists what was removed.
+// Candidates that other tasks still reference are kept and fail the command unless --force is given.
+func runPurge(ctx context.Context, svc *app.Service, opts purgeCommandOptions, now time.Time, stdout io.Writer) error {
+	if strings.TrimSpace(opts.olderThan) == "" {
+		return fmt.Errorf("--older-than is required")
+	}
+	age, err := domain.ParseReminderOffset(opts.olderThan)
+	if err != nil {
+		return fmt.Errorf("invalid --older-than %q: want a positive age such as 90d, 12w, or 720h", opts.olderThan)
+	}
+	cutoff := now.UTC().Add(-age)
+	projects, err := svc.ListProjects(ctx, true)
+	if err != nil {
+		return fmt.Errorf("list projects: %w", err)
+	}
+	slugs := make(map[string]string, len(projects))
+	for _, project := range projects {
+		slugs[project.ID] = project.Slug
+	}
+	if opts.dryRun {
+		ctx = app.WithDryRun(ctx)
+	}
+	result, err := svc.PurgeArchivedBefore(ctx, cutoff, opts.force)
+	if err != nil {
+		return fmt.Errorf("purge archived tasks: %w", err)
+	}
+
+	verb := "purged"
+	if opts.dryRun {
+		verb = "would purge"
+	}
+	for _, task := range result.Purged {
+		if _, err := fmt.Fprintf(stdout, "%s: %s %s %q (archived %s)\n", slugs[task.ProjectID], verb, task.TaskID, task.Title, task.ArchivedAt.UTC().Format("2006-01-02")); err != nil {
+			return fmt.Errorf("write purge output: %w", err)
+		}
+	}
+	for _, task := range result.Kept {
+		if _, err := fmt.Fprintf(stdout, "%s: kept %s %q, still referenced by %s\n", slugs[task.ProjectID], task.TaskID, task.Title, strings.Join(task.ReferencedBy, ", ")); err != nil {
+			return fmt.Errorf("write purge output: %w", err)
+		}
+	}
+	if _, err := fmt.Fprintf(stdout, "%s %d tasks archived before %s\n", verb, len(result.Purged), cutoff.Format(time.RFC3339)); err != nil {
+		return fmt.Errorf("write purge output: %w", err)
+	}
+	if kept := len(result.Kept); kept > 0 {
+		return fmt.Errorf("%d archived tasks kept because other tasks still reference them; pass --force to purge them anyway", kept)
+	}
+	return nil
+}
+
+// projectStatsJSON is the machine-readable stats row for one project.
+type projectStatsJSON struct {
+	ProjectID         string         `json:"project_id"`
+	Slug              string         `json:"slug"`
+	Name              string         `json:"name"`
+	TotalTasks        int            `json:"total_tasks"`
+	ByState           map[string]int `json:"by_state"`
+	Overdue           int            `json:"overdue"`
+	Blocked           int            `json:"blocked"`
+	AverageAgeSeconds int64          `json:"average_age_seconds"`
+}
+
+// statsStates lists the lifecycle states every stats row reports, including zero counts.
+var statsStates = []domain.LifecycleState{domain.StateTodo, domain.StateProgress, domain.StateDone, domain.StateArchived}
+
+// runStats prints task counts, overdue and blocked totals, and average task age per project.
+func runStats(ctx context.Context, svc *app.Service, opts statsCommandOptions, stdout io.Writer) error {
+	projects, err := svc.ListProjects(ctx, opts.includeArchived)
+	if err != nil {
+		return fmt.Errorf("list projects: %w", err)
+	}
+	if slug := strings.TrimSpace(opts.projectSlug); slug != "" {
+		projects = slices.DeleteFunc(projects, func(project domain.Project) bool {
+			return project.Slug != slug
+		})
+		if len(projects) == 0 {
+			return fmt.Errorf("project %q: %w", slug, app.ErrNotFound)
+		}
+	}
+
+	rows := make([]projectStatsJSON, 0, len(projects))
+	for _, project := range projects {
+		stats, err := svc.GetProjectStats(ctx, project.ID, opts.includeArchived)
+		if err != nil {
+			return fmt.Errorf("stats for project %q: %w", project.Slug, err)
+		}
+		byState := make(map[string]int, len(statsStates))
+		for _, state := range statsStates {
+			byState[string(state)] = stats.ByState[state]
+		}
+		rows = append(rows, projectStatsJSON{
+			ProjectID:         stats.ProjectID,
+			Slug:              stats.Slug,
+			Name:              stats.Name,
+			TotalTasks:        stats.TotalTasks,
+			ByState:           byState,
+			Overdue:           stats.Overdue,
+			Blocked:           stats.Blocked,
+			AverageAgeSeconds: int64(stats.AverageAge / time.Second),
+		})
+	}
+
+	if opts.json {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(rows); err != nil {
+			return fmt.Errorf("write stats json: %w", err)
+		}
+		return nil
+	}
+	for _, row := range rows {
+		states := make([]string, 0, len(statsStates))
+		for _, state := range statsStates {
+			states = append(states, fmt.Sprintf("%s %d", state, row.ByState[string(state)]))
+		}
+		if _, err := fmt.Fprintf(stdout, "%s (%s): %d tasks (%s), %d overdue, %d blocked, average age %s\n",
+			row.Slug, row.Name, row.TotalTasks, strings.Join(states, ", "), row.Overdue, row.Blocked, formatStatsAge(time.Duration(row.AverageAgeSeconds)*time.Second)); err != nil {
+			return fmt.Errorf("write stats output: %w", err)
+		}
+	}
+	return nil
+}
+
+// formatStatsAge renders one average task age in days, falling back to hours under a day.
+func formatStatsAge(age time.Duration) string {
+	if age < 24*time.Hour {
+		return fmt.Sprintf("%.1fh", age.Hours())
+	}
+	return fmt.Sprintf("%.1fd", age.Hours()/24)
+}
+
+// runDevSeed generates and imports one deterministic synthetic board.
+func runDevSeed(ctx context.Context, svc *app.Service, opts devSeedCommandOptions, stdout io.Writer) error {
+	snap, err := app.BuildSeedSnapshot(app.SeedSnapshotInput{
+		Projects:        opts.projects,
+		TasksPerProject: opts.tasksPerProject,
+		Seed:            opts.seed,
+	})
+	if err != nil {
+		return fmt.Errorf("build seed snapshot: %w", err)
+	}
+	startedAt := time.Now()
+	if _, err := svc.ImportSnapshot(ctx, snap); err != nil {
+		return fmt.Errorf("import seed snapshot: %w", err)
+	}
+	if _, err := fmt.Fprintf(stdout, "seeded %d projects, %d tasks (seed %d) in %s\n", len(snap.Projects), len(snap.Tasks), opts.seed, time.Since(startedAt).Round(time.Millisecond)); err != nil {
+		return fmt.Errorf("write dev seed output: %w", err)
+	}
 	return nil
 }
 
@@ -817,7 +1976,8 @@ func loadRuntimeConfig(configPath string, defaults config.Config, dbPath string,
 // toTUIRuntimeConfig maps persisted config values into runtime model options.
 func toTUIRuntimeConfig(cfg config.Config) tui.RuntimeConfig {
 	return tui.RuntimeConfig{
-		DefaultDeleteMode: app.DeleteMode(cfg.Delete.DefaultMode),
+		DefaultDeleteMode:  app.DeleteMode(cfg.Delete.DefaultMode),
+		ParentDeletePolicy: app.ParentDeletePolicy(cfg.Delete.ParentPolicy),
 		TaskFields: tui.TaskFieldConfig{
 			ShowPriority:    cfg.TaskFields.ShowPriority,
 			ShowDueDate:     cfg.TaskFields.ShowDueDate,
@@ -831,33 +1991,58 @@ func toTUIRuntimeConfig(cfg config.Config) tui.RuntimeConfig {
 		},
 		SearchRoots: cloneSearchRoots(cfg.Paths.SearchRoots),
 		Confirm: tui.ConfirmConfig{
-			Delete:     cfg.Confirm.Delete,
-			Archive:    cfg.Confirm.Archive,
-			HardDelete: cfg.Confirm.HardDelete,
-			Restore:    cfg.Confirm.Restore,
+			Delete:        cfg.Confirm.Delete,
+			Archive:       cfg.Confirm.Archive,
+			HardDelete:    cfg.Confirm.HardDelete,
+			Restore:       cfg.Confirm.Restore,
+			Quit:          cfg.Confirm.Quit,
+			DefaultCancel: cfg.Confirm.DefaultCancel,
 		},
 		Board: tui.BoardConfig{
-			ShowWIPWarnings: cfg.Board.ShowWIPWarnings,
-			GroupBy:         cfg.Board.GroupBy,
+			ShowWIPWarnings:     cfg.Board.ShowWIPWarnings,
+			GroupBy:             cfg.Board.GroupBy,
+			ColumnPageSize:      cfg.Board.ColumnPageSize,
+			TitleWrap:           cfg.Board.TitleWrap,
+			TitleMaxLines:       cfg.Board.TitleMaxLines,
+			WarnDuplicateTitles: cfg.Board.WarnDuplicateTitles,
+			DependencyBadges:    dependencyBadgesFromConfig(cfg.Board.DependencyBadges),
+			DimBlocked:          cfg.Board.DimBlocked,
+		},
+		Projects: tui.ProjectsConfig{
+			Sort:   cfg.Projects.Sort,
+			Pinned: append([]string(nil), cfg.Projects.Pinned...),
 		},
 		UI: tui.UIConfig{
-			DueSoonWindows: cfg.DueSoonDurations(),
-			ShowDueSummary: cfg.UI.ShowDueSummary,
+			DueSoonWindows:    cfg.DueSoonDurations(),
+			ShowDueSummary:    cfg.UI.ShowDueSummary,
+			StartupDueDigest:  cfg.UI.StartupDueDigest,
+			DefaultReminders:  append([]string(nil), cfg.UI.DefaultReminders...),
+			EmptyColumnText:   cfg.UI.EmptyColumnText,
+			EmptyBoardMessage: cfg.UI.EmptyBoardMessage,
+			HighlightStyle:    tui.HighlightStyle(cfg.UI.HighlightStyle),
+			HighlightColor:    cfg.UI.HighlightColor,
+			RenderIcons:       cfg.UI.RenderIcons,
+			StatusSegments:    statusSegmentsFromConfig(cfg.UI.StatusSegments),
+			RefreshOnFocus:    cfg.UI.RefreshOnFocus,
+			RefreshInterval:   cfg.RefreshIntervalDuration(),
+			Layout: tui.LayoutConfig{
+				HideNoticesPanel:     cfg.UI.NoticesPanel == "never",
+				NoticesPanelMinWidth: cfg.UI.NoticesPanelMinWidth,
+				CompactPaddingBelow:  cfg.UI.CompactPaddingBelow,
+			},
+			DraftAutosaveInterval: cfg.DraftAutosaveIntervalDuration(),
 		},
 		Labels: tui.LabelConfig{
 			Global:         append([]string(nil), cfg.Labels.Global...),
 			Projects:       cloneLabelProjectConfig(cfg.Labels.Projects),
 			EnforceAllowed: cfg.Labels.EnforceAllowed,
+			Colors:         maps.Clone(cfg.Labels.Colors),
 		},
-		ProjectRoots: cloneProjectRoots(cfg.ProjectRoots),
-		Keys: tui.KeyConfig{
-			CommandPalette: cfg.Keys.CommandPalette,
-			QuickActions:   cfg.Keys.QuickActions,
-			MultiSelect:    cfg.Keys.MultiSelect,
-			ActivityLog:    cfg.Keys.ActivityLog,
-			Undo:           cfg.Keys.Undo,
-			Redo:           cfg.Keys.Redo,
-		},
+		ProjectRoots:    cloneProjectRoots(cfg.ProjectRoots),
+		ProjectProfiles: projectProfilesFromConfig(cfg.ProjectProfiles),
+		Templates:       taskTemplatesFromConfig(cfg.Templates),
+		SavedSearches:   savedSearchesFromConfig(cfg.Searches),
+		Keys:            tui.KeyConfig(cfg.Keys),
 		Identity: tui.IdentityConfig{
 			ActorID:          cfg.Identity.ActorID,
 			DisplayName:      cfg.Identity.DisplayName,
@@ -898,6 +2083,33 @@ func persistAllowedLabels(configPath, projectSlug string, globalLabels, projectL
 	return nil
 }
 
+// persistHighlightColor updates the focused-row highlight color in the TOML config file.
+func persistHighlightColor(configPath, color string) error {
+	if err := config.UpsertHighlightColor(configPath, color); err != nil {
+		return fmt.Errorf("persist highlight color: %w", err)
+	}
+	return nil
+}
+
+// persistSavedSearch writes one named search filter set to the [searches] section of the TOML config file.
+func persistSavedSearch(configPath, name string, search tui.SavedSearch) error {
+	if err := config.UpsertSavedSearch(configPath, name, config.SavedSearchConfig{
+		Query:           search.Query,
+		CrossProject:    search.CrossProject,
+		IncludeArchived: search.IncludeArchived,
+		IncludeComments: search.IncludeComments,
+		States:          append([]string(nil), search.States...),
+		Levels:          append([]string(nil), search.Levels...),
+		DueAfter:        search.DueAfter,
+		DueBefore:       search.DueBefore,
+		CreatedAfter:    search.CreatedAfter,
+		CreatedBefore:   search.CreatedBefore,
+	}); err != nil {
+		return fmt.Errorf("persist saved search: %w", err)
+	}
+	return nil
+}
+
 // cloneLabelProjectConfig deep-copies per-project label lists.
 func cloneLabelProjectConfig(in map[string][]string) map[string][]string {
 	out := make(map[string][]string, len(in))
@@ -907,6 +2119,24 @@ func cloneLabelProjectConfig(in map[string][]string) map[string][]string {
 	return out
 }
 
+// dependencyBadgesFromConfig converts configured card badge names, keeping an empty list non-nil.
+func dependencyBadgesFromConfig(in []string) []tui.DependencyBadge {
+	out := make([]tui.DependencyBadge, 0, len(in))
+	for _, badge := range in {
+		out = append(out, tui.DependencyBadge(badge))
+	}
+	return out
+}
+
+// statusSegmentsFromConfig converts configured status-bar segment names, keeping an empty list non-nil.
+func statusSegmentsFromConfig(in []string) []tui.StatusSegment {
+	out := make([]tui.StatusSegment, 0, len(in))
+	for _, segment := range in {
+		out = append(out, tui.StatusSegment(segment))
+	}
+	return out
+}
+
 // cloneProjectRoots deep-copies project-root path mappings.
 func cloneProjectRoots(in map[string]string) map[string]string {
 	out := make(map[string]string, len(in))
@@ -916,6 +2146,68 @@ func cloneProjectRoots(in map[string]string) map[string]string {
 	return out
 }
 
+// projectProfilesFromConfig maps per-project view profiles into TUI profile values.
+func projectProfilesFromConfig(in map[string]config.ProjectProfileConfig) map[string]tui.ProjectProfile {
+	out := make(map[string]tui.ProjectProfile, len(in))
+	for slug, profile := range in {
+		out[slug] = tui.ProjectProfile{
+			GroupBy:         profile.GroupBy,
+			ColumnPageSize:  profile.ColumnPageSize,
+			HighlightStyle:  tui.HighlightStyle(profile.HighlightStyle),
+			ShowPriority:    profile.ShowPriority,
+			ShowDueDate:     profile.ShowDueDate,
+			ShowLabels:      profile.ShowLabels,
+			ShowDescription: profile.ShowDescription,
+		}
+	}
+	return out
+}
+
+// projectColumnsFromConfig collects the column sets declared by project profiles, keyed by project slug.
+func projectColumnsFromConfig(in map[string]config.ProjectProfileConfig) map[string][]string {
+	out := make(map[string][]string, len(in))
+	for slug, profile := range in {
+		if len(profile.Columns) > 0 {
+			out[slug] = append([]string(nil), profile.Columns...)
+		}
+	}
+	return out
+}
+
+// taskTemplatesFromConfig maps configured task templates into TUI template values.
+func taskTemplatesFromConfig(in map[string]config.TaskTemplateConfig) map[string]tui.TaskTemplate {
+	out := make(map[string]tui.TaskTemplate, len(in))
+	for name, template := range in {
+		out[name] = tui.TaskTemplate{
+			TitlePrefix: template.TitlePrefix,
+			Priority:    domain.Priority(template.Priority),
+			Labels:      append([]string(nil), template.Labels...),
+			Description: template.Description,
+		}
+	}
+	return out
+}
+
+// savedSearchesFromConfig maps configured saved searches into TUI saved-search values.
+func savedSearchesFromConfig(in map[string]config.SavedSearchConfig) map[string]tui.SavedSearch {
+	out := make(map[string]tui.SavedSearch, len(in))
+	for name, search := range in {
+		out[name] = tui.SavedSearch{
+			Query:           search.Query,
+			CrossProject:    search.CrossProject,
+			IncludeArchived: search.IncludeArchived,
+			IncludeComments: search.IncludeComments,
+			States:          append([]string(nil), search.States...),
+			Levels:          append([]string(nil), search.Levels...),
+			DueAfter:        search.DueAfter,
+			DueBefore:       search.DueBefore,
+			CreatedAfter:    search.CreatedAfter,
+			CreatedBefore:   search.CreatedBefore,
+		}
+	}
+	return out
+}
+
 // cloneSearchRoots deep-copies global search-root paths.
 func cloneSearchRoots(in []string) []string {
 	return append([]string(nil), in...)
@@ -923,18 +2215,32 @@ func cloneSearchRoots(in []string) []string {
 
 // runtimeLogger fans log events to a styled console sink and an optional dev-file sink.
 type runtimeLogger struct {
-	sinks           []*charmLog.Logger
-	consoleSink     *charmLog.Logger
-	consoleWriter   io.Writer
-	fileWriter      io.Writer
-	consoleEnabled  bool
+	sinks          []*charmLog.Logger
+	consoleSink    *charmLog.Logger
+	consoleWriter  io.Writer
+	fileWriter     io.Writer
+	consoleEnabled bool
+	// consoleJSON makes the package-log bridge re-encode logfmt records so the console stream stays pure JSON.
+	consoleJSON     bool
 	closeFile       func() error
-	devLog          string
+	devLogFile      *rotatingLogFile
 	level           charmLog.Level
 	defaultBridge   *runtimeLogBridgeWriter
 	previousDefault *charmLog.Logger
 }
 
+// logLevelOverride applies --quiet or --verbose on top of the configured log level.
+func logLevelOverride(level string, rootOpts rootCommandOptions) string {
+	switch {
+	case rootOpts.quiet:
+		return "error"
+	case rootOpts.verbose:
+		return "debug"
+	default:
+		return level
+	}
+}
+
 // newRuntimeLogger configures runtime log sinks from CLI/config state.
 func newRuntimeLogger(stderr io.Writer, appName string, devMode bool, cfg config.LoggingConfig, now func() time.Time) (*runtimeLogger, error) {
 	level, err := charmLog.ParseLevel(cfg.Level)
@@ -942,6 +2248,11 @@ func newRuntimeLogger(stderr io.Writer, appName string, devMode bool, cfg config
 		return nil, fmt.Errorf("parse logging level %q: %w", cfg.Level, err)
 	}
 
+	formatter, err := consoleLogFormatter(cfg.Format)
+	if err != nil {
+		return nil, err
+	}
+
 	if now == nil {
 		now = time.Now
 	}
@@ -954,7 +2265,7 @@ func newRuntimeLogger(stderr io.Writer, appName string, devMode bool, cfg config
 		Prefix:          appName,
 		ReportTimestamp: true,
 		TimeFormat:      time.RFC3339,
-		Formatter:       charmLog.TextFormatter,
+		Formatter:       formatter,
 	})
 
 	logger := &runtimeLogger{
@@ -962,6 +2273,7 @@ func newRuntimeLogger(stderr io.Writer, appName string, devMode bool, cfg config
 		consoleSink:    consoleLogger,
 		consoleWriter:  stderr,
 		consoleEnabled: true,
+		consoleJSON:    formatter == charmLog.JSONFormatter,
 		level:          level,
 	}
 	if !devMode || !cfg.DevFile.Enabled {
@@ -975,9 +2287,9 @@ func newRuntimeLogger(stderr io.Writer, appName string, devMode bool, cfg config
 	if err := os.MkdirAll(filepath.Dir(devLogPath), 0o755); err != nil {
 		return nil, fmt.Errorf("create dev log dir: %w", err)
 	}
-	logFile, err := os.OpenFile(devLogPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	logFile, err := openRotatingLogFile(devLogPath, int64(cfg.DevFile.MaxSizeMB)<<20, cfg.DevFile.MaxBackups)
 	if err != nil {
-		return nil, fmt.Errorf("open dev log file: %w", err)
+		return nil, err
 	}
 
 	// Keep file output parseable and unstyled while preserving styled console logs.
@@ -990,11 +2302,25 @@ func newRuntimeLogger(stderr io.Writer, appName string, devMode bool, cfg config
 	})
 	logger.sinks = append(logger.sinks, fileLogger)
 	logger.closeFile = logFile.Close
-	logger.devLog = devLogPath
+	logger.devLogFile = logFile
 	logger.fileWriter = logFile
 	return logger, nil
 }
 
+// consoleLogFormatter resolves the console formatter for one logging.format value.
+func consoleLogFormatter(format string) (charmLog.Formatter, error) {
+	switch strings.TrimSpace(strings.ToLower(format)) {
+	case "", "text":
+		return charmLog.TextFormatter, nil
+	case "logfmt":
+		return charmLog.LogfmtFormatter, nil
+	case "json":
+		return charmLog.JSONFormatter, nil
+	default:
+		return 0, fmt.Errorf("unsupported logging format %q (want text, logfmt, or json)", format)
+	}
+}
+
 // InstallAsDefault routes package-level charm/log calls through this runtime logger's sinks.
 func (l *runtimeLogger) InstallAsDefault(appName string) {
 	if l == nil {
@@ -1050,8 +2376,14 @@ func (w *runtimeLogBridgeWriter) Write(p []byte) (int, error) {
 	defer w.mu.Unlock()
 
 	var firstErr error
-	if w.runtime.consoleEnabled && w.runtime.consoleWriter != nil {
-		if _, err := w.runtime.consoleWriter.Write(p); err != nil && firstErr == nil {
+	switch {
+	case !w.runtime.consoleEnabled || w.runtime.consoleWriter == nil:
+	case w.runtime.consoleJSON && w.runtime.consoleSink != nil:
+		if err := relogLogfmtRecords(w.runtime.consoleSink, p); err != nil {
+			firstErr = err
+		}
+	default:
+		if _, err := w.runtime.consoleWriter.Write(p); err != nil {
 			firstErr = err
 		}
 	}
@@ -1063,12 +2395,45 @@ func (w *runtimeLogBridgeWriter) Write(p []byte) (int, error) {
 	return len(p), firstErr
 }
 
+// relogLogfmtRecords decodes logfmt lines from the package-log bridge and re-emits them through dst.
+// The sink stamps its own time and prefix, so those keys are dropped from the decoded record.
+func relogLogfmtRecords(dst *charmLog.Logger, p []byte) error {
+	decoder := logfmt.NewDecoder(bytes.NewReader(p))
+	for decoder.ScanRecord() {
+		level := charmLog.InfoLevel
+		var msg string
+		var keyvals []any
+		for decoder.ScanKeyval() {
+			key, value := string(decoder.Key()), string(decoder.Value())
+			switch key {
+			case charmLog.TimestampKey, charmLog.PrefixKey:
+			case charmLog.LevelKey:
+				if parsed, err := charmLog.ParseLevel(value); err == nil {
+					level = parsed
+				}
+			case charmLog.MessageKey:
+				msg = value
+			default:
+				keyvals = append(keyvals, key, value)
+			}
+		}
+		dst.Log(level, msg, keyvals...)
+	}
+	if err := decoder.Err(); err != nil {
+		return fmt.Errorf("decode bridged log record: %w", err)
+	}
+	return nil
+}
+
 // DevLogPath returns the active dev log file path.
 func (l *runtimeLogger) DevLogPath() string {
 	if l == nil {
 		return ""
 	}
-	return l.devLog
+	if l.devLogFile == nil {
+		return ""
+	}
+	return l.devLogFile.Path()
 }
 
 // Close closes the optional dev-file sink.
@@ -3,12 +3,16 @@ package main
 import (
 	"bytes"
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -17,10 +21,12 @@ import (
 	charmLog "github.com/charmbracelet/log"
 	"github.com/google/uuid"
 	serveradapter "github.com/hylla/tillsyn/internal/adapters/server"
+	"github.com/hylla/tillsyn/internal/adapters/storage/sqlite"
 	"github.com/hylla/tillsyn/internal/app"
 	"github.com/hylla/tillsyn/internal/config"
 	"github.com/hylla/tillsyn/internal/domain"
 	"github.com/hylla/tillsyn/internal/platform"
+	"github.com/hylla/tillsyn/internal/tui"
 )
 
 // TestMain sets deterministic environment defaults for CLI tests.
@@ -129,6 +135,158 @@ func TestRunStartsProgram(t *testing.T) {
 	}
 }
 
+// TestRunHoldsInstanceLockWhileTUIRuns verifies the TUI takes the database lock, offers read-only for live holders,
+// continues without a lock the filesystem cannot provide, and releases it on exit.
+func TestRunHoldsInstanceLockWhileTUIRuns(t *testing.T) {
+	origFactory := programFactory
+	origAcquire := acquireInstanceLockFunc
+	origConfirm := confirmReadOnlyFunc
+	t.Cleanup(func() {
+		programFactory = origFactory
+		acquireInstanceLockFunc = origAcquire
+		confirmReadOnlyFunc = origConfirm
+	})
+	confirmReadOnlyFunc = func(string) bool { return false }
+
+	dbPath := filepath.Join(t.TempDir(), "tillsyn.db")
+	lockPath := platform.InstanceLockPath(dbPath)
+	lockSeen := false
+	programFactory = func(_ tea.Model) program {
+		_, err := os.Stat(lockPath)
+		lockSeen = err == nil
+		return fakeProgram{}
+	}
+	cfgPath := filepath.Join(t.TempDir(), "config.toml")
+	writeBootstrapReadyConfig(t, cfgPath, t.TempDir())
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath}, io.Discard, io.Discard); err != nil {
+		t.Fatalf("run() error = %v", err)
+	}
+	if !lockSeen {
+		t.Fatal("expected instance lock to exist while the TUI runs")
+	}
+	if _, err := os.Stat(lockPath); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected instance lock released on exit, got %v", err)
+	}
+
+	// The parent process stands in for another live tillsyn instance holding the lock.
+	if err := os.WriteFile(lockPath, []byte(strconv.Itoa(os.Getppid())), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath}, io.Discard, io.Discard)
+	if !errors.Is(err, platform.ErrInstanceLocked) {
+		t.Fatalf("expected ErrInstanceLocked, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "in use by another tillsyn instance") {
+		t.Fatalf("expected in-use warning, got %v", err)
+	}
+
+	// A read-only launch browses the locked database without taking over the lock.
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "--read-only"}, io.Discard, io.Discard); err != nil {
+		t.Fatalf("run(--read-only) error = %v", err)
+	}
+	if content, err := os.ReadFile(lockPath); err != nil || strings.TrimSpace(string(content)) != strconv.Itoa(os.Getppid()) {
+		t.Fatalf("expected the other instance lock to remain, got %q err=%v", content, err)
+	}
+
+	// Accepting the read-only offer opens the locked database the same way.
+	offered := ""
+	confirmReadOnlyFunc = func(path string) bool {
+		offered = path
+		return true
+	}
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath}, io.Discard, io.Discard); err != nil {
+		t.Fatalf("run(accept read-only) error = %v", err)
+	}
+	if offered != dbPath {
+		t.Fatalf("expected read-only offer for %q, got %q", dbPath, offered)
+	}
+	if content, err := os.ReadFile(lockPath); err != nil || strings.TrimSpace(string(content)) != strconv.Itoa(os.Getppid()) {
+		t.Fatalf("expected accepted read-only launch to leave the other lock, got %q err=%v", content, err)
+	}
+
+	// Lock failures other than a live holder, such as filesystems without hard links, only warn.
+	acquireInstanceLockFunc = func(string) (*platform.InstanceLock, error) {
+		return nil, errors.New("create lock file: operation not permitted")
+	}
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath}, io.Discard, io.Discard); err != nil {
+		t.Fatalf("run(lock unavailable) error = %v", err)
+	}
+}
+
+// TestRunUnwritableDatabasePath verifies unwritable paths fail with guidance unless the read-only fallback applies.
+func TestRunUnwritableDatabasePath(t *testing.T) {
+	origFactory := programFactory
+	origCheck := checkDatabaseWritableFunc
+	t.Cleanup(func() {
+		programFactory = origFactory
+		checkDatabaseWritableFunc = origCheck
+	})
+	programFactory = func(_ tea.Model) program { return fakeProgram{} }
+
+	tmp := t.TempDir()
+	dbPath := filepath.Join(tmp, "tillsyn.db")
+	cfgPath := filepath.Join(tmp, "config.toml")
+	writeBootstrapReadyConfig(t, cfgPath, t.TempDir())
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath}, io.Discard, io.Discard); err != nil {
+		t.Fatalf("run() error = %v", err)
+	}
+
+	// Permission bits cannot block root test runners, so simulate the failed probe instead.
+	checkDatabaseWritableFunc = func(path string) error {
+		return fmt.Errorf("%w: %s: permission denied", platform.ErrPathNotWritable, path)
+	}
+	err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath}, io.Discard, io.Discard)
+	if !errors.Is(err, platform.ErrPathNotWritable) {
+		t.Fatalf("expected ErrPathNotWritable, got %v", err)
+	}
+	for _, want := range []string{"--db", "TILL_DB_PATH", "database.path", "readonly_fallback"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Fatalf("expected guidance to mention %q, got %v", want, err)
+		}
+	}
+
+	// --read-only opts into the fallback for the TUI without touching the lock.
+	lockSeen := true
+	programFactory = func(_ tea.Model) program {
+		_, statErr := os.Stat(platform.InstanceLockPath(dbPath))
+		lockSeen = statErr == nil
+		return fakeProgram{}
+	}
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "--read-only"}, io.Discard, io.Discard); err != nil {
+		t.Fatalf("run(--read-only) error = %v", err)
+	}
+	if lockSeen {
+		t.Fatal("expected read-only fallback to skip the instance lock")
+	}
+
+	// The config opt-in covers export, but never commands that must write.
+	content, err := os.ReadFile(cfgPath)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	content = append(content, []byte("\n[database]\nreadonly_fallback = true\n")...)
+	if err := os.WriteFile(cfgPath, content, 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	outPath := filepath.Join(tmp, "snapshot.json")
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "export", "--out", outPath}, io.Discard, io.Discard); err != nil {
+		t.Fatalf("run(export) error = %v", err)
+	}
+	if _, err := os.Stat(outPath); err != nil {
+		t.Fatalf("expected export output, got %v", err)
+	}
+	err = run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "import", "--in", outPath}, io.Discard, io.Discard)
+	if !errors.Is(err, platform.ErrPathNotWritable) {
+		t.Fatalf("expected import to refuse the unwritable database, got %v", err)
+	}
+
+	// Without an existing database there is nothing to browse, so the fallback does not apply.
+	missingPath := filepath.Join(tmp, "missing.db")
+	if err := run(context.Background(), []string{"--db", missingPath, "--config", cfgPath}, io.Discard, io.Discard); !errors.Is(err, platform.ErrPathNotWritable) {
+		t.Fatalf("expected missing database to refuse fallback, got %v", err)
+	}
+}
+
 // TestRunStartupPreservesExistingActorID verifies startup keeps a preconfigured identity.actor_id unchanged.
 func TestRunStartupPreservesExistingActorID(t *testing.T) {
 	origFactory := programFactory
@@ -328,13 +486,50 @@ func TestRunRootHelp(t *testing.T) {
 	if !strings.Contains(output, "usage") || !strings.Contains(output, "till [command]") {
 		t.Fatalf("expected root usage output, got %q", out.String())
 	}
-	for _, want := range []string{"serve", "export", "import", "paths", "init-dev-config"} {
+	for _, want := range []string{"serve", "export", "import", "paths", "init-dev-config", "schema", "completion", "man"} {
 		if !strings.Contains(output, want) {
 			t.Fatalf("expected %q command in root help, got %q", want, out.String())
 		}
 	}
 }
 
+// TestRunCompletionAndManPage verifies completion scripts and the man page are generated from the command tree.
+func TestRunCompletionAndManPage(t *testing.T) {
+	cases := map[string]string{
+		"bash":       "__start_till",
+		"zsh":        "#compdef till",
+		"fish":       "complete -c till",
+		"powershell": "Register-ArgumentCompleter",
+	}
+	for shell, want := range cases {
+		var out strings.Builder
+		if err := run(context.Background(), []string{"completion", shell}, &out, io.Discard); err != nil {
+			t.Fatalf("run(completion %s) error = %v", shell, err)
+		}
+		if !strings.Contains(out.String(), want) {
+			t.Fatalf("expected %s completion to contain %q, got %q", shell, want, out.String())
+		}
+	}
+	if err := run(context.Background(), []string{"completion", "tcsh"}, io.Discard, io.Discard); err == nil || !strings.Contains(err.Error(), "unsupported shell") {
+		t.Fatalf("expected unsupported shell error, got %v", err)
+	}
+
+	var out strings.Builder
+	if err := run(context.Background(), []string{"man"}, &out, io.Discard); err != nil {
+		t.Fatalf("run(man) error = %v", err)
+	}
+	page := out.String()
+	for _, want := range []string{".TH TILL 1", "serve", "--include-archived", "till paths"} {
+		if !strings.Contains(page, want) {
+			t.Fatalf("expected man page to contain %q, got %q", want, page)
+		}
+	}
+	// Hidden developer commands stay out of user-facing docs.
+	if strings.Contains(page, "tasks-per-project") {
+		t.Fatalf("expected hidden dev commands omitted from man page, got %q", page)
+	}
+}
+
 // TestRunSubcommandHelp verifies subcommand help output returns usage without executing command handlers.
 func TestRunSubcommandHelp(t *testing.T) {
 	origRunner := serveCommandRunner
@@ -362,7 +557,17 @@ func TestRunSubcommandHelp(t *testing.T) {
 		{
 			name: "import",
 			args: []string{"import", "--help"},
-			want: []string{"till import", "--in"},
+			want: []string{"till import", "--in", "--repair-positions"},
+		},
+		{
+			name: "repair-positions",
+			args: []string{"repair-positions", "--help"},
+			want: []string{"till repair-positions", "--project", "--dry-run"},
+		},
+		{
+			name: "doctor",
+			args: []string{"doctor", "--help"},
+			want: []string{"till doctor", "--project", "--fix"},
 		},
 		{
 			name: "init-dev-config",
@@ -441,6 +646,8 @@ func TestRunServeCommandWiresDefaults(t *testing.T) {
 	origRunner := serveCommandRunner
 	t.Cleanup(func() { serveCommandRunner = origRunner })
 
+	t.Setenv("TILL_API_TOKEN", "")
+
 	var gotCfg serveradapter.Config
 	var gotDeps serveradapter.Dependencies
 	serveCommandRunner = func(_ context.Context, cfg serveradapter.Config, deps serveradapter.Dependencies) error {
@@ -464,6 +671,12 @@ func TestRunServeCommandWiresDefaults(t *testing.T) {
 	if gotCfg.MCPEndpoint != "/mcp" {
 		t.Fatalf("serve mcp endpoint = %q, want /mcp", gotCfg.MCPEndpoint)
 	}
+	if gotCfg.ShutdownTimeout != 5*time.Second {
+		t.Fatalf("serve shutdown timeout = %s, want 5s", gotCfg.ShutdownTimeout)
+	}
+	if gotCfg.APIToken != "" || gotCfg.RateLimit != 0 || gotCfg.RateBurst != 0 {
+		t.Fatalf("serve access defaults = token %q rate %v burst %d, want all unset", gotCfg.APIToken, gotCfg.RateLimit, gotCfg.RateBurst)
+	}
 	if gotDeps.CaptureState == nil {
 		t.Fatal("expected capture_state dependency to be wired")
 	}
@@ -493,153 +706,1255 @@ func TestRunServeCommandWiresFlags(t *testing.T) {
 		"--http", "127.0.0.1:9090",
 		"--api-endpoint", "/custom-api",
 		"--mcp-endpoint", "/custom-mcp",
+		"--shutdown-timeout", "30s",
+		"--api-token", "flag-secret",
+		"--rate-limit", "2.5",
+		"--rate-burst", "10",
+	}
+	// An explicit flag wins over the environment token.
+	t.Setenv("TILL_API_TOKEN", "env-secret")
+	if err := run(context.Background(), args, io.Discard, io.Discard); err != nil {
+		t.Fatalf("run(serve with flags) error = %v", err)
+	}
+	if gotCfg.APIToken != "flag-secret" {
+		t.Fatalf("serve api token = %q, want flag-secret", gotCfg.APIToken)
+	}
+	if gotCfg.RateLimit != 2.5 || gotCfg.RateBurst != 10 {
+		t.Fatalf("serve rate = %v burst %d, want 2.5 burst 10", gotCfg.RateLimit, gotCfg.RateBurst)
+	}
+	if gotCfg.ShutdownTimeout != 30*time.Second {
+		t.Fatalf("serve shutdown timeout = %s, want 30s", gotCfg.ShutdownTimeout)
+	}
+	if gotCfg.HTTPBind != "127.0.0.1:9090" {
+		t.Fatalf("serve http bind = %q, want 127.0.0.1:9090", gotCfg.HTTPBind)
+	}
+	if gotCfg.APIEndpoint != "/custom-api" {
+		t.Fatalf("serve api endpoint = %q, want /custom-api", gotCfg.APIEndpoint)
+	}
+	if gotCfg.MCPEndpoint != "/custom-mcp" {
+		t.Fatalf("serve mcp endpoint = %q, want /custom-mcp", gotCfg.MCPEndpoint)
+	}
+}
+
+// TestRunServeCommandReadsAPITokenFromEnv verifies TILL_API_TOKEN supplies the token and bad rates are rejected.
+func TestRunServeCommandReadsAPITokenFromEnv(t *testing.T) {
+	origRunner := serveCommandRunner
+	t.Cleanup(func() { serveCommandRunner = origRunner })
+
+	var gotCfg serveradapter.Config
+	serveCommandRunner = func(_ context.Context, cfg serveradapter.Config, _ serveradapter.Dependencies) error {
+		gotCfg = cfg
+		return nil
+	}
+
+	tmp := t.TempDir()
+	base := []string{"--db", filepath.Join(tmp, "tillsyn.db"), "--config", filepath.Join(tmp, "tillsyn.toml"), "serve"}
+	t.Setenv("TILL_API_TOKEN", "  env-secret  ")
+	if err := run(context.Background(), base, io.Discard, io.Discard); err != nil {
+		t.Fatalf("run(serve) error = %v", err)
+	}
+	if gotCfg.APIToken != "env-secret" {
+		t.Fatalf("serve api token = %q, want env-secret", gotCfg.APIToken)
+	}
+
+	err := run(context.Background(), append(base, "--rate-limit", "-1"), io.Discard, io.Discard)
+	if err == nil || !strings.Contains(err.Error(), "--rate-limit must be >= 0") {
+		t.Fatalf("expected negative rate limit error, got %v", err)
+	}
+}
+
+// TestRunServeCommandPropagatesErrors verifies serve runner failures are returned to callers.
+func TestRunServeCommandPropagatesErrors(t *testing.T) {
+	origRunner := serveCommandRunner
+	t.Cleanup(func() { serveCommandRunner = origRunner })
+
+	serveCommandRunner = func(_ context.Context, _ serveradapter.Config, _ serveradapter.Dependencies) error {
+		return errors.New("listen failed")
+	}
+
+	tmp := t.TempDir()
+	dbPath := filepath.Join(tmp, "tillsyn.db")
+	cfgPath := filepath.Join(tmp, "tillsyn.toml")
+	err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "serve"}, io.Discard, io.Discard)
+	if err == nil {
+		t.Fatal("expected serve command error")
+	}
+	if !strings.Contains(err.Error(), "run serve command") {
+		t.Fatalf("expected wrapped serve error, got %v", err)
+	}
+}
+
+// TestRunExportCommandWritesSnapshot verifies behavior for the covered scenario.
+func TestRunExportCommandWritesSnapshot(t *testing.T) {
+	tmp := t.TempDir()
+	dbPath := filepath.Join(tmp, "tillsyn.db")
+	cfgPath := filepath.Join(tmp, "missing.toml")
+	outPath := filepath.Join(tmp, "snapshot.json")
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "export", "--out", outPath}, io.Discard, io.Discard); err != nil {
+		t.Fatalf("run(export) error = %v", err)
+	}
+
+	content, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+
+	var snap app.Snapshot
+	if err := json.Unmarshal(content, &snap); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if snap.Version != app.SnapshotVersion {
+		t.Fatalf("unexpected snapshot version %q", snap.Version)
+	}
+	if len(snap.Projects) != 0 {
+		t.Fatalf("expected no projects in empty export snapshot, got %d", len(snap.Projects))
+	}
+}
+
+// TestRunThemePreviewCommand verifies theme preview renders with the highlight and label colors the config resolves.
+func TestRunThemePreviewCommand(t *testing.T) {
+	tmp := t.TempDir()
+	cfgPath := filepath.Join(tmp, "config.toml")
+	if err := os.WriteFile(cfgPath, []byte("[ui]\nhighlight_color = \"33\"\n\n[labels.colors]\nBug = \"#ff8800\"\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	var out strings.Builder
+	if err := run(context.Background(), []string{"--config", cfgPath, "theme", "preview", "--width", "96"}, &out, io.Discard); err != nil {
+		t.Fatalf("run(theme preview) error = %v", err)
+	}
+	rendered := out.String()
+	// 38;5;33 is the configured highlight and 38;2;255;136;0 the bug label glyph.
+	for _, want := range []string{"till theme preview", "highlight=33", "WIP limit exceeded: 3/2", "archive task: Draft release notes", "38;5;33", "38;2;255;136;0"} {
+		if !strings.Contains(rendered, want) {
+			t.Fatalf("expected %q in preview output:\n%s", want, rendered)
+		}
+	}
+
+	// Without a config file the built-in highlight applies.
+	out.Reset()
+	if err := run(context.Background(), []string{"--config", filepath.Join(tmp, "missing.toml"), "theme", "preview"}, &out, io.Discard); err != nil {
+		t.Fatalf("run(theme preview) missing error = %v", err)
+	}
+	if !strings.Contains(out.String(), "highlight=212") {
+		t.Fatalf("expected default highlight in preview, got %q", out.String())
+	}
+}
+
+// TestRunThemeCheckCommand verifies theme check reads the config till resolves, TILL_HOME included, and fails strict runs on low contrast.
+func TestRunThemeCheckCommand(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("TILL_HOME", home)
+	t.Setenv("TILL_CONFIG", "")
+	paths, err := platform.DefaultPathsWithOptions(platform.Options{AppName: "tillsynx", Home: home})
+	if err != nil {
+		t.Fatalf("DefaultPathsWithOptions() error = %v", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(paths.ConfigPath), 0o755); err != nil {
+		t.Fatalf("MkdirAll() error = %v", err)
+	}
+	if err := os.WriteFile(paths.ConfigPath, []byte("[ui]\nhighlight_color = \"0\"\n\n[labels.colors]\nbug = \"#ff8800\"\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	var out strings.Builder
+	// A black highlight on a black background cannot pass, so strict mode must fail.
+	err = run(context.Background(), []string{"--app", "tillsynx", "--dev=false", "theme", "check", "--bg", "#000000", "--strict"}, &out, io.Discard)
+	if !errors.Is(err, errContrastWarnings) {
+		t.Fatalf("expected errContrastWarnings, got %v", err)
+	}
+	for _, want := range []string{"Theme from " + paths.ConfigPath, "selected card (highlight)", "1.00:1", "label glyph: bug"} {
+		if !strings.Contains(out.String(), want) {
+			t.Fatalf("expected %q in check output, got %q", want, out.String())
+		}
+	}
+
+	// Backgrounds go through the same validation as config colors.
+	for _, bad := range []string{"nope", "#fff"} {
+		if err := run(context.Background(), []string{"--app", "tillsynx", "--dev=false", "theme", "check", "--bg", bad}, io.Discard, io.Discard); err == nil {
+			t.Fatalf("expected --bg %q to fail", bad)
+		}
+	}
+
+	// Without a config file the built-in highlight applies.
+	out.Reset()
+	if err := run(context.Background(), []string{"--config", filepath.Join(home, "missing.toml"), "theme", "check"}, &out, io.Discard); err != nil {
+		t.Fatalf("run(theme check) missing error = %v", err)
+	}
+	if !strings.Contains(out.String(), "212") {
+		t.Fatalf("expected the built-in highlight checked, got %q", out.String())
+	}
+}
+
+// TestRunImportCommandReadsSnapshot verifies behavior for the covered scenario.
+func TestRunImportCommandReadsSnapshot(t *testing.T) {
+	tmp := t.TempDir()
+	dbPath := filepath.Join(tmp, "tillsyn.db")
+	cfgPath := filepath.Join(tmp, "missing.toml")
+
+	now := time.Date(2026, 2, 22, 12, 0, 0, 0, time.UTC)
+	snap := app.Snapshot{
+		Version: app.SnapshotVersion,
+		Projects: []app.SnapshotProject{
+			{
+				ID:        "p-import",
+				Slug:      "imported",
+				Name:      "Imported",
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+		},
+		Columns: []app.SnapshotColumn{
+			{
+				ID:        "c-import",
+				ProjectID: "p-import",
+				Name:      "To Do",
+				Position:  0,
+				WIPLimit:  0,
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+		},
+		Tasks: []app.SnapshotTask{
+			{
+				ID:        "t-import",
+				ProjectID: "p-import",
+				ColumnID:  "c-import",
+				Position:  0,
+				Title:     "Imported Task",
+				Priority:  domain.PriorityMedium,
+				Metadata:  domain.TaskMetadata{DependsOn: []string{"t-missing"}},
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+		},
+	}
+	content, err := json.MarshalIndent(snap, "", "  ")
+	if err != nil {
+		t.Fatalf("MarshalIndent() error = %v", err)
+	}
+	inPath := filepath.Join(tmp, "in.json")
+	if err := os.WriteFile(inPath, content, 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	var importOut bytes.Buffer
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "import", "--in", inPath}, &importOut, io.Discard); err != nil {
+		t.Fatalf("run(import) error = %v", err)
+	}
+	// The dangling dependency is dropped and reported alongside the import counts.
+	for _, want := range []string{"projects: created 1, updated 0", "tasks: created 1, updated 0", `dropped dangling depends_on reference "t-missing" on task t-import`} {
+		if !strings.Contains(importOut.String(), want) {
+			t.Fatalf("expected import output to contain %q, got %q", want, importOut.String())
+		}
+	}
+
+	outPath := filepath.Join(tmp, "out.json")
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "export", "--out", outPath}, io.Discard, io.Discard); err != nil {
+		t.Fatalf("run(export) error = %v", err)
+	}
+	outContent, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	var outSnap app.Snapshot
+	if err := json.Unmarshal(outContent, &outSnap); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	foundProject := false
+	foundTask := false
+	for _, p := range outSnap.Projects {
+		if p.ID == "p-import" {
+			foundProject = true
+			break
+		}
+	}
+	for _, tk := range outSnap.Tasks {
+		if tk.ID == "t-import" {
+			foundTask = true
+			break
+		}
+	}
+	if !foundProject || !foundTask {
+		t.Fatalf("expected imported data in exported snapshot, foundProject=%t foundTask=%t", foundProject, foundTask)
+	}
+}
+
+// TestRunExportSingleProject verifies --project exports one board that imports on its own and rejects unknown slugs.
+func TestRunExportSingleProject(t *testing.T) {
+	tmp := t.TempDir()
+	dbPath := filepath.Join(tmp, "tillsyn.db")
+	cfgPath := filepath.Join(tmp, "missing.toml")
+
+	now := time.Date(2026, 2, 22, 12, 0, 0, 0, time.UTC)
+	seed := app.Snapshot{
+		Version: app.SnapshotVersion,
+		Projects: []app.SnapshotProject{
+			{ID: "p-alpha", Slug: "alpha", Name: "Alpha", CreatedAt: now, UpdatedAt: now},
+			{ID: "p-beta", Slug: "beta", Name: "Beta", CreatedAt: now, UpdatedAt: now},
+		},
+		Columns: []app.SnapshotColumn{
+			{ID: "c-alpha", ProjectID: "p-alpha", Name: "To Do", CreatedAt: now, UpdatedAt: now},
+			{ID: "c-beta", ProjectID: "p-beta", Name: "To Do", CreatedAt: now, UpdatedAt: now},
+		},
+		Tasks: []app.SnapshotTask{
+			{ID: "t-alpha", ProjectID: "p-alpha", ColumnID: "c-alpha", Title: "Alpha task", Priority: domain.PriorityMedium, CreatedAt: now, UpdatedAt: now},
+			{ID: "t-beta", ProjectID: "p-beta", ColumnID: "c-beta", Title: "Beta task", Priority: domain.PriorityMedium, CreatedAt: now, UpdatedAt: now},
+		},
+	}
+	content, err := json.Marshal(seed)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	seedPath := filepath.Join(tmp, "seed.json")
+	if err := os.WriteFile(seedPath, content, 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "import", "--in", seedPath}, io.Discard, io.Discard); err != nil {
+		t.Fatalf("run(import seed) error = %v", err)
+	}
+
+	outPath := filepath.Join(tmp, "alpha.json")
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "export", "--project", "alpha", "--out", outPath}, io.Discard, io.Discard); err != nil {
+		t.Fatalf("run(export --project) error = %v", err)
+	}
+	exported, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	var snap app.Snapshot
+	if err := json.Unmarshal(exported, &snap); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if snap.ProjectSlug != "alpha" || len(snap.Projects) != 1 || snap.Projects[0].ID != "p-alpha" {
+		t.Fatalf("expected only the alpha project, got slug %q projects %#v", snap.ProjectSlug, snap.Projects)
+	}
+	if len(snap.Tasks) != 1 || snap.Tasks[0].ID != "t-alpha" {
+		t.Fatalf("expected only alpha tasks, got %#v", snap.Tasks)
+	}
+
+	// The single-project snapshot imports into an empty database by itself.
+	var importOut bytes.Buffer
+	freshDB := filepath.Join(tmp, "fresh.db")
+	if err := run(context.Background(), []string{"--db", freshDB, "--config", cfgPath, "import", "--validate", "--in", outPath}, &importOut, io.Discard); err != nil {
+		t.Fatalf("run(import single project) error = %v", err)
+	}
+	for _, want := range []string{`single-project snapshot: "alpha"`, "projects: created 1, updated 0", "tasks: created 1, updated 0"} {
+		if !strings.Contains(importOut.String(), want) {
+			t.Fatalf("expected import output to contain %q, got %q", want, importOut.String())
+		}
+	}
+
+	err = run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "export", "--project", "gamma", "--out", filepath.Join(tmp, "gamma.json")}, io.Discard, io.Discard)
+	if !errors.Is(err, app.ErrNotFound) {
+		t.Fatalf("expected not-found error for unknown slug, got %v", err)
+	}
+	if _, statErr := os.Stat(filepath.Join(tmp, "gamma.json")); !errors.Is(statErr, os.ErrNotExist) {
+		t.Fatalf("expected no snapshot written for unknown slug, stat err %v", statErr)
+	}
+}
+
+// TestRunImportDryRunWritesNothing verifies --dry-run summarizes without writing and fails on duplicate slugs.
+func TestRunImportDryRunWritesNothing(t *testing.T) {
+	tmp := t.TempDir()
+	dbPath := filepath.Join(tmp, "tillsyn.db")
+	cfgPath := filepath.Join(tmp, "missing.toml")
+
+	now := time.Date(2026, 2, 22, 12, 0, 0, 0, time.UTC)
+	snap := app.Snapshot{
+		Version:  app.SnapshotVersion,
+		Projects: []app.SnapshotProject{{ID: "p-dry", Slug: "dry", Name: "Dry", CreatedAt: now, UpdatedAt: now}},
+		Columns:  []app.SnapshotColumn{{ID: "c-dry", ProjectID: "p-dry", Name: "To Do", CreatedAt: now, UpdatedAt: now}},
+		Tasks:    []app.SnapshotTask{{ID: "t-dry", ProjectID: "p-dry", ColumnID: "c-dry", Title: "Dry Task", Priority: domain.PriorityMedium, CreatedAt: now, UpdatedAt: now}},
+	}
+	writeSnap := func(name string, snap app.Snapshot) string {
+		content, err := json.Marshal(snap)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		path := filepath.Join(tmp, name)
+		if err := os.WriteFile(path, content, 0o644); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+		return path
+	}
+
+	var out bytes.Buffer
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "import", "--in", writeSnap("dry.json", snap), "--dry-run"}, &out, io.Discard); err != nil {
+		t.Fatalf("run(import --dry-run) error = %v", err)
+	}
+	for _, want := range []string{"dry run: no changes written", "projects: would create 1, would update 0", "tasks: would create 1, would update 0"} {
+		if !strings.Contains(out.String(), want) {
+			t.Fatalf("expected dry-run output to contain %q, got %q", want, out.String())
+		}
+	}
+	var exported strings.Builder
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "export", "--out", "-"}, &exported, io.Discard); err != nil {
+		t.Fatalf("run(export) error = %v", err)
+	}
+	if strings.Contains(exported.String(), "p-dry") {
+		t.Fatalf("expected dry run to leave the database untouched, got %s", exported.String())
+	}
+
+	// Two projects sharing a slug make the dry run fail so scripts can gate the real import.
+	snap.Projects = append(snap.Projects, app.SnapshotProject{ID: "p-dry-2", Slug: "dry", Name: "Dry", CreatedAt: now, UpdatedAt: now})
+	err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "import", "--in", writeSnap("dup.json", snap), "--dry-run"}, io.Discard, io.Discard)
+	if err == nil || !strings.Contains(err.Error(), "duplicate project slugs") {
+		t.Fatalf("expected duplicate slug dry run to fail, got %v", err)
+	}
+
+	// A dependency on a task that exists nowhere would be dropped, so the dry run fails on it too.
+	snap.Projects = snap.Projects[:1]
+	snap.Tasks[0].Metadata.DependsOn = []string{"t-nowhere"}
+	out.Reset()
+	err = run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "import", "--in", writeSnap("dangling.json", snap), "--dry-run"}, &out, io.Discard)
+	if err == nil || !strings.Contains(err.Error(), "1 dangling dependency references") {
+		t.Fatalf("expected dangling dependency dry run to fail, got %v", err)
+	}
+	if !strings.Contains(out.String(), `would drop dangling depends_on reference "t-nowhere" on task t-dry`) {
+		t.Fatalf("expected dry run to list the dangling reference, got %q", out.String())
+	}
+}
+
+// TestRunImportFromTrello verifies --from trello imports a board export as a new project and reports dropped fields.
+func TestRunImportFromTrello(t *testing.T) {
+	tmp := t.TempDir()
+	dbPath := filepath.Join(tmp, "tillsyn.db")
+	cfgPath := filepath.Join(tmp, "missing.toml")
+	boardPath := filepath.Join(tmp, "board.json")
+	board := `{"name": "Trello Board", "lists": [{"id": "l1", "name": "To Do", "pos": 1}],
+		"cards": [{"id": "c1", "name": "Imported card", "idList": "l1", "pos": 1, "labels": [{"name": "ops"}]}],
+		"actions": [{"type": "commentCard"}]}`
+	if err := os.WriteFile(boardPath, []byte(board), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	var out bytes.Buffer
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "import", "--from", "trello", "--in", boardPath}, &out, io.Discard); err != nil {
+		t.Fatalf("run(import --from trello) error = %v", err)
+	}
+	for _, want := range []string{"projects: created 1", "tasks: created 1", "trello: dropped 1 card comments"} {
+		if !strings.Contains(out.String(), want) {
+			t.Fatalf("expected import output to contain %q, got %q", want, out.String())
+		}
+	}
+	var exported strings.Builder
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "export", "--out", "-"}, &exported, io.Discard); err != nil {
+		t.Fatalf("run(export) error = %v", err)
+	}
+	if !strings.Contains(exported.String(), `"slug": "trello-board"`) || !strings.Contains(exported.String(), "Imported card") {
+		t.Fatalf("expected trello board in export, got %s", exported.String())
+	}
+
+	err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "import", "--from", "asana", "--in", boardPath}, io.Discard, io.Discard)
+	if !errors.Is(err, app.ErrInvalidImportSource) {
+		t.Fatalf("expected unknown source to fail with ErrInvalidImportSource, got %v", err)
+	}
+}
+
+// TestRunImportMergeMode verifies --mode merge folds a re-keyed snapshot into the stored project with the same slug.
+func TestRunImportMergeMode(t *testing.T) {
+	tmp := t.TempDir()
+	dbPath := filepath.Join(tmp, "tillsyn.db")
+	cfgPath := filepath.Join(tmp, "missing.toml")
+
+	now := time.Date(2026, 2, 22, 12, 0, 0, 0, time.UTC)
+	snapFor := func(projectID, columnID, taskID string) string {
+		snap := app.Snapshot{
+			Version:  app.SnapshotVersion,
+			Projects: []app.SnapshotProject{{ID: projectID, Slug: "shared", Name: "Shared", CreatedAt: now, UpdatedAt: now}},
+			Columns:  []app.SnapshotColumn{{ID: columnID, ProjectID: projectID, Name: "To Do", CreatedAt: now, UpdatedAt: now}},
+			Tasks:    []app.SnapshotTask{{ID: taskID, ProjectID: projectID, ColumnID: columnID, Title: taskID, Priority: domain.PriorityMedium, CreatedAt: now, UpdatedAt: now}},
+		}
+		content, err := json.Marshal(snap)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		path := filepath.Join(tmp, projectID+".json")
+		if err := os.WriteFile(path, content, 0o644); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+		return path
+	}
+
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "import", "--in", snapFor("p-a", "c-a", "t-a")}, io.Discard, io.Discard); err != nil {
+		t.Fatalf("run(import) error = %v", err)
+	}
+	var out bytes.Buffer
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "import", "--mode", "merge", "--repair-positions", "--in", snapFor("p-b", "c-b", "t-b")}, &out, io.Discard); err != nil {
+		t.Fatalf("run(import --mode merge) error = %v", err)
+	}
+	if !strings.Contains(out.String(), `merged project p-b into p-a (slug "shared")`) {
+		t.Fatalf("expected merge output, got %q", out.String())
+	}
+
+	var exported strings.Builder
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "export", "--out", "-"}, &exported, io.Discard); err != nil {
+		t.Fatalf("run(export) error = %v", err)
+	}
+	var snap app.Snapshot
+	if err := json.Unmarshal([]byte(exported.String()), &snap); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	// Both tasks now share the first import's project and column.
+	for _, task := range snap.Tasks {
+		if task.ProjectID != "p-a" || task.ColumnID != "c-a" {
+			t.Fatalf("expected merged task in p-a/c-a, got %s in %s/%s", task.ID, task.ProjectID, task.ColumnID)
+		}
+	}
+	if len(snap.Tasks) != 2 {
+		t.Fatalf("expected both tasks kept, got %d", len(snap.Tasks))
+	}
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "import", "--mode", "overwrite", "--in", snapFor("p-c", "c-c", "t-c")}, io.Discard, io.Discard); !errors.Is(err, app.ErrInvalidImportMode) {
+		t.Fatalf("expected ErrInvalidImportMode, got %v", err)
+	}
+}
+
+// TestRunImportInDir verifies directory imports merge snapshots in filename order, skip non-snapshots, and report failures.
+func TestRunImportInDir(t *testing.T) {
+	tmp := t.TempDir()
+	cfgPath := filepath.Join(tmp, "missing.toml")
+	dir := filepath.Join(tmp, "snapshots")
+	if err := os.Mkdir(dir, 0o755); err != nil {
+		t.Fatalf("Mkdir() error = %v", err)
+	}
+
+	now := time.Date(2026, 2, 22, 12, 0, 0, 0, time.UTC)
+	writeSnap := func(name, version, slug, projectID string) {
+		snap := app.Snapshot{
+			Version:  version,
+			Projects: []app.SnapshotProject{{ID: projectID, Slug: slug, Name: slug, CreatedAt: now, UpdatedAt: now}},
+			Columns:  []app.SnapshotColumn{{ID: "c-" + projectID, ProjectID: projectID, Name: "To Do", CreatedAt: now, UpdatedAt: now}},
+			Tasks:    []app.SnapshotTask{{ID: "t-" + projectID, ProjectID: projectID, ColumnID: "c-" + projectID, Title: projectID, Priority: domain.PriorityMedium, CreatedAt: now, UpdatedAt: now}},
+		}
+		content, err := json.Marshal(snap)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), content, 0o644); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+	}
+	// Files are written out of order to prove the import sorts by name; 02 merges into 01 by slug.
+	writeSnap("05-other.json", app.SnapshotVersion, "other", "p-e")
+	writeSnap("02-b.json", app.SnapshotVersion, "shared", "p-b")
+	writeSnap("01-a.json", app.SnapshotVersion, "shared", "p-a")
+	writeSnap("03-bad.json", "tillsyn.snapshot.v0", "broken", "p-c")
+	for name, content := range map[string]string{
+		"04-trello.json": `{"name":"A Trello board","lists":[]}`,
+		"notes.txt":      "not json",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+	}
+
+	dbPath := filepath.Join(tmp, "tillsyn.db")
+	var out bytes.Buffer
+	err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "import", "--in-dir", dir}, &out, io.Discard)
+	if err == nil || !strings.Contains(err.Error(), "1 snapshot files failed to import: 03-bad.json") {
+		t.Fatalf("expected failure naming 03-bad.json, got %v", err)
+	}
+	for _, want := range []string{
+		`merged project p-b into p-a (slug "shared")`,
+		"03-bad.json: failed:",
+		"warning: skipping 04-trello.json: not a snapshot",
+		"warning: skipping notes.txt: not a .json file",
+		"imported 3 of 6 files (2 skipped, 1 failed)",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Fatalf("expected %q in output, got %q", want, out.String())
+		}
+	}
+	if strings.Index(out.String(), "01-a.json:") > strings.Index(out.String(), "02-b.json:") {
+		t.Fatalf("expected filename order, got %q", out.String())
+	}
+
+	var exported strings.Builder
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "export", "--out", "-"}, &exported, io.Discard); err != nil {
+		t.Fatalf("run(export) error = %v", err)
+	}
+	var snap app.Snapshot
+	if err := json.Unmarshal([]byte(exported.String()), &snap); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if len(snap.Projects) != 2 || len(snap.Tasks) != 3 {
+		t.Fatalf("expected 2 projects and 3 tasks after merging, got %d and %d", len(snap.Projects), len(snap.Tasks))
+	}
+
+	// --fail-fast stops at the bad file, so the later snapshot never lands.
+	failFastDB := filepath.Join(tmp, "fail-fast.db")
+	out.Reset()
+	err = run(context.Background(), []string{"--db", failFastDB, "--config", cfgPath, "import", "--in-dir", dir, "--fail-fast"}, &out, io.Discard)
+	if err == nil || !strings.Contains(err.Error(), "import 03-bad.json") {
+		t.Fatalf("expected fail-fast error on 03-bad.json, got %v", err)
+	}
+	if strings.Contains(out.String(), "05-other.json") {
+		t.Fatalf("expected fail-fast to stop before 05-other.json, got %q", out.String())
+	}
+
+	err = run(context.Background(), []string{"--db", failFastDB, "--config", cfgPath, "import", "--in-dir", dir, "--mode", "replace"}, io.Discard, io.Discard)
+	if err == nil || !strings.Contains(err.Error(), "--in-dir always merges") {
+		t.Fatalf("expected --mode replace to be rejected, got %v", err)
+	}
+}
+
+// TestRunSchemaSnapshotAndImportValidate verifies the schema command and that import --validate gates on it.
+func TestRunSchemaSnapshotAndImportValidate(t *testing.T) {
+	var out strings.Builder
+	if err := run(context.Background(), []string{"schema", "snapshot"}, &out, io.Discard); err != nil {
+		t.Fatalf("run(schema snapshot) error = %v", err)
+	}
+	var schema map[string]any
+	if err := json.Unmarshal([]byte(out.String()), &schema); err != nil {
+		t.Fatalf("expected schema output to be JSON, got %v", err)
+	}
+	if schema["$schema"] == nil || !strings.Contains(out.String(), app.SnapshotVersion) {
+		t.Fatalf("expected draft and snapshot version in schema, got %q", out.String())
+	}
+
+	tmp := t.TempDir()
+	dbPath := filepath.Join(tmp, "tillsyn.db")
+	cfgPath := filepath.Join(tmp, "missing.toml")
+	// An unknown field decodes fine without --validate, so only the schema check can reject it.
+	invalidPath := filepath.Join(tmp, "invalid.json")
+	invalid := `{"version":"` + app.SnapshotVersion + `","exported_at":"2026-02-22T12:00:00Z","projects":[],"columns":[],"tasks":[],"boards":[]}`
+	if err := os.WriteFile(invalidPath, []byte(invalid), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "import", "--in", invalidPath, "--validate"}, io.Discard, io.Discard)
+	if !errors.Is(err, app.ErrInvalidSnapshot) || !strings.Contains(err.Error(), `additional property "boards"`) {
+		t.Fatalf("expected ErrInvalidSnapshot for unknown field, got %v", err)
+	}
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "import", "--in", invalidPath}, io.Discard, io.Discard); err != nil {
+		t.Fatalf("run(import without --validate) error = %v", err)
+	}
+
+	// A round-tripped export must always pass its own schema.
+	exportPath := filepath.Join(tmp, "export.json")
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "export", "--out", exportPath}, io.Discard, io.Discard); err != nil {
+		t.Fatalf("run(export) error = %v", err)
+	}
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "import", "--in", exportPath, "--validate"}, io.Discard, io.Discard); err != nil {
+		t.Fatalf("run(import --validate exported snapshot) error = %v", err)
+	}
+}
+
+// TestRunRepairPositionsCommand verifies imported duplicate positions are renumbered per column.
+func TestRunRepairPositionsCommand(t *testing.T) {
+	tmp := t.TempDir()
+	dbPath := filepath.Join(tmp, "tillsyn.db")
+	cfgPath := filepath.Join(tmp, "missing.toml")
+
+	now := time.Date(2026, 2, 22, 12, 0, 0, 0, time.UTC)
+	snap := app.Snapshot{
+		Version:  app.SnapshotVersion,
+		Projects: []app.SnapshotProject{{ID: "p-repair", Slug: "repair", Name: "Repair", CreatedAt: now, UpdatedAt: now}},
+		Columns:  []app.SnapshotColumn{{ID: "c-repair", ProjectID: "p-repair", Name: "To Do", CreatedAt: now, UpdatedAt: now}},
+	}
+	// Both tasks share position 3, as a bad import or concurrent move could leave them.
+	for idx, id := range []string{"t-one", "t-two"} {
+		snap.Tasks = append(snap.Tasks, app.SnapshotTask{
+			ID:        id,
+			ProjectID: "p-repair",
+			ColumnID:  "c-repair",
+			Position:  3,
+			Title:     id,
+			Priority:  domain.PriorityMedium,
+			CreatedAt: now.Add(time.Duration(idx) * time.Minute),
+			UpdatedAt: now,
+		})
+	}
+	content, err := json.Marshal(snap)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	inPath := filepath.Join(tmp, "in.json")
+	if err := os.WriteFile(inPath, content, 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "import", "--in", inPath}, io.Discard, io.Discard); err != nil {
+		t.Fatalf("run(import) error = %v", err)
+	}
+
+	var out strings.Builder
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "repair-positions", "--project", "p-repair", "--dry-run"}, &out, io.Discard); err != nil {
+		t.Fatalf("run(repair-positions --dry-run) error = %v", err)
+	}
+	if !strings.Contains(out.String(), "p-repair: would reposition 2 tasks") {
+		t.Fatalf("expected dry-run report, got %q", out.String())
+	}
+	out.Reset()
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "repair-positions"}, &out, io.Discard); err != nil {
+		t.Fatalf("run(repair-positions) error = %v", err)
+	}
+	if !strings.Contains(out.String(), "p-repair: repositioned 2 tasks") {
+		t.Fatalf("expected repair report, got %q", out.String())
+	}
+
+	outPath := filepath.Join(tmp, "out.json")
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "export", "--out", outPath}, io.Discard, io.Discard); err != nil {
+		t.Fatalf("run(export) error = %v", err)
+	}
+	outContent, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	var outSnap app.Snapshot
+	if err := json.Unmarshal(outContent, &outSnap); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	positions := map[string]int{}
+	for _, task := range outSnap.Tasks {
+		positions[task.ID] = task.Position
+	}
+	if positions["t-one"] != 0 || positions["t-two"] != 1 {
+		t.Fatalf("expected contiguous positions in creation order, got %#v", positions)
+	}
+}
+
+// TestRunDoctorCommandReportsAndRepairsOrphans verifies doctor lists orphaned subtasks and reparents them on request.
+func TestRunDoctorCommandReportsAndRepairsOrphans(t *testing.T) {
+	tmp := t.TempDir()
+	dbPath := filepath.Join(tmp, "tillsyn.db")
+	cfgPath := filepath.Join(tmp, "missing.toml")
+
+	now := time.Date(2026, 2, 22, 12, 0, 0, 0, time.UTC)
+	snap := app.Snapshot{
+		Version:  app.SnapshotVersion,
+		Projects: []app.SnapshotProject{{ID: "p-doc", Slug: "doc", Name: "Doc", CreatedAt: now, UpdatedAt: now}},
+		Columns:  []app.SnapshotColumn{{ID: "c-doc", ProjectID: "p-doc", Name: "To Do", CreatedAt: now, UpdatedAt: now}},
+		Tasks: []app.SnapshotTask{
+			{ID: "t-parent", ProjectID: "p-doc", ColumnID: "c-doc", Title: "Parent", Priority: domain.PriorityMedium, CreatedAt: now, UpdatedAt: now},
+			{ID: "t-sub", ProjectID: "p-doc", ParentID: "t-parent", Kind: domain.WorkKindSubtask, ColumnID: "c-doc", Position: 1, Title: "Lost subtask", Priority: domain.PriorityMedium, CreatedAt: now, UpdatedAt: now},
+		},
+	}
+	content, err := json.Marshal(snap)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	inPath := filepath.Join(tmp, "in.json")
+	if err := os.WriteFile(inPath, content, 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "import", "--in", inPath}, io.Discard, io.Discard); err != nil {
+		t.Fatalf("run(import) error = %v", err)
+	}
+	// Snapshot import rejects dangling parents, so orphan the subtask by deleting its parent row directly.
+	repo, err := sqlite.Open(dbPath)
+	if err != nil {
+		t.Fatalf("sqlite.Open() error = %v", err)
+	}
+	if err := repo.DeleteTask(context.Background(), "t-parent"); err != nil {
+		t.Fatalf("DeleteTask() error = %v", err)
+	}
+	if err := repo.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	var out strings.Builder
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "doctor"}, &out, io.Discard); err != nil {
+		t.Fatalf("run(doctor) error = %v", err)
+	}
+	if !strings.Contains(out.String(), `doc: orphaned t-sub "Lost subtask" (missing parent t-parent)`) || !strings.Contains(out.String(), "1 orphaned subtasks found") {
+		t.Fatalf("expected orphan report, got %q", out.String())
+	}
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "doctor", "--fix", "archive"}, io.Discard, io.Discard); err == nil {
+		t.Fatal("expected invalid --fix mode to fail")
+	}
+
+	out.Reset()
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "doctor", "--fix", "reparent"}, &out, io.Discard); err != nil {
+		t.Fatalf("run(doctor --fix reparent) error = %v", err)
+	}
+	if !strings.Contains(out.String(), "doc: reparented 1 orphaned tasks") {
+		t.Fatalf("expected reparent report, got %q", out.String())
+	}
+	out.Reset()
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "doctor"}, &out, io.Discard); err != nil {
+		t.Fatalf("run(doctor) after fix error = %v", err)
+	}
+	if !strings.Contains(out.String(), "0 orphaned subtasks found") {
+		t.Fatalf("expected clean doctor run after repair, got %q", out.String())
+	}
+}
+
+// TestRunVerifyResourcesCommand verifies local refs are checked against project_roots and missing ones fail the run.
+func TestRunVerifyResourcesCommand(t *testing.T) {
+	tmp := t.TempDir()
+	dbPath := filepath.Join(tmp, "tillsyn.db")
+	root := filepath.Join(tmp, "repo")
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		t.Fatalf("MkdirAll() error = %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "plan.md"), []byte("plan"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	cfgPath := filepath.Join(tmp, "config.toml")
+	if err := os.WriteFile(cfgPath, []byte(fmt.Sprintf("[project_roots]\ndoc = %q\n", root)), 0o644); err != nil {
+		t.Fatalf("WriteFile(config) error = %v", err)
+	}
+
+	now := time.Date(2026, 2, 22, 12, 0, 0, 0, time.UTC)
+	snap := app.Snapshot{
+		Version:  app.SnapshotVersion,
+		Projects: []app.SnapshotProject{{ID: "p-doc", Slug: "doc", Name: "Doc", CreatedAt: now, UpdatedAt: now}},
+		Columns:  []app.SnapshotColumn{{ID: "c-doc", ProjectID: "p-doc", Name: "To Do", CreatedAt: now, UpdatedAt: now}},
+		Tasks: []app.SnapshotTask{{
+			ID: "t-refs", ProjectID: "p-doc", ColumnID: "c-doc", Title: "Refs", Priority: domain.PriorityMedium, CreatedAt: now, UpdatedAt: now,
+			Metadata: domain.TaskMetadata{ResourceRefs: []domain.ResourceRef{
+				{ResourceType: domain.ResourceTypeLocalFile, PathMode: domain.PathModeRelative, Location: "plan.md"},
+				{ResourceType: domain.ResourceTypeLocalFile, PathMode: domain.PathModeRelative, Location: "moved.md"},
+			}},
+		}},
+	}
+	content, err := json.Marshal(snap)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	inPath := filepath.Join(tmp, "in.json")
+	if err := os.WriteFile(inPath, content, 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "import", "--in", inPath}, io.Discard, io.Discard); err != nil {
+		t.Fatalf("run(import) error = %v", err)
+	}
+
+	var out strings.Builder
+	err = run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "verify-resources"}, &out, io.Discard)
+	if err == nil || !strings.Contains(err.Error(), "1 resource refs missing") {
+		t.Fatalf("expected missing-ref failure, got %v", err)
+	}
+	if !strings.Contains(out.String(), `doc: missing moved.md on t-refs "Refs"`) || !strings.Contains(out.String(), "doc: 1 of 2 local refs found") {
+		t.Fatalf("unexpected verify output %q", out.String())
+	}
+	repo, err := sqlite.Open(dbPath)
+	if err != nil {
+		t.Fatalf("sqlite.Open() error = %v", err)
+	}
+	task, err := repo.GetTask(context.Background(), "t-refs")
+	if closeErr := repo.Close(); closeErr != nil {
+		t.Fatalf("Close() error = %v", closeErr)
+	}
+	if err != nil {
+		t.Fatalf("GetTask() error = %v", err)
+	}
+	refs := task.Metadata.ResourceRefs
+	if refs[0].LastVerifiedAt == nil || refs[0].IsStale() || !refs[1].IsStale() {
+		t.Fatalf("expected found ref stamped and missing ref flagged, got %#v", refs)
+	}
+
+	// Once the file is back the run passes and the flag clears.
+	if err := os.WriteFile(filepath.Join(root, "moved.md"), []byte("moved"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	out.Reset()
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "verify-resources", "--project", "doc"}, &out, io.Discard); err != nil {
+		t.Fatalf("run(verify-resources) after restore error = %v", err)
+	}
+	if !strings.Contains(out.String(), "doc: 2 of 2 local refs found") {
+		t.Fatalf("expected clean verify output, got %q", out.String())
+	}
+}
+
+// TestRunPurgeCommand verifies purge previews, keeps referenced archives, and deletes the rest.
+func TestRunPurgeCommand(t *testing.T) {
+	tmp := t.TempDir()
+	dbPath := filepath.Join(tmp, "tillsyn.db")
+	cfgPath := filepath.Join(tmp, "config.toml")
+	created := time.Now().UTC().Add(-400 * 24 * time.Hour).Truncate(time.Second)
+	archived := time.Now().UTC().Add(-200 * 24 * time.Hour).Truncate(time.Second)
+	recent := time.Now().UTC().Add(-5 * 24 * time.Hour).Truncate(time.Second)
+	snap := app.Snapshot{
+		Version:  app.SnapshotVersion,
+		Projects: []app.SnapshotProject{{ID: "p-old", Slug: "old", Name: "Old", CreatedAt: created, UpdatedAt: created}},
+		Columns:  []app.SnapshotColumn{{ID: "c-old", ProjectID: "p-old", Name: "Done", CreatedAt: created, UpdatedAt: created}},
+		Tasks: []app.SnapshotTask{
+			{ID: "t-stale", ProjectID: "p-old", ColumnID: "c-old", Title: "Stale", Priority: domain.PriorityLow, LifecycleState: domain.StateArchived, CreatedAt: created, UpdatedAt: archived, ArchivedAt: &archived},
+			{ID: "t-needed", ProjectID: "p-old", ColumnID: "c-old", Title: "Needed", Priority: domain.PriorityLow, LifecycleState: domain.StateArchived, CreatedAt: created, UpdatedAt: archived, ArchivedAt: &archived, Position: 1},
+			{ID: "t-recent", ProjectID: "p-old", ColumnID: "c-old", Title: "Recent", Priority: domain.PriorityLow, LifecycleState: domain.StateArchived, CreatedAt: created, UpdatedAt: recent, ArchivedAt: &recent, Position: 2},
+			{ID: "t-live", ProjectID: "p-old", ColumnID: "c-old", Title: "Live", Priority: domain.PriorityLow, CreatedAt: created, UpdatedAt: created, Position: 3, Metadata: domain.TaskMetadata{DependsOn: []string{"t-needed"}}},
+		},
+	}
+	content, err := json.Marshal(snap)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	inPath := filepath.Join(tmp, "in.json")
+	if err := os.WriteFile(inPath, content, 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "import", "--in", inPath}, io.Discard, io.Discard); err != nil {
+		t.Fatalf("run(import) error = %v", err)
+	}
+
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "purge"}, io.Discard, io.Discard); err == nil || !strings.Contains(err.Error(), "--older-than is required") {
+		t.Fatalf("expected missing --older-than error, got %v", err)
+	}
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "purge", "--older-than", "soon"}, io.Discard, io.Discard); err == nil || !strings.Contains(err.Error(), "invalid --older-than") {
+		t.Fatalf("expected invalid --older-than error, got %v", err)
+	}
+
+	var out strings.Builder
+	err = run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "purge", "--older-than", "90d", "--dry-run"}, &out, io.Discard)
+	if err == nil || !strings.Contains(err.Error(), "1 archived tasks kept") {
+		t.Fatalf("expected kept-task failure, got %v", err)
+	}
+	for _, want := range []string{`old: would purge t-stale "Stale"`, `old: kept t-needed "Needed", still referenced by t-live`, "would purge 1 tasks archived before"} {
+		if !strings.Contains(out.String(), want) {
+			t.Fatalf("expected %q in dry-run output, got %q", want, out.String())
+		}
+	}
+
+	out.Reset()
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "purge", "--older-than", "90d", "--force"}, &out, io.Discard); err != nil {
+		t.Fatalf("run(purge --force) error = %v", err)
+	}
+	if !strings.Contains(out.String(), "purged 2 tasks archived before") {
+		t.Fatalf("unexpected purge output %q", out.String())
+	}
+	repo, err := sqlite.Open(dbPath)
+	if err != nil {
+		t.Fatalf("sqlite.Open() error = %v", err)
+	}
+	defer func() {
+		if closeErr := repo.Close(); closeErr != nil {
+			t.Fatalf("Close() error = %v", closeErr)
+		}
+	}()
+	tasks, err := repo.ListTasks(context.Background(), "p-old", true)
+	if err != nil {
+		t.Fatalf("ListTasks() error = %v", err)
+	}
+	ids := make([]string, 0, len(tasks))
+	for _, task := range tasks {
+		ids = append(ids, task.ID)
+		if task.ID == "t-live" && len(task.Metadata.DependsOn) != 0 {
+			t.Fatalf("expected forced purge to strip the dangling dependency, got %#v", task.Metadata.DependsOn)
+		}
+	}
+	slices.Sort(ids)
+	if got := strings.Join(ids, ","); got != "t-live,t-recent" {
+		t.Fatalf("remaining tasks = %q, want t-live,t-recent", got)
+	}
+}
+
+// TestRunConfigCheckCommand verifies config check lists problems with a non-zero exit and passes clean files.
+func TestRunConfigCheckCommand(t *testing.T) {
+	tmp := t.TempDir()
+	cfgPath := filepath.Join(tmp, "config.toml")
+	if err := os.WriteFile(cfgPath, []byte("[logging]\nlevel = \"loud\"\nformatt = \"json\"\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	var out strings.Builder
+	err := run(context.Background(), []string{"--config", cfgPath, "config", "check"}, &out, io.Discard)
+	if err == nil || !strings.Contains(err.Error(), "2 config problems found") {
+		t.Fatalf("expected config problems error, got %v", err)
+	}
+	if !strings.Contains(out.String(), `unknown key "logging.formatt"`) || !strings.Contains(out.String(), `invalid logging.level: "loud"`) {
+		t.Fatalf("unexpected config check output %q", out.String())
+	}
+
+	if err := os.WriteFile(cfgPath, []byte("[logging]\nlevel = \"warn\"\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	out.Reset()
+	if err := run(context.Background(), []string{"--config", cfgPath, "config", "check"}, &out, io.Discard); err != nil {
+		t.Fatalf("run(config check) clean error = %v", err)
+	}
+	if out.String() != cfgPath+": ok\n" {
+		t.Fatalf("unexpected clean output %q", out.String())
+	}
+
+	// A missing file is not an error: built-in defaults apply.
+	out.Reset()
+	if err := run(context.Background(), []string{"--config", filepath.Join(tmp, "missing.toml"), "config", "check"}, &out, io.Discard); err != nil {
+		t.Fatalf("run(config check) missing error = %v", err)
+	}
+	if !strings.Contains(out.String(), "not found; built-in defaults apply") {
+		t.Fatalf("unexpected missing-file output %q", out.String())
+	}
+}
+
+// TestRunStatsCommand verifies stats reports per-project metrics read-only, as text or JSON, for one or all projects.
+func TestRunStatsCommand(t *testing.T) {
+	origCheck := checkDatabaseWritableFunc
+	t.Cleanup(func() {
+		checkDatabaseWritableFunc = origCheck
+	})
+	tmp := t.TempDir()
+	dbPath := filepath.Join(tmp, "tillsyn.db")
+	cfgPath := filepath.Join(tmp, "missing.toml")
+
+	created := time.Now().UTC().Add(-48 * time.Hour)
+	due := created.Add(time.Hour)
+	snap := app.Snapshot{
+		Version: app.SnapshotVersion,
+		Projects: []app.SnapshotProject{
+			{ID: "p-alpha", Slug: "alpha", Name: "Alpha", CreatedAt: created, UpdatedAt: created},
+			{ID: "p-beta", Slug: "beta", Name: "Beta", CreatedAt: created, UpdatedAt: created},
+		},
+		Columns: []app.SnapshotColumn{
+			{ID: "c-alpha", ProjectID: "p-alpha", Name: "To Do", CreatedAt: created, UpdatedAt: created},
+			{ID: "c-beta", ProjectID: "p-beta", Name: "To Do", CreatedAt: created, UpdatedAt: created},
+		},
+		Tasks: []app.SnapshotTask{
+			{ID: "t-late", ProjectID: "p-alpha", ColumnID: "c-alpha", Title: "Late", Priority: domain.PriorityHigh, LifecycleState: domain.StateTodo, DueAt: &due, CreatedAt: created, UpdatedAt: created},
+			{ID: "t-done", ProjectID: "p-alpha", ColumnID: "c-alpha", Position: 1, Title: "Done", Priority: domain.PriorityLow, LifecycleState: domain.StateDone, CreatedAt: created, UpdatedAt: created},
+			{ID: "t-beta", ProjectID: "p-beta", ColumnID: "c-beta", Title: "Beta task", Priority: domain.PriorityMedium, LifecycleState: domain.StateProgress, CreatedAt: created, UpdatedAt: created},
+		},
+	}
+	content, err := json.Marshal(snap)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	inPath := filepath.Join(tmp, "in.json")
+	if err := os.WriteFile(inPath, content, 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "import", "--in", inPath}, io.Discard, io.Discard); err != nil {
+		t.Fatalf("run(import) error = %v", err)
+	}
+
+	// Stats opens the database read-only, so it works even where the writable probe would fail.
+	checkDatabaseWritableFunc = func(path string) error {
+		return fmt.Errorf("%w: %s: permission denied", platform.ErrPathNotWritable, path)
+	}
+	var out strings.Builder
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "stats"}, &out, io.Discard); err != nil {
+		t.Fatalf("run(stats) error = %v", err)
+	}
+	for _, want := range []string{
+		"alpha (Alpha): 2 tasks (todo 1, progress 0, done 1, archived 0), 1 overdue, 0 blocked, average age 2.0d",
+		"beta (Beta): 1 tasks (todo 0, progress 1, done 0, archived 0), 0 overdue, 0 blocked, average age 2.0d",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Fatalf("expected stats line %q, got %q", want, out.String())
+		}
+	}
+
+	out.Reset()
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "stats", "--project", "beta", "--json"}, &out, io.Discard); err != nil {
+		t.Fatalf("run(stats --json) error = %v", err)
+	}
+	var rows []projectStatsJSON
+	if err := json.Unmarshal([]byte(out.String()), &rows); err != nil {
+		t.Fatalf("Unmarshal(stats json) error = %v (%q)", err, out.String())
+	}
+	if len(rows) != 1 || rows[0].Slug != "beta" || rows[0].TotalTasks != 1 || rows[0].ByState["progress"] != 1 || rows[0].ByState["todo"] != 0 {
+		t.Fatalf("unexpected stats json rows %#v", rows)
+	}
+
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "stats", "--project", "missing"}, io.Discard, io.Discard); !errors.Is(err, app.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for unknown project slug, got %v", err)
+	}
+}
+
+// TestRunDevSeedCommand verifies dev seed is gated behind dev mode and imports a deterministic board.
+func TestRunDevSeedCommand(t *testing.T) {
+	workspace := t.TempDir()
+	t.Chdir(workspace)
+	dbPath := filepath.Join(workspace, "tillsyn.db")
+	cfgPath := filepath.Join(workspace, "missing.toml")
+
+	err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "dev", "seed"}, io.Discard, io.Discard)
+	if err == nil || !strings.Contains(err.Error(), "requires dev mode") {
+		t.Fatalf("expected dev-mode gate error, got %v", err)
+	}
+	if _, statErr := os.Stat(dbPath); !errors.Is(statErr, os.ErrNotExist) {
+		t.Fatalf("expected gated seed to leave the database untouched, stat error = %v", statErr)
+	}
+
+	var out strings.Builder
+	args := []string{"--dev", "--db", dbPath, "--config", cfgPath, "dev", "seed", "--projects", "2", "--tasks-per-project", "5", "--seed", "9"}
+	if err := run(context.Background(), args, &out, io.Discard); err != nil {
+		t.Fatalf("run(dev seed) error = %v", err)
+	}
+	if !strings.Contains(out.String(), "seeded 2 projects, 10 tasks (seed 9)") {
+		t.Fatalf("unexpected dev seed output %q", out.String())
+	}
+
+	var exported strings.Builder
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "export", "--out", "-"}, &exported, io.Discard); err != nil {
+		t.Fatalf("run(export) error = %v", err)
+	}
+	var snap app.Snapshot
+	if err := json.Unmarshal([]byte(exported.String()), &snap); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if len(snap.Projects) != 2 || len(snap.Tasks) != 10 {
+		t.Fatalf("expected seeded board in export, got projects=%d tasks=%d", len(snap.Projects), len(snap.Tasks))
 	}
-	if err := run(context.Background(), args, io.Discard, io.Discard); err != nil {
-		t.Fatalf("run(serve with flags) error = %v", err)
+
+	// A --task export prints one shareable card instead of the whole snapshot.
+	var card strings.Builder
+	task := snap.Tasks[0]
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "export", "--task", task.ID, "--out", "-"}, &card, io.Discard); err != nil {
+		t.Fatalf("run(export --task) error = %v", err)
 	}
-	if gotCfg.HTTPBind != "127.0.0.1:9090" {
-		t.Fatalf("serve http bind = %q, want 127.0.0.1:9090", gotCfg.HTTPBind)
+	if !strings.HasPrefix(card.String(), "# "+task.Title+"\n") {
+		t.Fatalf("expected markdown task card for %q, got %q", task.Title, card.String())
 	}
-	if gotCfg.APIEndpoint != "/custom-api" {
-		t.Fatalf("serve api endpoint = %q, want /custom-api", gotCfg.APIEndpoint)
+	var jsonCard strings.Builder
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "export", "--task", task.ID, "--format", "json", "--out", "-"}, &jsonCard, io.Discard); err != nil {
+		t.Fatalf("run(export --task --format json) error = %v", err)
 	}
-	if gotCfg.MCPEndpoint != "/custom-mcp" {
-		t.Fatalf("serve mcp endpoint = %q, want /custom-mcp", gotCfg.MCPEndpoint)
+	var taskSnap app.Snapshot
+	if err := json.Unmarshal([]byte(jsonCard.String()), &taskSnap); err != nil {
+		t.Fatalf("Unmarshal(task card) error = %v", err)
 	}
-}
-
-// TestRunServeCommandPropagatesErrors verifies serve runner failures are returned to callers.
-func TestRunServeCommandPropagatesErrors(t *testing.T) {
-	origRunner := serveCommandRunner
-	t.Cleanup(func() { serveCommandRunner = origRunner })
-
-	serveCommandRunner = func(_ context.Context, _ serveradapter.Config, _ serveradapter.Dependencies) error {
-		return errors.New("listen failed")
+	if len(taskSnap.Tasks) != 1 || taskSnap.Tasks[0].ID != task.ID {
+		t.Fatalf("expected json card scoped to %q, got %#v", task.ID, taskSnap.Tasks)
+	}
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "export", "--subtasks", "--out", "-"}, io.Discard, io.Discard); err == nil || !strings.Contains(err.Error(), "requires --task") {
+		t.Fatalf("expected --subtasks without --task to fail, got %v", err)
 	}
 
-	tmp := t.TempDir()
-	dbPath := filepath.Join(tmp, "tillsyn.db")
-	cfgPath := filepath.Join(tmp, "tillsyn.toml")
-	err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "serve"}, io.Discard, io.Discard)
-	if err == nil {
-		t.Fatal("expected serve command error")
+	// A csv export writes one row per task after the header.
+	var csvOut strings.Builder
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "export", "--format", "csv", "--out", "-"}, &csvOut, io.Discard); err != nil {
+		t.Fatalf("run(export --format csv) error = %v", err)
 	}
-	if !strings.Contains(err.Error(), "run serve command") {
-		t.Fatalf("expected wrapped serve error, got %v", err)
+	rows, err := csv.NewReader(strings.NewReader(csvOut.String())).ReadAll()
+	if err != nil {
+		t.Fatalf("ReadAll(csv) error = %v", err)
+	}
+	if len(rows) != 11 || rows[0][0] != "id" {
+		t.Fatalf("expected csv header plus 10 task rows, got %d rows starting %#v", len(rows), rows[0])
+	}
+	// A markdown export opens with the page title and lists tasks as checkboxes.
+	var mdOut strings.Builder
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "export", "--format", "markdown", "--out", "-"}, &mdOut, io.Discard); err != nil {
+		t.Fatalf("run(export --format markdown) error = %v", err)
+	}
+	if !strings.HasPrefix(mdOut.String(), "# Board Snapshot\n") || !strings.Contains(mdOut.String(), "- [ ] ") {
+		t.Fatalf("expected markdown board snapshot, got %q", mdOut.String())
+	}
+	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "export", "--format", "yaml", "--out", "-"}, io.Discard, io.Discard); !errors.Is(err, app.ErrInvalidExportFormat) {
+		t.Fatalf("expected ErrInvalidExportFormat, got %v", err)
 	}
 }
 
-// TestRunExportCommandWritesSnapshot verifies behavior for the covered scenario.
-func TestRunExportCommandWritesSnapshot(t *testing.T) {
+// TestRunProfilingFlagsWriteProfiles verifies --cpuprofile and --memprofile produce profile files for a CLI run.
+func TestRunProfilingFlagsWriteProfiles(t *testing.T) {
 	tmp := t.TempDir()
 	dbPath := filepath.Join(tmp, "tillsyn.db")
 	cfgPath := filepath.Join(tmp, "missing.toml")
-	outPath := filepath.Join(tmp, "snapshot.json")
-	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "export", "--out", outPath}, io.Discard, io.Discard); err != nil {
-		t.Fatalf("run(export) error = %v", err)
+	cpuPath := filepath.Join(tmp, "prof", "cpu.pprof")
+	memPath := filepath.Join(tmp, "prof", "mem.pprof")
+
+	args := []string{"--db", dbPath, "--config", cfgPath, "--cpuprofile", cpuPath, "--memprofile", memPath, "export", "--out", filepath.Join(tmp, "out.json")}
+	if err := run(context.Background(), args, io.Discard, io.Discard); err != nil {
+		t.Fatalf("run(export with profiling) error = %v", err)
+	}
+	for _, path := range []string{cpuPath, memPath} {
+		info, err := os.Stat(path)
+		if err != nil {
+			t.Fatalf("Stat(%s) error = %v", path, err)
+		}
+		if info.Size() == 0 {
+			t.Fatalf("expected non-empty profile at %s", path)
+		}
 	}
+}
 
-	content, err := os.ReadFile(outPath)
-	if err != nil {
-		t.Fatalf("ReadFile() error = %v", err)
+// TestStartProfilingPprofBindGuard verifies pprof serves on loopback and refuses public binds outside dev mode.
+func TestStartProfilingPprofBindGuard(t *testing.T) {
+	if _, err := startProfiling(profilingOptions{pprofAddr: "0.0.0.0:0"}, false); err == nil {
+		t.Fatal("expected non-loopback pprof bind to be rejected outside dev mode")
 	}
 
-	var snap app.Snapshot
-	if err := json.Unmarshal(content, &snap); err != nil {
-		t.Fatalf("Unmarshal() error = %v", err)
+	prof, err := startProfiling(profilingOptions{pprofAddr: "127.0.0.1:0"}, false)
+	if err != nil {
+		t.Fatalf("startProfiling() error = %v", err)
 	}
-	if snap.Version != app.SnapshotVersion {
-		t.Fatalf("unexpected snapshot version %q", snap.Version)
+	resp, err := http.Get("http://" + prof.addr + "/debug/pprof/")
+	if err != nil {
+		_ = prof.stop()
+		t.Fatalf("GET pprof index error = %v", err)
 	}
-	if len(snap.Projects) != 0 {
-		t.Fatalf("expected no projects in empty export snapshot, got %d", len(snap.Projects))
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected pprof index 200, got %d", resp.StatusCode)
+	}
+	if err := prof.stop(); err != nil {
+		t.Fatalf("stop() error = %v", err)
 	}
 }
 
-// TestRunImportCommandReadsSnapshot verifies behavior for the covered scenario.
-func TestRunImportCommandReadsSnapshot(t *testing.T) {
+// TestRunTimingFlagPrintsStartupSummary verifies --timing writes per-phase startup durations to stderr.
+func TestRunTimingFlagPrintsStartupSummary(t *testing.T) {
 	tmp := t.TempDir()
 	dbPath := filepath.Join(tmp, "tillsyn.db")
 	cfgPath := filepath.Join(tmp, "missing.toml")
 
-	now := time.Date(2026, 2, 22, 12, 0, 0, 0, time.UTC)
-	snap := app.Snapshot{
-		Version: app.SnapshotVersion,
-		Projects: []app.SnapshotProject{
-			{
-				ID:        "p-import",
-				Slug:      "imported",
-				Name:      "Imported",
-				CreatedAt: now,
-				UpdatedAt: now,
-			},
-		},
-		Columns: []app.SnapshotColumn{
-			{
-				ID:        "c-import",
-				ProjectID: "p-import",
-				Name:      "To Do",
-				Position:  0,
-				WIPLimit:  0,
-				CreatedAt: now,
-				UpdatedAt: now,
-			},
-		},
-		Tasks: []app.SnapshotTask{
-			{
-				ID:        "t-import",
-				ProjectID: "p-import",
-				ColumnID:  "c-import",
-				Position:  0,
-				Title:     "Imported Task",
-				Priority:  domain.PriorityMedium,
-				CreatedAt: now,
-				UpdatedAt: now,
-			},
-		},
-	}
-	content, err := json.MarshalIndent(snap, "", "  ")
-	if err != nil {
-		t.Fatalf("MarshalIndent() error = %v", err)
-	}
-	inPath := filepath.Join(tmp, "in.json")
-	if err := os.WriteFile(inPath, content, 0o644); err != nil {
-		t.Fatalf("WriteFile() error = %v", err)
+	var stderr bytes.Buffer
+	args := []string{"--db", dbPath, "--config", cfgPath, "--timing", "export", "--out", filepath.Join(tmp, "out.json")}
+	if err := run(context.Background(), args, io.Discard, &stderr); err != nil {
+		t.Fatalf("run(export --timing) error = %v", err)
+	}
+	out := stderr.String()
+	for _, want := range []string{"startup timing:", "paths", "config_load", "sqlite_open_migrate", "service_init", "total"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in timing summary, got %q", want, out)
+		}
 	}
+}
 
-	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "import", "--in", inPath}, io.Discard, io.Discard); err != nil {
-		t.Fatalf("run(import) error = %v", err)
+// TestRunQuietAndVerboseOverrideLogLevel verifies --quiet and --verbose override logging.level and reject being combined.
+func TestRunQuietAndVerboseOverrideLogLevel(t *testing.T) {
+	tmp := t.TempDir()
+	dbPath := filepath.Join(tmp, "tillsyn.db")
+	cfgPath := filepath.Join(tmp, "missing.toml")
+	runExport := func(flags ...string) string {
+		t.Helper()
+		var stderr bytes.Buffer
+		args := append([]string{"--db", dbPath, "--config", cfgPath}, flags...)
+		args = append(args, "export", "--out", filepath.Join(tmp, "out.json"))
+		if err := run(context.Background(), args, io.Discard, &stderr); err != nil {
+			t.Fatalf("run(export %v) error = %v", flags, err)
+		}
+		return stderr.String()
 	}
 
-	outPath := filepath.Join(tmp, "out.json")
-	if err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "export", "--out", outPath}, io.Discard, io.Discard); err != nil {
-		t.Fatalf("run(export) error = %v", err)
+	if out := runExport(); !strings.Contains(out, "INFO") || strings.Contains(out, "DEBU") {
+		t.Fatalf("default export logs = %q, want info without debug", out)
 	}
-	outContent, err := os.ReadFile(outPath)
-	if err != nil {
-		t.Fatalf("ReadFile() error = %v", err)
+	if out := runExport("--verbose"); !strings.Contains(out, "DEBU") {
+		t.Fatalf("verbose export logs = %q, want debug lines", out)
 	}
-	var outSnap app.Snapshot
-	if err := json.Unmarshal(outContent, &outSnap); err != nil {
-		t.Fatalf("Unmarshal() error = %v", err)
+	if out := strings.TrimSpace(runExport("--quiet")); out != "" {
+		t.Fatalf("quiet export logs = %q, want no output", out)
 	}
 
-	foundProject := false
-	foundTask := false
-	for _, p := range outSnap.Projects {
-		if p.ID == "p-import" {
-			foundProject = true
-			break
-		}
+	err := run(context.Background(), []string{"--db", dbPath, "--config", cfgPath, "--quiet", "--verbose", "export"}, io.Discard, io.Discard)
+	if err == nil || !strings.Contains(err.Error(), "--quiet and --verbose cannot be combined") {
+		t.Fatalf("run(--quiet --verbose) error = %v, want combination error", err)
 	}
-	for _, tk := range outSnap.Tasks {
-		if tk.ID == "t-import" {
-			foundTask = true
-			break
-		}
+}
+
+// TestStartupTimerSummary verifies phase durations are measured between marks and aligned in output.
+func TestStartupTimerSummary(t *testing.T) {
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	ticks := []time.Duration{0, 2 * time.Millisecond, 15 * time.Millisecond}
+	idx := 0
+	timer := newStartupTimer(func() time.Time {
+		at := base.Add(ticks[idx])
+		idx++
+		return at
+	})
+	timer.mark("paths")
+	timer.mark("sqlite_open_migrate")
+
+	var out strings.Builder
+	if err := timer.writeSummary(&out); err != nil {
+		t.Fatalf("writeSummary() error = %v", err)
 	}
-	if !foundProject || !foundTask {
-		t.Fatalf("expected imported data in exported snapshot, foundProject=%t foundTask=%t", foundProject, foundTask)
+	want := "startup timing:\n" +
+		"  paths                2.0ms\n" +
+		"  sqlite_open_migrate  13.0ms\n" +
+		"  total                15.0ms\n"
+	if out.String() != want {
+		t.Fatalf("unexpected summary\n%q\nwant\n%q", out.String(), want)
 	}
 }
 
@@ -819,6 +2134,74 @@ func TestRunPathsCommand(t *testing.T) {
 	}
 }
 
+// TestRunPathsCommandHonorsTillHome verifies TILL_HOME relocates every path and is shown by the paths command.
+func TestRunPathsCommandHonorsTillHome(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("TILL_HOME", home)
+
+	var out strings.Builder
+	if err := run(context.Background(), []string{"--app", "tillsynx", "paths"}, &out, io.Discard); err != nil {
+		t.Fatalf("run(paths) error = %v", err)
+	}
+	output := out.String()
+	for _, want := range []string{
+		"home: " + home,
+		"config: " + filepath.Join(home, "tillsynx", "config.toml"),
+		"data_dir: " + filepath.Join(home, "tillsynx"),
+		"db: " + filepath.Join(home, "tillsynx", "tillsynx.db"),
+	} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("expected %q in paths output, got %q", want, output)
+		}
+	}
+
+	// Explicit --config and --db still win over the home-rooted defaults.
+	paths, err := platform.DefaultPathsWithOptions(platform.Options{AppName: "tillsynx", Home: home})
+	if err != nil {
+		t.Fatalf("DefaultPathsWithOptions() error = %v", err)
+	}
+	rootOpts := rootCommandOptions{configPath: "/elsewhere/config.toml", dbPath: "/elsewhere/till.db", homeDir: home}
+	if got := resolveConfigPath(rootOpts, paths); got != rootOpts.configPath {
+		t.Fatalf("resolveConfigPath() = %q, want %q", got, rootOpts.configPath)
+	}
+	if got, overridden := resolveDBPath(rootOpts, paths); got != rootOpts.dbPath || !overridden {
+		t.Fatalf("resolveDBPath() = %q, %t, want %q, true", got, overridden, rootOpts.dbPath)
+	}
+}
+
+// TestOpenDataDirOutput verifies paths --open launches the opener and prints the path when headless.
+func TestOpenDataDirOutput(t *testing.T) {
+	dataDir := t.TempDir()
+	orig := openPathFunc
+	t.Cleanup(func() { openPathFunc = orig })
+	opened := ""
+	openPathFunc = func(path string) error {
+		opened = path
+		return nil
+	}
+
+	var out strings.Builder
+	if err := openDataDirOutput(&out, dataDir); err != nil {
+		t.Fatalf("openDataDirOutput() error = %v", err)
+	}
+	if opened != dataDir || !strings.Contains(out.String(), "opened data_dir: "+dataDir) {
+		t.Fatalf("expected data dir opened, got opened=%q output %q", opened, out.String())
+	}
+
+	openPathFunc = func(string) error { return platform.ErrOpenUnavailable }
+	out.Reset()
+	if err := openDataDirOutput(&out, dataDir); err != nil {
+		t.Fatalf("openDataDirOutput(headless) error = %v", err)
+	}
+	if got := out.String(); got != dataDir+"\n" {
+		t.Fatalf("expected bare data dir path when headless, got %q", got)
+	}
+
+	if err := openDataDirOutput(&out, filepath.Join(dataDir, "missing")); err == nil {
+		t.Fatal("expected missing data dir error")
+	}
+}
+
 // TestShellEscapePath verifies init-dev-config path output is shell-token safe.
 func TestShellEscapePath(t *testing.T) {
 	in := "/Users/me/Library/Application Support/tillsyn-dev/config.toml"
@@ -1203,6 +2586,7 @@ multi_select = "x"
 activity_log = "v"
 undo = "u"
 redo = "U"
+restore_task = "ctrl+r"
 `
 	if err := os.WriteFile(cfgPath, []byte(content), 0o644); err != nil {
 		t.Fatalf("WriteFile() error = %v", err)
@@ -1371,6 +2755,59 @@ func TestPersistAllowedLabelsRoundTrip(t *testing.T) {
 	}
 }
 
+// TestPersistHighlightColorRoundTrip verifies a saved highlight color reaches the next launch's TUI config.
+func TestPersistHighlightColorRoundTrip(t *testing.T) {
+	cfgPath := filepath.Join(t.TempDir(), "tillsyn.toml")
+
+	if err := persistHighlightColor(cfgPath, "#ff8800"); err != nil {
+		t.Fatalf("persistHighlightColor() error = %v", err)
+	}
+	cfg, err := config.Load(cfgPath, config.Default("/tmp/default.db"))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if got := toTUIRuntimeConfig(cfg).UI.HighlightColor; got != "#ff8800" {
+		t.Fatalf("expected persisted highlight color #ff8800, got %q", got)
+	}
+	if err := persistHighlightColor(cfgPath, "magenta"); err == nil {
+		t.Fatal("expected invalid highlight color to be rejected")
+	}
+}
+
+// TestPersistSavedSearchRoundTrip verifies a saved search reaches the next launch's TUI config and replaces by name.
+func TestPersistSavedSearchRoundTrip(t *testing.T) {
+	cfgPath := filepath.Join(t.TempDir(), "tillsyn.toml")
+
+	first := tui.SavedSearch{Query: "login", CrossProject: true, States: []string{"todo"}}
+	if err := persistSavedSearch(cfgPath, "Login", first); err != nil {
+		t.Fatalf("persistSavedSearch() error = %v", err)
+	}
+	dueBefore := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	second := tui.SavedSearch{Query: "login", States: []string{"progress", "todo"}, Levels: []string{"task"}, DueBefore: dueBefore}
+	if err := persistSavedSearch(cfgPath, "login", second); err != nil {
+		t.Fatalf("persistSavedSearch() overwrite error = %v", err)
+	}
+	cfg, err := config.Load(cfgPath, config.Default("/tmp/default.db"))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	searches := toTUIRuntimeConfig(cfg).SavedSearches
+	if len(searches) != 1 {
+		t.Fatalf("expected one saved search after overwrite, got %#v", searches)
+	}
+	got := searches["login"]
+	// The overwrite replaces the whole entry, so cross_project from the first save must not linger.
+	if got.CrossProject || got.Query != "login" || !got.DueBefore.Equal(dueBefore) || !got.DueAfter.IsZero() {
+		t.Fatalf("unexpected saved search %#v", got)
+	}
+	if !slices.Equal(got.States, []string{"progress", "todo"}) || !slices.Equal(got.Levels, []string{"task"}) {
+		t.Fatalf("unexpected saved search filters %#v", got)
+	}
+	if err := persistSavedSearch(cfgPath, "bad", tui.SavedSearch{States: []string{"someday"}}); err == nil {
+		t.Fatal("expected unknown search state to be rejected")
+	}
+}
+
 // TestRuntimeLoggerCanMuteConsoleSink verifies console output can be suppressed while other sinks remain active.
 func TestRuntimeLoggerCanMuteConsoleSink(t *testing.T) {
 	var console bytes.Buffer
@@ -1401,6 +2838,125 @@ func TestRuntimeLoggerCanMuteConsoleSink(t *testing.T) {
 	}
 }
 
+// TestRuntimeLoggerJSONConsoleFormat verifies logging.format=json emits JSON console lines, including bridged package logs, while the dev file stays logfmt.
+func TestRuntimeLoggerJSONConsoleFormat(t *testing.T) {
+	var console bytes.Buffer
+	cfg := config.Default("/tmp/tillsyn.db").Logging
+	cfg.Format = "json"
+	cfg.DevFile.Enabled = true
+	cfg.DevFile.Dir = t.TempDir()
+
+	logger, err := newRuntimeLogger(&console, "till", true, cfg, func() time.Time {
+		return time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	})
+	if err != nil {
+		t.Fatalf("newRuntimeLogger() error = %v", err)
+	}
+	t.Cleanup(func() {
+		logger.RestoreDefault()
+		if closeErr := logger.Close(); closeErr != nil {
+			t.Errorf("Close() error = %v", closeErr)
+		}
+	})
+	logger.InstallAsDefault("till")
+
+	logger.Info("runtime probe", "command", "serve")
+	charmLog.Warn("package probe", "transport", "mcp")
+
+	lines := strings.Split(strings.TrimSpace(console.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected two console lines, got %q", console.String())
+	}
+	want := []map[string]string{
+		{"msg": "runtime probe", "level": "info", "command": "serve", "prefix": "till"},
+		{"msg": "package probe", "level": "warn", "transport": "mcp", "prefix": "till"},
+	}
+	for i, line := range lines {
+		var record map[string]any
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("console line %d is not JSON: %q (%v)", i, line, err)
+		}
+		for key, value := range want[i] {
+			if record[key] != value {
+				t.Fatalf("console line %d %s = %#v, want %q (record %#v)", i, key, record[key], value, record)
+			}
+		}
+	}
+
+	// The dev file keeps the logfmt format regardless of the console choice.
+	content, err := os.ReadFile(logger.DevLogPath())
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if got := string(content); !strings.Contains(got, `msg="package probe"`) || strings.Contains(got, "{") {
+		t.Fatalf("expected logfmt dev log content, got %q", got)
+	}
+
+	cfg.Format = "yaml"
+	if _, err := newRuntimeLogger(&console, "till", false, cfg, time.Now); err == nil || !strings.Contains(err.Error(), "want text, logfmt, or json") {
+		t.Fatalf("expected unsupported format error, got %v", err)
+	}
+}
+
+// TestRotatingLogFileRotatesAndPrunes verifies size-based rollover to -NNN files, backup pruning, and reopening the newest file.
+func TestRotatingLogFileRotatesAndPrunes(t *testing.T) {
+	dir := t.TempDir()
+	base := filepath.Join(dir, "till-20260302.log")
+	unrelated := filepath.Join(dir, "till-20260301.log")
+	if err := os.WriteFile(unrelated, []byte("yesterday\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	writer, err := openRotatingLogFile(base, 10, 2)
+	if err != nil {
+		t.Fatalf("openRotatingLogFile() error = %v", err)
+	}
+	// Each 6-byte line overflows the 10-byte limit on the second write, so every line lands in its own file.
+	for i := range 5 {
+		if _, err := writer.Write([]byte(fmt.Sprintf("line%d\n", i))); err != nil {
+			t.Fatalf("Write(%d) error = %v", i, err)
+		}
+	}
+	if got, want := writer.Path(), filepath.Join(dir, "till-20260302-004.log"); got != want {
+		t.Fatalf("active path = %q, want %q", got, want)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("ReadDir() error = %v", err)
+	}
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	want := []string{"till-20260301.log", "till-20260302-002.log", "till-20260302-003.log", "till-20260302-004.log"}
+	if !slices.Equal(names, want) {
+		t.Fatalf("log files = %v, want %v", names, want)
+	}
+
+	// Reopening continues the newest file rather than the unsuffixed base.
+	writer, err = openRotatingLogFile(base, 0, 2)
+	if err != nil {
+		t.Fatalf("openRotatingLogFile(reopen) error = %v", err)
+	}
+	if _, err := writer.Write([]byte("more\n")); err != nil {
+		t.Fatalf("Write(reopen) error = %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Close(reopen) error = %v", err)
+	}
+	content, err := os.ReadFile(filepath.Join(dir, "till-20260302-004.log"))
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if got := string(content); got != "line4\nmore\n" {
+		t.Fatalf("newest log content = %q, want appended line", got)
+	}
+}
+
 // TestRuntimeLoggerInstallAsDefaultRoutesPackageLogsToFile verifies package-level charm/log output reaches the runtime file sink.
 func TestRuntimeLoggerInstallAsDefaultRoutesPackageLogsToFile(t *testing.T) {
 	var console bytes.Buffer
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"os"
+	"path/filepath"
+	"runtime"
+	runtimepprof "runtime/pprof"
+	"strings"
+	"time"
+)
+
+// profilingOptions stores opt-in profiling flag values.
+type profilingOptions struct {
+	pprofAddr  string
+	cpuProfile string
+	memProfile string
+}
+
+// enabled reports whether any profiling output was requested.
+func (o profilingOptions) enabled() bool {
+	return strings.TrimSpace(o.pprofAddr) != "" || strings.TrimSpace(o.cpuProfile) != "" || strings.TrimSpace(o.memProfile) != ""
+}
+
+// profiler owns running profiling sinks for one CLI invocation.
+type profiler struct {
+	opts      profilingOptions
+	server    *http.Server
+	addr      string
+	cpuFile   *os.File
+	serveDone chan struct{}
+}
+
+// startProfiling starts requested profiling sinks; pprof binds outside loopback only in dev mode.
+func startProfiling(opts profilingOptions, devMode bool) (*profiler, error) {
+	p := &profiler{opts: opts}
+	if addr := strings.TrimSpace(opts.pprofAddr); addr != "" {
+		if !devMode && !isLoopbackBind(addr) {
+			return nil, fmt.Errorf("--pprof %q: non-loopback binds require dev mode", addr)
+		}
+		listener, err := net.Listen("tcp", addr)
+		if err != nil {
+			return nil, fmt.Errorf("listen pprof %q: %w", addr, err)
+		}
+		mux := http.NewServeMux()
+		mux.HandleFunc("/debug/pprof/", pprof.Index)
+		mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+		mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+		mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+		mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+		p.server = &http.Server{Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+		p.addr = listener.Addr().String()
+		p.serveDone = make(chan struct{})
+		go func() {
+			defer close(p.serveDone)
+			_ = p.server.Serve(listener)
+		}()
+	}
+	if path := strings.TrimSpace(opts.cpuProfile); path != "" {
+		file, err := createProfileFile(path)
+		if err != nil {
+			_ = p.stop()
+			return nil, fmt.Errorf("create cpu profile: %w", err)
+		}
+		if err := runtimepprof.StartCPUProfile(file); err != nil {
+			_ = file.Close()
+			_ = p.stop()
+			return nil, fmt.Errorf("start cpu profile: %w", err)
+		}
+		p.cpuFile = file
+	}
+	return p, nil
+}
+
+// stop flushes profiles and shuts down the pprof listener.
+func (p *profiler) stop() error {
+	if p == nil {
+		return nil
+	}
+	var errs []error
+	if p.cpuFile != nil {
+		runtimepprof.StopCPUProfile()
+		if err := p.cpuFile.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("close cpu profile: %w", err))
+		}
+		p.cpuFile = nil
+	}
+	if path := strings.TrimSpace(p.opts.memProfile); path != "" {
+		if err := writeHeapProfile(path); err != nil {
+			errs = append(errs, err)
+		}
+		p.opts.memProfile = ""
+	}
+	if p.server != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		if err := p.server.Shutdown(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("shutdown pprof server: %w", err))
+		}
+		<-p.serveDone
+		p.server = nil
+	}
+	return errors.Join(errs...)
+}
+
+// writeHeapProfile writes a post-GC heap profile so it reflects live allocations.
+func writeHeapProfile(path string) error {
+	file, err := createProfileFile(path)
+	if err != nil {
+		return fmt.Errorf("create mem profile: %w", err)
+	}
+	runtime.GC()
+	if err := runtimepprof.WriteHeapProfile(file); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("write mem profile: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("close mem profile: %w", err)
+	}
+	return nil
+}
+
+// createProfileFile creates one profile output file and its parent directory.
+func createProfileFile(path string) (*os.File, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+	return os.Create(path)
+}
+
+// isLoopbackBind reports whether a listen address only accepts local connections.
+func isLoopbackBind(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
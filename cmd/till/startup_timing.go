@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// startupPhase stores one measured startup step.
+type startupPhase struct {
+	name     string
+	duration time.Duration
+}
+
+// startupTimer records sequential startup phase durations.
+type startupTimer struct {
+	now     func() time.Time
+	started time.Time
+	last    time.Time
+	phases  []startupPhase
+}
+
+// newStartupTimer starts timing at the current instant.
+func newStartupTimer(now func() time.Time) *startupTimer {
+	if now == nil {
+		now = time.Now
+	}
+	started := now()
+	return &startupTimer{now: now, started: started, last: started}
+}
+
+// mark closes the current phase under name and starts the next one.
+func (t *startupTimer) mark(name string) time.Duration {
+	at := t.now()
+	duration := at.Sub(t.last)
+	t.last = at
+	t.phases = append(t.phases, startupPhase{name: name, duration: duration})
+	return duration
+}
+
+// total returns elapsed time from timer start to the last mark.
+func (t *startupTimer) total() time.Duration {
+	return t.last.Sub(t.started)
+}
+
+// logPhases emits every recorded phase at debug level through the runtime logger.
+func (t *startupTimer) logPhases(logger *runtimeLogger) {
+	for _, phase := range t.phases {
+		logger.Debug("startup phase timing", "phase", phase.name, "duration", phase.duration)
+	}
+	logger.Debug("startup timing total", "duration", t.total())
+}
+
+// writeSummary renders an aligned per-phase timing table for --timing output.
+func (t *startupTimer) writeSummary(w io.Writer) error {
+	width := len("total")
+	for _, phase := range t.phases {
+		width = max(width, len(phase.name))
+	}
+	var out strings.Builder
+	out.WriteString("startup timing:\n")
+	for _, phase := range t.phases {
+		fmt.Fprintf(&out, "  %-*s  %s\n", width, phase.name, formatPhaseDuration(phase.duration))
+	}
+	fmt.Fprintf(&out, "  %-*s  %s\n", width, "total", formatPhaseDuration(t.total()))
+	if _, err := io.WriteString(w, out.String()); err != nil {
+		return fmt.Errorf("write startup timing: %w", err)
+	}
+	return nil
+}
+
+// formatPhaseDuration renders durations in milliseconds with sub-millisecond precision.
+func formatPhaseDuration(d time.Duration) string {
+	return fmt.Sprintf("%.1fms", float64(d)/float64(time.Millisecond))
+}
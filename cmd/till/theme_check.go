@@ -0,0 +1,81 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"charm.land/lipgloss/v2/table"
+	"github.com/hylla/tillsyn/internal/domain"
+	"github.com/hylla/tillsyn/internal/tui"
+)
+
+// errContrastWarnings reports that theme check --strict found low-contrast roles.
+var errContrastWarnings = errors.New("low-contrast theme colors found")
+
+// runThemeCheck evaluates the board theme the effective config resolves against a terminal background and prints a pass/warn table.
+func runThemeCheck(stdout io.Writer, rootOpts rootCommandOptions, background string, strict bool) error {
+	background = strings.TrimSpace(background)
+	if !domain.ValidColor(background) {
+		return fmt.Errorf("--bg %q must be an ANSI index 0-255 or #RRGGBB", background)
+	}
+	theme, configPath, err := loadEffectiveTheme(rootOpts)
+	if err != nil {
+		return err
+	}
+	roles := theme.Roles()
+
+	t := table.New().
+		Border(lipgloss.RoundedBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(lipgloss.Color(theme.Accent))).
+		Headers("Role", "FG", "Sample", "Ratio", "Result").
+		StyleFunc(func(row, _ int) lipgloss.Style {
+			if row == table.HeaderRow {
+				return lipgloss.NewStyle().Bold(true)
+			}
+			return lipgloss.NewStyle()
+		})
+	warnings := 0
+	for _, role := range roles {
+		ratio, err := tui.ContrastRatio(role.Color, background)
+		if err != nil {
+			return fmt.Errorf("%s: %w", role.Name, err)
+		}
+		verdict := contrastVerdict(ratio)
+		if verdict != "PASS" {
+			warnings++
+		}
+		sample := lipgloss.NewStyle().
+			Foreground(lipgloss.Color(role.Color)).
+			Background(lipgloss.Color(background)).
+			Render(" Sample ")
+		t.Row(role.Name, role.Color, sample, fmt.Sprintf("%.2f:1", ratio), verdict)
+	}
+	lines := []string{
+		fmt.Sprintf("Theme from %s", configPath),
+		fmt.Sprintf("Contrast against background %s (AA normal %.1f:1, large %.1f:1)", background, tui.ContrastAANormal, tui.ContrastAALarge),
+		t.Render(),
+		fmt.Sprintf("%d of %d roles below AA normal-text contrast", warnings, len(roles)),
+	}
+	if _, err := fmt.Fprintln(stdout, strings.Join(lines, "\n")); err != nil {
+		return fmt.Errorf("write theme check output: %w", err)
+	}
+	if strict && warnings > 0 {
+		return errContrastWarnings
+	}
+	return nil
+}
+
+// contrastVerdict classifies one ratio against WCAG AA thresholds.
+func contrastVerdict(ratio float64) string {
+	switch {
+	case ratio >= tui.ContrastAANormal:
+		return "PASS"
+	case ratio >= tui.ContrastAALarge:
+		return "WARN (large/bold only)"
+	default:
+		return "WARN"
+	}
+}
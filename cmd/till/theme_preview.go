@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/hylla/tillsyn/internal/config"
+	"github.com/hylla/tillsyn/internal/platform"
+	"github.com/hylla/tillsyn/internal/tui"
+)
+
+// Theme preview widths bound the sample board so three columns always fit; the height fits every sample card.
+const (
+	defaultThemePreviewWidth = 108
+	minThemePreviewWidth     = 72
+	maxThemePreviewWidth     = 140
+	themePreviewHeight       = 24
+)
+
+// runThemePreview loads the effective config and renders the TUI board with the theme it resolves.
+func runThemePreview(stdout io.Writer, rootOpts rootCommandOptions, width int) error {
+	cfg, configPath, err := loadEffectiveConfig(rootOpts)
+	if err != nil {
+		return err
+	}
+	width = min(max(width, minThemePreviewWidth), maxThemePreviewWidth)
+	runtimeCfg := toTUIRuntimeConfig(cfg)
+	theme := tui.ResolveTheme(runtimeCfg.UI.HighlightColor, runtimeCfg.Labels.Colors)
+	board, modal := tui.RenderThemePreview(runtimeCfg, width, themePreviewHeight)
+	title := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(theme.Accent)).Render("till theme preview")
+	subtitle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(theme.Dim)).
+		Render(fmt.Sprintf("config=%s  highlight=%s  width=%d", configPath, theme.Highlight, width))
+	frames := []string{title, subtitle, trimBlankRows(board), trimBlankRows(modal)}
+	if _, err := fmt.Fprintln(stdout, strings.Join(frames, "\n\n")); err != nil {
+		return fmt.Errorf("write theme preview: %w", err)
+	}
+	return nil
+}
+
+// loadEffectiveTheme resolves the config file till would load and returns the board theme it yields.
+// A missing file yields the built-in theme.
+func loadEffectiveTheme(rootOpts rootCommandOptions) (tui.Theme, string, error) {
+	cfg, configPath, err := loadEffectiveConfig(rootOpts)
+	if err != nil {
+		return tui.Theme{}, "", err
+	}
+	return tui.ResolveTheme(cfg.UI.HighlightColor, cfg.Labels.Colors), configPath, nil
+}
+
+// loadEffectiveConfig loads the config file till would load, resolved through the root flags and environment.
+func loadEffectiveConfig(rootOpts rootCommandOptions) (config.Config, string, error) {
+	paths, err := platform.DefaultPathsWithOptions(platform.Options{
+		AppName: rootOpts.appName,
+		DevMode: rootOpts.devMode,
+		Home:    rootOpts.homeDir,
+	})
+	if err != nil {
+		return config.Config{}, "", err
+	}
+	configPath := resolveConfigPath(rootOpts, paths)
+	dbPath, _ := resolveDBPath(rootOpts, paths)
+	cfg, err := config.Load(configPath, config.Default(dbPath))
+	if err != nil {
+		return config.Config{}, "", fmt.Errorf("load config %q: %w", configPath, err)
+	}
+	return cfg, configPath, nil
+}
+
+// trimBlankRows drops the blank rows a full-screen frame pads above and below its content.
+func trimBlankRows(frame string) string {
+	rows := strings.Split(frame, "\n")
+	isBlank := func(row string) bool { return strings.TrimSpace(ansi.Strip(row)) == "" }
+	for len(rows) > 0 && isBlank(rows[0]) {
+		rows = rows[1:]
+	}
+	for len(rows) > 0 && isBlank(rows[len(rows)-1]) {
+		rows = rows[:len(rows)-1]
+	}
+	return strings.Join(rows, "\n")
+}
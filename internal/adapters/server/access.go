@@ -0,0 +1,174 @@
+package server
+
+import (
+	"container/list"
+	"crypto/subtle"
+	"encoding/json"
+	"math"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/hylla/tillsyn/internal/adapters/server/httpapi"
+)
+
+// rateLimiterMaxClients caps how many client buckets the limiter keeps; past it the least recently seen client is dropped.
+const rateLimiterMaxClients = 4096
+
+// ipv6ClientPrefixBits is the IPv6 prefix length one client key covers, since one host usually owns a whole /64.
+const ipv6ClientPrefixBits = 64
+
+// protect wraps one API or MCP handler with the configured rate limit and bearer-token check.
+// Rate limiting runs first so clients guessing tokens are throttled too.
+func protect(next http.Handler, cfg Config, limiter *rateLimiter) http.Handler {
+	if cfg.APIToken != "" {
+		next = requireBearerToken(next, cfg.APIToken)
+	}
+	if limiter != nil {
+		next = limiter.wrap(next)
+	}
+	return next
+}
+
+// requireBearerToken rejects requests whose Authorization header does not carry token as a bearer credential.
+func requireBearerToken(next http.Handler, token string) http.Handler {
+	want := []byte(token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		scheme, credential, _ := strings.Cut(strings.TrimSpace(r.Header.Get("Authorization")), " ")
+		got := []byte(strings.TrimSpace(credential))
+		// The comparison runs in constant time so response timing does not leak how much of a guess matched.
+		if !strings.EqualFold(scheme, "Bearer") || subtle.ConstantTimeCompare(got, want) != 1 {
+			log.Warn("api request rejected", "reason", "unauthorized", "remote", clientIP(r), "path", r.URL.Path)
+			w.Header().Set("WWW-Authenticate", `Bearer realm="tillsyn"`)
+			writeAccessError(w, http.StatusUnauthorized, httpapi.APIError{
+				Code:    "unauthorized",
+				Message: "missing or invalid bearer token",
+				Hint:    "Send the server's API token as `Authorization: Bearer <token>`.",
+			})
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// rateLimiter is a per-client token bucket: each client earns rate tokens per second up to burst, and each request
+// spends one. Buckets are kept in least-recently-seen order so the oldest can be dropped in constant time.
+type rateLimiter struct {
+	mu         sync.Mutex
+	rate       float64
+	burst      float64
+	maxClients int
+	now        func() time.Time
+	buckets    map[string]*list.Element
+	recency    *list.List
+}
+
+// tokenBucket is the remaining allowance of one client as of last.
+type tokenBucket struct {
+	key    string
+	tokens float64
+	last   time.Time
+}
+
+// newRateLimiter builds a limiter allowing rate requests per second with the given burst, or returns nil when rate is not positive.
+// A burst below one defaults to the rate rounded up, so one second's worth of requests may arrive at once.
+func newRateLimiter(rate float64, burst int, now func() time.Time) *rateLimiter {
+	if rate <= 0 {
+		return nil
+	}
+	if burst < 1 {
+		burst = max(1, int(math.Ceil(rate)))
+	}
+	if now == nil {
+		now = time.Now
+	}
+	return &rateLimiter{
+		rate:       rate,
+		burst:      float64(burst),
+		maxClients: rateLimiterMaxClients,
+		now:        now,
+		buckets:    map[string]*list.Element{},
+		recency:    list.New(),
+	}
+}
+
+// allow spends one token for key, or reports how long until one is available.
+func (l *rateLimiter) allow(key string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	elem, ok := l.buckets[key]
+	if ok {
+		l.recency.MoveToFront(elem)
+	} else {
+		// The cap bounds memory however many addresses clients rotate through.
+		if l.recency.Len() >= l.maxClients {
+			oldest := l.recency.Back()
+			l.recency.Remove(oldest)
+			delete(l.buckets, oldest.Value.(*tokenBucket).key)
+		}
+		elem = l.recency.PushFront(&tokenBucket{key: key, tokens: l.burst, last: now})
+		l.buckets[key] = elem
+	}
+	bucket := elem.Value.(*tokenBucket)
+	bucket.tokens = min(l.burst, bucket.tokens+now.Sub(bucket.last).Seconds()*l.rate)
+	bucket.last = now
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		return true, 0
+	}
+	wait := time.Duration((1 - bucket.tokens) / l.rate * float64(time.Second))
+	return false, wait
+}
+
+// wrap rejects requests over the client's allowance with 429 and a Retry-After hint.
+func (l *rateLimiter) wrap(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip := clientIP(r)
+		ok, wait := l.allow(clientKey(ip))
+		if !ok {
+			retryAfter := max(1, int(math.Ceil(wait.Seconds())))
+			log.Warn("api request rejected", "reason", "rate_limited", "remote", ip, "path", r.URL.Path)
+			w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+			writeAccessError(w, http.StatusTooManyRequests, httpapi.APIError{
+				Code:    "rate_limited",
+				Message: "too many requests from this client",
+				Hint:    "Retry after " + strconv.Itoa(retryAfter) + "s.",
+			})
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// clientIP returns the host part of the request's remote address. Forwarding headers are ignored because any
+// client can set them; behind a reverse proxy every request therefore shares the proxy's bucket.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// clientKey maps one client IP to its rate-limit bucket key: IPv4 addresses stand alone, while IPv6 addresses share
+// their /64 so a client cannot mint fresh buckets by rotating through its own prefix.
+func clientKey(ip string) string {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil || !addr.Is6() || addr.Is4In6() {
+		return ip
+	}
+	return netip.PrefixFrom(addr.WithZone(""), ipv6ClientPrefixBits).Masked().String()
+}
+
+// writeAccessError writes one error in the HTTP API's error envelope.
+func writeAccessError(w http.ResponseWriter, statusCode int, apiErr httpapi.APIError) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(httpapi.ErrorEnvelope{Error: apiErr})
+}
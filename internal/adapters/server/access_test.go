@@ -0,0 +1,192 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hylla/tillsyn/internal/adapters/server/httpapi"
+)
+
+// okHandler answers every request with 200 so tests can see which requests got through.
+var okHandler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusOK)
+})
+
+// serveFrom sends one GET through handler from remoteAddr with the given Authorization header.
+func serveFrom(handler http.Handler, remoteAddr, authorization string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/projects", nil)
+	req.RemoteAddr = remoteAddr
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+// decodeAccessError reads the error code from one rejected response.
+func decodeAccessError(t *testing.T, rec *httptest.ResponseRecorder) string {
+	t.Helper()
+	var envelope httpapi.ErrorEnvelope
+	if err := json.Unmarshal(rec.Body.Bytes(), &envelope); err != nil {
+		t.Fatalf("decode error envelope %q: %v", rec.Body.String(), err)
+	}
+	return envelope.Error.Code
+}
+
+// TestProtectRequiresBearerToken verifies requests without the configured token are rejected with 401.
+func TestProtectRequiresBearerToken(t *testing.T) {
+	handler := protect(okHandler, Config{APIToken: "s3cret"}, nil)
+
+	tests := []struct {
+		name          string
+		authorization string
+		want          int
+	}{
+		{name: "missing header", want: http.StatusUnauthorized},
+		{name: "wrong token", authorization: "Bearer nope", want: http.StatusUnauthorized},
+		{name: "wrong scheme", authorization: "Basic s3cret", want: http.StatusUnauthorized},
+		{name: "prefix of token", authorization: "Bearer s3c", want: http.StatusUnauthorized},
+		{name: "valid token", authorization: "Bearer s3cret", want: http.StatusOK},
+		{name: "scheme is case-insensitive", authorization: "bearer s3cret", want: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serveFrom(handler, "192.0.2.1:4000", tt.authorization)
+			if rec.Code != tt.want {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.want)
+			}
+			if tt.want != http.StatusUnauthorized {
+				return
+			}
+			if code := decodeAccessError(t, rec); code != "unauthorized" {
+				t.Fatalf("error code = %q, want unauthorized", code)
+			}
+			if rec.Header().Get("WWW-Authenticate") == "" {
+				t.Fatal("expected WWW-Authenticate header on 401")
+			}
+		})
+	}
+
+	// Without a configured token the handler is passed through untouched.
+	if rec := serveFrom(protect(okHandler, Config{}, nil), "192.0.2.1:4000", ""); rec.Code != http.StatusOK {
+		t.Fatalf("unprotected status = %d, want 200", rec.Code)
+	}
+}
+
+// TestRateLimiterThrottlesPerClientIP verifies each IP gets its own burst, refills over time, and sees 429 beyond it.
+func TestRateLimiterThrottlesPerClientIP(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	limiter := newRateLimiter(1, 2, func() time.Time { return now })
+	handler := protect(okHandler, Config{}, limiter)
+
+	for i := range 2 {
+		if rec := serveFrom(handler, "192.0.2.1:4000", ""); rec.Code != http.StatusOK {
+			t.Fatalf("burst request %d status = %d, want 200", i, rec.Code)
+		}
+	}
+	rec := serveFrom(handler, "192.0.2.1:4001", "")
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("over-limit status = %d, want 429", rec.Code)
+	}
+	if code := decodeAccessError(t, rec); code != "rate_limited" {
+		t.Fatalf("error code = %q, want rate_limited", code)
+	}
+	if got := rec.Header().Get("Retry-After"); got != "1" {
+		t.Fatalf("Retry-After = %q, want 1", got)
+	}
+
+	// Another client keeps its own allowance.
+	if rec := serveFrom(handler, "198.51.100.7:5000", ""); rec.Code != http.StatusOK {
+		t.Fatalf("second client status = %d, want 200", rec.Code)
+	}
+
+	// One second refills one token at a rate of one per second.
+	now = now.Add(time.Second)
+	if rec := serveFrom(handler, "192.0.2.1:4000", ""); rec.Code != http.StatusOK {
+		t.Fatalf("refilled status = %d, want 200", rec.Code)
+	}
+	if rec := serveFrom(handler, "192.0.2.1:4000", ""); rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("post-refill status = %d, want 429", rec.Code)
+	}
+}
+
+// TestRateLimiterCapsClients verifies the limiter keeps at most maxClients buckets by dropping the least recently seen.
+func TestRateLimiterCapsClients(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	limiter := newRateLimiter(1, 1, func() time.Time { return now })
+	limiter.maxClients = 2
+
+	limiter.allow("a")
+	limiter.allow("b")
+	// Touching a makes b the oldest, so c evicts b rather than a.
+	limiter.allow("a")
+	limiter.allow("c")
+	if len(limiter.buckets) != 2 || limiter.recency.Len() != 2 {
+		t.Fatalf("expected 2 buckets, got %d (recency %d)", len(limiter.buckets), limiter.recency.Len())
+	}
+	if _, ok := limiter.buckets["b"]; ok {
+		t.Fatal("expected least recently seen client b evicted")
+	}
+	if ok, _ := limiter.allow("a"); ok {
+		t.Fatal("expected a to keep its spent bucket")
+	}
+}
+
+// TestClientKeyGroupsIPv6Prefix verifies IPv6 clients share one bucket per /64 while IPv4 clients stay separate.
+func TestClientKeyGroupsIPv6Prefix(t *testing.T) {
+	tests := []struct {
+		ip   string
+		want string
+	}{
+		{ip: "192.0.2.1", want: "192.0.2.1"},
+		{ip: "2001:db8:1:2:aaaa::1", want: "2001:db8:1:2::/64"},
+		{ip: "2001:db8:1:2:ffff::9", want: "2001:db8:1:2::/64"},
+		{ip: "fe80::1%eth0", want: "fe80::/64"},
+		{ip: "::ffff:192.0.2.1", want: "::ffff:192.0.2.1"},
+		{ip: "not-an-ip", want: "not-an-ip"},
+	}
+	for _, tt := range tests {
+		if got := clientKey(tt.ip); got != tt.want {
+			t.Fatalf("clientKey(%q) = %q, want %q", tt.ip, got, tt.want)
+		}
+	}
+
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	handler := protect(okHandler, Config{}, newRateLimiter(1, 1, func() time.Time { return now }))
+	if rec := serveFrom(handler, "[2001:db8:1:2::1]:4000", ""); rec.Code != http.StatusOK {
+		t.Fatalf("first IPv6 status = %d, want 200", rec.Code)
+	}
+	if rec := serveFrom(handler, "[2001:db8:1:2::2]:4000", ""); rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("same /64 status = %d, want 429", rec.Code)
+	}
+}
+
+// TestRateLimiterRunsBeforeAuth verifies token guesses count against the client's allowance.
+func TestRateLimiterRunsBeforeAuth(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	handler := protect(okHandler, Config{APIToken: "s3cret"}, newRateLimiter(1, 1, func() time.Time { return now }))
+
+	if rec := serveFrom(handler, "192.0.2.1:4000", "Bearer guess"); rec.Code != http.StatusUnauthorized {
+		t.Fatalf("first guess status = %d, want 401", rec.Code)
+	}
+	if rec := serveFrom(handler, "192.0.2.1:4000", "Bearer s3cret"); rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("status after spent burst = %d, want 429", rec.Code)
+	}
+}
+
+// TestNewRateLimiterDefaults verifies a disabled rate yields no limiter and a zero burst follows the rate.
+func TestNewRateLimiterDefaults(t *testing.T) {
+	if limiter := newRateLimiter(0, 5, nil); limiter != nil {
+		t.Fatal("expected zero rate to disable limiting")
+	}
+	if limiter := newRateLimiter(2.5, 0, nil); limiter.burst != 3 {
+		t.Fatalf("default burst = %v, want 3", limiter.burst)
+	}
+	if limiter := newRateLimiter(0.2, 0, nil); limiter.burst != 1 {
+		t.Fatalf("default burst for slow rate = %v, want 1", limiter.burst)
+	}
+}
@@ -17,6 +17,15 @@ import (
 // AppServiceAdapter maps transport contracts onto app.Service capture_state and attention APIs.
 type AppServiceAdapter struct {
 	service *app.Service
+	labels  LabelPolicy
+}
+
+// LabelPolicy restricts the labels of tasks created or updated through transports to a configured allowlist.
+type LabelPolicy struct {
+	// Enforce rejects labels missing from the project's allowlist; when false any label is accepted.
+	Enforce bool
+	// Allowed returns the allowed labels for one project slug, global labels included.
+	Allowed func(projectSlug string) []string
 }
 
 // NewAppServiceAdapter builds one common adapter over an app.Service instance.
@@ -24,6 +33,12 @@ func NewAppServiceAdapter(service *app.Service) *AppServiceAdapter {
 	return &AppServiceAdapter{service: service}
 }
 
+// WithLabelPolicy sets the label allowlist app.Service checks when tasks are created or updated and returns the adapter.
+func (a *AppServiceAdapter) WithLabelPolicy(policy LabelPolicy) *AppServiceAdapter {
+	a.labels = policy
+	return a
+}
+
 // CaptureState resolves one summary-first capture_state snapshot through app-level APIs.
 func (a *AppServiceAdapter) CaptureState(ctx context.Context, in CaptureStateRequest) (CaptureState, error) {
 	if a == nil || a.service == nil {
@@ -498,46 +513,25 @@ func mapAppError(operation string, err error) error {
 	if err == nil {
 		return nil
 	}
-
-	switch {
-	case errors.Is(err, ErrBootstrapRequired):
+	if errors.Is(err, ErrBootstrapRequired) {
 		return fmt.Errorf("%s: %w", operation, errors.Join(ErrBootstrapRequired, err))
-	case errors.Is(err, app.ErrNotFound):
+	}
+	// Completion-contract blocks keep their historical guardrail classification on the wire.
+	if errors.Is(err, domain.ErrTransitionBlocked) {
+		return fmt.Errorf("%s: %w", operation, errors.Join(ErrGuardrailViolation, err))
+	}
+
+	switch app.ErrorCodeOf(err) {
+	case app.ErrorCodeNotFound:
 		return fmt.Errorf("%s: %w", operation, errors.Join(ErrNotFound, err))
-	case errors.Is(err, domain.ErrMutationLeaseRequired),
-		errors.Is(err, domain.ErrMutationLeaseInvalid),
-		errors.Is(err, domain.ErrMutationLeaseExpired),
-		errors.Is(err, domain.ErrMutationLeaseRevoked),
-		errors.Is(err, domain.ErrOrchestratorOverlap),
-		errors.Is(err, domain.ErrOverrideTokenRequired),
-		errors.Is(err, domain.ErrOverrideTokenInvalid),
-		errors.Is(err, domain.ErrTransitionBlocked):
+	case app.ErrorCodePermission:
 		return fmt.Errorf("%s: %w", operation, errors.Join(ErrGuardrailViolation, err))
-	case errors.Is(err, domain.ErrInvalidID),
-		errors.Is(err, domain.ErrInvalidScopeType),
-		errors.Is(err, domain.ErrInvalidScopeID),
-		errors.Is(err, domain.ErrInvalidSummary),
-		errors.Is(err, domain.ErrInvalidBodyMarkdown),
-		errors.Is(err, domain.ErrInvalidAttentionState),
-		errors.Is(err, domain.ErrInvalidAttentionKind),
-		errors.Is(err, domain.ErrInvalidActorType),
-		errors.Is(err, domain.ErrInvalidName),
-		errors.Is(err, domain.ErrInvalidTitle),
-		errors.Is(err, domain.ErrInvalidParentID),
-		errors.Is(err, domain.ErrInvalidColumnID),
-		errors.Is(err, domain.ErrInvalidPriority),
-		errors.Is(err, domain.ErrInvalidLifecycleState),
-		errors.Is(err, domain.ErrInvalidKind),
-		errors.Is(err, domain.ErrInvalidKindID),
-		errors.Is(err, domain.ErrInvalidKindAppliesTo),
-		errors.Is(err, domain.ErrInvalidKindTemplate),
-		errors.Is(err, domain.ErrInvalidKindPayload),
-		errors.Is(err, domain.ErrInvalidKindPayloadSchema),
-		errors.Is(err, domain.ErrKindNotAllowed),
-		errors.Is(err, app.ErrInvalidDeleteMode):
+	case app.ErrorCodeConflict:
+		return fmt.Errorf("%s: %w", operation, errors.Join(ErrConflict, err))
+	case app.ErrorCodeWIPLimit:
+		return fmt.Errorf("%s: %w", operation, errors.Join(ErrWIPLimitExceeded, err))
+	case app.ErrorCodeValidation:
 		return fmt.Errorf("%s: %w", operation, errors.Join(ErrInvalidCaptureStateRequest, err))
-	case errors.Is(err, domain.ErrKindNotFound):
-		return fmt.Errorf("%s: %w", operation, errors.Join(ErrNotFound, err))
 	default:
 		return fmt.Errorf("%s: %w", operation, err)
 	}
@@ -3,6 +3,7 @@ package common
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -121,17 +122,29 @@ func (a *AppServiceAdapter) CreateTask(ctx context.Context, in CreateTaskRequest
 	if err != nil {
 		return domain.Task{}, err
 	}
+	project, err := a.resolveTaskProject(ctx, in.ProjectID, in.ProjectSlug)
+	if err != nil {
+		return domain.Task{}, err
+	}
+	columnID := strings.TrimSpace(in.ColumnID)
+	if columnID == "" {
+		columnID, err = a.firstColumnID(ctx, project)
+		if err != nil {
+			return domain.Task{}, err
+		}
+	}
 	ctx, actorType, err := withMutationGuardContext(ctx, in.Actor)
 	if err != nil {
 		return domain.Task{}, err
 	}
+	ctx = a.withLabelPolicyContext(withDryRunContext(ctx, in.DryRun))
 	actorID, _ := deriveMutationActorIdentity(in.Actor)
 	task, err := a.service.CreateTask(ctx, app.CreateTaskInput{
-		ProjectID:      strings.TrimSpace(in.ProjectID),
+		ProjectID:      project.ID,
 		ParentID:       strings.TrimSpace(in.ParentID),
 		Kind:           domain.WorkKind(strings.TrimSpace(in.Kind)),
 		Scope:          domain.KindAppliesTo(strings.TrimSpace(in.Scope)),
-		ColumnID:       strings.TrimSpace(in.ColumnID),
+		ColumnID:       columnID,
 		Title:          strings.TrimSpace(in.Title),
 		Description:    strings.TrimSpace(in.Description),
 		Priority:       domain.Priority(strings.TrimSpace(strings.ToLower(in.Priority))),
@@ -148,6 +161,65 @@ func (a *AppServiceAdapter) CreateTask(ctx context.Context, in CreateTaskRequest
 	return task, nil
 }
 
+// resolveTaskProject finds the project a new task is filed in by id or, when no id is given, by slug.
+func (a *AppServiceAdapter) resolveTaskProject(ctx context.Context, projectID, projectSlug string) (domain.Project, error) {
+	projectID = strings.TrimSpace(projectID)
+	projectSlug = strings.TrimSpace(strings.ToLower(projectSlug))
+	if projectID != "" {
+		project, err := a.lookupProject(ctx, projectID)
+		if err != nil {
+			return domain.Project{}, err
+		}
+		if projectSlug != "" && project.Slug != projectSlug {
+			return domain.Project{}, fmt.Errorf("project %q has slug %q, not %q: %w", projectID, project.Slug, projectSlug, ErrInvalidCaptureStateRequest)
+		}
+		return project, nil
+	}
+	if projectSlug == "" {
+		return domain.Project{}, fmt.Errorf("project_id or project_slug is required: %w", ErrInvalidCaptureStateRequest)
+	}
+	projects, err := a.service.ListProjects(ctx, false)
+	if err != nil {
+		return domain.Project{}, mapAppError("list projects", err)
+	}
+	matches := slices.DeleteFunc(projects, func(project domain.Project) bool {
+		return project.Slug != projectSlug
+	})
+	switch len(matches) {
+	case 0:
+		return domain.Project{}, fmt.Errorf("project slug %q (see till.list_projects for active slugs): %w", projectSlug, ErrNotFound)
+	case 1:
+		return matches[0], nil
+	default:
+		return domain.Project{}, fmt.Errorf("project slug %q is shared by %d projects; pass project_id instead: %w", projectSlug, len(matches), ErrInvalidCaptureStateRequest)
+	}
+}
+
+// firstColumnID returns the leftmost active column of one project, where tasks land when no column is named.
+func (a *AppServiceAdapter) firstColumnID(ctx context.Context, project domain.Project) (string, error) {
+	columns, err := a.service.ListColumns(ctx, project.ID, false)
+	if err != nil {
+		return "", mapAppError("list columns", err)
+	}
+	if len(columns) == 0 {
+		return "", fmt.Errorf("project %q has no active columns; pass column_id: %w", project.Slug, ErrInvalidCaptureStateRequest)
+	}
+	sortColumns(columns)
+	return columns[0].ID, nil
+}
+
+// withLabelPolicyContext asks app.Service to reject labels outside the project's allowlist when enforcement is on.
+func (a *AppServiceAdapter) withLabelPolicyContext(ctx context.Context) context.Context {
+	if !a.labels.Enforce {
+		return ctx
+	}
+	allowed := a.labels.Allowed
+	if allowed == nil {
+		allowed = func(string) []string { return nil }
+	}
+	return app.WithLabelAllowlist(ctx, allowed)
+}
+
 // UpdateTask updates one task/work-item row.
 func (a *AppServiceAdapter) UpdateTask(ctx context.Context, in UpdateTaskRequest) (domain.Task, error) {
 	if a == nil || a.service == nil {
@@ -161,6 +233,7 @@ func (a *AppServiceAdapter) UpdateTask(ctx context.Context, in UpdateTaskRequest
 	if err != nil {
 		return domain.Task{}, err
 	}
+	ctx = a.withLabelPolicyContext(withDryRunContext(ctx, in.DryRun))
 	actorID, _ := deriveMutationActorIdentity(in.Actor)
 	task, err := a.service.UpdateTask(ctx, app.UpdateTaskInput{
 		TaskID:      strings.TrimSpace(in.TaskID),
@@ -188,13 +261,75 @@ func (a *AppServiceAdapter) MoveTask(ctx context.Context, in MoveTaskRequest) (d
 	if err != nil {
 		return domain.Task{}, err
 	}
-	task, err := a.service.MoveTask(ctx, strings.TrimSpace(in.TaskID), strings.TrimSpace(in.ToColumnID), in.Position)
+	ctx = withDryRunContext(ctx, in.DryRun)
+	if in.EnforceWIPLimit {
+		ctx = app.WithWIPLimitEnforcement(ctx)
+	}
+	taskID := strings.TrimSpace(in.TaskID)
+	toColumnID := strings.TrimSpace(in.ToColumnID)
+	position := in.Position
+	if toColumnID == "" || in.AppendToColumn {
+		task, err := a.service.GetTask(ctx, taskID)
+		if err != nil {
+			return domain.Task{}, mapAppError("move task", err)
+		}
+		if toColumnID == "" {
+			toColumnID, err = a.columnIDByName(ctx, task.ProjectID, in.ToColumnName)
+			if err != nil {
+				return domain.Task{}, err
+			}
+		}
+		if in.AppendToColumn {
+			position, err = a.endOfColumnPosition(ctx, task, toColumnID)
+			if err != nil {
+				return domain.Task{}, err
+			}
+		}
+	}
+	task, err := a.service.MoveTask(ctx, taskID, toColumnID, position)
 	if err != nil {
 		return domain.Task{}, mapAppError("move task", err)
 	}
 	return task, nil
 }
 
+// columnIDByName resolves one active column of a project by name, ignoring case.
+// A miss lists the project's columns so the caller can correct the name.
+func (a *AppServiceAdapter) columnIDByName(ctx context.Context, projectID, name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", fmt.Errorf("to_column_id or to_column is required: %w", ErrInvalidCaptureStateRequest)
+	}
+	columns, err := a.service.ListColumns(ctx, projectID, false)
+	if err != nil {
+		return "", mapAppError("list columns", err)
+	}
+	sortColumns(columns)
+	names := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if strings.EqualFold(strings.TrimSpace(column.Name), name) {
+			return column.ID, nil
+		}
+		names = append(names, column.Name)
+	}
+	return "", fmt.Errorf("column %q is not in the task's project (columns: %s): %w", name, strings.Join(names, ", "), ErrNotFound)
+}
+
+// endOfColumnPosition returns the position just past the last task in the target column, not counting the moved task.
+func (a *AppServiceAdapter) endOfColumnPosition(ctx context.Context, task domain.Task, columnID string) (int, error) {
+	tasks, err := a.service.ListTasks(ctx, task.ProjectID, false)
+	if err != nil {
+		return 0, mapAppError("list tasks", err)
+	}
+	position := 0
+	for _, other := range tasks {
+		if other.ID != task.ID && other.ColumnID == columnID && other.Position >= position {
+			position = other.Position + 1
+		}
+	}
+	return position, nil
+}
+
 // DeleteTask applies archive/hard delete behavior for one task.
 func (a *AppServiceAdapter) DeleteTask(ctx context.Context, in DeleteTaskRequest) error {
 	if a == nil || a.service == nil {
@@ -204,6 +339,7 @@ func (a *AppServiceAdapter) DeleteTask(ctx context.Context, in DeleteTaskRequest
 	if err != nil {
 		return err
 	}
+	ctx = withDryRunContext(ctx, in.DryRun)
 	if err := a.service.DeleteTask(ctx, strings.TrimSpace(in.TaskID), app.DeleteMode(strings.TrimSpace(in.Mode))); err != nil {
 		return mapAppError("delete task", err)
 	}
@@ -219,6 +355,7 @@ func (a *AppServiceAdapter) RestoreTask(ctx context.Context, in RestoreTaskReque
 	if err != nil {
 		return domain.Task{}, err
 	}
+	ctx = withDryRunContext(ctx, in.DryRun)
 	task, err := a.service.RestoreTask(ctx, strings.TrimSpace(in.TaskID))
 	if err != nil {
 		return domain.Task{}, mapAppError("restore task", err)
@@ -235,6 +372,7 @@ func (a *AppServiceAdapter) ReparentTask(ctx context.Context, in ReparentTaskReq
 	if err != nil {
 		return domain.Task{}, err
 	}
+	ctx = withDryRunContext(ctx, in.DryRun)
 	task, err := a.service.ReparentTask(ctx, strings.TrimSpace(in.TaskID), strings.TrimSpace(in.ParentID))
 	if err != nil {
 		return domain.Task{}, mapAppError("reparent task", err)
@@ -259,6 +397,19 @@ func (a *AppServiceAdapter) SearchTasks(ctx context.Context, in SearchTasksReque
 	if a == nil || a.service == nil {
 		return nil, fmt.Errorf("app service adapter is not configured: %w", ErrInvalidCaptureStateRequest)
 	}
+	var bounds [4]time.Time
+	for idx, field := range []struct{ name, raw string }{
+		{"due_after", in.DueAfter},
+		{"due_before", in.DueBefore},
+		{"created_after", in.CreatedAfter},
+		{"created_before", in.CreatedBefore},
+	} {
+		bound, err := parseSearchBound(field.name, field.raw)
+		if err != nil {
+			return nil, err
+		}
+		bounds[idx] = bound
+	}
 	matches, err := a.service.SearchTaskMatches(ctx, app.SearchTasksFilter{
 		ProjectID:       strings.TrimSpace(in.ProjectID),
 		Query:           strings.TrimSpace(in.Query),
@@ -269,6 +420,10 @@ func (a *AppServiceAdapter) SearchTasks(ctx context.Context, in SearchTasksReque
 		Kinds:           append([]string(nil), in.Kinds...),
 		LabelsAny:       append([]string(nil), in.LabelsAny...),
 		LabelsAll:       append([]string(nil), in.LabelsAll...),
+		DueAfter:        bounds[0],
+		DueBefore:       bounds[1],
+		CreatedAfter:    bounds[2],
+		CreatedBefore:   bounds[3],
 		Mode:            app.SearchMode(strings.TrimSpace(in.Mode)),
 		Sort:            app.SearchSort(strings.TrimSpace(in.Sort)),
 		Limit:           in.Limit,
@@ -571,6 +726,27 @@ func parseOptionalRFC3339(raw string) (*time.Time, error) {
 	return &utc, nil
 }
 
+// parseSearchBound parses one optional RFC3339 search bound; blank leaves that side of the window open.
+func parseSearchBound(field, raw string) (time.Time, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return time.Time{}, nil
+	}
+	ts, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s must be RFC3339: %w", field, ErrInvalidCaptureStateRequest)
+	}
+	return ts.UTC(), nil
+}
+
+// withDryRunContext marks the context for non-persisting mutation previews when requested.
+func withDryRunContext(ctx context.Context, dryRun bool) context.Context {
+	if !dryRun {
+		return ctx
+	}
+	return app.WithDryRun(ctx)
+}
+
 // withMutationGuardContext validates actor tuple semantics and optionally attaches lease guard context.
 func withMutationGuardContext(ctx context.Context, actor ActorLeaseTuple) (context.Context, domain.ActorType, error) {
 	if ctx == nil {
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -149,3 +150,124 @@ func TestAppServiceAdapterUpdateTaskAllowsGuardedNonUserAttribution(t *testing.T
 		t.Fatalf("updated updated_by_type = %q, want %q", updated.UpdatedByType, domain.ActorTypeAgent)
 	}
 }
+
+// TestAppServiceAdapterGetBoardState verifies board reads group tasks under columns with dependency metadata and opt-in archived rows.
+func TestAppServiceAdapterGetBoardState(t *testing.T) {
+	adapter, service, project, seed := newActorAttributionAdapterFixture(t)
+	ctx := context.Background()
+
+	blocked, err := service.CreateTask(ctx, app.CreateTaskInput{
+		ProjectID: project.ID,
+		Kind:      domain.WorkKindTask,
+		Scope:     domain.KindAppliesToTask,
+		ColumnID:  seed.ColumnID,
+		Title:     "Blocked Task",
+		Priority:  domain.PriorityHigh,
+		Labels:    []string{"api"},
+		Metadata: domain.TaskMetadata{
+			DependsOn:     []string{seed.ID},
+			BlockedReason: "waiting on seed",
+		},
+		UpdatedByType: domain.ActorTypeUser,
+	})
+	if err != nil {
+		t.Fatalf("CreateTask() error = %v", err)
+	}
+	archived, err := service.CreateTask(ctx, app.CreateTaskInput{
+		ProjectID:     project.ID,
+		Kind:          domain.WorkKindTask,
+		Scope:         domain.KindAppliesToTask,
+		ColumnID:      seed.ColumnID,
+		Title:         "Archived Task",
+		Priority:      domain.PriorityLow,
+		UpdatedByType: domain.ActorTypeUser,
+	})
+	if err != nil {
+		t.Fatalf("CreateTask() error = %v", err)
+	}
+	if err := service.DeleteTask(ctx, archived.ID, app.DeleteModeArchive); err != nil {
+		t.Fatalf("DeleteTask() error = %v", err)
+	}
+
+	state, err := adapter.GetBoardState(ctx, BoardStateRequest{ProjectSlug: project.Slug})
+	if err != nil {
+		t.Fatalf("GetBoardState() error = %v", err)
+	}
+	if state.ProjectID != project.ID || state.ProjectSlug != project.Slug || state.IncludeArchived {
+		t.Fatalf("board header = %#v, want project %q without archived rows", state, project.Slug)
+	}
+	if len(state.Columns) < 2 {
+		t.Fatalf("columns = %d, want the auto-created board columns", len(state.Columns))
+	}
+	for idx := 1; idx < len(state.Columns); idx++ {
+		if state.Columns[idx-1].Position > state.Columns[idx].Position {
+			t.Fatalf("columns out of board order: %#v", state.Columns)
+		}
+	}
+	first := state.Columns[0]
+	if first.ID != seed.ColumnID || len(first.Tasks) != 2 {
+		t.Fatalf("first column = %#v, want the seed and blocked tasks only", first)
+	}
+	got := first.Tasks[1]
+	if got.ID != blocked.ID || got.LifecycleState != domain.StateTodo || got.BlockedReason != "waiting on seed" {
+		t.Fatalf("blocked task row = %#v", got)
+	}
+	if len(got.DependsOn) != 1 || got.DependsOn[0] != seed.ID || len(got.Labels) != 1 || got.Labels[0] != "api" {
+		t.Fatalf("blocked task metadata = %#v, want depends_on [%s] and labels [api]", got, seed.ID)
+	}
+
+	// Archived tasks only appear when the caller asks for them.
+	withArchived, err := adapter.GetBoardState(ctx, BoardStateRequest{ProjectSlug: project.Slug, IncludeArchived: true})
+	if err != nil {
+		t.Fatalf("GetBoardState(include archived) error = %v", err)
+	}
+	if len(withArchived.Columns[0].Tasks) != 3 || withArchived.Columns[0].Tasks[2].ArchivedAt == nil {
+		t.Fatalf("archived board first column = %#v, want the archived task appended", withArchived.Columns[0])
+	}
+
+	if _, err := adapter.GetBoardState(ctx, BoardStateRequest{ProjectSlug: "missing"}); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("GetBoardState(missing) error = %v, want ErrNotFound", err)
+	}
+	if _, err := adapter.GetBoardState(ctx, BoardStateRequest{ProjectSlug: "  "}); !errors.Is(err, ErrInvalidCaptureStateRequest) {
+		t.Fatalf("GetBoardState(blank) error = %v, want ErrInvalidCaptureStateRequest", err)
+	}
+
+	// A slug shared by two projects is rejected with both candidates rather than resolved to either.
+	twin, err := service.CreateProject(ctx, project.Name, "same slug")
+	if err != nil {
+		t.Fatalf("CreateProject(twin) error = %v", err)
+	}
+	if twin.Slug != project.Slug {
+		t.Fatalf("twin slug = %q, want %q", twin.Slug, project.Slug)
+	}
+	_, err = adapter.GetBoardState(ctx, BoardStateRequest{ProjectSlug: project.Slug})
+	if !errors.Is(err, ErrInvalidCaptureStateRequest) || !strings.Contains(err.Error(), project.ID) || !strings.Contains(err.Error(), twin.ID) {
+		t.Fatalf("GetBoardState(ambiguous) error = %v, want both candidate ids", err)
+	}
+}
+
+// TestAppServiceAdapterSearchTasksDateBounds verifies RFC3339 creation bounds reach the app filter and bad bounds are rejected.
+func TestAppServiceAdapterSearchTasksDateBounds(t *testing.T) {
+	adapter, _, project, seed := newActorAttributionAdapterFixture(t)
+	ctx := context.Background()
+
+	// The fixture clock creates every row at 2026-02-24T12:00:00Z.
+	before, err := adapter.SearchTasks(ctx, SearchTasksRequest{ProjectID: project.ID, CreatedBefore: "2026-03-01T00:00:00Z", Mode: "keyword"})
+	if err != nil {
+		t.Fatalf("SearchTasks(created_before) error = %v", err)
+	}
+	if len(before) != 1 || before[0].Task.ID != seed.ID {
+		t.Fatalf("created_before matches = %#v, want the seed task", before)
+	}
+	after, err := adapter.SearchTasks(ctx, SearchTasksRequest{ProjectID: project.ID, CreatedAfter: "2026-03-01T00:00:00Z", Mode: "keyword"})
+	if err != nil {
+		t.Fatalf("SearchTasks(created_after) error = %v", err)
+	}
+	if len(after) != 0 {
+		t.Fatalf("created_after matches = %#v, want none", after)
+	}
+	_, err = adapter.SearchTasks(ctx, SearchTasksRequest{ProjectID: project.ID, DueBefore: "next week"})
+	if !errors.Is(err, ErrInvalidCaptureStateRequest) || !strings.Contains(err.Error(), "due_before") {
+		t.Fatalf("SearchTasks(bad due_before) error = %v, want invalid request naming due_before", err)
+	}
+}
@@ -0,0 +1,106 @@
+package common
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// GetBoardState lists one project's columns and tasks by project slug for read-only board consumers.
+// Archived projects, columns, and tasks are left out unless the request includes them.
+func (a *AppServiceAdapter) GetBoardState(ctx context.Context, in BoardStateRequest) (BoardState, error) {
+	if a == nil || a.service == nil {
+		return BoardState{}, fmt.Errorf("app service adapter is not configured: %w", ErrInvalidCaptureStateRequest)
+	}
+	slug := strings.TrimSpace(in.ProjectSlug)
+	if slug == "" {
+		return BoardState{}, fmt.Errorf("project slug is required: %w", ErrInvalidCaptureStateRequest)
+	}
+	projects, err := a.service.ListProjects(ctx, in.IncludeArchived)
+	if err != nil {
+		return BoardState{}, mapAppError("list projects", err)
+	}
+	matches := slices.DeleteFunc(projects, func(project domain.Project) bool {
+		return project.Slug != slug
+	})
+	switch {
+	case len(matches) == 0:
+		return BoardState{}, fmt.Errorf("project %q: %w", slug, ErrNotFound)
+	case len(matches) > 1:
+		// Picking one would silently show the wrong board, so name every candidate instead.
+		candidates := make([]string, 0, len(matches))
+		for _, match := range matches {
+			candidates = append(candidates, fmt.Sprintf("%s (%s)", match.ID, match.Name))
+		}
+		return BoardState{}, fmt.Errorf("project slug %q is shared by %d projects: %s: %w", slug, len(matches), strings.Join(candidates, ", "), ErrInvalidCaptureStateRequest)
+	}
+	project := matches[0]
+
+	columns, err := a.service.ListColumns(ctx, project.ID, in.IncludeArchived)
+	if err != nil {
+		return BoardState{}, mapAppError("list columns", err)
+	}
+	tasks, err := a.service.ListTasks(ctx, project.ID, in.IncludeArchived)
+	if err != nil {
+		return BoardState{}, mapAppError("list tasks", err)
+	}
+	slices.SortFunc(columns, func(a, b domain.Column) int {
+		return cmp.Or(cmp.Compare(a.Position, b.Position), strings.Compare(a.ID, b.ID))
+	})
+	slices.SortFunc(tasks, func(a, b domain.Task) int {
+		return cmp.Or(cmp.Compare(a.Position, b.Position), strings.Compare(a.ID, b.ID))
+	})
+
+	state := BoardState{
+		ProjectID:       project.ID,
+		ProjectSlug:     project.Slug,
+		ProjectName:     project.Name,
+		IncludeArchived: in.IncludeArchived,
+		Columns:         make([]BoardColumn, 0, len(columns)),
+	}
+	columnIndex := make(map[string]int, len(columns))
+	for _, column := range columns {
+		columnIndex[column.ID] = len(state.Columns)
+		state.Columns = append(state.Columns, BoardColumn{
+			ID:         column.ID,
+			Name:       column.Name,
+			Position:   column.Position,
+			WIPLimit:   column.WIPLimit,
+			ArchivedAt: column.ArchivedAt,
+			Tasks:      make([]BoardTask, 0),
+		})
+	}
+	for _, task := range tasks {
+		// Tasks in a column the request filtered out stay with that column.
+		idx, ok := columnIndex[task.ColumnID]
+		if !ok {
+			continue
+		}
+		state.Columns[idx].Tasks = append(state.Columns[idx].Tasks, boardTaskFromDomain(task))
+	}
+	return state, nil
+}
+
+// boardTaskFromDomain maps one task onto its board payload row.
+func boardTaskFromDomain(task domain.Task) BoardTask {
+	return BoardTask{
+		ID:             task.ID,
+		ParentID:       task.ParentID,
+		Kind:           string(task.Kind),
+		Title:          task.Title,
+		Priority:       string(task.Priority),
+		Position:       task.Position,
+		LifecycleState: task.LifecycleState,
+		Labels:         slices.Clone(task.Labels),
+		DueAt:          task.DueAt,
+		DependsOn:      slices.Clone(task.Metadata.DependsOn),
+		BlockedBy:      slices.Clone(task.Metadata.BlockedBy),
+		BlockedReason:  task.Metadata.BlockedReason,
+		UpdatedAt:      task.UpdatedAt,
+		ArchivedAt:     task.ArchivedAt,
+	}
+}
@@ -14,6 +14,12 @@ var ErrBootstrapRequired = errors.New("bootstrap is required")
 // ErrGuardrailViolation reports fail-closed lease/scope/completion guardrail failures.
 var ErrGuardrailViolation = errors.New("guardrail violation")
 
+// ErrConflict reports writes rejected because stored state changed or conflicts with the request.
+var ErrConflict = errors.New("conflict")
+
+// ErrWIPLimitExceeded reports writes rejected by a column work-in-progress limit.
+var ErrWIPLimitExceeded = errors.New("wip limit exceeded")
+
 // BootstrapGuide stores summary-first onboarding guidance for empty-instance flows.
 type BootstrapGuide struct {
 	Mode          string   `json:"mode"`
@@ -56,8 +62,10 @@ type UpdateProjectRequest struct {
 }
 
 // CreateTaskRequest stores transport input for task creation.
+// The project is named by ProjectID or ProjectSlug; an empty ColumnID files the task in the project's first column.
 type CreateTaskRequest struct {
 	ProjectID   string
+	ProjectSlug string
 	ParentID    string
 	Kind        string
 	Scope       string
@@ -69,6 +77,7 @@ type CreateTaskRequest struct {
 	Labels      []string
 	Metadata    domain.TaskMetadata
 	Actor       ActorLeaseTuple
+	DryRun      bool
 }
 
 // UpdateTaskRequest stores transport input for task updates.
@@ -81,14 +90,22 @@ type UpdateTaskRequest struct {
 	Labels      []string
 	Metadata    *domain.TaskMetadata
 	Actor       ActorLeaseTuple
+	DryRun      bool
 }
 
 // MoveTaskRequest stores transport input for task move operations.
+// The target column is named by ToColumnID or, when that is empty, by ToColumnName within the task's project.
 type MoveTaskRequest struct {
-	TaskID     string
-	ToColumnID string
-	Position   int
-	Actor      ActorLeaseTuple
+	TaskID       string
+	ToColumnID   string
+	ToColumnName string
+	Position     int
+	// AppendToColumn places the task after the target column's last task and ignores Position.
+	AppendToColumn bool
+	Actor          ActorLeaseTuple
+	DryRun         bool
+	// EnforceWIPLimit rejects moves that would overfill the target column instead of only warning about them.
+	EnforceWIPLimit bool
 }
 
 // DeleteTaskRequest stores transport input for task delete operations.
@@ -96,12 +113,14 @@ type DeleteTaskRequest struct {
 	TaskID string
 	Mode   string
 	Actor  ActorLeaseTuple
+	DryRun bool
 }
 
 // RestoreTaskRequest stores transport input for restore operations.
 type RestoreTaskRequest struct {
 	TaskID string
 	Actor  ActorLeaseTuple
+	DryRun bool
 }
 
 // ReparentTaskRequest stores transport input for parent-link updates.
@@ -109,6 +128,7 @@ type ReparentTaskRequest struct {
 	TaskID   string
 	ParentID string
 	Actor    ActorLeaseTuple
+	DryRun   bool
 }
 
 // SearchTasksRequest stores transport input for search queries.
@@ -122,10 +142,15 @@ type SearchTasksRequest struct {
 	Kinds           []string
 	LabelsAny       []string
 	LabelsAll       []string
-	Mode            string
-	Sort            string
-	Limit           int
-	Offset          int
+	// DueAfter, DueBefore, CreatedAfter, and CreatedBefore are optional RFC3339 bounds on due and creation times.
+	DueAfter      string
+	DueBefore     string
+	CreatedAfter  string
+	CreatedBefore string
+	Mode          string
+	Sort          string
+	Limit         int
+	Offset        int
 }
 
 // SearchTaskMatch stores one transport-facing search match row.
@@ -225,6 +250,54 @@ type CommentRecord struct {
 	UpdatedAt    time.Time `json:"updated_at"`
 }
 
+// BoardStateRequest selects one project board by slug.
+type BoardStateRequest struct {
+	ProjectSlug     string
+	IncludeArchived bool
+}
+
+// BoardState is the read-only board payload: one project's columns in board order, each holding its tasks.
+type BoardState struct {
+	ProjectID       string        `json:"project_id"`
+	ProjectSlug     string        `json:"project_slug"`
+	ProjectName     string        `json:"project_name"`
+	IncludeArchived bool          `json:"include_archived"`
+	Columns         []BoardColumn `json:"columns"`
+}
+
+// BoardColumn stores one board column and its tasks in position order.
+type BoardColumn struct {
+	ID         string      `json:"id"`
+	Name       string      `json:"name"`
+	Position   int         `json:"position"`
+	WIPLimit   int         `json:"wip_limit"`
+	ArchivedAt *time.Time  `json:"archived_at,omitempty"`
+	Tasks      []BoardTask `json:"tasks"`
+}
+
+// BoardTask stores one task row with its lifecycle state and dependency metadata.
+type BoardTask struct {
+	ID             string                `json:"id"`
+	ParentID       string                `json:"parent_id,omitempty"`
+	Kind           string                `json:"kind"`
+	Title          string                `json:"title"`
+	Priority       string                `json:"priority"`
+	Position       int                   `json:"position"`
+	LifecycleState domain.LifecycleState `json:"lifecycle_state"`
+	Labels         []string              `json:"labels,omitempty"`
+	DueAt          *time.Time            `json:"due_at,omitempty"`
+	DependsOn      []string              `json:"depends_on,omitempty"`
+	BlockedBy      []string              `json:"blocked_by,omitempty"`
+	BlockedReason  string                `json:"blocked_reason,omitempty"`
+	UpdatedAt      time.Time             `json:"updated_at"`
+	ArchivedAt     *time.Time            `json:"archived_at,omitempty"`
+}
+
+// BoardStateReader resolves the read-only board payload for one project.
+type BoardStateReader interface {
+	GetBoardState(context.Context, BoardStateRequest) (BoardState, error)
+}
+
 // BootstrapGuideReader resolves onboarding guidance for empty-instance flows.
 type BootstrapGuideReader interface {
 	GetBootstrapGuide(context.Context) (BootstrapGuide, error)
@@ -21,6 +21,7 @@ const maxRequestBodyBytes int64 = 1 << 20
 type Handler struct {
 	captureState common.CaptureStateReader
 	attention    common.AttentionService
+	tasks        common.TaskService
 }
 
 // APIError represents one structured API failure response.
@@ -36,14 +37,35 @@ type ErrorEnvelope struct {
 	Error APIError `json:"error"`
 }
 
+// MoveTaskBody is the JSON payload for POST `/tasks/{id}/move`.
+type MoveTaskBody struct {
+	ColumnID string `json:"column_id"`
+	Position int    `json:"position"`
+	// OverrideWIPLimit moves the task even when the target column is at its WIP limit.
+	OverrideWIPLimit bool `json:"override_wip_limit,omitempty"`
+}
+
 // NewHandler constructs one HTTP API adapter from capture and optional attention services.
+// Task routes are served when either service also implements common.TaskService.
 func NewHandler(captureState common.CaptureStateReader, attention common.AttentionService) *Handler {
 	return &Handler{
 		captureState: captureState,
 		attention:    attention,
+		tasks:        pickTaskService(captureState, attention),
 	}
 }
 
+// pickTaskService resolves one task-service provider from available services.
+func pickTaskService(captureState common.CaptureStateReader, attention common.AttentionService) common.TaskService {
+	if svc, ok := captureState.(common.TaskService); ok {
+		return svc
+	}
+	if svc, ok := attention.(common.TaskService); ok {
+		return svc
+	}
+	return nil
+}
+
 // ServeHTTP routes one versioned API request to the matching handler.
 func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	path := normalizePath(r.URL.Path)
@@ -66,6 +88,14 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 		return
 	default:
+		if taskID, ok := resolveTaskMoveID(path); ok {
+			if r.Method != http.MethodPost {
+				writeMethodNotAllowed(w, http.MethodPost)
+				return
+			}
+			h.handleMoveTask(w, r, taskID)
+			return
+		}
 		itemID, ok := resolveAttentionItemID(path)
 		if !ok {
 			writeJSONError(w, http.StatusNotFound, APIError{
@@ -193,6 +223,59 @@ func (h *Handler) handleResolveAttentionItem(w http.ResponseWriter, r *http.Requ
 	writeJSON(w, http.StatusOK, item)
 }
 
+// handleMoveTask serves POST `/tasks/{id}/move`.
+func (h *Handler) handleMoveTask(w http.ResponseWriter, r *http.Request, taskID string) {
+	if h.tasks == nil {
+		writeJSONError(w, http.StatusNotImplemented, APIError{
+			Code:    "not_implemented",
+			Message: "task APIs are not available",
+		})
+		return
+	}
+
+	var body MoveTaskBody
+	if err := decodeJSONBody(r.Context(), w, r, &body); err != nil {
+		writeErrorFrom(w, err)
+		return
+	}
+	columnID := strings.TrimSpace(body.ColumnID)
+	if columnID == "" {
+		writeJSONError(w, http.StatusBadRequest, APIError{
+			Code:    "invalid_request",
+			Message: "column_id is required",
+		})
+		return
+	}
+	// The service rejects target columns outside the task's project before anything is written.
+	task, err := h.tasks.MoveTask(r.Context(), common.MoveTaskRequest{
+		TaskID:          taskID,
+		ToColumnID:      columnID,
+		Position:        body.Position,
+		EnforceWIPLimit: !body.OverrideWIPLimit,
+	})
+	if err != nil {
+		writeErrorFrom(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, task)
+}
+
+// resolveTaskMoveID parses `/tasks/{id}/move` and returns `{id}`.
+func resolveTaskMoveID(path string) (string, bool) {
+	const (
+		prefix = "tasks/"
+		suffix = "/move"
+	)
+	if !strings.HasPrefix(path, prefix) || !strings.HasSuffix(path, suffix) {
+		return "", false
+	}
+	id := strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(path, prefix), suffix))
+	if id == "" || strings.Contains(id, "/") {
+		return "", false
+	}
+	return id, true
+}
+
 // resolveAttentionItemID parses `/attention/items/{id}/resolve` and returns `{id}`.
 func resolveAttentionItemID(path string) (string, bool) {
 	const (
@@ -273,6 +356,25 @@ func mapHTTPError(err error) httpErrorMapping {
 				Message: err.Error(),
 			},
 		}
+	case errors.Is(err, common.ErrConflict):
+		return httpErrorMapping{
+			Class:      "conflict",
+			StatusCode: http.StatusConflict,
+			APIError: APIError{
+				Code:    "conflict",
+				Message: err.Error(),
+			},
+		}
+	case errors.Is(err, common.ErrWIPLimitExceeded):
+		return httpErrorMapping{
+			Class:      "wip_limit",
+			StatusCode: http.StatusConflict,
+			APIError: APIError{
+				Code:    "wip_limit_exceeded",
+				Message: err.Error(),
+				Hint:    "Retry with override_wip_limit=true to move the task anyway.",
+			},
+		}
 	case errors.Is(err, common.ErrNotFound):
 		return httpErrorMapping{
 			Class:      "not_found",
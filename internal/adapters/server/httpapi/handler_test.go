@@ -14,6 +14,9 @@ import (
 
 	charmLog "github.com/charmbracelet/log"
 	"github.com/hylla/tillsyn/internal/adapters/server/common"
+	"github.com/hylla/tillsyn/internal/adapters/storage/sqlite"
+	"github.com/hylla/tillsyn/internal/app"
+	"github.com/hylla/tillsyn/internal/domain"
 )
 
 // stubCaptureStateReader provides deterministic capture-state responses for handler tests.
@@ -688,6 +691,22 @@ func TestWriteErrorFromMappingBranches(t *testing.T) {
 			wantClass:     "not_found",
 			wantMsgSubstr: "missing",
 		},
+		{
+			name:          "conflict maps to conflict",
+			err:           errors.Join(common.ErrConflict, errors.New("stale write")),
+			wantStatus:    http.StatusConflict,
+			wantCode:      "conflict",
+			wantClass:     "conflict",
+			wantMsgSubstr: "stale write",
+		},
+		{
+			name:          "wip limit maps to conflict status",
+			err:           errors.Join(common.ErrWIPLimitExceeded, errors.New("column full")),
+			wantStatus:    http.StatusConflict,
+			wantCode:      "wip_limit_exceeded",
+			wantClass:     "wip_limit",
+			wantMsgSubstr: "column full",
+		},
 		{
 			name:          "attention unavailable is not implemented",
 			err:           errors.Join(common.ErrAttentionUnavailable, errors.New("feature disabled")),
@@ -797,3 +816,147 @@ func TestNormalizePath(t *testing.T) {
 		}
 	}
 }
+
+// newMoveTaskFixture builds one app-backed handler with two projects for task-move route tests.
+func newMoveTaskFixture(t *testing.T) (*Handler, *app.Service, domain.Task, []domain.Column, domain.Column) {
+	t.Helper()
+	repo, err := sqlite.OpenInMemory()
+	if err != nil {
+		t.Fatalf("OpenInMemory() error = %v", err)
+	}
+	t.Cleanup(func() {
+		_ = repo.Close()
+	})
+	nextID := 0
+	service := app.NewService(repo, func() string {
+		nextID++
+		return "id-" + strconv.Itoa(nextID)
+	}, func() time.Time {
+		return time.Date(2026, 2, 24, 12, 0, 0, 0, time.UTC)
+	}, app.ServiceConfig{AutoCreateProjectColumns: true})
+
+	ctx := context.Background()
+	project, err := service.CreateProject(ctx, "Board", "")
+	if err != nil {
+		t.Fatalf("CreateProject() error = %v", err)
+	}
+	columns, err := service.ListColumns(ctx, project.ID, false)
+	if err != nil || len(columns) < 2 {
+		t.Fatalf("ListColumns() = %d columns, err %v; want auto-created columns", len(columns), err)
+	}
+	task, err := service.CreateTask(ctx, app.CreateTaskInput{
+		ProjectID: project.ID,
+		ColumnID:  columns[0].ID,
+		Title:     "Move me",
+		Priority:  domain.PriorityMedium,
+	})
+	if err != nil {
+		t.Fatalf("CreateTask() error = %v", err)
+	}
+	other, err := service.CreateProject(ctx, "Other", "")
+	if err != nil {
+		t.Fatalf("CreateProject(other) error = %v", err)
+	}
+	otherColumns, err := service.ListColumns(ctx, other.ID, false)
+	if err != nil || len(otherColumns) == 0 {
+		t.Fatalf("ListColumns(other) = %d columns, err %v", len(otherColumns), err)
+	}
+	adapter := common.NewAppServiceAdapter(service)
+	return NewHandler(adapter, adapter), service, task, columns, otherColumns[0]
+}
+
+// postMoveTask sends one POST `/tasks/{id}/move` request with a raw JSON body.
+func postMoveTask(handler *Handler, taskID, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/tasks/"+taskID+"/move", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+// TestHandlerMoveTask verifies task moves return the updated task, enforce WIP limits, and reject foreign columns.
+func TestHandlerMoveTask(t *testing.T) {
+	handler, service, task, columns, foreign := newMoveTaskFixture(t)
+	target := columns[1]
+
+	rec := postMoveTask(handler, task.ID, `{"column_id":"`+target.ID+`","position":0}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("move status = %d, want %d (%s)", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	var moved domain.Task
+	if err := json.NewDecoder(rec.Body).Decode(&moved); err != nil {
+		t.Fatalf("Decode(move) error = %v", err)
+	}
+	if moved.ID != task.ID || moved.ColumnID != target.ID {
+		t.Fatalf("moved task = %#v, want %q in column %q", moved, task.ID, target.ID)
+	}
+
+	// A full target column answers 409 until the caller opts to override the limit.
+	if _, err := service.UpdateColumn(context.Background(), app.UpdateColumnInput{ColumnID: columns[0].ID, Name: columns[0].Name, WIPLimit: 1}); err != nil {
+		t.Fatalf("UpdateColumn() error = %v", err)
+	}
+	if _, err := service.CreateTask(context.Background(), app.CreateTaskInput{
+		ProjectID: task.ProjectID,
+		ColumnID:  columns[0].ID,
+		Title:     "Occupant",
+		Priority:  domain.PriorityLow,
+	}); err != nil {
+		t.Fatalf("CreateTask(occupant) error = %v", err)
+	}
+	rec = postMoveTask(handler, task.ID, `{"column_id":"`+columns[0].ID+`","position":0}`)
+	if rec.Code != http.StatusConflict {
+		t.Fatalf("full column status = %d, want %d", rec.Code, http.StatusConflict)
+	}
+	if envelope := decodeErrorEnvelope(t, rec); envelope.Error.Code != "wip_limit_exceeded" || envelope.Error.Hint == "" {
+		t.Fatalf("full column error = %#v, want wip_limit_exceeded with hint", envelope.Error)
+	}
+	rec = postMoveTask(handler, task.ID, `{"column_id":"`+columns[0].ID+`","position":0,"override_wip_limit":true}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("override status = %d, want %d (%s)", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	rec = postMoveTask(handler, task.ID, `{"column_id":"`+foreign.ID+`","position":0}`)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("foreign column status = %d, want %d (%s)", rec.Code, http.StatusBadRequest, rec.Body.String())
+	}
+	stored, err := service.ListTasks(context.Background(), task.ProjectID, false)
+	if err != nil {
+		t.Fatalf("ListTasks() error = %v", err)
+	}
+	for _, got := range stored {
+		if got.ID == task.ID && got.ColumnID != columns[0].ID {
+			t.Fatalf("task column after rejected move = %q, want %q", got.ColumnID, columns[0].ID)
+		}
+	}
+
+	cases := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+	}{
+		{name: "missing column", method: http.MethodPost, path: "/tasks/" + task.ID + "/move", body: `{"position":0}`, wantStatus: http.StatusBadRequest},
+		{name: "unknown field", method: http.MethodPost, path: "/tasks/" + task.ID + "/move", body: `{"columnId":"x"}`, wantStatus: http.StatusBadRequest},
+		{name: "unknown task", method: http.MethodPost, path: "/tasks/missing/move", body: `{"column_id":"` + target.ID + `"}`, wantStatus: http.StatusNotFound},
+		{name: "wrong method", method: http.MethodGet, path: "/tasks/" + task.ID + "/move", wantStatus: http.StatusMethodNotAllowed},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (%s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+		})
+	}
+}
+
+// TestHandlerMoveTaskUnavailable verifies the move route reports 501 without a task service.
+func TestHandlerMoveTaskUnavailable(t *testing.T) {
+	handler := NewHandler(&stubCaptureStateReader{}, nil)
+	rec := postMoveTask(handler, "t1", `{"column_id":"c1"}`)
+	if rec.Code != http.StatusNotImplemented {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusNotImplemented)
+	}
+}
@@ -0,0 +1,95 @@
+package mcpapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/log"
+	"github.com/hylla/tillsyn/internal/adapters/server/common"
+	"github.com/mark3labs/mcp-go/mcp"
+	mcpserver "github.com/mark3labs/mcp-go/server"
+)
+
+// boardResourceScheme is the URI scheme of the read-only board resource.
+const boardResourceScheme = "board"
+
+// boardResourceTemplate addresses one project board by slug, with archived rows opt-in.
+const boardResourceTemplate = boardResourceScheme + "://{project_slug}{?include_archived}"
+
+// registerBoardResource registers the read-only board:// resource when board state is available.
+func registerBoardResource(srv *mcpserver.MCPServer, boards common.BoardStateReader) {
+	if boards == nil {
+		return
+	}
+	srv.AddResourceTemplate(
+		mcp.NewResourceTemplate(
+			boardResourceTemplate,
+			"board",
+			mcp.WithTemplateDescription("Read-only board state for one project: columns in board order with their tasks, lifecycle state, and dependency metadata. Archived columns and tasks are included only with ?include_archived=true."),
+			mcp.WithTemplateMIMEType("application/json"),
+		),
+		func(ctx context.Context, req mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+			boardReq, err := parseBoardResourceURI(req.Params.URI)
+			if err != nil {
+				return nil, err
+			}
+			state, err := boards.GetBoardState(ctx, boardReq)
+			if err != nil {
+				mapped := mapToolError(err)
+				log.Error("mcp resource error mapped", "transport", "mcp", "resource", "board", "error_class", mapped.Class, "error_code", mapped.Code, "err", err)
+				return nil, errors.New(mapped.Text)
+			}
+			encoded, err := json.Marshal(state)
+			if err != nil {
+				return nil, fmt.Errorf("encode board resource: %w", err)
+			}
+			return []mcp.ResourceContents{
+				mcp.TextResourceContents{
+					URI:      req.Params.URI,
+					MIMEType: "application/json",
+					Text:     string(encoded),
+				},
+			}, nil
+		},
+	)
+}
+
+// parseBoardResourceURI resolves one board:// URI into a board-state request.
+func parseBoardResourceURI(raw string) (common.BoardStateRequest, error) {
+	parsed, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return common.BoardStateRequest{}, fmt.Errorf("invalid_request: parse board resource uri %q: %w", raw, err)
+	}
+	if parsed.Scheme != boardResourceScheme {
+		return common.BoardStateRequest{}, fmt.Errorf("invalid_request: board resource uri %q must use the %s:// scheme", raw, boardResourceScheme)
+	}
+	slug := strings.TrimSpace(parsed.Host)
+	if slug == "" {
+		return common.BoardStateRequest{}, fmt.Errorf("invalid_request: board resource uri %q is missing a project slug", raw)
+	}
+	req := common.BoardStateRequest{ProjectSlug: slug}
+	if value := strings.TrimSpace(parsed.Query().Get("include_archived")); value != "" {
+		includeArchived, err := strconv.ParseBool(value)
+		if err != nil {
+			return common.BoardStateRequest{}, fmt.Errorf("invalid_request: include_archived must be true or false, got %q", value)
+		}
+		req.IncludeArchived = includeArchived
+	}
+	return req, nil
+}
+
+// pickBoardStateReader resolves one board-state provider from available services.
+func pickBoardStateReader(captureState common.CaptureStateReader, attention common.AttentionService) common.BoardStateReader {
+	if svc, ok := captureState.(common.BoardStateReader); ok {
+		return svc
+	}
+	if svc, ok := attention.(common.BoardStateReader); ok {
+		return svc
+	}
+	return nil
+}
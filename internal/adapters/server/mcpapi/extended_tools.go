@@ -268,15 +268,16 @@ func registerTaskTools(
 		srv.AddTool(
 			mcp.NewTool(
 				"till.create_task",
-				mcp.WithDescription("Create one task/work-item (branch|phase|task|subtask via scope/kind)."),
-				mcp.WithString("project_id", mcp.Required(), mcp.Description("Project identifier")),
-				mcp.WithString("column_id", mcp.Required(), mcp.Description("Column identifier")),
+				mcp.WithDescription("Create one task/work-item (branch|phase|task|subtask via scope/kind) and return it, including its id."),
+				mcp.WithString("project_id", mcp.Description("Project identifier; required unless project_slug is given")),
+				mcp.WithString("project_slug", mcp.Description("Project slug, used when project_id is omitted")),
+				mcp.WithString("column_id", mcp.Description("Column identifier; defaults to the project's first column")),
 				mcp.WithString("title", mcp.Required(), mcp.Description("Task title")),
 				mcp.WithString("parent_id", mcp.Description("Optional parent task id")),
 				mcp.WithString("kind", mcp.Description("Kind identifier")),
 				mcp.WithString("scope", mcp.Description("project|branch|phase|task|subtask"), mcp.Enum(common.SupportedScopeTypes()...)),
 				mcp.WithString("description", mcp.Description("Task details in markdown-rich text")),
-				mcp.WithString("priority", mcp.Description("low|medium|high"), mcp.Enum("low", "medium", "high")),
+				mcp.WithString("priority", mcp.Description("none|low|medium|high|critical"), mcp.Enum("none", "low", "medium", "high", "critical")),
 				mcp.WithString("due_at", mcp.Description("Optional RFC3339 timestamp")),
 				mcp.WithArray("labels", mcp.Description("Optional labels"), mcp.WithStringItems()),
 				mcp.WithObject("metadata", mcp.Description("Optional task metadata object")),
@@ -287,10 +288,12 @@ func registerTaskTools(
 				mcp.WithString("agent_instance_id", mcp.Description("Agent instance id for authenticated agent mutations")),
 				mcp.WithString("lease_token", mcp.Description("Lease token for authenticated agent mutations")),
 				mcp.WithString("override_token", mcp.Description("Optional override token")),
+				mcp.WithBoolean("dry_run", mcp.Description("Validate and return the would-be result without persisting")),
 			),
 			func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 				var args struct {
 					ProjectID       string              `json:"project_id"`
+					ProjectSlug     string              `json:"project_slug"`
 					ParentID        string              `json:"parent_id"`
 					Kind            string              `json:"kind"`
 					Scope           string              `json:"scope"`
@@ -308,15 +311,13 @@ func registerTaskTools(
 					AgentInstanceID string              `json:"agent_instance_id"`
 					LeaseToken      string              `json:"lease_token"`
 					OverrideToken   string              `json:"override_token"`
+					DryRun          bool                `json:"dry_run"`
 				}
 				if err := req.BindArguments(&args); err != nil {
 					return invalidRequestToolResult(err), nil
 				}
-				if strings.TrimSpace(args.ProjectID) == "" {
-					return mcp.NewToolResultError(`invalid_request: required argument "project_id" not found`), nil
-				}
-				if strings.TrimSpace(args.ColumnID) == "" {
-					return mcp.NewToolResultError(`invalid_request: required argument "column_id" not found`), nil
+				if strings.TrimSpace(args.ProjectID) == "" && strings.TrimSpace(args.ProjectSlug) == "" {
+					return mcp.NewToolResultError(`invalid_request: required argument "project_id" or "project_slug" not found`), nil
 				}
 				if strings.TrimSpace(args.Title) == "" {
 					return mcp.NewToolResultError(`invalid_request: required argument "title" not found`), nil
@@ -335,6 +336,7 @@ func registerTaskTools(
 				}
 				task, err := tasks.CreateTask(ctx, common.CreateTaskRequest{
 					ProjectID:   args.ProjectID,
+					ProjectSlug: args.ProjectSlug,
 					ParentID:    args.ParentID,
 					Kind:        args.Kind,
 					Scope:       args.Scope,
@@ -346,11 +348,12 @@ func registerTaskTools(
 					Labels:      append([]string(nil), args.Labels...),
 					Metadata:    args.Metadata,
 					Actor:       actor,
+					DryRun:      args.DryRun,
 				})
 				if err != nil {
 					return toolResultFromError(err), nil
 				}
-				result, err := mcp.NewToolResultJSON(task)
+				result, err := mcp.NewToolResultJSON(taskMutationResult(task, args.DryRun))
 				if err != nil {
 					return nil, fmt.Errorf("encode create_task result: %w", err)
 				}
@@ -365,7 +368,7 @@ func registerTaskTools(
 				mcp.WithString("task_id", mcp.Required(), mcp.Description("Task identifier")),
 				mcp.WithString("title", mcp.Required(), mcp.Description("Task title")),
 				mcp.WithString("description", mcp.Description("Task details in markdown-rich text")),
-				mcp.WithString("priority", mcp.Description("low|medium|high"), mcp.Enum("low", "medium", "high")),
+				mcp.WithString("priority", mcp.Description("none|low|medium|high|critical"), mcp.Enum("none", "low", "medium", "high", "critical")),
 				mcp.WithString("due_at", mcp.Description("Optional RFC3339 timestamp")),
 				mcp.WithArray("labels", mcp.Description("Optional labels"), mcp.WithStringItems()),
 				mcp.WithObject("metadata", mcp.Description("Optional task metadata object")),
@@ -376,6 +379,7 @@ func registerTaskTools(
 				mcp.WithString("agent_instance_id", mcp.Description("Agent instance id for authenticated agent mutations")),
 				mcp.WithString("lease_token", mcp.Description("Lease token for authenticated agent mutations")),
 				mcp.WithString("override_token", mcp.Description("Optional override token")),
+				mcp.WithBoolean("dry_run", mcp.Description("Validate and return the would-be result without persisting")),
 			),
 			func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 				var args struct {
@@ -393,6 +397,7 @@ func registerTaskTools(
 					AgentInstanceID string               `json:"agent_instance_id"`
 					LeaseToken      string               `json:"lease_token"`
 					OverrideToken   string               `json:"override_token"`
+					DryRun          bool                 `json:"dry_run"`
 				}
 				if err := req.BindArguments(&args); err != nil {
 					return invalidRequestToolResult(err), nil
@@ -424,11 +429,12 @@ func registerTaskTools(
 					Labels:      append([]string(nil), args.Labels...),
 					Metadata:    args.Metadata,
 					Actor:       actor,
+					DryRun:      args.DryRun,
 				})
 				if err != nil {
 					return toolResultFromError(err), nil
 				}
-				result, err := mcp.NewToolResultJSON(task)
+				result, err := mcp.NewToolResultJSON(taskMutationResult(task, args.DryRun))
 				if err != nil {
 					return nil, fmt.Errorf("encode update_task result: %w", err)
 				}
@@ -439,28 +445,37 @@ func registerTaskTools(
 		srv.AddTool(
 			mcp.NewTool(
 				"till.move_task",
-				mcp.WithDescription("Move one task/work-item to another column/position."),
+				mcp.WithDescription("Move one task/work-item to another column/position and return it with its new column and lifecycle state."),
 				mcp.WithString("task_id", mcp.Required(), mcp.Description("Task identifier")),
-				mcp.WithString("to_column_id", mcp.Required(), mcp.Description("Destination column identifier")),
-				mcp.WithNumber("position", mcp.Required(), mcp.Description("Destination position")),
+				mcp.WithString("to_column_id", mcp.Description("Destination column identifier; required unless to_column is given")),
+				mcp.WithString("to_column", mcp.Description("Destination column name within the task's project, matched ignoring case")),
+				mcp.WithNumber("position", mcp.Description("Destination position; defaults to the end of the column")),
+				mcp.WithBoolean("override_wip_limit", mcp.Description("Move even when the destination column is at its WIP limit")),
 				mcp.WithString("actor_type", mcp.Description("agent_orchestrator|agent_subagent")),
 				mcp.WithString("agent_name", mcp.Description("Agent name for authenticated agent mutations")),
 				mcp.WithString("agent_instance_id", mcp.Description("Agent instance id for authenticated agent mutations")),
 				mcp.WithString("lease_token", mcp.Description("Lease token for authenticated agent mutations")),
 				mcp.WithString("override_token", mcp.Description("Optional override token")),
+				mcp.WithBoolean("dry_run", mcp.Description("Validate and return the would-be result without persisting")),
 			),
 			func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 				taskID, err := req.RequireString("task_id")
 				if err != nil {
 					return mcp.NewToolResultError(err.Error()), nil
 				}
-				toColumnID, err := req.RequireString("to_column_id")
-				if err != nil {
-					return mcp.NewToolResultError(err.Error()), nil
+				toColumnID := strings.TrimSpace(req.GetString("to_column_id", ""))
+				toColumnName := strings.TrimSpace(req.GetString("to_column", ""))
+				if toColumnID == "" && toColumnName == "" {
+					return mcp.NewToolResultError(`invalid_request: required argument "to_column_id" or "to_column" not found`), nil
 				}
-				position, err := req.RequireInt("position")
-				if err != nil {
-					return mcp.NewToolResultError(err.Error()), nil
+				// An omitted position appends, so agents promoting work need not count the destination column.
+				_, hasPosition := req.GetArguments()["position"]
+				position := 0
+				if hasPosition {
+					position, err = req.RequireInt("position")
+					if err != nil {
+						return mcp.NewToolResultError(err.Error()), nil
+					}
 				}
 				actor, err := buildMCPMutationActorTuple(
 					req.GetString("actor_type", ""),
@@ -474,16 +489,21 @@ func registerTaskTools(
 				if err != nil {
 					return mcp.NewToolResultError(err.Error()), nil
 				}
+				dryRun := req.GetBool("dry_run", false)
 				task, err := tasks.MoveTask(ctx, common.MoveTaskRequest{
-					TaskID:     taskID,
-					ToColumnID: toColumnID,
-					Position:   position,
-					Actor:      actor,
+					TaskID:          taskID,
+					ToColumnID:      toColumnID,
+					ToColumnName:    toColumnName,
+					Position:        position,
+					AppendToColumn:  !hasPosition,
+					Actor:           actor,
+					DryRun:          dryRun,
+					EnforceWIPLimit: !req.GetBool("override_wip_limit", false),
 				})
 				if err != nil {
 					return toolResultFromError(err), nil
 				}
-				result, err := mcp.NewToolResultJSON(task)
+				result, err := mcp.NewToolResultJSON(taskMutationResult(task, dryRun))
 				if err != nil {
 					return nil, fmt.Errorf("encode move_task result: %w", err)
 				}
@@ -502,6 +522,7 @@ func registerTaskTools(
 				mcp.WithString("agent_instance_id", mcp.Description("Agent instance id for authenticated agent mutations")),
 				mcp.WithString("lease_token", mcp.Description("Lease token for authenticated agent mutations")),
 				mcp.WithString("override_token", mcp.Description("Optional override token")),
+				mcp.WithBoolean("dry_run", mcp.Description("Validate and return the would-be result without persisting")),
 			),
 			func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 				taskID, err := req.RequireString("task_id")
@@ -520,18 +541,24 @@ func registerTaskTools(
 				if err != nil {
 					return mcp.NewToolResultError(err.Error()), nil
 				}
+				dryRun := req.GetBool("dry_run", false)
 				if err := tasks.DeleteTask(ctx, common.DeleteTaskRequest{
 					TaskID: taskID,
 					Mode:   req.GetString("mode", ""),
 					Actor:  actor,
+					DryRun: dryRun,
 				}); err != nil {
 					return toolResultFromError(err), nil
 				}
-				result, err := mcp.NewToolResultJSON(map[string]any{
-					"deleted": true,
+				payload := map[string]any{
+					"deleted": !dryRun,
 					"task_id": taskID,
 					"mode":    req.GetString("mode", ""),
-				})
+				}
+				if dryRun {
+					payload["dry_run"] = true
+				}
+				result, err := mcp.NewToolResultJSON(payload)
 				if err != nil {
 					return nil, fmt.Errorf("encode delete_task result: %w", err)
 				}
@@ -549,6 +576,7 @@ func registerTaskTools(
 				mcp.WithString("agent_instance_id", mcp.Description("Agent instance id for authenticated agent mutations")),
 				mcp.WithString("lease_token", mcp.Description("Lease token for authenticated agent mutations")),
 				mcp.WithString("override_token", mcp.Description("Optional override token")),
+				mcp.WithBoolean("dry_run", mcp.Description("Validate and return the would-be result without persisting")),
 			),
 			func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 				taskID, err := req.RequireString("task_id")
@@ -567,14 +595,16 @@ func registerTaskTools(
 				if err != nil {
 					return mcp.NewToolResultError(err.Error()), nil
 				}
+				dryRun := req.GetBool("dry_run", false)
 				task, err := tasks.RestoreTask(ctx, common.RestoreTaskRequest{
 					TaskID: taskID,
 					Actor:  actor,
+					DryRun: dryRun,
 				})
 				if err != nil {
 					return toolResultFromError(err), nil
 				}
-				result, err := mcp.NewToolResultJSON(task)
+				result, err := mcp.NewToolResultJSON(taskMutationResult(task, dryRun))
 				if err != nil {
 					return nil, fmt.Errorf("encode restore_task result: %w", err)
 				}
@@ -593,6 +623,7 @@ func registerTaskTools(
 				mcp.WithString("agent_instance_id", mcp.Description("Agent instance id for authenticated agent mutations")),
 				mcp.WithString("lease_token", mcp.Description("Lease token for authenticated agent mutations")),
 				mcp.WithString("override_token", mcp.Description("Optional override token")),
+				mcp.WithBoolean("dry_run", mcp.Description("Validate and return the would-be result without persisting")),
 			),
 			func(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 				taskID, err := req.RequireString("task_id")
@@ -611,15 +642,17 @@ func registerTaskTools(
 				if err != nil {
 					return mcp.NewToolResultError(err.Error()), nil
 				}
+				dryRun := req.GetBool("dry_run", false)
 				task, err := tasks.ReparentTask(ctx, common.ReparentTaskRequest{
 					TaskID:   taskID,
 					ParentID: req.GetString("parent_id", ""),
 					Actor:    actor,
+					DryRun:   dryRun,
 				})
 				if err != nil {
 					return toolResultFromError(err), nil
 				}
-				result, err := mcp.NewToolResultJSON(task)
+				result, err := mcp.NewToolResultJSON(taskMutationResult(task, dryRun))
 				if err != nil {
 					return nil, fmt.Errorf("encode reparent_task result: %w", err)
 				}
@@ -671,6 +704,10 @@ func registerTaskTools(
 				mcp.WithArray("kinds", mcp.Description("Optional kind filter"), mcp.WithStringItems()),
 				mcp.WithArray("labels_any", mcp.Description("Optional labels-any filter (matches when any listed label is present)"), mcp.WithStringItems()),
 				mcp.WithArray("labels_all", mcp.Description("Optional labels-all filter (matches only when all listed labels are present)"), mcp.WithStringItems()),
+				mcp.WithString("due_after", mcp.Description("Optional RFC3339 timestamp; keep tasks due at or after it (tasks without a due date never match)")),
+				mcp.WithString("due_before", mcp.Description("Optional RFC3339 timestamp; keep tasks due strictly before it (tasks without a due date never match)")),
+				mcp.WithString("created_after", mcp.Description("Optional RFC3339 timestamp; keep tasks created at or after it")),
+				mcp.WithString("created_before", mcp.Description("Optional RFC3339 timestamp; keep tasks created strictly before it")),
 				mcp.WithString("mode", mcp.Description("keyword|semantic|hybrid (default hybrid; semantic/hybrid fall back to keyword when embeddings/vector search is unavailable)"), mcp.Enum("keyword", "semantic", "hybrid")),
 				mcp.WithString("sort", mcp.Description("rank_desc|title_asc|created_at_desc|updated_at_desc (default rank_desc)"), mcp.Enum("rank_desc", "title_asc", "created_at_desc", "updated_at_desc")),
 				mcp.WithNumber(
@@ -698,6 +735,10 @@ func registerTaskTools(
 					Kinds:           req.GetStringSlice("kinds", nil),
 					LabelsAny:       req.GetStringSlice("labels_any", nil),
 					LabelsAll:       req.GetStringSlice("labels_all", nil),
+					DueAfter:        req.GetString("due_after", ""),
+					DueBefore:       req.GetString("due_before", ""),
+					CreatedAfter:    req.GetString("created_after", ""),
+					CreatedBefore:   req.GetString("created_before", ""),
 					Mode:            req.GetString("mode", ""),
 					Sort:            req.GetString("sort", ""),
 					Limit:           req.GetInt("limit", 0),
@@ -1207,3 +1248,14 @@ func invalidRequestToolResult(err error) *mcp.CallToolResult {
 	}
 	return mcp.NewToolResultError("invalid_request: " + err.Error())
 }
+
+// taskMutationResult wraps dry-run task previews so callers can tell them apart from persisted rows.
+func taskMutationResult(task domain.Task, dryRun bool) any {
+	if !dryRun {
+		return task
+	}
+	return map[string]any{
+		"dry_run": true,
+		"task":    task,
+	}
+}
@@ -2,14 +2,18 @@ package mcpapi
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"slices"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/hylla/tillsyn/internal/adapters/server/common"
+	"github.com/hylla/tillsyn/internal/adapters/storage/sqlite"
+	"github.com/hylla/tillsyn/internal/app"
 	"github.com/hylla/tillsyn/internal/domain"
 )
 
@@ -19,6 +23,8 @@ type stubExpandedService struct {
 	lastCreateTaskReq    common.CreateTaskRequest
 	lastUpdateTaskReq    common.UpdateTaskRequest
 	lastRestoreTaskReq   common.RestoreTaskRequest
+	lastMoveTaskReq      common.MoveTaskRequest
+	lastDeleteTaskReq    common.DeleteTaskRequest
 	lastCreateCommentReq common.CreateCommentRequest
 	lastListCommentReq   common.ListCommentsByTargetRequest
 	lastSearchTasksReq   common.SearchTasksRequest
@@ -117,7 +123,8 @@ func (s *stubExpandedService) UpdateTask(_ context.Context, in common.UpdateTask
 }
 
 // MoveTask returns one deterministic moved task row.
-func (s *stubExpandedService) MoveTask(_ context.Context, _ common.MoveTaskRequest) (domain.Task, error) {
+func (s *stubExpandedService) MoveTask(_ context.Context, in common.MoveTaskRequest) (domain.Task, error) {
+	s.lastMoveTaskReq = in
 	now := time.Date(2026, 2, 24, 12, 0, 0, 0, time.UTC)
 	return domain.Task{
 		ID:             "t1",
@@ -135,7 +142,8 @@ func (s *stubExpandedService) MoveTask(_ context.Context, _ common.MoveTaskReque
 }
 
 // DeleteTask reports deterministic success.
-func (s *stubExpandedService) DeleteTask(_ context.Context, _ common.DeleteTaskRequest) error {
+func (s *stubExpandedService) DeleteTask(_ context.Context, in common.DeleteTaskRequest) error {
+	s.lastDeleteTaskReq = in
 	return nil
 }
 
@@ -862,6 +870,8 @@ func TestHandlerExpandedSearchToolForwardsExtendedFilters(t *testing.T) {
 		"kinds":            []any{"phase"},
 		"labels_any":       []any{"backend", "ops"},
 		"labels_all":       []any{"urgent"},
+		"due_before":       "2026-03-08T00:00:00Z",
+		"created_before":   "2026-02-01T00:00:00Z",
 		"mode":             "hybrid",
 		"sort":             "title_asc",
 		"limit":            75,
@@ -910,6 +920,9 @@ func TestHandlerExpandedSearchToolForwardsExtendedFilters(t *testing.T) {
 	if got := service.lastSearchTasksReq.LabelsAll; !slices.Equal(got, []string{"urgent"}) {
 		t.Fatalf("labels_all = %#v, want [urgent]", got)
 	}
+	if got := service.lastSearchTasksReq; got.DueBefore != "2026-03-08T00:00:00Z" || got.CreatedBefore != "2026-02-01T00:00:00Z" || got.DueAfter != "" || got.CreatedAfter != "" {
+		t.Fatalf("date bounds = due %q..%q created %q..%q, want due_before and created_before only", got.DueAfter, got.DueBefore, got.CreatedAfter, got.CreatedBefore)
+	}
 
 	_, defaultResp := postJSONRPC(t, server.Client(), server.URL, callToolRequest(611, "till.search_task_matches", map[string]any{
 		"project_id": "p1",
@@ -1230,3 +1243,301 @@ func TestHandlerExpandedToolInvalidBindArguments(t *testing.T) {
 		t.Fatalf("error text = %q, want prefix invalid_request:", got)
 	}
 }
+
+// TestHandlerExpandedToolDryRunPreviews verifies dry_run flows through mutation tools and marks preview results.
+func TestHandlerExpandedToolDryRunPreviews(t *testing.T) {
+	service := &stubExpandedService{
+		stubCaptureStateReader: stubCaptureStateReader{
+			captureState: common.CaptureState{StateHash: "abc123"},
+		},
+	}
+	handler, err := NewHandler(Config{}, service, nil)
+	if err != nil {
+		t.Fatalf("NewHandler() error = %v", err)
+	}
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	_, _ = postJSONRPC(t, server.Client(), server.URL, initializeRequest())
+
+	_, moveResp := postJSONRPC(t, server.Client(), server.URL, callToolRequest(720, "till.move_task", map[string]any{
+		"task_id":           "t1",
+		"to_column_id":      "c2",
+		"position":          1,
+		"dry_run":           true,
+		"actor_type":        "agent_orchestrator",
+		"agent_name":        "agent-1",
+		"agent_instance_id": "inst-1",
+		"lease_token":       "tok-1",
+	}))
+	if isError, _ := moveResp.Result["isError"].(bool); isError {
+		t.Fatalf("move_task dry_run returned isError=true: %#v", moveResp.Result)
+	}
+	if !service.lastMoveTaskReq.DryRun {
+		t.Fatal("move_task dry_run = false, want true")
+	}
+	movePreview := toolResultStructured(t, moveResp.Result)
+	if dryRun, _ := movePreview["dry_run"].(bool); !dryRun {
+		t.Fatalf("move_task preview missing dry_run marker: %#v", movePreview)
+	}
+	task, ok := movePreview["task"].(map[string]any)
+	if !ok || task["ColumnID"] != "c2" {
+		t.Fatalf("move_task preview task = %#v, want column c2", movePreview["task"])
+	}
+
+	_, createResp := postJSONRPC(t, server.Client(), server.URL, callToolRequest(721, "till.create_task", map[string]any{
+		"project_id":        "p1",
+		"column_id":         "c1",
+		"title":             "Task One",
+		"dry_run":           true,
+		"actor_type":        "agent_orchestrator",
+		"agent_name":        "agent-1",
+		"agent_instance_id": "inst-1",
+		"lease_token":       "tok-1",
+	}))
+	if isError, _ := createResp.Result["isError"].(bool); isError {
+		t.Fatalf("create_task dry_run returned isError=true: %#v", createResp.Result)
+	}
+	if !service.lastCreateTaskReq.DryRun {
+		t.Fatal("create_task dry_run = false, want true")
+	}
+
+	_, deleteResp := postJSONRPC(t, server.Client(), server.URL, callToolRequest(722, "till.delete_task", map[string]any{
+		"task_id":           "t1",
+		"mode":              "hard",
+		"dry_run":           true,
+		"actor_type":        "agent_orchestrator",
+		"agent_name":        "agent-1",
+		"agent_instance_id": "inst-1",
+		"lease_token":       "tok-1",
+	}))
+	if isError, _ := deleteResp.Result["isError"].(bool); isError {
+		t.Fatalf("delete_task dry_run returned isError=true: %#v", deleteResp.Result)
+	}
+	if !service.lastDeleteTaskReq.DryRun {
+		t.Fatal("delete_task dry_run = false, want true")
+	}
+	deletePreview := toolResultStructured(t, deleteResp.Result)
+	if deleted, _ := deletePreview["deleted"].(bool); deleted {
+		t.Fatalf("delete_task dry_run reported deleted=true: %#v", deletePreview)
+	}
+
+	_, realMoveResp := postJSONRPC(t, server.Client(), server.URL, callToolRequest(723, "till.move_task", map[string]any{
+		"task_id":           "t1",
+		"to_column_id":      "c2",
+		"position":          1,
+		"actor_type":        "agent_orchestrator",
+		"agent_name":        "agent-1",
+		"agent_instance_id": "inst-1",
+		"lease_token":       "tok-1",
+	}))
+	if service.lastMoveTaskReq.DryRun {
+		t.Fatal("move_task without dry_run forwarded DryRun=true")
+	}
+	if _, ok := toolResultStructured(t, realMoveResp.Result)["dry_run"]; ok {
+		t.Fatal("persisted move_task result unexpectedly carried dry_run marker")
+	}
+}
+
+// appBackedFixture holds one MCP server over a real app service with a seeded project and an agent lease.
+type appBackedFixture struct {
+	server  *httptest.Server
+	service *app.Service
+	project domain.Project
+	lease   domain.CapabilityLease
+}
+
+// leaseArgs returns the agent tuple arguments authenticated task tools require, merged into args.
+func (f appBackedFixture) leaseArgs(args map[string]any) map[string]any {
+	args["actor_type"] = "agent_orchestrator"
+	args["agent_name"] = f.lease.AgentName
+	args["agent_instance_id"] = f.lease.InstanceID
+	args["lease_token"] = f.lease.LeaseToken
+	return args
+}
+
+// newAppBackedFixture builds one MCP server over an in-memory app service with a "Roadmap" project.
+func newAppBackedFixture(t *testing.T, cfg app.ServiceConfig, policy common.LabelPolicy) appBackedFixture {
+	t.Helper()
+	repo, err := sqlite.OpenInMemory()
+	if err != nil {
+		t.Fatalf("OpenInMemory() error = %v", err)
+	}
+	t.Cleanup(func() {
+		_ = repo.Close()
+	})
+	nextID := 0
+	cfg.AutoCreateProjectColumns = true
+	service := app.NewService(repo, func() string {
+		nextID++
+		return "id-" + strconv.Itoa(nextID)
+	}, func() time.Time {
+		return time.Date(2026, 2, 24, 12, 0, 0, 0, time.UTC)
+	}, cfg)
+	adapter := common.NewAppServiceAdapter(service).WithLabelPolicy(policy)
+
+	ctx := context.Background()
+	project, err := service.CreateProject(ctx, "Roadmap", "")
+	if err != nil {
+		t.Fatalf("CreateProject() error = %v", err)
+	}
+	lease, err := adapter.IssueCapabilityLease(ctx, common.IssueCapabilityLeaseRequest{
+		ProjectID:       project.ID,
+		ScopeType:       string(domain.CapabilityScopeProject),
+		ScopeID:         project.ID,
+		Role:            string(domain.CapabilityRoleWorker),
+		AgentName:       "agent-1",
+		AgentInstanceID: "agent-1-instance",
+	})
+	if err != nil {
+		t.Fatalf("IssueCapabilityLease() error = %v", err)
+	}
+	handler, err := NewHandler(Config{}, adapter, adapter)
+	if err != nil {
+		t.Fatalf("NewHandler() error = %v", err)
+	}
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	_, _ = postJSONRPC(t, server.Client(), server.URL, initializeRequest())
+	return appBackedFixture{server: server, service: service, project: project, lease: lease}
+}
+
+// TestHandlerCreateTaskByProjectSlugEnforcesLabels verifies create_task resolves slugs, defaults the column, and checks labels.
+func TestHandlerCreateTaskByProjectSlugEnforcesLabels(t *testing.T) {
+	fixture := newAppBackedFixture(t, app.ServiceConfig{}, common.LabelPolicy{
+		Enforce: true,
+		Allowed: func(projectSlug string) []string {
+			if projectSlug == "roadmap" {
+				return []string{"bug", "ux"}
+			}
+			return nil
+		},
+	})
+	client, url := fixture.server.Client(), fixture.server.URL
+
+	_, createResp := postJSONRPC(t, client, url, callToolRequest(500, "till.create_task", fixture.leaseArgs(map[string]any{
+		"project_slug": "roadmap",
+		"title":        "Fix login redirect",
+		"description":  "Redirect loops after SSO.",
+		"priority":     "high",
+		"labels":       []any{"Bug"},
+		"due_at":       "2026-03-01T09:00:00Z",
+	})))
+	if isError, _ := createResp.Result["isError"].(bool); isError {
+		t.Fatalf("create_task returned isError=true: %#v", createResp.Result)
+	}
+	created := toolResultStructured(t, createResp.Result)
+	taskID, _ := created["ID"].(string)
+	if taskID == "" {
+		t.Fatalf("create_task result missing task id: %#v", created)
+	}
+	tasks, err := fixture.service.ListTasks(context.Background(), fixture.project.ID, false)
+	if err != nil || len(tasks) != 1 || tasks[0].ID != taskID {
+		t.Fatalf("ListTasks() = %#v, err %v; want the created task", tasks, err)
+	}
+	columns, err := fixture.service.ListColumns(context.Background(), fixture.project.ID, false)
+	if err != nil || len(columns) == 0 {
+		t.Fatalf("ListColumns() = %d columns, err %v", len(columns), err)
+	}
+	if tasks[0].ColumnID != columns[0].ID || tasks[0].Priority != domain.PriorityHigh || tasks[0].DueAt == nil {
+		t.Fatalf("created task = %#v, want first column, high priority, and a due date", tasks[0])
+	}
+
+	// Failures come back as tool errors that say what to change.
+	cases := []struct {
+		name string
+		args map[string]any
+		want string
+	}{
+		{name: "unknown slug", args: map[string]any{"project_slug": "nope", "title": "x"}, want: "not_found:"},
+		{name: "missing project", args: map[string]any{"title": "x"}, want: `"project_id" or "project_slug"`},
+		{name: "disallowed label", args: map[string]any{"project_slug": "roadmap", "title": "x", "labels": []any{"bug", "chore"}}, want: "labels not allowed for project \"roadmap\": chore (allowed: bug, ux)"},
+	}
+	for idx, tc := range cases {
+		_, resp := postJSONRPC(t, client, url, callToolRequest(501+idx, "till.create_task", fixture.leaseArgs(tc.args)))
+		if isError, _ := resp.Result["isError"].(bool); !isError {
+			t.Fatalf("%s: expected isError=true, got %#v", tc.name, resp.Result)
+		}
+		if text := toolResultText(t, resp.Result); !strings.Contains(text, tc.want) {
+			t.Fatalf("%s: error text = %q, want %q", tc.name, text, tc.want)
+		}
+	}
+	if tasks, _ := fixture.service.ListTasks(context.Background(), fixture.project.ID, false); len(tasks) != 1 {
+		t.Fatalf("expected rejected calls to create nothing, got %d tasks", len(tasks))
+	}
+
+	// Updates go through the same allowlist.
+	_, updateResp := postJSONRPC(t, client, url, callToolRequest(510, "till.update_task", fixture.leaseArgs(map[string]any{
+		"task_id": taskID,
+		"title":   "Fix login redirect",
+		"labels":  []any{"ux", "chore"},
+	})))
+	if isError, _ := updateResp.Result["isError"].(bool); !isError {
+		t.Fatalf("update_task: expected isError=true, got %#v", updateResp.Result)
+	}
+	if text := toolResultText(t, updateResp.Result); !strings.Contains(text, "labels not allowed for project \"roadmap\": chore") {
+		t.Fatalf("update_task: error text = %q, want the disallowed label", text)
+	}
+}
+
+// TestHandlerMoveTaskByColumnNameHonorsWIPLimits verifies move_task resolves column names, appends, and enforces WIP limits.
+func TestHandlerMoveTaskByColumnNameHonorsWIPLimits(t *testing.T) {
+	fixture := newAppBackedFixture(t, app.ServiceConfig{DefaultColumnWIPLimits: map[string]int{"progress": 1}}, common.LabelPolicy{})
+	client, url := fixture.server.Client(), fixture.server.URL
+	ctx := context.Background()
+	columns, err := fixture.service.ListColumns(ctx, fixture.project.ID, false)
+	if err != nil || len(columns) < 3 {
+		t.Fatalf("ListColumns() = %d columns, err %v; want auto-created columns", len(columns), err)
+	}
+	taskIDs := make([]string, 0, 2)
+	for _, title := range []string{"First", "Second"} {
+		task, err := fixture.service.CreateTask(ctx, app.CreateTaskInput{
+			ProjectID: fixture.project.ID,
+			ColumnID:  columns[0].ID,
+			Title:     title,
+			Priority:  domain.PriorityMedium,
+		})
+		if err != nil {
+			t.Fatalf("CreateTask(%s) error = %v", title, err)
+		}
+		taskIDs = append(taskIDs, task.ID)
+	}
+	move := func(id int, args map[string]any) jsonRPCResponse {
+		t.Helper()
+		_, resp := postJSONRPC(t, client, url, callToolRequest(id, "till.move_task", fixture.leaseArgs(args)))
+		return resp
+	}
+
+	resp := move(600, map[string]any{"task_id": taskIDs[0], "to_column": "in progress"})
+	if isError, _ := resp.Result["isError"].(bool); isError {
+		t.Fatalf("move_task returned isError=true: %#v", resp.Result)
+	}
+	moved := toolResultStructured(t, resp.Result)
+	if moved["ColumnID"] != columns[1].ID || moved["LifecycleState"] != string(domain.StateProgress) {
+		t.Fatalf("move_task result = %#v, want the In Progress column and progress state", moved)
+	}
+
+	// The column is at its limit, so the second move fails with a structured error that says how to proceed.
+	resp = move(601, map[string]any{"task_id": taskIDs[1], "to_column": "In Progress"})
+	if isError, _ := resp.Result["isError"].(bool); !isError {
+		t.Fatalf("expected WIP-limited move to fail, got %#v", resp.Result)
+	}
+	detail, _ := toolResultStructured(t, resp.Result)["error"].(map[string]any)
+	if detail["code"] != "wip_limit_exceeded" || !strings.Contains(fmt.Sprint(detail["hint"]), "override_wip_limit=true") {
+		t.Fatalf("structured error = %#v, want wip_limit_exceeded with an override hint", detail)
+	}
+
+	resp = move(602, map[string]any{"task_id": taskIDs[1], "to_column": "In Progress", "override_wip_limit": true})
+	if isError, _ := resp.Result["isError"].(bool); isError {
+		t.Fatalf("override move returned isError=true: %#v", resp.Result)
+	}
+	if got := toolResultStructured(t, resp.Result)["Position"]; got != float64(1) {
+		t.Fatalf("appended position = %v, want 1", got)
+	}
+
+	resp = move(603, map[string]any{"task_id": taskIDs[0], "to_column": "Shipped"})
+	detail, _ = toolResultStructured(t, resp.Result)["error"].(map[string]any)
+	if detail["code"] != "not_found" || !strings.Contains(fmt.Sprint(detail["message"]), "columns: To Do, In Progress, Done") {
+		t.Fatalf("structured error = %#v, want not_found listing the project's columns", detail)
+	}
+}
@@ -54,6 +54,7 @@ func NewHandler(cfg Config, captureState common.CaptureStateReader, attention co
 	registerKindTools(mcpSrv, pickKindCatalogService(captureState, attention))
 	registerCapabilityLeaseTools(mcpSrv, pickCapabilityLeaseService(captureState, attention))
 	registerCommentTools(mcpSrv, pickCommentService(captureState, attention))
+	registerBoardResource(mcpSrv, pickBoardStateReader(captureState, attention))
 
 	streamable := mcpserver.NewStreamableHTTPServer(
 		mcpSrv,
@@ -259,7 +260,13 @@ func toolResultFromError(err error) *mcp.CallToolResult {
 		"err",
 		err,
 	)
-	return mcp.NewToolResultError(mapped.Text)
+	result := mcp.NewToolResultStructured(toolErrorPayload{Error: toolErrorDetail{
+		Code:    mapped.Code,
+		Message: errorMessage(err),
+		Hint:    mapped.Hint,
+	}}, mapped.Text)
+	result.IsError = true
+	return result
 }
 
 // toolErrorMapping captures one mapped MCP tool error classification and payload text.
@@ -267,6 +274,28 @@ type toolErrorMapping struct {
 	Class string
 	Code  string
 	Text  string
+	// Hint suggests how the caller can recover, when there is a known way.
+	Hint string
+}
+
+// toolErrorPayload is the structured content of a tool error, so agents can branch on the code instead of parsing text.
+type toolErrorPayload struct {
+	Error toolErrorDetail `json:"error"`
+}
+
+// toolErrorDetail describes one tool error.
+type toolErrorDetail struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Hint    string `json:"hint,omitempty"`
+}
+
+// errorMessage returns err's text, or "unknown error" for nil.
+func errorMessage(err error) string {
+	if err == nil {
+		return "unknown error"
+	}
+	return err.Error()
 }
 
 // mapToolError converts one service error into MCP tool error metadata and response text.
@@ -283,6 +312,7 @@ func mapToolError(err error) toolErrorMapping {
 			Class: "bootstrap",
 			Code:  "bootstrap_required",
 			Text:  "bootstrap_required: " + err.Error(),
+			Hint:  "Call till.get_bootstrap_guide, then create the first project.",
 		}
 	case errors.Is(err, common.ErrGuardrailViolation):
 		return toolErrorMapping{
@@ -290,6 +320,19 @@ func mapToolError(err error) toolErrorMapping {
 			Code:  "guardrail_failed",
 			Text:  "guardrail_failed: " + err.Error(),
 		}
+	case errors.Is(err, common.ErrConflict):
+		return toolErrorMapping{
+			Class: "conflict",
+			Code:  "conflict",
+			Text:  "conflict: " + err.Error(),
+		}
+	case errors.Is(err, common.ErrWIPLimitExceeded):
+		return toolErrorMapping{
+			Class: "wip_limit",
+			Code:  "wip_limit_exceeded",
+			Text:  "wip_limit_exceeded: " + err.Error(),
+			Hint:  "Retry with override_wip_limit=true to move the task anyway.",
+		}
 	case errors.Is(err, common.ErrInvalidCaptureStateRequest), errors.Is(err, common.ErrUnsupportedScope):
 		return toolErrorMapping{
 			Class: "invalid",
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"slices"
@@ -678,6 +679,20 @@ func TestToolResultFromErrorMapping(t *testing.T) {
 			wantLogCode:  "not_found",
 			wantLogClass: "not_found",
 		},
+		{
+			name:         "conflict",
+			err:          errors.Join(common.ErrConflict, errors.New("stale write")),
+			wantPrefix:   "conflict:",
+			wantLogCode:  "conflict",
+			wantLogClass: "conflict",
+		},
+		{
+			name:         "wip limit exceeded",
+			err:          errors.Join(common.ErrWIPLimitExceeded, errors.New("column full")),
+			wantPrefix:   "wip_limit_exceeded:",
+			wantLogCode:  "wip_limit_exceeded",
+			wantLogClass: "wip_limit",
+		},
 		{
 			name:         "attention unavailable",
 			err:          errors.Join(common.ErrAttentionUnavailable, errors.New("disabled")),
@@ -706,6 +721,11 @@ func TestToolResultFromErrorMapping(t *testing.T) {
 			if got := callToolResultText(t, result); !strings.HasPrefix(got, tt.wantPrefix) {
 				t.Fatalf("text = %q, want prefix %q", got, tt.wantPrefix)
 			}
+			// The same classification rides along as structured content for agents that branch on codes.
+			payload, ok := result.StructuredContent.(toolErrorPayload)
+			if !ok || payload.Error.Code != tt.wantLogCode || payload.Error.Message != errorMessage(tt.err) {
+				t.Fatalf("structured content = %#v, want code %q and the error message", result.StructuredContent, tt.wantLogCode)
+			}
 			if got := logOutput.String(); !strings.Contains(got, "mcp tool error mapped") {
 				t.Fatalf("log output = %q, want message marker", got)
 			}
@@ -914,3 +934,106 @@ func TestHandlerAttentionToolCallErrorMapping(t *testing.T) {
 		t.Fatalf("error text = %q, want prefix not_found:", got)
 	}
 }
+
+// stubBoardStateReader provides deterministic board-state responses for MCP resource tests.
+type stubBoardStateReader struct {
+	stubCaptureStateReader
+	state       common.BoardState
+	err         error
+	lastRequest common.BoardStateRequest
+}
+
+// GetBoardState records the latest request and returns one fixture board.
+func (s *stubBoardStateReader) GetBoardState(_ context.Context, req common.BoardStateRequest) (common.BoardState, error) {
+	s.lastRequest = req
+	if s.err != nil {
+		return common.BoardState{}, s.err
+	}
+	return s.state, nil
+}
+
+// readResourceRequest constructs one deterministic resources/read JSON-RPC request payload.
+func readResourceRequest(id int, uri string) map[string]any {
+	return map[string]any{
+		"jsonrpc": "2.0",
+		"id":      id,
+		"method":  "resources/read",
+		"params": map[string]any{
+			"uri": uri,
+		},
+	}
+}
+
+// TestHandlerBoardResourceRead verifies board:// reads return JSON board state and honor include_archived.
+func TestHandlerBoardResourceRead(t *testing.T) {
+	boards := &stubBoardStateReader{
+		state: common.BoardState{
+			ProjectID:   "p1",
+			ProjectSlug: "roadmap",
+			ProjectName: "Roadmap",
+			Columns: []common.BoardColumn{{
+				ID:   "c1",
+				Name: "To Do",
+				Tasks: []common.BoardTask{{
+					ID:             "t1",
+					Title:          "Ship it",
+					LifecycleState: domain.StateTodo,
+					DependsOn:      []string{"t0"},
+				}},
+			}},
+		},
+	}
+	handler, err := NewHandler(Config{}, boards, nil)
+	if err != nil {
+		t.Fatalf("NewHandler() error = %v", err)
+	}
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	_, _ = postJSONRPC(t, server.Client(), server.URL, initializeRequest())
+
+	_, listResp := postJSONRPC(t, server.Client(), server.URL, map[string]any{
+		"jsonrpc": "2.0",
+		"id":      2,
+		"method":  "resources/templates/list",
+	})
+	templates, _ := listResp.Result["resourceTemplates"].([]any)
+	if len(templates) != 1 || !strings.Contains(fmt.Sprint(templates[0]), boardResourceTemplate) {
+		t.Fatalf("resource templates = %#v, want %q", listResp.Result, boardResourceTemplate)
+	}
+
+	_, readResp := postJSONRPC(t, server.Client(), server.URL, readResourceRequest(3, "board://roadmap"))
+	contents, _ := readResp.Result["contents"].([]any)
+	if len(contents) != 1 {
+		t.Fatalf("resources/read result = %#v, want one content block", readResp)
+	}
+	block, _ := contents[0].(map[string]any)
+	if block["mimeType"] != "application/json" {
+		t.Fatalf("mimeType = %#v, want application/json", block["mimeType"])
+	}
+	var decoded common.BoardState
+	if err := json.Unmarshal([]byte(fmt.Sprint(block["text"])), &decoded); err != nil {
+		t.Fatalf("Unmarshal(board) error = %v", err)
+	}
+	if decoded.ProjectSlug != "roadmap" || len(decoded.Columns) != 1 || decoded.Columns[0].Tasks[0].DependsOn[0] != "t0" {
+		t.Fatalf("board payload = %#v", decoded)
+	}
+	if boards.lastRequest.ProjectSlug != "roadmap" || boards.lastRequest.IncludeArchived {
+		t.Fatalf("board request = %#v, want roadmap without archived rows", boards.lastRequest)
+	}
+
+	_, _ = postJSONRPC(t, server.Client(), server.URL, readResourceRequest(4, "board://roadmap?include_archived=true"))
+	if !boards.lastRequest.IncludeArchived {
+		t.Fatal("include_archived = false, want true from the resource query")
+	}
+
+	// Invalid query values and unknown projects surface as JSON-RPC errors.
+	_, badResp := postJSONRPC(t, server.Client(), server.URL, readResourceRequest(5, "board://roadmap?include_archived=maybe"))
+	if !strings.Contains(fmt.Sprint(badResp.Error["message"]), "invalid_request") {
+		t.Fatalf("invalid query error = %#v, want invalid_request", badResp.Error)
+	}
+	boards.err = fmt.Errorf("project %q: %w", "missing", common.ErrNotFound)
+	_, missingResp := postJSONRPC(t, server.Client(), server.URL, readResourceRequest(6, "board://missing"))
+	if !strings.Contains(fmt.Sprint(missingResp.Error["message"]), "not_found") {
+		t.Fatalf("missing project error = %#v, want not_found", missingResp.Error)
+	}
+}
@@ -3,20 +3,33 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"net"
 	"net/http"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/charmbracelet/log"
 	"github.com/hylla/tillsyn/internal/adapters/server/common"
 	"github.com/hylla/tillsyn/internal/adapters/server/httpapi"
 	"github.com/hylla/tillsyn/internal/adapters/server/mcpapi"
+	"github.com/hylla/tillsyn/internal/domain"
 )
 
 // defaultBindAddress defines the localhost-first serve default.
 const defaultBindAddress = "127.0.0.1:5437"
 
+// readinessTimeout bounds the database query behind /readyz so a wedged store fails the probe instead of hanging it.
+const readinessTimeout = 2 * time.Second
+
+// readinessCacheTTL is how long one /readyz result answers later probes, so a probe flood costs one query per interval.
+const readinessCacheTTL = time.Second
+
 // defaultShutdownTimeout bounds graceful shutdown time once context cancellation starts.
 const defaultShutdownTimeout = 5 * time.Second
 
@@ -27,6 +40,14 @@ type Config struct {
 	MCPEndpoint   string
 	ServerName    string
 	ServerVersion string
+	// ShutdownTimeout bounds how long in-flight requests may drain after cancellation; zero uses the default.
+	ShutdownTimeout time.Duration
+	// APIToken, when set, requires `Authorization: Bearer <token>` on API and MCP requests; health probes stay open.
+	APIToken string
+	// RateLimit caps API and MCP requests per second from one client IP (one /64 for IPv6); zero disables limiting.
+	RateLimit float64
+	// RateBurst is how many requests one client IP may send at once before RateLimit applies; zero uses the rate rounded up.
+	RateBurst int
 }
 
 // Dependencies defines app-facing adapters required by server transports.
@@ -59,33 +80,48 @@ func NewHandler(cfg Config, deps Dependencies) (http.Handler, Config, error) {
 	}
 	apiHandler := httpapi.NewHandler(deps.CaptureState, deps.Attention)
 
+	// One limiter serves both transports so a client's allowance is shared across them.
+	limiter := newRateLimiter(normalizedCfg.RateLimit, normalizedCfg.RateBurst, nil)
+	protectedMCP := protect(mcpHandler, normalizedCfg, limiter)
+	protectedAPI := protect(http.StripPrefix(normalizedCfg.APIEndpoint, apiHandler), normalizedCfg, limiter)
+
 	mux := http.NewServeMux()
 	mux.HandleFunc("/healthz", writeHealthStatus)
-	mux.HandleFunc("/readyz", writeHealthStatus)
-	mux.Handle(normalizedCfg.MCPEndpoint, mcpHandler)
-	mux.Handle(normalizedCfg.APIEndpoint, http.StripPrefix(normalizedCfg.APIEndpoint, apiHandler))
-	mux.Handle(normalizedCfg.APIEndpoint+"/", http.StripPrefix(normalizedCfg.APIEndpoint, apiHandler))
+	mux.Handle("/readyz", readinessHandler(newReadinessProbe(pickProjectLister(deps), readinessTimeout, readinessCacheTTL, nil)))
+	mux.Handle(normalizedCfg.MCPEndpoint, protectedMCP)
+	mux.Handle(normalizedCfg.APIEndpoint, protectedAPI)
+	mux.Handle(normalizedCfg.APIEndpoint+"/", protectedAPI)
 	return mux, normalizedCfg, nil
 }
 
 // Run starts the composed HTTP server and blocks until shutdown or startup failure.
+// Cancelling ctx stops accepting connections and drains in-flight requests for up to cfg.ShutdownTimeout.
 func Run(ctx context.Context, cfg Config, deps Dependencies) error {
-	if ctx == nil {
-		ctx = context.Background()
-	}
-
 	handler, normalizedCfg, err := NewHandler(cfg, deps)
 	if err != nil {
 		return fmt.Errorf("build server handler: %w", err)
 	}
+	listener, err := net.Listen("tcp", normalizedCfg.HTTPBind)
+	if err != nil {
+		return fmt.Errorf("listen and serve: %w", err)
+	}
+	return serveListener(ctx, listener, handler, normalizedCfg.ShutdownTimeout)
+}
+
+// serveListener serves handler on listener until ctx is cancelled, then shuts down gracefully.
+// Requests still running when shutdownTimeout elapses are cut off by force-closing their connections.
+func serveListener(ctx context.Context, listener net.Listener, handler http.Handler, shutdownTimeout time.Duration) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	tracker := &inFlightTracker{}
 	httpServer := &http.Server{
-		Addr:    normalizedCfg.HTTPBind,
-		Handler: handler,
+		Handler: tracker.wrap(handler),
 	}
 
 	serveErrCh := make(chan error, 1)
 	go func() {
-		serveErrCh <- httpServer.ListenAndServe()
+		serveErrCh <- httpServer.Serve(listener)
 	}()
 
 	select {
@@ -95,10 +131,19 @@ func Run(ctx context.Context, cfg Config, deps Dependencies) error {
 		}
 		return fmt.Errorf("listen and serve: %w", err)
 	case <-ctx.Done():
-		shutdownCtx, cancel := context.WithTimeout(context.Background(), defaultShutdownTimeout)
+		inFlight := tracker.active()
+		log.Info("server shutting down", "in_flight", inFlight, "timeout", shutdownTimeout)
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
 		defer cancel()
 
 		shutdownErr := httpServer.Shutdown(shutdownCtx)
+		if errors.Is(shutdownErr, context.DeadlineExceeded) {
+			abandoned := tracker.active()
+			log.Warn("server shutdown timed out; forcing close", "drained", inFlight-abandoned, "abandoned", abandoned)
+			_ = httpServer.Close()
+		} else {
+			log.Info("server drained in-flight requests", "drained", inFlight)
+		}
 		serveErr := <-serveErrCh
 		if shutdownErr != nil && !errors.Is(shutdownErr, context.Canceled) {
 			return fmt.Errorf("shutdown server: %w", shutdownErr)
@@ -110,6 +155,25 @@ func Run(ctx context.Context, cfg Config, deps Dependencies) error {
 	}
 }
 
+// inFlightTracker counts requests currently inside the served handler.
+type inFlightTracker struct {
+	count atomic.Int64
+}
+
+// wrap returns a handler that counts each request for its whole duration.
+func (t *inFlightTracker) wrap(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.count.Add(1)
+		defer t.count.Add(-1)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// active reports the number of requests currently in flight.
+func (t *inFlightTracker) active() int64 {
+	return t.count.Load()
+}
+
 // normalizeConfig applies defaults and validates endpoint collisions.
 func normalizeConfig(cfg Config) (Config, error) {
 	cfg.HTTPBind = strings.TrimSpace(cfg.HTTPBind)
@@ -131,6 +195,19 @@ func normalizeConfig(cfg Config) (Config, error) {
 	if cfg.ServerVersion == "" {
 		cfg.ServerVersion = "dev"
 	}
+	if cfg.ShutdownTimeout < 0 {
+		return Config{}, fmt.Errorf("shutdown timeout must be >= 0, got %s", cfg.ShutdownTimeout)
+	}
+	if cfg.ShutdownTimeout == 0 {
+		cfg.ShutdownTimeout = defaultShutdownTimeout
+	}
+	cfg.APIToken = strings.TrimSpace(cfg.APIToken)
+	if cfg.RateLimit < 0 || math.IsNaN(cfg.RateLimit) || math.IsInf(cfg.RateLimit, 0) {
+		return Config{}, fmt.Errorf("rate limit must be a finite number >= 0, got %v", cfg.RateLimit)
+	}
+	if cfg.RateBurst < 0 {
+		return Config{}, fmt.Errorf("rate burst must be >= 0, got %d", cfg.RateBurst)
+	}
 	return cfg, nil
 }
 
@@ -150,9 +227,87 @@ func normalizeEndpoint(path string, fallback string) string {
 	return path
 }
 
-// writeHealthStatus responds with a deterministic readiness payload.
+// writeHealthStatus responds with a deterministic liveness payload; it answers 200 whenever the process is serving.
 func writeHealthStatus(w http.ResponseWriter, _ *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	_, _ = w.Write([]byte(`{"status":"ok"}` + "\n"))
 }
+
+// projectLister is the cheap read /readyz issues to prove the database answers queries.
+type projectLister interface {
+	ListProjects(context.Context, bool) ([]domain.Project, error)
+}
+
+// pickProjectLister returns the first dependency able to list projects, or nil when none can.
+func pickProjectLister(deps Dependencies) projectLister {
+	if lister, ok := deps.CaptureState.(projectLister); ok {
+		return lister
+	}
+	if lister, ok := deps.Attention.(projectLister); ok {
+		return lister
+	}
+	return nil
+}
+
+// readinessStatus is the /readyz response body. Failures stay generic; the store error is only logged.
+type readinessStatus struct {
+	Status string `json:"status"`
+}
+
+// readinessProbe runs the /readyz database check at most once per ttl; concurrent probes wait for the running check
+// and share its result.
+type readinessProbe struct {
+	mu        sync.Mutex
+	lister    projectLister
+	timeout   time.Duration
+	ttl       time.Duration
+	now       func() time.Time
+	checkedAt time.Time
+	err       error
+}
+
+// newReadinessProbe builds a probe over lister, or returns nil when there is no store to check.
+func newReadinessProbe(lister projectLister, timeout, ttl time.Duration, now func() time.Time) *readinessProbe {
+	if lister == nil {
+		return nil
+	}
+	if now == nil {
+		now = time.Now
+	}
+	return &readinessProbe{lister: lister, timeout: timeout, ttl: ttl, now: now}
+}
+
+// check returns the last result while it is fresh and otherwise lists projects within the timeout.
+func (p *readinessProbe) check(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.checkedAt.IsZero() && p.now().Sub(p.checkedAt) < p.ttl {
+		return p.err
+	}
+	// The shared result must not depend on one caller hanging up, so only the timeout bounds the query.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), p.timeout)
+	defer cancel()
+	_, p.err = p.lister.ListProjects(ctx, false)
+	p.checkedAt = p.now()
+	return p.err
+}
+
+// readinessHandler answers 200 while the probe's project listing succeeds and 503 otherwise.
+// Without a probe there is nothing to check, so readiness matches liveness.
+func readinessHandler(probe *readinessProbe) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if probe == nil {
+			writeHealthStatus(w, r)
+			return
+		}
+		status, body := http.StatusOK, readinessStatus{Status: "ok"}
+		if err := probe.check(r.Context()); err != nil {
+			log.Warn("readiness check failed", "err", err)
+			status, body = http.StatusServiceUnavailable, readinessStatus{Status: "unavailable"}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(body)
+	})
+}
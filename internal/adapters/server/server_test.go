@@ -0,0 +1,247 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hylla/tillsyn/internal/adapters/server/common"
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// startSlowServer serves a handler that blocks until release is closed and reports each started request on started.
+func startSlowServer(t *testing.T, ctx context.Context, shutdownTimeout time.Duration) (string, chan struct{}, chan struct{}, chan error) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen() error = %v", err)
+	}
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		started <- struct{}{}
+		<-release
+		_, _ = io.WriteString(w, "done")
+	})
+	done := make(chan error, 1)
+	go func() {
+		done <- serveListener(ctx, listener, handler, shutdownTimeout)
+	}()
+	return "http://" + listener.Addr().String(), started, release, done
+}
+
+// TestServeListenerDrainsInFlightRequests verifies cancellation waits for running handlers to finish.
+func TestServeListenerDrainsInFlightRequests(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	url, started, release, done := startSlowServer(t, ctx, 5*time.Second)
+
+	respCh := make(chan *http.Response, 1)
+	errCh := make(chan error, 1)
+	go func() {
+		resp, err := http.Get(url)
+		if err != nil {
+			errCh <- err
+			return
+		}
+		respCh <- resp
+	}()
+	<-started
+	cancel()
+
+	// The server must keep running until the in-flight handler returns.
+	select {
+	case err := <-done:
+		t.Fatalf("serveListener returned %v before the in-flight request finished", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(release)
+
+	select {
+	case resp := <-respCh:
+		body, _ := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if resp.StatusCode != http.StatusOK || string(body) != "done" {
+			t.Fatalf("drained response = %d %q, want 200 done", resp.StatusCode, body)
+		}
+	case err := <-errCh:
+		t.Fatalf("in-flight request failed during shutdown: %v", err)
+	}
+	if err := <-done; err != nil {
+		t.Fatalf("serveListener() error = %v, want nil after draining", err)
+	}
+}
+
+// TestServeListenerForcesCloseAfterTimeout verifies handlers running past the shutdown timeout are cut off.
+func TestServeListenerForcesCloseAfterTimeout(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	url, started, release, done := startSlowServer(t, ctx, 20*time.Millisecond)
+	defer close(release)
+
+	go func() {
+		resp, err := http.Get(url)
+		if err == nil {
+			_ = resp.Body.Close()
+		}
+	}()
+	<-started
+	cancel()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("serveListener() error = %v, want context.DeadlineExceeded", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("serveListener did not force close after the shutdown timeout")
+	}
+}
+
+// TestNormalizeConfigShutdownTimeout verifies the shutdown timeout default and validation.
+func TestNormalizeConfigShutdownTimeout(t *testing.T) {
+	cfg, err := normalizeConfig(Config{})
+	if err != nil {
+		t.Fatalf("normalizeConfig() error = %v", err)
+	}
+	if cfg.ShutdownTimeout != defaultShutdownTimeout {
+		t.Fatalf("shutdown timeout = %s, want %s", cfg.ShutdownTimeout, defaultShutdownTimeout)
+	}
+	if _, err := normalizeConfig(Config{ShutdownTimeout: -time.Second}); err == nil {
+		t.Fatal("expected negative shutdown timeout to be rejected")
+	}
+}
+
+// TestNormalizeConfigAccessControls verifies token trimming and rate validation.
+func TestNormalizeConfigAccessControls(t *testing.T) {
+	cfg, err := normalizeConfig(Config{APIToken: "  s3cret \n", RateLimit: 2, RateBurst: 4})
+	if err != nil {
+		t.Fatalf("normalizeConfig() error = %v", err)
+	}
+	if cfg.APIToken != "s3cret" {
+		t.Fatalf("api token = %q, want trimmed s3cret", cfg.APIToken)
+	}
+	if _, err := normalizeConfig(Config{RateLimit: -1}); err == nil {
+		t.Fatal("expected negative rate limit to be rejected")
+	}
+	if _, err := normalizeConfig(Config{RateBurst: -1}); err == nil {
+		t.Fatal("expected negative rate burst to be rejected")
+	}
+}
+
+// stubProjectStore serves capture_state and project listings, failing or blocking listings on demand.
+type stubProjectStore struct {
+	err   error
+	block bool
+	calls *int
+}
+
+// CaptureState returns an empty capture so the stub satisfies the required dependency.
+func (s stubProjectStore) CaptureState(context.Context, common.CaptureStateRequest) (common.CaptureState, error) {
+	return common.CaptureState{}, nil
+}
+
+// ListProjects returns no projects, the configured error, or waits for the caller's deadline.
+func (s stubProjectStore) ListProjects(ctx context.Context, _ bool) ([]domain.Project, error) {
+	if s.calls != nil {
+		*s.calls++
+	}
+	if s.block {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	return nil, s.err
+}
+
+// TestHealthAndReadinessProbes verifies liveness always passes while readiness reflects the store.
+func TestHealthAndReadinessProbes(t *testing.T) {
+	tests := []struct {
+		name       string
+		store      stubProjectStore
+		wantReady  int
+		wantStatus string
+	}{
+		{name: "store answers", store: stubProjectStore{}, wantReady: http.StatusOK, wantStatus: "ok"},
+		{name: "store fails", store: stubProjectStore{err: errors.New("database is locked")}, wantReady: http.StatusServiceUnavailable, wantStatus: "unavailable"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The token must not gate probes, so supervisors need no credentials.
+			handler, _, err := NewHandler(Config{APIToken: "s3cret"}, Dependencies{CaptureState: tt.store})
+			if err != nil {
+				t.Fatalf("NewHandler() error = %v", err)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+			if rec.Code != http.StatusOK {
+				t.Fatalf("healthz status = %d, want 200", rec.Code)
+			}
+
+			rec = httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+			if rec.Code != tt.wantReady {
+				t.Fatalf("readyz status = %d, want %d", rec.Code, tt.wantReady)
+			}
+			// Probes are unauthenticated, so store errors must never reach the body.
+			if strings.Contains(rec.Body.String(), "database") {
+				t.Fatalf("readyz body %q leaks the store error", rec.Body.String())
+			}
+			var body readinessStatus
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatalf("decode readyz body %q: %v", rec.Body.String(), err)
+			}
+			if body.Status != tt.wantStatus {
+				t.Fatalf("readyz status = %q, want %q", body.Status, tt.wantStatus)
+			}
+		})
+	}
+}
+
+// TestReadinessHandlerTimesOut verifies a store that never answers fails the probe once the timeout passes.
+func TestReadinessHandlerTimesOut(t *testing.T) {
+	handler := readinessHandler(newReadinessProbe(stubProjectStore{block: true}, 20*time.Millisecond, readinessCacheTTL, nil))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("readyz status = %d, want 503", rec.Code)
+	}
+	var body readinessStatus
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("decode readyz body %q: %v", rec.Body.String(), err)
+	}
+	if body.Status != "unavailable" {
+		t.Fatalf("readyz body = %+v, want unavailable", body)
+	}
+}
+
+// TestReadinessProbeCachesResult verifies probes within the TTL share one store query.
+func TestReadinessProbeCachesResult(t *testing.T) {
+	calls := 0
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	handler := readinessHandler(newReadinessProbe(stubProjectStore{calls: &calls}, time.Second, time.Second, func() time.Time { return now }))
+	probe := func() {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("readyz status = %d, want 200", rec.Code)
+		}
+	}
+	for range 5 {
+		probe()
+	}
+	if calls != 1 {
+		t.Fatalf("store queried %d times within the ttl, want 1", calls)
+	}
+	now = now.Add(time.Second)
+	probe()
+	if calls != 2 {
+		t.Fatalf("store queried %d times after the ttl, want 2", calls)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"math/bits"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -71,6 +72,39 @@ func Open(path string) (*Repository, error) {
 	return repo, nil
 }
 
+// OpenReadOnly opens one existing database without migrating or writing to it.
+// It serves sessions whose database path cannot be written, so the schema must already exist.
+func OpenReadOnly(path string) (*Repository, error) {
+	if strings.TrimSpace(path) == "" {
+		return nil, errors.New("sqlite path is required")
+	}
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("stat sqlite: %w", err)
+	}
+	dsn := "file:" + (&url.URL{Path: filepath.ToSlash(path)}).EscapedPath() + "?mode=ro"
+	db, err := sql.Open(driverName, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("open sqlite read-only: %w", err)
+	}
+	repo := &Repository{db: db}
+	ctx := context.Background()
+	if _, err := db.ExecContext(ctx, `PRAGMA foreign_keys = ON;`); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("open sqlite read-only: %w", err)
+	}
+	// Without migrations, an empty or foreign file would only fail later on the first board query.
+	var projects int
+	if err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM projects`).Scan(&projects); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("open sqlite read-only: database has no tillsyn schema: %w", err)
+	}
+	if err := repo.probeVecCapability(ctx); err != nil && !errors.Is(err, errSQLiteVecUnavailable) {
+		_ = db.Close()
+		return nil, fmt.Errorf("open sqlite read-only vec capability probe: %w", err)
+	}
+	return repo, nil
+}
+
 // OpenInMemory opens in memory.
 func OpenInMemory() (*Repository, error) {
 	db, err := sql.Open(driverName, "file::memory:?cache=shared")
@@ -272,6 +306,8 @@ func (r *Repository) migrate(ctx context.Context) error {
 		`CREATE INDEX IF NOT EXISTS idx_tasks_project_column_position ON tasks(project_id, column_id, position);`,
 		`CREATE INDEX IF NOT EXISTS idx_work_items_project_column_position ON work_items(project_id, column_id, position);`,
 		`CREATE INDEX IF NOT EXISTS idx_work_items_project_parent ON work_items(project_id, parent_id);`,
+		`CREATE INDEX IF NOT EXISTS idx_work_items_project_due_at ON work_items(project_id, unixepoch(due_at, 'subsec'));`,
+		`CREATE INDEX IF NOT EXISTS idx_work_items_project_created_at ON work_items(project_id, unixepoch(created_at, 'subsec'));`,
 		`CREATE INDEX IF NOT EXISTS idx_change_events_project_created_at ON change_events(project_id, created_at DESC, id DESC);`,
 		`CREATE INDEX IF NOT EXISTS idx_comments_project_target_created_at ON comments(project_id, target_type, target_id, created_at ASC, id ASC);`,
 		`CREATE INDEX IF NOT EXISTS idx_comments_project_created_at ON comments(project_id, created_at DESC, id DESC);`,
@@ -1134,7 +1170,33 @@ func (r *Repository) CreateColumn(ctx context.Context, c domain.Column) error {
 
 // UpdateColumn updates state for the requested operation.
 func (r *Repository) UpdateColumn(ctx context.Context, c domain.Column) error {
-	res, err := r.db.ExecContext(ctx, `
+	return updateColumnExec(ctx, r.db, c)
+}
+
+// UpdateColumns updates every column in one transaction, so either all land or none do.
+func (r *Repository) UpdateColumns(ctx context.Context, columns []domain.Column) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	for _, c := range columns {
+		if err = updateColumnExec(ctx, tx, c); err != nil {
+			return err
+		}
+	}
+	err = tx.Commit()
+	return err
+}
+
+// updateColumnExec writes one column row through db or tx.
+func updateColumnExec(ctx context.Context, exec execerContext, c domain.Column) error {
+	res, err := exec.ExecContext(ctx, `
 		UPDATE columns_v1
 		SET name = ?, wip_limit = ?, position = ?, updated_at = ?, archived_at = ?
 		WHERE id = ?
@@ -1145,6 +1207,31 @@ func (r *Repository) UpdateColumn(ctx context.Context, c domain.Column) error {
 	return translateNoRows(res)
 }
 
+// GetColumn returns one column by id.
+func (r *Repository) GetColumn(ctx context.Context, id string) (domain.Column, error) {
+	var (
+		c          domain.Column
+		createdRaw string
+		updatedRaw string
+		archived   sql.NullString
+	)
+	err := r.db.QueryRowContext(ctx, `
+		SELECT id, project_id, name, wip_limit, position, created_at, updated_at, archived_at
+		FROM columns_v1
+		WHERE id = ?
+	`, id).Scan(&c.ID, &c.ProjectID, &c.Name, &c.WIPLimit, &c.Position, &createdRaw, &updatedRaw, &archived)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.Column{}, app.ErrNotFound
+	}
+	if err != nil {
+		return domain.Column{}, err
+	}
+	c.CreatedAt = parseTS(createdRaw)
+	c.UpdatedAt = parseTS(updatedRaw)
+	c.ArchivedAt = parseNullTS(archived)
+	return c, nil
+}
+
 // ListColumns lists columns.
 func (r *Repository) ListColumns(ctx context.Context, projectID string, includeArchived bool) ([]domain.Column, error) {
 	query := `
@@ -1277,6 +1364,25 @@ func (r *Repository) CreateTask(ctx context.Context, t domain.Task) error {
 
 // UpdateTask updates state for the requested operation.
 func (r *Repository) UpdateTask(ctx context.Context, t domain.Task) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if err = updateTaskTx(ctx, tx, t); err != nil {
+		return err
+	}
+	err = tx.Commit()
+	return err
+}
+
+// updateTaskTx updates one task and records its change event inside tx.
+func updateTaskTx(ctx context.Context, tx *sql.Tx, t domain.Task) error {
 	labelsJSON, err := json.Marshal(t.Labels)
 	if err != nil {
 		return err
@@ -1291,16 +1397,6 @@ func (r *Repository) UpdateTask(ctx context.Context, t domain.Task) error {
 		scope = domain.DefaultTaskScope(t.Kind, t.ParentID)
 	}
 
-	tx, err := r.db.BeginTx(ctx, nil)
-	if err != nil {
-		return err
-	}
-	defer func() {
-		if err != nil {
-			_ = tx.Rollback()
-		}
-	}()
-
 	prev, err := getTaskByID(ctx, tx, t.ID)
 	if err != nil {
 		return err
@@ -1352,7 +1448,7 @@ func (r *Repository) UpdateTask(ctx context.Context, t domain.Task) error {
 		actorName = chooseActorName(actorID, mutationActor.ActorName)
 		actorType = normalizeActorType(mutationActor.ActorType)
 	}
-	err = insertTaskChangeEvent(ctx, tx, domain.ChangeEvent{
+	return insertTaskChangeEvent(ctx, tx, domain.ChangeEvent{
 		ProjectID:  t.ProjectID,
 		WorkItemID: t.ID,
 		Operation:  op,
@@ -1362,12 +1458,6 @@ func (r *Repository) UpdateTask(ctx context.Context, t domain.Task) error {
 		Metadata:   metadata,
 		OccurredAt: t.UpdatedAt,
 	})
-	if err != nil {
-		return err
-	}
-
-	err = tx.Commit()
-	return err
 }
 
 // GetTask returns task.
@@ -1406,6 +1496,151 @@ func (r *Repository) ListTasks(ctx context.Context, projectID string, includeArc
 	return out, rows.Err()
 }
 
+// ListTasksInWindow lists a project's tasks, archived ones included, whose due and created times fall inside window.
+// Stored timestamps trim trailing fractional zeros, so bounds compare as unix seconds rather than as text.
+func (r *Repository) ListTasksInWindow(ctx context.Context, projectID string, window app.TaskDateWindow) ([]domain.Task, error) {
+	query := `
+		SELECT
+			w.id, w.project_id, w.parent_id, w.kind, w.scope, w.lifecycle_state, w.column_id, w.position, w.title, w.description, w.priority, w.due_at, w.labels_json,
+			w.metadata_json, w.created_by_actor, w.updated_by_actor, w.updated_by_type, w.created_at, w.updated_at, w.started_at, w.completed_at, w.archived_at, w.canceled_at
+		FROM work_items w
+		WHERE w.project_id = ?`
+	args := []any{projectID}
+	bounds := []struct {
+		column string
+		op     string
+		at     time.Time
+	}{
+		{"due_at", ">=", window.DueAfter},
+		{"due_at", "<", window.DueBefore},
+		{"created_at", ">=", window.CreatedAfter},
+		{"created_at", "<", window.CreatedBefore},
+	}
+	for _, bound := range bounds {
+		if bound.at.IsZero() {
+			continue
+		}
+		query += fmt.Sprintf(` AND unixepoch(w.%s, 'subsec') %s unixepoch(?, 'subsec')`, bound.column, bound.op)
+		args = append(args, ts(bound.at))
+	}
+	query += ` ORDER BY w.column_id ASC, w.position ASC`
+	return r.queryTasks(ctx, query, args...)
+}
+
+// ListOrphanedTasks lists tasks, archived ones included, whose parent is missing from the same project, ordered by ID.
+func (r *Repository) ListOrphanedTasks(ctx context.Context, projectID string) ([]domain.Task, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT
+			w.id, w.project_id, w.parent_id, w.kind, w.scope, w.lifecycle_state, w.column_id, w.position, w.title, w.description, w.priority, w.due_at, w.labels_json,
+			w.metadata_json, w.created_by_actor, w.updated_by_actor, w.updated_by_type, w.created_at, w.updated_at, w.started_at, w.completed_at, w.archived_at, w.canceled_at
+		FROM work_items w
+		WHERE w.project_id = ? AND w.parent_id != ''
+			AND NOT EXISTS (SELECT 1 FROM work_items p WHERE p.id = w.parent_id AND p.project_id = w.project_id)
+		ORDER BY w.id ASC
+	`, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := []domain.Task{}
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, task)
+	}
+	return out, rows.Err()
+}
+
+// boardRowPredicate matches the rows the board shows at project level: non-subtask roots and orphans.
+const boardRowPredicate = `
+	w.project_id = ? AND w.column_id = ? AND w.kind != 'subtask'
+	AND (w.parent_id = '' OR NOT EXISTS (SELECT 1 FROM work_items p WHERE p.id = w.parent_id AND p.project_id = w.project_id))
+`
+
+// ListTasksPage lists one position-ordered page of board rows within a column, followed by all of their descendants.
+// Without IncludeArchived an archived task drops out together with its subtree.
+func (r *Repository) ListTasksPage(ctx context.Context, query app.TaskPageQuery) ([]domain.Task, error) {
+	archivedFilter := ""
+	if !query.IncludeArchived {
+		archivedFilter = ` AND w.archived_at IS NULL`
+	}
+	rowsQuery := `
+		SELECT
+			w.id, w.project_id, w.parent_id, w.kind, w.scope, w.lifecycle_state, w.column_id, w.position, w.title, w.description, w.priority, w.due_at, w.labels_json,
+			w.metadata_json, w.created_by_actor, w.updated_by_actor, w.updated_by_type, w.created_at, w.updated_at, w.started_at, w.completed_at, w.archived_at, w.canceled_at
+		FROM work_items w
+		WHERE ` + boardRowPredicate + archivedFilter + `
+		ORDER BY w.position ASC, w.id ASC LIMIT ? OFFSET ?`
+	out, err := r.queryTasks(ctx, rowsQuery, query.ProjectID, query.ColumnID, query.Limit, query.Offset)
+	if err != nil || len(out) == 0 {
+		return out, err
+	}
+
+	args := make([]any, 0, len(out)+2)
+	args = append(args, query.ProjectID)
+	for _, task := range out {
+		args = append(args, task.ID)
+	}
+	args = append(args, query.ProjectID)
+	descendantsQuery := `
+		WITH RECURSIVE subtree(id) AS (
+			SELECT w.id FROM work_items w
+			WHERE w.project_id = ? AND w.parent_id IN (` + queryPlaceholders(len(out)) + `)` + archivedFilter + `
+			UNION
+			SELECT w.id FROM work_items w JOIN subtree s ON w.parent_id = s.id
+			WHERE w.project_id = ?` + archivedFilter + `
+		)
+		SELECT
+			w.id, w.project_id, w.parent_id, w.kind, w.scope, w.lifecycle_state, w.column_id, w.position, w.title, w.description, w.priority, w.due_at, w.labels_json,
+			w.metadata_json, w.created_by_actor, w.updated_by_actor, w.updated_by_type, w.created_at, w.updated_at, w.started_at, w.completed_at, w.archived_at, w.canceled_at
+		FROM work_items w
+		WHERE w.id IN (SELECT id FROM subtree)
+		ORDER BY w.position ASC, w.id ASC`
+	descendants, err := r.queryTasks(ctx, descendantsQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	return append(out, descendants...), nil
+}
+
+// CountTasksPage counts the board rows in one column, so headers and WIP limits do not depend on loaded pages.
+func (r *Repository) CountTasksPage(ctx context.Context, query app.TaskPageQuery) (app.TaskPageCount, error) {
+	sqlQuery := `
+		SELECT COUNT(*), COALESCE(SUM(CASE WHEN w.archived_at IS NULL THEN 1 ELSE 0 END), 0)
+		FROM work_items w
+		WHERE ` + boardRowPredicate
+	if !query.IncludeArchived {
+		sqlQuery += ` AND w.archived_at IS NULL`
+	}
+	var count app.TaskPageCount
+	if err := r.db.QueryRowContext(ctx, sqlQuery, query.ProjectID, query.ColumnID).Scan(&count.Total, &count.Active); err != nil {
+		return app.TaskPageCount{}, err
+	}
+	return count, nil
+}
+
+// queryTasks runs one task SELECT and scans every row.
+func (r *Repository) queryTasks(ctx context.Context, query string, args ...any) ([]domain.Task, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := []domain.Task{}
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, task)
+	}
+	return out, rows.Err()
+}
+
 // DeleteTask deletes task.
 func (r *Repository) DeleteTask(ctx context.Context, id string) error {
 	tx, err := r.db.BeginTx(ctx, nil)
@@ -1418,6 +1653,48 @@ func (r *Repository) DeleteTask(ctx context.Context, id string) error {
 		}
 	}()
 
+	if err = deleteTaskTx(ctx, tx, id); err != nil {
+		return err
+	}
+	err = tx.Commit()
+	return err
+}
+
+// ApplyTaskBatch applies every update and then every deletion in one transaction, so either all land or none do.
+func (r *Repository) ApplyTaskBatch(ctx context.Context, batch app.TaskBatch) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	// batchCtx carries a per-task actor override into the change event for that write.
+	batchCtx := func(taskID string) context.Context {
+		if actor, ok := batch.Actors[taskID]; ok {
+			return app.WithMutationActor(ctx, actor)
+		}
+		return ctx
+	}
+	for _, task := range batch.Update {
+		if err = updateTaskTx(batchCtx(task.ID), tx, task); err != nil {
+			return err
+		}
+	}
+	for _, id := range batch.Delete {
+		if err = deleteTaskTx(batchCtx(id), tx, id); err != nil {
+			return err
+		}
+	}
+	err = tx.Commit()
+	return err
+}
+
+// deleteTaskTx deletes one task and records its change event inside tx.
+func deleteTaskTx(ctx context.Context, tx *sql.Tx, id string) error {
 	task, err := getTaskByID(ctx, tx, id)
 	if err != nil {
 		return err
@@ -1439,7 +1716,7 @@ func (r *Repository) DeleteTask(ctx context.Context, id string) error {
 		actorType = normalizeActorType(mutationActor.ActorType)
 	}
 
-	err = insertTaskChangeEvent(ctx, tx, domain.ChangeEvent{
+	return insertTaskChangeEvent(ctx, tx, domain.ChangeEvent{
 		ProjectID:  task.ProjectID,
 		WorkItemID: task.ID,
 		Operation:  domain.ChangeOperationDelete,
@@ -1455,12 +1732,6 @@ func (r *Repository) DeleteTask(ctx context.Context, id string) error {
 		},
 		OccurredAt: time.Now().UTC(),
 	})
-	if err != nil {
-		return err
-	}
-
-	err = tx.Commit()
-	return err
 }
 
 // UpsertTaskEmbedding writes one task embedding row for semantic retrieval.
@@ -1671,6 +1942,75 @@ func (r *Repository) ListCommentsByTarget(ctx context.Context, target domain.Com
 	return out, rows.Err()
 }
 
+// SearchCommentTargetIDs returns distinct work-item ids in the given projects whose comment summary or body contains query.
+// Matching is case-insensitive for ASCII text; project-level comments are skipped.
+func (r *Repository) SearchCommentTargetIDs(ctx context.Context, projectIDs []string, query string) ([]string, error) {
+	query = strings.TrimSpace(strings.ToLower(query))
+	if query == "" || len(projectIDs) == 0 {
+		return nil, nil
+	}
+	args := make([]any, 0, len(projectIDs)+3)
+	for _, projectID := range projectIDs {
+		args = append(args, projectID)
+	}
+	args = append(args, string(domain.CommentTargetTypeProject), query, query)
+	// instr avoids LIKE so query text containing % or _ matches literally.
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT DISTINCT target_id
+		FROM comments
+		WHERE project_id IN (`+queryPlaceholders(len(projectIDs))+`)
+			AND target_type <> ?
+			AND (instr(lower(body_markdown), ?) > 0 OR instr(lower(summary), ?) > 0)
+		ORDER BY target_id ASC
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := make([]string, 0)
+	for rows.Next() {
+		var targetID string
+		if err := rows.Scan(&targetID); err != nil {
+			return nil, err
+		}
+		out = append(out, targetID)
+	}
+	return out, rows.Err()
+}
+
+// ListProjectActivity summarizes every project's unarchived task count and newest change event in one query.
+func (r *Repository) ListProjectActivity(ctx context.Context) ([]app.ProjectActivity, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT
+			p.id,
+			(SELECT COUNT(*) FROM work_items w WHERE w.project_id = p.id AND w.archived_at IS NULL),
+			COALESCE((SELECT e.created_at FROM change_events e WHERE e.project_id = p.id ORDER BY e.created_at DESC, e.id DESC LIMIT 1), '')
+		FROM projects p
+		ORDER BY p.created_at ASC, p.id ASC
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	out := []app.ProjectActivity{}
+	for rows.Next() {
+		var (
+			activity   app.ProjectActivity
+			changedRaw string
+		)
+		if err := rows.Scan(&activity.ProjectID, &activity.TaskCount, &changedRaw); err != nil {
+			return nil, err
+		}
+		if changedRaw != "" {
+			activity.LastChangeAt = parseTS(changedRaw)
+		}
+		out = append(out, activity)
+	}
+	return out, rows.Err()
+}
+
 // ListProjectChangeEvents lists recent project events for activity-log consumption.
 func (r *Repository) ListProjectChangeEvents(ctx context.Context, projectID string, limit int) ([]domain.ChangeEvent, error) {
 	if limit <= 0 {
@@ -4,7 +4,9 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -338,6 +340,24 @@ func TestRepository_CreateAndListCommentsByTarget(t *testing.T) {
 	if len(comments) != 1 || comments[0].ID != "c3" {
 		t.Fatalf("unexpected project comments %#v", comments)
 	}
+
+	// Comment search is case-insensitive, skips project comments, and treats LIKE wildcards literally.
+	ids, err := repo.SearchCommentTargetIDs(ctx, []string{project.ID}, "FIRST line")
+	if err != nil {
+		t.Fatalf("SearchCommentTargetIDs() error = %v", err)
+	}
+	if len(ids) != 1 || ids[0] != "t1" {
+		t.Fatalf("expected comment match on t1, got %#v", ids)
+	}
+	for _, query := range []string{"project note", "%"} {
+		ids, err = repo.SearchCommentTargetIDs(ctx, []string{project.ID}, query)
+		if err != nil {
+			t.Fatalf("SearchCommentTargetIDs(%q) error = %v", query, err)
+		}
+		if len(ids) != 0 {
+			t.Fatalf("expected no comment matches for %q, got %#v", query, ids)
+		}
+	}
 }
 
 // TestRepository_NotFoundCases verifies behavior for the covered scenario.
@@ -365,6 +385,56 @@ func TestRepository_NotFoundCases(t *testing.T) {
 	}
 }
 
+// TestOpenReadOnlyReadsExistingDatabaseAndRejectsWrites verifies read-only opens serve reads without writing.
+func TestOpenReadOnlyReadsExistingDatabaseAndRejectsWrites(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	dbPath := filepath.Join(dir, "tillsyn db.db")
+	repo, err := Open(dbPath)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	project, err := domain.NewProject("p1", "Example", "", now)
+	if err != nil {
+		t.Fatalf("NewProject() error = %v", err)
+	}
+	if err := repo.CreateProject(ctx, project); err != nil {
+		t.Fatalf("CreateProject() error = %v", err)
+	}
+	if err := repo.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	readOnly, err := OpenReadOnly(dbPath)
+	if err != nil {
+		t.Fatalf("OpenReadOnly() error = %v", err)
+	}
+	t.Cleanup(func() {
+		_ = readOnly.Close()
+	})
+	projects, err := readOnly.ListProjects(ctx, false)
+	if err != nil || len(projects) != 1 || projects[0].Name != "Example" {
+		t.Fatalf("ListProjects() = %#v, %v", projects, err)
+	}
+	project.Name = "Renamed"
+	if err := readOnly.UpdateProject(ctx, project); err == nil {
+		t.Fatal("expected writes through a read-only open to fail")
+	}
+
+	if _, err := OpenReadOnly(filepath.Join(dir, "missing.db")); err == nil {
+		t.Fatal("expected missing database to fail read-only open")
+	}
+	// An empty file opens as SQLite but has no schema to browse.
+	emptyPath := filepath.Join(dir, "empty.db")
+	if err := os.WriteFile(emptyPath, nil, 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if _, err := OpenReadOnly(emptyPath); err == nil || !strings.Contains(err.Error(), "no tillsyn schema") {
+		t.Fatalf("expected schema error for empty database, got %v", err)
+	}
+}
+
 // TestRepository_ProjectAndColumnUpdates verifies behavior for the covered scenario.
 func TestRepository_ProjectAndColumnUpdates(t *testing.T) {
 	ctx := context.Background()
@@ -446,6 +516,16 @@ func TestRepository_ProjectAndColumnUpdates(t *testing.T) {
 	if len(columns) != 1 || columns[0].Name != "Doing" {
 		t.Fatalf("unexpected columns %#v", columns)
 	}
+	loadedColumn, err := repo.GetColumn(ctx, column.ID)
+	if err != nil {
+		t.Fatalf("GetColumn() error = %v", err)
+	}
+	if loadedColumn.Name != "Doing" || loadedColumn.Position != 2 || loadedColumn.ProjectID != project.ID {
+		t.Fatalf("unexpected loaded column %#v", loadedColumn)
+	}
+	if _, err := repo.GetColumn(ctx, "missing"); !errors.Is(err, app.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for missing column, got %v", err)
+	}
 
 	column.Archive(now.Add(5 * time.Minute))
 	if err := repo.UpdateColumn(ctx, column); err != nil {
@@ -465,6 +545,26 @@ func TestRepository_ProjectAndColumnUpdates(t *testing.T) {
 	if len(allCols) != 1 || allCols[0].ArchivedAt == nil {
 		t.Fatalf("expected archived column in all list, got %#v", allCols)
 	}
+
+	// UpdateColumns is all-or-nothing: one missing column rolls back the whole batch.
+	second, _ := domain.NewColumn("c2", project.ID, "Done", 3, 0, now)
+	if err := repo.CreateColumn(ctx, second); err != nil {
+		t.Fatalf("CreateColumn(second) error = %v", err)
+	}
+	_ = second.SetPosition(0, now.Add(6*time.Minute))
+	missing, _ := domain.NewColumn("c-missing", project.ID, "Gone", 1, 0, now)
+	if err := repo.UpdateColumns(ctx, []domain.Column{second, missing}); !errors.Is(err, app.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for missing column in batch, got %v", err)
+	}
+	if loaded, _ := repo.GetColumn(ctx, second.ID); loaded.Position != 3 {
+		t.Fatalf("expected failed batch to roll back, got position %d", loaded.Position)
+	}
+	if err := repo.UpdateColumns(ctx, []domain.Column{second}); err != nil {
+		t.Fatalf("UpdateColumns() error = %v", err)
+	}
+	if loaded, _ := repo.GetColumn(ctx, second.ID); loaded.Position != 0 {
+		t.Fatalf("expected batch update persisted, got position %d", loaded.Position)
+	}
 }
 
 // TestRepository_DeleteProjectCascades verifies project hard-delete cascades to child rows.
@@ -1686,3 +1786,432 @@ func TestRepository_PersistsProjectKindAndTaskScope(t *testing.T) {
 		t.Fatalf("expected persisted task scope phase, got %q", loadedNestedPhaseTask.Scope)
 	}
 }
+
+// TestRepository_ListOrphanedTasks verifies only tasks whose parent is missing from the project are listed, archived ones included.
+func TestRepository_ListOrphanedTasks(t *testing.T) {
+	ctx := context.Background()
+	repo, err := OpenInMemory()
+	if err != nil {
+		t.Fatalf("OpenInMemory() error = %v", err)
+	}
+	t.Cleanup(func() {
+		_ = repo.Close()
+	})
+
+	now := time.Date(2026, 3, 3, 14, 0, 0, 0, time.UTC)
+	project, _ := domain.NewProject("p1", "Example", "", now)
+	if err := repo.CreateProject(ctx, project); err != nil {
+		t.Fatalf("CreateProject() error = %v", err)
+	}
+	column, _ := domain.NewColumn("c1", project.ID, "To Do", 0, 0, now)
+	if err := repo.CreateColumn(ctx, column); err != nil {
+		t.Fatalf("CreateColumn() error = %v", err)
+	}
+	// t-gone parents t-orphan-a and t-orphan-b; t-keep keeps its healthy child.
+	inputs := []domain.TaskInput{
+		{ID: "t-gone", Title: "gone"},
+		{ID: "t-keep", Title: "keep"},
+		{ID: "t-orphan-b", ParentID: "t-gone", Kind: domain.WorkKindSubtask, Title: "orphan b"},
+		{ID: "t-orphan-a", ParentID: "t-gone", Kind: domain.WorkKindSubtask, Title: "orphan a"},
+		{ID: "t-child", ParentID: "t-keep", Kind: domain.WorkKindSubtask, Title: "child"},
+	}
+	for idx, in := range inputs {
+		in.ProjectID = project.ID
+		in.ColumnID = column.ID
+		in.Position = idx
+		in.Priority = domain.PriorityLow
+		task, _ := domain.NewTask(in, now)
+		if err := repo.CreateTask(ctx, task); err != nil {
+			t.Fatalf("CreateTask(%s) error = %v", in.ID, err)
+		}
+	}
+	archived, _ := repo.GetTask(ctx, "t-orphan-b")
+	archived.Archive(now.Add(time.Minute))
+	if err := repo.UpdateTask(ctx, archived); err != nil {
+		t.Fatalf("UpdateTask() error = %v", err)
+	}
+	if err := repo.DeleteTask(ctx, "t-gone"); err != nil {
+		t.Fatalf("DeleteTask() error = %v", err)
+	}
+
+	orphans, err := repo.ListOrphanedTasks(ctx, project.ID)
+	if err != nil {
+		t.Fatalf("ListOrphanedTasks() error = %v", err)
+	}
+	if len(orphans) != 2 || orphans[0].ID != "t-orphan-a" || orphans[1].ID != "t-orphan-b" {
+		t.Fatalf("ListOrphanedTasks() = %#v, want t-orphan-a and t-orphan-b", orphans)
+	}
+}
+
+// TestRepository_ListProjectActivity verifies the aggregate summary counts unarchived tasks and finds each project's newest change.
+func TestRepository_ListProjectActivity(t *testing.T) {
+	ctx := context.Background()
+	repo, err := OpenInMemory()
+	if err != nil {
+		t.Fatalf("OpenInMemory() error = %v", err)
+	}
+	t.Cleanup(func() {
+		_ = repo.Close()
+	})
+
+	now := time.Date(2026, 3, 3, 14, 0, 0, 0, time.UTC)
+	busy, _ := domain.NewProject("p1", "Busy", "", now)
+	quiet, _ := domain.NewProject("p2", "Quiet", "", now.Add(time.Minute))
+	for _, project := range []domain.Project{busy, quiet} {
+		if err := repo.CreateProject(ctx, project); err != nil {
+			t.Fatalf("CreateProject() error = %v", err)
+		}
+	}
+	column, _ := domain.NewColumn("c1", busy.ID, "To Do", 0, 0, now)
+	if err := repo.CreateColumn(ctx, column); err != nil {
+		t.Fatalf("CreateColumn() error = %v", err)
+	}
+	for idx, id := range []string{"t1", "t2"} {
+		task, _ := domain.NewTask(domain.TaskInput{ID: id, ProjectID: busy.ID, ColumnID: column.ID, Position: idx, Title: id, Priority: domain.PriorityLow}, now)
+		if err := repo.CreateTask(ctx, task); err != nil {
+			t.Fatalf("CreateTask(%s) error = %v", id, err)
+		}
+	}
+	archived, _ := repo.GetTask(ctx, "t2")
+	archived.Archive(now.Add(time.Hour))
+	if err := repo.UpdateTask(ctx, archived); err != nil {
+		t.Fatalf("UpdateTask() error = %v", err)
+	}
+	events, err := repo.ListProjectChangeEvents(ctx, busy.ID, 1)
+	if err != nil || len(events) != 1 {
+		t.Fatalf("ListProjectChangeEvents() = %v, %v", events, err)
+	}
+
+	activity, err := repo.ListProjectActivity(ctx)
+	if err != nil {
+		t.Fatalf("ListProjectActivity() error = %v", err)
+	}
+	want := []app.ProjectActivity{
+		{ProjectID: busy.ID, TaskCount: 1, LastChangeAt: events[0].OccurredAt},
+		{ProjectID: quiet.ID},
+	}
+	if len(activity) != len(want) {
+		t.Fatalf("ListProjectActivity() = %#v, want %#v", activity, want)
+	}
+	for idx := range want {
+		if activity[idx].ProjectID != want[idx].ProjectID || activity[idx].TaskCount != want[idx].TaskCount || !activity[idx].LastChangeAt.Equal(want[idx].LastChangeAt) {
+			t.Fatalf("ListProjectActivity()[%d] = %#v, want %#v", idx, activity[idx], want[idx])
+		}
+	}
+}
+
+// TestRepository_ListTasksPage verifies per-column paging honors position order, offsets, and archive filters.
+func TestRepository_ListTasksPage(t *testing.T) {
+	ctx := context.Background()
+	repo, err := OpenInMemory()
+	if err != nil {
+		t.Fatalf("OpenInMemory() error = %v", err)
+	}
+	t.Cleanup(func() {
+		_ = repo.Close()
+	})
+
+	now := time.Date(2026, 3, 3, 14, 0, 0, 0, time.UTC)
+	project, _ := domain.NewProject("p1", "Example", "", now)
+	if err := repo.CreateProject(ctx, project); err != nil {
+		t.Fatalf("CreateProject() error = %v", err)
+	}
+	todo, _ := domain.NewColumn("c1", project.ID, "To Do", 0, 0, now)
+	done, _ := domain.NewColumn("c2", project.ID, "Done", 1, 0, now)
+	for _, column := range []domain.Column{todo, done} {
+		if err := repo.CreateColumn(ctx, column); err != nil {
+			t.Fatalf("CreateColumn() error = %v", err)
+		}
+	}
+	// Insert out of position order so the query ordering is what the assertions observe.
+	for _, spec := range []struct {
+		id       string
+		columnID string
+		position int
+	}{
+		{"t3", todo.ID, 2},
+		{"t1", todo.ID, 0},
+		{"t4", todo.ID, 3},
+		{"t2", todo.ID, 1},
+		{"d1", done.ID, 0},
+	} {
+		task, err := domain.NewTask(domain.TaskInput{
+			ID:        spec.id,
+			ProjectID: project.ID,
+			ColumnID:  spec.columnID,
+			Position:  spec.position,
+			Title:     spec.id,
+			Priority:  domain.PriorityLow,
+		}, now)
+		if err != nil {
+			t.Fatalf("NewTask(%s) error = %v", spec.id, err)
+		}
+		if err := repo.CreateTask(ctx, task); err != nil {
+			t.Fatalf("CreateTask(%s) error = %v", spec.id, err)
+		}
+	}
+	archived, err := repo.GetTask(ctx, "t2")
+	if err != nil {
+		t.Fatalf("GetTask() error = %v", err)
+	}
+	archived.Archive(now)
+	if err := repo.UpdateTask(ctx, archived); err != nil {
+		t.Fatalf("UpdateTask() error = %v", err)
+	}
+
+	page, err := repo.ListTasksPage(ctx, app.TaskPageQuery{ProjectID: project.ID, ColumnID: todo.ID, Limit: 2})
+	if err != nil {
+		t.Fatalf("ListTasksPage() error = %v", err)
+	}
+	if len(page) != 2 || page[0].ID != "t1" || page[1].ID != "t3" {
+		t.Fatalf("unexpected first active page %#v", page)
+	}
+	page, err = repo.ListTasksPage(ctx, app.TaskPageQuery{ProjectID: project.ID, ColumnID: todo.ID, Limit: 2, Offset: 2})
+	if err != nil {
+		t.Fatalf("ListTasksPage(offset) error = %v", err)
+	}
+	if len(page) != 1 || page[0].ID != "t4" {
+		t.Fatalf("unexpected second active page %#v", page)
+	}
+	page, err = repo.ListTasksPage(ctx, app.TaskPageQuery{ProjectID: project.ID, ColumnID: todo.ID, IncludeArchived: true, Limit: 10})
+	if err != nil {
+		t.Fatalf("ListTasksPage(archived) error = %v", err)
+	}
+	if len(page) != 4 || page[1].ID != "t2" {
+		t.Fatalf("expected archived rows in position order, got %#v", page)
+	}
+}
+
+// TestRepository_ListTasksPageCountsBoardRows verifies hidden subtasks take no page slots and ride along with their parents.
+func TestRepository_ListTasksPageCountsBoardRows(t *testing.T) {
+	ctx := context.Background()
+	repo, err := OpenInMemory()
+	if err != nil {
+		t.Fatalf("OpenInMemory() error = %v", err)
+	}
+	t.Cleanup(func() {
+		_ = repo.Close()
+	})
+
+	now := time.Date(2026, 3, 3, 14, 0, 0, 0, time.UTC)
+	project, _ := domain.NewProject("p1", "Example", "", now)
+	if err := repo.CreateProject(ctx, project); err != nil {
+		t.Fatalf("CreateProject() error = %v", err)
+	}
+	todo, _ := domain.NewColumn("c1", project.ID, "To Do", 0, 0, now)
+	done, _ := domain.NewColumn("c2", project.ID, "Done", 1, 0, now)
+	for _, column := range []domain.Column{todo, done} {
+		if err := repo.CreateColumn(ctx, column); err != nil {
+			t.Fatalf("CreateColumn() error = %v", err)
+		}
+	}
+	// The subtasks sort ahead of every board row, and one lives in another column.
+	inputs := []domain.TaskInput{
+		{ID: "t1", ColumnID: todo.ID, Position: 2, Title: "first"},
+		{ID: "t2", ColumnID: todo.ID, Position: 3, Title: "second"},
+		{ID: "s1", ParentID: "t1", Kind: domain.WorkKindSubtask, ColumnID: todo.ID, Position: 0, Title: "step one"},
+		{ID: "s2", ParentID: "s1", Kind: domain.WorkKindSubtask, ColumnID: todo.ID, Position: 1, Title: "step two"},
+		{ID: "s3", ParentID: "t1", Kind: domain.WorkKindSubtask, ColumnID: done.ID, Position: 0, Title: "step three"},
+	}
+	for _, in := range inputs {
+		in.ProjectID = project.ID
+		in.Priority = domain.PriorityLow
+		task, err := domain.NewTask(in, now)
+		if err != nil {
+			t.Fatalf("NewTask(%s) error = %v", in.ID, err)
+		}
+		if err := repo.CreateTask(ctx, task); err != nil {
+			t.Fatalf("CreateTask(%s) error = %v", in.ID, err)
+		}
+	}
+
+	query := app.TaskPageQuery{ProjectID: project.ID, ColumnID: todo.ID, Limit: 1}
+	page, err := repo.ListTasksPage(ctx, query)
+	if err != nil {
+		t.Fatalf("ListTasksPage() error = %v", err)
+	}
+	ids := make([]string, 0, len(page))
+	for _, task := range page {
+		ids = append(ids, task.ID)
+	}
+	if got := strings.Join(ids, ","); got != "t1,s1,s3,s2" {
+		t.Fatalf("first page = %s, want t1 followed by its whole subtree", got)
+	}
+	query.Offset = 1
+	page, err = repo.ListTasksPage(ctx, query)
+	if err != nil {
+		t.Fatalf("ListTasksPage(offset) error = %v", err)
+	}
+	if len(page) != 1 || page[0].ID != "t2" {
+		t.Fatalf("second page = %#v, want only t2", page)
+	}
+	count, err := repo.CountTasksPage(ctx, query)
+	if err != nil {
+		t.Fatalf("CountTasksPage() error = %v", err)
+	}
+	if count != (app.TaskPageCount{Total: 2, Active: 2}) {
+		t.Fatalf("CountTasksPage() = %#v, want 2 board rows", count)
+	}
+}
+
+// TestRepository_ListTasksInWindowFiltersInQuery verifies due and created bounds run as indexed SQL predicates.
+func TestRepository_ListTasksInWindowFiltersInQuery(t *testing.T) {
+	ctx := context.Background()
+	repo, err := OpenInMemory()
+	if err != nil {
+		t.Fatalf("OpenInMemory() error = %v", err)
+	}
+	t.Cleanup(func() {
+		_ = repo.Close()
+	})
+
+	now := time.Date(2026, 3, 3, 14, 0, 0, 0, time.UTC)
+	project, _ := domain.NewProject("p1", "Example", "", now)
+	if err := repo.CreateProject(ctx, project); err != nil {
+		t.Fatalf("CreateProject() error = %v", err)
+	}
+	column, _ := domain.NewColumn("c1", project.ID, "To Do", 0, 0, now)
+	if err := repo.CreateColumn(ctx, column); err != nil {
+		t.Fatalf("CreateColumn() error = %v", err)
+	}
+	weekStart := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	weekEnd := weekStart.AddDate(0, 0, 7)
+	inside, early := weekStart.Add(36*time.Hour), weekStart.AddDate(0, 0, -1)
+	dues := map[string]*time.Time{
+		"inside": &inside,
+		"early":  &early,
+		"start":  &weekStart,
+		"after":  &weekEnd,
+		"none":   nil,
+	}
+	for position, id := range []string{"inside", "early", "start", "after", "none"} {
+		task, err := domain.NewTask(domain.TaskInput{
+			ID:        id,
+			ProjectID: project.ID,
+			ColumnID:  column.ID,
+			Position:  position,
+			Title:     id,
+			Priority:  domain.PriorityLow,
+			DueAt:     dues[id],
+		}, now.Add(-time.Duration(position)*24*time.Hour))
+		if err != nil {
+			t.Fatalf("NewTask(%s) error = %v", id, err)
+		}
+		if err := repo.CreateTask(ctx, task); err != nil {
+			t.Fatalf("CreateTask(%s) error = %v", id, err)
+		}
+	}
+
+	taskIDs := func(window app.TaskDateWindow) string {
+		t.Helper()
+		tasks, err := repo.ListTasksInWindow(ctx, project.ID, window)
+		if err != nil {
+			t.Fatalf("ListTasksInWindow() error = %v", err)
+		}
+		ids := make([]string, 0, len(tasks))
+		for _, task := range tasks {
+			ids = append(ids, task.ID)
+		}
+		return strings.Join(ids, ",")
+	}
+	if got := taskIDs(app.TaskDateWindow{DueAfter: weekStart, DueBefore: weekEnd}); got != "inside,start" {
+		t.Fatalf("due window = %s, want inside,start", got)
+	}
+	// "…00.5Z" sorts before "…00Z" as text, so a text comparison would drop "start" here.
+	if got := taskIDs(app.TaskDateWindow{DueBefore: weekStart.Add(500 * time.Millisecond), CreatedBefore: now}); got != "early,start" {
+		t.Fatalf("due and created window = %s, want early,start", got)
+	}
+	if got := taskIDs(app.TaskDateWindow{CreatedAfter: now.AddDate(0, 0, -2)}); got != "inside,early,start" {
+		t.Fatalf("created window = %s, want inside,early,start", got)
+	}
+
+	var plan strings.Builder
+	rows, err := repo.db.QueryContext(ctx, `EXPLAIN QUERY PLAN SELECT id FROM work_items w WHERE w.project_id = ? AND unixepoch(w.due_at, 'subsec') >= unixepoch(?, 'subsec')`, project.ID, ts(weekStart))
+	if err != nil {
+		t.Fatalf("EXPLAIN QUERY PLAN error = %v", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id, parent, unused int
+		var detail string
+		if err := rows.Scan(&id, &parent, &unused, &detail); err != nil {
+			t.Fatalf("scan query plan error = %v", err)
+		}
+		plan.WriteString(detail)
+	}
+	if !strings.Contains(plan.String(), "idx_work_items_project_due_at") {
+		t.Fatalf("expected the due window to use idx_work_items_project_due_at, got plan %q", plan.String())
+	}
+}
+
+// TestRepository_ApplyTaskBatchIsAtomic verifies a batch lands whole, and a failing write leaves every earlier one unapplied.
+func TestRepository_ApplyTaskBatchIsAtomic(t *testing.T) {
+	ctx := context.Background()
+	repo, err := OpenInMemory()
+	if err != nil {
+		t.Fatalf("OpenInMemory() error = %v", err)
+	}
+	t.Cleanup(func() {
+		_ = repo.Close()
+	})
+
+	now := time.Date(2026, 3, 3, 14, 0, 0, 0, time.UTC)
+	project, _ := domain.NewProject("p1", "Example", "", now)
+	if err := repo.CreateProject(ctx, project); err != nil {
+		t.Fatalf("CreateProject() error = %v", err)
+	}
+	column, _ := domain.NewColumn("c1", project.ID, "To Do", 0, 0, now)
+	if err := repo.CreateColumn(ctx, column); err != nil {
+		t.Fatalf("CreateColumn() error = %v", err)
+	}
+	for _, id := range []string{"t1", "t2", "t3"} {
+		task, _ := domain.NewTask(domain.TaskInput{ID: id, ProjectID: project.ID, ColumnID: column.ID, Title: id, Priority: domain.PriorityLow}, now)
+		if err := repo.CreateTask(ctx, task); err != nil {
+			t.Fatalf("CreateTask(%s) error = %v", id, err)
+		}
+	}
+	t1, _ := repo.GetTask(ctx, "t1")
+	t1.Archive(now.Add(time.Minute))
+
+	// The missing deletion fails after the update ran, so the whole batch must roll back.
+	err = repo.ApplyTaskBatch(ctx, app.TaskBatch{Update: []domain.Task{t1}, Delete: []string{"t2", "missing"}})
+	if !errors.Is(err, app.ErrNotFound) {
+		t.Fatalf("ApplyTaskBatch(missing) error = %v, want ErrNotFound", err)
+	}
+	if got, _ := repo.GetTask(ctx, "t1"); got.ArchivedAt != nil {
+		t.Fatal("expected t1 update rolled back")
+	}
+	if _, err := repo.GetTask(ctx, "t2"); err != nil {
+		t.Fatalf("expected t2 deletion rolled back, got %v", err)
+	}
+
+	// The per-task actor override attributes only t1's change event to the system.
+	systemActor := app.MutationActor{ActorID: "tillsyn-system-test", ActorType: domain.ActorTypeSystem}
+	batch := app.TaskBatch{Update: []domain.Task{t1}, Delete: []string{"t2"}, Actors: map[string]app.MutationActor{"t1": systemActor}}
+	if err := repo.ApplyTaskBatch(ctx, batch); err != nil {
+		t.Fatalf("ApplyTaskBatch() error = %v", err)
+	}
+	if got, _ := repo.GetTask(ctx, "t1"); got.ArchivedAt == nil {
+		t.Fatal("expected t1 archived")
+	}
+	if _, err := repo.GetTask(ctx, "t2"); !errors.Is(err, app.ErrNotFound) {
+		t.Fatalf("expected t2 deleted, got %v", err)
+	}
+	events, err := repo.ListProjectChangeEvents(ctx, project.ID, 10)
+	if err != nil {
+		t.Fatalf("ListProjectChangeEvents() error = %v", err)
+	}
+	// Three creates plus the batch's update and delete; the rolled-back attempt leaves no events.
+	if len(events) != 5 {
+		t.Fatalf("expected 5 change events, got %d", len(events))
+	}
+	for _, event := range events {
+		if event.Operation == domain.ChangeOperationCreate {
+			continue
+		}
+		wantSystem := event.WorkItemID == "t1"
+		if gotSystem := event.ActorID == systemActor.ActorID && event.ActorType == domain.ActorTypeSystem; gotSystem != wantSystem {
+			t.Fatalf("change event %s %s actor = %q/%q, want system=%t", event.Operation, event.WorkItemID, event.ActorID, event.ActorType, wantSystem)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package app
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// autoCompleteActorID identifies the system actor recorded on parent auto-complete change events.
+const autoCompleteActorID = "tillsyn-system-auto-complete"
+
+// autoCompleteActor is the system actor recorded on parent auto-complete change events.
+var autoCompleteActor = MutationActor{
+	ActorID:   autoCompleteActorID,
+	ActorName: "auto-complete parents",
+	ActorType: domain.ActorTypeSystem,
+}
+
+// autoCompleteAncestors returns the ancestors of one newly done task that now qualify for done, already moved
+// to the done column; it writes nothing. Parents manually reopened, with an explicit state, or with unmet
+// completion criteria stop the walk.
+func (s *Service) autoCompleteAncestors(ctx context.Context, task domain.Task, columns []domain.Column) ([]domain.Task, error) {
+	if !s.autoDoneParents || strings.TrimSpace(task.ParentID) == "" {
+		return nil, nil
+	}
+	doneColumnID := doneColumnIDForColumns(columns)
+	if doneColumnID == "" {
+		return nil, nil
+	}
+	projectTasks, err := s.repo.ListTasks(ctx, task.ProjectID, true)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]int, len(projectTasks))
+	for idx, candidate := range projectTasks {
+		byID[candidate.ID] = idx
+	}
+	// The just-moved task may be newer than the listed copy.
+	if idx, ok := byID[task.ID]; ok {
+		projectTasks[idx] = task
+	}
+
+	completed := make([]domain.Task, 0)
+	for parentID := task.ParentID; parentID != ""; {
+		idx, ok := byID[parentID]
+		if !ok {
+			break
+		}
+		parent := projectTasks[idx]
+		if !s.parentReadyForAutoComplete(ctx, parent, projectTasks) {
+			break
+		}
+		position := 0
+		for _, candidate := range projectTasks {
+			if candidate.ColumnID == doneColumnID && candidate.ArchivedAt == nil && candidate.ID != parent.ID {
+				position = max(position, candidate.Position+1)
+			}
+		}
+		now := s.clock()
+		if err := parent.Move(doneColumnID, position, now); err != nil {
+			return nil, err
+		}
+		if err := parent.SetLifecycleState(domain.StateDone, now); err != nil {
+			return nil, err
+		}
+		projectTasks[idx] = parent
+		completed = append(completed, parent)
+		parentID = parent.ParentID
+	}
+	return completed, nil
+}
+
+// parentReadyForAutoComplete reports whether one parent may be auto-moved to done.
+func (s *Service) parentReadyForAutoComplete(ctx context.Context, parent domain.Task, projectTasks []domain.Task) bool {
+	if parent.ArchivedAt != nil || parent.LifecycleState == domain.StateDone {
+		return false
+	}
+	// Manual reopens and explicitly set states are user decisions that auto-complete must not override.
+	if parent.Metadata.ManuallyReopened || parent.Metadata.LifecycleStateExplicit {
+		return false
+	}
+	children := make([]domain.Task, 0)
+	for _, candidate := range projectTasks {
+		if candidate.ParentID == parent.ID && candidate.ArchivedAt == nil {
+			children = append(children, candidate)
+		}
+	}
+	if len(children) == 0 || slices.ContainsFunc(children, func(child domain.Task) bool {
+		return child.LifecycleState != domain.StateDone
+	}) {
+		return false
+	}
+	if len(parent.CompletionCriteriaUnmet(children)) > 0 {
+		return false
+	}
+	return s.ensureTaskCompletionAttentionClear(ctx, parent) == nil
+}
+
+// doneColumnIDForColumns returns the first active column mapped to the done lifecycle state.
+func doneColumnIDForColumns(columns []domain.Column) string {
+	ordered := slices.Clone(columns)
+	slices.SortFunc(ordered, func(a, b domain.Column) int {
+		return cmp.Compare(a.Position, b.Position)
+	})
+	for _, column := range ordered {
+		if column.ArchivedAt == nil && normalizeStateID(column.Name) == "done" {
+			return column.ID
+		}
+	}
+	return ""
+}
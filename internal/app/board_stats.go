@@ -0,0 +1,66 @@
+package app
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// ProjectStats summarizes one project's board for reporting.
+type ProjectStats struct {
+	ProjectID  string
+	Slug       string
+	Name       string
+	TotalTasks int
+	ByState    map[domain.LifecycleState]int
+	// Overdue counts tasks past their due date that are neither done nor archived.
+	Overdue int
+	// Blocked comes from the dependency rollup, which only considers active tasks.
+	Blocked    int
+	AverageAge time.Duration
+}
+
+// GetProjectStats counts one project's tasks by lifecycle state and reports overdue, blocked, and average-age figures.
+func (s *Service) GetProjectStats(ctx context.Context, projectID string, includeArchived bool) (ProjectStats, error) {
+	projectID = strings.TrimSpace(projectID)
+	if projectID == "" {
+		return ProjectStats{}, domain.ErrInvalidID
+	}
+	project, err := s.repo.GetProject(ctx, projectID)
+	if err != nil {
+		return ProjectStats{}, err
+	}
+	tasks, err := s.ListTasks(ctx, projectID, includeArchived)
+	if err != nil {
+		return ProjectStats{}, err
+	}
+	rollup, err := s.GetProjectDependencyRollup(ctx, projectID)
+	if err != nil {
+		return ProjectStats{}, err
+	}
+
+	stats := ProjectStats{
+		ProjectID:  project.ID,
+		Slug:       project.Slug,
+		Name:       project.Name,
+		TotalTasks: len(tasks),
+		ByState:    map[domain.LifecycleState]int{},
+		Blocked:    rollup.BlockedItems,
+	}
+	now := s.clock().UTC()
+	var totalAge time.Duration
+	for _, task := range tasks {
+		stats.ByState[task.LifecycleState]++
+		if task.DueAt != nil && task.DueAt.Before(now) && task.LifecycleState != domain.StateDone && task.LifecycleState != domain.StateArchived {
+			stats.Overdue++
+		}
+		// Clock skew between writers can leave a creation time in the future; it counts as zero age.
+		totalAge += max(now.Sub(task.CreatedAt), 0)
+	}
+	if len(tasks) > 0 {
+		stats.AverageAge = totalAge / time.Duration(len(tasks))
+	}
+	return stats, nil
+}
@@ -0,0 +1,54 @@
+package app
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// ListDoneTasks lists the project's unarchived tasks that sit in columns mapped to the done lifecycle state.
+// Results follow board order: column position, then task position, then id.
+func (s *Service) ListDoneTasks(ctx context.Context, projectID string) ([]domain.Task, error) {
+	projectID = strings.TrimSpace(projectID)
+	if projectID == "" {
+		return nil, domain.ErrInvalidID
+	}
+	columns, err := s.ListColumns(ctx, projectID, false)
+	if err != nil {
+		return nil, err
+	}
+	columnOrder := make(map[string]int, len(columns))
+	for idx, column := range columns {
+		if lifecycleStateForColumnID(columns, column.ID) == domain.StateDone {
+			columnOrder[column.ID] = idx
+		}
+	}
+	if len(columnOrder) == 0 {
+		return []domain.Task{}, nil
+	}
+	tasks, err := s.repo.ListTasks(ctx, projectID, false)
+	if err != nil {
+		return nil, err
+	}
+	done := make([]domain.Task, 0)
+	for _, task := range tasks {
+		if task.ArchivedAt != nil {
+			continue
+		}
+		if _, ok := columnOrder[task.ColumnID]; ok {
+			done = append(done, task)
+		}
+	}
+	slices.SortStableFunc(done, func(a, b domain.Task) int {
+		if a.ColumnID != b.ColumnID {
+			return columnOrder[a.ColumnID] - columnOrder[b.ColumnID]
+		}
+		if a.Position != b.Position {
+			return a.Position - b.Position
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+	return done, nil
+}
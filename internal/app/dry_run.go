@@ -0,0 +1,18 @@
+package app
+
+import "context"
+
+// WithDryRun marks a context so mutation use-cases validate and compute results without persisting.
+func WithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunContextKey{}, true)
+}
+
+// DryRunFromContext reports whether the context requests a non-persisting mutation preview.
+func DryRunFromContext(ctx context.Context) bool {
+	raw := ctx.Value(dryRunContextKey{})
+	dryRun, ok := raw.(bool)
+	return ok && dryRun
+}
+
+// dryRunContextKey stores context keys for dry-run mutation flags.
+type dryRunContextKey struct{}
@@ -0,0 +1,114 @@
+package app
+
+import (
+	"errors"
+
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// ErrorCode classifies service failures for transport status mapping and UI branching.
+type ErrorCode string
+
+// ErrorCode values.
+const (
+	ErrorCodeNone       ErrorCode = ""
+	ErrorCodeNotFound   ErrorCode = "not_found"
+	ErrorCodeConflict   ErrorCode = "conflict"
+	ErrorCodeValidation ErrorCode = "validation"
+	ErrorCodePermission ErrorCode = "permission"
+	ErrorCodeWIPLimit   ErrorCode = "wip_limit"
+	ErrorCodeInternal   ErrorCode = "internal"
+)
+
+// notFoundErrors lists sentinels that describe missing resources.
+var notFoundErrors = []error{
+	ErrNotFound,
+	domain.ErrKindNotFound,
+}
+
+// permissionErrors lists sentinels that describe rejected caller authority.
+var permissionErrors = []error{
+	domain.ErrMutationLeaseRequired,
+	domain.ErrMutationLeaseInvalid,
+	domain.ErrMutationLeaseExpired,
+	domain.ErrMutationLeaseRevoked,
+	domain.ErrOrchestratorOverlap,
+	domain.ErrOverrideTokenRequired,
+	domain.ErrOverrideTokenInvalid,
+}
+
+// conflictErrors lists sentinels that describe state conflicts with the stored data.
+var conflictErrors = []error{
+	domain.ErrTransitionBlocked,
+	ErrParentHasSubtasks,
+}
+
+// validationErrors lists sentinels that describe malformed caller input.
+var validationErrors = []error{
+	domain.ErrInvalidID,
+	domain.ErrInvalidName,
+	domain.ErrInvalidTitle,
+	domain.ErrInvalidSummary,
+	domain.ErrInvalidBodyMarkdown,
+	domain.ErrInvalidPriority,
+	domain.ErrInvalidPosition,
+	domain.ErrInvalidColumnID,
+	domain.ErrInvalidParentID,
+	domain.ErrInvalidScopeType,
+	domain.ErrInvalidScopeID,
+	domain.ErrInvalidTargetID,
+	domain.ErrInvalidTargetType,
+	domain.ErrInvalidKind,
+	domain.ErrInvalidKindID,
+	domain.ErrInvalidKindAppliesTo,
+	domain.ErrKindNotAllowed,
+	domain.ErrInvalidKindTemplate,
+	domain.ErrInvalidKindPayload,
+	domain.ErrInvalidKindPayloadSchema,
+	domain.ErrInvalidLifecycleState,
+	domain.ErrInvalidActorType,
+	domain.ErrInvalidAttentionState,
+	domain.ErrInvalidAttentionKind,
+	domain.ErrInvalidCapabilityRole,
+	domain.ErrInvalidCapabilityScope,
+	domain.ErrInvalidCapabilityToken,
+	domain.ErrInvalidCapabilityExpiry,
+	domain.ErrInvalidWIPLimit,
+	ErrInvalidDeleteMode,
+	ErrInvalidSeedSize,
+	ErrInvalidCardFormat,
+	ErrInvalidExportFormat,
+	ErrInvalidImportMode,
+	ErrDuplicateColumnName,
+	ErrLabelNotAllowed,
+}
+
+// ErrorCodeOf classifies one error chain into a stable error code.
+func ErrorCodeOf(err error) ErrorCode {
+	switch {
+	case err == nil:
+		return ErrorCodeNone
+	case errorIsAny(err, notFoundErrors):
+		return ErrorCodeNotFound
+	case errorIsAny(err, permissionErrors):
+		return ErrorCodePermission
+	case errors.Is(err, domain.ErrWIPLimitExceeded):
+		return ErrorCodeWIPLimit
+	case errorIsAny(err, conflictErrors):
+		return ErrorCodeConflict
+	case errorIsAny(err, validationErrors):
+		return ErrorCodeValidation
+	default:
+		return ErrorCodeInternal
+	}
+}
+
+// errorIsAny reports whether err matches any target sentinel.
+func errorIsAny(err error, targets []error) bool {
+	for _, target := range targets {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
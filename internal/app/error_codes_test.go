@@ -0,0 +1,40 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// TestErrorCodeOf verifies sentinel errors classify into stable error codes through wrapping.
+func TestErrorCodeOf(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want ErrorCode
+	}{
+		{name: "nil", err: nil, want: ErrorCodeNone},
+		{name: "app not found", err: fmt.Errorf("get task: %w", ErrNotFound), want: ErrorCodeNotFound},
+		{name: "kind not found", err: domain.ErrKindNotFound, want: ErrorCodeNotFound},
+		{name: "lease expired", err: fmt.Errorf("guard: %w", domain.ErrMutationLeaseExpired), want: ErrorCodePermission},
+		{name: "override invalid", err: domain.ErrOverrideTokenInvalid, want: ErrorCodePermission},
+		{name: "transition blocked", err: fmt.Errorf("%w: start criteria unmet", domain.ErrTransitionBlocked), want: ErrorCodeConflict},
+		{name: "parent has subtasks", err: fmt.Errorf("%w: 2 subtasks", ErrParentHasSubtasks), want: ErrorCodeConflict},
+		{name: "wip limit", err: fmt.Errorf("move: %w", domain.ErrWIPLimitExceeded), want: ErrorCodeWIPLimit},
+		{name: "invalid title", err: domain.ErrInvalidTitle, want: ErrorCodeValidation},
+		{name: "invalid delete mode", err: ErrInvalidDeleteMode, want: ErrorCodeValidation},
+		{name: "invalid wip limit", err: domain.ErrInvalidWIPLimit, want: ErrorCodeValidation},
+		{name: "duplicate column name", err: fmt.Errorf("%w: %q", ErrDuplicateColumnName, "Done"), want: ErrorCodeValidation},
+		{name: "joined validation", err: errors.Join(errors.New("context"), domain.ErrInvalidPosition), want: ErrorCodeValidation},
+		{name: "unclassified", err: errors.New("disk full"), want: ErrorCodeInternal},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := ErrorCodeOf(tc.err); got != tc.want {
+				t.Fatalf("ErrorCodeOf(%v) = %q, want %q", tc.err, got, tc.want)
+			}
+		})
+	}
+}
@@ -4,6 +4,15 @@ import "errors"
 
 // ErrNotFound and related errors describe validation and runtime failures.
 var (
-	ErrNotFound          = errors.New("not found")
-	ErrInvalidDeleteMode = errors.New("invalid delete mode")
+	ErrNotFound            = errors.New("not found")
+	ErrInvalidDeleteMode   = errors.New("invalid delete mode")
+	ErrInvalidSeedSize     = errors.New("invalid seed size")
+	ErrParentHasSubtasks   = errors.New("task has subtasks")
+	ErrInvalidCardFormat   = errors.New("invalid task card format")
+	ErrInvalidExportFormat = errors.New("invalid export format")
+	ErrInvalidImportMode   = errors.New("invalid import mode")
+	ErrInvalidImportSource = errors.New("invalid import source")
+	ErrDuplicateColumnName = errors.New("duplicate column name")
+	ErrAmbiguousSlug       = errors.New("ambiguous project slug")
+	ErrLabelNotAllowed     = errors.New("label not allowed")
 )
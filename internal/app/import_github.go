@@ -0,0 +1,278 @@
+package app
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// githubIssue is the subset of one GitHub issue the converter reads. It accepts both the REST API's snake_case
+// fields and the camelCase fields printed by `gh issue list --json`.
+type githubIssue struct {
+	Number        int              `json:"number"`
+	Title         string           `json:"title"`
+	Body          string           `json:"body"`
+	State         string           `json:"state"`
+	Labels        []githubLabel    `json:"labels"`
+	Milestone     *githubMilestone `json:"milestone"`
+	Assignees     []githubUser     `json:"assignees"`
+	CreatedAt     *time.Time       `json:"created_at"`
+	CreatedAtCLI  *time.Time       `json:"createdAt"`
+	ClosedAt      *time.Time       `json:"closed_at"`
+	ClosedAtCLI   *time.Time       `json:"closedAt"`
+	HTMLURL       string           `json:"html_url"`
+	URL           string           `json:"url"`
+	RepositoryURL string           `json:"repository_url"`
+	PullRequest   json.RawMessage  `json:"pull_request"`
+	// Comments is a count in REST exports and a list in gh exports.
+	Comments json.RawMessage `json:"comments"`
+}
+
+// githubLabel is one issue label.
+type githubLabel struct {
+	Name string `json:"name"`
+}
+
+// githubMilestone is one issue milestone, imported as a branch grouping its issues.
+type githubMilestone struct {
+	Title    string     `json:"title"`
+	DueOn    *time.Time `json:"due_on"`
+	DueOnCLI *time.Time `json:"dueOn"`
+}
+
+// githubUser is one issue assignee.
+type githubUser struct {
+	Login string `json:"login"`
+}
+
+// SnapshotFromGitHubIssues converts one GitHub issues JSON export (a REST API issue array or `gh issue list --json`
+// output) into a snapshot holding a single new project named after the repository. Open issues land in a To Do
+// column and closed ones in Done; titles, bodies, and labels carry over, the first assignee becomes the task's
+// assignee, and each task links back to its issue as a ticket resource. Every milestone becomes a branch holding its
+// issues, done once all of them are closed. Pull requests, comments, and additional assignees are dropped with a
+// note rather than failing the import. New rows get ids from idGen.
+func SnapshotFromGitHubIssues(content []byte, idGen IDGenerator, now time.Time) (ConvertedSnapshot, error) {
+	var issues []githubIssue
+	if err := json.Unmarshal(content, &issues); err != nil {
+		return ConvertedSnapshot{}, fmt.Errorf("decode github issues json: %w", err)
+	}
+	if idGen == nil {
+		return ConvertedSnapshot{}, errors.New("github import requires an id generator")
+	}
+	now = now.UTC()
+	slices.SortStableFunc(issues, func(a, b githubIssue) int { return cmp.Compare(a.Number, b.Number) })
+
+	repo := githubRepository(issues)
+	name, description := cmp.Or(repo, "GitHub issues"), ""
+	if repo != "" {
+		description = "Issues imported from github.com/" + repo
+	}
+	project, err := domain.NewProject(idGen(), name, description, now)
+	if err != nil {
+		return ConvertedSnapshot{}, fmt.Errorf("github project %q: %w", name, err)
+	}
+	out := ConvertedSnapshot{Snapshot: Snapshot{
+		Version:    SnapshotVersion,
+		ExportedAt: now,
+		Projects:   []SnapshotProject{snapshotProjectFromDomain(project)},
+		Columns:    []SnapshotColumn{},
+		Tasks:      []SnapshotTask{},
+	}}
+	columns := make([]domain.Column, 0, 2)
+	for _, columnName := range []string{"To Do", "Done"} {
+		column, err := domain.NewColumn(idGen(), project.ID, columnName, len(columns), 0, now)
+		if err != nil {
+			return ConvertedSnapshot{}, fmt.Errorf("github column %q: %w", columnName, err)
+		}
+		columns = append(columns, column)
+		out.Snapshot.Columns = append(out.Snapshot.Columns, snapshotColumnFromDomain(column))
+	}
+	todo, done := columns[0], columns[1]
+
+	var pullRequests, untitled, comments, extraAssignees int
+	kept := make([]githubIssue, 0, len(issues))
+	for _, issue := range issues {
+		switch {
+		case len(issue.PullRequest) > 0 && string(issue.PullRequest) != "null":
+			pullRequests++
+		case strings.TrimSpace(issue.Title) == "":
+			untitled++
+		default:
+			kept = append(kept, issue)
+		}
+	}
+
+	// Milestones come first so each branch sits ahead of its issues; a branch is done once every issue in it is closed.
+	milestoneOpen := map[string]bool{}
+	milestoneDue := map[string]*time.Time{}
+	milestoneTitles := make([]string, 0)
+	for _, issue := range kept {
+		title := githubMilestoneTitle(issue)
+		if title == "" {
+			continue
+		}
+		if _, seen := milestoneOpen[title]; !seen {
+			milestoneTitles = append(milestoneTitles, title)
+		}
+		milestoneOpen[title] = milestoneOpen[title] || !githubIssueClosed(issue)
+		// gh exports only carry the due date when asked for it, so take it from any issue that has one.
+		milestoneDue[title] = cmp.Or(milestoneDue[title], issue.Milestone.DueOn, issue.Milestone.DueOnCLI)
+	}
+	slices.Sort(milestoneTitles)
+
+	nextPosition := map[string]int{}
+	branchIDs := map[string]string{}
+	for _, title := range milestoneTitles {
+		column := done
+		if milestoneOpen[title] {
+			column = todo
+		}
+		branch, err := domain.NewTask(domain.TaskInput{
+			ID:             idGen(),
+			ProjectID:      project.ID,
+			Kind:           domain.WorkKind("branch"),
+			Scope:          domain.KindAppliesToBranch,
+			ColumnID:       column.ID,
+			Position:       nextPosition[column.ID],
+			Title:          title,
+			Priority:       domain.PriorityMedium,
+			DueAt:          milestoneDue[title],
+			LifecycleState: lifecycleStateForColumnID(columns, column.ID),
+		}, now)
+		if err != nil {
+			return ConvertedSnapshot{}, fmt.Errorf("github milestone %q: %w", title, err)
+		}
+		nextPosition[column.ID]++
+		branchIDs[title] = branch.ID
+		out.Snapshot.Tasks = append(out.Snapshot.Tasks, snapshotTaskFromDomain(branch))
+	}
+
+	for _, issue := range kept {
+		comments += githubCommentCount(issue.Comments)
+		column := todo
+		if githubIssueClosed(issue) {
+			column = done
+		}
+		labels := make([]string, 0, len(issue.Labels))
+		for _, label := range issue.Labels {
+			labels = append(labels, label.Name)
+		}
+		metadata := domain.TaskMetadata{}
+		if len(issue.Assignees) > 0 {
+			metadata.Assignee = issue.Assignees[0].Login
+			extraAssignees += len(issue.Assignees) - 1
+		}
+		if link := githubIssueLink(issue); link != "" {
+			refTitle := fmt.Sprintf("#%d", issue.Number)
+			if repo != "" {
+				refTitle = repo + refTitle
+			}
+			metadata.ResourceRefs = []domain.ResourceRef{{
+				ResourceType: domain.ResourceTypeTicket,
+				Location:     link,
+				PathMode:     domain.PathModeAbsolute,
+				Title:        refTitle,
+			}}
+		}
+		task, err := domain.NewTask(domain.TaskInput{
+			ID:             idGen(),
+			ProjectID:      project.ID,
+			ParentID:       branchIDs[githubMilestoneTitle(issue)],
+			Kind:           domain.WorkKindTask,
+			ColumnID:       column.ID,
+			Position:       nextPosition[column.ID],
+			Title:          issue.Title,
+			Description:    issue.Body,
+			Priority:       domain.PriorityMedium,
+			Labels:         labels,
+			Metadata:       metadata,
+			LifecycleState: domain.StateTodo,
+		}, cmp.Or(issue.CreatedAt, issue.CreatedAtCLI, &now).UTC())
+		if err != nil {
+			return ConvertedSnapshot{}, fmt.Errorf("github issue #%d: %w", issue.Number, err)
+		}
+		nextPosition[column.ID]++
+		if column.ID == done.ID {
+			// Closing through the lifecycle records the completion time the issue was closed at.
+			closedAt := cmp.Or(issue.ClosedAt, issue.ClosedAtCLI, &now)
+			if err := task.SetLifecycleState(domain.StateDone, *closedAt); err != nil {
+				return ConvertedSnapshot{}, fmt.Errorf("github issue #%d: %w", issue.Number, err)
+			}
+		}
+		out.Snapshot.Tasks = append(out.Snapshot.Tasks, snapshotTaskFromDomain(task))
+	}
+
+	out.Dropped = droppedNotes(
+		droppedCount{pullRequests, "pull requests"},
+		droppedCount{untitled, "untitled issues"},
+		droppedCount{comments, "issue comments"},
+		droppedCount{extraAssignees, "additional assignees beyond each issue's first"},
+	)
+	return out, nil
+}
+
+// githubIssueClosed reports whether the issue is closed; gh prints states in upper case.
+func githubIssueClosed(issue githubIssue) bool {
+	return strings.EqualFold(strings.TrimSpace(issue.State), "closed")
+}
+
+// githubMilestoneTitle returns the trimmed milestone title, or "" when the issue has no milestone.
+func githubMilestoneTitle(issue githubIssue) string {
+	if issue.Milestone == nil {
+		return ""
+	}
+	return strings.TrimSpace(issue.Milestone.Title)
+}
+
+// githubIssueLink returns the issue's web URL. REST exports put it in html_url and use url for the API endpoint.
+func githubIssueLink(issue githubIssue) string {
+	if link := strings.TrimSpace(issue.HTMLURL); link != "" {
+		return link
+	}
+	link := strings.TrimSpace(issue.URL)
+	if strings.Contains(link, "api.github.com") {
+		return ""
+	}
+	return link
+}
+
+// githubRepository returns the "owner/repo" name found in the first issue whose URLs name one.
+func githubRepository(issues []githubIssue) string {
+	for _, issue := range issues {
+		for _, raw := range []string{issue.RepositoryURL, githubIssueLink(issue)} {
+			parsed, err := url.Parse(strings.TrimSpace(raw))
+			if err != nil || parsed.Host == "" {
+				continue
+			}
+			parts := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+			// API URLs read /repos/<owner>/<repo>; web URLs read /<owner>/<repo>/issues/<n>.
+			if len(parts) > 0 && parts[0] == "repos" {
+				parts = parts[1:]
+			}
+			if len(parts) >= 2 && parts[0] != "" && parts[1] != "" {
+				return parts[0] + "/" + parts[1]
+			}
+		}
+	}
+	return ""
+}
+
+// githubCommentCount reads a comment count from either a REST count or a gh comment list.
+func githubCommentCount(raw json.RawMessage) int {
+	var count int
+	if err := json.Unmarshal(raw, &count); err == nil {
+		return count
+	}
+	var list []json.RawMessage
+	if err := json.Unmarshal(raw, &list); err == nil {
+		return len(list)
+	}
+	return 0
+}
@@ -0,0 +1,188 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// ImportMode selects how a snapshot import matches its rows against stored data.
+type ImportMode string
+
+// ImportMode values.
+const (
+	// ImportModeReplace writes every snapshot row by id, so the snapshot's copy replaces any stored row with that id.
+	ImportModeReplace ImportMode = "replace"
+	// ImportModeMerge matches projects by slug and columns by name before writing, folding the snapshot into the board.
+	ImportModeMerge ImportMode = "merge"
+)
+
+// ProjectMatch records one snapshot project folded into a stored project with the same slug.
+type ProjectMatch struct {
+	Slug       string
+	SnapshotID string
+	StoredID   string
+}
+
+// ParseImportMode normalizes one import mode name, defaulting to replace.
+func ParseImportMode(raw string) (ImportMode, error) {
+	switch strings.TrimSpace(strings.ToLower(raw)) {
+	case "", string(ImportModeReplace):
+		return ImportModeReplace, nil
+	case string(ImportModeMerge):
+		return ImportModeMerge, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrInvalidImportMode, raw)
+	}
+}
+
+// ImportSnapshotMerge folds one snapshot into the stored board.
+// Projects match stored projects by slug, columns match by name within a matched project, and tasks match by id;
+// matches are updated, everything else is inserted, and stored rows the snapshot omits are left untouched.
+func (s *Service) ImportSnapshotMerge(ctx context.Context, snap Snapshot) (ImportSummary, error) {
+	if err := snap.Validate(); err != nil {
+		return ImportSummary{}, err
+	}
+	matched, err := s.matchSnapshotToStored(ctx, &snap)
+	if err != nil {
+		return ImportSummary{}, err
+	}
+	snap.sort()
+	return s.importValidatedSnapshot(ctx, snap, matched)
+}
+
+// matchSnapshotToStored rewrites snapshot project and column ids onto the stored rows they match.
+func (s *Service) matchSnapshotToStored(ctx context.Context, snap *Snapshot) ([]ProjectMatch, error) {
+	stored, err := s.repo.ListProjects(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	storedIDs := make(map[string]struct{}, len(stored))
+	storedBySlug := map[string][]domain.Project{}
+	for _, project := range stored {
+		storedIDs[project.ID] = struct{}{}
+		if slug := strings.TrimSpace(project.Slug); slug != "" {
+			storedBySlug[slug] = append(storedBySlug[slug], project)
+		}
+	}
+	snapshotIDs := make(map[string]struct{}, len(snap.Projects))
+	for _, project := range snap.Projects {
+		snapshotIDs[project.ID] = struct{}{}
+	}
+
+	projectIDs := map[string]string{}
+	claimed := map[string]struct{}{}
+	matched := make([]ProjectMatch, 0)
+	for _, project := range snap.Projects {
+		if _, ok := storedIDs[project.ID]; ok {
+			continue
+		}
+		candidates := storedBySlug[strings.TrimSpace(project.Slug)]
+		// An ambiguous slug, or one whose stored project the snapshot also carries, is imported as a new project.
+		if len(candidates) != 1 {
+			continue
+		}
+		target := candidates[0]
+		if _, ok := snapshotIDs[target.ID]; ok {
+			continue
+		}
+		if _, ok := claimed[target.ID]; ok {
+			continue
+		}
+		claimed[target.ID] = struct{}{}
+		projectIDs[project.ID] = target.ID
+		matched = append(matched, ProjectMatch{Slug: target.Slug, SnapshotID: project.ID, StoredID: target.ID})
+	}
+
+	columnIDs, err := s.matchSnapshotColumns(ctx, snap, projectIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(projectIDs) == 0 && len(columnIDs) == 0 {
+		return matched, nil
+	}
+	snap.rewriteIDs(projectIDs, columnIDs)
+	return matched, nil
+}
+
+// matchSnapshotColumns maps snapshot columns of stored projects onto stored columns with the same name.
+func (s *Service) matchSnapshotColumns(ctx context.Context, snap *Snapshot, projectIDs map[string]string) (map[string]string, error) {
+	columnIDs := map[string]string{}
+	storedByProject := map[string][]domain.Column{}
+	claimed := map[string]struct{}{}
+	for _, column := range snap.Columns {
+		projectID := column.ProjectID
+		if mapped, ok := projectIDs[projectID]; ok {
+			projectID = mapped
+		}
+		columns, ok := storedByProject[projectID]
+		if !ok {
+			var err error
+			columns, err = s.repo.ListColumns(ctx, projectID, true)
+			if err != nil {
+				return nil, err
+			}
+			storedByProject[projectID] = columns
+		}
+		// A column the store already holds under its own id needs no matching.
+		if slices.ContainsFunc(columns, func(stored domain.Column) bool { return stored.ID == column.ID }) {
+			claimed[column.ID] = struct{}{}
+			continue
+		}
+		for _, stored := range columns {
+			if _, taken := claimed[stored.ID]; taken || !strings.EqualFold(strings.TrimSpace(stored.Name), strings.TrimSpace(column.Name)) {
+				continue
+			}
+			columnIDs[column.ID] = stored.ID
+			claimed[stored.ID] = struct{}{}
+			break
+		}
+	}
+	return columnIDs, nil
+}
+
+// rewriteIDs replaces matched project and column ids across every snapshot section.
+// Sections are copied first so the caller's snapshot is left as decoded.
+func (s *Snapshot) rewriteIDs(projectIDs, columnIDs map[string]string) {
+	remap := func(ids map[string]string, id string) string {
+		if mapped, ok := ids[id]; ok {
+			return mapped
+		}
+		return id
+	}
+	s.Projects = slices.Clone(s.Projects)
+	for i := range s.Projects {
+		s.Projects[i].ID = remap(projectIDs, s.Projects[i].ID)
+	}
+	s.Columns = slices.Clone(s.Columns)
+	for i := range s.Columns {
+		s.Columns[i].ID = remap(columnIDs, s.Columns[i].ID)
+		s.Columns[i].ProjectID = remap(projectIDs, s.Columns[i].ProjectID)
+	}
+	s.Tasks = slices.Clone(s.Tasks)
+	for i := range s.Tasks {
+		s.Tasks[i].ProjectID = remap(projectIDs, s.Tasks[i].ProjectID)
+		s.Tasks[i].ColumnID = remap(columnIDs, s.Tasks[i].ColumnID)
+	}
+	s.Comments = slices.Clone(s.Comments)
+	for i := range s.Comments {
+		s.Comments[i].ProjectID = remap(projectIDs, s.Comments[i].ProjectID)
+		if s.Comments[i].TargetType == domain.CommentTargetTypeProject {
+			s.Comments[i].TargetID = remap(projectIDs, s.Comments[i].TargetID)
+		}
+	}
+	s.ProjectAllowedKinds = slices.Clone(s.ProjectAllowedKinds)
+	for i := range s.ProjectAllowedKinds {
+		s.ProjectAllowedKinds[i].ProjectID = remap(projectIDs, s.ProjectAllowedKinds[i].ProjectID)
+	}
+	s.CapabilityLeases = slices.Clone(s.CapabilityLeases)
+	for i := range s.CapabilityLeases {
+		s.CapabilityLeases[i].ProjectID = remap(projectIDs, s.CapabilityLeases[i].ProjectID)
+		if s.CapabilityLeases[i].ScopeType == domain.CapabilityScopeProject {
+			s.CapabilityLeases[i].ScopeID = remap(projectIDs, s.CapabilityLeases[i].ScopeID)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package app
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// DuplicateSlug identifies one project slug shared by several projects.
+type DuplicateSlug struct {
+	Slug       string
+	ProjectIDs []string
+}
+
+// planImport classifies each snapshot row as an insert or an update of stored data without writing anything.
+// Stored rows are read once per stored project, or once per comment target, rather than once per row.
+// Tasks and comments of projects not yet stored count as inserts.
+func (s *Service) planImport(ctx context.Context, snap Snapshot) (ImportSummary, error) {
+	summary := ImportSummary{}
+	stored, err := s.repo.ListProjects(ctx, true)
+	if err != nil {
+		return ImportSummary{}, err
+	}
+	storedProjects := make(map[string]domain.Project, len(stored))
+	for _, project := range stored {
+		storedProjects[project.ID] = project
+	}
+	for _, project := range snap.Projects {
+		countImportRow(&summary.Projects, hasKey(storedProjects, project.ID))
+	}
+	summary.DuplicateSlugs = duplicateImportSlugs(stored, snap.Projects)
+
+	storedColumns := map[string]struct{}{}
+	for _, project := range snap.Projects {
+		if _, ok := storedProjects[project.ID]; !ok {
+			continue
+		}
+		columns, err := s.repo.ListColumns(ctx, project.ID, true)
+		if err != nil {
+			return ImportSummary{}, err
+		}
+		for _, column := range columns {
+			storedColumns[column.ID] = struct{}{}
+		}
+	}
+	for _, column := range snap.Columns {
+		countImportRow(&summary.Columns, hasKey(storedColumns, column.ID))
+	}
+
+	storedTasks := map[string]struct{}{}
+	listedProjects := map[string]struct{}{}
+	for _, task := range snap.Tasks {
+		if _, ok := storedProjects[task.ProjectID]; !ok || hasKey(listedProjects, task.ProjectID) {
+			continue
+		}
+		listedProjects[task.ProjectID] = struct{}{}
+		tasks, err := s.repo.ListTasks(ctx, task.ProjectID, true)
+		if err != nil {
+			return ImportSummary{}, err
+		}
+		for _, stored := range tasks {
+			storedTasks[stored.ID] = struct{}{}
+		}
+	}
+	for _, task := range snap.Tasks {
+		countImportRow(&summary.Tasks, hasKey(storedTasks, task.ID))
+	}
+
+	storedComments := map[domain.CommentTarget]map[string]struct{}{}
+	for _, comment := range snap.Comments {
+		target := domain.CommentTarget{
+			ProjectID:  comment.ProjectID,
+			TargetType: comment.TargetType,
+			TargetID:   comment.TargetID,
+		}
+		if _, ok := storedProjects[target.ProjectID]; !ok {
+			summary.Comments.Created++
+			continue
+		}
+		ids, listed := storedComments[target]
+		if !listed {
+			existing, err := s.repo.ListCommentsByTarget(ctx, target)
+			if err != nil {
+				return ImportSummary{}, err
+			}
+			ids = make(map[string]struct{}, len(existing))
+			for _, stored := range existing {
+				ids[stored.ID] = struct{}{}
+			}
+			storedComments[target] = ids
+		}
+		if !hasKey(ids, comment.ID) {
+			summary.Comments.Created++
+		}
+	}
+	return summary, nil
+}
+
+// countImportRow tallies one row as an update when it is already stored, otherwise as an insert.
+func countImportRow(counts *ImportCounts, stored bool) {
+	if stored {
+		counts.Updated++
+		return
+	}
+	counts.Created++
+}
+
+// hasKey reports whether one map holds the key.
+func hasKey[V any](values map[string]V, key string) bool {
+	_, ok := values[key]
+	return ok
+}
+
+// duplicateImportSlugs lists slugs that several projects would share once the snapshot projects replace their stored rows.
+// Only slugs touched by the snapshot are reported, so pre-existing duplicates elsewhere stay out of the summary.
+func duplicateImportSlugs(stored []domain.Project, imported []SnapshotProject) []DuplicateSlug {
+	slugByID := make(map[string]string, len(stored)+len(imported))
+	for _, project := range stored {
+		slugByID[project.ID] = strings.TrimSpace(project.Slug)
+	}
+	touched := map[string]struct{}{}
+	for _, project := range imported {
+		slug := strings.TrimSpace(project.Slug)
+		slugByID[project.ID] = slug
+		touched[slug] = struct{}{}
+	}
+	idsBySlug := map[string][]string{}
+	for id, slug := range slugByID {
+		if slug == "" {
+			continue
+		}
+		idsBySlug[slug] = append(idsBySlug[slug], id)
+	}
+	duplicates := make([]DuplicateSlug, 0)
+	for slug, ids := range idsBySlug {
+		if _, ok := touched[slug]; !ok || len(ids) < 2 {
+			continue
+		}
+		slices.Sort(ids)
+		duplicates = append(duplicates, DuplicateSlug{Slug: slug, ProjectIDs: ids})
+	}
+	slices.SortFunc(duplicates, func(a, b DuplicateSlug) int { return strings.Compare(a.Slug, b.Slug) })
+	return duplicates
+}
@@ -0,0 +1,57 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ImportSource identifies the file format one import reads.
+type ImportSource string
+
+// ImportSource values.
+const (
+	// ImportSourceSnapshot reads a tillsyn snapshot JSON file.
+	ImportSourceSnapshot ImportSource = "snapshot"
+	// ImportSourceTrello reads a Trello board JSON export and converts it into a new project.
+	ImportSourceTrello ImportSource = "trello"
+	// ImportSourceGitHub reads a GitHub issues JSON export and converts it into a new project.
+	ImportSourceGitHub ImportSource = "github"
+)
+
+// ParseImportSource normalizes one import source name, defaulting to snapshot.
+func ParseImportSource(raw string) (ImportSource, error) {
+	switch strings.TrimSpace(strings.ToLower(raw)) {
+	case "", string(ImportSourceSnapshot):
+		return ImportSourceSnapshot, nil
+	case string(ImportSourceTrello):
+		return ImportSourceTrello, nil
+	case string(ImportSourceGitHub):
+		return ImportSourceGitHub, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrInvalidImportSource, raw)
+	}
+}
+
+// ConvertedSnapshot is a snapshot built from another tool's export, with notes on the source data it left out.
+type ConvertedSnapshot struct {
+	Snapshot Snapshot
+	// Dropped describes source fields with no tillsyn equivalent, e.g. "dropped 4 card comments".
+	Dropped []string
+}
+
+// droppedCount counts one kind of source data a converter left out.
+type droppedCount struct {
+	count int
+	what  string
+}
+
+// droppedNotes renders the non-zero counts as ConvertedSnapshot.Dropped notes, in argument order.
+func droppedNotes(counts ...droppedCount) []string {
+	var out []string
+	for _, dropped := range counts {
+		if dropped.count > 0 {
+			out = append(out, fmt.Sprintf("dropped %d %s", dropped.count, dropped.what))
+		}
+	}
+	return out
+}
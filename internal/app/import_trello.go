@@ -0,0 +1,250 @@
+package app
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// trelloBoard is the subset of a Trello board JSON export the converter reads.
+type trelloBoard struct {
+	Name         string            `json:"name"`
+	Desc         string            `json:"desc"`
+	Lists        []trelloList      `json:"lists"`
+	Cards        []trelloCard      `json:"cards"`
+	Checklists   []trelloChecklist `json:"checklists"`
+	Actions      []trelloAction    `json:"actions"`
+	CustomFields []json.RawMessage `json:"customFields"`
+}
+
+// trelloList is one Trello list, imported as a column.
+type trelloList struct {
+	ID     string  `json:"id"`
+	Name   string  `json:"name"`
+	Closed bool    `json:"closed"`
+	Pos    float64 `json:"pos"`
+}
+
+// trelloCard is one Trello card, imported as a task.
+type trelloCard struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Desc        string            `json:"desc"`
+	Closed      bool              `json:"closed"`
+	IDList      string            `json:"idList"`
+	Pos         float64           `json:"pos"`
+	Due         *time.Time        `json:"due"`
+	Labels      []trelloLabel     `json:"labels"`
+	IDMembers   []string          `json:"idMembers"`
+	Attachments []json.RawMessage `json:"attachments"`
+}
+
+// trelloLabel is one Trello label; unnamed labels are identified only by color.
+type trelloLabel struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+// trelloChecklist is one Trello checklist, whose items import as subtasks of its card.
+type trelloChecklist struct {
+	Name       string            `json:"name"`
+	IDCard     string            `json:"idCard"`
+	Pos        float64           `json:"pos"`
+	CheckItems []trelloCheckItem `json:"checkItems"`
+}
+
+// trelloCheckItem is one checklist entry.
+type trelloCheckItem struct {
+	Name  string     `json:"name"`
+	State string     `json:"state"`
+	Pos   float64    `json:"pos"`
+	Due   *time.Time `json:"due"`
+}
+
+// trelloAction is one entry of the board's activity feed; only comments are counted.
+type trelloAction struct {
+	Type string `json:"type"`
+}
+
+// SnapshotFromTrello converts one Trello board JSON export into a snapshot holding a single new project.
+// Lists become columns and cards become tasks in list and card order; closed lists and cards are archived.
+// Card labels keep their names (unnamed labels use their color) and due dates carry over. Checklist items become
+// subtasks of their card, marked done when checked, and are prefixed with the checklist name when a card has several.
+// Comments, attachments, members, and custom fields have no equivalent and are dropped with a note rather than
+// failing the import, as are cards whose list is missing from the export. New rows get ids from idGen.
+func SnapshotFromTrello(content []byte, idGen IDGenerator, now time.Time) (ConvertedSnapshot, error) {
+	var board trelloBoard
+	if err := json.Unmarshal(content, &board); err != nil {
+		return ConvertedSnapshot{}, fmt.Errorf("decode trello json: %w", err)
+	}
+	if idGen == nil {
+		return ConvertedSnapshot{}, errors.New("trello import requires an id generator")
+	}
+	now = now.UTC()
+
+	name := strings.TrimSpace(board.Name)
+	if name == "" {
+		name = "Trello import"
+	}
+	project, err := domain.NewProject(idGen(), name, board.Desc, now)
+	if err != nil {
+		return ConvertedSnapshot{}, fmt.Errorf("trello board %q: %w", name, err)
+	}
+	out := ConvertedSnapshot{Snapshot: Snapshot{
+		Version:    SnapshotVersion,
+		ExportedAt: now,
+		Projects:   []SnapshotProject{snapshotProjectFromDomain(project)},
+		Columns:    []SnapshotColumn{},
+		Tasks:      []SnapshotTask{},
+	}}
+
+	lists := slices.Clone(board.Lists)
+	slices.SortStableFunc(lists, func(a, b trelloList) int { return cmp.Compare(a.Pos, b.Pos) })
+	columns := make([]domain.Column, 0, len(lists))
+	columnByList := map[string]domain.Column{}
+	for _, list := range lists {
+		listName := strings.TrimSpace(list.Name)
+		if listName == "" {
+			listName = "Untitled list"
+		}
+		column, err := domain.NewColumn(idGen(), project.ID, listName, len(columns), 0, now)
+		if err != nil {
+			return ConvertedSnapshot{}, fmt.Errorf("trello list %q: %w", listName, err)
+		}
+		if list.Closed {
+			column.Archive(now)
+		}
+		columns = append(columns, column)
+		columnByList[list.ID] = column
+		out.Snapshot.Columns = append(out.Snapshot.Columns, snapshotColumnFromDomain(column))
+	}
+
+	checklistsByCard := map[string][]trelloChecklist{}
+	for _, checklist := range board.Checklists {
+		checklistsByCard[checklist.IDCard] = append(checklistsByCard[checklist.IDCard], checklist)
+	}
+	cards := slices.Clone(board.Cards)
+	slices.SortStableFunc(cards, func(a, b trelloCard) int { return cmp.Compare(a.Pos, b.Pos) })
+
+	nextPosition := map[string]int{}
+	var orphanCards, attachments, membered, untitled int
+	for _, card := range cards {
+		column, ok := columnByList[card.IDList]
+		if !ok {
+			orphanCards++
+			continue
+		}
+		title := strings.TrimSpace(card.Name)
+		if title == "" {
+			untitled++
+			continue
+		}
+		attachments += len(card.Attachments)
+		if len(card.IDMembers) > 0 {
+			membered++
+		}
+		labels := make([]string, 0, len(card.Labels))
+		for _, label := range card.Labels {
+			labels = append(labels, cmp.Or(strings.TrimSpace(label.Name), strings.TrimSpace(label.Color)))
+		}
+		task, err := domain.NewTask(domain.TaskInput{
+			ID:             idGen(),
+			ProjectID:      project.ID,
+			ColumnID:       column.ID,
+			Position:       nextPosition[column.ID],
+			Title:          title,
+			Description:    card.Desc,
+			Priority:       domain.PriorityMedium,
+			DueAt:          card.Due,
+			Labels:         labels,
+			LifecycleState: lifecycleStateForColumnID(columns, column.ID),
+		}, trelloCreatedAt(card.ID, now))
+		if err != nil {
+			return ConvertedSnapshot{}, fmt.Errorf("trello card %q: %w", title, err)
+		}
+		nextPosition[column.ID]++
+		if card.Closed || column.ArchivedAt != nil {
+			task.Archive(now)
+		}
+		out.Snapshot.Tasks = append(out.Snapshot.Tasks, snapshotTaskFromDomain(task))
+
+		checklists := checklistsByCard[card.ID]
+		slices.SortStableFunc(checklists, func(a, b trelloChecklist) int { return cmp.Compare(a.Pos, b.Pos) })
+		for _, checklist := range checklists {
+			items := slices.Clone(checklist.CheckItems)
+			slices.SortStableFunc(items, func(a, b trelloCheckItem) int { return cmp.Compare(a.Pos, b.Pos) })
+			for _, item := range items {
+				itemTitle := strings.TrimSpace(item.Name)
+				if itemTitle == "" {
+					untitled++
+					continue
+				}
+				// With several checklists on one card the prefix keeps items from different lists apart.
+				if prefix := strings.TrimSpace(checklist.Name); len(checklists) > 1 && prefix != "" {
+					itemTitle = prefix + ": " + itemTitle
+				}
+				subtask, err := domain.NewTask(domain.TaskInput{
+					ID:             idGen(),
+					ProjectID:      project.ID,
+					ParentID:       task.ID,
+					Kind:           domain.WorkKindSubtask,
+					ColumnID:       column.ID,
+					Position:       nextPosition[column.ID],
+					Title:          itemTitle,
+					Priority:       domain.PriorityMedium,
+					DueAt:          item.Due,
+					LifecycleState: task.LifecycleState,
+				}, task.CreatedAt)
+				if err != nil {
+					return ConvertedSnapshot{}, fmt.Errorf("trello checklist item %q on card %q: %w", itemTitle, title, err)
+				}
+				nextPosition[column.ID]++
+				switch {
+				case task.ArchivedAt != nil:
+					subtask.Archive(now)
+				case item.State == "complete":
+					if err := subtask.SetLifecycleState(domain.StateDone, now); err != nil {
+						return ConvertedSnapshot{}, fmt.Errorf("trello checklist item %q on card %q: %w", itemTitle, title, err)
+					}
+				}
+				out.Snapshot.Tasks = append(out.Snapshot.Tasks, snapshotTaskFromDomain(subtask))
+			}
+		}
+	}
+
+	comments := 0
+	for _, action := range board.Actions {
+		if action.Type == "commentCard" {
+			comments++
+		}
+	}
+	out.Dropped = droppedNotes(
+		droppedCount{orphanCards, "cards whose list is missing from the export"},
+		droppedCount{untitled, "untitled cards and checklist items"},
+		droppedCount{comments, "card comments"},
+		droppedCount{attachments, "card attachments"},
+		droppedCount{membered, "card member assignments"},
+		droppedCount{len(board.CustomFields), "custom field definitions"},
+	)
+	return out, nil
+}
+
+// trelloCreatedAt recovers a card's creation time from its id, whose first eight hex digits are a Unix timestamp.
+// Ids that do not decode fall back to fallback.
+func trelloCreatedAt(id string, fallback time.Time) time.Time {
+	if len(id) < 8 {
+		return fallback
+	}
+	seconds, err := strconv.ParseInt(id[:8], 16, 64)
+	if err != nil || seconds <= 0 {
+		return fallback
+	}
+	return time.Unix(seconds, 0).UTC()
+}
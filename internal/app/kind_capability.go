@@ -504,6 +504,9 @@ func (s *Service) enforceMutationGuardAcrossScopes(ctx context.Context, projectI
 		log.Error("mutation blocked: lease scope mismatch", "project_id", projectID, "agent_instance_id", guard.AgentInstanceID, "lease_scope_type", lease.ScopeType, "lease_scope_id", lease.ScopeID, "requested_scopes", strings.Join(requestedScopes, ","))
 		return domain.ErrMutationLeaseInvalid
 	}
+	if DryRunFromContext(ctx) {
+		return nil
+	}
 	lease.Heartbeat(now)
 	if err := s.repo.UpdateCapabilityLease(ctx, lease); err != nil {
 		return err
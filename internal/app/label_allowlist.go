@@ -0,0 +1,67 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// LabelAllowlist returns the labels allowed on one project's tasks by project slug, global labels included.
+type LabelAllowlist func(projectSlug string) []string
+
+// WithLabelAllowlist marks a context so task creates and updates fail when they carry labels allowed does not list.
+// Without it labels are free-form; callers attach it when labels.enforce_allowed is on.
+func WithLabelAllowlist(ctx context.Context, allowed LabelAllowlist) context.Context {
+	return context.WithValue(ctx, labelAllowlistContextKey{}, allowed)
+}
+
+// LabelAllowlistFromContext returns the label allowlist the context enforces, if any.
+func LabelAllowlistFromContext(ctx context.Context) (LabelAllowlist, bool) {
+	allowed, ok := ctx.Value(labelAllowlistContextKey{}).(LabelAllowlist)
+	return allowed, ok && allowed != nil
+}
+
+// labelAllowlistContextKey stores context keys for label allowlist enforcement.
+type labelAllowlistContextKey struct{}
+
+// CheckLabelsAllowed rejects labels missing from allowed, comparing case-insensitively.
+// An empty allowlist rejects every label, since enforcement without configured labels would otherwise allow anything.
+func CheckLabelsAllowed(projectSlug string, labels, allowed []string) error {
+	if len(labels) == 0 {
+		return nil
+	}
+	if len(allowed) == 0 {
+		return fmt.Errorf("no labels configured for project %q; disable labels.enforce_allowed to allow free-form labels: %w", projectSlug, ErrLabelNotAllowed)
+	}
+	allowedSet := make(map[string]struct{}, len(allowed))
+	for _, label := range allowed {
+		allowedSet[strings.TrimSpace(strings.ToLower(label))] = struct{}{}
+	}
+	disallowed := make([]string, 0)
+	for _, raw := range labels {
+		label := strings.TrimSpace(strings.ToLower(raw))
+		if _, ok := allowedSet[label]; label == "" || ok {
+			continue
+		}
+		disallowed = append(disallowed, label)
+	}
+	if len(disallowed) == 0 {
+		return nil
+	}
+	slices.Sort(disallowed)
+	return fmt.Errorf("labels not allowed for project %q: %s (allowed: %s): %w", projectSlug, strings.Join(disallowed, ", "), strings.Join(allowed, ", "), ErrLabelNotAllowed)
+}
+
+// ensureLabelsAllowed checks a task's labels against the allowlist the context enforces.
+func (s *Service) ensureLabelsAllowed(ctx context.Context, projectID string, labels []string) error {
+	allowlist, ok := LabelAllowlistFromContext(ctx)
+	if !ok || len(labels) == 0 {
+		return nil
+	}
+	project, err := s.repo.GetProject(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	return CheckLabelsAllowed(project.Slug, labels, allowlist(project.Slug))
+}
@@ -0,0 +1,147 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// SetTaskLifecycleState sets one task's lifecycle state explicitly, decoupled from its column.
+// An empty state clears the explicit state so the task follows its column mapping again.
+func (s *Service) SetTaskLifecycleState(ctx context.Context, taskID string, state domain.LifecycleState) (domain.Task, error) {
+	taskID = strings.TrimSpace(taskID)
+	if taskID == "" {
+		return domain.Task{}, domain.ErrInvalidID
+	}
+	state = domain.LifecycleState(strings.TrimSpace(strings.ToLower(string(state))))
+	switch state {
+	case "", domain.StateTodo, domain.StateProgress, domain.StateDone:
+	default:
+		// Archival has its own archive/restore flow and is never set directly.
+		return domain.Task{}, domain.ErrInvalidLifecycleState
+	}
+	task, err := s.repo.GetTask(ctx, taskID)
+	if err != nil {
+		return domain.Task{}, err
+	}
+	if task.ArchivedAt != nil {
+		return domain.Task{}, fmt.Errorf("%w: archived tasks must be restored first", domain.ErrTransitionBlocked)
+	}
+	guardScopes, err := s.capabilityScopesForTaskLineage(ctx, task)
+	if err != nil {
+		return domain.Task{}, err
+	}
+	if err := s.enforceMutationGuardAcrossScopes(ctx, task.ProjectID, task.UpdatedByType, guardScopes); err != nil {
+		return domain.Task{}, err
+	}
+	columns, err := s.repo.ListColumns(ctx, task.ProjectID, true)
+	if err != nil {
+		return domain.Task{}, err
+	}
+	explicit := state != ""
+	if !explicit {
+		state = lifecycleStateForColumnID(columns, task.ColumnID)
+		if state == "" {
+			state = domain.StateTodo
+		}
+	}
+	fromState := task.LifecycleState
+	if err := s.ensureLifecycleTransitionAllowed(ctx, task, fromState, state); err != nil {
+		return domain.Task{}, err
+	}
+	if err := task.SetLifecycleState(state, s.clock()); err != nil {
+		return domain.Task{}, err
+	}
+	task.Metadata.LifecycleStateExplicit = explicit
+	applyMutationActorToTask(ctx, &task)
+	return s.commitTaskTransition(ctx, task, fromState, state, columns)
+}
+
+// commitTaskTransition finishes every lifecycle change MoveTask and SetTaskLifecycleState make, so both paths
+// to done behave alike. It records manual reopens, hands a completed task's recurrence to its follow-up, and
+// writes the task together with the ancestors its completion auto-completes as one batch.
+func (s *Service) commitTaskTransition(ctx context.Context, task domain.Task, fromState, toState domain.LifecycleState, columns []domain.Column) (domain.Task, error) {
+	completed := toState == domain.StateDone && fromState != domain.StateDone
+	// Parent auto-complete bypasses this path, so every call here is a manual decision it must respect.
+	switch {
+	case fromState == domain.StateDone && toState != domain.StateDone:
+		task.Metadata.ManuallyReopened = true
+	case toState == domain.StateDone:
+		task.Metadata.ManuallyReopened = false
+	}
+	// Completing a recurring task hands its recurrence to the follow-up, so reopening and completing it again does not repeat.
+	recurEvery := ""
+	if completed {
+		recurEvery = task.Metadata.RecurEvery
+		task.Metadata.RecurEvery = ""
+	}
+	if DryRunFromContext(ctx) {
+		return task, nil
+	}
+
+	batch := TaskBatch{Update: []domain.Task{task}}
+	if completed {
+		ancestors, err := s.autoCompleteAncestors(ctx, task, columns)
+		if err != nil {
+			return domain.Task{}, err
+		}
+		// The change events carry the system actor; task attribution stays with the last human or agent
+		// editor so later guarded moves are not mistaken for system mutations.
+		for _, ancestor := range ancestors {
+			if batch.Actors == nil {
+				batch.Actors = map[string]MutationActor{}
+			}
+			batch.Actors[ancestor.ID] = autoCompleteActor
+			batch.Update = append(batch.Update, ancestor)
+		}
+	}
+	if err := s.repo.ApplyTaskBatch(ctx, batch); err != nil {
+		return domain.Task{}, err
+	}
+	s.invalidateDependencyRollup(task.ProjectID)
+	for _, written := range batch.Update {
+		s.refreshTaskEmbedding(ctx, written)
+	}
+	if recurEvery != "" {
+		if err := s.createRecurringFollowUp(ctx, task, recurEvery, columns); err != nil {
+			return domain.Task{}, err
+		}
+	}
+	return task, nil
+}
+
+// ensureLifecycleTransitionAllowed enforces start and completion criteria for one lifecycle transition.
+func (s *Service) ensureLifecycleTransitionAllowed(ctx context.Context, task domain.Task, fromState, toState domain.LifecycleState) error {
+	if fromState == domain.StateTodo && toState == domain.StateProgress {
+		if unmet := task.StartCriteriaUnmet(); len(unmet) > 0 {
+			return fmt.Errorf("%w: start criteria unmet (%s)", domain.ErrTransitionBlocked, strings.Join(unmet, ", "))
+		}
+	}
+	if toState != domain.StateDone {
+		return nil
+	}
+	projectTasks, err := s.repo.ListTasks(ctx, task.ProjectID, true)
+	if err != nil {
+		return err
+	}
+	children := make([]domain.Task, 0)
+	for _, candidate := range projectTasks {
+		if candidate.ParentID == task.ID {
+			children = append(children, candidate)
+		}
+	}
+	for _, child := range children {
+		if child.ArchivedAt != nil {
+			continue
+		}
+		if child.LifecycleState != domain.StateDone {
+			return fmt.Errorf("%w: completion criteria unmet (subtasks must be done before moving to done)", domain.ErrTransitionBlocked)
+		}
+	}
+	if unmet := task.CompletionCriteriaUnmet(children); len(unmet) > 0 {
+		return fmt.Errorf("%w: completion criteria unmet (%s)", domain.ErrTransitionBlocked, strings.Join(unmet, ", "))
+	}
+	return s.ensureTaskCompletionAttentionClear(ctx, task)
+}
@@ -0,0 +1,144 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// OrphanRepairMode selects how RepairOrphanedTasks resolves tasks with a missing parent.
+type OrphanRepairMode string
+
+// OrphanRepairReparent and related constants define supported orphan repair modes.
+const (
+	// OrphanRepairReparent moves each orphan to the project root.
+	OrphanRepairReparent OrphanRepairMode = "reparent"
+	// OrphanRepairDelete hard-deletes each orphan together with its descendants.
+	OrphanRepairDelete OrphanRepairMode = "delete"
+)
+
+// ErrInvalidOrphanRepairMode reports an unsupported orphan repair mode.
+var ErrInvalidOrphanRepairMode = errors.New("invalid orphan repair mode")
+
+// OrphanedTaskLister is an optional repository extension that finds orphaned tasks without loading the whole project.
+type OrphanedTaskLister interface {
+	ListOrphanedTasks(context.Context, string) ([]domain.Task, error)
+}
+
+// ListOrphanedTasks returns tasks in one project whose ParentID references a task missing from that project.
+func (s *Service) ListOrphanedTasks(ctx context.Context, projectID string) ([]domain.Task, error) {
+	projectID = strings.TrimSpace(projectID)
+	if projectID == "" {
+		return nil, domain.ErrInvalidID
+	}
+	if lister, ok := s.repo.(OrphanedTaskLister); ok {
+		return lister.ListOrphanedTasks(ctx, projectID)
+	}
+	tasks, err := s.repo.ListTasks(ctx, projectID, true)
+	if err != nil {
+		return nil, err
+	}
+	return orphanedTasks(tasks), nil
+}
+
+// RepairOrphanedTasks reparents orphans to the project root or deletes them, returning the orphans it resolved.
+// Orphans cannot pass the normal lineage checks, so repairs are guarded at project scope only.
+func (s *Service) RepairOrphanedTasks(ctx context.Context, projectID string, mode OrphanRepairMode) ([]domain.Task, error) {
+	switch mode {
+	case OrphanRepairReparent, OrphanRepairDelete:
+	default:
+		return nil, ErrInvalidOrphanRepairMode
+	}
+	projectID = strings.TrimSpace(projectID)
+	if projectID == "" {
+		return nil, domain.ErrInvalidID
+	}
+	tasks, err := s.repo.ListTasks(ctx, projectID, true)
+	if err != nil {
+		return nil, err
+	}
+	orphans := orphanedTasks(tasks)
+	if len(orphans) == 0 || DryRunFromContext(ctx) {
+		return orphans, nil
+	}
+	projectScope := []mutationScopeCandidate{newProjectMutationScopeCandidate(projectID)}
+	for _, orphan := range orphans {
+		if err := s.enforceMutationGuardAcrossScopes(ctx, projectID, orphan.UpdatedByType, projectScope); err != nil {
+			return nil, err
+		}
+	}
+
+	// All repairs land in one batch, so a failure never leaves some orphans repaired and others not.
+	batch := TaskBatch{}
+	for _, orphan := range orphans {
+		switch mode {
+		case OrphanRepairReparent:
+			if err := orphan.Reparent("", s.clock()); err != nil {
+				return nil, err
+			}
+			promoteToRootTask(&orphan)
+			applyMutationActorToTask(ctx, &orphan)
+			batch.Update = append(batch.Update, orphan)
+		case OrphanRepairDelete:
+			// Children of a deleted orphan would become orphans themselves, so remove the whole subtree.
+			batch.Delete = append(batch.Delete, descendantTaskIDs(tasks, orphan.ID)...)
+		}
+	}
+	if err := s.repo.ApplyTaskBatch(ctx, batch); err != nil {
+		return nil, err
+	}
+	for _, taskID := range batch.Delete {
+		s.dropTaskEmbedding(ctx, taskID)
+	}
+	s.invalidateDependencyRollup(projectID)
+	return orphans, nil
+}
+
+// promoteToRootTask rewrites subtask kind and scope for an item moved to the project root.
+// Root-level items cannot keep the subtask scope, and subtask-kind rows stay hidden on the board.
+func promoteToRootTask(task *domain.Task) {
+	if task.Scope == domain.KindAppliesToSubtask {
+		task.Scope = domain.KindAppliesToTask
+	}
+	if task.Kind == domain.WorkKindSubtask {
+		task.Kind = domain.WorkKindTask
+	}
+}
+
+// orphanedTasks returns tasks whose parent is not present in the same task list, ordered by ID.
+func orphanedTasks(tasks []domain.Task) []domain.Task {
+	byID := make(map[string]struct{}, len(tasks))
+	for _, task := range tasks {
+		byID[task.ID] = struct{}{}
+	}
+	out := make([]domain.Task, 0)
+	for _, task := range tasks {
+		parentID := strings.TrimSpace(task.ParentID)
+		if parentID == "" {
+			continue
+		}
+		if _, ok := byID[parentID]; !ok {
+			out = append(out, task)
+		}
+	}
+	slices.SortFunc(out, func(a, b domain.Task) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+	return out
+}
+
+// descendantTaskIDs returns rootID followed by every descendant, children before grandchildren.
+func descendantTaskIDs(tasks []domain.Task, rootID string) []string {
+	out := []string{rootID}
+	for idx := 0; idx < len(out); idx++ {
+		for _, task := range tasks {
+			if task.ParentID == out[idx] {
+				out = append(out, task.ID)
+			}
+		}
+	}
+	return out
+}
@@ -24,6 +24,8 @@ type Repository interface {
 
 	CreateColumn(context.Context, domain.Column) error
 	UpdateColumn(context.Context, domain.Column) error
+	UpdateColumns(context.Context, []domain.Column) error
+	GetColumn(context.Context, string) (domain.Column, error)
 	ListColumns(context.Context, string, bool) ([]domain.Column, error)
 
 	CreateTask(context.Context, domain.Task) error
@@ -31,8 +33,10 @@ type Repository interface {
 	GetTask(context.Context, string) (domain.Task, error)
 	ListTasks(context.Context, string, bool) ([]domain.Task, error)
 	DeleteTask(context.Context, string) error
+	ApplyTaskBatch(context.Context, TaskBatch) error
 	CreateComment(context.Context, domain.Comment) error
 	ListCommentsByTarget(context.Context, domain.CommentTarget) ([]domain.Comment, error)
+	SearchCommentTargetIDs(context.Context, []string, string) ([]string, error)
 	ListProjectChangeEvents(context.Context, string, int) ([]domain.ChangeEvent, error)
 	CreateAttentionItem(context.Context, domain.AttentionItem) error
 	GetAttentionItem(context.Context, string) (domain.AttentionItem, error)
@@ -45,3 +49,55 @@ type Repository interface {
 	ListCapabilityLeasesByScope(context.Context, string, domain.CapabilityScopeType, string) ([]domain.CapabilityLease, error)
 	RevokeCapabilityLeasesByScope(context.Context, string, domain.CapabilityScopeType, string, time.Time, string) error
 }
+
+// TaskBatch is one set of task writes a repository applies atomically: every update, then every deletion.
+// Actors optionally attributes individual writes, keyed by task id, to an actor other than the request's.
+type TaskBatch struct {
+	Update []domain.Task
+	Delete []string
+	Actors map[string]MutationActor
+}
+
+// TaskPageQuery scopes one position-ordered page of board rows within a single column.
+// Board rows are the non-subtask tasks the board shows at project level: roots and orphans.
+// Limit and Offset count board rows only; their descendants ride along with each page.
+type TaskPageQuery struct {
+	ProjectID       string
+	ColumnID        string
+	IncludeArchived bool
+	Limit           int
+	Offset          int
+}
+
+// TaskPageCount holds how many board rows one column has, in total and unarchived.
+type TaskPageCount struct {
+	Total  int
+	Active int
+}
+
+// TaskDateWindow bounds tasks by due and created time; each window is [after, before) and zero bounds are open.
+// Tasks without a due date never match a due bound.
+type TaskDateWindow struct {
+	DueAfter      time.Time
+	DueBefore     time.Time
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+}
+
+// IsZero reports whether the window sets no bound.
+func (w TaskDateWindow) IsZero() bool {
+	return w.DueAfter.IsZero() && w.DueBefore.IsZero() && w.CreatedAfter.IsZero() && w.CreatedBefore.IsZero()
+}
+
+// TaskWindowLister is an optional repository extension that filters task search by date window in the query.
+// ListTasksInWindow lists a project's tasks, archived ones included, inside the window.
+type TaskWindowLister interface {
+	ListTasksInWindow(context.Context, string, TaskDateWindow) ([]domain.Task, error)
+}
+
+// TaskPageLister is an optional repository extension for paginated per-column task reads.
+// ListTasksPage returns the page's board rows in position order followed by all of their descendants.
+type TaskPageLister interface {
+	ListTasksPage(context.Context, TaskPageQuery) ([]domain.Task, error)
+	CountTasksPage(context.Context, TaskPageQuery) (TaskPageCount, error)
+}
@@ -0,0 +1,146 @@
+package app
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// NormalizeColumnPositions renumbers tasks 0..n-1 within each column of one project by current order.
+// It repairs gaps and duplicate positions and returns how many tasks changed position.
+func (s *Service) NormalizeColumnPositions(ctx context.Context, projectID string) (int, error) {
+	projectID = strings.TrimSpace(projectID)
+	if projectID == "" {
+		return 0, domain.ErrInvalidID
+	}
+	if _, err := s.repo.GetProject(ctx, projectID); err != nil {
+		return 0, err
+	}
+	tasks, err := s.repo.ListTasks(ctx, projectID, true)
+	if err != nil {
+		return 0, err
+	}
+	// Duplicate positions keep a stable order by creation time, then ID.
+	slices.SortFunc(tasks, func(a, b domain.Task) int {
+		return cmp.Or(
+			strings.Compare(a.ColumnID, b.ColumnID),
+			cmp.Compare(a.Position, b.Position),
+			a.CreatedAt.Compare(b.CreatedAt),
+			strings.Compare(a.ID, b.ID),
+		)
+	})
+
+	batch := TaskBatch{}
+	next := 0
+	for idx, task := range tasks {
+		if idx == 0 || task.ColumnID != tasks[idx-1].ColumnID {
+			next = 0
+		}
+		position := next
+		next++
+		if task.Position == position {
+			continue
+		}
+		if err := task.Move(task.ColumnID, position, s.clock()); err != nil {
+			return 0, err
+		}
+		applyMutationActorToTask(ctx, &task)
+		batch.Update = append(batch.Update, task)
+	}
+	if len(batch.Update) == 0 {
+		return 0, nil
+	}
+	// Guard enforcement must follow the caller's request actor, not historical task attribution.
+	guardActorType := domain.ActorTypeUser
+	if actor, ok := MutationActorFromContext(ctx); ok {
+		guardActorType = normalizeActorTypeInput(actor.ActorType)
+	}
+	projectScope := []mutationScopeCandidate{newProjectMutationScopeCandidate(projectID)}
+	if err := s.enforceMutationGuardAcrossScopes(ctx, projectID, guardActorType, projectScope); err != nil {
+		return 0, err
+	}
+	if DryRunFromContext(ctx) {
+		return len(batch.Update), nil
+	}
+	// Every renumbered task lands in one batch, so a failure never leaves a column half-normalized.
+	if err := s.repo.ApplyTaskBatch(ctx, batch); err != nil {
+		return 0, err
+	}
+	s.invalidateDependencyRollup(projectID)
+	return len(batch.Update), nil
+}
+
+// MoveColumn moves one active column to newPosition among its project's active columns and renumbers the rest.
+// Archived columns keep their relative order after the active ones, so positions stay unique.
+func (s *Service) MoveColumn(ctx context.Context, columnID string, newPosition int) (domain.Column, error) {
+	columnID = strings.TrimSpace(columnID)
+	if columnID == "" {
+		return domain.Column{}, domain.ErrInvalidID
+	}
+	column, err := s.repo.GetColumn(ctx, columnID)
+	if err != nil {
+		return domain.Column{}, err
+	}
+	if column.ArchivedAt != nil {
+		return domain.Column{}, ErrNotFound
+	}
+	columns, err := s.repo.ListColumns(ctx, column.ProjectID, true)
+	if err != nil {
+		return domain.Column{}, err
+	}
+	slices.SortStableFunc(columns, func(a, b domain.Column) int {
+		return cmp.Or(
+			cmp.Compare(a.Position, b.Position),
+			strings.Compare(a.ID, b.ID),
+		)
+	})
+	active := make([]domain.Column, 0, len(columns))
+	archived := make([]domain.Column, 0)
+	for _, candidate := range columns {
+		if candidate.ID == columnID {
+			continue
+		}
+		if candidate.ArchivedAt != nil {
+			archived = append(archived, candidate)
+			continue
+		}
+		active = append(active, candidate)
+	}
+	if newPosition < 0 || newPosition > len(active) {
+		return domain.Column{}, domain.ErrInvalidPosition
+	}
+	ordered := slices.Insert(active, newPosition, column)
+	ordered = append(ordered, archived...)
+
+	// Guard enforcement must follow the caller's request actor, not historical column attribution.
+	guardActorType := domain.ActorTypeUser
+	if actor, ok := MutationActorFromContext(ctx); ok {
+		guardActorType = normalizeActorTypeInput(actor.ActorType)
+	}
+	projectScope := []mutationScopeCandidate{newProjectMutationScopeCandidate(column.ProjectID)}
+	if err := s.enforceMutationGuardAcrossScopes(ctx, column.ProjectID, guardActorType, projectScope); err != nil {
+		return domain.Column{}, err
+	}
+
+	now := s.clock()
+	changed := make([]domain.Column, 0, len(ordered))
+	for idx := range ordered {
+		if ordered[idx].Position == idx {
+			continue
+		}
+		if err := ordered[idx].SetPosition(idx, now); err != nil {
+			return domain.Column{}, err
+		}
+		changed = append(changed, ordered[idx])
+	}
+	// Every renumbered column lands in one write, so a failure never leaves duplicate or gapped positions.
+	if len(changed) > 0 && !DryRunFromContext(ctx) {
+		if err := s.repo.UpdateColumns(ctx, changed); err != nil {
+			return domain.Column{}, err
+		}
+	}
+	return ordered[newPosition], nil
+}
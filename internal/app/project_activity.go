@@ -0,0 +1,53 @@
+package app
+
+import (
+	"context"
+	"time"
+)
+
+// ProjectActivity summarizes one project's size and latest change for ordering project lists.
+type ProjectActivity struct {
+	ProjectID string
+	// TaskCount counts the project's unarchived tasks.
+	TaskCount int
+	// LastChangeAt is the newest change event's time, zero when the project has none.
+	LastChangeAt time.Time
+}
+
+// ProjectActivityLister is an optional repository extension that summarizes every project in one query.
+type ProjectActivityLister interface {
+	ListProjectActivity(context.Context) ([]ProjectActivity, error)
+}
+
+// ListProjectActivity summarizes task counts and latest changes for every project, archived ones included.
+func (s *Service) ListProjectActivity(ctx context.Context) ([]ProjectActivity, error) {
+	if lister, ok := s.repo.(ProjectActivityLister); ok {
+		return lister.ListProjectActivity(ctx)
+	}
+	return s.listProjectActivityFallback(ctx)
+}
+
+// listProjectActivityFallback builds the summaries project by project for repositories without an aggregate query.
+func (s *Service) listProjectActivityFallback(ctx context.Context) ([]ProjectActivity, error) {
+	projects, err := s.repo.ListProjects(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]ProjectActivity, 0, len(projects))
+	for _, project := range projects {
+		tasks, err := s.repo.ListTasks(ctx, project.ID, false)
+		if err != nil {
+			return nil, err
+		}
+		events, err := s.repo.ListProjectChangeEvents(ctx, project.ID, 1)
+		if err != nil {
+			return nil, err
+		}
+		activity := ProjectActivity{ProjectID: project.ID, TaskCount: len(tasks)}
+		if len(events) > 0 {
+			activity.LastChangeAt = events[0].OccurredAt
+		}
+		out = append(out, activity)
+	}
+	return out, nil
+}
@@ -0,0 +1,192 @@
+package app
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// PurgedTask names one archived task removed by a purge, or selected for removal on a dry run.
+type PurgedTask struct {
+	TaskID     string
+	ProjectID  string
+	Title      string
+	ArchivedAt time.Time
+}
+
+// KeptTask names one purge candidate left in place because tasks that stay still reference it.
+type KeptTask struct {
+	TaskID    string
+	ProjectID string
+	Title     string
+	// ReferencedBy lists the IDs of the staying tasks that depend on, are blocked by, or are children of this task.
+	ReferencedBy []string
+}
+
+// PurgeResult summarizes one purge pass.
+type PurgeResult struct {
+	Purged []PurgedTask
+	Kept   []KeptTask
+}
+
+// PurgeArchivedBefore hard-deletes every task archived before cutoff, across all projects.
+// A candidate that a staying task still references through depends_on, blocked_by, or its parent link is kept,
+// unless force is set; forced purges strip the dangling dependency IDs and move orphaned children to the project root.
+// The detaching updates and the deletions land in one repository batch. Dry runs report the same result without changing anything.
+func (s *Service) PurgeArchivedBefore(ctx context.Context, cutoff time.Time, force bool) (PurgeResult, error) {
+	projects, err := s.repo.ListProjects(ctx, true)
+	if err != nil {
+		return PurgeResult{}, err
+	}
+	tasks := make([]domain.Task, 0)
+	for _, project := range projects {
+		projectTasks, err := s.repo.ListTasks(ctx, project.ID, true)
+		if err != nil {
+			return PurgeResult{}, err
+		}
+		tasks = append(tasks, projectTasks...)
+	}
+
+	purge := map[string]struct{}{}
+	for _, task := range tasks {
+		if task.ArchivedAt != nil && task.ArchivedAt.Before(cutoff) {
+			purge[task.ID] = struct{}{}
+		}
+	}
+	if !force {
+		// Keeping one candidate can make it a staying referrer of another, so repeat until nothing changes.
+		for changed := true; changed; {
+			changed = false
+			for _, task := range tasks {
+				if _, ok := purge[task.ID]; !ok {
+					continue
+				}
+				if len(purgeReferrers(tasks, purge, task.ID)) > 0 {
+					delete(purge, task.ID)
+					changed = true
+				}
+			}
+		}
+	}
+
+	result := PurgeResult{}
+	for _, task := range tasks {
+		if task.ArchivedAt == nil || !task.ArchivedAt.Before(cutoff) {
+			continue
+		}
+		if _, ok := purge[task.ID]; ok {
+			result.Purged = append(result.Purged, PurgedTask{
+				TaskID:     task.ID,
+				ProjectID:  task.ProjectID,
+				Title:      task.Title,
+				ArchivedAt: *task.ArchivedAt,
+			})
+			continue
+		}
+		result.Kept = append(result.Kept, KeptTask{
+			TaskID:       task.ID,
+			ProjectID:    task.ProjectID,
+			Title:        task.Title,
+			ReferencedBy: purgeReferrers(tasks, purge, task.ID),
+		})
+	}
+	slices.SortFunc(result.Purged, func(a, b PurgedTask) int {
+		return cmp.Or(cmp.Compare(a.ProjectID, b.ProjectID), a.ArchivedAt.Compare(b.ArchivedAt), cmp.Compare(a.TaskID, b.TaskID))
+	})
+	slices.SortFunc(result.Kept, func(a, b KeptTask) int {
+		return cmp.Or(cmp.Compare(a.ProjectID, b.ProjectID), cmp.Compare(a.TaskID, b.TaskID))
+	})
+	if len(result.Purged) == 0 || DryRunFromContext(ctx) {
+		return result, nil
+	}
+
+	for _, task := range tasks {
+		if _, ok := purge[task.ID]; !ok {
+			continue
+		}
+		projectScope := []mutationScopeCandidate{newProjectMutationScopeCandidate(task.ProjectID)}
+		if err := s.enforceMutationGuardAcrossScopes(ctx, task.ProjectID, task.UpdatedByType, projectScope); err != nil {
+			return PurgeResult{}, err
+		}
+	}
+	batch := TaskBatch{}
+	touched := map[string]struct{}{}
+	if force {
+		for _, task := range tasks {
+			if _, ok := purge[task.ID]; ok {
+				continue
+			}
+			changed, err := detachPurgedReferences(&task, purge, s.clock())
+			if err != nil {
+				return PurgeResult{}, err
+			}
+			if !changed {
+				continue
+			}
+			applyMutationActorToTask(ctx, &task)
+			batch.Update = append(batch.Update, task)
+			touched[task.ProjectID] = struct{}{}
+		}
+	}
+	for _, purged := range result.Purged {
+		batch.Delete = append(batch.Delete, purged.TaskID)
+		touched[purged.ProjectID] = struct{}{}
+	}
+	if err := s.repo.ApplyTaskBatch(ctx, batch); err != nil {
+		return PurgeResult{}, err
+	}
+	for _, purged := range result.Purged {
+		s.dropTaskEmbedding(ctx, purged.TaskID)
+	}
+	for projectID := range touched {
+		s.invalidateDependencyRollup(projectID)
+	}
+	return result, nil
+}
+
+// purgeReferrers returns the IDs of tasks outside the purge set that reference taskID, ordered by ID.
+func purgeReferrers(tasks []domain.Task, purge map[string]struct{}, taskID string) []string {
+	out := make([]string, 0)
+	for _, task := range tasks {
+		if task.ID == taskID {
+			continue
+		}
+		if _, ok := purge[task.ID]; ok {
+			continue
+		}
+		if task.ParentID == taskID || slices.Contains(task.Metadata.DependsOn, taskID) || slices.Contains(task.Metadata.BlockedBy, taskID) {
+			out = append(out, task.ID)
+		}
+	}
+	slices.Sort(out)
+	return out
+}
+
+// detachPurgedReferences drops purged IDs from one staying task's dependency lists and moves it to the project
+// root when its parent is being purged. It reports whether the task changed.
+func detachPurgedReferences(task *domain.Task, purge map[string]struct{}, now time.Time) (bool, error) {
+	isPurged := func(id string) bool {
+		_, ok := purge[id]
+		return ok
+	}
+	changed := false
+	if slices.ContainsFunc(task.Metadata.DependsOn, isPurged) {
+		task.Metadata.DependsOn = slices.DeleteFunc(slices.Clone(task.Metadata.DependsOn), isPurged)
+		changed = true
+	}
+	if slices.ContainsFunc(task.Metadata.BlockedBy, isPurged) {
+		task.Metadata.BlockedBy = slices.DeleteFunc(slices.Clone(task.Metadata.BlockedBy), isPurged)
+		changed = true
+	}
+	if task.ParentID != "" && isPurged(task.ParentID) {
+		if err := task.Reparent("", now); err != nil {
+			return false, err
+		}
+		promoteToRootTask(task)
+		changed = true
+	}
+	return changed, nil
+}
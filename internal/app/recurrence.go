@@ -0,0 +1,95 @@
+package app
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// recurrenceActorID identifies the system actor recorded on recurring follow-up change events.
+const recurrenceActorID = "tillsyn-system-recurrence"
+
+// createRecurringFollowUp clones one just-completed recurring task into the first active column with its due date advanced.
+// The clone copies title, description, priority, labels, reminders, and the recurrence itself under a fresh id.
+// Tasks without a due date schedule the follow-up one interval after completion.
+func (s *Service) createRecurringFollowUp(ctx context.Context, task domain.Task, recurEvery string, columns []domain.Column) error {
+	columnID := firstActiveColumnID(columns)
+	if columnID == "" {
+		return nil
+	}
+	base := s.clock()
+	if task.DueAt != nil {
+		base = *task.DueAt
+	}
+	nextDue, err := domain.AdvanceRecurrence(base, recurEvery)
+	if err != nil {
+		return err
+	}
+	tasks, err := s.repo.ListTasks(ctx, task.ProjectID, false)
+	if err != nil {
+		return err
+	}
+	position := 0
+	for _, candidate := range tasks {
+		if candidate.ColumnID == columnID {
+			position = max(position, candidate.Position+1)
+		}
+	}
+	lifecycleState := lifecycleStateForColumnID(columns, columnID)
+	if lifecycleState == "" {
+		lifecycleState = domain.StateTodo
+	}
+	followUp, err := domain.NewTask(domain.TaskInput{
+		ID:             s.idGen(),
+		ProjectID:      task.ProjectID,
+		ParentID:       task.ParentID,
+		Kind:           task.Kind,
+		Scope:          task.Scope,
+		LifecycleState: lifecycleState,
+		ColumnID:       columnID,
+		Position:       position,
+		Title:          task.Title,
+		Description:    task.Description,
+		Priority:       task.Priority,
+		DueAt:          &nextDue,
+		Labels:         slices.Clone(task.Labels),
+		Metadata: domain.TaskMetadata{
+			Reminders:  slices.Clone(task.Metadata.Reminders),
+			RecurEvery: recurEvery,
+		},
+		CreatedByActor: task.UpdatedByActor,
+		UpdatedByActor: task.UpdatedByActor,
+		UpdatedByType:  task.UpdatedByType,
+	}, s.clock())
+	if err != nil {
+		return err
+	}
+	// The create event carries the system actor so the activity log shows the follow-up as automatic.
+	systemCtx := WithMutationActor(ctx, MutationActor{
+		ActorID:   recurrenceActorID,
+		ActorName: "recurring tasks",
+		ActorType: domain.ActorTypeSystem,
+	})
+	if err := s.repo.CreateTask(systemCtx, followUp); err != nil {
+		return err
+	}
+	s.refreshTaskEmbedding(ctx, followUp)
+	return nil
+}
+
+// firstActiveColumnID returns the leftmost active column.
+func firstActiveColumnID(columns []domain.Column) string {
+	ordered := slices.Clone(columns)
+	slices.SortFunc(ordered, func(a, b domain.Column) int {
+		return cmp.Or(cmp.Compare(a.Position, b.Position), strings.Compare(a.ID, b.ID))
+	})
+	for _, column := range ordered {
+		if column.ArchivedAt == nil {
+			return column.ID
+		}
+	}
+	return ""
+}
@@ -0,0 +1,131 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// MissingResourceRef names one local resource ref whose path could not be found.
+type MissingResourceRef struct {
+	TaskID    string
+	TaskTitle string
+	Location  string
+	Path      string
+}
+
+// ResourceVerification summarizes one project's resource verification pass.
+type ResourceVerification struct {
+	// Checked counts local refs whose path could be resolved and tested.
+	Checked int
+	// Verified counts checked refs whose path still exists.
+	Verified int
+	// Unresolved counts relative refs skipped because the project has no root configured.
+	Unresolved int
+	// Missing lists checked refs whose path is gone, in task order.
+	Missing []MissingResourceRef
+}
+
+// VerifyResourceRefs checks every local file and directory ref on one project's tasks, archived ones included.
+// Found refs get a fresh LastVerifiedAt and lose their missing marker; gone refs keep MissingSince from the first miss.
+// Relative refs resolve against root, and are left untouched when root is empty. URLs and other ref types are skipped.
+func (s *Service) VerifyResourceRefs(ctx context.Context, projectID, root string) (ResourceVerification, error) {
+	projectID = strings.TrimSpace(projectID)
+	if projectID == "" {
+		return ResourceVerification{}, domain.ErrInvalidID
+	}
+	tasks, err := s.repo.ListTasks(ctx, projectID, true)
+	if err != nil {
+		return ResourceVerification{}, err
+	}
+	root = strings.TrimSpace(root)
+	now := s.clock().UTC().Truncate(time.Second)
+	projectScope := []mutationScopeCandidate{newProjectMutationScopeCandidate(projectID)}
+
+	result := ResourceVerification{}
+	for _, task := range tasks {
+		refs := append([]domain.ResourceRef(nil), task.Metadata.ResourceRefs...)
+		changed := false
+		for idx, ref := range refs {
+			if ref.ResourceType != domain.ResourceTypeLocalFile && ref.ResourceType != domain.ResourceTypeLocalDir {
+				continue
+			}
+			path, ok := resolveLocalResourcePath(ref, root)
+			if !ok {
+				result.Unresolved++
+				continue
+			}
+			result.Checked++
+			found, err := localResourceExists(path, ref.ResourceType == domain.ResourceTypeLocalDir)
+			if err != nil {
+				return ResourceVerification{}, fmt.Errorf("verify resource %q on task %s: %w", ref.Location, task.ID, err)
+			}
+			if found {
+				result.Verified++
+				verifiedAt := now
+				refs[idx].LastVerifiedAt = &verifiedAt
+				refs[idx].MissingSince = nil
+				changed = true
+				continue
+			}
+			result.Missing = append(result.Missing, MissingResourceRef{
+				TaskID:    task.ID,
+				TaskTitle: task.Title,
+				Location:  ref.Location,
+				Path:      path,
+			})
+			if ref.MissingSince == nil {
+				missingSince := now
+				refs[idx].MissingSince = &missingSince
+				changed = true
+			}
+		}
+		if !changed || DryRunFromContext(ctx) {
+			continue
+		}
+		if err := s.enforceMutationGuardAcrossScopes(ctx, projectID, task.UpdatedByType, projectScope); err != nil {
+			return ResourceVerification{}, err
+		}
+		// Verification is bookkeeping, so it rewrites the refs without counting as an edit to the task.
+		task.Metadata.ResourceRefs = refs
+		if err := s.repo.UpdateTask(ctx, task); err != nil {
+			return ResourceVerification{}, err
+		}
+	}
+	return result, nil
+}
+
+// resolveLocalResourcePath returns the filesystem path for one local ref; relative refs need a root.
+func resolveLocalResourcePath(ref domain.ResourceRef, root string) (string, bool) {
+	location := filepath.FromSlash(strings.TrimSpace(ref.Location))
+	if location == "" {
+		return "", false
+	}
+	if ref.PathMode == domain.PathModeAbsolute || filepath.IsAbs(location) {
+		return filepath.Clean(location), true
+	}
+	if root == "" {
+		return "", false
+	}
+	return filepath.Join(root, location), true
+}
+
+// localResourceExists reports whether path exists with the expected kind; a file where a directory was attached counts as missing.
+func localResourceExists(path string, wantDir bool) (bool, error) {
+	info, err := os.Stat(path)
+	// A file sitting where a parent directory used to be reports ENOTDIR rather than not-exist.
+	if errors.Is(err, os.ErrNotExist) || errors.Is(err, syscall.ENOTDIR) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return info.IsDir() == wantDir, nil
+}
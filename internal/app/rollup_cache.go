@@ -0,0 +1,144 @@
+package app
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// defaultDependencyRollupCacheTTL bounds how long a cached rollup may hide writes made by other processes.
+const defaultDependencyRollupCacheTTL = 5 * time.Second
+
+// DependencyRollupCacheStats reports cumulative dependency-rollup cache counters.
+type DependencyRollupCacheStats struct {
+	Entries       int
+	Hits          uint64
+	Misses        uint64
+	Invalidations uint64
+}
+
+// dependencyRollupCacheEntry stores one cached rollup and when it was computed.
+type dependencyRollupCacheEntry struct {
+	rollup   domain.DependencyRollup
+	storedAt time.Time
+}
+
+// dependencyRollupCache stores per-project rollups invalidated by in-process task writes.
+// generation advances on every invalidation, so a rollup computed from reads that began before one is never stored.
+type dependencyRollupCache struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	entries    map[string]dependencyRollupCacheEntry
+	generation uint64
+	stats      DependencyRollupCacheStats
+}
+
+// newDependencyRollupCache constructs a rollup cache; a negative ttl disables caching.
+func newDependencyRollupCache(ttl time.Duration) *dependencyRollupCache {
+	if ttl == 0 {
+		ttl = defaultDependencyRollupCacheTTL
+	}
+	return &dependencyRollupCache{
+		ttl:     ttl,
+		entries: map[string]dependencyRollupCacheEntry{},
+	}
+}
+
+// get returns one fresh cached rollup for a project, plus the generation a miss should hand back to put.
+func (c *dependencyRollupCache) get(projectID string, now time.Time) (domain.DependencyRollup, uint64, bool) {
+	if c == nil || c.ttl < 0 {
+		return domain.DependencyRollup{}, 0, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[projectID]
+	if ok && now.Sub(entry.storedAt) > c.ttl {
+		delete(c.entries, projectID)
+		ok = false
+	}
+	if !ok {
+		c.stats.Misses++
+		c.logLocked("miss", projectID)
+		return domain.DependencyRollup{}, c.generation, false
+	}
+	c.stats.Hits++
+	c.logLocked("hit", projectID)
+	return entry.rollup, c.generation, true
+}
+
+// put stores one computed rollup for a project unless an invalidation happened since get returned generation.
+func (c *dependencyRollupCache) put(projectID string, rollup domain.DependencyRollup, generation uint64, now time.Time) {
+	if c == nil || c.ttl < 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if generation != c.generation {
+		c.logLocked("stale", projectID)
+		return
+	}
+	c.entries[projectID] = dependencyRollupCacheEntry{rollup: rollup, storedAt: now}
+}
+
+// invalidate drops the cached rollup for one project, or every project when projectID is empty.
+func (c *dependencyRollupCache) invalidate(projectID string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// Advance even when nothing is cached: a read in flight must not store what it computed before this write.
+	c.generation++
+	projectID = strings.TrimSpace(projectID)
+	if projectID == "" {
+		if len(c.entries) == 0 {
+			return
+		}
+		c.entries = map[string]dependencyRollupCacheEntry{}
+	} else {
+		if _, ok := c.entries[projectID]; !ok {
+			return
+		}
+		delete(c.entries, projectID)
+	}
+	c.stats.Invalidations++
+	c.logLocked("invalidate", projectID)
+}
+
+// snapshot returns the current cache counters.
+func (c *dependencyRollupCache) snapshot() DependencyRollupCacheStats {
+	if c == nil {
+		return DependencyRollupCacheStats{}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stats := c.stats
+	stats.Entries = len(c.entries)
+	return stats
+}
+
+// logLocked emits one debug line with running cache counters; callers must hold c.mu.
+func (c *dependencyRollupCache) logLocked(event, projectID string) {
+	log.Debug(
+		"dependency rollup cache",
+		"event", event,
+		"project_id", projectID,
+		"entries", len(c.entries),
+		"hits", c.stats.Hits,
+		"misses", c.stats.Misses,
+		"invalidations", c.stats.Invalidations,
+	)
+}
+
+// DependencyRollupCacheStats returns cumulative dependency-rollup cache counters.
+func (s *Service) DependencyRollupCacheStats() DependencyRollupCacheStats {
+	return s.rollupCache.snapshot()
+}
+
+// invalidateDependencyRollup drops cached rollups after task writes change dependency state.
+func (s *Service) invalidateDependencyRollup(projectID string) {
+	s.rollupCache.invalidate(projectID)
+}
@@ -32,6 +32,7 @@ type jsonSchemaValidator struct {
 // jsonSchemaNode represents one compiled schema node.
 type jsonSchemaNode struct {
 	typ             string
+	nullable        bool
 	required        []string
 	requiredSet     map[string]struct{}
 	properties      map[string]*jsonSchemaNode
@@ -92,11 +93,12 @@ func compileJSONSchemaNode(raw any, path string) (*jsonSchemaNode, error) {
 	}
 
 	if rawType, ok := obj["type"]; ok {
-		typeText, ok := rawType.(string)
-		if !ok {
-			return nil, SchemaValidationError{Path: path + ".type", Message: "must be a string"}
+		typeText, nullable, err := parseSchemaType(rawType)
+		if err != nil {
+			return nil, SchemaValidationError{Path: path + ".type", Message: err.Error()}
 		}
-		node.typ = strings.TrimSpace(strings.ToLower(typeText))
+		node.typ = typeText
+		node.nullable = nullable
 		switch node.typ {
 		case "object", "array", "string", "number", "integer", "boolean", "null":
 		default:
@@ -188,6 +190,38 @@ func compileJSONSchemaNode(raw any, path string) (*jsonSchemaNode, error) {
 	return node, nil
 }
 
+// parseSchemaType reads one schema type, accepting the nullable union form ["<type>", "null"].
+func parseSchemaType(raw any) (string, bool, error) {
+	switch value := raw.(type) {
+	case string:
+		return strings.TrimSpace(strings.ToLower(value)), false, nil
+	case []any:
+		typ := ""
+		nullable := false
+		for _, item := range value {
+			text, ok := item.(string)
+			if !ok {
+				return "", false, fmt.Errorf("must contain only strings")
+			}
+			text = strings.TrimSpace(strings.ToLower(text))
+			switch {
+			case text == "null":
+				nullable = true
+			case typ == "":
+				typ = text
+			default:
+				return "", false, fmt.Errorf("only one non-null type is supported")
+			}
+		}
+		if typ == "" {
+			typ = "null"
+		}
+		return typ, nullable, nil
+	default:
+		return "", false, fmt.Errorf("must be a string or array of strings")
+	}
+}
+
 // parseSchemaInt converts JSON number values into ints for schema bounds.
 func parseSchemaInt(raw any) (int, error) {
 	switch value := raw.(type) {
@@ -214,6 +248,9 @@ func validateJSONSchemaNode(node *jsonSchemaNode, value any, path string) error
 	if node == nil {
 		return nil
 	}
+	if node.nullable && value == nil {
+		return nil
+	}
 
 	if len(node.enum) > 0 {
 		matched := false
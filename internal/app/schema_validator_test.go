@@ -113,6 +113,22 @@ func TestCompileJSONSchemaSupportsTypelessObject(t *testing.T) {
 	}
 }
 
+// TestCompileJSONSchemaSupportsNullableTypes verifies the ["<type>", "null"] union accepts null and the base type only.
+func TestCompileJSONSchemaSupportsNullableTypes(t *testing.T) {
+	validator, err := compileJSONSchema(`{"type":"object","properties":{"labels":{"type":["array","null"],"items":{"type":"string"}}}}`)
+	if err != nil {
+		t.Fatalf("compileJSONSchema() error = %v", err)
+	}
+	for _, payload := range []string{`{"labels":null}`, `{"labels":["a"]}`} {
+		if err := validator.ValidatePayload(json.RawMessage(payload)); err != nil {
+			t.Fatalf("ValidatePayload(%s) error = %v", payload, err)
+		}
+	}
+	if err := validator.ValidatePayload(json.RawMessage(`{"labels":"a"}`)); err == nil || !strings.Contains(err.Error(), "$.labels: expected array") {
+		t.Fatalf("expected non-null mismatch to fail, got %v", err)
+	}
+}
+
 // TestCompileJSONSchemaRejectsInvalidDefinitions verifies deterministic schema compile failures.
 func TestCompileJSONSchemaRejectsInvalidDefinitions(t *testing.T) {
 	tests := []struct {
@@ -122,6 +138,7 @@ func TestCompileJSONSchemaRejectsInvalidDefinitions(t *testing.T) {
 	}{
 		{name: "root must be object", schema: `[]`, wantErr: "schema must be an object"},
 		{name: "type must be string", schema: `{"type":1}`, wantErr: "$.type"},
+		{name: "type union allows one non-null type", schema: `{"type":["string","integer"]}`, wantErr: "only one non-null type"},
 		{name: "unsupported type", schema: `{"type":"mystery"}`, wantErr: "unsupported type"},
 		{name: "required must be array", schema: `{"required":"name"}`, wantErr: "$.required"},
 		{name: "required item must be string", schema: `{"required":[1]}`, wantErr: "$.required[0]"},
@@ -0,0 +1,135 @@
+package app
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// seedBaseTime anchors synthetic timestamps so the same seed always yields identical snapshots.
+var seedBaseTime = time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+
+// seedColumnNames lists the synthetic board columns and their lifecycle states.
+var seedColumnNames = []struct {
+	name  string
+	state domain.LifecycleState
+}{
+	{name: "To Do", state: domain.StateTodo},
+	{name: "In Progress", state: domain.StateProgress},
+	{name: "Done", state: domain.StateDone},
+}
+
+// seedLabels lists the label pool sampled by synthetic tasks.
+var seedLabels = []string{"backend", "frontend", "infra", "docs", "bug", "perf", "ux"}
+
+// seedTitleWords lists the vocabulary sampled for synthetic task titles.
+var seedTitleWords = []string{
+	"refactor", "parser", "cache", "board", "sync", "render", "index", "export",
+	"import", "migrate", "layout", "search", "picker", "theme", "lease", "thread",
+}
+
+// SeedSnapshotInput holds sizing and randomness inputs for synthetic board generation.
+type SeedSnapshotInput struct {
+	Projects        int
+	TasksPerProject int
+	Seed            uint64
+}
+
+// BuildSeedSnapshot generates a deterministic synthetic snapshot for load testing and demos.
+func BuildSeedSnapshot(in SeedSnapshotInput) (Snapshot, error) {
+	if in.Projects <= 0 {
+		return Snapshot{}, fmt.Errorf("projects must be > 0: %w", ErrInvalidSeedSize)
+	}
+	if in.TasksPerProject < 0 {
+		return Snapshot{}, fmt.Errorf("tasks per project must be >= 0: %w", ErrInvalidSeedSize)
+	}
+	rng := rand.New(rand.NewPCG(in.Seed, in.Seed^0x9e3779b97f4a7c15))
+	snap := Snapshot{
+		Version:    SnapshotVersion,
+		ExportedAt: seedBaseTime,
+		Projects:   make([]SnapshotProject, 0, in.Projects),
+		Columns:    make([]SnapshotColumn, 0, in.Projects*len(seedColumnNames)),
+		Tasks:      make([]SnapshotTask, 0, in.Projects*in.TasksPerProject),
+	}
+	for p := range in.Projects {
+		// IDs embed the seed so re-running one seed upserts the same rows instead of duplicating them.
+		projectID := fmt.Sprintf("seed-%d-p%04d", in.Seed, p+1)
+		snap.Projects = append(snap.Projects, SnapshotProject{
+			ID:          projectID,
+			Slug:        projectID,
+			Name:        fmt.Sprintf("Seed %d Project %d", in.Seed, p+1),
+			Description: "Synthetic board generated by `till dev seed`.",
+			Kind:        domain.DefaultProjectKind,
+			CreatedAt:   seedBaseTime,
+			UpdatedAt:   seedBaseTime,
+		})
+		columnIDs := make([]string, 0, len(seedColumnNames))
+		for idx, column := range seedColumnNames {
+			columnID := fmt.Sprintf("%s-c%d", projectID, idx+1)
+			columnIDs = append(columnIDs, columnID)
+			snap.Columns = append(snap.Columns, SnapshotColumn{
+				ID:        columnID,
+				ProjectID: projectID,
+				Name:      column.name,
+				Position:  idx,
+				CreatedAt: seedBaseTime,
+				UpdatedAt: seedBaseTime,
+			})
+		}
+
+		positions := make([]int, len(columnIDs))
+		taskIDs := make([]string, 0, in.TasksPerProject)
+		for t := range in.TasksPerProject {
+			taskID := fmt.Sprintf("%s-t%06d", projectID, t+1)
+			columnIdx := rng.IntN(len(columnIDs))
+			at := seedBaseTime.Add(time.Duration(t) * time.Minute)
+			task := SnapshotTask{
+				ID:             taskID,
+				ProjectID:      projectID,
+				Kind:           domain.WorkKindTask,
+				Scope:          domain.KindAppliesToTask,
+				LifecycleState: seedColumnNames[columnIdx].state,
+				ColumnID:       columnIDs[columnIdx],
+				Position:       positions[columnIdx],
+				Title:          seedTaskTitle(rng, t+1),
+				Priority:       []domain.Priority{domain.PriorityLow, domain.PriorityMedium, domain.PriorityHigh}[rng.IntN(3)],
+				Labels:         seedTaskLabels(rng),
+				CreatedByActor: "tillsyn-seed",
+				UpdatedByActor: "tillsyn-seed",
+				UpdatedByType:  domain.ActorTypeSystem,
+				CreatedAt:      at,
+				UpdatedAt:      at,
+			}
+			if task.LifecycleState == domain.StateDone {
+				task.CompletedAt = &at
+			}
+			// Roughly a quarter of tasks depend on earlier ones so rollups have real graphs to walk.
+			if len(taskIDs) > 0 && rng.IntN(4) == 0 {
+				task.Metadata.DependsOn = []string{taskIDs[rng.IntN(len(taskIDs))]}
+			}
+			positions[columnIdx]++
+			taskIDs = append(taskIDs, taskID)
+			snap.Tasks = append(snap.Tasks, task)
+		}
+	}
+	return snap, nil
+}
+
+// seedTaskTitle builds one readable synthetic title.
+func seedTaskTitle(rng *rand.Rand, ordinal int) string {
+	first := seedTitleWords[rng.IntN(len(seedTitleWords))]
+	second := seedTitleWords[rng.IntN(len(seedTitleWords))]
+	return fmt.Sprintf("%s %s #%d", first, second, ordinal)
+}
+
+// seedTaskLabels samples zero to two distinct labels.
+func seedTaskLabels(rng *rand.Rand) []string {
+	count := rng.IntN(3)
+	labels := make([]string, 0, count)
+	for _, idx := range rng.Perm(len(seedLabels))[:count] {
+		labels = append(labels, seedLabels[idx])
+	}
+	return labels
+}
@@ -3,6 +3,7 @@ package app
 import (
 	"context"
 	"fmt"
+	"maps"
 	"slices"
 	"strings"
 	"sync"
@@ -21,6 +22,45 @@ const (
 	DeleteModeHard    DeleteMode = "hard"
 )
 
+// ParentDeletePolicy selects how hard-deleting a task treats its subtasks.
+type ParentDeletePolicy string
+
+// ParentDeletePolicyCascade and related constants define supported parent delete policies.
+const (
+	// ParentDeletePolicyCascade deletes the task together with every descendant.
+	ParentDeletePolicyCascade ParentDeletePolicy = "cascade"
+	// ParentDeletePolicyReparent moves direct subtasks up to the deleted task's parent.
+	ParentDeletePolicyReparent ParentDeletePolicy = "reparent-to-grandparent"
+	// ParentDeletePolicyBlock refuses to hard-delete tasks that still have subtasks.
+	ParentDeletePolicyBlock ParentDeletePolicy = "block"
+)
+
+// WithParentDeletePolicy marks a context so hard deletes apply policy instead of the service's configured one.
+// Callers that reload configuration, like the TUI, pass their current policy on every delete this way.
+func WithParentDeletePolicy(ctx context.Context, policy ParentDeletePolicy) context.Context {
+	return context.WithValue(ctx, parentDeletePolicyContextKey{}, policy)
+}
+
+// ParentDeletePolicyFromContext returns the per-call parent delete policy carried by ctx, if any.
+func ParentDeletePolicyFromContext(ctx context.Context) (ParentDeletePolicy, bool) {
+	policy, ok := ctx.Value(parentDeletePolicyContextKey{}).(ParentDeletePolicy)
+	return policy, ok
+}
+
+// parentDeletePolicyContextKey stores context keys for per-call parent delete policies.
+type parentDeletePolicyContextKey struct{}
+
+// parentDeletePolicyFor returns the supported policy carried by ctx, falling back to the configured one.
+func (s *Service) parentDeletePolicyFor(ctx context.Context) ParentDeletePolicy {
+	policy, _ := ParentDeletePolicyFromContext(ctx)
+	switch policy {
+	case ParentDeletePolicyCascade, ParentDeletePolicyReparent, ParentDeletePolicyBlock:
+		return policy
+	default:
+		return s.parentDeletePolicy
+	}
+}
+
 // SearchMode represents a selectable search strategy.
 type SearchMode string
 
@@ -60,8 +100,15 @@ var supportedSearchLevelFilters = map[string]struct{}{
 // ServiceConfig holds configuration for service.
 type ServiceConfig struct {
 	DefaultDeleteMode        DeleteMode
+	ParentDeletePolicy       ParentDeletePolicy
+	AutoCompleteParents      bool
 	StateTemplates           []StateTemplate
 	AutoCreateProjectColumns bool
+	// DefaultColumnWIPLimits sets WIP limits on auto-created columns, keyed by column name or state id (e.g. "progress").
+	DefaultColumnWIPLimits map[string]int
+	// ProjectColumns sets the column names, in order, created for new projects keyed by project slug.
+	// Projects without an entry get the state template columns.
+	ProjectColumns           map[string][]string
 	CapabilityLeaseTTL       time.Duration
 	RequireAgentLease        *bool
 	EmbeddingGenerator       EmbeddingGenerator
@@ -69,6 +116,8 @@ type ServiceConfig struct {
 	SearchLexicalWeight      float64
 	SearchSemanticWeight     float64
 	SearchSemanticCandidates int
+	// DependencyRollupCacheTTL bounds cached rollup age; zero uses the default and negative disables caching.
+	DependencyRollupCacheTTL time.Duration
 }
 
 // StateTemplate represents state template data used by this package.
@@ -91,8 +140,12 @@ type Service struct {
 	idGen              IDGenerator
 	clock              Clock
 	defaultDeleteMode  DeleteMode
+	parentDeletePolicy ParentDeletePolicy
+	autoDoneParents    bool
 	stateTemplates     []StateTemplate
 	autoProjectCols    bool
+	projectColumns     map[string][]string
+	columnWIPLimits    map[string]int
 	defaultLeaseTTL    time.Duration
 	requireAgentLease  bool
 	schemaCache        map[string]schemaCacheEntry
@@ -103,6 +156,7 @@ type Service struct {
 	searchLexicalW     float64
 	searchSemanticW    float64
 	searchSemanticK    int
+	rollupCache        *dependencyRollupCache
 }
 
 // NewService constructs a new value for this package.
@@ -116,6 +170,12 @@ func NewService(repo Repository, idGen IDGenerator, clock Clock, cfg ServiceConf
 	if cfg.DefaultDeleteMode == "" {
 		cfg.DefaultDeleteMode = DeleteModeArchive
 	}
+	// Deleting a whole subtree is opt-in, so an unset or unknown policy refuses instead.
+	switch cfg.ParentDeletePolicy {
+	case ParentDeletePolicyCascade, ParentDeletePolicyReparent:
+	default:
+		cfg.ParentDeletePolicy = ParentDeletePolicyBlock
+	}
 	if cfg.CapabilityLeaseTTL <= 0 {
 		cfg.CapabilityLeaseTTL = defaultCapabilityLeaseTTL
 	}
@@ -127,6 +187,13 @@ func NewService(repo Repository, idGen IDGenerator, clock Clock, cfg ServiceConf
 	if len(templates) == 0 {
 		templates = defaultStateTemplates()
 	}
+	applyColumnWIPLimits(templates, cfg.DefaultColumnWIPLimits)
+	projectColumns := make(map[string][]string, len(cfg.ProjectColumns))
+	for rawSlug, names := range cfg.ProjectColumns {
+		if slug := strings.TrimSpace(strings.ToLower(rawSlug)); slug != "" && len(names) > 0 {
+			projectColumns[slug] = slices.Clone(names)
+		}
+	}
 	searchIndex := cfg.SearchIndex
 	if searchIndex == nil {
 		if idx, ok := repo.(TaskSearchIndex); ok {
@@ -144,8 +211,12 @@ func NewService(repo Repository, idGen IDGenerator, clock Clock, cfg ServiceConf
 		idGen:              idGen,
 		clock:              clock,
 		defaultDeleteMode:  cfg.DefaultDeleteMode,
+		parentDeletePolicy: cfg.ParentDeletePolicy,
+		autoDoneParents:    cfg.AutoCompleteParents,
 		stateTemplates:     templates,
 		autoProjectCols:    cfg.AutoCreateProjectColumns,
+		projectColumns:     projectColumns,
+		columnWIPLimits:    maps.Clone(cfg.DefaultColumnWIPLimits),
 		defaultLeaseTTL:    cfg.CapabilityLeaseTTL,
 		requireAgentLease:  requireAgentLease,
 		schemaCache:        map[string]schemaCacheEntry{},
@@ -154,6 +225,7 @@ func NewService(repo Repository, idGen IDGenerator, clock Clock, cfg ServiceConf
 		searchLexicalW:     lexicalWeight,
 		searchSemanticW:    semanticWeight,
 		searchSemanticK:    semanticCandidates,
+		rollupCache:        newDependencyRollupCache(cfg.DependencyRollupCacheTTL),
 	}
 }
 
@@ -182,7 +254,7 @@ func (s *Service) EnsureDefaultProject(ctx context.Context) (domain.Project, err
 		return domain.Project{}, err
 	}
 
-	if err := s.createDefaultColumns(ctx, project.ID, now); err != nil {
+	if err := s.createColumns(ctx, project.ID, s.stateTemplates, now); err != nil {
 		return domain.Project{}, err
 	}
 
@@ -195,6 +267,8 @@ type CreateProjectInput struct {
 	Description string
 	Kind        domain.KindID
 	Metadata    domain.ProjectMetadata
+	// Columns names the project's columns in order; empty uses the set configured for the project's slug, if any.
+	Columns     []string
 	UpdatedBy   string
 	UpdatedType domain.ActorType
 }
@@ -230,14 +304,25 @@ func (s *Service) CreateProjectWithMetadata(ctx context.Context, in CreateProjec
 	if err := s.validateProjectKind(ctx, "", project.Kind, project.Metadata.KindPayload); err != nil {
 		return domain.Project{}, err
 	}
+	// Resolve the column set before persisting so a bad set never leaves a project behind.
+	columns, err := s.projectColumnTemplates(project.Slug, in.Columns)
+	if err != nil {
+		return domain.Project{}, err
+	}
 	if err := s.repo.CreateProject(ctx, project); err != nil {
 		return domain.Project{}, err
 	}
 	if err := s.initializeProjectAllowedKinds(ctx, project); err != nil {
 		return domain.Project{}, err
 	}
-	if s.autoProjectCols {
-		if err := s.createDefaultColumns(ctx, project.ID, now); err != nil {
+	// A declared column set is created even when auto-created default columns are turned off.
+	switch {
+	case len(columns) > 0:
+		if err := s.createColumns(ctx, project.ID, columns, now); err != nil {
+			return domain.Project{}, err
+		}
+	case s.autoProjectCols:
+		if err := s.createColumns(ctx, project.ID, s.stateTemplates, now); err != nil {
 			return domain.Project{}, err
 		}
 	}
@@ -343,7 +428,11 @@ func (s *Service) DeleteProject(ctx context.Context, projectID string) error {
 	if err := s.enforceMutationGuard(ctx, project.ID, domain.ActorTypeUser, domain.CapabilityScopeProject, project.ID); err != nil {
 		return err
 	}
-	return s.repo.DeleteProject(ctx, project.ID)
+	if err := s.repo.DeleteProject(ctx, project.ID); err != nil {
+		return err
+	}
+	s.invalidateDependencyRollup(project.ID)
+	return nil
 }
 
 // CreateColumn creates column.
@@ -358,6 +447,54 @@ func (s *Service) CreateColumn(ctx context.Context, projectID, name string, posi
 	return column, nil
 }
 
+// UpdateColumnInput holds input values for update column operations.
+type UpdateColumnInput struct {
+	ColumnID    string
+	Name        string
+	WIPLimit    int
+	UpdatedType domain.ActorType
+}
+
+// UpdateColumn renames one column and sets its WIP limit.
+// Names must stay unique within the project, compared case-insensitively; dry runs validate without writing.
+func (s *Service) UpdateColumn(ctx context.Context, in UpdateColumnInput) (domain.Column, error) {
+	columnID := strings.TrimSpace(in.ColumnID)
+	if columnID == "" {
+		return domain.Column{}, domain.ErrInvalidID
+	}
+	column, err := s.repo.GetColumn(ctx, columnID)
+	if err != nil {
+		return domain.Column{}, err
+	}
+	now := s.clock()
+	if err := column.Rename(in.Name, now); err != nil {
+		return domain.Column{}, err
+	}
+	if err := column.SetWIPLimit(in.WIPLimit, now); err != nil {
+		return domain.Column{}, err
+	}
+	siblings, err := s.repo.ListColumns(ctx, column.ProjectID, true)
+	if err != nil {
+		return domain.Column{}, err
+	}
+	for _, sibling := range siblings {
+		if sibling.ID != column.ID && strings.EqualFold(strings.TrimSpace(sibling.Name), column.Name) {
+			return domain.Column{}, fmt.Errorf("%w: %q", ErrDuplicateColumnName, column.Name)
+		}
+	}
+	projectScope := []mutationScopeCandidate{newProjectMutationScopeCandidate(column.ProjectID)}
+	if err := s.enforceMutationGuardAcrossScopes(ctx, column.ProjectID, in.UpdatedType, projectScope); err != nil {
+		return domain.Column{}, err
+	}
+	if DryRunFromContext(ctx) {
+		return column, nil
+	}
+	if err := s.repo.UpdateColumn(ctx, column); err != nil {
+		return domain.Column{}, err
+	}
+	return column, nil
+}
+
 // CreateTaskInput holds input values for create task operations.
 type CreateTaskInput struct {
 	ProjectID      string
@@ -414,15 +551,24 @@ type SearchTasksFilter struct {
 	Query           string
 	CrossProject    bool
 	IncludeArchived bool
+	// IncludeComments also matches the query against comment summaries and bodies on each work item.
+	IncludeComments bool
 	States          []string
 	Levels          []string
 	Kinds           []string
 	LabelsAny       []string
 	LabelsAll       []string
-	Mode            SearchMode
-	Sort            SearchSort
-	Limit           int
-	Offset          int
+	// DueAfter and DueBefore keep tasks due at or after, and strictly before, the given times; tasks without a due
+	// date never match a due bound. CreatedAfter and CreatedBefore bound the creation time the same way.
+	// Zero times leave that side of the window open.
+	DueAfter      time.Time
+	DueBefore     time.Time
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	Mode          SearchMode
+	Sort          SearchSort
+	Limit         int
+	Offset        int
 }
 
 // TaskMatch describes a matched result.
@@ -430,6 +576,8 @@ type TaskMatch struct {
 	Project domain.Project
 	Task    domain.Task
 	StateID string
+	// CommentMatch reports a keyword match found only in the task's comments, not its own fields.
+	CommentMatch bool
 }
 
 // CreateTask creates task.
@@ -438,6 +586,9 @@ func (s *Service) CreateTask(ctx context.Context, in CreateTaskInput) (domain.Ta
 	if actorType == "" {
 		actorType = domain.ActorTypeUser
 	}
+	if err := s.ensureLabelsAllowed(ctx, in.ProjectID, in.Labels); err != nil {
+		return domain.Task{}, err
+	}
 	var parent *domain.Task
 	guardScopes := []mutationScopeCandidate{
 		newProjectMutationScopeCandidate(in.ProjectID),
@@ -506,10 +657,15 @@ func (s *Service) CreateTask(ctx context.Context, in CreateTaskInput) (domain.Ta
 	if err != nil {
 		return domain.Task{}, err
 	}
+	if DryRunFromContext(ctx) {
+		return task, nil
+	}
 
 	if err := s.repo.CreateTask(ctx, task); err != nil {
 		return domain.Task{}, err
 	}
+	// Template actions below may write more tasks, so invalidate once the whole create finishes.
+	defer s.invalidateDependencyRollup(task.ProjectID)
 	s.refreshTaskEmbedding(ctx, task)
 	if err := s.applyKindTemplateSystemActions(ctx, task, kindDef); err != nil {
 		return domain.Task{}, err
@@ -534,6 +690,17 @@ func (s *Service) MoveTask(ctx context.Context, taskID, toColumnID string, posit
 	if err != nil {
 		return domain.Task{}, err
 	}
+	targetIdx := slices.IndexFunc(columns, func(column domain.Column) bool {
+		return column.ID == toColumnID
+	})
+	if targetIdx < 0 {
+		return domain.Task{}, fmt.Errorf("column %q is not in project %q: %w", toColumnID, task.ProjectID, domain.ErrInvalidColumnID)
+	}
+	if WIPLimitEnforcedFromContext(ctx) {
+		if err := s.ensureColumnHasRoom(ctx, task, columns[targetIdx]); err != nil {
+			return domain.Task{}, err
+		}
+	}
 	fromState := lifecycleStateForColumnID(columns, task.ColumnID)
 	if fromState == "" {
 		fromState = task.LifecycleState
@@ -542,36 +709,13 @@ func (s *Service) MoveTask(ctx context.Context, taskID, toColumnID string, posit
 	if toState == "" {
 		toState = fromState
 	}
-	if fromState == domain.StateTodo && toState == domain.StateProgress {
-		if unmet := task.StartCriteriaUnmet(); len(unmet) > 0 {
-			return domain.Task{}, fmt.Errorf("%w: start criteria unmet (%s)", domain.ErrTransitionBlocked, strings.Join(unmet, ", "))
-		}
+	if task.Metadata.LifecycleStateExplicit {
+		// An explicitly set state is decoupled from columns, so the move keeps it unchanged.
+		fromState = task.LifecycleState
+		toState = task.LifecycleState
 	}
-	if toState == domain.StateDone {
-		projectTasks, listErr := s.repo.ListTasks(ctx, task.ProjectID, true)
-		if listErr != nil {
-			return domain.Task{}, listErr
-		}
-		children := make([]domain.Task, 0)
-		for _, candidate := range projectTasks {
-			if candidate.ParentID == task.ID {
-				children = append(children, candidate)
-			}
-		}
-		for _, child := range children {
-			if child.ArchivedAt != nil {
-				continue
-			}
-			if child.LifecycleState != domain.StateDone {
-				return domain.Task{}, fmt.Errorf("%w: completion criteria unmet (subtasks must be done before moving to done)", domain.ErrTransitionBlocked)
-			}
-		}
-		if unmet := task.CompletionCriteriaUnmet(children); len(unmet) > 0 {
-			return domain.Task{}, fmt.Errorf("%w: completion criteria unmet (%s)", domain.ErrTransitionBlocked, strings.Join(unmet, ", "))
-		}
-		if blockErr := s.ensureTaskCompletionAttentionClear(ctx, task); blockErr != nil {
-			return domain.Task{}, blockErr
-		}
+	if err := s.ensureLifecycleTransitionAllowed(ctx, task, fromState, toState); err != nil {
+		return domain.Task{}, err
 	}
 	if err := task.Move(toColumnID, position, s.clock()); err != nil {
 		return domain.Task{}, err
@@ -580,11 +724,7 @@ func (s *Service) MoveTask(ctx context.Context, taskID, toColumnID string, posit
 		return domain.Task{}, err
 	}
 	applyMutationActorToTask(ctx, &task)
-	if err := s.repo.UpdateTask(ctx, task); err != nil {
-		return domain.Task{}, err
-	}
-	s.refreshTaskEmbedding(ctx, task)
-	return task, nil
+	return s.commitTaskTransition(ctx, task, fromState, toState, columns)
 }
 
 // RestoreTask restores task.
@@ -605,22 +745,21 @@ func (s *Service) RestoreTask(ctx context.Context, taskID string) (domain.Task,
 	if err := s.enforceMutationGuardAcrossScopes(ctx, task.ProjectID, guardActorType, guardScopes); err != nil {
 		return domain.Task{}, err
 	}
-	task.Restore(s.clock())
 	columns, err := s.repo.ListColumns(ctx, task.ProjectID, true)
 	if err != nil {
 		return domain.Task{}, err
 	}
-	restoredState := lifecycleStateForColumnID(columns, task.ColumnID)
-	if restoredState == "" {
-		restoredState = domain.StateTodo
-	}
-	if err := task.SetLifecycleState(restoredState, s.clock()); err != nil {
+	if err := restoreTaskState(&task, columns, s.clock()); err != nil {
 		return domain.Task{}, err
 	}
 	applyMutationActorToTask(ctx, &task)
+	if DryRunFromContext(ctx) {
+		return task, nil
+	}
 	if err := s.repo.UpdateTask(ctx, task); err != nil {
 		return domain.Task{}, err
 	}
+	s.invalidateDependencyRollup(task.ProjectID)
 	s.refreshTaskEmbedding(ctx, task)
 	return task, nil
 }
@@ -645,6 +784,7 @@ func (s *Service) RenameTask(ctx context.Context, taskID, title string) (domain.
 	if err := s.repo.UpdateTask(ctx, task); err != nil {
 		return domain.Task{}, err
 	}
+	s.invalidateDependencyRollup(task.ProjectID)
 	s.refreshTaskEmbedding(ctx, task)
 	return task, nil
 }
@@ -666,6 +806,9 @@ func (s *Service) UpdateTask(ctx context.Context, in UpdateTaskInput) (domain.Ta
 	if err != nil {
 		return domain.Task{}, err
 	}
+	if err := s.ensureLabelsAllowed(ctx, task.ProjectID, in.Labels); err != nil {
+		return domain.Task{}, err
+	}
 	if err := s.enforceMutationGuardAcrossScopes(ctx, task.ProjectID, actorType, guardScopes); err != nil {
 		return domain.Task{}, err
 	}
@@ -697,9 +840,13 @@ func (s *Service) UpdateTask(ctx context.Context, in UpdateTaskInput) (domain.Ta
 			return domain.Task{}, err
 		}
 	}
+	if DryRunFromContext(ctx) {
+		return task, nil
+	}
 	if err := s.repo.UpdateTask(ctx, task); err != nil {
 		return domain.Task{}, err
 	}
+	s.invalidateDependencyRollup(task.ProjectID)
 	s.refreshTaskEmbedding(ctx, task)
 	return task, nil
 }
@@ -725,7 +872,14 @@ func (s *Service) DeleteTask(ctx context.Context, taskID string, mode DeleteMode
 		}
 		task.Archive(s.clock())
 		applyMutationActorToTask(ctx, &task)
-		return s.repo.UpdateTask(ctx, task)
+		if DryRunFromContext(ctx) {
+			return nil
+		}
+		if err := s.repo.UpdateTask(ctx, task); err != nil {
+			return err
+		}
+		s.invalidateDependencyRollup(task.ProjectID)
+		return nil
 	case DeleteModeHard:
 		task, err := s.repo.GetTask(ctx, taskID)
 		if err != nil {
@@ -738,16 +892,62 @@ func (s *Service) DeleteTask(ctx context.Context, taskID string, mode DeleteMode
 		if err := s.enforceMutationGuardAcrossScopes(ctx, task.ProjectID, task.UpdatedByType, guardScopes); err != nil {
 			return err
 		}
-		if err := s.repo.DeleteTask(ctx, taskID); err != nil {
+		projectTasks, err := s.repo.ListTasks(ctx, task.ProjectID, true)
+		if err != nil {
 			return err
 		}
-		s.dropTaskEmbedding(ctx, taskID)
+		descendants := descendantTaskIDs(projectTasks, task.ID)[1:]
+		policy := s.parentDeletePolicyFor(ctx)
+		if len(descendants) > 0 && policy == ParentDeletePolicyBlock {
+			return fmt.Errorf("%w: %d subtasks must be moved or deleted first", ErrParentHasSubtasks, len(descendants))
+		}
+		if DryRunFromContext(ctx) {
+			return nil
+		}
+		batch, err := s.parentDeleteBatch(ctx, policy, task, projectTasks, descendants)
+		if err != nil {
+			return err
+		}
+		if err := s.repo.ApplyTaskBatch(ctx, batch); err != nil {
+			return err
+		}
+		s.invalidateDependencyRollup(task.ProjectID)
+		for _, deletedID := range batch.Delete {
+			s.dropTaskEmbedding(ctx, deletedID)
+		}
 		return nil
 	default:
 		return ErrInvalidDeleteMode
 	}
 }
 
+// parentDeleteBatch builds the writes that hard-delete one task under the parent delete policy.
+// Cascade deletes every descendant with the task; reparent moves direct children to the grandparent first.
+// The repository applies the batch in one transaction, so a failure never leaves a half-deleted subtree.
+func (s *Service) parentDeleteBatch(ctx context.Context, policy ParentDeletePolicy, parent domain.Task, projectTasks []domain.Task, descendants []string) (TaskBatch, error) {
+	batch := TaskBatch{}
+	if policy != ParentDeletePolicyReparent {
+		batch.Delete = append(batch.Delete, descendants...)
+		batch.Delete = append(batch.Delete, parent.ID)
+		return batch, nil
+	}
+	for _, child := range projectTasks {
+		if child.ParentID != parent.ID {
+			continue
+		}
+		if err := child.Reparent(parent.ParentID, s.clock()); err != nil {
+			return TaskBatch{}, err
+		}
+		if parent.ParentID == "" {
+			promoteToRootTask(&child)
+		}
+		applyMutationActorToTask(ctx, &child)
+		batch.Update = append(batch.Update, child)
+	}
+	batch.Delete = append(batch.Delete, parent.ID)
+	return batch, nil
+}
+
 // ListProjects lists projects.
 func (s *Service) ListProjects(ctx context.Context, includeArchived bool) ([]domain.Project, error) {
 	return s.repo.ListProjects(ctx, includeArchived)
@@ -765,6 +965,11 @@ func (s *Service) ListColumns(ctx context.Context, projectID string, includeArch
 	return columns, nil
 }
 
+// GetTask returns one task by id, archived or not.
+func (s *Service) GetTask(ctx context.Context, taskID string) (domain.Task, error) {
+	return s.repo.GetTask(ctx, strings.TrimSpace(taskID))
+}
+
 // ListTasks lists tasks.
 func (s *Service) ListTasks(ctx context.Context, projectID string, includeArchived bool) ([]domain.Task, error) {
 	tasks, err := s.repo.ListTasks(ctx, projectID, includeArchived)
@@ -897,11 +1102,20 @@ func (s *Service) GetProjectDependencyRollup(ctx context.Context, projectID stri
 	if _, err := s.repo.GetProject(ctx, projectID); err != nil {
 		return domain.DependencyRollup{}, err
 	}
+	rollup, generation, ok := s.rollupCache.get(projectID, s.clock())
+	if ok {
+		return rollup, nil
+	}
 	tasks, err := s.repo.ListTasks(ctx, projectID, false)
 	if err != nil {
 		return domain.DependencyRollup{}, err
 	}
-	return buildDependencyRollup(projectID, tasks), nil
+	if err := ctx.Err(); err != nil {
+		return domain.DependencyRollup{}, err
+	}
+	rollup = buildDependencyRollup(projectID, tasks)
+	s.rollupCache.put(projectID, rollup, generation, s.clock())
+	return rollup, nil
 }
 
 // ListChildTasks lists child tasks for a parent within the same project.
@@ -972,9 +1186,13 @@ func (s *Service) ReparentTask(ctx context.Context, taskID, parentID string) (do
 		return domain.Task{}, err
 	}
 	applyMutationActorToTask(ctx, &task)
+	if DryRunFromContext(ctx) {
+		return task, nil
+	}
 	if err := s.repo.UpdateTask(ctx, task); err != nil {
 		return domain.Task{}, err
 	}
+	s.invalidateDependencyRollup(task.ProjectID)
 	return task, nil
 }
 
@@ -1008,6 +1226,12 @@ func (s *Service) SearchTaskMatches(ctx context.Context, in SearchTasksFilter) (
 	if invalid := unsupportedSearchLevels(levelFilter); len(invalid) > 0 {
 		log.Warn("search request includes unsupported levels filter values", "levels", strings.Join(invalid, ","))
 	}
+	window := TaskDateWindow{
+		DueAfter:      in.DueAfter,
+		DueBefore:     in.DueBefore,
+		CreatedAfter:  in.CreatedAfter,
+		CreatedBefore: in.CreatedBefore,
+	}
 	allowAllStates := len(stateFilter) == 0
 	wantsArchivedState := allowAllStates
 	if !allowAllStates {
@@ -1037,10 +1261,28 @@ func (s *Service) SearchTaskMatches(ctx context.Context, in SearchTasksFilter) (
 	}
 
 	query := strings.TrimSpace(strings.ToLower(in.Query))
+	commentMatches := map[string]struct{}{}
+	if in.IncludeComments && query != "" && len(targetProjects) > 0 {
+		targetProjectIDs := make([]string, 0, len(targetProjects))
+		for _, project := range targetProjects {
+			targetProjectIDs = append(targetProjectIDs, project.ID)
+		}
+		ids, err := s.repo.SearchCommentTargetIDs(ctx, targetProjectIDs, query)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			commentMatches[id] = struct{}{}
+		}
+	}
 	out := make([]TaskMatch, 0)
 	lexicalScores := map[string]float64{}
 	projectIDs := make([]string, 0, len(targetProjects))
 	for _, project := range targetProjects {
+		// Cross-project scans can be long; abort promptly once the caller gives up.
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		projectIDs = append(projectIDs, project.ID)
 		columns, err := s.repo.ListColumns(ctx, project.ID, true)
 		if err != nil {
@@ -1051,13 +1293,13 @@ func (s *Service) SearchTaskMatches(ctx context.Context, in SearchTasksFilter) (
 			stateByColumn[column.ID] = normalizeStateID(column.Name)
 		}
 
-		tasks, err := s.repo.ListTasks(ctx, project.ID, true)
+		tasks, err := s.listSearchTasks(ctx, project.ID, window)
 		if err != nil {
 			return nil, err
 		}
 		for _, task := range tasks {
 			stateID := stateByColumn[task.ColumnID]
-			if stateID == "" {
+			if stateID == "" || task.Metadata.LifecycleStateExplicit {
 				stateID = string(task.LifecycleState)
 			}
 			if stateID == "" {
@@ -1076,16 +1318,27 @@ func (s *Service) SearchTaskMatches(ctx context.Context, in SearchTasksFilter) (
 			if !taskMatchesExtendedSearchFilters(task, levelFilter, kindFilter, labelsAnyFilter, labelsAllFilter) {
 				continue
 			}
-			lexicalScores[task.ID] = taskLexicalMatchScore(task, query)
+			lexicalScore := taskLexicalMatchScore(task, query)
+			commentMatch := false
+			if _, ok := commentMatches[task.ID]; ok && lexicalScore <= 0 {
+				// A comment hit ranks below any match on the task's own fields.
+				lexicalScore = commentLexicalMatchScore
+				commentMatch = true
+			}
+			lexicalScores[task.ID] = lexicalScore
 
 			out = append(out, TaskMatch{
-				Project: project,
-				Task:    task,
-				StateID: stateID,
+				Project:      project,
+				Task:         task,
+				StateID:      stateID,
+				CommentMatch: commentMatch,
 			})
 		}
 	}
 
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 	semanticScores := map[string]float64{}
 	semanticReady := false
 	effectiveMode := mode
@@ -1271,6 +1524,42 @@ func unsupportedSearchLevels(levelFilter map[string]struct{}) []string {
 	return out
 }
 
+// listSearchTasks lists a project's tasks, archived ones included, inside window.
+// Repositories implementing TaskWindowLister apply the window in their query; other tasks are filtered here.
+func (s *Service) listSearchTasks(ctx context.Context, projectID string, window TaskDateWindow) ([]domain.Task, error) {
+	if lister, ok := s.repo.(TaskWindowLister); ok && !window.IsZero() {
+		return lister.ListTasksInWindow(ctx, projectID, window)
+	}
+	tasks, err := s.repo.ListTasks(ctx, projectID, true)
+	if err != nil || window.IsZero() {
+		return tasks, err
+	}
+	return slices.DeleteFunc(tasks, func(task domain.Task) bool {
+		return !taskWithinDateWindow(task, window)
+	}), nil
+}
+
+// taskWithinDateWindow reports whether task falls inside window's due and created bounds.
+func taskWithinDateWindow(task domain.Task, window TaskDateWindow) bool {
+	if !window.DueAfter.IsZero() || !window.DueBefore.IsZero() {
+		if task.DueAt == nil || !timeWithinWindow(*task.DueAt, window.DueAfter, window.DueBefore) {
+			return false
+		}
+	}
+	return timeWithinWindow(task.CreatedAt, window.CreatedAfter, window.CreatedBefore)
+}
+
+// timeWithinWindow reports whether at lies in [after, before), treating zero bounds as open.
+func timeWithinWindow(at, after, before time.Time) bool {
+	if !after.IsZero() && at.Before(after) {
+		return false
+	}
+	if !before.IsZero() && !at.Before(before) {
+		return false
+	}
+	return true
+}
+
 // taskMatchesExtendedSearchFilters applies optional level/kind/label filter constraints to one task.
 func taskMatchesExtendedSearchFilters(task domain.Task, levelFilter, kindFilter, labelsAnyFilter, labelsAllFilter map[string]struct{}) bool {
 	if len(levelFilter) > 0 {
@@ -1365,6 +1654,9 @@ func clamp01(value float64) float64 {
 	return value
 }
 
+// commentLexicalMatchScore is the lexical score given to tasks that match only through their comments.
+const commentLexicalMatchScore = 0.5
+
 // taskLexicalMatchScore calculates a normalized lexical score for one task/query pair.
 func taskLexicalMatchScore(task domain.Task, query string) float64 {
 	query = strings.TrimSpace(strings.ToLower(query))
@@ -1449,8 +1741,10 @@ func fuzzyContainsQuery(candidate, query string) bool {
 // buildDependencyRollup computes aggregate dependency and blocked-state counts.
 func buildDependencyRollup(projectID string, tasks []domain.Task) domain.DependencyRollup {
 	rollup := domain.DependencyRollup{
-		ProjectID:  projectID,
-		TotalItems: len(tasks),
+		ProjectID:        projectID,
+		TotalItems:       len(tasks),
+		UnresolvedByTask: map[string]int{},
+		BlockingByTask:   map[string]int{},
 	}
 	stateByID := make(map[string]domain.LifecycleState, len(tasks))
 	for _, task := range tasks {
@@ -1459,6 +1753,15 @@ func buildDependencyRollup(projectID string, tasks []domain.Task) domain.Depende
 	for _, task := range tasks {
 		dependsOn := uniqueNonEmptyIDs(task.Metadata.DependsOn)
 		blockedBy := uniqueNonEmptyIDs(task.Metadata.BlockedBy)
+		// Per-task counts feed the board's card badges; done tasks no longer wait on or block anything.
+		if task.LifecycleState != domain.StateDone {
+			for _, refID := range uniqueNonEmptyIDs(append(slices.Clone(dependsOn), blockedBy...)) {
+				rollup.BlockingByTask[refID]++
+				if state, ok := stateByID[refID]; !ok || state != domain.StateDone {
+					rollup.UnresolvedByTask[task.ID]++
+				}
+			}
+		}
 
 		if len(dependsOn) > 0 {
 			rollup.ItemsWithDependencies++
@@ -1575,6 +1878,28 @@ func sanitizeStateTemplates(in []StateTemplate) []StateTemplate {
 	return out
 }
 
+// applyColumnWIPLimits sets template WIP limits from a name-keyed map; keys match by normalized state id.
+func applyColumnWIPLimits(templates []StateTemplate, limits map[string]int) {
+	if len(limits) == 0 {
+		return
+	}
+	byID := make(map[string]int, len(limits))
+	// Sorted keys make the first spelling win deterministically when two names normalize to one state id.
+	for _, name := range slices.Sorted(maps.Keys(limits)) {
+		id, limit := normalizeStateID(name), limits[name]
+		if _, seen := byID[id]; id != "" && limit >= 0 && !seen {
+			byID[id] = limit
+		}
+	}
+	for i := range templates {
+		if limit, ok := byID[templates[i].ID]; ok {
+			templates[i].WIPLimit = limit
+		} else if limit, ok := byID[normalizeStateID(templates[i].Name)]; ok {
+			templates[i].WIPLimit = limit
+		}
+	}
+}
+
 // normalizeStateID normalizes state id.
 func normalizeStateID(name string) string {
 	name = strings.TrimSpace(strings.ToLower(name))
@@ -1666,19 +1991,48 @@ func applyMutationActorToTask(ctx context.Context, task *domain.Task) {
 	task.UpdatedByType = normalizeActorTypeInput(actor.ActorType)
 }
 
-// createDefaultColumns creates default columns.
-func (s *Service) createDefaultColumns(ctx context.Context, projectID string, now time.Time) error {
-	for idx, state := range s.stateTemplates {
+// projectColumnTemplates returns the column templates for a new project: the explicit names when given, otherwise the
+// set configured for the project's slug. It returns nil when neither names a column, leaving the defaults to apply.
+// Names repeated case-insensitively fail with ErrDuplicateColumnName.
+func (s *Service) projectColumnTemplates(slug string, explicit []string) ([]StateTemplate, error) {
+	names := explicit
+	if len(names) == 0 {
+		names = s.projectColumns[strings.TrimSpace(strings.ToLower(slug))]
+	}
+	templates := make([]StateTemplate, 0, len(names))
+	seen := map[string]struct{}{}
+	for _, raw := range names {
+		name := strings.TrimSpace(raw)
+		if name == "" {
+			continue
+		}
+		key := strings.ToLower(name)
+		if _, ok := seen[key]; ok {
+			return nil, fmt.Errorf("%w: %q", ErrDuplicateColumnName, name)
+		}
+		seen[key] = struct{}{}
+		templates = append(templates, StateTemplate{ID: normalizeStateID(name), Name: name, Position: len(templates)})
+	}
+	if len(templates) == 0 {
+		return nil, nil
+	}
+	applyColumnWIPLimits(templates, s.columnWIPLimits)
+	return templates, nil
+}
+
+// createColumns creates one column per template for the project.
+func (s *Service) createColumns(ctx context.Context, projectID string, templates []StateTemplate, now time.Time) error {
+	for idx, state := range templates {
 		position := state.Position
 		if position < 0 {
 			position = idx
 		}
 		column, err := domain.NewColumn(s.idGen(), projectID, state.Name, position, state.WIPLimit, now)
 		if err != nil {
-			return fmt.Errorf("create default column %q: %w", state.Name, err)
+			return fmt.Errorf("create column %q: %w", state.Name, err)
 		}
 		if err := s.repo.CreateColumn(ctx, column); err != nil {
-			return fmt.Errorf("persist default column %q: %w", state.Name, err)
+			return fmt.Errorf("persist column %q: %w", state.Name, err)
 		}
 	}
 	return nil
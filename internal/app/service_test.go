@@ -5,6 +5,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
 	"sort"
 	"strings"
 	"testing"
@@ -211,6 +216,28 @@ func (f *fakeRepo) UpdateColumn(_ context.Context, c domain.Column) error {
 	return nil
 }
 
+// UpdateColumns updates every column only when all of them are stored, mirroring a transaction.
+func (f *fakeRepo) UpdateColumns(_ context.Context, columns []domain.Column) error {
+	for _, c := range columns {
+		if _, ok := f.columns[c.ID]; !ok {
+			return ErrNotFound
+		}
+	}
+	for _, c := range columns {
+		f.columns[c.ID] = c
+	}
+	return nil
+}
+
+// GetColumn returns one column by id.
+func (f *fakeRepo) GetColumn(_ context.Context, id string) (domain.Column, error) {
+	c, ok := f.columns[id]
+	if !ok {
+		return domain.Column{}, ErrNotFound
+	}
+	return c, nil
+}
+
 // ListColumns lists columns.
 func (f *fakeRepo) ListColumns(_ context.Context, projectID string, includeArchived bool) ([]domain.Column, error) {
 	out := make([]domain.Column, 0, len(f.columns))
@@ -274,6 +301,27 @@ func (f *fakeRepo) DeleteTask(_ context.Context, id string) error {
 	return nil
 }
 
+// ApplyTaskBatch applies the batch only when every update and deletion targets a stored task, mirroring a transaction.
+func (f *fakeRepo) ApplyTaskBatch(_ context.Context, batch TaskBatch) error {
+	for _, task := range batch.Update {
+		if _, ok := f.tasks[task.ID]; !ok {
+			return ErrNotFound
+		}
+	}
+	for _, id := range batch.Delete {
+		if _, ok := f.tasks[id]; !ok {
+			return ErrNotFound
+		}
+	}
+	for _, task := range batch.Update {
+		f.tasks[task.ID] = task
+	}
+	for _, id := range batch.Delete {
+		delete(f.tasks, id)
+	}
+	return nil
+}
+
 // CreateComment creates comment.
 func (f *fakeRepo) CreateComment(_ context.Context, comment domain.Comment) error {
 	key := comment.ProjectID + "|" + string(comment.TargetType) + "|" + comment.TargetID
@@ -287,6 +335,28 @@ func (f *fakeRepo) ListCommentsByTarget(_ context.Context, target domain.Comment
 	return append([]domain.Comment(nil), f.comments[key]...), nil
 }
 
+// SearchCommentTargetIDs returns work-item ids whose comments contain the query.
+func (f *fakeRepo) SearchCommentTargetIDs(_ context.Context, projectIDs []string, query string) ([]string, error) {
+	query = strings.ToLower(query)
+	seen := map[string]struct{}{}
+	out := make([]string, 0)
+	for _, comments := range f.comments {
+		for _, comment := range comments {
+			if comment.TargetType == domain.CommentTargetTypeProject || !slices.Contains(projectIDs, comment.ProjectID) {
+				continue
+			}
+			if !strings.Contains(strings.ToLower(comment.BodyMarkdown), query) && !strings.Contains(strings.ToLower(comment.Summary), query) {
+				continue
+			}
+			if _, ok := seen[comment.TargetID]; !ok {
+				seen[comment.TargetID] = struct{}{}
+				out = append(out, comment.TargetID)
+			}
+		}
+	}
+	return out, nil
+}
+
 // CreateAttentionItem creates one attention item row.
 func (f *fakeRepo) CreateAttentionItem(_ context.Context, item domain.AttentionItem) error {
 	f.attentionItems[item.ID] = item
@@ -1155,6 +1225,92 @@ func TestSearchTaskMatchesExtendedFilters(t *testing.T) {
 	}
 }
 
+// TestSearchTaskMatchesDateWindows verifies due and created windows combine with state filters.
+func TestSearchTaskMatchesDateWindows(t *testing.T) {
+	repo := newFakeRepo()
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	project, _ := domain.NewProject("p1", "Inbox", "", now)
+	repo.projects[project.ID] = project
+	todo, _ := domain.NewColumn("c1", project.ID, "To Do", 0, 0, now)
+	done, _ := domain.NewColumn("c2", project.ID, "Done", 1, 0, now)
+	repo.columns[todo.ID] = todo
+	repo.columns[done.ID] = done
+
+	weekStart := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)
+	weekEnd := weekStart.AddDate(0, 0, 7)
+	addTask := func(id, columnID string, createdAt time.Time, dueAt *time.Time) {
+		t.Helper()
+		task, err := domain.NewTask(domain.TaskInput{
+			ID:        id,
+			ProjectID: project.ID,
+			ColumnID:  columnID,
+			Title:     id,
+			Priority:  domain.PriorityLow,
+			DueAt:     dueAt,
+		}, createdAt)
+		if err != nil {
+			t.Fatalf("NewTask(%s) error = %v", id, err)
+		}
+		repo.tasks[task.ID] = task
+	}
+	dueMonday := weekStart
+	dueSunday := weekEnd.Add(-time.Hour)
+	dueNextWeek := weekEnd
+	addTask("due-monday", todo.ID, now, &dueMonday)
+	addTask("due-sunday", todo.ID, now, &dueSunday)
+	addTask("due-next-week", todo.ID, now, &dueNextWeek)
+	addTask("done-this-week", done.ID, now, &dueMonday)
+	addTask("no-due", todo.ID, now, nil)
+	addTask("stale", todo.ID, now.AddDate(0, -2, 0), nil)
+
+	svc := NewService(repo, nil, func() time.Time { return now }, ServiceConfig{})
+	ids := func(matches []TaskMatch) string {
+		out := make([]string, 0, len(matches))
+		for _, match := range matches {
+			out = append(out, match.Task.ID)
+		}
+		slices.Sort(out)
+		return strings.Join(out, ",")
+	}
+
+	// The due window includes its start, excludes its end, skips undated tasks, and ANDs with states.
+	matches, err := svc.SearchTaskMatches(context.Background(), SearchTasksFilter{
+		ProjectID: project.ID,
+		States:    []string{"todo"},
+		DueAfter:  weekStart,
+		DueBefore: weekEnd,
+	})
+	if err != nil {
+		t.Fatalf("SearchTaskMatches(due window) error = %v", err)
+	}
+	if got, want := ids(matches), "due-monday,due-sunday"; got != want {
+		t.Fatalf("due window rows = %q, want %q", got, want)
+	}
+
+	matches, err = svc.SearchTaskMatches(context.Background(), SearchTasksFilter{
+		ProjectID:     project.ID,
+		CreatedBefore: now.AddDate(0, -1, 0),
+	})
+	if err != nil {
+		t.Fatalf("SearchTaskMatches(created before) error = %v", err)
+	}
+	if got := ids(matches); got != "stale" {
+		t.Fatalf("created-before rows = %q, want stale", got)
+	}
+
+	matches, err = svc.SearchTaskMatches(context.Background(), SearchTasksFilter{
+		ProjectID:    project.ID,
+		CreatedAfter: now,
+		DueAfter:     weekEnd,
+	})
+	if err != nil {
+		t.Fatalf("SearchTaskMatches(created after) error = %v", err)
+	}
+	if got := ids(matches); got != "due-next-week" {
+		t.Fatalf("created-after rows = %q, want due-next-week", got)
+	}
+}
+
 // TestSearchTaskMatchesLexicalMetadataFields verifies lexical scoring covers embedding metadata fields.
 func TestSearchTaskMatchesLexicalMetadataFields(t *testing.T) {
 	repo := newFakeRepo()
@@ -1210,6 +1366,58 @@ func TestSearchTaskMatchesLexicalMetadataFields(t *testing.T) {
 	}
 }
 
+// TestSearchTaskMatchesIncludeComments verifies comment bodies match only when requested and are flagged as comment matches.
+func TestSearchTaskMatchesIncludeComments(t *testing.T) {
+	repo := newFakeRepo()
+	now := time.Date(2026, 3, 3, 11, 30, 0, 0, time.UTC)
+	project, _ := domain.NewProject("p1", "Inbox", "", now)
+	repo.projects[project.ID] = project
+	column, _ := domain.NewColumn("c1", project.ID, "To Do", 0, 0, now)
+	repo.columns[column.ID] = column
+	commented, _ := domain.NewTask(domain.TaskInput{ID: "t1", ProjectID: project.ID, ColumnID: column.ID, Title: "Flaky deploy", Priority: domain.PriorityLow}, now)
+	titled, _ := domain.NewTask(domain.TaskInput{ID: "t2", ProjectID: project.ID, ColumnID: column.ID, Position: 1, Title: "Rotate certificate", Priority: domain.PriorityLow}, now)
+	repo.tasks[commented.ID] = commented
+	repo.tasks[titled.ID] = titled
+	for _, in := range []domain.CommentInput{
+		{ID: "cm1", ProjectID: project.ID, TargetType: domain.CommentTargetTypeTask, TargetID: commented.ID, BodyMarkdown: "Root cause was the expired Certificate on staging."},
+		{ID: "cm2", ProjectID: project.ID, TargetType: domain.CommentTargetTypeTask, TargetID: titled.ID, BodyMarkdown: "certificate renewal is scripted"},
+		// Project-level comments never surface a task.
+		{ID: "cm3", ProjectID: project.ID, TargetType: domain.CommentTargetTypeProject, TargetID: project.ID, BodyMarkdown: "certificate inventory"},
+	} {
+		comment, err := domain.NewComment(in, now)
+		if err != nil {
+			t.Fatalf("NewComment(%s) error = %v", in.ID, err)
+		}
+		if err := repo.CreateComment(context.Background(), comment); err != nil {
+			t.Fatalf("CreateComment(%s) error = %v", in.ID, err)
+		}
+	}
+	svc := NewService(repo, nil, func() time.Time { return now }, ServiceConfig{})
+
+	matches, err := svc.SearchTaskMatches(context.Background(), SearchTasksFilter{ProjectID: project.ID, Query: "certificate"})
+	if err != nil {
+		t.Fatalf("SearchTaskMatches() error = %v", err)
+	}
+	if len(matches) != 1 || matches[0].Task.ID != titled.ID || matches[0].CommentMatch {
+		t.Fatalf("expected only the title match without comments, got %#v", matches)
+	}
+
+	matches, err = svc.SearchTaskMatches(context.Background(), SearchTasksFilter{ProjectID: project.ID, Query: "certificate", IncludeComments: true})
+	if err != nil {
+		t.Fatalf("SearchTaskMatches(include comments) error = %v", err)
+	}
+	if len(matches) != 2 {
+		t.Fatalf("expected title and comment matches, got %#v", matches)
+	}
+	// Title matches rank ahead of comment-only matches, and only the comment-only row is flagged.
+	if matches[0].Task.ID != titled.ID || matches[0].CommentMatch {
+		t.Fatalf("expected title match first and unflagged, got %#v", matches[0])
+	}
+	if matches[1].Task.ID != commented.ID || !matches[1].CommentMatch {
+		t.Fatalf("expected comment-only match flagged, got %#v", matches[1])
+	}
+}
+
 // TestSearchTaskMatchesSortAndPagination verifies optioned sorting and pagination behavior.
 func TestSearchTaskMatchesSortAndPagination(t *testing.T) {
 	repo := newFakeRepo()
@@ -1810,6 +2018,122 @@ func TestCreateProjectWithMetadataAndAutoColumns(t *testing.T) {
 	}
 }
 
+// TestCreateProjectAppliesDefaultColumnWIPLimits verifies configured WIP limits reach auto-created columns only.
+func TestCreateProjectAppliesDefaultColumnWIPLimits(t *testing.T) {
+	repo := newFakeRepo()
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	existing, _ := domain.NewProject("p-old", "Old", "", now)
+	repo.projects[existing.ID] = existing
+	oldColumn, _ := domain.NewColumn("c-old", existing.ID, "In Progress", 1, 0, now)
+	repo.columns[oldColumn.ID] = oldColumn
+
+	ids := []string{"p1", "c1", "c2", "c3"}
+	idx := 0
+	svc := NewService(repo, func() string {
+		id := ids[idx]
+		idx++
+		return id
+	}, func() time.Time { return now }, ServiceConfig{
+		AutoCreateProjectColumns: true,
+		// Keys match by column name or state id, ignoring case; unknown names are ignored.
+		DefaultColumnWIPLimits: map[string]int{"in progress": 3, "todo": 8, "Backlog": 2},
+	})
+	project, err := svc.CreateProject(context.Background(), "Roadmap", "")
+	if err != nil {
+		t.Fatalf("CreateProject() error = %v", err)
+	}
+	columns, err := svc.ListColumns(context.Background(), project.ID, false)
+	if err != nil {
+		t.Fatalf("ListColumns() error = %v", err)
+	}
+	got := map[string]int{}
+	for _, column := range columns {
+		got[column.Name] = column.WIPLimit
+	}
+	want := map[string]int{"To Do": 8, "In Progress": 3, "Done": 0}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("auto-created WIP limits = %#v, want %#v", got, want)
+	}
+	if repo.columns[oldColumn.ID].WIPLimit != 0 {
+		t.Fatalf("expected existing project columns to keep their WIP limit, got %d", repo.columns[oldColumn.ID].WIPLimit)
+	}
+}
+
+// TestCreateProjectUsesProjectColumnSets verifies explicit and slug-configured column sets replace the defaults in order.
+func TestCreateProjectUsesProjectColumnSets(t *testing.T) {
+	repo := newFakeRepo()
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	next := 0
+	svc := NewService(repo, func() string {
+		next++
+		return fmt.Sprintf("id-%d", next)
+	}, func() time.Time { return now }, ServiceConfig{
+		AutoCreateProjectColumns: true,
+		DefaultColumnWIPLimits:   map[string]int{"review": 2},
+		ProjectColumns:           map[string][]string{" Roadmap ": {"Ideas", "Planned", "Shipped"}},
+	})
+	columnNames := func(projectID string) ([]string, map[string]int) {
+		t.Helper()
+		columns, err := svc.ListColumns(context.Background(), projectID, false)
+		if err != nil {
+			t.Fatalf("ListColumns() error = %v", err)
+		}
+		names := make([]string, 0, len(columns))
+		limits := map[string]int{}
+		for _, column := range columns {
+			names = append(names, column.Name)
+			limits[column.Name] = column.WIPLimit
+		}
+		return names, limits
+	}
+
+	explicit, err := svc.CreateProjectWithMetadata(context.Background(), CreateProjectInput{
+		Name:    "Bugs",
+		Columns: []string{" Triage ", "", "Review", "Closed"},
+	})
+	if err != nil {
+		t.Fatalf("CreateProjectWithMetadata(explicit) error = %v", err)
+	}
+	names, limits := columnNames(explicit.ID)
+	if want := []string{"Triage", "Review", "Closed"}; !slices.Equal(names, want) {
+		t.Fatalf("explicit columns = %#v, want %#v", names, want)
+	}
+	if limits["Review"] != 2 {
+		t.Fatalf("expected the configured WIP limit on Review, got %d", limits["Review"])
+	}
+
+	configured, err := svc.CreateProject(context.Background(), "Roadmap", "")
+	if err != nil {
+		t.Fatalf("CreateProject(configured) error = %v", err)
+	}
+	names, _ = columnNames(configured.ID)
+	if want := []string{"Ideas", "Planned", "Shipped"}; !slices.Equal(names, want) {
+		t.Fatalf("configured columns = %#v, want %#v", names, want)
+	}
+
+	fallback, err := svc.CreateProject(context.Background(), "Inbox", "")
+	if err != nil {
+		t.Fatalf("CreateProject(fallback) error = %v", err)
+	}
+	names, _ = columnNames(fallback.ID)
+	if want := []string{"To Do", "In Progress", "Done"}; !slices.Equal(names, want) {
+		t.Fatalf("fallback columns = %#v, want %#v", names, want)
+	}
+
+	// A repeated name is rejected before the project is stored.
+	projectCount := len(repo.projects)
+	_, err = svc.CreateProjectWithMetadata(context.Background(), CreateProjectInput{
+		Name:    "Dupes",
+		Columns: []string{"Todo", "todo"},
+	})
+	if !errors.Is(err, ErrDuplicateColumnName) {
+		t.Fatalf("expected ErrDuplicateColumnName, got %v", err)
+	}
+	if len(repo.projects) != projectCount {
+		t.Fatalf("expected no project to be stored for a rejected column set, got %d projects", len(repo.projects))
+	}
+}
+
 // TestUpdateProject verifies behavior for the covered scenario.
 func TestUpdateProject(t *testing.T) {
 	repo := newFakeRepo()
@@ -2174,6 +2498,187 @@ func TestGetProjectDependencyRollup(t *testing.T) {
 	if rollup.UnresolvedDependencyEdges != 2 {
 		t.Fatalf("expected 2 unresolved dependencies, got %d", rollup.UnresolvedDependencyEdges)
 	}
+	// Per-task counts merge depends_on and blocked_by, so dep-open counts once for the blocked task.
+	if want := map[string]int{"blocked": 2}; !maps.Equal(rollup.UnresolvedByTask, want) {
+		t.Fatalf("UnresolvedByTask = %#v, want %#v", rollup.UnresolvedByTask, want)
+	}
+	if want := map[string]int{"dep-ready": 1, "dep-open": 1, "dep-missing": 1}; !maps.Equal(rollup.BlockingByTask, want) {
+		t.Fatalf("BlockingByTask = %#v, want %#v", rollup.BlockingByTask, want)
+	}
+}
+
+// TestGetProjectStats verifies per-state counts, overdue and blocked totals, and average age.
+func TestGetProjectStats(t *testing.T) {
+	repo := newFakeRepo()
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	project, _ := domain.NewProject("p1", "Inbox", "", now)
+	repo.projects[project.ID] = project
+	column, _ := domain.NewColumn("c1", project.ID, "To Do", 0, 0, now)
+	repo.columns[column.ID] = column
+
+	past := now.Add(-24 * time.Hour)
+	overdue, _ := domain.NewTask(domain.TaskInput{
+		ID:        "overdue",
+		ProjectID: project.ID,
+		ColumnID:  column.ID,
+		Title:     "overdue",
+		Priority:  domain.PriorityHigh,
+		DueAt:     &past,
+		Metadata:  domain.TaskMetadata{BlockedReason: "waiting on review"},
+	}, now.Add(-4*24*time.Hour))
+	// A done task past its due date is finished, not overdue.
+	doneLate, _ := domain.NewTask(domain.TaskInput{
+		ID:             "done-late",
+		ProjectID:      project.ID,
+		ColumnID:       column.ID,
+		Position:       1,
+		Title:          "done late",
+		Priority:       domain.PriorityLow,
+		LifecycleState: domain.StateDone,
+		DueAt:          &past,
+	}, now.Add(-2*24*time.Hour))
+	archived, _ := domain.NewTask(domain.TaskInput{
+		ID:        "archived",
+		ProjectID: project.ID,
+		ColumnID:  column.ID,
+		Position:  2,
+		Title:     "archived",
+		Priority:  domain.PriorityLow,
+	}, now.Add(-6*24*time.Hour))
+	archived.Archive(now)
+	repo.tasks[overdue.ID] = overdue
+	repo.tasks[doneLate.ID] = doneLate
+	repo.tasks[archived.ID] = archived
+
+	svc := NewService(repo, nil, func() time.Time { return now }, ServiceConfig{})
+	stats, err := svc.GetProjectStats(context.Background(), project.ID, false)
+	if err != nil {
+		t.Fatalf("GetProjectStats() error = %v", err)
+	}
+	want := ProjectStats{
+		ProjectID:  project.ID,
+		Slug:       project.Slug,
+		Name:       "Inbox",
+		TotalTasks: 2,
+		ByState:    map[domain.LifecycleState]int{domain.StateTodo: 1, domain.StateDone: 1},
+		Overdue:    1,
+		Blocked:    1,
+		AverageAge: 3 * 24 * time.Hour,
+	}
+	if !reflect.DeepEqual(stats, want) {
+		t.Fatalf("GetProjectStats() = %#v, want %#v", stats, want)
+	}
+
+	// Including archived rows counts the archived task and its age, but never as overdue.
+	stats, err = svc.GetProjectStats(context.Background(), project.ID, true)
+	if err != nil {
+		t.Fatalf("GetProjectStats(includeArchived) error = %v", err)
+	}
+	if stats.TotalTasks != 3 || stats.ByState[domain.StateArchived] != 1 || stats.Overdue != 1 || stats.AverageAge != 4*24*time.Hour {
+		t.Fatalf("unexpected archived-inclusive stats %#v", stats)
+	}
+
+	if _, err := svc.GetProjectStats(context.Background(), "missing", false); err == nil {
+		t.Fatal("expected missing project error")
+	}
+}
+
+// TestGetProjectDependencyRollupCacheInvalidation verifies rollups are cached until task writes or TTL expiry.
+func TestGetProjectDependencyRollupCacheInvalidation(t *testing.T) {
+	repo := newFakeRepo()
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	project, _ := domain.NewProject("p1", "Inbox", "", now)
+	repo.projects[project.ID] = project
+	column, _ := domain.NewColumn("c1", project.ID, "To Do", 0, 0, now)
+	repo.columns[column.ID] = column
+	dep, _ := domain.NewTask(domain.TaskInput{
+		ID:        "dep",
+		ProjectID: project.ID,
+		ColumnID:  column.ID,
+		Title:     "dep",
+		Priority:  domain.PriorityLow,
+	}, now)
+	repo.tasks[dep.ID] = dep
+
+	clock := now
+	svc := NewService(repo, func() string { return "t-new" }, func() time.Time { return clock }, ServiceConfig{
+		DependencyRollupCacheTTL: time.Minute,
+	})
+	ctx := context.Background()
+	if _, err := svc.GetProjectDependencyRollup(ctx, project.ID); err != nil {
+		t.Fatalf("GetProjectDependencyRollup() error = %v", err)
+	}
+
+	// A direct repo write bypasses service invalidation, so the cached rollup is served.
+	external := dep
+	external.ID = "external"
+	repo.tasks[external.ID] = external
+	rollup, err := svc.GetProjectDependencyRollup(ctx, project.ID)
+	if err != nil {
+		t.Fatalf("GetProjectDependencyRollup() cached error = %v", err)
+	}
+	if rollup.TotalItems != 1 {
+		t.Fatalf("expected cached rollup with 1 item, got %d", rollup.TotalItems)
+	}
+	if stats := svc.DependencyRollupCacheStats(); stats.Hits != 1 || stats.Misses != 1 || stats.Entries != 1 {
+		t.Fatalf("unexpected cache stats after hit %#v", stats)
+	}
+
+	// Service task writes invalidate the project entry.
+	if _, err := svc.CreateTask(ctx, CreateTaskInput{
+		ProjectID: project.ID,
+		ColumnID:  column.ID,
+		Title:     "depends",
+		Priority:  domain.PriorityMedium,
+		Metadata:  domain.TaskMetadata{DependsOn: []string{dep.ID}},
+	}); err != nil {
+		t.Fatalf("CreateTask() error = %v", err)
+	}
+	rollup, err = svc.GetProjectDependencyRollup(ctx, project.ID)
+	if err != nil {
+		t.Fatalf("GetProjectDependencyRollup() after write error = %v", err)
+	}
+	if rollup.TotalItems != 3 || rollup.DependencyEdges != 1 {
+		t.Fatalf("expected recomputed rollup after create, got %#v", rollup)
+	}
+	if stats := svc.DependencyRollupCacheStats(); stats.Invalidations != 1 || stats.Misses != 2 {
+		t.Fatalf("unexpected cache stats after invalidation %#v", stats)
+	}
+
+	// Expired entries are recomputed even without an in-process write.
+	delete(repo.tasks, external.ID)
+	clock = clock.Add(2 * time.Minute)
+	rollup, err = svc.GetProjectDependencyRollup(ctx, project.ID)
+	if err != nil {
+		t.Fatalf("GetProjectDependencyRollup() after ttl error = %v", err)
+	}
+	if rollup.TotalItems != 2 {
+		t.Fatalf("expected ttl expiry to recompute rollup, got %d items", rollup.TotalItems)
+	}
+}
+
+// TestDependencyRollupCacheDropsStalePut verifies a rollup read before an invalidation is not cached after it.
+func TestDependencyRollupCacheDropsStalePut(t *testing.T) {
+	cache := newDependencyRollupCache(time.Minute)
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	stale := domain.DependencyRollup{ProjectID: "p1", TotalItems: 1}
+
+	_, generation, ok := cache.get("p1", now)
+	if ok {
+		t.Fatal("expected a miss on an empty cache")
+	}
+	// A task write lands while the read is still computing from the old rows.
+	cache.invalidate("p1")
+	cache.put("p1", stale, generation, now)
+	if _, _, ok := cache.get("p1", now); ok {
+		t.Fatal("expected the rollup computed before the invalidation to be dropped")
+	}
+
+	_, generation, _ = cache.get("p1", now)
+	cache.put("p1", stale, generation, now)
+	if rollup, _, ok := cache.get("p1", now); !ok || rollup.TotalItems != 1 {
+		t.Fatalf("expected a current-generation rollup to be cached, got %#v ok=%t", rollup, ok)
+	}
 }
 
 // TestListProjectChangeEvents verifies behavior for the covered scenario.
@@ -2894,3 +3399,1394 @@ func TestReparentTaskRejectsCycle(t *testing.T) {
 		t.Fatalf("expected ErrInvalidParentID, got %v", err)
 	}
 }
+
+// TestChangeTaskKindPromotesAndRevalidatesHierarchy verifies kind conversion re-checks the parent and every child.
+func TestChangeTaskKindPromotesAndRevalidatesHierarchy(t *testing.T) {
+	repo := newFakeRepo()
+	ids := []string{"p1", "c1", "t-grow", "t-step", "t-phase"}
+	idx := 0
+	svc := NewService(repo, func() string {
+		id := ids[idx]
+		idx++
+		return id
+	}, func() time.Time {
+		return time.Date(2026, 2, 24, 10, 0, 0, 0, time.UTC)
+	}, ServiceConfig{DefaultDeleteMode: DeleteModeArchive})
+	ctx := context.Background()
+
+	project, err := svc.CreateProject(ctx, "Hierarchy", "")
+	if err != nil {
+		t.Fatalf("CreateProject() error = %v", err)
+	}
+	column, err := svc.CreateColumn(ctx, project.ID, "To Do", 0, 0)
+	if err != nil {
+		t.Fatalf("CreateColumn() error = %v", err)
+	}
+	grow, err := svc.CreateTask(ctx, CreateTaskInput{ProjectID: project.ID, ColumnID: column.ID, Title: "grow", Priority: domain.PriorityMedium})
+	if err != nil {
+		t.Fatalf("CreateTask(grow) error = %v", err)
+	}
+	step, err := svc.CreateTask(ctx, CreateTaskInput{ProjectID: project.ID, ParentID: grow.ID, Kind: domain.WorkKindSubtask, ColumnID: column.ID, Title: "step", Priority: domain.PriorityMedium})
+	if err != nil {
+		t.Fatalf("CreateTask(step) error = %v", err)
+	}
+
+	branch, err := svc.ChangeTaskKind(ctx, ChangeTaskKindInput{TaskID: grow.ID, Kind: "branch"})
+	if err != nil {
+		t.Fatalf("ChangeTaskKind(branch) error = %v", err)
+	}
+	if branch.Kind != "branch" || branch.Scope != domain.KindAppliesToBranch || branch.ID != grow.ID {
+		t.Fatalf("expected task promoted to branch in place, got %#v", branch)
+	}
+	if stored, _ := repo.GetTask(ctx, grow.ID); stored.Scope != domain.KindAppliesToBranch {
+		t.Fatalf("expected promotion persisted, got scope %q", stored.Scope)
+	}
+
+	// A phase child only accepts branch or phase parents, so demoting its branch back to a task fails.
+	if _, err := svc.CreateTask(ctx, CreateTaskInput{ProjectID: project.ID, ParentID: grow.ID, Kind: domain.WorkKindPhase, ColumnID: column.ID, Title: "phase one", Priority: domain.PriorityMedium}); err != nil {
+		t.Fatalf("CreateTask(phase) error = %v", err)
+	}
+	if _, err := svc.ChangeTaskKind(ctx, ChangeTaskKindInput{TaskID: grow.ID, Kind: domain.WorkKindTask}); !errors.Is(err, domain.ErrKindNotAllowed) || !strings.Contains(err.Error(), "phase one") {
+		t.Fatalf("expected child phase to block demotion, got %v", err)
+	}
+	if stored, _ := repo.GetTask(ctx, grow.ID); stored.Kind != "branch" {
+		t.Fatalf("expected rejected demotion to leave the branch untouched, got %q", stored.Kind)
+	}
+
+	// A nested item cannot become a top-level-only kind.
+	if _, err := svc.ChangeTaskKind(ctx, ChangeTaskKindInput{TaskID: step.ID, Kind: domain.WorkKindTask}); !errors.Is(err, domain.ErrKindNotAllowed) {
+		t.Fatalf("expected nested task kind rejected, got %v", err)
+	}
+}
+
+// TestDryRunMutationsValidateWithoutPersisting verifies dry-run previews share validation but skip writes.
+func TestDryRunMutationsValidateWithoutPersisting(t *testing.T) {
+	repo := newFakeRepo()
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	project, _ := domain.NewProject("p1", "Inbox", "", now)
+	repo.projects[project.ID] = project
+	todo, _ := domain.NewColumn("c1", project.ID, "To Do", 0, 0, now)
+	progress, _ := domain.NewColumn("c2", project.ID, "In Progress", 1, 0, now)
+	repo.columns[todo.ID] = todo
+	repo.columns[progress.ID] = progress
+	task, _ := domain.NewTask(domain.TaskInput{
+		ID:        "t1",
+		ProjectID: project.ID,
+		ColumnID:  todo.ID,
+		Position:  0,
+		Title:     "existing",
+		Priority:  domain.PriorityMedium,
+	}, now)
+	repo.tasks[task.ID] = task
+
+	svc := NewService(repo, func() string { return "t2" }, func() time.Time { return now }, ServiceConfig{})
+	ctx := WithDryRun(context.Background())
+	if !DryRunFromContext(ctx) || DryRunFromContext(context.Background()) {
+		t.Fatal("expected dry-run flag only on marked context")
+	}
+
+	created, err := svc.CreateTask(ctx, CreateTaskInput{
+		ProjectID: project.ID,
+		ColumnID:  todo.ID,
+		Title:     "preview",
+		Priority:  domain.PriorityLow,
+	})
+	if err != nil {
+		t.Fatalf("CreateTask(dry-run) error = %v", err)
+	}
+	if created.ID != "t2" || created.Position != 1 {
+		t.Fatalf("unexpected dry-run create preview %#v", created)
+	}
+	if _, ok := repo.tasks["t2"]; ok {
+		t.Fatal("expected dry-run create to skip persistence")
+	}
+
+	moved, err := svc.MoveTask(ctx, task.ID, progress.ID, 3)
+	if err != nil {
+		t.Fatalf("MoveTask(dry-run) error = %v", err)
+	}
+	if moved.ColumnID != progress.ID || moved.Position != 3 || moved.LifecycleState != domain.StateProgress {
+		t.Fatalf("unexpected dry-run move preview %#v", moved)
+	}
+	if stored := repo.tasks[task.ID]; stored.ColumnID != todo.ID || stored.Position != 0 {
+		t.Fatalf("expected dry-run move to leave stored task untouched, got %#v", stored)
+	}
+
+	if err := svc.DeleteTask(ctx, task.ID, DeleteModeHard); err != nil {
+		t.Fatalf("DeleteTask(dry-run) error = %v", err)
+	}
+	if _, ok := repo.tasks[task.ID]; !ok {
+		t.Fatal("expected dry-run delete to keep task")
+	}
+
+	if _, err := svc.CreateTask(ctx, CreateTaskInput{ProjectID: project.ID, ColumnID: todo.ID, Title: "", Priority: domain.PriorityLow}); !errors.Is(err, domain.ErrInvalidTitle) {
+		t.Fatalf("expected dry-run create to surface ErrInvalidTitle, got %v", err)
+	}
+}
+
+// TestLongOperationsHonorContextCancellation verifies scans abort with the context error once canceled.
+func TestLongOperationsHonorContextCancellation(t *testing.T) {
+	repo := newFakeRepo()
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	project, _ := domain.NewProject("p1", "Inbox", "", now)
+	repo.projects[project.ID] = project
+	column, _ := domain.NewColumn("c1", project.ID, "To Do", 0, 0, now)
+	repo.columns[column.ID] = column
+	task, _ := domain.NewTask(domain.TaskInput{
+		ID:        "t1",
+		ProjectID: project.ID,
+		ColumnID:  column.ID,
+		Title:     "scan me",
+		Priority:  domain.PriorityMedium,
+	}, now)
+	repo.tasks[task.ID] = task
+	svc := NewService(repo, nil, func() time.Time { return now }, ServiceConfig{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := svc.SearchTaskMatches(ctx, SearchTasksFilter{CrossProject: true, Query: "scan"}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("SearchTaskMatches() error = %v, want context.Canceled", err)
+	}
+	if _, err := svc.GetProjectDependencyRollup(ctx, project.ID); !errors.Is(err, context.Canceled) {
+		t.Fatalf("GetProjectDependencyRollup() error = %v, want context.Canceled", err)
+	}
+	if _, err := svc.ExportSnapshot(ctx, true); !errors.Is(err, context.Canceled) {
+		t.Fatalf("ExportSnapshot() error = %v, want context.Canceled", err)
+	}
+}
+
+// TestListProjectActivityFallback verifies repositories without the aggregate query still get per-project summaries.
+func TestListProjectActivityFallback(t *testing.T) {
+	repo := newFakeRepo()
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	project, _ := domain.NewProject("p1", "Inbox", "", now)
+	repo.projects[project.ID] = project
+	column, _ := domain.NewColumn("c1", project.ID, "To Do", 0, 0, now)
+	repo.columns[column.ID] = column
+	task, _ := domain.NewTask(domain.TaskInput{ID: "t1", ProjectID: project.ID, ColumnID: column.ID, Title: "task", Priority: domain.PriorityLow}, now)
+	repo.tasks[task.ID] = task
+	repo.changeEvents[project.ID] = []domain.ChangeEvent{{ProjectID: project.ID, Operation: domain.ChangeOperationCreate, OccurredAt: now.Add(time.Hour)}}
+
+	svc := NewService(repo, nil, func() time.Time { return now }, ServiceConfig{})
+	activity, err := svc.ListProjectActivity(context.Background())
+	if err != nil {
+		t.Fatalf("ListProjectActivity() error = %v", err)
+	}
+	if len(activity) != 1 || activity[0].ProjectID != project.ID || activity[0].TaskCount != 1 || !activity[0].LastChangeAt.Equal(now.Add(time.Hour)) {
+		t.Fatalf("unexpected project activity %#v", activity)
+	}
+}
+
+// TestListTasksPage verifies per-column paging over board rows, continuation state, and input validation.
+func TestListTasksPage(t *testing.T) {
+	repo := newFakeRepo()
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	project, _ := domain.NewProject("p1", "Inbox", "", now)
+	repo.projects[project.ID] = project
+	todo, _ := domain.NewColumn("c1", project.ID, "To Do", 0, 0, now)
+	done, _ := domain.NewColumn("c2", project.ID, "Done", 1, 0, now)
+	repo.columns[todo.ID] = todo
+	repo.columns[done.ID] = done
+	for idx := range 3 {
+		task, _ := domain.NewTask(domain.TaskInput{
+			ID:        fmt.Sprintf("t%d", idx),
+			ProjectID: project.ID,
+			ColumnID:  todo.ID,
+			Position:  idx,
+			Title:     fmt.Sprintf("task %d", idx),
+			Priority:  domain.PriorityLow,
+		}, now)
+		repo.tasks[task.ID] = task
+	}
+	other, _ := domain.NewTask(domain.TaskInput{
+		ID:        "d1",
+		ProjectID: project.ID,
+		ColumnID:  done.ID,
+		Title:     "done",
+		Priority:  domain.PriorityLow,
+	}, now)
+	repo.tasks[other.ID] = other
+	// A hidden subtask sorted ahead of the board rows must not use up a page slot.
+	step, _ := domain.NewTask(domain.TaskInput{
+		ID:        "s0",
+		ProjectID: project.ID,
+		ParentID:  "t1",
+		Kind:      domain.WorkKindSubtask,
+		ColumnID:  todo.ID,
+		Title:     "step",
+		Priority:  domain.PriorityLow,
+	}, now)
+	repo.tasks[step.ID] = step
+
+	svc := NewService(repo, nil, func() time.Time { return now }, ServiceConfig{})
+	ctx := context.Background()
+	page, err := svc.ListTasksPage(ctx, ListTasksPageInput{ProjectID: project.ID, ColumnID: todo.ID, Limit: 2})
+	if err != nil {
+		t.Fatalf("ListTasksPage() error = %v", err)
+	}
+	if len(page.Tasks) != 3 || page.Tasks[0].ID != "t0" || page.Tasks[2].ID != "s0" || !page.HasMore || page.NextOffset != 2 {
+		t.Fatalf("unexpected first page %#v", page)
+	}
+	if page.Count != (TaskPageCount{Total: 3, Active: 3}) {
+		t.Fatalf("page count = %#v, want 3 board rows", page.Count)
+	}
+	page, err = svc.ListTasksPage(ctx, ListTasksPageInput{ProjectID: project.ID, ColumnID: todo.ID, Limit: 2, Offset: page.NextOffset})
+	if err != nil {
+		t.Fatalf("ListTasksPage(next) error = %v", err)
+	}
+	if len(page.Tasks) != 1 || page.Tasks[0].ID != "t2" || page.HasMore || page.NextOffset != 3 {
+		t.Fatalf("unexpected last page %#v", page)
+	}
+	if _, err := svc.ListTasksPage(ctx, ListTasksPageInput{ProjectID: project.ID}); !errors.Is(err, domain.ErrInvalidColumnID) {
+		t.Fatalf("expected ErrInvalidColumnID, got %v", err)
+	}
+}
+
+// TestListDoneTasks verifies only unarchived tasks in done-mapped columns are listed, in board order.
+func TestListDoneTasks(t *testing.T) {
+	repo := newFakeRepo()
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	project, _ := domain.NewProject("p1", "Inbox", "", now)
+	repo.projects[project.ID] = project
+	todo, _ := domain.NewColumn("c1", project.ID, "To Do", 0, 0, now)
+	done, _ := domain.NewColumn("c2", project.ID, "Done", 1, 0, now)
+	completed, _ := domain.NewColumn("c3", project.ID, "Completed", 2, 0, now)
+	for _, column := range []domain.Column{todo, done, completed} {
+		repo.columns[column.ID] = column
+	}
+	seed := []struct {
+		id       string
+		columnID string
+		position int
+		archived bool
+	}{
+		{id: "open", columnID: todo.ID},
+		{id: "late", columnID: completed.ID},
+		{id: "second", columnID: done.ID, position: 1},
+		{id: "first", columnID: done.ID, position: 0},
+		{id: "archived", columnID: done.ID, position: 2, archived: true},
+	}
+	for _, entry := range seed {
+		task, _ := domain.NewTask(domain.TaskInput{
+			ID:        entry.id,
+			ProjectID: project.ID,
+			ColumnID:  entry.columnID,
+			Position:  entry.position,
+			Title:     entry.id,
+			Priority:  domain.PriorityLow,
+		}, now)
+		if entry.archived {
+			task.Archive(now)
+		}
+		repo.tasks[task.ID] = task
+	}
+
+	svc := NewService(repo, nil, func() time.Time { return now }, ServiceConfig{})
+	tasks, err := svc.ListDoneTasks(context.Background(), project.ID)
+	if err != nil {
+		t.Fatalf("ListDoneTasks() error = %v", err)
+	}
+	got := make([]string, 0, len(tasks))
+	for _, task := range tasks {
+		got = append(got, task.ID)
+	}
+	// "Completed" also maps to done; archived tasks are skipped.
+	if want := []string{"first", "second", "late"}; !slices.Equal(got, want) {
+		t.Fatalf("ListDoneTasks() = %v, want %v", got, want)
+	}
+	if _, err := svc.ListDoneTasks(context.Background(), " "); !errors.Is(err, domain.ErrInvalidID) {
+		t.Fatalf("expected ErrInvalidID for blank project, got %v", err)
+	}
+}
+
+// TestNormalizeColumnPositionsRepairsGapsAndDuplicates verifies per-column renumbering keeps current order.
+func TestNormalizeColumnPositionsRepairsGapsAndDuplicates(t *testing.T) {
+	repo := newFakeRepo()
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	project, _ := domain.NewProject("p1", "Inbox", "", now)
+	repo.projects[project.ID] = project
+	todo, _ := domain.NewColumn("c1", project.ID, "To Do", 0, 0, now)
+	done, _ := domain.NewColumn("c2", project.ID, "Done", 1, 0, now)
+	repo.columns[todo.ID] = todo
+	repo.columns[done.ID] = done
+
+	// To Do has a gap and a duplicate; Done is already contiguous.
+	seed := []struct {
+		id       string
+		columnID string
+		position int
+		created  time.Time
+	}{
+		{id: "t-b", columnID: todo.ID, position: 4, created: now.Add(time.Minute)},
+		{id: "t-a", columnID: todo.ID, position: 4, created: now},
+		{id: "t-first", columnID: todo.ID, position: 1, created: now},
+		{id: "t-done", columnID: done.ID, position: 0, created: now},
+	}
+	for _, row := range seed {
+		task, _ := domain.NewTask(domain.TaskInput{
+			ID:        row.id,
+			ProjectID: project.ID,
+			ColumnID:  row.columnID,
+			Position:  row.position,
+			Title:     row.id,
+			Priority:  domain.PriorityMedium,
+		}, row.created)
+		repo.tasks[task.ID] = task
+	}
+
+	svc := NewService(repo, nil, func() time.Time { return now }, ServiceConfig{})
+	preview, err := svc.NormalizeColumnPositions(WithDryRun(context.Background()), project.ID)
+	if err != nil || preview != 3 {
+		t.Fatalf("expected dry run to report 3 changes, got %d err %v", preview, err)
+	}
+	if repo.tasks["t-first"].Position != 1 {
+		t.Fatal("expected dry run to leave positions untouched")
+	}
+
+	// Agents need a lease covering the project, and a failed batch leaves every column as it was.
+	agentCtx := WithMutationActor(context.Background(), MutationActor{ActorID: "agent-1", ActorType: domain.ActorTypeAgent})
+	if _, err := svc.NormalizeColumnPositions(agentCtx, project.ID); !errors.Is(err, domain.ErrMutationLeaseRequired) {
+		t.Fatalf("expected agent repair without lease rejected, got %v", err)
+	}
+	failing := NewService(failingBatchRepo{repo}, nil, func() time.Time { return now }, ServiceConfig{})
+	if _, err := failing.NormalizeColumnPositions(context.Background(), project.ID); !errors.Is(err, errBatchFailed) {
+		t.Fatalf("expected failed batch surfaced, got %v", err)
+	}
+	if repo.tasks["t-first"].Position != 1 || repo.tasks["t-a"].Position != 4 {
+		t.Fatal("expected failed batch to leave positions untouched")
+	}
+
+	changed, err := svc.NormalizeColumnPositions(context.Background(), project.ID)
+	if err != nil {
+		t.Fatalf("NormalizeColumnPositions() error = %v", err)
+	}
+	if changed != 3 {
+		t.Fatalf("expected 3 repositioned tasks, got %d", changed)
+	}
+	want := map[string]int{"t-first": 0, "t-a": 1, "t-b": 2, "t-done": 0}
+	for id, position := range want {
+		if got := repo.tasks[id].Position; got != position {
+			t.Fatalf("expected %s at position %d, got %d", id, position, got)
+		}
+	}
+
+	if changed, err := svc.NormalizeColumnPositions(context.Background(), project.ID); err != nil || changed != 0 {
+		t.Fatalf("expected second repair to be a no-op, got %d err %v", changed, err)
+	}
+	if _, err := svc.NormalizeColumnPositions(context.Background(), " "); !errors.Is(err, domain.ErrInvalidID) {
+		t.Fatalf("expected ErrInvalidID for blank project, got %v", err)
+	}
+}
+
+// errBatchFailed is returned by failingBatchRepo.
+var errBatchFailed = errors.New("batch failed")
+
+// failingBatchRepo rejects every task and column batch while leaving single-row writes working.
+type failingBatchRepo struct {
+	*fakeRepo
+}
+
+// ApplyTaskBatch always fails without writing.
+func (failingBatchRepo) ApplyTaskBatch(context.Context, TaskBatch) error {
+	return errBatchFailed
+}
+
+// UpdateColumns always fails without writing.
+func (failingBatchRepo) UpdateColumns(context.Context, []domain.Column) error {
+	return errBatchFailed
+}
+
+// TestUpdateColumnRenamesAndSetsWIPLimit verifies column edits validate the name and limit and keep names unique.
+func TestUpdateColumnRenamesAndSetsWIPLimit(t *testing.T) {
+	repo := newFakeRepo()
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	project, _ := domain.NewProject("p1", "Inbox", "", now)
+	repo.projects[project.ID] = project
+	todo, _ := domain.NewColumn("c1", project.ID, "To Do", 0, 0, now)
+	done, _ := domain.NewColumn("c2", project.ID, "Done", 1, 0, now)
+	repo.columns[todo.ID] = todo
+	repo.columns[done.ID] = done
+
+	later := now.Add(time.Hour)
+	svc := NewService(repo, nil, func() time.Time { return later }, ServiceConfig{})
+	updated, err := svc.UpdateColumn(context.Background(), UpdateColumnInput{ColumnID: todo.ID, Name: "  Backlog ", WIPLimit: 4})
+	if err != nil {
+		t.Fatalf("UpdateColumn() error = %v", err)
+	}
+	if updated.Name != "Backlog" || updated.WIPLimit != 4 || !updated.UpdatedAt.Equal(later) {
+		t.Fatalf("unexpected updated column %#v", updated)
+	}
+	if stored := repo.columns[todo.ID]; stored.Name != "Backlog" || stored.WIPLimit != 4 {
+		t.Fatalf("expected stored column updated, got %#v", stored)
+	}
+
+	// Keeping a column's own name, even with new casing, is not a duplicate.
+	if _, err := svc.UpdateColumn(context.Background(), UpdateColumnInput{ColumnID: todo.ID, Name: "backlog"}); err != nil {
+		t.Fatalf("expected recasing own name to succeed, got %v", err)
+	}
+	if _, err := svc.UpdateColumn(context.Background(), UpdateColumnInput{ColumnID: todo.ID, Name: "DONE"}); !errors.Is(err, ErrDuplicateColumnName) {
+		t.Fatalf("expected ErrDuplicateColumnName, got %v", err)
+	}
+	if _, err := svc.UpdateColumn(context.Background(), UpdateColumnInput{ColumnID: todo.ID, Name: " "}); !errors.Is(err, domain.ErrInvalidName) {
+		t.Fatalf("expected ErrInvalidName, got %v", err)
+	}
+	if _, err := svc.UpdateColumn(context.Background(), UpdateColumnInput{ColumnID: todo.ID, Name: "Backlog", WIPLimit: -1}); !errors.Is(err, domain.ErrInvalidWIPLimit) {
+		t.Fatalf("expected negative WIP limit rejected, got %v", err)
+	}
+	if _, err := svc.UpdateColumn(context.Background(), UpdateColumnInput{ColumnID: "missing", Name: "X"}); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	if got := repo.columns[todo.ID]; got.Name != "backlog" || got.WIPLimit != 0 {
+		t.Fatalf("expected rejected edits to leave the column unchanged, got %#v", got)
+	}
+
+	// A dry run reports the edited column without storing it.
+	preview, err := svc.UpdateColumn(WithDryRun(context.Background()), UpdateColumnInput{ColumnID: todo.ID, Name: "Icebox", WIPLimit: 2})
+	if err != nil || preview.Name != "Icebox" || preview.WIPLimit != 2 {
+		t.Fatalf("expected dry-run preview of the edit, got %#v (err %v)", preview, err)
+	}
+	if got := repo.columns[todo.ID]; got.Name != "backlog" {
+		t.Fatalf("expected dry run to leave the column unchanged, got %#v", got)
+	}
+
+	// Agents need a lease covering the project, like other board mutations.
+	if _, err := svc.UpdateColumn(context.Background(), UpdateColumnInput{ColumnID: todo.ID, Name: "Icebox", UpdatedType: domain.ActorTypeAgent}); !errors.Is(err, domain.ErrMutationLeaseRequired) {
+		t.Fatalf("expected agent column edit without lease rejected, got %v", err)
+	}
+}
+
+// TestMoveColumnReindexesSiblings verifies column moves renumber siblings and reject out-of-range positions.
+func TestMoveColumnReindexesSiblings(t *testing.T) {
+	repo := newFakeRepo()
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	project, _ := domain.NewProject("p1", "Inbox", "", now)
+	repo.projects[project.ID] = project
+	// The archived column sits between active ones to prove it is renumbered after them.
+	seed := []struct {
+		id       string
+		name     string
+		position int
+		archived bool
+	}{
+		{id: "c1", name: "To Do", position: 0},
+		{id: "c4", name: "Old", position: 1, archived: true},
+		{id: "c2", name: "In Progress", position: 2},
+		{id: "c3", name: "Done", position: 3},
+	}
+	for _, row := range seed {
+		column, _ := domain.NewColumn(row.id, project.ID, row.name, row.position, 0, now)
+		if row.archived {
+			column.Archive(now)
+		}
+		repo.columns[column.ID] = column
+	}
+
+	svc := NewService(repo, nil, func() time.Time { return now }, ServiceConfig{})
+	moved, err := svc.MoveColumn(context.Background(), "c3", 0)
+	if err != nil {
+		t.Fatalf("MoveColumn() error = %v", err)
+	}
+	if moved.ID != "c3" || moved.Position != 0 {
+		t.Fatalf("expected c3 at position 0, got %#v", moved)
+	}
+	want := map[string]int{"c3": 0, "c1": 1, "c2": 2, "c4": 3}
+	for id, position := range want {
+		if got := repo.columns[id].Position; got != position {
+			t.Fatalf("expected %s at position %d, got %d", id, position, got)
+		}
+	}
+
+	if _, err := svc.MoveColumn(context.Background(), "c3", 3); !errors.Is(err, domain.ErrInvalidPosition) {
+		t.Fatalf("expected ErrInvalidPosition past the last active column, got %v", err)
+	}
+	if _, err := svc.MoveColumn(context.Background(), "c3", -1); !errors.Is(err, domain.ErrInvalidPosition) {
+		t.Fatalf("expected ErrInvalidPosition before the first column, got %v", err)
+	}
+	if _, err := svc.MoveColumn(context.Background(), "c4", 0); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for archived column, got %v", err)
+	}
+	if _, err := svc.MoveColumn(context.Background(), "missing", 0); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for unknown column, got %v", err)
+	}
+
+	// Agents need a lease covering the project, like column edits.
+	agentCtx := WithMutationActor(context.Background(), MutationActor{ActorID: "agent-1", ActorType: domain.ActorTypeAgent})
+	if _, err := svc.MoveColumn(agentCtx, "c1", 0); !errors.Is(err, domain.ErrMutationLeaseRequired) {
+		t.Fatalf("expected agent column move without lease rejected, got %v", err)
+	}
+
+	// Renumbered columns land in one write, so a failed write leaves every position as it was.
+	svc = NewService(failingBatchRepo{repo}, nil, func() time.Time { return now }, ServiceConfig{})
+	if _, err := svc.MoveColumn(context.Background(), "c2", 0); !errors.Is(err, errBatchFailed) {
+		t.Fatalf("expected failed column batch surfaced, got %v", err)
+	}
+	for id, position := range want {
+		if got := repo.columns[id].Position; got != position {
+			t.Fatalf("expected failed move to leave %s at %d, got %d", id, position, got)
+		}
+	}
+}
+
+// TestRepairOrphanedTasksReparentsAndDeletes verifies orphan detection and both repair modes.
+func TestRepairOrphanedTasksReparentsAndDeletes(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	newRepo := func() *fakeRepo {
+		repo := newFakeRepo()
+		project, _ := domain.NewProject("p1", "Inbox", "", now)
+		repo.projects[project.ID] = project
+		column, _ := domain.NewColumn("c1", project.ID, "To Do", 0, 0, now)
+		repo.columns[column.ID] = column
+		// t-orphan points at a hard-deleted parent; t-grandchild hangs below the orphan.
+		inputs := []domain.TaskInput{
+			{ID: "t-root", Title: "root"},
+			{ID: "t-child", ParentID: "t-root", Kind: domain.WorkKindSubtask, Title: "child"},
+			{ID: "t-orphan", ParentID: "t-gone", Kind: domain.WorkKindSubtask, Title: "orphan"},
+			{ID: "t-grandchild", ParentID: "t-orphan", Kind: domain.WorkKindSubtask, Title: "grandchild"},
+		}
+		for idx, in := range inputs {
+			in.ProjectID = project.ID
+			in.ColumnID = column.ID
+			in.Position = idx
+			in.Priority = domain.PriorityMedium
+			task, err := domain.NewTask(in, now)
+			if err != nil {
+				t.Fatalf("NewTask(%s) error = %v", in.ID, err)
+			}
+			repo.tasks[task.ID] = task
+		}
+		return repo
+	}
+
+	repo := newRepo()
+	svc := NewService(repo, nil, func() time.Time { return now }, ServiceConfig{})
+	orphans, err := svc.ListOrphanedTasks(context.Background(), "p1")
+	if err != nil {
+		t.Fatalf("ListOrphanedTasks() error = %v", err)
+	}
+	if len(orphans) != 1 || orphans[0].ID != "t-orphan" {
+		t.Fatalf("expected only t-orphan, got %#v", orphans)
+	}
+	if _, err := svc.RepairOrphanedTasks(context.Background(), "p1", "archive"); !errors.Is(err, ErrInvalidOrphanRepairMode) {
+		t.Fatalf("expected ErrInvalidOrphanRepairMode, got %v", err)
+	}
+
+	if _, err := svc.RepairOrphanedTasks(context.Background(), "p1", OrphanRepairReparent); err != nil {
+		t.Fatalf("RepairOrphanedTasks(reparent) error = %v", err)
+	}
+	reparented := repo.tasks["t-orphan"]
+	if reparented.ParentID != "" || reparented.Scope != domain.KindAppliesToTask || reparented.Kind != domain.WorkKindTask {
+		t.Fatalf("expected orphan promoted to a root task, got parent=%q scope=%q kind=%q", reparented.ParentID, reparented.Scope, reparented.Kind)
+	}
+	if repo.tasks["t-grandchild"].ParentID != "t-orphan" {
+		t.Fatal("expected descendants to stay attached to the reparented orphan")
+	}
+
+	repo = newRepo()
+	svc = NewService(repo, nil, func() time.Time { return now }, ServiceConfig{})
+	if _, err := svc.RepairOrphanedTasks(context.Background(), "p1", OrphanRepairDelete); err != nil {
+		t.Fatalf("RepairOrphanedTasks(delete) error = %v", err)
+	}
+	for _, id := range []string{"t-orphan", "t-grandchild"} {
+		if _, ok := repo.tasks[id]; ok {
+			t.Fatalf("expected %s to be deleted with the orphan subtree", id)
+		}
+	}
+	if _, ok := repo.tasks["t-child"]; !ok {
+		t.Fatal("expected healthy subtasks to be untouched")
+	}
+
+	// The subtree deletion is one batch, so a failed batch deletes nothing.
+	repo = newRepo()
+	svc = NewService(failingBatchRepo{repo}, nil, func() time.Time { return now }, ServiceConfig{})
+	if _, err := svc.RepairOrphanedTasks(context.Background(), "p1", OrphanRepairDelete); !errors.Is(err, errBatchFailed) {
+		t.Fatalf("expected RepairOrphanedTasks(delete) to surface the batch error, got %v", err)
+	}
+	if len(repo.tasks) != 4 {
+		t.Fatalf("expected failed repair to leave every task, got %d", len(repo.tasks))
+	}
+}
+
+// TestDeleteTaskHonorsParentDeletePolicy verifies cascade, reparent, and block policies for hard deletes.
+func TestDeleteTaskHonorsParentDeletePolicy(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	newRepo := func() *fakeRepo {
+		repo := newFakeRepo()
+		project, _ := domain.NewProject("p1", "Inbox", "", now)
+		repo.projects[project.ID] = project
+		column, _ := domain.NewColumn("c1", project.ID, "To Do", 0, 0, now)
+		repo.columns[column.ID] = column
+		// t-parent owns one child, which owns one grandchild.
+		inputs := []domain.TaskInput{
+			{ID: "t-parent", Title: "parent"},
+			{ID: "t-child", ParentID: "t-parent", Kind: domain.WorkKindSubtask, Title: "child"},
+			{ID: "t-grandchild", ParentID: "t-child", Kind: domain.WorkKindSubtask, Title: "grandchild"},
+		}
+		for idx, in := range inputs {
+			in.ProjectID = project.ID
+			in.ColumnID = column.ID
+			in.Position = idx
+			in.Priority = domain.PriorityMedium
+			task, err := domain.NewTask(in, now)
+			if err != nil {
+				t.Fatalf("NewTask(%s) error = %v", in.ID, err)
+			}
+			repo.tasks[task.ID] = task
+		}
+		return repo
+	}
+
+	// Without a configured policy, hard deletes of parents are blocked.
+	repo := newRepo()
+	svc := NewService(repo, nil, func() time.Time { return now }, ServiceConfig{})
+	if err := svc.DeleteTask(context.Background(), "t-parent", DeleteModeHard); !errors.Is(err, ErrParentHasSubtasks) {
+		t.Fatalf("expected default policy to block, got %v", err)
+	}
+	// A per-call policy overrides the configured one, which is how the TUI applies reloaded config.
+	if err := svc.DeleteTask(WithParentDeletePolicy(context.Background(), ParentDeletePolicyCascade), "t-parent", DeleteModeHard); err != nil {
+		t.Fatalf("DeleteTask(cascade per call) error = %v", err)
+	}
+	if len(repo.tasks) != 0 {
+		t.Fatalf("expected cascade to delete the whole subtree, got %d tasks", len(repo.tasks))
+	}
+
+	repo = newRepo()
+	svc = NewService(repo, nil, func() time.Time { return now }, ServiceConfig{ParentDeletePolicy: ParentDeletePolicyReparent})
+	if err := svc.DeleteTask(context.Background(), "t-child", DeleteModeHard); err != nil {
+		t.Fatalf("DeleteTask(reparent) error = %v", err)
+	}
+	if got := repo.tasks["t-grandchild"].ParentID; got != "t-parent" {
+		t.Fatalf("expected grandchild moved to grandparent, got parent %q", got)
+	}
+	if err := svc.DeleteTask(context.Background(), "t-parent", DeleteModeHard); err != nil {
+		t.Fatalf("DeleteTask(reparent root) error = %v", err)
+	}
+	// Reparenting to the project root promotes subtasks so they stay visible on the board.
+	promoted := repo.tasks["t-grandchild"]
+	if promoted.ParentID != "" || promoted.Kind != domain.WorkKindTask || promoted.Scope != domain.KindAppliesToTask {
+		t.Fatalf("expected grandchild promoted to a root task, got parent=%q kind=%q scope=%q", promoted.ParentID, promoted.Kind, promoted.Scope)
+	}
+
+	repo = newRepo()
+	svc = NewService(repo, nil, func() time.Time { return now }, ServiceConfig{ParentDeletePolicy: ParentDeletePolicyBlock})
+	err := svc.DeleteTask(context.Background(), "t-parent", DeleteModeHard)
+	if !errors.Is(err, ErrParentHasSubtasks) || !strings.Contains(err.Error(), "2 subtasks") {
+		t.Fatalf("expected ErrParentHasSubtasks naming 2 subtasks, got %v", err)
+	}
+	if len(repo.tasks) != 3 {
+		t.Fatalf("expected block policy to leave tasks untouched, got %d", len(repo.tasks))
+	}
+	if err := svc.DeleteTask(context.Background(), "t-grandchild", DeleteModeHard); err != nil {
+		t.Fatalf("expected leaf delete to pass block policy, got %v", err)
+	}
+
+	// Cascade and reparent writes land in one batch, so a failed batch leaves the subtree whole.
+	for _, policy := range []ParentDeletePolicy{ParentDeletePolicyCascade, ParentDeletePolicyReparent} {
+		repo = newRepo()
+		svc = NewService(failingBatchRepo{repo}, nil, func() time.Time { return now }, ServiceConfig{ParentDeletePolicy: policy})
+		if err := svc.DeleteTask(context.Background(), "t-parent", DeleteModeHard); !errors.Is(err, errBatchFailed) {
+			t.Fatalf("expected %s delete to surface the batch error, got %v", policy, err)
+		}
+		if len(repo.tasks) != 3 || repo.tasks["t-child"].ParentID != "t-parent" {
+			t.Fatalf("expected failed %s delete to leave the subtree untouched, got %#v", policy, repo.tasks)
+		}
+	}
+}
+
+// TestArchiveAndRestoreTaskSubtree verifies subtree archive and restore cover every descendant in one call.
+func TestArchiveAndRestoreTaskSubtree(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	repo := newFakeRepo()
+	project, _ := domain.NewProject("p1", "Inbox", "", now)
+	repo.projects[project.ID] = project
+	todo, _ := domain.NewColumn("c1", project.ID, "To Do", 0, 0, now)
+	done, _ := domain.NewColumn("c2", project.ID, "Done", 1, 0, now)
+	repo.columns[todo.ID] = todo
+	repo.columns[done.ID] = done
+	inputs := []domain.TaskInput{
+		{ID: "t-epic", ColumnID: todo.ID, Title: "epic"},
+		{ID: "t-child", ParentID: "t-epic", Kind: domain.WorkKindSubtask, ColumnID: done.ID, Title: "child"},
+		{ID: "t-grandchild", ParentID: "t-child", Kind: domain.WorkKindSubtask, ColumnID: todo.ID, Title: "grandchild"},
+		{ID: "t-other", ColumnID: todo.ID, Title: "other"},
+	}
+	for idx, in := range inputs {
+		in.ProjectID = project.ID
+		in.Position = idx
+		in.Priority = domain.PriorityMedium
+		task, err := domain.NewTask(in, now)
+		if err != nil {
+			t.Fatalf("NewTask(%s) error = %v", in.ID, err)
+		}
+		repo.tasks[task.ID] = task
+	}
+	svc := NewService(repo, nil, func() time.Time { return now }, ServiceConfig{})
+
+	// Dry runs report the subtree without persisting it.
+	preview, err := svc.ArchiveTaskSubtree(WithDryRun(context.Background()), "t-epic")
+	if err != nil {
+		t.Fatalf("ArchiveTaskSubtree(dry run) error = %v", err)
+	}
+	if len(preview) != 3 || repo.tasks["t-epic"].ArchivedAt != nil {
+		t.Fatalf("expected a 3-task dry-run preview with no writes, got %d tasks", len(preview))
+	}
+
+	archived, err := svc.ArchiveTaskSubtree(context.Background(), "t-epic")
+	if err != nil {
+		t.Fatalf("ArchiveTaskSubtree() error = %v", err)
+	}
+	if len(archived) != 3 {
+		t.Fatalf("expected 3 archived tasks, got %d", len(archived))
+	}
+	for _, id := range []string{"t-epic", "t-child", "t-grandchild"} {
+		if repo.tasks[id].ArchivedAt == nil {
+			t.Fatalf("expected %s archived", id)
+		}
+	}
+	if repo.tasks["t-other"].ArchivedAt != nil {
+		t.Fatal("expected unrelated task to stay active")
+	}
+
+	restored, err := svc.RestoreTaskSubtree(context.Background(), "t-epic")
+	if err != nil {
+		t.Fatalf("RestoreTaskSubtree() error = %v", err)
+	}
+	if len(restored) != 3 {
+		t.Fatalf("expected 3 restored tasks, got %d", len(restored))
+	}
+	if repo.tasks["t-child"].ArchivedAt != nil || repo.tasks["t-child"].LifecycleState != domain.StateDone {
+		t.Fatalf("expected child restored into its done column state, got %#v", repo.tasks["t-child"])
+	}
+	if _, err := svc.RestoreTaskSubtree(context.Background(), " "); !errors.Is(err, domain.ErrInvalidID) {
+		t.Fatalf("expected ErrInvalidID for blank task id, got %v", err)
+	}
+}
+
+// TestMoveTaskAutoCompletesParents verifies parents move to done with their last subtask unless manually reopened.
+func TestMoveTaskAutoCompletesParents(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	newRepo := func() *fakeRepo {
+		repo := newFakeRepo()
+		project, _ := domain.NewProject("p1", "Inbox", "", now)
+		repo.projects[project.ID] = project
+		for idx, name := range []string{"To Do", "In Progress", "Done"} {
+			column, _ := domain.NewColumn(fmt.Sprintf("c%d", idx+1), project.ID, name, idx, 0, now)
+			repo.columns[column.ID] = column
+		}
+		inputs := []domain.TaskInput{
+			{ID: "t-epic", Title: "epic"},
+			{ID: "t-a", ParentID: "t-epic", Kind: domain.WorkKindSubtask, Title: "a"},
+			{ID: "t-b", ParentID: "t-epic", Kind: domain.WorkKindSubtask, Title: "b"},
+		}
+		for idx, in := range inputs {
+			in.ProjectID = project.ID
+			in.ColumnID = "c1"
+			in.Position = idx
+			in.Priority = domain.PriorityMedium
+			task, err := domain.NewTask(in, now)
+			if err != nil {
+				t.Fatalf("NewTask(%s) error = %v", in.ID, err)
+			}
+			repo.tasks[task.ID] = task
+		}
+		return repo
+	}
+	move := func(t *testing.T, svc *Service, taskID, columnID string) {
+		t.Helper()
+		if _, err := svc.MoveTask(context.Background(), taskID, columnID, 0); err != nil {
+			t.Fatalf("MoveTask(%s, %s) error = %v", taskID, columnID, err)
+		}
+	}
+
+	repo := newRepo()
+	recorder := &actorRecordingRepo{fakeRepo: repo, actors: map[string]MutationActor{}}
+	svc := NewService(recorder, nil, func() time.Time { return now }, ServiceConfig{AutoCompleteParents: true})
+	move(t, svc, "t-a", "c3")
+	if repo.tasks["t-epic"].ColumnID != "c1" {
+		t.Fatal("expected parent to wait for every subtask")
+	}
+	move(t, svc, "t-b", "c3")
+	epic := repo.tasks["t-epic"]
+	if epic.ColumnID != "c3" || epic.LifecycleState != domain.StateDone {
+		t.Fatalf("expected parent auto-moved to done, got column=%q state=%q", epic.ColumnID, epic.LifecycleState)
+	}
+	if actor := recorder.actors["t-epic"]; actor.ActorType != domain.ActorTypeSystem || actor.ActorID != autoCompleteActorID {
+		t.Fatalf("expected system actor on the auto-complete change, got %#v", actor)
+	}
+
+	// A manual reopen sticks even when the subtasks finish again.
+	move(t, svc, "t-epic", "c2")
+	move(t, svc, "t-b", "c2")
+	move(t, svc, "t-b", "c3")
+	if repo.tasks["t-epic"].ColumnID != "c2" {
+		t.Fatal("expected manually reopened parent to stay out of done")
+	}
+
+	// The last subtask's move and its parent's completion share one batch, so a failed write stores neither.
+	repo = newRepo()
+	svc = NewService(repo, nil, func() time.Time { return now }, ServiceConfig{AutoCompleteParents: true})
+	move(t, svc, "t-a", "c3")
+	svc = NewService(failingBatchRepo{fakeRepo: repo}, nil, func() time.Time { return now }, ServiceConfig{AutoCompleteParents: true})
+	if _, err := svc.MoveTask(context.Background(), "t-b", "c3", 0); !errors.Is(err, errBatchFailed) {
+		t.Fatalf("MoveTask(failing batch) error = %v, want errBatchFailed", err)
+	}
+	if repo.tasks["t-b"].ColumnID != "c1" || repo.tasks["t-epic"].ColumnID != "c1" {
+		t.Fatalf("expected neither subtask nor parent moved, got %q and %q", repo.tasks["t-b"].ColumnID, repo.tasks["t-epic"].ColumnID)
+	}
+
+	repo = newRepo()
+	svc = NewService(repo, nil, func() time.Time { return now }, ServiceConfig{})
+	move(t, svc, "t-a", "c3")
+	move(t, svc, "t-b", "c3")
+	if repo.tasks["t-epic"].ColumnID != "c1" {
+		t.Fatal("expected no auto-complete when the setting is off")
+	}
+}
+
+// actorRecordingRepo records the mutation actor attached to each task create and update.
+type actorRecordingRepo struct {
+	*fakeRepo
+	actors map[string]MutationActor
+}
+
+// CreateTask records the context actor before delegating to the fake repository.
+func (r *actorRecordingRepo) CreateTask(ctx context.Context, task domain.Task) error {
+	actor, _ := MutationActorFromContext(ctx)
+	r.actors[task.ID] = actor
+	return r.fakeRepo.CreateTask(ctx, task)
+}
+
+// UpdateTask records the context actor before delegating to the fake repository.
+func (r *actorRecordingRepo) UpdateTask(ctx context.Context, task domain.Task) error {
+	actor, _ := MutationActorFromContext(ctx)
+	r.actors[task.ID] = actor
+	return r.fakeRepo.UpdateTask(ctx, task)
+}
+
+// ApplyTaskBatch records each write's batch actor, falling back to the context actor, before delegating.
+func (r *actorRecordingRepo) ApplyTaskBatch(ctx context.Context, batch TaskBatch) error {
+	for _, task := range batch.Update {
+		actor, ok := batch.Actors[task.ID]
+		if !ok {
+			actor, _ = MutationActorFromContext(ctx)
+		}
+		r.actors[task.ID] = actor
+	}
+	return r.fakeRepo.ApplyTaskBatch(ctx, batch)
+}
+
+// TestMoveTaskCreatesRecurringFollowUp verifies completing a recurring task clones it into the first column with the next due date.
+func TestMoveTaskCreatesRecurringFollowUp(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	repo := newFakeRepo()
+	project, _ := domain.NewProject("p1", "Inbox", "", now)
+	repo.projects[project.ID] = project
+	for idx, name := range []string{"To Do", "In Progress", "Done"} {
+		column, _ := domain.NewColumn(fmt.Sprintf("c%d", idx+1), project.ID, name, idx, 0, now)
+		repo.columns[column.ID] = column
+	}
+	due := now.Add(-24 * time.Hour)
+	chore, _ := domain.NewTask(domain.TaskInput{
+		ID:          "t-chore",
+		ProjectID:   project.ID,
+		ColumnID:    "c2",
+		Title:       "water plants",
+		Description: "both balconies",
+		Priority:    domain.PriorityHigh,
+		DueAt:       &due,
+		Labels:      []string{"home"},
+		Metadata:    domain.TaskMetadata{RecurEvery: "1w", Reminders: []string{"1d"}, Objective: "keep plants alive"},
+	}, now)
+	repo.tasks[chore.ID] = chore
+
+	recorder := &actorRecordingRepo{fakeRepo: repo, actors: map[string]MutationActor{}}
+	svc := NewService(recorder, func() string { return "t-next" }, func() time.Time { return now }, ServiceConfig{})
+	done, err := svc.MoveTask(context.Background(), chore.ID, "c3", 0)
+	if err != nil {
+		t.Fatalf("MoveTask() error = %v", err)
+	}
+	if done.Metadata.RecurEvery != "" || repo.tasks[chore.ID].Metadata.RecurEvery != "" {
+		t.Fatal("expected the completed task to hand its recurrence to the follow-up")
+	}
+
+	next, ok := repo.tasks["t-next"]
+	if !ok {
+		t.Fatal("expected a recurring follow-up task")
+	}
+	if next.ColumnID != "c1" || next.LifecycleState != domain.StateTodo {
+		t.Fatalf("expected follow-up in the first column as todo, got column=%q state=%q", next.ColumnID, next.LifecycleState)
+	}
+	if next.DueAt == nil || !next.DueAt.Equal(due.AddDate(0, 0, 7)) {
+		t.Fatalf("expected due date advanced one week, got %v", next.DueAt)
+	}
+	if next.Title != chore.Title || next.Description != chore.Description || next.Priority != domain.PriorityHigh || !reflect.DeepEqual(next.Labels, []string{"home"}) {
+		t.Fatalf("expected title, description, priority, and labels copied, got %#v", next)
+	}
+	if next.Metadata.RecurEvery != "1w" || !reflect.DeepEqual(next.Metadata.Reminders, []string{"1d"}) || next.Metadata.Objective != "" {
+		t.Fatalf("expected only recurrence and reminders carried in metadata, got %#v", next.Metadata)
+	}
+	if actor := recorder.actors["t-next"]; actor.ActorType != domain.ActorTypeSystem || actor.ActorID != recurrenceActorID {
+		t.Fatalf("expected system actor on the follow-up create, got %#v", actor)
+	}
+
+	// Reopening and completing again does not spawn a second follow-up.
+	if _, err := svc.MoveTask(context.Background(), chore.ID, "c2", 0); err != nil {
+		t.Fatalf("MoveTask(reopen) error = %v", err)
+	}
+	delete(repo.tasks, "t-next")
+	if _, err := svc.MoveTask(context.Background(), chore.ID, "c3", 0); err != nil {
+		t.Fatalf("MoveTask(done again) error = %v", err)
+	}
+	if _, ok := repo.tasks["t-next"]; ok {
+		t.Fatal("expected no follow-up after the recurrence was handed off")
+	}
+}
+
+// TestSetTaskLifecycleStateDoneCreatesRecurringFollowUp verifies an explicit done hands off recurrence like a move to done.
+func TestSetTaskLifecycleStateDoneCreatesRecurringFollowUp(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	repo := newFakeRepo()
+	project, _ := domain.NewProject("p1", "Inbox", "", now)
+	repo.projects[project.ID] = project
+	for idx, name := range []string{"To Do", "Done"} {
+		column, _ := domain.NewColumn(fmt.Sprintf("c%d", idx+1), project.ID, name, idx, 0, now)
+		repo.columns[column.ID] = column
+	}
+	due := now.Add(-24 * time.Hour)
+	chore, _ := domain.NewTask(domain.TaskInput{
+		ID:        "t-chore",
+		ProjectID: project.ID,
+		ColumnID:  "c1",
+		Title:     "water plants",
+		Priority:  domain.PriorityMedium,
+		DueAt:     &due,
+		Metadata:  domain.TaskMetadata{RecurEvery: "1w"},
+	}, now)
+	repo.tasks[chore.ID] = chore
+
+	svc := NewService(repo, func() string { return "t-next" }, func() time.Time { return now }, ServiceConfig{})
+	done, err := svc.SetTaskLifecycleState(context.Background(), chore.ID, domain.StateDone)
+	if err != nil {
+		t.Fatalf("SetTaskLifecycleState(done) error = %v", err)
+	}
+	if done.Metadata.RecurEvery != "" || repo.tasks[chore.ID].Metadata.RecurEvery != "" {
+		t.Fatal("expected the completed task to hand its recurrence to the follow-up")
+	}
+	next, ok := repo.tasks["t-next"]
+	if !ok {
+		t.Fatal("expected a recurring follow-up task")
+	}
+	if next.Metadata.RecurEvery != "1w" || next.DueAt == nil || !next.DueAt.Equal(due.AddDate(0, 0, 7)) {
+		t.Fatalf("expected follow-up due one week later with the recurrence, got %#v", next)
+	}
+}
+
+// TestSetTaskLifecycleStateDecouplesFromColumn verifies explicit states survive moves and drive state search.
+func TestSetTaskLifecycleStateDecouplesFromColumn(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	repo := newFakeRepo()
+	project, _ := domain.NewProject("p1", "Inbox", "", now)
+	repo.projects[project.ID] = project
+	for idx, name := range []string{"To Do", "Review", "Done"} {
+		column, _ := domain.NewColumn(fmt.Sprintf("c%d", idx+1), project.ID, name, idx, 0, now)
+		repo.columns[column.ID] = column
+	}
+	task, _ := domain.NewTask(domain.TaskInput{
+		ID:        "t1",
+		ProjectID: project.ID,
+		ColumnID:  "c2",
+		Title:     "ship it",
+		Priority:  domain.PriorityMedium,
+	}, now)
+	repo.tasks[task.ID] = task
+	svc := NewService(repo, nil, func() time.Time { return now }, ServiceConfig{})
+
+	if _, err := svc.SetTaskLifecycleState(context.Background(), "t1", domain.StateArchived); !errors.Is(err, domain.ErrInvalidLifecycleState) {
+		t.Fatalf("expected archived state to be rejected, got %v", err)
+	}
+	updated, err := svc.SetTaskLifecycleState(context.Background(), "t1", domain.StateDone)
+	if err != nil {
+		t.Fatalf("SetTaskLifecycleState(done) error = %v", err)
+	}
+	if updated.LifecycleState != domain.StateDone || !updated.Metadata.LifecycleStateExplicit || updated.ColumnID != "c2" {
+		t.Fatalf("expected explicit done state in the review column, got %#v", updated)
+	}
+
+	// Moving the task keeps the explicit state instead of the column mapping.
+	moved, err := svc.MoveTask(context.Background(), "t1", "c1", 0)
+	if err != nil {
+		t.Fatalf("MoveTask() error = %v", err)
+	}
+	if moved.LifecycleState != domain.StateDone {
+		t.Fatalf("expected explicit state to survive a move, got %q", moved.LifecycleState)
+	}
+	matches, err := svc.SearchTaskMatches(context.Background(), SearchTasksFilter{ProjectID: "p1", States: []string{"done"}})
+	if err != nil {
+		t.Fatalf("SearchTaskMatches() error = %v", err)
+	}
+	if len(matches) != 1 || matches[0].StateID != "done" {
+		t.Fatalf("expected state search to use the explicit state, got %#v", matches)
+	}
+
+	// Clearing the explicit state falls back to the column mapping.
+	cleared, err := svc.SetTaskLifecycleState(context.Background(), "t1", "")
+	if err != nil {
+		t.Fatalf("SetTaskLifecycleState(clear) error = %v", err)
+	}
+	if cleared.LifecycleState != domain.StateTodo || cleared.Metadata.LifecycleStateExplicit {
+		t.Fatalf("expected column-mapped todo state after clearing, got %#v", cleared)
+	}
+}
+
+// TestSetTaskLifecycleStateDoneAutoCompletesParents verifies an explicit done completes parents like a move to done.
+func TestSetTaskLifecycleStateDoneAutoCompletesParents(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	repo := newFakeRepo()
+	project, _ := domain.NewProject("p1", "Inbox", "", now)
+	repo.projects[project.ID] = project
+	for idx, name := range []string{"To Do", "Done"} {
+		column, _ := domain.NewColumn(fmt.Sprintf("c%d", idx+1), project.ID, name, idx, 0, now)
+		repo.columns[column.ID] = column
+	}
+	inputs := []domain.TaskInput{
+		{ID: "t-epic", Title: "epic"},
+		{ID: "t-a", ParentID: "t-epic", Kind: domain.WorkKindSubtask, Title: "a"},
+	}
+	for idx, in := range inputs {
+		in.ProjectID = project.ID
+		in.ColumnID = "c1"
+		in.Position = idx
+		in.Priority = domain.PriorityMedium
+		task, _ := domain.NewTask(in, now)
+		repo.tasks[task.ID] = task
+	}
+
+	svc := NewService(repo, nil, func() time.Time { return now }, ServiceConfig{AutoCompleteParents: true})
+	if _, err := svc.SetTaskLifecycleState(context.Background(), "t-a", domain.StateDone); err != nil {
+		t.Fatalf("SetTaskLifecycleState(done) error = %v", err)
+	}
+	if epic := repo.tasks["t-epic"]; epic.ColumnID != "c2" || epic.LifecycleState != domain.StateDone {
+		t.Fatalf("expected parent auto-moved to done, got column=%q state=%q", epic.ColumnID, epic.LifecycleState)
+	}
+
+	// Reopening through an explicit state counts as a manual reopen, as a move out of done does.
+	reopened, err := svc.SetTaskLifecycleState(context.Background(), "t-epic", domain.StateProgress)
+	if err != nil {
+		t.Fatalf("SetTaskLifecycleState(progress) error = %v", err)
+	}
+	if !reopened.Metadata.ManuallyReopened {
+		t.Fatal("expected an explicit reopen to mark the parent manually reopened")
+	}
+}
+
+// TestMoveTaskWIPLimitEnforcement verifies WIP limits only block moves when the context opts in, and foreign columns are rejected.
+func TestMoveTaskWIPLimitEnforcement(t *testing.T) {
+	repo := newFakeRepo()
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	project, _ := domain.NewProject("p1", "Inbox", "", now)
+	other, _ := domain.NewProject("p2", "Other", "", now)
+	repo.projects[project.ID] = project
+	repo.projects[other.ID] = other
+	todo, _ := domain.NewColumn("c1", project.ID, "To Do", 0, 0, now)
+	progress, _ := domain.NewColumn("c2", project.ID, "In Progress", 1, 1, now)
+	foreign, _ := domain.NewColumn("c9", other.ID, "To Do", 0, 0, now)
+	repo.columns[todo.ID] = todo
+	repo.columns[progress.ID] = progress
+	repo.columns[foreign.ID] = foreign
+	for _, in := range []domain.TaskInput{
+		{ID: "t1", ProjectID: project.ID, ColumnID: todo.ID, Title: "mover", Priority: domain.PriorityMedium},
+		{ID: "t2", ProjectID: project.ID, ColumnID: progress.ID, Title: "occupant", Priority: domain.PriorityMedium},
+	} {
+		task, _ := domain.NewTask(in, now)
+		repo.tasks[task.ID] = task
+	}
+
+	svc := NewService(repo, nil, func() time.Time { return now }, ServiceConfig{})
+	if _, err := svc.MoveTask(WithWIPLimitEnforcement(context.Background()), "t1", progress.ID, 0); !errors.Is(err, domain.ErrWIPLimitExceeded) {
+		t.Fatalf("expected ErrWIPLimitExceeded, got %v", err)
+	}
+	if _, err := svc.MoveTask(context.Background(), "t1", foreign.ID, 0); !errors.Is(err, domain.ErrInvalidColumnID) {
+		t.Fatalf("expected ErrInvalidColumnID for a foreign column, got %v", err)
+	}
+	// Without enforcement the limit is advisory, matching the board's warning-only display.
+	moved, err := svc.MoveTask(context.Background(), "t1", progress.ID, 1)
+	if err != nil {
+		t.Fatalf("MoveTask() error = %v", err)
+	}
+	if moved.ColumnID != progress.ID {
+		t.Fatalf("column = %q, want %q", moved.ColumnID, progress.ID)
+	}
+}
+
+// TestBulkUpdateTaskLabelsAppliesOneBatch verifies bulk label edits skip unchanged tasks and write all-or-nothing.
+func TestBulkUpdateTaskLabelsAppliesOneBatch(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	repo := newFakeRepo()
+	project, _ := domain.NewProject("p1", "Inbox", "", now)
+	repo.projects[project.ID] = project
+	column, _ := domain.NewColumn("c1", project.ID, "To Do", 0, 0, now)
+	repo.columns[column.ID] = column
+	for idx, in := range []domain.TaskInput{
+		{ID: "t1", Title: "one", Labels: []string{"bug"}},
+		{ID: "t2", Title: "two", Labels: []string{"ui"}},
+	} {
+		in.ProjectID = project.ID
+		in.ColumnID = column.ID
+		in.Position = idx
+		in.Priority = domain.PriorityMedium
+		task, _ := domain.NewTask(in, now)
+		repo.tasks[task.ID] = task
+	}
+
+	failing := NewService(failingBatchRepo{repo}, nil, func() time.Time { return now }, ServiceConfig{})
+	if _, err := failing.BulkUpdateTaskLabels(context.Background(), BulkLabelInput{TaskIDs: []string{"t1", "t2"}, Label: "Ops"}); !errors.Is(err, errBatchFailed) {
+		t.Fatalf("expected failed batch surfaced, got %v", err)
+	}
+	if !slices.Equal(repo.tasks["t1"].Labels, []string{"bug"}) || !slices.Equal(repo.tasks["t2"].Labels, []string{"ui"}) {
+		t.Fatalf("expected failed batch to leave labels, got t1=%v t2=%v", repo.tasks["t1"].Labels, repo.tasks["t2"].Labels)
+	}
+
+	svc := NewService(repo, nil, func() time.Time { return now }, ServiceConfig{})
+	updated, err := svc.BulkUpdateTaskLabels(context.Background(), BulkLabelInput{TaskIDs: []string{"t1", "t2"}, Label: " BUG "})
+	if err != nil {
+		t.Fatalf("BulkUpdateTaskLabels(add) error = %v", err)
+	}
+	if len(updated) != 1 || updated[0].ID != "t2" || !slices.Equal(repo.tasks["t2"].Labels, []string{"bug", "ui"}) {
+		t.Fatalf("expected only t2 to gain bug, got %#v", updated)
+	}
+	updated, err = svc.BulkUpdateTaskLabels(context.Background(), BulkLabelInput{TaskIDs: []string{"t1", "t2"}, Label: "bug", Remove: true})
+	if err != nil || len(updated) != 2 {
+		t.Fatalf("expected bug removed from both tasks, got %d (err %v)", len(updated), err)
+	}
+	if _, err := svc.BulkUpdateTaskLabels(context.Background(), BulkLabelInput{TaskIDs: []string{"t1"}, Label: " "}); !errors.Is(err, domain.ErrInvalidName) {
+		t.Fatalf("expected blank label rejected, got %v", err)
+	}
+}
+
+// TestTaskLabelAllowlistEnforcement verifies creates and updates only check labels when the context carries an allowlist.
+func TestTaskLabelAllowlistEnforcement(t *testing.T) {
+	repo := newFakeRepo()
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	project, _ := domain.NewProject("p1", "Inbox", "", now)
+	repo.projects[project.ID] = project
+	column, _ := domain.NewColumn("c1", project.ID, "To Do", 0, 0, now)
+	repo.columns[column.ID] = column
+	svc := NewService(repo, func() string { return "t1" }, func() time.Time { return now }, ServiceConfig{})
+
+	allowlist := func(projectSlug string) []string {
+		if projectSlug == "inbox" {
+			return []string{"bug", "ux"}
+		}
+		return nil
+	}
+	ctx := WithLabelAllowlist(context.Background(), allowlist)
+	in := CreateTaskInput{ProjectID: project.ID, ColumnID: column.ID, Title: "One", Priority: domain.PriorityMedium, Labels: []string{"Bug", "chore"}}
+	_, err := svc.CreateTask(ctx, in)
+	if !errors.Is(err, ErrLabelNotAllowed) || !strings.Contains(err.Error(), `labels not allowed for project "inbox": chore (allowed: bug, ux)`) {
+		t.Fatalf("expected ErrLabelNotAllowed naming chore, got %v", err)
+	}
+	if len(repo.tasks) != 0 {
+		t.Fatalf("expected the rejected create to store nothing, got %d tasks", len(repo.tasks))
+	}
+	in.Labels = []string{"Bug"}
+	if _, err := svc.CreateTask(ctx, in); err != nil {
+		t.Fatalf("CreateTask() error = %v", err)
+	}
+
+	update := UpdateTaskInput{TaskID: "t1", Title: "One", Priority: domain.PriorityMedium, Labels: []string{"bug", "chore"}}
+	if _, err := svc.UpdateTask(ctx, update); !errors.Is(err, ErrLabelNotAllowed) {
+		t.Fatalf("expected ErrLabelNotAllowed on update, got %v", err)
+	}
+	// Without an allowlist in the context labels stay free-form.
+	updated, err := svc.UpdateTask(context.Background(), update)
+	if err != nil {
+		t.Fatalf("UpdateTask() error = %v", err)
+	}
+	if !slices.Equal(updated.Labels, []string{"bug", "chore"}) {
+		t.Fatalf("labels = %#v, want bug and chore", updated.Labels)
+	}
+	if ErrorCodeOf(ErrLabelNotAllowed) != ErrorCodeValidation {
+		t.Fatalf("expected ErrLabelNotAllowed to classify as validation, got %q", ErrorCodeOf(ErrLabelNotAllowed))
+	}
+}
+
+// TestCheckLabelsAllowedWithoutConfiguredLabels verifies enforcement with an empty allowlist rejects any label.
+func TestCheckLabelsAllowedWithoutConfiguredLabels(t *testing.T) {
+	if err := CheckLabelsAllowed("inbox", nil, nil); err != nil {
+		t.Fatalf("expected no labels to pass, got %v", err)
+	}
+	err := CheckLabelsAllowed("inbox", []string{"bug"}, nil)
+	if !errors.Is(err, ErrLabelNotAllowed) || !strings.Contains(err.Error(), "no labels configured") {
+		t.Fatalf("expected no-labels-configured rejection, got %v", err)
+	}
+}
+
+// TestVerifyResourceRefsMarksMissingAndVerified verifies local refs are stamped when found and flagged when gone.
+func TestVerifyResourceRefsMarksMissingAndVerified(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	earlier := now.Add(-48 * time.Hour)
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "docs"), 0o755); err != nil {
+		t.Fatalf("mkdir docs: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "docs", "plan.md"), []byte("plan"), 0o644); err != nil {
+		t.Fatalf("write plan: %v", err)
+	}
+	repo := newFakeRepo()
+	project, _ := domain.NewProject("p1", "Inbox", "", now)
+	repo.projects[project.ID] = project
+	column, _ := domain.NewColumn("c1", project.ID, "To Do", 0, 0, now)
+	repo.columns[column.ID] = column
+	task, err := domain.NewTask(domain.TaskInput{
+		ID:        "t1",
+		ProjectID: project.ID,
+		ColumnID:  column.ID,
+		Title:     "refs",
+		Priority:  domain.PriorityMedium,
+		Metadata: domain.TaskMetadata{ResourceRefs: []domain.ResourceRef{
+			{ResourceType: domain.ResourceTypeLocalFile, PathMode: domain.PathModeRelative, Location: "docs/plan.md", MissingSince: &earlier},
+			{ResourceType: domain.ResourceTypeLocalDir, PathMode: domain.PathModeRelative, Location: "docs"},
+			{ResourceType: domain.ResourceTypeLocalFile, PathMode: domain.PathModeRelative, Location: "docs/gone.md", MissingSince: &earlier},
+			{ResourceType: domain.ResourceTypeLocalDir, PathMode: domain.PathModeAbsolute, Location: filepath.ToSlash(filepath.Join(root, "docs", "plan.md"))},
+			{ResourceType: domain.ResourceTypeURL, PathMode: domain.PathModeAbsolute, Location: "https://example.com"},
+		}},
+	}, earlier)
+	if err != nil {
+		t.Fatalf("NewTask() error = %v", err)
+	}
+	repo.tasks[task.ID] = task
+	svc := NewService(repo, nil, func() time.Time { return now }, ServiceConfig{})
+
+	// A dry run reports without writing.
+	result, err := svc.VerifyResourceRefs(WithDryRun(context.Background()), project.ID, root)
+	if err != nil {
+		t.Fatalf("VerifyResourceRefs(dry run) error = %v", err)
+	}
+	if result.Checked != 4 || result.Verified != 2 || len(result.Missing) != 2 {
+		t.Fatalf("unexpected dry-run result %#v", result)
+	}
+	if repo.tasks[task.ID].Metadata.ResourceRefs[1].LastVerifiedAt != nil {
+		t.Fatal("expected dry run to leave refs untouched")
+	}
+
+	if _, err := svc.VerifyResourceRefs(context.Background(), project.ID, root); err != nil {
+		t.Fatalf("VerifyResourceRefs() error = %v", err)
+	}
+	refs := repo.tasks[task.ID].Metadata.ResourceRefs
+	if refs[0].IsStale() || refs[0].LastVerifiedAt == nil || !refs[0].LastVerifiedAt.Equal(now) {
+		t.Fatalf("expected found file verified and unmarked, got %#v", refs[0])
+	}
+	if refs[1].IsStale() || refs[1].LastVerifiedAt == nil {
+		t.Fatalf("expected found dir verified, got %#v", refs[1])
+	}
+	// A ref that stays missing keeps the time it was first found gone.
+	if !refs[2].IsStale() || !refs[2].MissingSince.Equal(earlier) {
+		t.Fatalf("expected gone file to keep its first missing time, got %#v", refs[2])
+	}
+	// A file where a directory was attached counts as missing.
+	if !refs[3].IsStale() || !refs[3].MissingSince.Equal(now) {
+		t.Fatalf("expected kind mismatch marked missing now, got %#v", refs[3])
+	}
+	if refs[4].IsStale() || refs[4].LastVerifiedAt != nil {
+		t.Fatalf("expected url ref skipped, got %#v", refs[4])
+	}
+	if !repo.tasks[task.ID].UpdatedAt.Equal(earlier) {
+		t.Fatalf("expected verification not to bump the task update time, got %v", repo.tasks[task.ID].UpdatedAt)
+	}
+
+	// Without a root, relative refs are counted as unresolved and left alone.
+	result, err = svc.VerifyResourceRefs(context.Background(), project.ID, "")
+	if err != nil {
+		t.Fatalf("VerifyResourceRefs(no root) error = %v", err)
+	}
+	if result.Unresolved != 3 || result.Checked != 1 {
+		t.Fatalf("unexpected no-root result %#v", result)
+	}
+}
+
+// TestPurgeArchivedBeforeKeepsReferencedTasks verifies purge removes old archives but keeps ones staying tasks reference.
+func TestPurgeArchivedBeforeKeepsReferencedTasks(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	longAgo := now.Add(-100 * 24 * time.Hour)
+	cutoff := now.Add(-90 * 24 * time.Hour)
+	repo := newFakeRepo()
+	project, _ := domain.NewProject("p1", "Inbox", "", longAgo)
+	repo.projects[project.ID] = project
+	column, _ := domain.NewColumn("c1", project.ID, "To Do", 0, 0, longAgo)
+	repo.columns[column.ID] = column
+	addTask := func(id, parentID string, archivedAt *time.Time, metadata domain.TaskMetadata) {
+		t.Helper()
+		task, err := domain.NewTask(domain.TaskInput{
+			ID:        id,
+			ProjectID: project.ID,
+			ParentID:  parentID,
+			ColumnID:  column.ID,
+			Title:     id,
+			Priority:  domain.PriorityMedium,
+			Metadata:  metadata,
+		}, longAgo)
+		if err != nil {
+			t.Fatalf("NewTask(%s) error = %v", id, err)
+		}
+		if archivedAt != nil {
+			task.Archive(*archivedAt)
+		}
+		repo.tasks[task.ID] = task
+	}
+	recent := now.Add(-10 * 24 * time.Hour)
+	addTask("old-free", "", &longAgo, domain.TaskMetadata{})
+	addTask("old-parent", "", &longAgo, domain.TaskMetadata{})
+	addTask("old-child", "old-parent", &longAgo, domain.TaskMetadata{})
+	addTask("old-dep", "", &longAgo, domain.TaskMetadata{BlockedBy: []string{"old-blocker"}})
+	addTask("old-blocker", "", &longAgo, domain.TaskMetadata{})
+	addTask("recent", "", &recent, domain.TaskMetadata{})
+	addTask("live", "", nil, domain.TaskMetadata{DependsOn: []string{"old-dep"}})
+	svc := NewService(repo, nil, func() time.Time { return now }, ServiceConfig{})
+
+	// A dry run reports the same split without deleting anything.
+	result, err := svc.PurgeArchivedBefore(WithDryRun(context.Background()), cutoff, false)
+	if err != nil {
+		t.Fatalf("PurgeArchivedBefore(dry run) error = %v", err)
+	}
+	if len(result.Purged) != 3 || len(repo.tasks) != 7 {
+		t.Fatalf("unexpected dry-run result %#v with %d tasks left", result, len(repo.tasks))
+	}
+
+	result, err = svc.PurgeArchivedBefore(context.Background(), cutoff, false)
+	if err != nil {
+		t.Fatalf("PurgeArchivedBefore() error = %v", err)
+	}
+	purged := make([]string, 0, len(result.Purged))
+	for _, task := range result.Purged {
+		purged = append(purged, task.TaskID)
+	}
+	if got, want := strings.Join(purged, ","), "old-child,old-free,old-parent"; got != want {
+		t.Fatalf("purged = %q, want %q", got, want)
+	}
+	// old-dep is referenced by a live task, and keeping it keeps the task it is blocked by.
+	if len(result.Kept) != 2 || result.Kept[0].TaskID != "old-blocker" || result.Kept[1].TaskID != "old-dep" {
+		t.Fatalf("unexpected kept tasks %#v", result.Kept)
+	}
+	if got := strings.Join(result.Kept[1].ReferencedBy, ","); got != "live" {
+		t.Fatalf("expected old-dep referenced by live, got %q", got)
+	}
+	for _, id := range []string{"old-dep", "old-blocker", "recent", "live"} {
+		if _, ok := repo.tasks[id]; !ok {
+			t.Fatalf("expected %s to survive the purge", id)
+		}
+	}
+
+	// Forcing purges the referenced tasks and strips the dangling dependency from the live task.
+	result, err = svc.PurgeArchivedBefore(context.Background(), cutoff, true)
+	if err != nil {
+		t.Fatalf("PurgeArchivedBefore(force) error = %v", err)
+	}
+	if len(result.Purged) != 2 || len(result.Kept) != 0 {
+		t.Fatalf("unexpected forced result %#v", result)
+	}
+	if deps := repo.tasks["live"].Metadata.DependsOn; len(deps) != 0 {
+		t.Fatalf("expected live task dependency stripped, got %#v", deps)
+	}
+	if _, ok := repo.tasks["recent"]; !ok {
+		t.Fatal("expected recently archived task to survive")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -17,8 +18,10 @@ const SnapshotVersion = "tillsyn.snapshot.v2"
 
 // Snapshot represents snapshot data used by this package.
 type Snapshot struct {
-	Version             string                        `json:"version"`
-	ExportedAt          time.Time                     `json:"exported_at"`
+	Version    string    `json:"version"`
+	ExportedAt time.Time `json:"exported_at"`
+	// ProjectSlug marks a single-project export and names the only project the snapshot carries.
+	ProjectSlug         string                        `json:"project_slug,omitempty"`
 	Projects            []SnapshotProject             `json:"projects"`
 	Columns             []SnapshotColumn              `json:"columns"`
 	Tasks               []SnapshotTask                `json:"tasks"`
@@ -135,6 +138,55 @@ type SnapshotCapabilityLease struct {
 
 // ExportSnapshot handles export snapshot.
 func (s *Service) ExportSnapshot(ctx context.Context, includeArchived bool) (Snapshot, error) {
+	return s.exportSnapshot(ctx, nil, includeArchived)
+}
+
+// ExportProjectsSnapshot exports only the listed projects, with the full kind catalog, as one importable snapshot.
+// Every id must name a project visible under includeArchived.
+func (s *Service) ExportProjectsSnapshot(ctx context.Context, projectIDs []string, includeArchived bool) (Snapshot, error) {
+	wanted := make(map[string]struct{}, len(projectIDs))
+	for _, projectID := range projectIDs {
+		if projectID = strings.TrimSpace(projectID); projectID != "" {
+			wanted[projectID] = struct{}{}
+		}
+	}
+	if len(wanted) == 0 {
+		return Snapshot{}, fmt.Errorf("%w: at least one project id is required", domain.ErrInvalidID)
+	}
+	return s.exportSnapshot(ctx, wanted, includeArchived)
+}
+
+// ExportProjectSnapshot exports the one project with the given slug as a standalone snapshot.
+// The snapshot records the slug so imports can tell it apart from a full export.
+func (s *Service) ExportProjectSnapshot(ctx context.Context, projectSlug string, includeArchived bool) (Snapshot, error) {
+	projectSlug = strings.TrimSpace(projectSlug)
+	if projectSlug == "" {
+		return Snapshot{}, fmt.Errorf("%w: project slug is required", domain.ErrInvalidID)
+	}
+	projects, err := s.repo.ListProjects(ctx, includeArchived)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	matches := slices.DeleteFunc(projects, func(project domain.Project) bool {
+		return project.Slug != projectSlug
+	})
+	switch len(matches) {
+	case 0:
+		return Snapshot{}, fmt.Errorf("%w: project %q", ErrNotFound, projectSlug)
+	case 1:
+	default:
+		return Snapshot{}, fmt.Errorf("%w: %q is shared by %d projects", ErrAmbiguousSlug, projectSlug, len(matches))
+	}
+	snap, err := s.exportSnapshot(ctx, map[string]struct{}{matches[0].ID: {}}, includeArchived)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	snap.ProjectSlug = projectSlug
+	return snap, nil
+}
+
+// exportSnapshot builds a snapshot of every project, or only those in wanted when it is non-nil.
+func (s *Service) exportSnapshot(ctx context.Context, wanted map[string]struct{}, includeArchived bool) (Snapshot, error) {
 	kindDefinitions, err := s.repo.ListKindDefinitions(ctx, includeArchived)
 	if err != nil {
 		return Snapshot{}, err
@@ -144,6 +196,26 @@ func (s *Service) ExportSnapshot(ctx context.Context, includeArchived bool) (Sna
 	if err != nil {
 		return Snapshot{}, err
 	}
+	if wanted != nil {
+		projects = slices.DeleteFunc(projects, func(project domain.Project) bool {
+			_, ok := wanted[project.ID]
+			return !ok
+		})
+		if len(projects) != len(wanted) {
+			found := make(map[string]struct{}, len(projects))
+			for _, project := range projects {
+				found[project.ID] = struct{}{}
+			}
+			missing := make([]string, 0, len(wanted)-len(projects))
+			for projectID := range wanted {
+				if _, ok := found[projectID]; !ok {
+					missing = append(missing, projectID)
+				}
+			}
+			slices.Sort(missing)
+			return Snapshot{}, fmt.Errorf("%w: project %s", ErrNotFound, strings.Join(missing, ", "))
+		}
+	}
 
 	snap := Snapshot{
 		Version:             SnapshotVersion,
@@ -160,6 +232,9 @@ func (s *Service) ExportSnapshot(ctx context.Context, includeArchived bool) (Sna
 		snap.KindDefinitions = append(snap.KindDefinitions, snapshotKindDefinitionFromDomain(kind))
 	}
 	for _, project := range projects {
+		if err := ctx.Err(); err != nil {
+			return Snapshot{}, err
+		}
 		snap.Projects = append(snap.Projects, snapshotProjectFromDomain(project))
 
 		allowedKinds, listErr := s.repo.ListProjectAllowedKinds(ctx, project.ID)
@@ -206,12 +281,133 @@ func (s *Service) ExportSnapshot(ctx context.Context, includeArchived bool) (Sna
 	return snap, nil
 }
 
+// ImportSummary reports what one snapshot import wrote, or would write under a dry run, and which references it dropped.
+type ImportSummary struct {
+	DryRun   bool
+	Projects ImportCounts
+	Columns  ImportCounts
+	Tasks    ImportCounts
+	// Comments are append-only, so comments already stored count as neither created nor updated.
+	Comments ImportCounts
+	// DroppedDependencies lists dependency references that named no task in the snapshot or the database.
+	DroppedDependencies []DroppedDependency
+	// DuplicateSlugs lists project slugs that more than one project would share after the import.
+	DuplicateSlugs []DuplicateSlug
+	// ProjectIDs lists the stored ids of the imported projects, after any merge matching.
+	ProjectIDs []string
+	// MatchedProjects lists snapshot projects a merge folded into stored projects with the same slug.
+	MatchedProjects []ProjectMatch
+}
+
+// ImportCounts splits the rows of one snapshot section into inserts and updates of stored rows.
+type ImportCounts struct {
+	Created int
+	Updated int
+}
+
+// DroppedDependency identifies one dangling dependency reference removed during import.
+type DroppedDependency struct {
+	TaskID string
+	// Field is the metadata list the reference came from: depends_on or blocked_by.
+	Field string
+	Ref   string
+}
+
 // ImportSnapshot handles import snapshot.
-func (s *Service) ImportSnapshot(ctx context.Context, snap Snapshot) error {
+// Dependency references that resolve to no snapshot or stored task are dropped and listed in the summary.
+// Under WithDryRun the snapshot is validated and summarized without writing anything.
+func (s *Service) ImportSnapshot(ctx context.Context, snap Snapshot) (ImportSummary, error) {
 	if err := snap.Validate(); err != nil {
-		return err
+		return ImportSummary{}, err
 	}
 	snap.sort()
+	return s.importValidatedSnapshot(ctx, snap, nil)
+}
+
+// importValidatedSnapshot prunes, plans, and writes one validated snapshot, honoring dry runs.
+func (s *Service) importValidatedSnapshot(ctx context.Context, snap Snapshot, matched []ProjectMatch) (ImportSummary, error) {
+	dropped, err := s.pruneDanglingDependencies(ctx, &snap)
+	if err != nil {
+		return ImportSummary{}, err
+	}
+	summary, err := s.planImport(ctx, snap)
+	if err != nil {
+		return ImportSummary{}, err
+	}
+	summary.DroppedDependencies = dropped
+	summary.MatchedProjects = matched
+	summary.ProjectIDs = make([]string, 0, len(snap.Projects))
+	for _, project := range snap.Projects {
+		summary.ProjectIDs = append(summary.ProjectIDs, project.ID)
+	}
+	if DryRunFromContext(ctx) {
+		summary.DryRun = true
+		return summary, nil
+	}
+	if err := s.importSnapshot(ctx, snap); err != nil {
+		return ImportSummary{}, err
+	}
+	return summary, nil
+}
+
+// pruneDanglingDependencies removes depends_on and blocked_by references that match no task in the snapshot or database.
+// References to stored tasks stay, so a partial snapshot can still point at work already on the board.
+func (s *Service) pruneDanglingDependencies(ctx context.Context, snap *Snapshot) ([]DroppedDependency, error) {
+	// Rewrites land in a copy so the caller's snapshot keeps its original references.
+	snap.Tasks = slices.Clone(snap.Tasks)
+	known := make(map[string]bool, len(snap.Tasks))
+	for _, task := range snap.Tasks {
+		known[task.ID] = true
+	}
+	resolves := func(ref string) (bool, error) {
+		if ok, checked := known[ref]; checked {
+			return ok, nil
+		}
+		_, err := s.repo.GetTask(ctx, ref)
+		switch {
+		case err == nil:
+			known[ref] = true
+		case errors.Is(err, ErrNotFound):
+			known[ref] = false
+		default:
+			return false, fmt.Errorf("resolve dependency %q: %w", ref, err)
+		}
+		return known[ref], nil
+	}
+	dropped := make([]DroppedDependency, 0)
+	for idx := range snap.Tasks {
+		task := &snap.Tasks[idx]
+		for _, list := range []struct {
+			field string
+			refs  *[]string
+		}{
+			{"depends_on", &task.Metadata.DependsOn},
+			{"blocked_by", &task.Metadata.BlockedBy},
+		} {
+			kept := make([]string, 0, len(*list.refs))
+			for _, ref := range *list.refs {
+				ok, err := resolves(ref)
+				if err != nil {
+					return nil, err
+				}
+				if !ok {
+					dropped = append(dropped, DroppedDependency{TaskID: task.ID, Field: list.field, Ref: ref})
+					continue
+				}
+				kept = append(kept, ref)
+			}
+			if len(kept) != len(*list.refs) {
+				*list.refs = kept
+			}
+		}
+	}
+	return dropped, nil
+}
+
+// importSnapshot writes one validated, sorted snapshot.
+func (s *Service) importSnapshot(ctx context.Context, snap Snapshot) error {
+	// Imports rewrite tasks across projects, so drop every cached rollup however the import ends.
+	defer s.invalidateDependencyRollup("")
 
 	for _, project := range snap.Projects {
 		if err := s.upsertProject(ctx, project.toDomain()); err != nil {
@@ -307,6 +503,11 @@ func (s *Snapshot) Validate() error {
 		}
 		projectIDs[p.ID] = struct{}{}
 	}
+	if slug := strings.TrimSpace(s.ProjectSlug); slug != "" {
+		if len(s.Projects) != 1 || s.Projects[0].Slug != slug {
+			return fmt.Errorf("single-project snapshot for %q must carry exactly that project", slug)
+		}
+	}
 
 	columnIDs := map[string]struct{}{}
 	for i, c := range s.Columns {
@@ -355,10 +556,10 @@ func (s *Snapshot) Validate() error {
 		if t.Position < 0 {
 			return fmt.Errorf("tasks[%d].position must be >= 0", i)
 		}
-		switch t.Priority {
-		case domain.PriorityLow, domain.PriorityMedium, domain.PriorityHigh:
-		default:
-			return fmt.Errorf("tasks[%d].priority must be low|medium|high", i)
+		// Snapshots from other versions may carry priorities this build lacks, so unknown values import as medium.
+		if !domain.IsValidPriority(t.Priority) {
+			t.Priority = domain.PriorityMedium
+			s.Tasks[i].Priority = t.Priority
 		}
 		if strings.TrimSpace(string(t.Kind)) == "" {
 			t.Kind = domain.WorkKindTask
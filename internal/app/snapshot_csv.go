@@ -0,0 +1,80 @@
+package app
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ExportFormat identifies one whole-snapshot export encoding.
+type ExportFormat string
+
+// ExportFormat values.
+const (
+	ExportFormatJSON     ExportFormat = "json"
+	ExportFormatCSV      ExportFormat = "csv"
+	ExportFormatMarkdown ExportFormat = "markdown"
+)
+
+// snapshotCSVHeader lists the task columns written by EncodeSnapshotCSV.
+var snapshotCSVHeader = []string{"id", "parent_id", "project", "column", "title", "priority", "labels", "due_at", "lifecycle_state"}
+
+// ParseExportFormat normalizes one snapshot export format name, defaulting to json.
+func ParseExportFormat(raw string) (ExportFormat, error) {
+	switch strings.TrimSpace(strings.ToLower(raw)) {
+	case "", "json":
+		return ExportFormatJSON, nil
+	case "csv":
+		return ExportFormatCSV, nil
+	case "markdown", "md":
+		return ExportFormatMarkdown, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrInvalidExportFormat, raw)
+	}
+}
+
+// EncodeSnapshotCSV renders one snapshot as a task table, one row per task in snapshot order.
+// Rows carry ids and parent ids so hierarchy survives the trip into a spreadsheet; CSV is export-only.
+func EncodeSnapshotCSV(snap Snapshot) ([]byte, error) {
+	projectSlugs := make(map[string]string, len(snap.Projects))
+	for _, project := range snap.Projects {
+		projectSlugs[project.ID] = project.Slug
+	}
+	columnNames := make(map[string]string, len(snap.Columns))
+	for _, column := range snap.Columns {
+		columnNames[column.ID] = column.Name
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(snapshotCSVHeader); err != nil {
+		return nil, fmt.Errorf("encode snapshot csv: %w", err)
+	}
+	for _, task := range snap.Tasks {
+		dueAt := ""
+		if task.DueAt != nil {
+			dueAt = task.DueAt.UTC().Format(time.RFC3339)
+		}
+		row := []string{
+			task.ID,
+			task.ParentID,
+			projectSlugs[task.ProjectID],
+			columnNames[task.ColumnID],
+			task.Title,
+			string(task.Priority),
+			strings.Join(task.Labels, ";"),
+			dueAt,
+			string(task.LifecycleState),
+		}
+		if err := w.Write(row); err != nil {
+			return nil, fmt.Errorf("encode snapshot csv: %w", err)
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, fmt.Errorf("encode snapshot csv: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,114 @@
+package app
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// EncodeSnapshotMarkdown renders one snapshot as a readable status page: a section per project, a subsection per
+// column, and a checkbox list of the column's tasks. Subtasks nest under their parent when both sit in the same
+// column; a subtask in another column is listed at the top level of its own column. Markdown is export-only.
+func EncodeSnapshotMarkdown(snap Snapshot) []byte {
+	projects := slices.Clone(snap.Projects)
+	slices.SortStableFunc(projects, func(a, b SnapshotProject) int {
+		return cmp.Or(cmp.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name)), cmp.Compare(a.ID, b.ID))
+	})
+	columnsByProject := map[string][]SnapshotColumn{}
+	for _, column := range snap.Columns {
+		columnsByProject[column.ProjectID] = append(columnsByProject[column.ProjectID], column)
+	}
+	tasksByColumn := map[string][]SnapshotTask{}
+	for _, task := range snap.Tasks {
+		tasksByColumn[task.ColumnID] = append(tasksByColumn[task.ColumnID], task)
+	}
+
+	var b strings.Builder
+	b.WriteString("# Board Snapshot\n")
+	if !snap.ExportedAt.IsZero() {
+		fmt.Fprintf(&b, "\nExported %s.\n", snap.ExportedAt.UTC().Format("2006-01-02 15:04 UTC"))
+	}
+	for _, project := range projects {
+		fmt.Fprintf(&b, "\n## %s%s\n", project.Name, archivedMarkdownSuffix(project.ArchivedAt != nil))
+		if description := strings.TrimSpace(project.Description); description != "" {
+			fmt.Fprintf(&b, "\n%s\n", description)
+		}
+		columns := columnsByProject[project.ID]
+		if len(columns) == 0 {
+			b.WriteString("\n_No columns._\n")
+		}
+		for _, column := range columns {
+			fmt.Fprintf(&b, "\n### %s%s\n\n", column.Name, archivedMarkdownSuffix(column.ArchivedAt != nil))
+			tasks := tasksByColumn[column.ID]
+			if len(tasks) == 0 {
+				b.WriteString("_No tasks._\n")
+				continue
+			}
+			writeMarkdownTaskList(&b, tasks)
+		}
+	}
+	return []byte(b.String())
+}
+
+// writeMarkdownTaskList writes one column's tasks as a nested checkbox list in board order.
+func writeMarkdownTaskList(b *strings.Builder, tasks []SnapshotTask) {
+	inColumn := make(map[string]struct{}, len(tasks))
+	for _, task := range tasks {
+		inColumn[task.ID] = struct{}{}
+	}
+	children := map[string][]SnapshotTask{}
+	for _, task := range tasks {
+		parentID := task.ParentID
+		if _, ok := inColumn[parentID]; !ok {
+			parentID = ""
+		}
+		children[parentID] = append(children[parentID], task)
+	}
+	for parentID := range children {
+		slices.SortFunc(children[parentID], func(a, b SnapshotTask) int {
+			return cmp.Or(cmp.Compare(a.Position, b.Position), cmp.Compare(a.ID, b.ID))
+		})
+	}
+	var write func(parentID string, depth int)
+	write = func(parentID string, depth int) {
+		for _, task := range children[parentID] {
+			mark := " "
+			if task.LifecycleState == domain.StateDone {
+				mark = "x"
+			}
+			fmt.Fprintf(b, "%s- [%s] %s%s\n", strings.Repeat("  ", depth), mark, task.Title, markdownTaskAnnotations(task))
+			write(task.ID, depth+1)
+		}
+	}
+	write("", 0)
+}
+
+// markdownTaskAnnotations renders the priority, due date, and archived marker that follow a task title.
+// Tasks without a priority, due date, or archive time get no annotation.
+func markdownTaskAnnotations(task SnapshotTask) string {
+	notes := make([]string, 0, 3)
+	if task.Priority != "" && task.Priority != domain.PriorityNone {
+		notes = append(notes, "priority: "+string(task.Priority))
+	}
+	if task.DueAt != nil {
+		notes = append(notes, "due: "+task.DueAt.UTC().Format("2006-01-02"))
+	}
+	if task.ArchivedAt != nil {
+		notes = append(notes, "archived")
+	}
+	if len(notes) == 0 {
+		return ""
+	}
+	return " _(" + strings.Join(notes, ", ") + ")_"
+}
+
+// archivedMarkdownSuffix marks archived project and column headings.
+func archivedMarkdownSuffix(archived bool) string {
+	if archived {
+		return " (archived)"
+	}
+	return ""
+}
@@ -0,0 +1,142 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// ErrInvalidSnapshot reports a snapshot payload that does not match the snapshot schema.
+var ErrInvalidSnapshot = errors.New("snapshot does not match schema")
+
+// jsonSchemaDraft identifies the JSON Schema dialect SnapshotJSONSchema emits.
+const jsonSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+// Reflected types with dedicated schema encodings.
+var (
+	timeType       = reflect.TypeFor[time.Time]()
+	rawMessageType = reflect.TypeFor[json.RawMessage]()
+)
+
+// SnapshotJSONSchema returns the JSON Schema for snapshot payloads, derived from the Snapshot struct and its json tags.
+// Fields without omitempty are required; nil-able Go types (pointers, slices, maps) also accept null.
+func SnapshotJSONSchema() ([]byte, error) {
+	schema := jsonSchemaForType(reflect.TypeFor[Snapshot](), map[reflect.Type]bool{})
+	schema["$schema"] = jsonSchemaDraft
+	schema["title"] = "tillsyn snapshot"
+	schema["description"] = "Payload written by `till export` and read by `till import`."
+	// Import only accepts the canonical version, so advertise it as the single allowed value.
+	if props, ok := schema["properties"].(map[string]any); ok {
+		props["version"] = map[string]any{"type": "string", "enum": []any{SnapshotVersion}}
+	}
+	out, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("encode snapshot schema: %w", err)
+	}
+	return append(out, '\n'), nil
+}
+
+// ValidateSnapshotJSON checks raw snapshot JSON against SnapshotJSONSchema before it is decoded.
+func ValidateSnapshotJSON(content []byte) error {
+	schema, err := SnapshotJSONSchema()
+	if err != nil {
+		return err
+	}
+	validator, err := compileJSONSchema(string(schema))
+	if err != nil {
+		return fmt.Errorf("compile snapshot schema: %w", err)
+	}
+	if err := validator.ValidatePayload(content); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidSnapshot, err)
+	}
+	return nil
+}
+
+// jsonSchemaForType builds the schema node for one Go type as encoding/json marshals it.
+// visiting guards recursive types, which fall back to an unconstrained node.
+func jsonSchemaForType(t reflect.Type, visiting map[reflect.Type]bool) map[string]any {
+	switch {
+	case t == timeType:
+		return map[string]any{"type": "string", "format": "date-time"}
+	case t == rawMessageType:
+		return map[string]any{"description": "arbitrary JSON"}
+	}
+	switch t.Kind() {
+	case reflect.Pointer:
+		return nullableJSONSchema(jsonSchemaForType(t.Elem(), visiting))
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return map[string]any{"type": "string", "contentEncoding": "base64"}
+		}
+		node := map[string]any{"type": "array", "items": jsonSchemaForType(t.Elem(), visiting)}
+		if t.Kind() == reflect.Slice {
+			return nullableJSONSchema(node)
+		}
+		return node
+	case reflect.Map:
+		return nullableJSONSchema(map[string]any{"type": "object"})
+	case reflect.Struct:
+		if visiting[t] {
+			return map[string]any{}
+		}
+		visiting[t] = true
+		defer delete(visiting, t)
+		props := map[string]any{}
+		required := []any{}
+		addStructJSONSchemaFields(t, visiting, props, &required)
+		return map[string]any{
+			"type":                 "object",
+			"properties":           props,
+			"required":             required,
+			"additionalProperties": false,
+		}
+	default:
+		return map[string]any{}
+	}
+}
+
+// addStructJSONSchemaFields adds one struct's json fields to props, flattening untagged embedded structs.
+func addStructJSONSchemaFields(t reflect.Type, visiting map[reflect.Type]bool, props map[string]any, required *[]any) {
+	for idx := range t.NumField() {
+		field := t.Field(idx)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			addStructJSONSchemaFields(field.Type, visiting, props, required)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		props[name] = jsonSchemaForType(field.Type, visiting)
+		if !strings.Contains(","+opts+",", ",omitempty,") {
+			*required = append(*required, name)
+		}
+	}
+}
+
+// nullableJSONSchema widens one typed node to also accept null.
+func nullableJSONSchema(node map[string]any) map[string]any {
+	if typ, ok := node["type"].(string); ok {
+		node["type"] = []any{typ, "null"}
+	}
+	return node
+}
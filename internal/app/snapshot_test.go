@@ -1,8 +1,14 @@
 package app
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"reflect"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -128,6 +134,190 @@ func TestExportSnapshotIncludesExpectedData(t *testing.T) {
 	}
 }
 
+// TestExportProjectsSnapshotScopesToSelection verifies scoped exports carry only the chosen projects and reject unknown ids.
+func TestExportProjectsSnapshotScopesToSelection(t *testing.T) {
+	repo := newFakeRepo()
+	now := time.Date(2026, 2, 22, 10, 0, 0, 0, time.UTC)
+	for _, id := range []string{"p1", "p2", "p3"} {
+		project, _ := domain.NewProject(id, "Project "+id, "", now)
+		repo.projects[project.ID] = project
+		column, _ := domain.NewColumn("c-"+id, project.ID, "To Do", 0, 0, now)
+		repo.columns[column.ID] = column
+		task, _ := domain.NewTask(domain.TaskInput{ID: "t-" + id, ProjectID: project.ID, ColumnID: column.ID, Title: "Task " + id, Priority: domain.PriorityLow}, now)
+		repo.tasks[task.ID] = task
+	}
+	svc := NewService(repo, nil, func() time.Time { return now }, ServiceConfig{})
+
+	snap, err := svc.ExportProjectsSnapshot(context.Background(), []string{"p3", "p1", " p1 "}, true)
+	if err != nil {
+		t.Fatalf("ExportProjectsSnapshot() error = %v", err)
+	}
+	if len(snap.Projects) != 2 || snap.Projects[0].ID != "p1" || snap.Projects[1].ID != "p3" {
+		t.Fatalf("expected sorted p1 and p3 only, got %#v", snap.Projects)
+	}
+	if len(snap.Columns) != 2 || len(snap.Tasks) != 2 {
+		t.Fatalf("expected selected columns and tasks only, got c=%d t=%d", len(snap.Columns), len(snap.Tasks))
+	}
+	for _, task := range snap.Tasks {
+		if task.ProjectID == "p2" {
+			t.Fatalf("expected unselected project tasks left out, got %#v", task)
+		}
+	}
+	if err := snap.Validate(); err != nil {
+		t.Fatalf("expected scoped snapshot to stay importable, got %v", err)
+	}
+
+	if _, err := svc.ExportProjectsSnapshot(context.Background(), []string{"p1", "missing"}, true); !errors.Is(err, ErrNotFound) || !strings.Contains(err.Error(), "missing") {
+		t.Fatalf("expected ErrNotFound naming the missing project, got %v", err)
+	}
+	if _, err := svc.ExportProjectsSnapshot(context.Background(), nil, true); !errors.Is(err, domain.ErrInvalidID) {
+		t.Fatalf("expected ErrInvalidID for an empty selection, got %v", err)
+	}
+}
+
+// TestExportProjectSnapshotBySlug verifies single-project exports resolve one slug, mark the snapshot, and reject bad slugs.
+func TestExportProjectSnapshotBySlug(t *testing.T) {
+	repo := newFakeRepo()
+	now := time.Date(2026, 2, 22, 10, 0, 0, 0, time.UTC)
+	for _, id := range []string{"p1", "p2"} {
+		project, _ := domain.NewProject(id, "Project "+id, "", now)
+		repo.projects[project.ID] = project
+		column, _ := domain.NewColumn("c-"+id, project.ID, "To Do", 0, 0, now)
+		repo.columns[column.ID] = column
+		task, _ := domain.NewTask(domain.TaskInput{ID: "t-" + id, ProjectID: project.ID, ColumnID: column.ID, Title: "Task " + id, Priority: domain.PriorityLow}, now)
+		repo.tasks[task.ID] = task
+	}
+	svc := NewService(repo, nil, func() time.Time { return now }, ServiceConfig{})
+	slug := repo.projects["p2"].Slug
+
+	snap, err := svc.ExportProjectSnapshot(context.Background(), " "+slug+" ", false)
+	if err != nil {
+		t.Fatalf("ExportProjectSnapshot() error = %v", err)
+	}
+	if snap.ProjectSlug != slug || len(snap.Projects) != 1 || snap.Projects[0].ID != "p2" {
+		t.Fatalf("expected only p2 marked with its slug, got slug %q projects %#v", snap.ProjectSlug, snap.Projects)
+	}
+	if len(snap.Tasks) != 1 || snap.Tasks[0].ID != "t-p2" {
+		t.Fatalf("expected only p2 tasks, got %#v", snap.Tasks)
+	}
+	if err := snap.Validate(); err != nil {
+		t.Fatalf("expected single-project snapshot to validate, got %v", err)
+	}
+
+	// A marked snapshot that carries other projects is rejected on import.
+	tampered := snap
+	tampered.Projects = append(slices.Clone(snap.Projects), SnapshotProject{ID: "p9", Slug: "extra", Name: "Extra", CreatedAt: now, UpdatedAt: now})
+	if err := tampered.Validate(); err == nil {
+		t.Fatal("expected validation to reject a single-project snapshot with extra projects")
+	}
+
+	if _, err := svc.ExportProjectSnapshot(context.Background(), "missing", false); !errors.Is(err, ErrNotFound) || !strings.Contains(err.Error(), "missing") {
+		t.Fatalf("expected ErrNotFound naming the slug, got %v", err)
+	}
+	if _, err := svc.ExportProjectSnapshot(context.Background(), " ", false); !errors.Is(err, domain.ErrInvalidID) {
+		t.Fatalf("expected ErrInvalidID for an empty slug, got %v", err)
+	}
+	shared := repo.projects["p1"]
+	shared.Slug = slug
+	repo.projects["p1"] = shared
+	if _, err := svc.ExportProjectSnapshot(context.Background(), slug, false); !errors.Is(err, ErrAmbiguousSlug) {
+		t.Fatalf("expected ErrAmbiguousSlug for a shared slug, got %v", err)
+	}
+}
+
+// TestExportTaskCardScopesToTaskSubtree verifies single-task exports carry only the task, its subtasks, and their context.
+func TestExportTaskCardScopesToTaskSubtree(t *testing.T) {
+	repo := newFakeRepo()
+	now := time.Date(2026, 2, 22, 10, 0, 0, 0, time.UTC)
+	p1, _ := domain.NewProject("p1", "Alpha", "", now)
+	repo.projects[p1.ID] = p1
+	c1, _ := domain.NewColumn("c1", p1.ID, "To Do", 0, 0, now)
+	c2, _ := domain.NewColumn("c2", p1.ID, "Done", 1, 0, now)
+	c3, _ := domain.NewColumn("c3", p1.ID, "Review", 2, 0, now)
+	repo.columns[c1.ID] = c1
+	repo.columns[c2.ID] = c2
+	repo.columns[c3.ID] = c3
+
+	root, _ := domain.NewTask(domain.TaskInput{ID: "root", ProjectID: p1.ID, ColumnID: c1.ID, Title: "Ship card", Description: "Share this one thing.", Priority: domain.PriorityHigh}, now)
+	root.Metadata.DependsOn = []string{"other"}
+	root.Metadata.ResourceRefs = []domain.ResourceRef{{ID: "r1", ResourceType: domain.ResourceTypeLocalFile, Location: "docs/card.md", Title: "Spec"}}
+	child, _ := domain.NewTask(domain.TaskInput{ID: "child", ProjectID: p1.ID, ParentID: root.ID, ColumnID: c2.ID, Title: "Write encoder", Priority: domain.PriorityLow}, now)
+	child.LifecycleState = domain.StateDone
+	archivedChild, _ := domain.NewTask(domain.TaskInput{ID: "gone", ProjectID: p1.ID, ParentID: root.ID, ColumnID: c2.ID, Position: 1, Title: "Dropped", Priority: domain.PriorityLow}, now)
+	archivedChild.Archive(now)
+	other, _ := domain.NewTask(domain.TaskInput{ID: "other", ProjectID: p1.ID, ColumnID: c3.ID, Title: "Upstream work", Priority: domain.PriorityMedium}, now)
+	for _, task := range []domain.Task{root, child, archivedChild, other} {
+		repo.tasks[task.ID] = task
+	}
+	taskComment, err := domain.NewComment(domain.CommentInput{
+		ID:           "comment-1",
+		ProjectID:    p1.ID,
+		TargetType:   domain.CommentTargetTypeTask,
+		TargetID:     root.ID,
+		BodyMarkdown: "Looks good",
+		ActorID:      "tester",
+		ActorName:    "tester",
+		ActorType:    domain.ActorTypeUser,
+	}, now)
+	if err != nil {
+		t.Fatalf("NewComment() error = %v", err)
+	}
+	repo.comments[p1.ID+"|"+string(domain.CommentTargetTypeTask)+"|"+root.ID] = []domain.Comment{taskComment}
+	svc := NewService(repo, nil, func() time.Time { return now }, ServiceConfig{})
+
+	snap, err := svc.ExportTaskSnapshot(context.Background(), root.ID, false)
+	if err != nil {
+		t.Fatalf("ExportTaskSnapshot() error = %v", err)
+	}
+	if len(snap.Tasks) != 1 || snap.Tasks[0].ID != root.ID || len(snap.Columns) != 1 || snap.Columns[0].ID != c1.ID {
+		t.Fatalf("expected only the root task and its column, got tasks=%#v columns=%#v", snap.Tasks, snap.Columns)
+	}
+	if len(snap.Comments) != 1 || snap.Comments[0].ID != taskComment.ID {
+		t.Fatalf("expected the task comment, got %#v", snap.Comments)
+	}
+
+	// Archived subtasks stay behind while the root is active.
+	snap, err = svc.ExportTaskSnapshot(context.Background(), root.ID, true)
+	if err != nil {
+		t.Fatalf("ExportTaskSnapshot(subtasks) error = %v", err)
+	}
+	if len(snap.Tasks) != 2 || len(snap.Columns) != 2 {
+		t.Fatalf("expected root and active child in two columns, got tasks=%d columns=%d", len(snap.Tasks), len(snap.Columns))
+	}
+
+	card, err := svc.ExportTaskCard(context.Background(), ExportTaskCardInput{TaskID: root.ID, IncludeSubtasks: true})
+	if err != nil {
+		t.Fatalf("ExportTaskCard(markdown) error = %v", err)
+	}
+	for _, want := range []string{
+		"# Ship card",
+		"- ID: `root`",
+		"- Column: To Do",
+		"Share this one thing.",
+		"- Depends on: Upstream work (`other`)",
+		"- Spec: `docs/card.md`",
+		"- [x] Write encoder (`child`)",
+	} {
+		if !strings.Contains(string(card), want) {
+			t.Fatalf("expected markdown card to contain %q, got\n%s", want, card)
+		}
+	}
+	if strings.Contains(string(card), "Dropped") {
+		t.Fatalf("expected archived subtask omitted, got\n%s", card)
+	}
+
+	jsonCard, err := svc.ExportTaskCard(context.Background(), ExportTaskCardInput{TaskID: root.ID, Format: TaskCardFormatJSON})
+	if err != nil {
+		t.Fatalf("ExportTaskCard(json) error = %v", err)
+	}
+	if !strings.Contains(string(jsonCard), `"version": "`+SnapshotVersion+`"`) || !strings.Contains(string(jsonCard), `"location": "docs/card.md"`) {
+		t.Fatalf("expected snapshot-encoded json card, got\n%s", jsonCard)
+	}
+	if _, err := svc.ExportTaskCard(context.Background(), ExportTaskCardInput{TaskID: root.ID, Format: "yaml"}); !errors.Is(err, ErrInvalidCardFormat) {
+		t.Fatalf("expected ErrInvalidCardFormat, got %v", err)
+	}
+}
+
 // TestImportSnapshotCreatesAndUpdates verifies behavior for the covered scenario.
 func TestImportSnapshotCreatesAndUpdates(t *testing.T) {
 	repo := newFakeRepo()
@@ -200,7 +390,7 @@ func TestImportSnapshotCreatesAndUpdates(t *testing.T) {
 		},
 	}
 
-	if err := svc.ImportSnapshot(context.Background(), snap); err != nil {
+	if _, err := svc.ImportSnapshot(context.Background(), snap); err != nil {
 		t.Fatalf("ImportSnapshot() error = %v", err)
 	}
 
@@ -247,17 +437,199 @@ func TestImportSnapshotCreatesAndUpdates(t *testing.T) {
 	}
 }
 
+// trelloTestExport is a trimmed Trello board export covering lists, cards, labels, checklists, and dropped fields.
+const trelloTestExport = `{
+  "name": "Launch Plan",
+  "desc": "From Trello",
+  "customFields": [{"id": "cf1"}],
+  "lists": [
+    {"id": "l-done", "name": "Done", "pos": 3},
+    {"id": "l-todo", "name": "To Do", "pos": 1},
+    {"id": "l-old", "name": "Icebox", "closed": true, "pos": 9}
+  ],
+  "cards": [
+    {"id": "5f1a2b3c0000000000000001", "name": "Write copy", "desc": "Hero text", "idList": "l-todo", "pos": 2,
+     "due": "2026-03-10T17:00:00.000Z", "labels": [{"name": "Marketing", "color": "green"}, {"name": "", "color": "red"}],
+     "idMembers": ["m1"], "attachments": [{"id": "a1"}, {"id": "a2"}]},
+    {"id": "card-2", "name": "Book venue", "idList": "l-todo", "pos": 1},
+    {"id": "card-3", "name": "Kickoff", "idList": "l-done", "pos": 1},
+    {"id": "card-4", "name": "Old idea", "idList": "l-old", "pos": 1},
+    {"id": "card-5", "name": "Lost card", "idList": "l-gone", "pos": 1}
+  ],
+  "checklists": [
+    {"id": "cl1", "name": "Drafts", "idCard": "5f1a2b3c0000000000000001", "pos": 1, "checkItems": [
+      {"id": "i2", "name": "Second draft", "state": "incomplete", "pos": 2},
+      {"id": "i1", "name": "First draft", "state": "complete", "pos": 1}
+    ]}
+  ],
+  "actions": [{"type": "commentCard"}, {"type": "updateCard"}]
+}`
+
+// TestSnapshotFromTrello verifies the Trello converter maps lists, cards, labels, and checklists and notes dropped fields.
+func TestSnapshotFromTrello(t *testing.T) {
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	next := 0
+	idGen := func() string {
+		next++
+		return fmt.Sprintf("id-%d", next)
+	}
+	converted, err := SnapshotFromTrello([]byte(trelloTestExport), idGen, now)
+	if err != nil {
+		t.Fatalf("SnapshotFromTrello() error = %v", err)
+	}
+	snap := converted.Snapshot
+	if err := snap.Validate(); err != nil {
+		t.Fatalf("converted snapshot Validate() error = %v", err)
+	}
+	if len(snap.Projects) != 1 || snap.Projects[0].Slug != "launch-plan" || snap.Projects[0].Description != "From Trello" {
+		t.Fatalf("unexpected project %#v", snap.Projects)
+	}
+	columnNames := make([]string, 0, len(snap.Columns))
+	columnByName := map[string]SnapshotColumn{}
+	for _, column := range snap.Columns {
+		columnNames = append(columnNames, column.Name)
+		columnByName[column.Name] = column
+	}
+	// Lists keep Trello's pos order, and a closed list becomes an archived column.
+	if !slices.Equal(columnNames, []string{"To Do", "Done", "Icebox"}) || columnByName["Icebox"].ArchivedAt == nil {
+		t.Fatalf("unexpected columns %#v", snap.Columns)
+	}
+
+	tasks := map[string]SnapshotTask{}
+	for _, task := range snap.Tasks {
+		tasks[task.Title] = task
+	}
+	if len(tasks) != 6 {
+		t.Fatalf("expected 4 cards and 2 checklist items, got %#v", snap.Tasks)
+	}
+	copyTask := tasks["Write copy"]
+	if copyTask.ColumnID != columnByName["To Do"].ID || copyTask.Position != 1 || tasks["Book venue"].Position != 0 {
+		t.Fatalf("expected cards ordered by pos within To Do, got copy=%#v venue=%#v", copyTask, tasks["Book venue"])
+	}
+	if !slices.Equal(copyTask.Labels, []string{"marketing", "red"}) || copyTask.DueAt == nil || copyTask.Description != "Hero text" {
+		t.Fatalf("unexpected card fields %#v", copyTask)
+	}
+	// The card id's leading hex digits are its creation time.
+	if want := time.Unix(0x5f1a2b3c, 0).UTC(); !copyTask.CreatedAt.Equal(want) {
+		t.Fatalf("expected created_at %v from the card id, got %v", want, copyTask.CreatedAt)
+	}
+	if tasks["Kickoff"].LifecycleState != domain.StateDone || tasks["Old idea"].ArchivedAt == nil {
+		t.Fatalf("expected done-column and closed-list states, got kickoff=%q old=%v", tasks["Kickoff"].LifecycleState, tasks["Old idea"].ArchivedAt)
+	}
+	first, second := tasks["First draft"], tasks["Second draft"]
+	if first.ParentID != copyTask.ID || first.Kind != domain.WorkKindSubtask || first.LifecycleState != domain.StateDone {
+		t.Fatalf("expected checked item as done subtask of its card, got %#v", first)
+	}
+	if second.ParentID != copyTask.ID || second.LifecycleState != domain.StateTodo || second.Position <= first.Position {
+		t.Fatalf("expected unchecked item after the first as todo subtask, got %#v", second)
+	}
+
+	wantDropped := []string{
+		"dropped 1 cards whose list is missing from the export",
+		"dropped 1 card comments",
+		"dropped 2 card attachments",
+		"dropped 1 card member assignments",
+		"dropped 1 custom field definitions",
+	}
+	if !slices.Equal(converted.Dropped, wantDropped) {
+		t.Fatalf("expected dropped notes %#v, got %#v", wantDropped, converted.Dropped)
+	}
+
+	if _, err := SnapshotFromTrello([]byte("not json"), idGen, now); err == nil {
+		t.Fatal("expected malformed export to fail")
+	}
+}
+
+// TestSnapshotFromGitHubIssues verifies the GitHub converter maps issue state, milestones, labels, and assignees
+// from both REST and gh CLI field spellings.
+func TestSnapshotFromGitHubIssues(t *testing.T) {
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	next := 0
+	idGen := func() string {
+		next++
+		return fmt.Sprintf("id-%d", next)
+	}
+	export := `[
+	  {"number": 2, "title": "Fix crash", "body": "Stack trace", "state": "closed",
+	   "labels": [{"name": "Bug"}], "milestone": {"title": "v1.0", "due_on": "2026-04-01T00:00:00Z"},
+	   "assignees": [{"login": "ana"}, {"login": "bo"}],
+	   "created_at": "2026-01-05T10:00:00Z", "closed_at": "2026-01-09T10:00:00Z", "comments": 3,
+	   "html_url": "https://github.com/acme/widget/issues/2", "url": "https://api.github.com/repos/acme/widget/issues/2",
+	   "repository_url": "https://api.github.com/repos/acme/widget"},
+	  {"number": 1, "title": "Add docs", "state": "OPEN", "milestone": {"title": "v1.0"},
+	   "createdAt": "2026-01-02T10:00:00Z", "comments": [{"body": "+1"}], "url": "https://github.com/acme/widget/issues/1"},
+	  {"number": 3, "title": "Bump deps", "state": "open", "pull_request": {"url": "x"}},
+	  {"number": 4, "title": "Loose end", "state": "closed"}
+	]`
+	converted, err := SnapshotFromGitHubIssues([]byte(export), idGen, now)
+	if err != nil {
+		t.Fatalf("SnapshotFromGitHubIssues() error = %v", err)
+	}
+	snap := converted.Snapshot
+	if err := snap.Validate(); err != nil {
+		t.Fatalf("converted snapshot Validate() error = %v", err)
+	}
+	if len(snap.Projects) != 1 || snap.Projects[0].Name != "acme/widget" || snap.Projects[0].Slug != "acme-widget" {
+		t.Fatalf("expected project named after the repository, got %#v", snap.Projects)
+	}
+	columnByID := map[string]string{}
+	for _, column := range snap.Columns {
+		columnByID[column.ID] = column.Name
+	}
+	tasks := map[string]SnapshotTask{}
+	for _, task := range snap.Tasks {
+		tasks[task.Title] = task
+	}
+	if len(tasks) != 4 {
+		t.Fatalf("expected one milestone branch and three issues, got %#v", snap.Tasks)
+	}
+	// One issue in the milestone is still open, so the branch stays in To Do.
+	branch := tasks["v1.0"]
+	if branch.Kind != domain.WorkKind("branch") || columnByID[branch.ColumnID] != "To Do" || branch.DueAt == nil {
+		t.Fatalf("unexpected milestone branch %#v", branch)
+	}
+	crash := tasks["Fix crash"]
+	if crash.ParentID != branch.ID || columnByID[crash.ColumnID] != "Done" || crash.LifecycleState != domain.StateDone {
+		t.Fatalf("expected closed issue done under its milestone, got %#v", crash)
+	}
+	if crash.CompletedAt == nil || !crash.CompletedAt.Equal(time.Date(2026, 1, 9, 10, 0, 0, 0, time.UTC)) {
+		t.Fatalf("expected completion at the issue close time, got %v", crash.CompletedAt)
+	}
+	if crash.Description != "Stack trace" || !slices.Equal(crash.Labels, []string{"bug"}) || crash.Metadata.Assignee != "ana" {
+		t.Fatalf("unexpected issue fields %#v", crash)
+	}
+	refs := crash.Metadata.ResourceRefs
+	if len(refs) != 1 || refs[0].ResourceType != domain.ResourceTypeTicket || refs[0].Location != "https://github.com/acme/widget/issues/2" || refs[0].Title != "acme/widget#2" {
+		t.Fatalf("expected ticket ref to the issue page, got %#v", refs)
+	}
+	docs := tasks["Add docs"]
+	if docs.ParentID != branch.ID || columnByID[docs.ColumnID] != "To Do" || !docs.CreatedAt.Equal(time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)) {
+		t.Fatalf("expected gh-style open issue in To Do, got %#v", docs)
+	}
+	if loose := tasks["Loose end"]; loose.ParentID != "" || columnByID[loose.ColumnID] != "Done" {
+		t.Fatalf("expected issue without milestone at the root of Done, got %#v", loose)
+	}
+	wantDropped := []string{
+		"dropped 1 pull requests",
+		"dropped 4 issue comments",
+		"dropped 1 additional assignees beyond each issue's first",
+	}
+	if !slices.Equal(converted.Dropped, wantDropped) {
+		t.Fatalf("expected dropped notes %#v, got %#v", wantDropped, converted.Dropped)
+	}
+}
+
 // TestImportSnapshotValidateErrors verifies behavior for the covered scenario.
 func TestImportSnapshotValidateErrors(t *testing.T) {
 	repo := newFakeRepo()
 	svc := NewService(repo, nil, time.Now, ServiceConfig{})
 
 	badVersion := Snapshot{Version: "tillsyn.snapshot.v999"}
-	if err := svc.ImportSnapshot(context.Background(), badVersion); err == nil {
+	if _, err := svc.ImportSnapshot(context.Background(), badVersion); err == nil {
 		t.Fatal("expected version validation error")
 	}
 	missingVersion := Snapshot{}
-	if err := svc.ImportSnapshot(context.Background(), missingVersion); err == nil {
+	if _, err := svc.ImportSnapshot(context.Background(), missingVersion); err == nil {
 		t.Fatal("expected missing version validation error")
 	}
 
@@ -267,7 +639,7 @@ func TestImportSnapshotValidateErrors(t *testing.T) {
 		Projects: []SnapshotProject{{ID: "p1", Name: "A", Slug: "a", CreatedAt: now, UpdatedAt: now}},
 		Columns:  []SnapshotColumn{{ID: "c1", ProjectID: "missing", Name: "To Do", Position: 0, CreatedAt: now, UpdatedAt: now}},
 	}
-	if err := svc.ImportSnapshot(context.Background(), badRefs); err == nil {
+	if _, err := svc.ImportSnapshot(context.Background(), badRefs); err == nil {
 		t.Fatal("expected reference validation error")
 	}
 
@@ -284,7 +656,7 @@ func TestImportSnapshotValidateErrors(t *testing.T) {
 			{ID: "p1", ProjectID: "p1", ParentID: "t1", Kind: domain.WorkKindPhase, Scope: domain.KindAppliesToPhase, ColumnID: "c1", Position: 1, Title: "Phase", Priority: domain.PriorityMedium, CreatedAt: now, UpdatedAt: now},
 		},
 	}
-	if err := svc.ImportSnapshot(context.Background(), invalidPhaseParent); err == nil || !strings.Contains(err.Error(), "invalid for phase parent scope") {
+	if _, err := svc.ImportSnapshot(context.Background(), invalidPhaseParent); err == nil || !strings.Contains(err.Error(), "invalid for phase parent scope") {
 		t.Fatalf("expected invalid phase parent error, got %v", err)
 	}
 
@@ -302,11 +674,43 @@ func TestImportSnapshotValidateErrors(t *testing.T) {
 			{ID: "phase-2", ProjectID: "p2", ParentID: "phase-1", Kind: domain.WorkKindPhase, Scope: domain.KindAppliesToPhase, ColumnID: "c2", Position: 2, Title: "Nested Phase", Priority: domain.PriorityMedium, CreatedAt: now, UpdatedAt: now},
 		},
 	}
-	if err := svc.ImportSnapshot(context.Background(), validNestedPhase); err != nil {
+	if _, err := svc.ImportSnapshot(context.Background(), validNestedPhase); err != nil {
 		t.Fatalf("expected valid nested phase lineage to import, got %v", err)
 	}
 }
 
+// TestImportSnapshotDefaultsUnknownPriorities verifies new priorities round-trip and unknown or missing ones import as medium.
+func TestImportSnapshotDefaultsUnknownPriorities(t *testing.T) {
+	repo := newFakeRepo()
+	svc := NewService(repo, nil, time.Now, ServiceConfig{})
+	now := time.Date(2026, 2, 22, 10, 0, 0, 0, time.UTC)
+	snap := Snapshot{
+		Version:  SnapshotVersion,
+		Projects: []SnapshotProject{{ID: "p1", Name: "A", Slug: "a", CreatedAt: now, UpdatedAt: now}},
+		Columns:  []SnapshotColumn{{ID: "c1", ProjectID: "p1", Name: "To Do", CreatedAt: now, UpdatedAt: now}},
+		Tasks: []SnapshotTask{
+			{ID: "t-critical", ProjectID: "p1", ColumnID: "c1", Title: "Critical", Priority: domain.PriorityCritical, CreatedAt: now, UpdatedAt: now},
+			{ID: "t-none", ProjectID: "p1", ColumnID: "c1", Position: 1, Title: "None", Priority: domain.PriorityNone, CreatedAt: now, UpdatedAt: now},
+			{ID: "t-urgent", ProjectID: "p1", ColumnID: "c1", Position: 2, Title: "Urgent", Priority: domain.Priority("urgent"), CreatedAt: now, UpdatedAt: now},
+			{ID: "t-blank", ProjectID: "p1", ColumnID: "c1", Position: 3, Title: "Blank", CreatedAt: now, UpdatedAt: now},
+		},
+	}
+	if _, err := svc.ImportSnapshot(context.Background(), snap); err != nil {
+		t.Fatalf("ImportSnapshot() error = %v", err)
+	}
+	want := map[string]domain.Priority{
+		"t-critical": domain.PriorityCritical,
+		"t-none":     domain.PriorityNone,
+		"t-urgent":   domain.PriorityMedium,
+		"t-blank":    domain.PriorityMedium,
+	}
+	for id, priority := range want {
+		if got := repo.tasks[id].Priority; got != priority {
+			t.Fatalf("task %s priority = %q, want %q", id, got, priority)
+		}
+	}
+}
+
 // failingSnapshotRepo represents failing snapshot repo data used by this package.
 type failingSnapshotRepo struct {
 	*fakeRepo
@@ -318,6 +722,367 @@ func (f failingSnapshotRepo) ListProjects(context.Context, bool) ([]domain.Proje
 	return nil, f.err
 }
 
+// TestImportSnapshotDropsDanglingDependencies verifies unresolved dependency references are dropped and reported.
+func TestImportSnapshotDropsDanglingDependencies(t *testing.T) {
+	repo := newFakeRepo()
+	now := time.Date(2026, 2, 22, 10, 0, 0, 0, time.UTC)
+	storedProject, _ := domain.NewProject("p-stored", "Stored", "", now)
+	storedCol, _ := domain.NewColumn("c-stored", storedProject.ID, "To Do", 0, 0, now)
+	storedTask, _ := domain.NewTask(domain.TaskInput{ID: "t-stored", ProjectID: storedProject.ID, ColumnID: storedCol.ID, Title: "Stored", Priority: domain.PriorityLow}, now)
+	repo.projects[storedProject.ID] = storedProject
+	repo.columns[storedCol.ID] = storedCol
+	repo.tasks[storedTask.ID] = storedTask
+
+	svc := NewService(repo, nil, func() time.Time { return now }, ServiceConfig{})
+	snap := Snapshot{
+		Version:  SnapshotVersion,
+		Projects: []SnapshotProject{{ID: "p1", Name: "Imported", Slug: "imported", CreatedAt: now, UpdatedAt: now}},
+		Columns:  []SnapshotColumn{{ID: "c1", ProjectID: "p1", Name: "To Do", CreatedAt: now, UpdatedAt: now}},
+		Tasks: []SnapshotTask{
+			{ID: "t1", ProjectID: "p1", ColumnID: "c1", Title: "First", Priority: domain.PriorityMedium, CreatedAt: now, UpdatedAt: now},
+			{
+				ID:        "t2",
+				ProjectID: "p1",
+				ColumnID:  "c1",
+				Position:  1,
+				Title:     "Second",
+				Priority:  domain.PriorityMedium,
+				// t1 lives in the snapshot and t-stored in the database; only the t-gone references dangle.
+				Metadata:  domain.TaskMetadata{DependsOn: []string{"t1", "t-gone"}, BlockedBy: []string{"t-stored", "t-gone"}},
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+		},
+	}
+
+	summary, err := svc.ImportSnapshot(context.Background(), snap)
+	if err != nil {
+		t.Fatalf("ImportSnapshot() error = %v", err)
+	}
+	if summary.Projects != (ImportCounts{Created: 1}) || summary.Columns != (ImportCounts{Created: 1}) || summary.Tasks != (ImportCounts{Created: 2}) {
+		t.Fatalf("unexpected import counts %#v", summary)
+	}
+	wantDropped := []DroppedDependency{
+		{TaskID: "t2", Field: "depends_on", Ref: "t-gone"},
+		{TaskID: "t2", Field: "blocked_by", Ref: "t-gone"},
+	}
+	if !reflect.DeepEqual(summary.DroppedDependencies, wantDropped) {
+		t.Fatalf("expected dropped %#v, got %#v", wantDropped, summary.DroppedDependencies)
+	}
+	imported := repo.tasks["t2"]
+	if !reflect.DeepEqual(imported.Metadata.DependsOn, []string{"t1"}) || !reflect.DeepEqual(imported.Metadata.BlockedBy, []string{"t-stored"}) {
+		t.Fatalf("expected resolvable references kept, got depends_on=%#v blocked_by=%#v", imported.Metadata.DependsOn, imported.Metadata.BlockedBy)
+	}
+}
+
+// TestImportSnapshotDryRunSummarizesWithoutWriting verifies a dry-run import classifies rows and leaves the repo untouched.
+func TestImportSnapshotDryRunSummarizesWithoutWriting(t *testing.T) {
+	repo := newFakeRepo()
+	now := time.Date(2026, 2, 22, 10, 0, 0, 0, time.UTC)
+	existing, _ := domain.NewProject("p1", "Inbox", "", now)
+	other, _ := domain.NewProject("p3", "Roadmap", "", now)
+	column, _ := domain.NewColumn("c1", existing.ID, "To Do", 0, 0, now)
+	task, _ := domain.NewTask(domain.TaskInput{ID: "t1", ProjectID: existing.ID, ColumnID: column.ID, Title: "Stored", Priority: domain.PriorityLow}, now)
+	repo.projects[existing.ID] = existing
+	repo.projects[other.ID] = other
+	repo.columns[column.ID] = column
+	repo.tasks[task.ID] = task
+
+	svc := NewService(repo, nil, func() time.Time { return now }, ServiceConfig{})
+	snap := Snapshot{
+		Version: SnapshotVersion,
+		Projects: []SnapshotProject{
+			{ID: "p1", Name: "Inbox", Slug: "inbox", CreatedAt: now, UpdatedAt: now},
+			// p2 takes the slug the stored p3 already uses.
+			{ID: "p2", Name: "Roadmap", Slug: "roadmap", CreatedAt: now, UpdatedAt: now},
+		},
+		Columns: []SnapshotColumn{
+			{ID: "c1", ProjectID: "p1", Name: "To Do", CreatedAt: now, UpdatedAt: now},
+			{ID: "c2", ProjectID: "p2", Name: "To Do", CreatedAt: now, UpdatedAt: now},
+		},
+		Tasks: []SnapshotTask{
+			{ID: "t1", ProjectID: "p1", ColumnID: "c1", Title: "Renamed", Priority: domain.PriorityLow, CreatedAt: now, UpdatedAt: now},
+			{ID: "t2", ProjectID: "p2", ColumnID: "c2", Title: "New", Priority: domain.PriorityLow, Metadata: domain.TaskMetadata{DependsOn: []string{"t-gone"}}, CreatedAt: now, UpdatedAt: now},
+		},
+		Comments: []SnapshotComment{
+			{ID: "cm1", ProjectID: "p2", TargetType: domain.CommentTargetTypeProject, TargetID: "p2", BodyMarkdown: "hello", ActorID: "user-1", ActorName: "user", ActorType: domain.ActorTypeUser, CreatedAt: now, UpdatedAt: now},
+		},
+	}
+
+	summary, err := svc.ImportSnapshot(WithDryRun(context.Background()), snap)
+	if err != nil {
+		t.Fatalf("ImportSnapshot(dry run) error = %v", err)
+	}
+	if !summary.DryRun {
+		t.Fatal("expected dry-run summary")
+	}
+	if summary.Projects != (ImportCounts{Created: 1, Updated: 1}) || summary.Columns != (ImportCounts{Created: 1, Updated: 1}) || summary.Tasks != (ImportCounts{Created: 1, Updated: 1}) || summary.Comments != (ImportCounts{Created: 1}) {
+		t.Fatalf("unexpected dry-run counts %#v", summary)
+	}
+	if len(summary.DroppedDependencies) != 1 || summary.DroppedDependencies[0].Ref != "t-gone" {
+		t.Fatalf("expected dangling dependency reported, got %#v", summary.DroppedDependencies)
+	}
+	wantSlugs := []DuplicateSlug{{Slug: "roadmap", ProjectIDs: []string{"p2", "p3"}}}
+	if !reflect.DeepEqual(summary.DuplicateSlugs, wantSlugs) {
+		t.Fatalf("expected duplicate slugs %#v, got %#v", wantSlugs, summary.DuplicateSlugs)
+	}
+	// Nothing was written.
+	if _, ok := repo.projects["p2"]; ok {
+		t.Fatal("expected dry run not to create projects")
+	}
+	if repo.tasks["t1"].Title != "Stored" {
+		t.Fatalf("expected dry run not to update tasks, got %q", repo.tasks["t1"].Title)
+	}
+}
+
+// TestImportSnapshotMergeMatchesStoredRows verifies merge imports fold projects by slug and columns by name, keeping local-only rows.
+func TestImportSnapshotMergeMatchesStoredRows(t *testing.T) {
+	repo := newFakeRepo()
+	now := time.Date(2026, 2, 22, 10, 0, 0, 0, time.UTC)
+	project, _ := domain.NewProject("p-local", "Inbox", "", now)
+	column, _ := domain.NewColumn("c-local", project.ID, "To Do", 0, 0, now)
+	localOnly, _ := domain.NewTask(domain.TaskInput{ID: "t-local", ProjectID: project.ID, ColumnID: column.ID, Title: "Local only", Priority: domain.PriorityLow}, now)
+	shared, _ := domain.NewTask(domain.TaskInput{ID: "t-shared", ProjectID: project.ID, ColumnID: column.ID, Position: 1, Title: "Old title", Priority: domain.PriorityLow}, now)
+	repo.projects[project.ID] = project
+	repo.columns[column.ID] = column
+	repo.tasks[localOnly.ID] = localOnly
+	repo.tasks[shared.ID] = shared
+
+	svc := NewService(repo, nil, func() time.Time { return now }, ServiceConfig{})
+	snap := Snapshot{
+		Version:  SnapshotVersion,
+		Projects: []SnapshotProject{{ID: "p-remote", Name: "Inbox", Slug: project.Slug, CreatedAt: now, UpdatedAt: now}},
+		Columns: []SnapshotColumn{
+			{ID: "c-remote", ProjectID: "p-remote", Name: "to do", CreatedAt: now, UpdatedAt: now},
+			{ID: "c-new", ProjectID: "p-remote", Name: "Doing", Position: 1, CreatedAt: now, UpdatedAt: now},
+		},
+		Tasks: []SnapshotTask{
+			{ID: "t-shared", ProjectID: "p-remote", ColumnID: "c-remote", Title: "New title", Priority: domain.PriorityHigh, CreatedAt: now, UpdatedAt: now},
+			{ID: "t-new", ProjectID: "p-remote", ColumnID: "c-new", Title: "From snapshot", Priority: domain.PriorityMedium, CreatedAt: now, UpdatedAt: now},
+		},
+	}
+
+	summary, err := svc.ImportSnapshotMerge(context.Background(), snap)
+	if err != nil {
+		t.Fatalf("ImportSnapshotMerge() error = %v", err)
+	}
+	if want := []ProjectMatch{{Slug: project.Slug, SnapshotID: "p-remote", StoredID: "p-local"}}; !reflect.DeepEqual(summary.MatchedProjects, want) {
+		t.Fatalf("expected project matched by slug, got %#v", summary.MatchedProjects)
+	}
+	if summary.Projects != (ImportCounts{Updated: 1}) || summary.Columns != (ImportCounts{Created: 1, Updated: 1}) || summary.Tasks != (ImportCounts{Created: 1, Updated: 1}) {
+		t.Fatalf("unexpected merge counts %#v", summary)
+	}
+	if _, ok := repo.projects["p-remote"]; ok {
+		t.Fatal("expected no duplicate project for the matched slug")
+	}
+	if got := repo.tasks["t-shared"]; got.Title != "New title" || got.ProjectID != "p-local" || got.ColumnID != "c-local" {
+		t.Fatalf("expected shared task updated in the stored column, got %#v", got)
+	}
+	if got := repo.tasks["t-new"]; got.ProjectID != "p-local" || got.ColumnID != "c-new" || repo.columns["c-new"].ProjectID != "p-local" {
+		t.Fatalf("expected new task and column inserted under the stored project, got task %#v", got)
+	}
+	if got := repo.tasks["t-local"]; got.Title != "Local only" {
+		t.Fatalf("expected local-only task untouched, got %#v", got)
+	}
+	// The caller's decoded snapshot keeps its original ids.
+	if snap.Tasks[0].ProjectID != "p-remote" {
+		t.Fatalf("expected caller snapshot unchanged, got project id %q", snap.Tasks[0].ProjectID)
+	}
+	if _, err := ParseImportMode("overwrite"); !errors.Is(err, ErrInvalidImportMode) {
+		t.Fatalf("expected ErrInvalidImportMode, got %v", err)
+	}
+}
+
+// TestEncodeSnapshotCSV verifies the csv export writes one quoted row per task with hierarchy ids.
+func TestEncodeSnapshotCSV(t *testing.T) {
+	now := time.Date(2026, 2, 22, 10, 0, 0, 0, time.UTC)
+	due := now.Add(24 * time.Hour)
+	snap := Snapshot{
+		Projects: []SnapshotProject{{ID: "p1", Slug: "inbox"}},
+		Columns:  []SnapshotColumn{{ID: "c1", ProjectID: "p1", Name: "To Do"}},
+		Tasks: []SnapshotTask{
+			{ID: "t1", ProjectID: "p1", ColumnID: "c1", Title: "Parent, with comma", Priority: domain.PriorityHigh, Labels: []string{"a", "b"}, DueAt: &due, LifecycleState: domain.StateTodo},
+			{ID: "t2", ProjectID: "p1", ParentID: "t1", ColumnID: "c1", Title: "Child\nsecond line", Priority: domain.PriorityLow, LifecycleState: domain.StateDone},
+		},
+	}
+	encoded, err := EncodeSnapshotCSV(snap)
+	if err != nil {
+		t.Fatalf("EncodeSnapshotCSV() error = %v", err)
+	}
+	// Fields with commas and newlines are quoted, so the csv reader recovers them intact.
+	rows, err := csv.NewReader(bytes.NewReader(encoded)).ReadAll()
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+	want := [][]string{
+		{"id", "parent_id", "project", "column", "title", "priority", "labels", "due_at", "lifecycle_state"},
+		{"t1", "", "inbox", "To Do", "Parent, with comma", "high", "a;b", "2026-02-23T10:00:00Z", "todo"},
+		{"t2", "t1", "inbox", "To Do", "Child\nsecond line", "low", "", "", "done"},
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Fatalf("unexpected csv rows %#v", rows)
+	}
+	if _, err := ParseExportFormat("yaml"); !errors.Is(err, ErrInvalidExportFormat) {
+		t.Fatalf("expected ErrInvalidExportFormat, got %v", err)
+	}
+}
+
+// TestEncodeSnapshotMarkdown verifies the markdown export nests subtasks under their parent within each column section.
+func TestEncodeSnapshotMarkdown(t *testing.T) {
+	now := time.Date(2026, 2, 22, 10, 0, 0, 0, time.UTC)
+	due := now.Add(24 * time.Hour)
+	snap := Snapshot{
+		ExportedAt: now,
+		Projects: []SnapshotProject{
+			{ID: "p2", Name: "Website", ArchivedAt: &now},
+			{ID: "p1", Name: "Inbox", Description: "Team inbox"},
+		},
+		Columns: []SnapshotColumn{
+			{ID: "c1", ProjectID: "p1", Name: "To Do", Position: 0},
+			{ID: "c2", ProjectID: "p1", Name: "Done", Position: 1},
+			{ID: "c3", ProjectID: "p2", Name: "Backlog", Position: 0},
+		},
+		Tasks: []SnapshotTask{
+			{ID: "t2", ProjectID: "p1", ColumnID: "c1", Position: 1, Title: "Standalone", Priority: domain.PriorityNone, LifecycleState: domain.StateTodo},
+			{ID: "t1", ProjectID: "p1", ColumnID: "c1", Position: 0, Title: "Launch", Priority: domain.PriorityHigh, DueAt: &due, LifecycleState: domain.StateProgress},
+			{ID: "t3", ProjectID: "p1", ParentID: "t1", ColumnID: "c1", Position: 0, Title: "Draft copy", Priority: domain.PriorityLow, LifecycleState: domain.StateDone},
+			{ID: "t4", ProjectID: "p1", ParentID: "t1", ColumnID: "c2", Position: 0, Title: "Book venue", Priority: domain.PriorityMedium, LifecycleState: domain.StateDone, ArchivedAt: &now},
+		},
+	}
+	got := string(EncodeSnapshotMarkdown(snap))
+	// Projects sort by name; t4's parent sits in another column, so it starts its own list.
+	want := `# Board Snapshot
+
+Exported 2026-02-22 10:00 UTC.
+
+## Inbox
+
+Team inbox
+
+### To Do
+
+- [ ] Launch _(priority: high, due: 2026-02-23)_
+  - [x] Draft copy _(priority: low)_
+- [ ] Standalone
+
+### Done
+
+- [x] Book venue _(priority: medium, archived)_
+
+## Website (archived)
+
+### Backlog
+
+_No tasks._
+`
+	if got != want {
+		t.Fatalf("unexpected markdown export:\n%s", got)
+	}
+	if format, err := ParseExportFormat(" MD "); err != nil || format != ExportFormatMarkdown {
+		t.Fatalf("expected md alias to parse as markdown, got %q, %v", format, err)
+	}
+}
+
+// TestSnapshotJSONSchemaAcceptsEncodedSnapshots keeps the derived schema in sync with what the snapshot structs encode.
+func TestSnapshotJSONSchemaAcceptsEncodedSnapshots(t *testing.T) {
+	// Every field populated exercises each schema node; a zero snapshot exercises required fields and nulls.
+	full := Snapshot{}
+	populateForSchemaTest(reflect.ValueOf(&full).Elem(), 0)
+	full.Version = SnapshotVersion
+	for name, snap := range map[string]Snapshot{"populated": full, "zero": {Version: SnapshotVersion}} {
+		content, err := json.Marshal(snap)
+		if err != nil {
+			t.Fatalf("Marshal(%s) error = %v", name, err)
+		}
+		if err := ValidateSnapshotJSON(content); err != nil {
+			t.Fatalf("ValidateSnapshotJSON(%s) error = %v", name, err)
+		}
+	}
+
+	repo := newFakeRepo()
+	now := time.Date(2026, 2, 22, 10, 0, 0, 0, time.UTC)
+	project, _ := domain.NewProject("p1", "Alpha", "", now)
+	repo.projects[project.ID] = project
+	column, _ := domain.NewColumn("c1", project.ID, "To Do", 0, 0, now)
+	repo.columns[column.ID] = column
+	task, _ := domain.NewTask(domain.TaskInput{ID: "t1", ProjectID: project.ID, ColumnID: column.ID, Title: "Task", Priority: domain.PriorityLow}, now)
+	repo.tasks[task.ID] = task
+	exported, err := NewService(repo, nil, func() time.Time { return now }, ServiceConfig{}).ExportSnapshot(context.Background(), true)
+	if err != nil {
+		t.Fatalf("ExportSnapshot() error = %v", err)
+	}
+	content, err := json.Marshal(exported)
+	if err != nil {
+		t.Fatalf("Marshal(export) error = %v", err)
+	}
+	if err := ValidateSnapshotJSON(content); err != nil {
+		t.Fatalf("ValidateSnapshotJSON(export) error = %v", err)
+	}
+
+	invalid := []struct {
+		name    string
+		payload string
+		want    string
+	}{
+		{name: "version", payload: `{"version":"tillsyn.snapshot.v1","exported_at":"2026-01-01T00:00:00Z","projects":[],"columns":[],"tasks":[]}`, want: "$.version"},
+		{name: "missing field", payload: `{"version":"` + SnapshotVersion + `","exported_at":"2026-01-01T00:00:00Z","projects":[],"columns":[]}`, want: `missing required field "tasks"`},
+		{name: "unknown field", payload: `{"version":"` + SnapshotVersion + `","exported_at":"2026-01-01T00:00:00Z","projects":[],"columns":[],"tasks":[],"boards":[]}`, want: `additional property "boards"`},
+		{name: "wrong type", payload: `{"version":"` + SnapshotVersion + `","exported_at":"2026-01-01T00:00:00Z","projects":[],"columns":[{"id":"c1","project_id":"p1","name":"To Do","wip_limit":"3","position":0,"created_at":"x","updated_at":"x"}],"tasks":[]}`, want: "$.columns[0].wip_limit: expected integer"},
+	}
+	for _, tc := range invalid {
+		err := ValidateSnapshotJSON([]byte(tc.payload))
+		if !errors.Is(err, ErrInvalidSnapshot) || !strings.Contains(err.Error(), tc.want) {
+			t.Fatalf("%s: expected ErrInvalidSnapshot containing %q, got %v", tc.name, tc.want, err)
+		}
+	}
+}
+
+// populateForSchemaTest fills every exported field with a non-zero value, one element per slice and map.
+func populateForSchemaTest(v reflect.Value, depth int) {
+	if depth > 8 {
+		return
+	}
+	if v.Type() == reflect.TypeFor[time.Time]() {
+		v.Set(reflect.ValueOf(time.Date(2026, 2, 22, 10, 0, 0, 0, time.UTC)))
+		return
+	}
+	if v.Type() == reflect.TypeFor[json.RawMessage]() {
+		v.SetBytes([]byte(`{"any":["json"]}`))
+		return
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString("x")
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(1)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(1.5)
+	case reflect.Pointer:
+		v.Set(reflect.New(v.Type().Elem()))
+		populateForSchemaTest(v.Elem(), depth+1)
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		populateForSchemaTest(v.Index(0), depth+1)
+	case reflect.Map:
+		v.Set(reflect.MakeMap(v.Type()))
+		key := reflect.New(v.Type().Key()).Elem()
+		value := reflect.New(v.Type().Elem()).Elem()
+		populateForSchemaTest(key, depth+1)
+		populateForSchemaTest(value, depth+1)
+		v.SetMapIndex(key, value)
+	case reflect.Struct:
+		for idx := range v.NumField() {
+			if v.Type().Field(idx).IsExported() {
+				populateForSchemaTest(v.Field(idx), depth+1)
+			}
+		}
+	}
+}
+
 // TestExportSnapshotPropagatesError verifies behavior for the covered scenario.
 func TestExportSnapshotPropagatesError(t *testing.T) {
 	expected := errors.New("boom")
@@ -327,3 +1092,36 @@ func TestExportSnapshotPropagatesError(t *testing.T) {
 		t.Fatalf("expected error %v, got %v", expected, err)
 	}
 }
+
+// TestBuildSeedSnapshotDeterministic verifies seeded boards are reproducible, valid, and sized as requested.
+func TestBuildSeedSnapshotDeterministic(t *testing.T) {
+	in := SeedSnapshotInput{Projects: 2, TasksPerProject: 25, Seed: 42}
+	first, err := BuildSeedSnapshot(in)
+	if err != nil {
+		t.Fatalf("BuildSeedSnapshot() error = %v", err)
+	}
+	second, err := BuildSeedSnapshot(in)
+	if err != nil {
+		t.Fatalf("BuildSeedSnapshot() second error = %v", err)
+	}
+	if !reflect.DeepEqual(first, second) {
+		t.Fatal("expected identical snapshots for the same seed")
+	}
+	if len(first.Projects) != 2 || len(first.Columns) != 6 || len(first.Tasks) != 50 {
+		t.Fatalf("unexpected snapshot sizes projects=%d columns=%d tasks=%d", len(first.Projects), len(first.Columns), len(first.Tasks))
+	}
+	if err := first.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	other, err := BuildSeedSnapshot(SeedSnapshotInput{Projects: 2, TasksPerProject: 25, Seed: 7})
+	if err != nil {
+		t.Fatalf("BuildSeedSnapshot(other seed) error = %v", err)
+	}
+	if reflect.DeepEqual(first.Tasks, other.Tasks) {
+		t.Fatal("expected different seeds to produce different tasks")
+	}
+	if _, err := BuildSeedSnapshot(SeedSnapshotInput{Projects: 0}); !errors.Is(err, ErrInvalidSeedSize) {
+		t.Fatalf("expected ErrInvalidSeedSize, got %v", err)
+	}
+}
@@ -0,0 +1,144 @@
+package app
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// ArchiveTaskSubtree archives one task together with every active descendant and returns the archived tasks.
+// Guards are checked for the whole subtree before any write, and the writes land in one repository batch.
+func (s *Service) ArchiveTaskSubtree(ctx context.Context, taskID string) ([]domain.Task, error) {
+	root, projectTasks, err := s.loadTaskSubtreeRoot(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]domain.Task, len(projectTasks))
+	for _, task := range projectTasks {
+		byID[task.ID] = task
+	}
+	targets := make([]domain.Task, 0)
+	for _, id := range descendantTaskIDs(projectTasks, root.ID) {
+		task := byID[id]
+		if task.ArchivedAt != nil {
+			continue
+		}
+		guardScopes, err := s.capabilityScopesForTaskLineage(ctx, task)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.enforceMutationGuardAcrossScopes(ctx, task.ProjectID, task.UpdatedByType, guardScopes); err != nil {
+			return nil, err
+		}
+		targets = append(targets, task)
+	}
+	if len(targets) == 0 {
+		return nil, nil
+	}
+
+	now := s.clock()
+	archived := make([]domain.Task, 0, len(targets))
+	for _, task := range targets {
+		task.Archive(now)
+		applyMutationActorToTask(ctx, &task)
+		archived = append(archived, task)
+	}
+	if DryRunFromContext(ctx) {
+		return archived, nil
+	}
+	if err := s.repo.ApplyTaskBatch(ctx, TaskBatch{Update: archived}); err != nil {
+		return nil, err
+	}
+	s.invalidateDependencyRollup(root.ProjectID)
+	return archived, nil
+}
+
+// RestoreTaskSubtree restores one task together with every archived descendant and returns the restored tasks.
+// Guards are checked for the whole subtree before any write, and the writes land in one repository batch.
+func (s *Service) RestoreTaskSubtree(ctx context.Context, taskID string) ([]domain.Task, error) {
+	root, projectTasks, err := s.loadTaskSubtreeRoot(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	columns, err := s.repo.ListColumns(ctx, root.ProjectID, true)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]domain.Task, len(projectTasks))
+	for _, task := range projectTasks {
+		byID[task.ID] = task
+	}
+	// Guard enforcement must follow the caller's request actor, not historical task attribution.
+	guardActorType := domain.ActorTypeUser
+	if actor, ok := MutationActorFromContext(ctx); ok {
+		guardActorType = normalizeActorTypeInput(actor.ActorType)
+	}
+	targets := make([]domain.Task, 0)
+	for _, id := range descendantTaskIDs(projectTasks, root.ID) {
+		task := byID[id]
+		if task.ArchivedAt == nil {
+			continue
+		}
+		guardScopes, err := s.capabilityScopesForTaskLineage(ctx, task)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.enforceMutationGuardAcrossScopes(ctx, task.ProjectID, guardActorType, guardScopes); err != nil {
+			return nil, err
+		}
+		targets = append(targets, task)
+	}
+	if len(targets) == 0 {
+		return nil, nil
+	}
+
+	now := s.clock()
+	restored := make([]domain.Task, 0, len(targets))
+	for _, task := range targets {
+		if err := restoreTaskState(&task, columns, now); err != nil {
+			return nil, err
+		}
+		applyMutationActorToTask(ctx, &task)
+		restored = append(restored, task)
+	}
+	if DryRunFromContext(ctx) {
+		return restored, nil
+	}
+	if err := s.repo.ApplyTaskBatch(ctx, TaskBatch{Update: restored}); err != nil {
+		return nil, err
+	}
+	s.invalidateDependencyRollup(root.ProjectID)
+	for _, task := range restored {
+		s.refreshTaskEmbedding(ctx, task)
+	}
+	return restored, nil
+}
+
+// loadTaskSubtreeRoot loads one subtree root and every task in its project, archived included.
+func (s *Service) loadTaskSubtreeRoot(ctx context.Context, taskID string) (domain.Task, []domain.Task, error) {
+	taskID = strings.TrimSpace(taskID)
+	if taskID == "" {
+		return domain.Task{}, nil, domain.ErrInvalidID
+	}
+	root, err := s.repo.GetTask(ctx, taskID)
+	if err != nil {
+		return domain.Task{}, nil, err
+	}
+	projectTasks, err := s.repo.ListTasks(ctx, root.ProjectID, true)
+	if err != nil {
+		return domain.Task{}, nil, err
+	}
+	return root, projectTasks, nil
+}
+
+// restoreTaskState clears archival and returns the task to the lifecycle state of its column.
+func restoreTaskState(task *domain.Task, columns []domain.Column, now time.Time) error {
+	task.Restore(now)
+	restoredState := lifecycleStateForColumnID(columns, task.ColumnID)
+	if restoredState == "" {
+		restoredState = domain.StateTodo
+	}
+	return task.SetLifecycleState(restoredState, now)
+}
@@ -0,0 +1,220 @@
+package app
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// TaskCardFormat identifies one single-task export encoding.
+type TaskCardFormat string
+
+// TaskCardFormat values.
+const (
+	TaskCardFormatMarkdown TaskCardFormat = "markdown"
+	TaskCardFormatJSON     TaskCardFormat = "json"
+)
+
+// ExportTaskCardInput holds one single-task export request.
+type ExportTaskCardInput struct {
+	TaskID          string
+	IncludeSubtasks bool
+	Format          TaskCardFormat
+}
+
+// ParseTaskCardFormat normalizes one task card format name, defaulting to markdown.
+func ParseTaskCardFormat(raw string) (TaskCardFormat, error) {
+	switch strings.TrimSpace(strings.ToLower(raw)) {
+	case "", "markdown", "md":
+		return TaskCardFormatMarkdown, nil
+	case "json":
+		return TaskCardFormatJSON, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrInvalidCardFormat, raw)
+	}
+}
+
+// ExportTaskSnapshot exports one task, and optionally its active subtasks, using the snapshot encoding.
+// The snapshot carries the owning project, the columns the tasks sit in, and the tasks' comments.
+func (s *Service) ExportTaskSnapshot(ctx context.Context, taskID string, includeSubtasks bool) (Snapshot, error) {
+	snap, _, err := s.exportTaskSnapshot(ctx, taskID, includeSubtasks)
+	return snap, err
+}
+
+// ExportTaskCard renders one task, and optionally its subtasks, as a shareable JSON or markdown card.
+func (s *Service) ExportTaskCard(ctx context.Context, in ExportTaskCardInput) ([]byte, error) {
+	format, err := ParseTaskCardFormat(string(in.Format))
+	if err != nil {
+		return nil, err
+	}
+	snap, projectTasks, err := s.exportTaskSnapshot(ctx, in.TaskID, in.IncludeSubtasks)
+	if err != nil {
+		return nil, err
+	}
+	if format == TaskCardFormatJSON {
+		encoded, err := json.MarshalIndent(snap, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("encode task card json: %w", err)
+		}
+		return append(encoded, '\n'), nil
+	}
+	titles := make(map[string]string, len(projectTasks))
+	for _, task := range projectTasks {
+		titles[task.ID] = task.Title
+	}
+	return []byte(taskCardMarkdown(snap, strings.TrimSpace(in.TaskID), titles)), nil
+}
+
+// exportTaskSnapshot builds one task-scoped snapshot and also returns every task in the owning project.
+func (s *Service) exportTaskSnapshot(ctx context.Context, taskID string, includeSubtasks bool) (Snapshot, []domain.Task, error) {
+	root, projectTasks, err := s.loadTaskSubtreeRoot(ctx, taskID)
+	if err != nil {
+		return Snapshot{}, nil, err
+	}
+	project, err := s.repo.GetProject(ctx, root.ProjectID)
+	if err != nil {
+		return Snapshot{}, nil, err
+	}
+	columns, err := s.repo.ListColumns(ctx, root.ProjectID, true)
+	if err != nil {
+		return Snapshot{}, nil, err
+	}
+
+	tasks := []domain.Task{root}
+	if includeSubtasks {
+		byID := make(map[string]domain.Task, len(projectTasks))
+		for _, task := range projectTasks {
+			byID[task.ID] = task
+		}
+		for _, id := range descendantTaskIDs(projectTasks, root.ID)[1:] {
+			// Archived subtasks only travel with an archived root, matching what the board shows.
+			if task := byID[id]; task.ArchivedAt == nil || root.ArchivedAt != nil {
+				tasks = append(tasks, task)
+			}
+		}
+	}
+
+	snap := Snapshot{
+		Version:    SnapshotVersion,
+		ExportedAt: s.clock().UTC(),
+		Projects:   []SnapshotProject{snapshotProjectFromDomain(project)},
+		Columns:    make([]SnapshotColumn, 0),
+		Tasks:      make([]SnapshotTask, 0, len(tasks)),
+		Comments:   make([]SnapshotComment, 0),
+	}
+	usedColumns := map[string]struct{}{}
+	for _, task := range tasks {
+		snap.Tasks = append(snap.Tasks, snapshotTaskFromDomain(task))
+		usedColumns[task.ColumnID] = struct{}{}
+	}
+	for _, column := range columns {
+		if _, ok := usedColumns[column.ID]; ok {
+			snap.Columns = append(snap.Columns, snapshotColumnFromDomain(column))
+		}
+	}
+	comments, err := s.commentsForProjectSnapshot(ctx, project, tasks)
+	if err != nil {
+		return Snapshot{}, nil, err
+	}
+	for _, comment := range comments {
+		if comment.TargetType != domain.CommentTargetTypeProject {
+			snap.Comments = append(snap.Comments, comment)
+		}
+	}
+	snap.sort()
+	return snap, projectTasks, nil
+}
+
+// taskCardMarkdown renders one task-scoped snapshot as a markdown card rooted at rootID.
+// titles resolves dependency IDs that point outside the exported tasks.
+func taskCardMarkdown(snap Snapshot, rootID string, titles map[string]string) string {
+	byID := make(map[string]SnapshotTask, len(snap.Tasks))
+	children := map[string][]SnapshotTask{}
+	for _, task := range snap.Tasks {
+		byID[task.ID] = task
+		children[task.ParentID] = append(children[task.ParentID], task)
+	}
+	for parentID := range children {
+		slices.SortFunc(children[parentID], func(a, b SnapshotTask) int {
+			return cmp.Or(cmp.Compare(a.Position, b.Position), cmp.Compare(a.ID, b.ID))
+		})
+	}
+	columnNames := make(map[string]string, len(snap.Columns))
+	for _, column := range snap.Columns {
+		columnNames[column.ID] = column.Name
+	}
+	root := byID[rootID]
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", root.Title)
+	fmt.Fprintf(&b, "- ID: `%s`\n", root.ID)
+	if len(snap.Projects) > 0 {
+		fmt.Fprintf(&b, "- Project: %s\n", snap.Projects[0].Name)
+	}
+	if name := columnNames[root.ColumnID]; name != "" {
+		fmt.Fprintf(&b, "- Column: %s\n", name)
+	}
+	fmt.Fprintf(&b, "- State: %s\n", root.LifecycleState)
+	fmt.Fprintf(&b, "- Priority: %s\n", root.Priority)
+	if root.DueAt != nil {
+		fmt.Fprintf(&b, "- Due: %s\n", root.DueAt.UTC().Format("2006-01-02"))
+	}
+	if len(root.Labels) > 0 {
+		fmt.Fprintf(&b, "- Labels: %s\n", strings.Join(root.Labels, ", "))
+	}
+	if description := strings.TrimSpace(root.Description); description != "" {
+		fmt.Fprintf(&b, "\n%s\n", description)
+	}
+
+	dependencyLine := func(id string) string {
+		if title := strings.TrimSpace(titles[id]); title != "" {
+			return fmt.Sprintf("%s (`%s`)", title, id)
+		}
+		return fmt.Sprintf("`%s`", id)
+	}
+	if len(root.Metadata.DependsOn) > 0 || len(root.Metadata.BlockedBy) > 0 {
+		b.WriteString("\n## Dependencies\n\n")
+		for _, id := range root.Metadata.DependsOn {
+			fmt.Fprintf(&b, "- Depends on: %s\n", dependencyLine(id))
+		}
+		for _, id := range root.Metadata.BlockedBy {
+			fmt.Fprintf(&b, "- Blocked by: %s\n", dependencyLine(id))
+		}
+	}
+
+	if len(root.Metadata.ResourceRefs) > 0 {
+		b.WriteString("\n## Resources\n\n")
+		for _, ref := range root.Metadata.ResourceRefs {
+			line := fmt.Sprintf("`%s`", ref.Location)
+			if title := strings.TrimSpace(ref.Title); title != "" {
+				line = fmt.Sprintf("%s: %s", title, line)
+			}
+			if notes := strings.TrimSpace(ref.Notes); notes != "" {
+				line += " - " + notes
+			}
+			fmt.Fprintf(&b, "- %s\n", line)
+		}
+	}
+
+	if len(children[root.ID]) > 0 {
+		b.WriteString("\n## Subtasks\n\n")
+		var writeChildren func(parentID string, depth int)
+		writeChildren = func(parentID string, depth int) {
+			for _, child := range children[parentID] {
+				mark := " "
+				if child.LifecycleState == domain.StateDone {
+					mark = "x"
+				}
+				fmt.Fprintf(&b, "%s- [%s] %s (`%s`)\n", strings.Repeat("  ", depth), mark, child.Title, child.ID)
+				writeChildren(child.ID, depth+1)
+			}
+		}
+		writeChildren(root.ID, 0)
+	}
+	return b.String()
+}
@@ -0,0 +1,74 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// ChangeTaskKindInput holds input values for converting one task to another kind.
+type ChangeTaskKindInput struct {
+	TaskID string
+	Kind   domain.WorkKind
+	// Scope overrides the level derived from the kind and parent; empty derives it.
+	Scope domain.KindAppliesTo
+}
+
+// ChangeTaskKind promotes or demotes one task to another kind, such as task to branch, keeping its id, history, and children.
+// The new kind must accept the task's parent, and every child must still accept the converted task as its parent.
+func (s *Service) ChangeTaskKind(ctx context.Context, in ChangeTaskKindInput) (domain.Task, error) {
+	task, err := s.repo.GetTask(ctx, strings.TrimSpace(in.TaskID))
+	if err != nil {
+		return domain.Task{}, err
+	}
+	guardScopes, err := s.capabilityScopesForTaskLineage(ctx, task)
+	if err != nil {
+		return domain.Task{}, err
+	}
+	if err := s.enforceMutationGuardAcrossScopes(ctx, task.ProjectID, task.UpdatedByType, guardScopes); err != nil {
+		return domain.Task{}, err
+	}
+	var parent *domain.Task
+	if task.ParentID != "" {
+		parentTask, parentErr := s.repo.GetTask(ctx, task.ParentID)
+		if parentErr != nil {
+			return domain.Task{}, parentErr
+		}
+		parent = &parentTask
+	}
+	scope := normalizeTaskScopeForKind(domain.KindID(in.Kind), in.Scope, parent)
+	kindDef, err := s.validateTaskKind(ctx, task.ProjectID, domain.KindID(in.Kind), scope, parent, task.Metadata.KindPayload)
+	if err != nil {
+		return domain.Task{}, err
+	}
+	converted := task
+	if err := converted.ChangeKind(domain.WorkKind(kindDef.ID), scope, s.clock()); err != nil {
+		return domain.Task{}, err
+	}
+
+	// Children keep their own kinds, so each must still allow the converted task as a parent.
+	tasks, err := s.repo.ListTasks(ctx, task.ProjectID, true)
+	if err != nil {
+		return domain.Task{}, err
+	}
+	for _, child := range tasks {
+		if child.ParentID != task.ID {
+			continue
+		}
+		if _, err := s.validateTaskKind(ctx, child.ProjectID, domain.KindID(child.Kind), child.Scope, &converted, child.Metadata.KindPayload); err != nil {
+			return domain.Task{}, fmt.Errorf("child %q: %w", child.Title, err)
+		}
+	}
+
+	applyMutationActorToTask(ctx, &converted)
+	if DryRunFromContext(ctx) {
+		return converted, nil
+	}
+	if err := s.repo.UpdateTask(ctx, converted); err != nil {
+		return domain.Task{}, err
+	}
+	s.invalidateDependencyRollup(converted.ProjectID)
+	return converted, nil
+}
@@ -0,0 +1,86 @@
+package app
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// BulkLabelInput adds or removes one label across several tasks.
+type BulkLabelInput struct {
+	TaskIDs []string
+	Label   string
+	Remove  bool
+}
+
+// BulkUpdateTaskLabels adds or removes one label on every listed task and returns the tasks it changed.
+// Tasks that already carry (or already lack) the label are skipped; the rest land in one repository batch,
+// so either every change is stored or none is.
+func (s *Service) BulkUpdateTaskLabels(ctx context.Context, in BulkLabelInput) ([]domain.Task, error) {
+	label := strings.TrimSpace(strings.ToLower(in.Label))
+	if label == "" {
+		return nil, domain.ErrInvalidName
+	}
+	targets := make([]domain.Task, 0, len(in.TaskIDs))
+	for _, taskID := range in.TaskIDs {
+		task, err := s.repo.GetTask(ctx, taskID)
+		if err != nil {
+			return nil, err
+		}
+		has := slices.ContainsFunc(task.Labels, func(existing string) bool {
+			return strings.EqualFold(strings.TrimSpace(existing), label)
+		})
+		if has != in.Remove {
+			continue
+		}
+		guardScopes, err := s.capabilityScopesForTaskLineage(ctx, task)
+		if err != nil {
+			return nil, err
+		}
+		if !in.Remove {
+			if err := s.ensureLabelsAllowed(ctx, task.ProjectID, []string{label}); err != nil {
+				return nil, err
+			}
+		}
+		if err := s.enforceMutationGuardAcrossScopes(ctx, task.ProjectID, task.UpdatedByType, guardScopes); err != nil {
+			return nil, err
+		}
+		targets = append(targets, task)
+	}
+	if len(targets) == 0 {
+		return nil, nil
+	}
+
+	now := s.clock()
+	updated := make([]domain.Task, 0, len(targets))
+	for _, task := range targets {
+		labels := slices.DeleteFunc(slices.Clone(task.Labels), func(existing string) bool {
+			return strings.EqualFold(strings.TrimSpace(existing), label)
+		})
+		if !in.Remove {
+			labels = append(labels, label)
+		}
+		if err := task.UpdateDetails(task.Title, task.Description, task.Priority, task.DueAt, labels, now); err != nil {
+			return nil, err
+		}
+		applyMutationActorToTask(ctx, &task)
+		updated = append(updated, task)
+	}
+	if DryRunFromContext(ctx) {
+		return updated, nil
+	}
+	if err := s.repo.ApplyTaskBatch(ctx, TaskBatch{Update: updated}); err != nil {
+		return nil, err
+	}
+	projectIDs := map[string]struct{}{}
+	for _, task := range updated {
+		projectIDs[task.ProjectID] = struct{}{}
+		s.refreshTaskEmbedding(ctx, task)
+	}
+	for projectID := range projectIDs {
+		s.invalidateDependencyRollup(projectID)
+	}
+	return updated, nil
+}
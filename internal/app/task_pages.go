@@ -0,0 +1,156 @@
+package app
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// defaultTaskPageLimit and maxTaskPageLimit bound per-column page sizes.
+const (
+	defaultTaskPageLimit = 50
+	maxTaskPageLimit     = 500
+)
+
+// ListTasksPageInput holds input values for one paginated per-column task read.
+type ListTasksPageInput struct {
+	ProjectID       string
+	ColumnID        string
+	IncludeArchived bool
+	Limit           int
+	Offset          int
+}
+
+// TaskPage holds one page of column tasks plus continuation state.
+// Tasks holds the page's board rows and their descendants; NextOffset, HasMore, and Count count board rows only.
+type TaskPage struct {
+	Tasks      []domain.Task
+	NextOffset int
+	HasMore    bool
+	Count      TaskPageCount
+}
+
+// ListTasksPage lists one position-ordered page of board rows in a column, with their descendants.
+func (s *Service) ListTasksPage(ctx context.Context, in ListTasksPageInput) (TaskPage, error) {
+	query := TaskPageQuery{
+		ProjectID:       strings.TrimSpace(in.ProjectID),
+		ColumnID:        strings.TrimSpace(in.ColumnID),
+		IncludeArchived: in.IncludeArchived,
+		Limit:           in.Limit,
+		Offset:          max(0, in.Offset),
+	}
+	if query.ProjectID == "" {
+		return TaskPage{}, domain.ErrInvalidID
+	}
+	if query.ColumnID == "" {
+		return TaskPage{}, domain.ErrInvalidColumnID
+	}
+	if query.Limit <= 0 {
+		query.Limit = defaultTaskPageLimit
+	}
+	query.Limit = min(query.Limit, maxTaskPageLimit)
+
+	var (
+		tasks []domain.Task
+		count TaskPageCount
+		err   error
+	)
+	if lister, ok := s.repo.(TaskPageLister); ok {
+		count, err = lister.CountTasksPage(ctx, query)
+		if err == nil {
+			tasks, err = lister.ListTasksPage(ctx, query)
+		}
+	} else {
+		tasks, count, err = s.listTasksPageFallback(ctx, query)
+	}
+	if err != nil {
+		return TaskPage{}, err
+	}
+	// Every descendant's parent is in the page, so whatever lacks one is a board row.
+	inPage := make(map[string]struct{}, len(tasks))
+	for _, task := range tasks {
+		inPage[task.ID] = struct{}{}
+	}
+	rows := 0
+	for _, task := range tasks {
+		if _, ok := inPage[task.ParentID]; !ok {
+			rows++
+		}
+	}
+	next := query.Offset + rows
+	return TaskPage{Tasks: tasks, NextOffset: next, HasMore: rows > 0 && next < count.Total, Count: count}, nil
+}
+
+// isBoardRow reports whether a task shows on the board at project level: a non-subtask root or orphan.
+func isBoardRow(task domain.Task, known map[string]domain.Task) bool {
+	if task.Kind == domain.WorkKindSubtask {
+		return false
+	}
+	if task.ParentID == "" {
+		return true
+	}
+	_, ok := known[task.ParentID]
+	return !ok
+}
+
+// listTasksPageFallback pages a full project listing for repositories without native paging.
+func (s *Service) listTasksPageFallback(ctx context.Context, query TaskPageQuery) ([]domain.Task, TaskPageCount, error) {
+	all, err := s.repo.ListTasks(ctx, query.ProjectID, true)
+	if err != nil {
+		return nil, TaskPageCount{}, err
+	}
+	known := make(map[string]domain.Task, len(all))
+	children := make(map[string][]domain.Task, len(all))
+	for _, task := range all {
+		known[task.ID] = task
+		if task.ParentID != "" {
+			children[task.ParentID] = append(children[task.ParentID], task)
+		}
+	}
+	listed := func(task domain.Task) bool {
+		return query.IncludeArchived || task.ArchivedAt == nil
+	}
+	rows := make([]domain.Task, 0)
+	count := TaskPageCount{}
+	for _, task := range all {
+		if task.ColumnID != query.ColumnID || !listed(task) || !isBoardRow(task, known) {
+			continue
+		}
+		rows = append(rows, task)
+		count.Total++
+		if task.ArchivedAt == nil {
+			count.Active++
+		}
+	}
+	slices.SortStableFunc(rows, compareTaskPagePosition)
+	if query.Offset >= len(rows) {
+		return []domain.Task{}, count, nil
+	}
+	rows = rows[query.Offset:min(len(rows), query.Offset+query.Limit)]
+
+	// Unlisted (archived) tasks prune their whole subtree, so every descendant's parent is in the page.
+	descendants := make([]domain.Task, 0)
+	queue := slices.Clone(rows)
+	for len(queue) > 0 {
+		parent := queue[0]
+		queue = queue[1:]
+		for _, child := range children[parent.ID] {
+			if listed(child) {
+				descendants = append(descendants, child)
+				queue = append(queue, child)
+			}
+		}
+	}
+	slices.SortStableFunc(descendants, compareTaskPagePosition)
+	return append(slices.Clone(rows), descendants...), count, nil
+}
+
+// compareTaskPagePosition orders tasks by position, then id, matching the paged repository query.
+func compareTaskPagePosition(a, b domain.Task) int {
+	if a.Position == b.Position {
+		return strings.Compare(a.ID, b.ID)
+	}
+	return a.Position - b.Position
+}
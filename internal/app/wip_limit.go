@@ -0,0 +1,45 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// WithWIPLimitEnforcement marks a context so task moves fail when they would overfill the target column.
+// Without it moves only surface WIP limits as warnings, which is how the TUI treats them.
+func WithWIPLimitEnforcement(ctx context.Context) context.Context {
+	return context.WithValue(ctx, wipLimitContextKey{}, true)
+}
+
+// WIPLimitEnforcedFromContext reports whether the context requests WIP-limit enforcement.
+func WIPLimitEnforcedFromContext(ctx context.Context) bool {
+	raw := ctx.Value(wipLimitContextKey{})
+	enforced, ok := raw.(bool)
+	return ok && enforced
+}
+
+// wipLimitContextKey stores context keys for WIP-limit enforcement flags.
+type wipLimitContextKey struct{}
+
+// ensureColumnHasRoom rejects moving a task into a column whose active tasks already fill its WIP limit.
+func (s *Service) ensureColumnHasRoom(ctx context.Context, task domain.Task, column domain.Column) error {
+	if column.WIPLimit <= 0 || task.ColumnID == column.ID {
+		return nil
+	}
+	tasks, err := s.repo.ListTasks(ctx, task.ProjectID, false)
+	if err != nil {
+		return err
+	}
+	active := 0
+	for _, other := range tasks {
+		if other.ColumnID == column.ID && other.ID != task.ID && other.ArchivedAt == nil {
+			active++
+		}
+	}
+	if active >= column.WIPLimit {
+		return fmt.Errorf("column %q holds %d/%d tasks: %w", column.Name, active, column.WIPLimit, domain.ErrWIPLimitExceeded)
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	toml "github.com/pelletier/go-toml/v2"
+)
+
+// Check reads the config file at path the way Load does, but reports every problem it finds instead of stopping at
+// the first. Beyond Validate it flags unknown keys, search roots that are not directories, and project_roots keys
+// that collapse to the same slug. A missing or empty file has no problems; the error covers files that cannot be read.
+func Check(path string, defaults Config) ([]string, error) {
+	if strings.TrimSpace(path) == "" {
+		return nil, nil
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read config: %w", err)
+	}
+	if len(content) == 0 {
+		return nil, nil
+	}
+
+	problems := make([]string, 0)
+	seen := map[string]struct{}{}
+	report := func(problem string) {
+		if _, ok := seen[problem]; ok {
+			return
+		}
+		seen[problem] = struct{}{}
+		problems = append(problems, problem)
+	}
+
+	strict := defaults
+	decoder := toml.NewDecoder(bytes.NewReader(content))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&strict); err != nil {
+		var missing *toml.StrictMissingError
+		if !errors.As(err, &missing) {
+			// Syntax errors leave nothing reliable to check further.
+			report(fmt.Sprintf("decode toml: %v", err))
+			return problems, nil
+		}
+		for _, decodeErr := range missing.Errors {
+			report(fmt.Sprintf("unknown key %q", strings.Join(decodeErr.Key(), ".")))
+		}
+	}
+
+	var raw map[string]any
+	if err := toml.Unmarshal(content, &raw); err == nil {
+		for _, problem := range duplicateProjectRootProblems(raw["project_roots"]) {
+			report(problem)
+		}
+	}
+
+	cfg := defaults
+	if err := toml.Unmarshal(content, &cfg); err != nil {
+		report(fmt.Sprintf("decode toml: %v", err))
+		return problems, nil
+	}
+	if strings.TrimSpace(cfg.Database.Path) == "" {
+		cfg.Database.Path = strings.TrimSpace(defaults.Database.Path)
+	}
+	cfg.normalize()
+
+	switch cfg.Logging.Level {
+	case "debug", "info", "warn", "error", "fatal":
+	default:
+		report(fmt.Sprintf("invalid logging.level: %q", cfg.Logging.Level))
+	}
+	for i, state := range cfg.Search.States {
+		if !isKnownLifecycleState(state) {
+			report(fmt.Sprintf("search.states[%d] references unknown state %q", i, state))
+		}
+	}
+	for i, root := range cfg.Paths.SearchRoots {
+		info, err := os.Stat(root)
+		switch {
+		case err != nil:
+			report(fmt.Sprintf("paths.search_roots[%d] %q does not exist", i, root))
+		case !info.IsDir():
+			report(fmt.Sprintf("paths.search_roots[%d] %q is not a directory", i, root))
+		}
+	}
+	if err := validateHighlightColor(cfg.UI.HighlightColor); err != nil {
+		report(err.Error())
+	}
+	labels := make([]string, 0, len(cfg.Labels.Colors))
+	for label := range cfg.Labels.Colors {
+		labels = append(labels, label)
+	}
+	slices.Sort(labels)
+	for _, label := range labels {
+		if err := validateColorValue("labels.colors."+label, cfg.Labels.Colors[label]); err != nil {
+			report(err.Error())
+		}
+	}
+	// Validate stops at its first failure; anything it catches that the checks above did not is still reported.
+	if err := cfg.Validate(); err != nil {
+		report(err.Error())
+	}
+	return problems, nil
+}
+
+// duplicateProjectRootProblems reports project_roots keys that normalize to the same project slug.
+// Load keeps only one of them, so the other mapping would be dropped without a warning.
+func duplicateProjectRootProblems(value any) []string {
+	table, ok := value.(map[string]any)
+	if !ok {
+		return nil
+	}
+	keysBySlug := map[string][]string{}
+	for key := range table {
+		slug := strings.TrimSpace(strings.ToLower(key))
+		keysBySlug[slug] = append(keysBySlug[slug], key)
+	}
+	slugs := make([]string, 0, len(keysBySlug))
+	for slug, keys := range keysBySlug {
+		if len(keys) > 1 {
+			slugs = append(slugs, slug)
+		}
+	}
+	slices.Sort(slugs)
+	out := make([]string, 0, len(slugs))
+	for _, slug := range slugs {
+		keys := keysBySlug[slug]
+		slices.Sort(keys)
+		out = append(out, fmt.Sprintf("project_roots keys %q all map to project slug %q", keys, slug))
+	}
+	return out
+}
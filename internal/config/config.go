@@ -3,13 +3,16 @@ package config
 import (
 	"errors"
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
 	"slices"
 	"sort"
 	"strings"
 	"time"
+	"unicode"
 
+	"github.com/hylla/tillsyn/internal/domain"
 	toml "github.com/pelletier/go-toml/v2"
 )
 
@@ -18,39 +21,50 @@ type DeleteMode string
 
 // DeleteModeArchive and related constants define package defaults.
 const (
-	DeleteModeArchive DeleteMode = "archive"
-	DeleteModeHard    DeleteMode = "hard"
-	defaultLogLevel              = "info"
-	defaultDevLogDir             = ".tillsyn/log"
-	defaultActorType             = "user"
+	DeleteModeArchive       DeleteMode = "archive"
+	DeleteModeHard          DeleteMode = "hard"
+	defaultLogLevel                    = "info"
+	defaultLogFormat                   = "text"
+	defaultDevLogDir                   = ".tillsyn/log"
+	defaultDevLogMaxSizeMB             = 10
+	defaultDevLogMaxBackups            = 5
+	defaultActorType                   = "user"
+	defaultRefreshInterval             = 2 * time.Second
 )
 
 // Config holds package configuration.
 type Config struct {
-	Database     DatabaseConfig    `toml:"database"`
-	Delete       DeleteConfig      `toml:"delete"`
-	Confirm      ConfirmConfig     `toml:"confirm"`
-	TaskFields   TaskFieldsConfig  `toml:"task_fields"`
-	Board        BoardConfig       `toml:"board"`
-	Search       SearchConfig      `toml:"search"`
-	Embeddings   EmbeddingsConfig  `toml:"embeddings"`
-	Identity     IdentityConfig    `toml:"identity"`
-	Paths        PathsConfig       `toml:"paths"`
-	UI           UIConfig          `toml:"ui"`
-	Logging      LoggingConfig     `toml:"logging"`
-	ProjectRoots map[string]string `toml:"project_roots"`
-	Labels       LabelConfig       `toml:"labels"`
-	Keys         KeyConfig         `toml:"keys"`
+	Database        DatabaseConfig                  `toml:"database"`
+	Delete          DeleteConfig                    `toml:"delete"`
+	Confirm         ConfirmConfig                   `toml:"confirm"`
+	TaskFields      TaskFieldsConfig                `toml:"task_fields"`
+	Board           BoardConfig                     `toml:"board"`
+	Projects        ProjectsConfig                  `toml:"projects"`
+	Search          SearchConfig                    `toml:"search"`
+	Embeddings      EmbeddingsConfig                `toml:"embeddings"`
+	Identity        IdentityConfig                  `toml:"identity"`
+	Paths           PathsConfig                     `toml:"paths"`
+	UI              UIConfig                        `toml:"ui"`
+	Logging         LoggingConfig                   `toml:"logging"`
+	ProjectRoots    map[string]string               `toml:"project_roots"`
+	Labels          LabelConfig                     `toml:"labels"`
+	Keys            KeyConfig                       `toml:"keys"`
+	ProjectProfiles map[string]ProjectProfileConfig `toml:"project_profiles"`
+	Templates       map[string]TaskTemplateConfig   `toml:"templates"`
+	Searches        map[string]SavedSearchConfig    `toml:"searches"`
 }
 
 // DatabaseConfig holds configuration for database.
 type DatabaseConfig struct {
 	Path string `toml:"path"`
+	// ReadOnlyFallback opens an existing database read-only when its path cannot be written.
+	ReadOnlyFallback bool `toml:"readonly_fallback"`
 }
 
 // DeleteConfig holds configuration for delete.
 type DeleteConfig struct {
-	DefaultMode DeleteMode `toml:"default_mode"`
+	DefaultMode  DeleteMode `toml:"default_mode"`
+	ParentPolicy string     `toml:"parent_policy"` // cascade | reparent-to-grandparent | block
 }
 
 // ConfirmConfig holds configuration for confirmation behavior.
@@ -59,6 +73,10 @@ type ConfirmConfig struct {
 	Archive    bool `toml:"archive"`
 	HardDelete bool `toml:"hard_delete"`
 	Restore    bool `toml:"restore"`
+	// Quit asks before ctrl+c quits while a form or modal holds unsaved input; untouched modals still quit at once.
+	Quit bool `toml:"quit"`
+	// DefaultCancel highlights cancel when a confirmation dialog opens, so enter alone never applies the action.
+	DefaultCancel bool `toml:"default_cancel"`
 }
 
 // TaskFieldsConfig holds configuration for task fields.
@@ -71,8 +89,26 @@ type TaskFieldsConfig struct {
 
 // BoardConfig holds configuration for board.
 type BoardConfig struct {
-	ShowWIPWarnings bool   `toml:"show_wip_warnings"`
-	GroupBy         string `toml:"group_by"` // none | priority | state
+	ShowWIPWarnings     bool   `toml:"show_wip_warnings"`
+	GroupBy             string `toml:"group_by"` // none | priority | state | assignee
+	ColumnPageSize      int    `toml:"column_page_size"`
+	AutoCompleteParents bool   `toml:"auto_complete_parents"`
+	TitleWrap           bool   `toml:"title_wrap"`
+	TitleMaxLines       int    `toml:"title_max_lines"`
+	// WarnDuplicateTitles asks for confirmation before creating a task whose title closely matches an existing one.
+	WarnDuplicateTitles bool `toml:"warn_duplicate_titles"`
+	// DependencyBadges lists opt-in card badges in order: blocked_by (⛔N) | blocks (→N); empty, the default, hides them.
+	DependencyBadges []string `toml:"dependency_badges"`
+	// Columns maps default column names to the WIP limit applied when a new project's columns are created.
+	Columns map[string]int `toml:"columns"`
+	// DimBlocked fades unselected board cards that list blockers or a blocked reason.
+	DimBlocked bool `toml:"dim_blocked"`
+}
+
+// ProjectsConfig holds project picker and tab ordering configuration.
+type ProjectsConfig struct {
+	Sort   string   `toml:"sort"`   // none | alphabetical | recent | task_count | pinned
+	Pinned []string `toml:"pinned"` // project slugs leading the pinned sort, in order
 }
 
 // SearchConfig holds configuration for search.
@@ -109,13 +145,67 @@ type PathsConfig struct {
 
 // UIConfig holds configuration for UI behavior.
 type UIConfig struct {
-	DueSoonWindows []string `toml:"due_soon_windows"`
-	ShowDueSummary bool     `toml:"show_due_summary"`
+	DueSoonWindows    []string `toml:"due_soon_windows"`
+	ShowDueSummary    bool     `toml:"show_due_summary"`
+	StartupDueDigest  bool     `toml:"startup_due_digest"`
+	DefaultReminders  []string `toml:"default_reminders"` // lead times prefilled on new tasks, e.g. "1d"
+	EmptyColumnText   string   `toml:"empty_column_text"`
+	EmptyBoardMessage string   `toml:"empty_board_message"`
+	HighlightStyle    string   `toml:"highlight_style"` // color | bold | underline | reverse | bar
+	HighlightColor    string   `toml:"highlight_color"` // ANSI index (0-255) or #RRGGBB; empty keeps the built-in color
+	RenderIcons       bool     `toml:"render_icons"`    // false drops emoji project icons, keeping plain-ASCII markers
+	StatusSegments    []string `toml:"status_segments"` // info | focus | selection | attention | due | blocked | resources | status
+	RefreshOnFocus    bool     `toml:"refresh_on_focus"`
+	RefreshInterval   string   `toml:"refresh_interval"` // duration such as "30s"; empty keeps the 2s default and "0s" disables polling
+	// Layout breakpoints are terminal widths in cells; 0 keeps the built-in behavior.
+	NoticesPanel         string `toml:"notices_panel"`           // auto | never
+	NoticesPanelMinWidth int    `toml:"notices_panel_min_width"` // hide the notices panel below this width
+	CompactPaddingBelow  int    `toml:"compact_padding_below"`   // drop the outer gutter below this width
+	// DraftAutosaveInterval saves open task forms for crash recovery, e.g. "15s"; empty or "0s" disables drafts.
+	DraftAutosaveInterval string `toml:"draft_autosave_interval"`
+}
+
+// ProjectProfileConfig holds per-project view overrides; unset fields fall back to global settings.
+type ProjectProfileConfig struct {
+	GroupBy         string `toml:"group_by"` // none | priority | state | assignee
+	ColumnPageSize  *int   `toml:"column_page_size"`
+	HighlightStyle  string `toml:"highlight_style"` // color | bold | underline | reverse | bar
+	ShowPriority    *bool  `toml:"show_priority"`
+	ShowDueDate     *bool  `toml:"show_due_date"`
+	ShowLabels      *bool  `toml:"show_labels"`
+	ShowDescription *bool  `toml:"show_description"`
+	// Columns names the columns, in order, created when a project with this slug is created.
+	Columns []string `toml:"columns"`
+}
+
+// TaskTemplateConfig holds new-task form defaults offered by the new-from-template picker.
+type TaskTemplateConfig struct {
+	TitlePrefix string   `toml:"title_prefix"`
+	Priority    string   `toml:"priority"` // none | low | medium | high | critical; empty keeps the form default
+	Labels      []string `toml:"labels"`
+	Description string   `toml:"description"`
+}
+
+// SavedSearchConfig holds one named search filter set recalled by the load-search command.
+type SavedSearchConfig struct {
+	Query           string   `toml:"query"`
+	CrossProject    bool     `toml:"cross_project"`
+	IncludeArchived bool     `toml:"include_archived"`
+	IncludeComments bool     `toml:"include_comments"`
+	States          []string `toml:"states"` // todo | progress | done | archived; empty searches every state
+	Levels          []string `toml:"levels"` // project | branch | phase | task | subtask; empty searches every level
+	// The window bounds are the applied search bounds; unset leaves that side open, and before bounds are exclusive.
+	DueAfter      time.Time `toml:"due_after"`
+	DueBefore     time.Time `toml:"due_before"`
+	CreatedAfter  time.Time `toml:"created_after"`
+	CreatedBefore time.Time `toml:"created_before"`
 }
 
 // LoggingConfig holds runtime logging configuration.
 type LoggingConfig struct {
-	Level   string               `toml:"level"`
+	Level string `toml:"level"`
+	// Format selects the console formatter: text | logfmt | json. The dev-file sink always writes logfmt.
+	Format  string               `toml:"format"`
 	DevFile LoggingDevFileConfig `toml:"dev_file"`
 }
 
@@ -123,6 +213,10 @@ type LoggingConfig struct {
 type LoggingDevFileConfig struct {
 	Enabled bool   `toml:"enabled"`
 	Dir     string `toml:"dir"`
+	// MaxSizeMB rotates the active dev log into a new -NNN suffixed file once it passes this size; zero disables rotation.
+	MaxSizeMB int `toml:"max_size_mb"`
+	// MaxBackups caps how many rotated files are kept per day; zero keeps them all.
+	MaxBackups int `toml:"max_backups"`
 }
 
 // LabelConfig holds label suggestion and enforcement configuration.
@@ -130,16 +224,112 @@ type LabelConfig struct {
 	Global         []string            `toml:"global"`
 	Projects       map[string][]string `toml:"projects"`
 	EnforceAllowed bool                `toml:"enforce_allowed"`
+	// Colors maps a label to the ANSI index (0-255) or #RRGGBB color of the board glyph shown for tasks whose first label it is.
+	Colors map[string]string `toml:"colors"`
 }
 
 // KeyConfig holds configuration for key.
+// Values are one key or a comma-separated list such as "h,left"; empty values keep the built-in keys.
 type KeyConfig struct {
-	CommandPalette string `toml:"command_palette"`
-	QuickActions   string `toml:"quick_actions"`
-	MultiSelect    string `toml:"multi_select"`
-	ActivityLog    string `toml:"activity_log"`
-	Undo           string `toml:"undo"`
-	Redo           string `toml:"redo"`
+	CommandPalette   string `toml:"command_palette"`
+	QuickActions     string `toml:"quick_actions"`
+	MultiSelect      string `toml:"multi_select"`
+	ActivityLog      string `toml:"activity_log"`
+	Undo             string `toml:"undo"`
+	Redo             string `toml:"redo"`
+	Quit             string `toml:"quit"`
+	Reload           string `toml:"reload"`
+	ToggleHelp       string `toml:"toggle_help"`
+	FooterHelp       string `toml:"footer_help"`
+	MoveLeft         string `toml:"move_left"`
+	MoveRight        string `toml:"move_right"`
+	MoveUp           string `toml:"move_up"`
+	MoveDown         string `toml:"move_down"`
+	AddTask          string `toml:"add_task"`
+	TaskInfo         string `toml:"task_info"`
+	EditTask         string `toml:"edit_task"`
+	NewProject       string `toml:"new_project"`
+	EditProject      string `toml:"edit_project"`
+	DeleteTask       string `toml:"delete_task"`
+	ArchiveTask      string `toml:"archive_task"`
+	MoveTaskLeft     string `toml:"move_task_left"`
+	MoveTaskRight    string `toml:"move_task_right"`
+	MoveColumnLeft   string `toml:"move_column_left"`
+	MoveColumnRight  string `toml:"move_column_right"`
+	HardDeleteTask   string `toml:"hard_delete_task"`
+	RestoreTask      string `toml:"restore_task"`
+	Search           string `toml:"search"`
+	Projects         string `toml:"projects"`
+	ToggleArchived   string `toml:"toggle_archived"`
+	ToggleSelectMode string `toml:"toggle_select_mode"`
+	FocusSubtree     string `toml:"focus_subtree"`
+	ClearFocus       string `toml:"clear_focus"`
+	Inbox            string `toml:"inbox"`
+	PreviousProject  string `toml:"previous_project"`
+	JumpToTask       string `toml:"jump_to_task"`
+	QuickAssign      string `toml:"quick_assign"`
+	ToggleWatch      string `toml:"toggle_watch"`
+}
+
+// KeyAction describes one configurable board action: its [keys] name, built-in keys, and help text.
+type KeyAction struct {
+	// Name is the action's key under [keys], such as "move_left".
+	Name string
+	// Defaults lists the built-in keys, comma-separated, bound when the config value is empty.
+	Defaults string
+	// HelpKeys overrides the key glyph shown in help for the built-in keys; empty derives it from Defaults.
+	HelpKeys string
+	// Help describes the action in help overlays.
+	Help  string
+	value func(*KeyConfig) *string
+}
+
+// Value returns the configured keys for the action in k; empty means Defaults apply.
+func (a KeyAction) Value(k KeyConfig) string {
+	return *a.value(&k)
+}
+
+// KeyActions lists every configurable board action in help order.
+// It is the single default key table: config validation and the TUI key map are both built from it.
+var KeyActions = []KeyAction{
+	{Name: "quit", Defaults: "q,ctrl+c", HelpKeys: "q", Help: "quit", value: func(k *KeyConfig) *string { return &k.Quit }},
+	{Name: "reload", Defaults: "r", Help: "reload", value: func(k *KeyConfig) *string { return &k.Reload }},
+	{Name: "toggle_help", Defaults: "?", Help: "toggle help", value: func(k *KeyConfig) *string { return &k.ToggleHelp }},
+	{Name: "footer_help", Defaults: "H", Help: "cycle footer keys", value: func(k *KeyConfig) *string { return &k.FooterHelp }},
+	{Name: "move_left", Defaults: "h,left", HelpKeys: "h/←", Help: "column left", value: func(k *KeyConfig) *string { return &k.MoveLeft }},
+	{Name: "move_right", Defaults: "l,right", HelpKeys: "l/→", Help: "column right", value: func(k *KeyConfig) *string { return &k.MoveRight }},
+	{Name: "move_up", Defaults: "k,up", HelpKeys: "k/↑", Help: "move up", value: func(k *KeyConfig) *string { return &k.MoveUp }},
+	{Name: "move_down", Defaults: "j,down", HelpKeys: "j/↓", Help: "move down", value: func(k *KeyConfig) *string { return &k.MoveDown }},
+	{Name: "add_task", Defaults: "n", Help: "new task", value: func(k *KeyConfig) *string { return &k.AddTask }},
+	{Name: "task_info", Defaults: "i,enter", Help: "task info", value: func(k *KeyConfig) *string { return &k.TaskInfo }},
+	{Name: "edit_task", Defaults: "e", Help: "edit task", value: func(k *KeyConfig) *string { return &k.EditTask }},
+	{Name: "new_project", Defaults: "N", Help: "new project", value: func(k *KeyConfig) *string { return &k.NewProject }},
+	{Name: "edit_project", Defaults: "M", Help: "edit project", value: func(k *KeyConfig) *string { return &k.EditProject }},
+	{Name: "command_palette", Defaults: ":", Help: "command palette", value: func(k *KeyConfig) *string { return &k.CommandPalette }},
+	{Name: "quick_actions", Defaults: ".", Help: "quick actions", value: func(k *KeyConfig) *string { return &k.QuickActions }},
+	{Name: "delete_task", Defaults: "d", Help: "delete (default)", value: func(k *KeyConfig) *string { return &k.DeleteTask }},
+	{Name: "archive_task", Defaults: "a", Help: "archive task", value: func(k *KeyConfig) *string { return &k.ArchiveTask }},
+	{Name: "move_task_left", Defaults: "[", Help: "move task left", value: func(k *KeyConfig) *string { return &k.MoveTaskLeft }},
+	{Name: "move_task_right", Defaults: "]", Help: "move task right", value: func(k *KeyConfig) *string { return &k.MoveTaskRight }},
+	{Name: "move_column_left", Defaults: "<", Help: "move column left", value: func(k *KeyConfig) *string { return &k.MoveColumnLeft }},
+	{Name: "move_column_right", Defaults: ">", Help: "move column right", value: func(k *KeyConfig) *string { return &k.MoveColumnRight }},
+	{Name: "hard_delete_task", Defaults: "D", Help: "hard delete", value: func(k *KeyConfig) *string { return &k.HardDeleteTask }},
+	{Name: "restore_task", Defaults: "u", Help: "restore task", value: func(k *KeyConfig) *string { return &k.RestoreTask }},
+	{Name: "search", Defaults: "/", Help: "search", value: func(k *KeyConfig) *string { return &k.Search }},
+	{Name: "projects", Defaults: "p,P", Help: "project picker", value: func(k *KeyConfig) *string { return &k.Projects }},
+	{Name: "toggle_archived", Defaults: "t", Help: "toggle archived", value: func(k *KeyConfig) *string { return &k.ToggleArchived }},
+	{Name: "toggle_select_mode", Defaults: "ctrl+y", Help: "text select mode", value: func(k *KeyConfig) *string { return &k.ToggleSelectMode }},
+	{Name: "focus_subtree", Defaults: "f", Help: "focus subtree", value: func(k *KeyConfig) *string { return &k.FocusSubtree }},
+	{Name: "clear_focus", Defaults: "F", Help: "full board", value: func(k *KeyConfig) *string { return &k.ClearFocus }},
+	{Name: "multi_select", Defaults: "space", Help: "toggle select", value: func(k *KeyConfig) *string { return &k.MultiSelect }},
+	{Name: "activity_log", Defaults: "g", Help: "activity log", value: func(k *KeyConfig) *string { return &k.ActivityLog }},
+	{Name: "inbox", Defaults: "I", Help: "inbox triage", value: func(k *KeyConfig) *string { return &k.Inbox }},
+	{Name: "previous_project", Defaults: "`", Help: "previous project", value: func(k *KeyConfig) *string { return &k.PreviousProject }},
+	{Name: "jump_to_task", Defaults: "#", Help: "jump to task id", value: func(k *KeyConfig) *string { return &k.JumpToTask }},
+	{Name: "quick_assign", Defaults: "o", Help: "assign", value: func(k *KeyConfig) *string { return &k.QuickAssign }},
+	{Name: "toggle_watch", Defaults: "w", Help: "watch", value: func(k *KeyConfig) *string { return &k.ToggleWatch }},
+	{Name: "undo", Defaults: "ctrl+z", Help: "undo", value: func(k *KeyConfig) *string { return &k.Undo }},
+	{Name: "redo", Defaults: "ctrl+shift+z", Help: "redo", value: func(k *KeyConfig) *string { return &k.Redo }},
 }
 
 // Default returns default the requested value.
@@ -149,13 +339,15 @@ func Default(dbPath string) Config {
 			Path: dbPath,
 		},
 		Delete: DeleteConfig{
-			DefaultMode: DeleteModeArchive,
+			DefaultMode:  DeleteModeArchive,
+			ParentPolicy: "block",
 		},
 		Confirm: ConfirmConfig{
-			Delete:     true,
-			Archive:    true,
-			HardDelete: true,
-			Restore:    false,
+			Delete:        true,
+			Archive:       true,
+			HardDelete:    true,
+			Restore:       false,
+			DefaultCancel: true,
 		},
 		TaskFields: TaskFieldsConfig{
 			ShowPriority:    true,
@@ -164,8 +356,14 @@ func Default(dbPath string) Config {
 			ShowDescription: false,
 		},
 		Board: BoardConfig{
-			ShowWIPWarnings: true,
-			GroupBy:         "none",
+			ShowWIPWarnings:     true,
+			GroupBy:             "none",
+			TitleMaxLines:       2,
+			WarnDuplicateTitles: true,
+			DependencyBadges:    []string{},
+		},
+		Projects: ProjectsConfig{
+			Sort: "none",
 		},
 		Search: SearchConfig{
 			CrossProject:    false,
@@ -192,21 +390,36 @@ func Default(dbPath string) Config {
 			SearchRoots: []string{},
 		},
 		UI: UIConfig{
-			DueSoonWindows: []string{"24h", "1h"},
-			ShowDueSummary: true,
+			DueSoonWindows:   []string{"24h", "1h"},
+			ShowDueSummary:   true,
+			DefaultReminders: []string{},
+			EmptyColumnText:  "(empty)",
+			HighlightStyle:   "color",
+			RenderIcons:      true,
+			StatusSegments:   []string{"info", "focus", "selection", "status"},
+			RefreshOnFocus:   false,
+			RefreshInterval:  defaultRefreshInterval.String(),
+			NoticesPanel:     "auto",
 		},
 		Logging: LoggingConfig{
-			Level: defaultLogLevel,
+			Level:  defaultLogLevel,
+			Format: defaultLogFormat,
 			DevFile: LoggingDevFileConfig{
-				Enabled: true,
-				Dir:     defaultDevLogDir,
+				Enabled:    true,
+				Dir:        defaultDevLogDir,
+				MaxSizeMB:  defaultDevLogMaxSizeMB,
+				MaxBackups: defaultDevLogMaxBackups,
 			},
 		},
-		ProjectRoots: map[string]string{},
+		ProjectRoots:    map[string]string{},
+		ProjectProfiles: map[string]ProjectProfileConfig{},
+		Templates:       map[string]TaskTemplateConfig{},
+		Searches:        map[string]SavedSearchConfig{},
 		Labels: LabelConfig{
 			Global:         []string{},
 			Projects:       map[string][]string{},
 			EnforceAllowed: false,
+			Colors:         map[string]string{},
 		},
 		Keys: KeyConfig{
 			CommandPalette: ":",
@@ -267,12 +480,43 @@ func (c *Config) Validate() error {
 	default:
 		return fmt.Errorf("invalid delete.default_mode: %q", c.Delete.DefaultMode)
 	}
+	switch strings.TrimSpace(strings.ToLower(c.Delete.ParentPolicy)) {
+	case "", "cascade", "reparent-to-grandparent", "block":
+	default:
+		return fmt.Errorf("invalid delete.parent_policy: %q", c.Delete.ParentPolicy)
+	}
 
 	switch strings.TrimSpace(strings.ToLower(c.Board.GroupBy)) {
-	case "", "none", "priority", "state":
+	case "", "none", "priority", "state", "assignee":
 	default:
 		return fmt.Errorf("invalid board.group_by: %q", c.Board.GroupBy)
 	}
+	if c.Board.ColumnPageSize < 0 {
+		return fmt.Errorf("board.column_page_size must be >= 0")
+	}
+	if c.Board.TitleMaxLines < 1 {
+		return fmt.Errorf("board.title_max_lines must be >= 1")
+	}
+	// Names are checked in sorted order so the reported problem is the same on every run.
+	columnOwners := map[string]string{}
+	for _, name := range slices.Sorted(maps.Keys(c.Board.Columns)) {
+		key := strings.TrimSpace(strings.ToLower(name))
+		if key == "" {
+			return fmt.Errorf("board.columns keys must be non-empty column names")
+		}
+		if limit := c.Board.Columns[name]; limit < 0 {
+			return fmt.Errorf("board.columns.%q must be >= 0", name)
+		}
+		if owner, ok := columnOwners[key]; ok {
+			return fmt.Errorf("board.columns.%q and board.columns.%q name the same column", owner, name)
+		}
+		columnOwners[key] = name
+	}
+	switch strings.TrimSpace(strings.ToLower(c.Projects.Sort)) {
+	case "", "none", "alphabetical", "recent", "task_count", "pinned":
+	default:
+		return fmt.Errorf("invalid projects.sort: %q", c.Projects.Sort)
+	}
 	if c.Embeddings.Dimensions < 0 {
 		return fmt.Errorf("embeddings.dimensions must be >= 0")
 	}
@@ -328,6 +572,65 @@ func (c *Config) Validate() error {
 			return fmt.Errorf("ui.due_soon_windows[%d] must be > 0", i)
 		}
 	}
+	for i, raw := range c.UI.DefaultReminders {
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+		if _, err := domain.ParseReminderOffset(raw); err != nil {
+			return fmt.Errorf("ui.default_reminders[%d]: %w", i, err)
+		}
+	}
+	if err := validateHighlightColor(c.UI.HighlightColor); err != nil {
+		return err
+	}
+	switch strings.TrimSpace(strings.ToLower(c.UI.HighlightStyle)) {
+	case "", "color", "bold", "underline", "reverse", "bar":
+	default:
+		return fmt.Errorf("invalid ui.highlight_style: %q", c.UI.HighlightStyle)
+	}
+	if raw := strings.TrimSpace(c.UI.RefreshInterval); raw != "" {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("ui.refresh_interval invalid duration %q", c.UI.RefreshInterval)
+		}
+		if d < 0 {
+			return fmt.Errorf("ui.refresh_interval must be >= 0")
+		}
+	}
+	for i, raw := range c.Board.DependencyBadges {
+		switch strings.TrimSpace(strings.ToLower(raw)) {
+		case "blocked_by", "blocks":
+		default:
+			return fmt.Errorf("board.dependency_badges[%d] invalid badge %q", i, raw)
+		}
+	}
+	for i, raw := range c.UI.StatusSegments {
+		switch strings.TrimSpace(strings.ToLower(raw)) {
+		case "info", "focus", "selection", "attention", "due", "blocked", "resources", "status":
+		default:
+			return fmt.Errorf("ui.status_segments[%d] invalid segment %q", i, raw)
+		}
+	}
+	switch strings.TrimSpace(strings.ToLower(c.UI.NoticesPanel)) {
+	case "", "auto", "never":
+	default:
+		return fmt.Errorf("invalid ui.notices_panel: %q", c.UI.NoticesPanel)
+	}
+	if c.UI.NoticesPanelMinWidth < 0 {
+		return fmt.Errorf("ui.notices_panel_min_width must be >= 0")
+	}
+	if c.UI.CompactPaddingBelow < 0 {
+		return fmt.Errorf("ui.compact_padding_below must be >= 0")
+	}
+	if raw := strings.TrimSpace(c.UI.DraftAutosaveInterval); raw != "" {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("ui.draft_autosave_interval invalid duration %q", c.UI.DraftAutosaveInterval)
+		}
+		if d < 0 {
+			return fmt.Errorf("ui.draft_autosave_interval must be >= 0")
+		}
+	}
 	c.Logging.Level = strings.TrimSpace(strings.ToLower(c.Logging.Level))
 	if c.Logging.Level == "" {
 		c.Logging.Level = defaultLogLevel
@@ -337,10 +640,25 @@ func (c *Config) Validate() error {
 	default:
 		return fmt.Errorf("invalid logging.level: %q", c.Logging.Level)
 	}
+	c.Logging.Format = strings.TrimSpace(strings.ToLower(c.Logging.Format))
+	if c.Logging.Format == "" {
+		c.Logging.Format = defaultLogFormat
+	}
+	switch c.Logging.Format {
+	case "text", "logfmt", "json":
+	default:
+		return fmt.Errorf("invalid logging.format: %q (want text, logfmt, or json)", c.Logging.Format)
+	}
 	c.Logging.DevFile.Dir = strings.TrimSpace(c.Logging.DevFile.Dir)
 	if c.Logging.DevFile.Dir == "" {
 		c.Logging.DevFile.Dir = defaultDevLogDir
 	}
+	if c.Logging.DevFile.MaxSizeMB < 0 {
+		return fmt.Errorf("logging.dev_file.max_size_mb must be >= 0")
+	}
+	if c.Logging.DevFile.MaxBackups < 0 {
+		return fmt.Errorf("logging.dev_file.max_backups must be >= 0")
+	}
 	for key, rootPath := range c.ProjectRoots {
 		if strings.TrimSpace(key) == "" {
 			return errors.New("project_roots contains an empty key")
@@ -349,6 +667,79 @@ func (c *Config) Validate() error {
 			return fmt.Errorf("project_roots.%s path is empty", key)
 		}
 	}
+	for projectSlug, profile := range c.ProjectProfiles {
+		if strings.TrimSpace(projectSlug) == "" {
+			return errors.New("project_profiles contains an empty project key")
+		}
+		switch strings.TrimSpace(strings.ToLower(profile.GroupBy)) {
+		case "", "none", "priority", "state", "assignee":
+		default:
+			return fmt.Errorf("invalid project_profiles.%s.group_by: %q", projectSlug, profile.GroupBy)
+		}
+		if profile.ColumnPageSize != nil && *profile.ColumnPageSize < 0 {
+			return fmt.Errorf("project_profiles.%s.column_page_size must be >= 0", projectSlug)
+		}
+		switch strings.TrimSpace(strings.ToLower(profile.HighlightStyle)) {
+		case "", "color", "bold", "underline", "reverse", "bar":
+		default:
+			return fmt.Errorf("invalid project_profiles.%s.highlight_style: %q", projectSlug, profile.HighlightStyle)
+		}
+		seenColumns := map[string]struct{}{}
+		for _, column := range profile.Columns {
+			name := strings.TrimSpace(strings.ToLower(column))
+			if name == "" {
+				return fmt.Errorf("project_profiles.%s.columns contains an empty column name", projectSlug)
+			}
+			if _, ok := seenColumns[name]; ok {
+				return fmt.Errorf("project_profiles.%s.columns repeats column %q", projectSlug, column)
+			}
+			seenColumns[name] = struct{}{}
+		}
+	}
+	for name, template := range c.Templates {
+		if strings.TrimSpace(name) == "" {
+			return errors.New("templates contains an empty template name")
+		}
+		switch strings.TrimSpace(strings.ToLower(template.Priority)) {
+		case "", "none", "low", "medium", "high", "critical":
+		default:
+			return fmt.Errorf("invalid templates.%s.priority: %q", name, template.Priority)
+		}
+		for i, label := range template.Labels {
+			if strings.TrimSpace(label) == "" {
+				return fmt.Errorf("templates.%s.labels[%d] is empty", name, i)
+			}
+		}
+	}
+	for name, search := range c.Searches {
+		if strings.TrimSpace(name) == "" {
+			return errors.New("searches contains an empty search name")
+		}
+		for i, state := range search.States {
+			if !isKnownLifecycleState(state) {
+				return fmt.Errorf("searches.%s.states[%d] references unknown state %q", name, i, state)
+			}
+		}
+		for i, level := range search.Levels {
+			if !isKnownSearchLevel(level) {
+				return fmt.Errorf("searches.%s.levels[%d] references unknown level %q", name, i, level)
+			}
+		}
+		if !search.DueAfter.IsZero() && !search.DueBefore.IsZero() && !search.DueAfter.Before(search.DueBefore) {
+			return fmt.Errorf("searches.%s.due_before must be after due_after", name)
+		}
+		if !search.CreatedAfter.IsZero() && !search.CreatedBefore.IsZero() && !search.CreatedAfter.Before(search.CreatedBefore) {
+			return fmt.Errorf("searches.%s.created_before must be after created_after", name)
+		}
+	}
+	for label, value := range c.Labels.Colors {
+		if strings.TrimSpace(value) == "" {
+			return fmt.Errorf("labels.colors.%s is empty", label)
+		}
+		if err := validateColorValue("labels.colors."+label, value); err != nil {
+			return err
+		}
+	}
 	for projectSlug, labels := range c.Labels.Projects {
 		if strings.TrimSpace(projectSlug) == "" {
 			return errors.New("labels.projects contains an empty project key")
@@ -359,10 +750,59 @@ func (c *Config) Validate() error {
 			}
 		}
 	}
+	if err := validateKeyBindings(c.Keys); err != nil {
+		return err
+	}
+
+	return nil
+}
 
+// validateKeyBindings rejects configs where two board actions, after defaults apply, share a key.
+func validateKeyBindings(keys KeyConfig) error {
+	owners := map[string]string{}
+	for _, action := range KeyActions {
+		value := strings.TrimSpace(action.Value(keys))
+		if value == "" {
+			value = action.Defaults
+		}
+		for _, key := range canonicalBindingKeys(value) {
+			if owner, ok := owners[key]; ok && owner != action.Name {
+				return fmt.Errorf("keys.%s and keys.%s both bind %q", owner, action.Name, key)
+			}
+			owners[key] = action.Name
+		}
+	}
 	return nil
 }
 
+// canonicalBindingKeys expands one key value into the key strings the TUI matches.
+// Uppercase runes and their shift+ form name the same key, as do "space" and a literal space.
+func canonicalBindingKeys(value string) []string {
+	parts := []string{value}
+	if value != "," && strings.Contains(value, ",") {
+		parts = strings.Split(value, ",")
+	}
+	out := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part != " " {
+			part = strings.TrimSpace(part)
+		}
+		rs := []rune(part)
+		switch {
+		case part == "":
+			continue
+		case part == " " || strings.EqualFold(part, "space"):
+			part = "space"
+		case len(rs) == 1 && unicode.IsUpper(rs[0]):
+			part = "shift+" + strings.ToLower(part)
+		case len(rs) > 1:
+			part = strings.ToLower(part)
+		}
+		out = append(out, part)
+	}
+	return out
+}
+
 // DueSoonDurations handles due soon durations.
 func (c Config) DueSoonDurations() []time.Duration {
 	out := make([]time.Duration, 0, len(c.UI.DueSoonWindows))
@@ -388,6 +828,29 @@ func (c Config) DueSoonDurations() []time.Duration {
 	return out
 }
 
+// RefreshIntervalDuration returns the parsed board polling interval, or zero when polling is disabled.
+// An unset interval keeps the historical 2s polling.
+func (c Config) RefreshIntervalDuration() time.Duration {
+	raw := strings.TrimSpace(c.UI.RefreshInterval)
+	if raw == "" {
+		return defaultRefreshInterval
+	}
+	d, err := time.ParseDuration(raw)
+	if err != nil || d <= 0 {
+		return 0
+	}
+	return d
+}
+
+// DraftAutosaveIntervalDuration returns the parsed task-form draft autosave interval, or zero when drafts are disabled.
+func (c Config) DraftAutosaveIntervalDuration() time.Duration {
+	d, err := time.ParseDuration(strings.TrimSpace(c.UI.DraftAutosaveInterval))
+	if err != nil || d <= 0 {
+		return 0
+	}
+	return d
+}
+
 // AllowedLabels returns normalized allowed label suggestions for a project slug.
 func (c Config) AllowedLabels(projectSlug string) []string {
 	projectSlug = strings.TrimSpace(strings.ToLower(projectSlug))
@@ -475,6 +938,52 @@ func (c *Config) normalize() {
 		windows = []string{"24h", "1h"}
 	}
 	c.UI.DueSoonWindows = windows
+	reminders := make([]string, 0, len(c.UI.DefaultReminders))
+	for _, raw := range c.UI.DefaultReminders {
+		offset, err := domain.ParseReminderOffset(raw)
+		if err != nil {
+			continue
+		}
+		if reminder := domain.FormatReminderOffset(offset); !slices.Contains(reminders, reminder) {
+			reminders = append(reminders, reminder)
+		}
+	}
+	c.UI.DefaultReminders = reminders
+	c.Delete.ParentPolicy = strings.TrimSpace(strings.ToLower(c.Delete.ParentPolicy))
+	if c.Delete.ParentPolicy == "" {
+		c.Delete.ParentPolicy = "block"
+	}
+	c.UI.HighlightStyle = strings.TrimSpace(strings.ToLower(c.UI.HighlightStyle))
+	c.UI.HighlightColor = strings.TrimSpace(c.UI.HighlightColor)
+	if c.UI.HighlightStyle == "" {
+		c.UI.HighlightStyle = "color"
+	}
+	// An explicit empty list hides every status segment, so only dedupe here.
+	segments := make([]string, 0, len(c.UI.StatusSegments))
+	for _, raw := range c.UI.StatusSegments {
+		segment := strings.TrimSpace(strings.ToLower(raw))
+		if !slices.Contains(segments, segment) {
+			segments = append(segments, segment)
+		}
+	}
+	c.UI.StatusSegments = segments
+	badges := make([]string, 0, len(c.Board.DependencyBadges))
+	for _, raw := range c.Board.DependencyBadges {
+		badge := strings.TrimSpace(strings.ToLower(raw))
+		if !slices.Contains(badges, badge) {
+			badges = append(badges, badge)
+		}
+	}
+	c.Board.DependencyBadges = badges
+	columnLimits := make(map[string]int, len(c.Board.Columns))
+	for name, limit := range c.Board.Columns {
+		columnLimits[strings.TrimSpace(name)] = limit
+	}
+	c.Board.Columns = columnLimits
+	c.UI.NoticesPanel = strings.TrimSpace(strings.ToLower(c.UI.NoticesPanel))
+	if c.UI.NoticesPanel == "" {
+		c.UI.NoticesPanel = "auto"
+	}
 	c.Logging.Level = strings.TrimSpace(strings.ToLower(c.Logging.Level))
 	if c.Logging.Level == "" {
 		c.Logging.Level = defaultLogLevel
@@ -495,6 +1004,71 @@ func (c *Config) normalize() {
 	}
 	c.ProjectRoots = roots
 
+	c.Projects.Sort = strings.TrimSpace(strings.ToLower(c.Projects.Sort))
+	if c.Projects.Sort == "" {
+		c.Projects.Sort = "none"
+	}
+	// Pinned order is meaningful, so dedupe without sorting.
+	pinned := make([]string, 0, len(c.Projects.Pinned))
+	seenPinned := map[string]struct{}{}
+	for _, raw := range c.Projects.Pinned {
+		slug := strings.TrimSpace(strings.ToLower(raw))
+		if slug == "" {
+			continue
+		}
+		if _, ok := seenPinned[slug]; ok {
+			continue
+		}
+		seenPinned[slug] = struct{}{}
+		pinned = append(pinned, slug)
+	}
+	c.Projects.Pinned = pinned
+
+	profiles := make(map[string]ProjectProfileConfig, len(c.ProjectProfiles))
+	for rawKey, profile := range c.ProjectProfiles {
+		key := strings.TrimSpace(strings.ToLower(rawKey))
+		if key == "" {
+			continue
+		}
+		profile.GroupBy = strings.TrimSpace(strings.ToLower(profile.GroupBy))
+		profile.HighlightStyle = strings.TrimSpace(strings.ToLower(profile.HighlightStyle))
+		// Column order is meaningful and names keep their case, so only trim them.
+		columns := make([]string, 0, len(profile.Columns))
+		for _, column := range profile.Columns {
+			columns = append(columns, strings.TrimSpace(column))
+		}
+		profile.Columns = columns
+		profiles[key] = profile
+	}
+	c.ProjectProfiles = profiles
+
+	templates := make(map[string]TaskTemplateConfig, len(c.Templates))
+	for rawName, template := range c.Templates {
+		name := strings.TrimSpace(strings.ToLower(rawName))
+		if name == "" {
+			continue
+		}
+		template.Priority = strings.TrimSpace(strings.ToLower(template.Priority))
+		// Labels keep their configured order; empty entries are left for Validate to reject.
+		labels := make([]string, 0, len(template.Labels))
+		for _, label := range template.Labels {
+			labels = append(labels, strings.TrimSpace(label))
+		}
+		template.Labels = labels
+		templates[name] = template
+	}
+	c.Templates = templates
+
+	searches := make(map[string]SavedSearchConfig, len(c.Searches))
+	for rawName, search := range c.Searches {
+		name := strings.TrimSpace(strings.ToLower(rawName))
+		if name == "" {
+			continue
+		}
+		searches[name] = normalizeSavedSearch(search)
+	}
+	c.Searches = searches
+
 	c.Labels.Global = normalizeLabelConfigList(c.Labels.Global)
 	projectLabels := make(map[string][]string, len(c.Labels.Projects))
 	for rawKey, labels := range c.Labels.Projects {
@@ -506,12 +1080,27 @@ func (c *Config) normalize() {
 	}
 	c.Labels.Projects = projectLabels
 
+	labelColors := make(map[string]string, len(c.Labels.Colors))
+	for rawLabel, rawColor := range c.Labels.Colors {
+		label := strings.TrimSpace(strings.ToLower(rawLabel))
+		if label == "" {
+			continue
+		}
+		labelColors[label] = strings.TrimSpace(rawColor)
+	}
+	c.Labels.Colors = labelColors
+
 	c.Keys.CommandPalette = normalizeKeyBinding(c.Keys.CommandPalette, ":")
 	c.Keys.QuickActions = normalizeKeyBinding(c.Keys.QuickActions, ".")
 	c.Keys.MultiSelect = normalizeKeyBinding(c.Keys.MultiSelect, "space")
 	c.Keys.ActivityLog = normalizeKeyBinding(c.Keys.ActivityLog, "g")
 	c.Keys.Undo = normalizeKeyBinding(c.Keys.Undo, "z")
 	c.Keys.Redo = normalizeKeyBinding(c.Keys.Redo, "Z")
+	// The remaining actions stay empty when unset so the TUI keeps its built-in keys and help glyphs.
+	for _, action := range KeyActions {
+		value := action.value(&c.Keys)
+		*value = strings.TrimSpace(*value)
+	}
 }
 
 // normalizeLabelConfigList trims, lowercases, and deduplicates label config entries.
@@ -879,6 +1468,175 @@ func UpsertAllowedLabels(path, projectSlug string, globalLabels, projectLabels [
 	return nil
 }
 
+// UpsertSavedSearch writes one named [searches] entry to the config file, replacing any search with the same name.
+func UpsertSavedSearch(path, name string, search SavedSearchConfig) error {
+	configPath := strings.TrimSpace(path)
+	if configPath == "" {
+		return errors.New("config path is required")
+	}
+	name = strings.TrimSpace(strings.ToLower(name))
+	if name == "" {
+		return errors.New("search name is required")
+	}
+	search = normalizeSavedSearch(search)
+	for i, state := range search.States {
+		if !isKnownLifecycleState(state) {
+			return fmt.Errorf("searches.%s.states[%d] references unknown state %q", name, i, state)
+		}
+	}
+	for i, level := range search.Levels {
+		if !isKnownSearchLevel(level) {
+			return fmt.Errorf("searches.%s.levels[%d] references unknown level %q", name, i, level)
+		}
+	}
+
+	raw := map[string]any{}
+	content, err := os.ReadFile(configPath)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("read config: %w", err)
+		}
+	} else if len(content) > 0 {
+		if err := toml.Unmarshal(content, &raw); err != nil {
+			return fmt.Errorf("decode toml: %w", err)
+		}
+	}
+
+	searches := map[string]any{}
+	if tableValue, ok := raw["searches"]; ok {
+		table, ok := tableValue.(map[string]any)
+		if !ok {
+			return errors.New("searches must be a table")
+		}
+		for key, value := range table {
+			// Drop any differently-cased spelling of the name being replaced.
+			if strings.TrimSpace(strings.ToLower(key)) == name {
+				continue
+			}
+			searches[key] = value
+		}
+	}
+	// Only set fields are written, so saved entries stay as short as the filters they capture.
+	entry := map[string]any{}
+	if search.Query != "" {
+		entry["query"] = search.Query
+	}
+	if search.CrossProject {
+		entry["cross_project"] = true
+	}
+	if search.IncludeArchived {
+		entry["include_archived"] = true
+	}
+	if search.IncludeComments {
+		entry["include_comments"] = true
+	}
+	if len(search.States) > 0 {
+		entry["states"] = search.States
+	}
+	if len(search.Levels) > 0 {
+		entry["levels"] = search.Levels
+	}
+	for key, bound := range map[string]time.Time{
+		"due_after":      search.DueAfter,
+		"due_before":     search.DueBefore,
+		"created_after":  search.CreatedAfter,
+		"created_before": search.CreatedBefore,
+	} {
+		if !bound.IsZero() {
+			entry[key] = bound.UTC()
+		}
+	}
+	searches[name] = entry
+	raw["searches"] = searches
+
+	encoded, err := toml.Marshal(raw)
+	if err != nil {
+		return fmt.Errorf("encode toml: %w", err)
+	}
+	if err := EnsureConfigDir(configPath); err != nil {
+		return fmt.Errorf("ensure config dir: %w", err)
+	}
+	if err := os.WriteFile(configPath, encoded, 0o644); err != nil {
+		return fmt.Errorf("write config: %w", err)
+	}
+	return nil
+}
+
+// UpsertHighlightColor writes ui.highlight_color to the config file; an empty color clears it.
+func UpsertHighlightColor(path, color string) error {
+	configPath := strings.TrimSpace(path)
+	if configPath == "" {
+		return errors.New("config path is required")
+	}
+	color = strings.TrimSpace(color)
+	if err := validateHighlightColor(color); err != nil {
+		return err
+	}
+
+	raw := map[string]any{}
+	missing := false
+	content, err := os.ReadFile(configPath)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("read config: %w", err)
+		}
+		missing = true
+	} else if len(content) > 0 {
+		if err := toml.Unmarshal(content, &raw); err != nil {
+			return fmt.Errorf("decode toml: %w", err)
+		}
+	}
+	if missing && color == "" {
+		return nil
+	}
+
+	ui := map[string]any{}
+	if tableValue, ok := raw["ui"]; ok {
+		table, ok := tableValue.(map[string]any)
+		if !ok {
+			return errors.New("ui must be a table")
+		}
+		for key, value := range table {
+			ui[key] = value
+		}
+	}
+	if color == "" {
+		delete(ui, "highlight_color")
+	} else {
+		ui["highlight_color"] = color
+	}
+	if len(ui) == 0 {
+		delete(raw, "ui")
+	} else {
+		raw["ui"] = ui
+	}
+
+	encoded, err := toml.Marshal(raw)
+	if err != nil {
+		return fmt.Errorf("encode toml: %w", err)
+	}
+	if err := EnsureConfigDir(configPath); err != nil {
+		return fmt.Errorf("ensure config dir: %w", err)
+	}
+	if err := os.WriteFile(configPath, encoded, 0o644); err != nil {
+		return fmt.Errorf("write config: %w", err)
+	}
+	return nil
+}
+
+// validateHighlightColor accepts an empty value, an ANSI color index (0-255), or a #RRGGBB hex color.
+func validateHighlightColor(raw string) error {
+	return validateColorValue("ui.highlight_color", raw)
+}
+
+// validateColorValue accepts an empty value, an ANSI color index (0-255), or a #RRGGBB hex color for one config field.
+func validateColorValue(field, raw string) error {
+	if value := strings.TrimSpace(raw); value == "" || domain.ValidColor(value) {
+		return nil
+	}
+	return fmt.Errorf("invalid %s: %q (want an ANSI index 0-255 or #RRGGBB)", field, raw)
+}
+
 // decodeStringList coerces TOML list values into normalized string slices.
 func decodeStringList(value any, field string) ([]string, error) {
 	switch list := value.(type) {
@@ -931,6 +1689,19 @@ func decodeTrimmedStringList(value any, field string) ([]string, error) {
 	}
 }
 
+// isKnownSearchLevel reports whether level names a search level filter value.
+func isKnownSearchLevel(level string) bool {
+	return slices.Contains([]string{"project", "branch", "phase", "task", "subtask"}, level)
+}
+
+// normalizeSavedSearch trims text fields and lowercases and deduplicates the state and level filters.
+func normalizeSavedSearch(search SavedSearchConfig) SavedSearchConfig {
+	search.Query = strings.TrimSpace(search.Query)
+	search.States = normalizeLabelConfigList(search.States)
+	search.Levels = normalizeLabelConfigList(search.Levels)
+	return search
+}
+
 // isKnownLifecycleState reports whether the requested condition is satisfied.
 func isKnownLifecycleState(state string) bool {
 	return slices.Contains([]string{"todo", "progress", "done", "archived"}, state)
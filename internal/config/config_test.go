@@ -2,10 +2,16 @@ package config
 
 import (
 	"errors"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/hylla/tillsyn/internal/domain"
 )
 
 // TestDefaultConfig verifies behavior for the covered scenario.
@@ -14,14 +20,23 @@ func TestDefaultConfig(t *testing.T) {
 	if cfg.Database.Path != "/tmp/tillsyn.db" {
 		t.Fatalf("unexpected db path %q", cfg.Database.Path)
 	}
+	if cfg.Database.ReadOnlyFallback {
+		t.Fatal("expected readonly_fallback to be opt-in")
+	}
 	if cfg.Delete.DefaultMode != DeleteModeArchive {
 		t.Fatalf("unexpected delete mode %q", cfg.Delete.DefaultMode)
 	}
+	if cfg.Delete.ParentPolicy != "block" {
+		t.Fatalf("unexpected parent delete policy %q", cfg.Delete.ParentPolicy)
+	}
 	if !cfg.Confirm.Delete || !cfg.Confirm.Archive || !cfg.Confirm.HardDelete {
 		t.Fatalf("unexpected confirm defaults %#v", cfg.Confirm)
 	}
-	if cfg.Confirm.Restore {
-		t.Fatalf("expected restore confirm disabled by default, got %#v", cfg.Confirm)
+	if cfg.Confirm.Restore || cfg.Confirm.Quit {
+		t.Fatalf("expected restore and quit confirms disabled by default, got %#v", cfg.Confirm)
+	}
+	if !cfg.Confirm.DefaultCancel {
+		t.Fatalf("expected confirm dialogs to default to cancel, got %#v", cfg.Confirm)
 	}
 	if !cfg.TaskFields.ShowPriority || !cfg.TaskFields.ShowDueDate || !cfg.TaskFields.ShowLabels {
 		t.Fatal("expected priority/due_date/labels enabled by default")
@@ -35,9 +50,45 @@ func TestDefaultConfig(t *testing.T) {
 	if !cfg.UI.ShowDueSummary {
 		t.Fatal("expected due summary enabled by default")
 	}
+	if !cfg.UI.RenderIcons {
+		t.Fatal("expected icon rendering enabled by default")
+	}
+	if len(cfg.UI.DefaultReminders) != 0 {
+		t.Fatalf("expected no default reminders, got %#v", cfg.UI.DefaultReminders)
+	}
+	if cfg.UI.EmptyColumnText != "(empty)" || cfg.UI.EmptyBoardMessage != "" {
+		t.Fatalf("unexpected empty-state defaults %#v", cfg.UI)
+	}
+	if got := cfg.UI.StatusSegments; !slices.Equal(got, []string{"info", "focus", "selection", "status"}) {
+		t.Fatalf("unexpected default status segments %#v", got)
+	}
+	if cfg.UI.RefreshOnFocus || cfg.RefreshIntervalDuration() != 2*time.Second {
+		t.Fatalf("expected refresh on focus off and 2s polling by default, got %#v", cfg.UI)
+	}
+	if cfg.UI.NoticesPanel != "auto" || cfg.UI.NoticesPanelMinWidth != 0 || cfg.UI.CompactPaddingBelow != 0 {
+		t.Fatalf("expected built-in layout breakpoints by default, got %#v", cfg.UI)
+	}
+	if cfg.DraftAutosaveIntervalDuration() != 0 {
+		t.Fatalf("expected task-form drafts off by default, got %#v", cfg.UI)
+	}
+	if cfg.Board.TitleWrap || cfg.Board.TitleMaxLines != 2 {
+		t.Fatalf("expected truncated titles with a two-line wrap limit by default, got %#v", cfg.Board)
+	}
+	if !cfg.Board.WarnDuplicateTitles {
+		t.Fatalf("expected duplicate title warnings on by default, got %#v", cfg.Board)
+	}
+	if got := cfg.Board.DependencyBadges; len(got) != 0 {
+		t.Fatalf("expected dependency badges off by default, got %#v", got)
+	}
+	if cfg.Projects.Sort != "none" || len(cfg.Projects.Pinned) != 0 {
+		t.Fatalf("expected projects in load order by default, got %#v", cfg.Projects)
+	}
 	if cfg.Logging.Level != "info" {
 		t.Fatalf("expected default logging level info, got %q", cfg.Logging.Level)
 	}
+	if cfg.Logging.Format != "text" {
+		t.Fatalf("expected default logging format text, got %q", cfg.Logging.Format)
+	}
 	if !cfg.Logging.DevFile.Enabled {
 		t.Fatal("expected dev file logging enabled by default")
 	}
@@ -77,6 +128,7 @@ func TestLoadFileOverridesDefaults(t *testing.T) {
 	content := `
 [database]
 path = "/custom/tillsyn.db"
+readonly_fallback = true
 
 [delete]
 default_mode = "hard"
@@ -86,6 +138,8 @@ delete = true
 archive = false
 hard_delete = true
 restore = true
+quit = true
+default_cancel = false
 
 [task_fields]
 show_priority = true
@@ -96,6 +150,18 @@ show_description = true
 [ui]
 due_soon_windows = ["12h", "45m"]
 show_due_summary = false
+startup_due_digest = true
+render_icons = false
+default_reminders = ["24h", "1D", "90m"]
+empty_column_text = "nothing here"
+empty_board_message = "Welcome to the team board."
+status_segments = ["Due", "status", "due"]
+refresh_on_focus = true
+refresh_interval = "30s"
+notices_panel = " Never "
+notices_panel_min_width = 140
+compact_padding_below = 90
+draft_autosave_interval = "15s"
 `
 	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
 		t.Fatalf("WriteFile() error = %v", err)
@@ -108,6 +174,9 @@ show_due_summary = false
 	if cfg.Database.Path != "/custom/tillsyn.db" {
 		t.Fatalf("unexpected db path %q", cfg.Database.Path)
 	}
+	if !cfg.Database.ReadOnlyFallback {
+		t.Fatal("expected readonly_fallback enabled from config override")
+	}
 	if cfg.Delete.DefaultMode != DeleteModeHard {
 		t.Fatalf("unexpected delete mode %q", cfg.Delete.DefaultMode)
 	}
@@ -120,9 +189,40 @@ show_due_summary = false
 	if cfg.Confirm.Archive {
 		t.Fatalf("expected archive confirm false, got %#v", cfg.Confirm)
 	}
+	if !cfg.Confirm.Quit {
+		t.Fatalf("expected quit confirm true from config override, got %#v", cfg.Confirm)
+	}
+	if cfg.Confirm.DefaultCancel {
+		t.Fatalf("expected default_cancel false from config override, got %#v", cfg.Confirm)
+	}
 	if cfg.UI.ShowDueSummary {
 		t.Fatal("expected due summary hidden from config override")
 	}
+	if !cfg.UI.StartupDueDigest {
+		t.Fatal("expected startup due digest enabled from config override")
+	}
+	if cfg.UI.RenderIcons {
+		t.Fatal("expected icon rendering disabled from config override")
+	}
+	// 24h and 1D are the same lead time, so normalization keeps one canonical entry.
+	if got := cfg.UI.DefaultReminders; !slices.Equal(got, []string{"1d", "90m"}) {
+		t.Fatalf("expected normalized default reminders [1d 90m], got %#v", got)
+	}
+	if cfg.UI.EmptyColumnText != "nothing here" || cfg.UI.EmptyBoardMessage != "Welcome to the team board." {
+		t.Fatalf("unexpected empty-state overrides %#v", cfg.UI)
+	}
+	if got := cfg.UI.StatusSegments; !slices.Equal(got, []string{"due", "status"}) {
+		t.Fatalf("expected normalized status segments [due status], got %#v", got)
+	}
+	if !cfg.UI.RefreshOnFocus || cfg.RefreshIntervalDuration() != 30*time.Second {
+		t.Fatalf("unexpected refresh overrides %#v", cfg.UI)
+	}
+	if cfg.UI.NoticesPanel != "never" || cfg.UI.NoticesPanelMinWidth != 140 || cfg.UI.CompactPaddingBelow != 90 {
+		t.Fatalf("unexpected layout overrides %#v", cfg.UI)
+	}
+	if cfg.DraftAutosaveIntervalDuration() != 15*time.Second {
+		t.Fatalf("unexpected draft autosave override %#v", cfg.UI)
+	}
 }
 
 // TestLoadIdentityAndPathsOverrides verifies behavior for the covered scenario.
@@ -211,6 +311,23 @@ default_mode = "weird"
 	}
 }
 
+// TestLoadRejectsInvalidParentDeletePolicy verifies behavior for the covered scenario.
+func TestLoadRejectsInvalidParentDeletePolicy(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.toml")
+	content := `
+[delete]
+parent_policy = "orphan"
+`
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	_, err := Load(path, Default("/tmp/default.db"))
+	if err == nil || !strings.Contains(err.Error(), "delete.parent_policy") {
+		t.Fatalf("expected invalid delete.parent_policy error, got %v", err)
+	}
+}
+
 // TestEnsureConfigDir verifies behavior for the covered scenario.
 func TestEnsureConfigDir(t *testing.T) {
 	target := filepath.Join(t.TempDir(), "a", "b", "config.toml")
@@ -233,6 +350,21 @@ path = "/custom/tillsyn.db"
 [board]
 show_wip_warnings = false
 group_by = "priority"
+column_page_size = 40
+auto_complete_parents = true
+title_wrap = true
+title_max_lines = 3
+warn_duplicate_titles = false
+dependency_badges = ["Blocks", "blocks"]
+dim_blocked = true
+
+[board.columns]
+" In Progress " = 3
+done = 0
+
+[projects]
+sort = " Pinned "
+pinned = ["Ops", "", "inbox", "ops"]
 
 [search]
 cross_project = true
@@ -257,6 +389,8 @@ multi_select = "space"
 activity_log = "g"
 undo = "u"
 redo = "U"
+restore_task = "ctrl+r"
+move_left = " A , left "
 `
 	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
 		t.Fatalf("WriteFile() error = %v", err)
@@ -266,18 +400,34 @@ redo = "U"
 	if err != nil {
 		t.Fatalf("Load() error = %v", err)
 	}
-	if cfg.Board.GroupBy != "priority" || cfg.Board.ShowWIPWarnings {
+	if cfg.Board.GroupBy != "priority" || cfg.Board.ShowWIPWarnings || cfg.Board.ColumnPageSize != 40 || !cfg.Board.AutoCompleteParents {
+		t.Fatalf("unexpected board settings %#v", cfg.Board)
+	}
+	if !cfg.Board.TitleWrap || cfg.Board.TitleMaxLines != 3 || cfg.Board.WarnDuplicateTitles || !cfg.Board.DimBlocked {
 		t.Fatalf("unexpected board settings %#v", cfg.Board)
 	}
+	if got := cfg.Board.DependencyBadges; !slices.Equal(got, []string{"blocks"}) {
+		t.Fatalf("expected normalized dependency badges [blocks], got %#v", got)
+	}
+	if got := cfg.Board.Columns; !maps.Equal(got, map[string]int{"In Progress": 3, "done": 0}) {
+		t.Fatalf("expected trimmed board column WIP limits, got %#v", got)
+	}
+	if cfg.Projects.Sort != "pinned" || !slices.Equal(cfg.Projects.Pinned, []string{"ops", "inbox"}) {
+		t.Fatalf("expected normalized projects settings with pinned order kept, got %#v", cfg.Projects)
+	}
 	if !cfg.Search.CrossProject || !cfg.Search.IncludeArchived {
 		t.Fatalf("unexpected search settings %#v", cfg.Search)
 	}
 	if len(cfg.Search.States) != 3 {
 		t.Fatalf("unexpected search states %#v", cfg.Search.States)
 	}
-	if cfg.Keys.QuickActions != "." {
+	if cfg.Keys.QuickActions != "." || cfg.Keys.RestoreTask != "ctrl+r" || cfg.Keys.MoveLeft != "A , left" {
 		t.Fatalf("unexpected keys config %#v", cfg.Keys)
 	}
+	// Unset actions stay empty so the TUI keeps its built-in keys.
+	if cfg.Keys.MoveRight != "" {
+		t.Fatalf("expected unset move_right to stay empty, got %q", cfg.Keys.MoveRight)
+	}
 	if got := cfg.DueSoonDurations(); len(got) != 2 || got[0] != 2*time.Hour || got[1] != 48*time.Hour {
 		t.Fatalf("unexpected due durations %#v", got)
 	}
@@ -292,6 +442,57 @@ redo = "U"
 	}
 }
 
+// TestValidateRejectsConflictingKeys verifies two actions cannot bind the same key, including built-in defaults.
+func TestValidateRejectsConflictingKeys(t *testing.T) {
+	cases := []struct {
+		name string
+		set  func(*KeyConfig)
+		want string
+	}{
+		{
+			name: "two overrides",
+			set:  func(k *KeyConfig) { k.Search = "x"; k.Inbox = "x" },
+			want: `keys.search and keys.inbox both bind "x"`,
+		},
+		{
+			name: "override against built-in default",
+			set:  func(k *KeyConfig) { k.Undo = "u" },
+			want: `keys.restore_task and keys.undo both bind "u"`,
+		},
+		{
+			name: "uppercase matches shift form",
+			set:  func(k *KeyConfig) { k.ArchiveTask = "shift+d" },
+			want: `keys.archive_task and keys.hard_delete_task both bind "shift+d"`,
+		},
+		{
+			name: "list entry",
+			set:  func(k *KeyConfig) { k.MoveLeft = "a,left" },
+			want: `keys.move_left and keys.archive_task both bind "a"`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := Default("/tmp/tillsyn.db")
+			tc.set(&cfg.Keys)
+			err := cfg.Validate()
+			if err == nil || err.Error() != tc.want {
+				t.Fatalf("expected error %q, got %v", tc.want, err)
+			}
+		})
+	}
+
+	// Remapping vim navigation away from h/j/k/l is valid once nothing else uses the new keys.
+	cfg := Default("/tmp/tillsyn.db")
+	cfg.Keys.MoveLeft = "left"
+	cfg.Keys.MoveDown = "down"
+	cfg.Keys.MoveUp = "up"
+	cfg.Keys.MoveRight = "right"
+	cfg.Keys.ArchiveTask = "h"
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+}
+
 // TestValidateRejectsUnknownSearchState verifies behavior for the covered scenario.
 func TestValidateRejectsUnknownSearchState(t *testing.T) {
 	cfg := Default("/tmp/tillsyn.db")
@@ -301,6 +502,15 @@ func TestValidateRejectsUnknownSearchState(t *testing.T) {
 	}
 }
 
+// TestValidateRejectsUnknownProjectSort verifies projects.sort only accepts the documented orderings.
+func TestValidateRejectsUnknownProjectSort(t *testing.T) {
+	cfg := Default("/tmp/tillsyn.db")
+	cfg.Projects.Sort = "by-color"
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("expected unknown project sort validation error")
+	}
+}
+
 // TestValidateRejectsInvalidDueSoonWindow verifies behavior for the covered scenario.
 func TestValidateRejectsInvalidDueSoonWindow(t *testing.T) {
 	cfg := Default("/tmp/tillsyn.db")
@@ -310,6 +520,122 @@ func TestValidateRejectsInvalidDueSoonWindow(t *testing.T) {
 	}
 }
 
+// TestValidateRejectsUnknownDependencyBadge verifies only known card badges are accepted.
+func TestValidateRejectsUnknownDependencyBadge(t *testing.T) {
+	cfg := Default("/tmp/tillsyn.db")
+	cfg.Board.DependencyBadges = []string{"blocks", "related"}
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "board.dependency_badges[1]") {
+		t.Fatalf("expected unknown dependency badge error, got %v", err)
+	}
+}
+
+// TestValidateRejectsInvalidBoardColumnLimit verifies default column WIP limits need a name and a non-negative limit.
+func TestValidateRejectsInvalidBoardColumnLimit(t *testing.T) {
+	cfg := Default("/tmp/tillsyn.db")
+	cfg.Board.Columns = map[string]int{"In Progress": -1}
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "board.columns") {
+		t.Fatalf("expected negative column WIP limit error, got %v", err)
+	}
+	cfg.Board.Columns = map[string]int{" ": 2}
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "board.columns") {
+		t.Fatalf("expected blank column name error, got %v", err)
+	}
+	// Keys differing only in case collide, and the pair is reported in sorted order every time.
+	cfg.Board.Columns = map[string]int{"review": 1, "Review": 2, "Done": 0}
+	for range 10 {
+		err := cfg.Validate()
+		if err == nil || err.Error() != `board.columns."Review" and board.columns."review" name the same column` {
+			t.Fatalf("expected deterministic duplicate column error, got %v", err)
+		}
+	}
+}
+
+// TestValidateRejectsInvalidDefaultReminder verifies default reminders must be positive lead times.
+func TestValidateRejectsInvalidDefaultReminder(t *testing.T) {
+	for _, raw := range []string{"soon", "0h", "-1d"} {
+		cfg := Default("/tmp/tillsyn.db")
+		cfg.UI.DefaultReminders = []string{"1d", raw}
+		if err := cfg.Validate(); !errors.Is(err, domain.ErrInvalidReminderOffset) || !strings.Contains(err.Error(), "ui.default_reminders[1]") {
+			t.Fatalf("expected invalid reminder error for %q, got %v", raw, err)
+		}
+	}
+}
+
+// TestValidateRejectsInvalidHighlightStyle verifies behavior for the covered scenario.
+func TestValidateRejectsInvalidHighlightStyle(t *testing.T) {
+	cfg := Default("/tmp/tillsyn.db")
+	cfg.UI.HighlightStyle = "blink"
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("expected invalid highlight style validation error")
+	}
+	cfg.UI.HighlightStyle = " Reverse "
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("expected case-insensitive highlight style, got %v", err)
+	}
+}
+
+// TestValidateRejectsInvalidStatusSegment verifies behavior for the covered scenario.
+func TestValidateRejectsInvalidStatusSegment(t *testing.T) {
+	cfg := Default("/tmp/tillsyn.db")
+	cfg.UI.StatusSegments = []string{"due", "clock"}
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "ui.status_segments[1]") {
+		t.Fatalf("expected invalid status segment error, got %v", err)
+	}
+	cfg.UI.StatusSegments = []string{}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("expected empty status segments to be valid, got %v", err)
+	}
+}
+
+// TestValidateRejectsInvalidRefreshInterval verifies behavior for the covered scenario.
+func TestValidateRejectsInvalidRefreshInterval(t *testing.T) {
+	cfg := Default("/tmp/tillsyn.db")
+	for _, raw := range []string{"soon", "-5s"} {
+		cfg.UI.RefreshInterval = raw
+		if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "ui.refresh_interval") {
+			t.Fatalf("expected invalid refresh interval error for %q, got %v", raw, err)
+		}
+	}
+	cfg.UI.RefreshInterval = "0s"
+	if err := cfg.Validate(); err != nil || cfg.RefreshIntervalDuration() != 0 {
+		t.Fatalf("expected 0s to disable polling, got err=%v interval=%v", err, cfg.RefreshIntervalDuration())
+	}
+	cfg.UI.RefreshInterval = ""
+	if err := cfg.Validate(); err != nil || cfg.RefreshIntervalDuration() != 2*time.Second {
+		t.Fatalf("expected an unset interval to keep 2s polling, got err=%v interval=%v", err, cfg.RefreshIntervalDuration())
+	}
+}
+
+// TestValidateRejectsInvalidDraftAutosaveInterval verifies behavior for the covered scenario.
+func TestValidateRejectsInvalidDraftAutosaveInterval(t *testing.T) {
+	cfg := Default("/tmp/tillsyn.db")
+	for _, raw := range []string{"often", "-1m"} {
+		cfg.UI.DraftAutosaveInterval = raw
+		if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "ui.draft_autosave_interval") {
+			t.Fatalf("expected invalid draft autosave interval error for %q, got %v", raw, err)
+		}
+	}
+}
+
+// TestValidateRejectsInvalidLayoutBreakpoints verifies behavior for the covered scenario.
+func TestValidateRejectsInvalidLayoutBreakpoints(t *testing.T) {
+	cfg := Default("/tmp/tillsyn.db")
+	cfg.UI.NoticesPanel = "sometimes"
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "ui.notices_panel") {
+		t.Fatalf("expected invalid notices panel error, got %v", err)
+	}
+	cfg = Default("/tmp/tillsyn.db")
+	cfg.UI.NoticesPanelMinWidth = -1
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "ui.notices_panel_min_width") {
+		t.Fatalf("expected negative notices panel width error, got %v", err)
+	}
+	cfg = Default("/tmp/tillsyn.db")
+	cfg.UI.CompactPaddingBelow = -1
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "ui.compact_padding_below") {
+		t.Fatalf("expected negative compact padding width error, got %v", err)
+	}
+}
+
 // TestValidateRejectsInvalidLoggingLevel verifies behavior for the covered scenario.
 func TestValidateRejectsInvalidLoggingLevel(t *testing.T) {
 	cfg := Default("/tmp/tillsyn.db")
@@ -319,6 +645,39 @@ func TestValidateRejectsInvalidLoggingLevel(t *testing.T) {
 	}
 }
 
+// TestValidateLoggingFormat verifies logging.format normalization and the unknown-value error.
+func TestValidateLoggingFormat(t *testing.T) {
+	cfg := Default("/tmp/tillsyn.db")
+	cfg.Logging.Format = " JSON "
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if cfg.Logging.Format != "json" {
+		t.Fatalf("expected normalized logging format json, got %q", cfg.Logging.Format)
+	}
+	cfg.Logging.Format = "yaml"
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "want text, logfmt, or json") {
+		t.Fatalf("expected invalid logging format error listing choices, got %v", err)
+	}
+}
+
+// TestValidateRejectsNegativeDevLogRotation verifies dev-file rotation limits must be non-negative.
+func TestValidateRejectsNegativeDevLogRotation(t *testing.T) {
+	cfg := Default("/tmp/tillsyn.db")
+	if cfg.Logging.DevFile.MaxSizeMB != 10 || cfg.Logging.DevFile.MaxBackups != 5 {
+		t.Fatalf("expected default dev log rotation 10MB/5 backups, got %#v", cfg.Logging.DevFile)
+	}
+	cfg.Logging.DevFile.MaxSizeMB = -1
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "max_size_mb") {
+		t.Fatalf("expected max_size_mb validation error, got %v", err)
+	}
+	cfg = Default("/tmp/tillsyn.db")
+	cfg.Logging.DevFile.MaxBackups = -1
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "max_backups") {
+		t.Fatalf("expected max_backups validation error, got %v", err)
+	}
+}
+
 // TestValidateRejectsInvalidIdentityActorType verifies behavior for the covered scenario.
 func TestValidateRejectsInvalidIdentityActorType(t *testing.T) {
 	cfg := Default("/tmp/tillsyn.db")
@@ -397,6 +756,130 @@ func TestValidateRejectsEmptyProjectRoot(t *testing.T) {
 	}
 }
 
+// TestLoadProjectProfiles verifies per-project view profiles load with normalized keys and optional fields.
+func TestLoadProjectProfiles(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.toml")
+	content := `
+[database]
+path = "/custom/tillsyn.db"
+
+[project_profiles.Roadmap]
+group_by = "Priority"
+column_page_size = 10
+show_description = true
+columns = [" Ideas ", "Planned", "Shipped"]
+`
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	cfg, err := Load(path, Default("/tmp/default.db"))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	profile, ok := cfg.ProjectProfiles["roadmap"]
+	if !ok {
+		t.Fatalf("expected lowercased roadmap profile, got %#v", cfg.ProjectProfiles)
+	}
+	if profile.GroupBy != "priority" || profile.ColumnPageSize == nil || *profile.ColumnPageSize != 10 {
+		t.Fatalf("unexpected profile overrides %#v", profile)
+	}
+	// Unset toggles stay nil so the global task_fields value still applies.
+	if profile.ShowDescription == nil || !*profile.ShowDescription || profile.ShowPriority != nil {
+		t.Fatalf("unexpected profile field toggles %#v", profile)
+	}
+	if want := []string{"Ideas", "Planned", "Shipped"}; !slices.Equal(profile.Columns, want) {
+		t.Fatalf("profile columns = %#v, want %#v", profile.Columns, want)
+	}
+
+	cfg.ProjectProfiles["roadmap"] = ProjectProfileConfig{GroupBy: "assignee"}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("expected assignee profile group_by to validate, got %v", err)
+	}
+	cfg.ProjectProfiles["roadmap"] = ProjectProfileConfig{GroupBy: "owner"}
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("expected error for invalid profile group_by")
+	}
+	cfg.ProjectProfiles["roadmap"] = ProjectProfileConfig{Columns: []string{"Ideas", "ideas"}}
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("expected error for repeated profile column names")
+	}
+}
+
+// TestLoadTemplates verifies task templates load with normalized names and priorities.
+func TestLoadTemplates(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.toml")
+	content := `
+[database]
+path = "/custom/tillsyn.db"
+
+[templates.Bug]
+title_prefix = "bug: "
+priority = "High"
+labels = [" bug ", "triage"]
+description = "## Steps"
+`
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	cfg, err := Load(path, Default("/tmp/default.db"))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	template, ok := cfg.Templates["bug"]
+	if !ok {
+		t.Fatalf("expected lowercased bug template, got %#v", cfg.Templates)
+	}
+	// The title prefix keeps its trailing space so typed titles follow it directly.
+	if template.TitlePrefix != "bug: " || template.Priority != "high" || template.Description != "## Steps" {
+		t.Fatalf("unexpected template values %#v", template)
+	}
+	if len(template.Labels) != 2 || template.Labels[0] != "bug" || template.Labels[1] != "triage" {
+		t.Fatalf("unexpected template labels %#v", template.Labels)
+	}
+
+	cfg.Templates["bug"] = TaskTemplateConfig{Priority: "urgent"}
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("expected error for invalid template priority")
+	}
+	cfg.Templates["bug"] = TaskTemplateConfig{Labels: []string{""}}
+	if err := cfg.Validate(); err == nil {
+		t.Fatal("expected error for empty template label")
+	}
+}
+
+// TestLoadLabelColors verifies label colors load with lowercased labels and reject invalid colors.
+func TestLoadLabelColors(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.toml")
+	content := `
+[database]
+path = "/custom/tillsyn.db"
+
+[labels.colors]
+Bug = " 196 "
+planning = "#5FAFFF"
+`
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	cfg, err := Load(path, Default("/tmp/default.db"))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.Labels.Colors["bug"] != "196" || cfg.Labels.Colors["planning"] != "#5FAFFF" {
+		t.Fatalf("unexpected label colors %#v", cfg.Labels.Colors)
+	}
+
+	cfg.Labels.Colors["bug"] = "red"
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "labels.colors.bug") {
+		t.Fatalf("expected invalid label color error naming the label, got %v", err)
+	}
+}
+
 // TestUpsertProjectRootWritesAndClearsMapping verifies behavior for the covered scenario.
 func TestUpsertProjectRootWritesAndClearsMapping(t *testing.T) {
 	path := filepath.Join(t.TempDir(), "config.toml")
@@ -694,3 +1177,132 @@ func TestUpsertAllowedLabelsRejectsInvalidInput(t *testing.T) {
 		t.Fatal("expected error for empty project slug")
 	}
 }
+
+// TestUpsertHighlightColorWritesAndClears verifies ui.highlight_color round-trips without disturbing other [ui] keys.
+func TestUpsertHighlightColorWritesAndClears(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	content := `
+[ui]
+highlight_style = "bar"
+`
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	if err := UpsertHighlightColor(path, " #1E90FF "); err != nil {
+		t.Fatalf("UpsertHighlightColor() error = %v", err)
+	}
+	cfg, err := Load(path, Default("/tmp/default.db"))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.UI.HighlightColor != "#1E90FF" || cfg.UI.HighlightStyle != "bar" {
+		t.Fatalf("expected persisted highlight color with style kept, got %#v", cfg.UI)
+	}
+
+	if err := UpsertHighlightColor(path, ""); err != nil {
+		t.Fatalf("UpsertHighlightColor(clear) error = %v", err)
+	}
+	cfg, err = Load(path, Default("/tmp/default.db"))
+	if err != nil {
+		t.Fatalf("Load() after clear error = %v", err)
+	}
+	if cfg.UI.HighlightColor != "" || cfg.UI.HighlightStyle != "bar" {
+		t.Fatalf("expected highlight color cleared with style kept, got %#v", cfg.UI)
+	}
+}
+
+// TestUpsertHighlightColorRejectsInvalidInput verifies only ANSI indexes and #RRGGBB colors are written.
+func TestUpsertHighlightColorRejectsInvalidInput(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	if err := UpsertHighlightColor("", "212"); err == nil {
+		t.Fatal("expected error for empty config path")
+	}
+	for _, color := range []string{"256", "-1", "#12345", "#GGGGGG", "pink"} {
+		if err := UpsertHighlightColor(path, color); err == nil {
+			t.Fatalf("expected invalid highlight color %q to be rejected", color)
+		}
+	}
+	if _, err := os.Stat(path); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected rejected colors to leave the config unwritten, stat error = %v", err)
+	}
+	cfg := Default("/tmp/tillsyn.db")
+	cfg.UI.HighlightColor = "pink"
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "ui.highlight_color") {
+		t.Fatalf("expected invalid ui.highlight_color validation error, got %v", err)
+	}
+}
+
+// TestCheckReportsEveryProblem verifies Check collects all config problems instead of stopping at the first.
+func TestCheckReportsEveryProblem(t *testing.T) {
+	dir := t.TempDir()
+	notDir := filepath.Join(dir, "file.txt")
+	if err := os.WriteFile(notDir, []byte("x"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	path := filepath.Join(dir, "config.toml")
+	content := strings.Join([]string{
+		"colour = \"red\"",
+		"[logging]",
+		"level = \"verbose\"",
+		"[search]",
+		"states = [\"todo\", \"blocked\"]",
+		"[paths]",
+		"search_roots = [" + strconv.Quote(dir) + ", " + strconv.Quote(notDir) + "]",
+		"[project_roots]",
+		"Inbox = \"/tmp/a\"",
+		"inbox = \"/tmp/b\"",
+		"[ui]",
+		"highlight_color = \"purple\"",
+		"[labels.colors]",
+		"bug = \"#12345\"",
+		"[board]",
+		"group_by = \"owner\"",
+	}, "\n")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	problems, err := Check(path, Default("/tmp/tillsyn.db"))
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	joined := strings.Join(problems, "\n")
+	for _, want := range []string{
+		`unknown key "colour"`,
+		`invalid logging.level: "verbose"`,
+		`search.states[1] references unknown state "blocked"`,
+		`is not a directory`,
+		`project_roots keys ["Inbox" "inbox"] all map to project slug "inbox"`,
+		`invalid ui.highlight_color: "purple"`,
+		`invalid labels.colors.bug: "#12345"`,
+		`invalid board.group_by: "owner"`,
+	} {
+		if !strings.Contains(joined, want) {
+			t.Fatalf("expected problem %q, got:\n%s", want, joined)
+		}
+	}
+	// Problems Validate also catches are reported once.
+	if strings.Count(joined, "invalid logging.level") != 1 {
+		t.Fatalf("expected deduped problems, got:\n%s", joined)
+	}
+
+	// A clean file and a missing file both pass.
+	if err := os.WriteFile(path, []byte("[logging]\nlevel = \"debug\"\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if problems, err := Check(path, Default("/tmp/tillsyn.db")); err != nil || len(problems) != 0 {
+		t.Fatalf("expected clean config, got %q, %v", problems, err)
+	}
+	if problems, err := Check(filepath.Join(dir, "missing.toml"), Default("/tmp/tillsyn.db")); err != nil || len(problems) != 0 {
+		t.Fatalf("expected missing config to pass, got %q, %v", problems, err)
+	}
+
+	// Broken TOML syntax is a single problem.
+	if err := os.WriteFile(path, []byte("[logging\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if problems, err := Check(path, Default("/tmp/tillsyn.db")); err != nil || len(problems) != 1 || !strings.HasPrefix(problems[0], "decode toml") {
+		t.Fatalf("expected one decode problem, got %q, %v", problems, err)
+	}
+}
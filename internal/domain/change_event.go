@@ -37,4 +37,8 @@ type DependencyRollup struct {
 	BlockedItems              int
 	BlockedByEdges            int
 	UnresolvedDependencyEdges int
+	// UnresolvedByTask maps each open task id to its depends_on and blocked_by references that are missing or not done.
+	UnresolvedByTask map[string]int
+	// BlockingByTask maps each task id to how many open tasks list it in depends_on or blocked_by.
+	BlockingByTask map[string]int
 }
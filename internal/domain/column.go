@@ -70,6 +70,16 @@ func (c *Column) SetPosition(position int, now time.Time) error {
 	return nil
 }
 
+// SetWIPLimit sets the column work-in-progress limit; zero means unlimited.
+func (c *Column) SetWIPLimit(limit int, now time.Time) error {
+	if limit < 0 {
+		return ErrInvalidWIPLimit
+	}
+	c.WIPLimit = limit
+	c.UpdatedAt = now.UTC()
+	return nil
+}
+
 // Archive archives the requested operation.
 func (c *Column) Archive(now time.Time) {
 	ts := now.UTC()
@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"errors"
+	"strings"
 	"testing"
 	"time"
 )
@@ -87,6 +89,20 @@ func TestProjectUpdateDetailsWithMetadata(t *testing.T) {
 	}
 }
 
+// TestValidColor verifies ANSI indexes and six-digit hex colors are accepted and anything else rejected.
+func TestValidColor(t *testing.T) {
+	for _, value := range []string{"0", "212", "255", "#00ffAA"} {
+		if !ValidColor(value) {
+			t.Fatalf("ValidColor(%q) = false, want true", value)
+		}
+	}
+	for _, value := range []string{"", "256", "-1", "#fff", "#gggggg", "red"} {
+		if ValidColor(value) {
+			t.Fatalf("ValidColor(%q) = true, want false", value)
+		}
+	}
+}
+
 // TestNewColumnValidation verifies behavior for the covered scenario.
 func TestNewColumnValidation(t *testing.T) {
 	now := time.Now()
@@ -119,6 +135,9 @@ func TestColumnMutations(t *testing.T) {
 	if c.Position != 3 {
 		t.Fatalf("unexpected position %d", c.Position)
 	}
+	if err := c.SetWIPLimit(-1, now); !errors.Is(err, ErrInvalidWIPLimit) || c.WIPLimit != 5 {
+		t.Fatalf("expected ErrInvalidWIPLimit leaving the limit at 5, got %v (limit %d)", err, c.WIPLimit)
+	}
 }
 
 // TestNewTaskDefaultsAndLabels verifies behavior for the covered scenario.
@@ -164,6 +183,28 @@ func TestNewTaskValidation(t *testing.T) {
 	}
 }
 
+// TestTaskPriorityLevels verifies none and critical are accepted alongside the original levels.
+func TestTaskPriorityLevels(t *testing.T) {
+	now := time.Now()
+	for _, priority := range []Priority{PriorityNone, PriorityLow, PriorityMedium, PriorityHigh, PriorityCritical} {
+		task, err := NewTask(TaskInput{ID: "t1", ProjectID: "p1", ColumnID: "c1", Title: "x", Priority: priority}, now)
+		if err != nil {
+			t.Fatalf("NewTask(%q) error = %v", priority, err)
+		}
+		if task.Priority != priority {
+			t.Fatalf("expected priority %q, got %q", priority, task.Priority)
+		}
+	}
+	// An unset priority still defaults to medium rather than none.
+	task, err := NewTask(TaskInput{ID: "t2", ProjectID: "p1", ColumnID: "c1", Title: "x"}, now)
+	if err != nil || task.Priority != PriorityMedium {
+		t.Fatalf("expected default medium priority, got %q (err %v)", task.Priority, err)
+	}
+	if got := Priorities(); len(got) != 5 || got[0] != PriorityNone || got[4] != PriorityCritical {
+		t.Fatalf("unexpected priority order %#v", got)
+	}
+}
+
 // TestTaskMoveUpdateArchiveRestore verifies behavior for the covered scenario.
 func TestTaskMoveUpdateArchiveRestore(t *testing.T) {
 	now := time.Now()
@@ -307,6 +348,29 @@ func TestTaskLifecycleTransitions(t *testing.T) {
 	}
 }
 
+// TestUpdatePlanningMetadataKeepsLifecycleBookkeeping verifies planning edits cannot reset lifecycle flags.
+func TestUpdatePlanningMetadataKeepsLifecycleBookkeeping(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	task, err := NewTask(TaskInput{
+		ID:        "t-meta",
+		ProjectID: "p1",
+		ColumnID:  "c1",
+		Title:     "planned",
+		Priority:  PriorityLow,
+	}, now)
+	if err != nil {
+		t.Fatalf("NewTask() error = %v", err)
+	}
+	task.Metadata.ManuallyReopened = true
+	task.Metadata.LifecycleStateExplicit = true
+	if err := task.UpdatePlanningMetadata(TaskMetadata{Objective: "new goal"}, "", ActorTypeUser, now); err != nil {
+		t.Fatalf("UpdatePlanningMetadata() error = %v", err)
+	}
+	if task.Metadata.Objective != "new goal" || !task.Metadata.ManuallyReopened || !task.Metadata.LifecycleStateExplicit {
+		t.Fatalf("expected lifecycle flags preserved across planning edits, got %#v", task.Metadata)
+	}
+}
+
 // TestTaskContractUnmetChecks verifies behavior for the covered scenario.
 func TestTaskContractUnmetChecks(t *testing.T) {
 	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
@@ -369,3 +433,103 @@ func TestNewTaskRejectsInvalidMetadata(t *testing.T) {
 		t.Fatal("expected invalid context type error")
 	}
 }
+
+// TestTaskReminders verifies reminder offsets normalize on create and fire ahead of the due date.
+func TestTaskReminders(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	due := now.Add(72 * time.Hour)
+	task, err := NewTask(TaskInput{
+		ID:        "t-remind",
+		ProjectID: "p1",
+		ColumnID:  "c1",
+		Title:     "ship",
+		Priority:  PriorityMedium,
+		DueAt:     &due,
+		Metadata:  TaskMetadata{Reminders: []string{" 2h ", "1D", "24h", "", "1w"}},
+	}, now)
+	if err != nil {
+		t.Fatalf("NewTask() error = %v", err)
+	}
+	// 24h and 1d collapse into one offset; the longest lead time sorts first.
+	if got := strings.Join(task.Metadata.Reminders, ","); got != "1w,1d,2h" {
+		t.Fatalf("expected normalized reminders 1w,1d,2h, got %q", got)
+	}
+	if times := task.ReminderTimes(); len(times) != 3 || !times[0].Equal(due.Add(-7*24*time.Hour)) || !times[2].Equal(due.Add(-2*time.Hour)) {
+		t.Fatalf("unexpected reminder times %#v", times)
+	}
+
+	cases := []struct {
+		at   time.Time
+		want time.Time
+		ok   bool
+	}{
+		{at: now, want: due.Add(-7 * 24 * time.Hour), ok: true},
+		{at: due.Add(-90 * time.Minute), want: due.Add(-2 * time.Hour), ok: true},
+		{at: due, ok: false},
+	}
+	for _, tc := range cases {
+		got, ok := task.ActiveReminder(tc.at)
+		if ok != tc.ok || !got.Equal(tc.want) {
+			t.Fatalf("ActiveReminder(%s) = %s, %t; want %s, %t", tc.at, got, ok, tc.want, tc.ok)
+		}
+	}
+
+	task.DueAt = nil
+	if _, ok := task.ActiveReminder(now); ok {
+		t.Fatal("expected no active reminder without a due date")
+	}
+
+	for _, raw := range []string{"0h", "-1d", "soon", "d"} {
+		if _, err := ParseReminderOffset(raw); !errors.Is(err, ErrInvalidReminderOffset) {
+			t.Fatalf("ParseReminderOffset(%q) expected ErrInvalidReminderOffset, got %v", raw, err)
+		}
+	}
+	if _, err := NewTask(TaskInput{ID: "t-bad", ProjectID: "p1", ColumnID: "c1", Title: "bad", Priority: PriorityMedium, Metadata: TaskMetadata{Reminders: []string{"soon"}}}, now); !errors.Is(err, ErrInvalidReminderOffset) {
+		t.Fatalf("expected NewTask to reject invalid reminder, got %v", err)
+	}
+}
+
+// TestTaskRecurrence verifies recurrence intervals normalize on create and advance along the calendar.
+func TestTaskRecurrence(t *testing.T) {
+	now := time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC)
+	task, err := NewTask(TaskInput{
+		ID:        "t-chore",
+		ProjectID: "p1",
+		ColumnID:  "c1",
+		Title:     "water plants",
+		Priority:  PriorityLow,
+		Metadata:  TaskMetadata{RecurEvery: " 1MO "},
+	}, now)
+	if err != nil {
+		t.Fatalf("NewTask() error = %v", err)
+	}
+	if task.Metadata.RecurEvery != "1mo" {
+		t.Fatalf("expected normalized recurrence 1mo, got %q", task.Metadata.RecurEvery)
+	}
+
+	cases := []struct {
+		every string
+		want  time.Time
+	}{
+		{every: "3d", want: time.Date(2026, 2, 3, 9, 0, 0, 0, time.UTC)},
+		{every: "2w", want: time.Date(2026, 2, 14, 9, 0, 0, 0, time.UTC)},
+		// Calendar months normalize overflow the same way time.AddDate does.
+		{every: "1mo", want: time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC)},
+		{every: "1y", want: time.Date(2027, 1, 31, 9, 0, 0, 0, time.UTC)},
+	}
+	for _, tc := range cases {
+		got, err := AdvanceRecurrence(now, tc.every)
+		if err != nil || !got.Equal(tc.want) {
+			t.Fatalf("AdvanceRecurrence(%q) = %s, %v; want %s", tc.every, got, err, tc.want)
+		}
+	}
+
+	for _, raw := range []string{"0d", "-1w", "weekly", "mo", "2h"} {
+		if _, err := NormalizeRecurrence(raw); !errors.Is(err, ErrInvalidRecurrence) {
+			t.Fatalf("NormalizeRecurrence(%q) expected ErrInvalidRecurrence, got %v", raw, err)
+		}
+	}
+	if _, err := NewTask(TaskInput{ID: "t-bad", ProjectID: "p1", ColumnID: "c1", Title: "bad", Priority: PriorityMedium, Metadata: TaskMetadata{RecurEvery: "sometimes"}}, now); !errors.Is(err, ErrInvalidRecurrence) {
+		t.Fatalf("expected NewTask to reject invalid recurrence, got %v", err)
+	}
+}
@@ -41,4 +41,8 @@ var (
 	ErrOverrideTokenRequired    = errors.New("override token is required for overlapping orchestrator lease")
 	ErrOverrideTokenInvalid     = errors.New("override token is invalid")
 	ErrTransitionBlocked        = errors.New("transition blocked by completion contract")
+	ErrWIPLimitExceeded         = errors.New("wip limit exceeded")
+	ErrInvalidWIPLimit          = errors.New("invalid wip limit")
+	ErrInvalidReminderOffset    = errors.New("invalid reminder offset")
+	ErrInvalidRecurrence        = errors.New("invalid recurrence")
 )
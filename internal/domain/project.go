@@ -3,6 +3,7 @@ package domain
 import (
 	"bytes"
 	"encoding/json"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -146,6 +147,16 @@ func normalizeSlug(s string) string {
 	return out
 }
 
+// ValidColor reports whether value is a terminal color the TUI can render: an ANSI index (0-255) or a #RRGGBB hex color.
+func ValidColor(value string) bool {
+	if hex, ok := strings.CutPrefix(value, "#"); ok {
+		_, err := strconv.ParseUint(hex, 16, 32)
+		return err == nil && len(hex) == 6
+	}
+	index, err := strconv.Atoi(value)
+	return err == nil && index >= 0 && index <= 255
+}
+
 // normalizeProjectMetadata normalizes project metadata.
 func normalizeProjectMetadata(meta ProjectMetadata) (ProjectMetadata, error) {
 	meta.Owner = strings.TrimSpace(meta.Owner)
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// recurrenceUnits lists the calendar units a recurrence interval accepts: days, weeks, months, and years.
+var recurrenceUnits = []string{"d", "w", "mo", "y"}
+
+// parseRecurrence splits one recurrence interval such as "3d", "1w", "1mo", or "1y" into its count and unit.
+func parseRecurrence(raw string) (int, string, error) {
+	text := strings.TrimSpace(strings.ToLower(raw))
+	for _, unit := range recurrenceUnits {
+		digits, ok := strings.CutSuffix(text, unit)
+		if !ok {
+			continue
+		}
+		count, err := strconv.Atoi(digits)
+		if err != nil || count <= 0 {
+			break
+		}
+		return count, unit, nil
+	}
+	return 0, "", fmt.Errorf("%w: %q (want a positive interval such as 3d, 1w, 1mo, or 1y)", ErrInvalidRecurrence, raw)
+}
+
+// NormalizeRecurrence canonicalizes one recurrence interval; an empty value means the task does not repeat.
+func NormalizeRecurrence(raw string) (string, error) {
+	if strings.TrimSpace(raw) == "" {
+		return "", nil
+	}
+	count, unit, err := parseRecurrence(raw)
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(count) + unit, nil
+}
+
+// AdvanceRecurrence returns from moved forward by one recurrence interval.
+// Months and years follow the calendar, so a monthly task due on the 15th stays on the 15th.
+func AdvanceRecurrence(from time.Time, every string) (time.Time, error) {
+	count, unit, err := parseRecurrence(every)
+	if err != nil {
+		return time.Time{}, err
+	}
+	switch unit {
+	case "d":
+		return from.AddDate(0, 0, count), nil
+	case "w":
+		return from.AddDate(0, 0, 7*count), nil
+	case "mo":
+		return from.AddDate(0, count, 0), nil
+	default:
+		return from.AddDate(count, 0, 0), nil
+	}
+}
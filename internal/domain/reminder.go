@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// reminderDay and reminderWeek are the calendar units ParseReminderOffset accepts beyond time.ParseDuration.
+const (
+	reminderDay  = 24 * time.Hour
+	reminderWeek = 7 * reminderDay
+)
+
+// ParseReminderOffset parses one reminder lead time before the due date, such as "30m", "2h", "1d", or "1w".
+func ParseReminderOffset(raw string) (time.Duration, error) {
+	text := strings.TrimSpace(strings.ToLower(raw))
+	var (
+		offset time.Duration
+		err    error
+	)
+	switch {
+	case strings.HasSuffix(text, "d"), strings.HasSuffix(text, "w"):
+		unit := reminderDay
+		if strings.HasSuffix(text, "w") {
+			unit = reminderWeek
+		}
+		var count int
+		count, err = strconv.Atoi(text[:len(text)-1])
+		offset = time.Duration(count) * unit
+	default:
+		offset, err = time.ParseDuration(text)
+	}
+	if err != nil || offset <= 0 {
+		return 0, fmt.Errorf("%w: %q (want a positive duration such as 2h, 1d, or 1w)", ErrInvalidReminderOffset, raw)
+	}
+	return offset, nil
+}
+
+// FormatReminderOffset renders one reminder lead time in its largest whole unit.
+func FormatReminderOffset(offset time.Duration) string {
+	switch {
+	case offset%reminderWeek == 0:
+		return strconv.FormatInt(int64(offset/reminderWeek), 10) + "w"
+	case offset%reminderDay == 0:
+		return strconv.FormatInt(int64(offset/reminderDay), 10) + "d"
+	case offset%time.Hour == 0:
+		return strconv.FormatInt(int64(offset/time.Hour), 10) + "h"
+	case offset%time.Minute == 0:
+		return strconv.FormatInt(int64(offset/time.Minute), 10) + "m"
+	default:
+		return offset.String()
+	}
+}
+
+// normalizeReminderOffsets canonicalizes reminder lead times, dropping duplicates and ordering earliest reminder first.
+func normalizeReminderOffsets(raw []string) ([]string, error) {
+	offsets := make([]time.Duration, 0, len(raw))
+	for _, value := range raw {
+		if strings.TrimSpace(value) == "" {
+			continue
+		}
+		offset, err := ParseReminderOffset(value)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(offsets, offset) {
+			offsets = append(offsets, offset)
+		}
+	}
+	if len(offsets) == 0 {
+		return nil, nil
+	}
+	// The longest lead time fires first, so list it first.
+	slices.SortFunc(offsets, func(a, b time.Duration) int { return cmp.Compare(b, a) })
+	out := make([]string, 0, len(offsets))
+	for _, offset := range offsets {
+		out = append(out, FormatReminderOffset(offset))
+	}
+	return out, nil
+}
+
+// ReminderTimes returns when each configured reminder fires, earliest first; tasks without a due date have none.
+func (t Task) ReminderTimes() []time.Time {
+	if t.DueAt == nil {
+		return nil
+	}
+	out := make([]time.Time, 0, len(t.Metadata.Reminders))
+	for _, raw := range t.Metadata.Reminders {
+		offset, err := ParseReminderOffset(raw)
+		if err != nil {
+			continue
+		}
+		out = append(out, t.DueAt.UTC().Add(-offset))
+	}
+	slices.SortFunc(out, time.Time.Compare)
+	return out
+}
+
+// ActiveReminder returns the most recent reminder that has fired at now while the task is not yet due.
+// Once the due date passes the task is overdue instead, so no reminder is active.
+func (t Task) ActiveReminder(now time.Time) (time.Time, bool) {
+	if t.DueAt == nil || !now.Before(t.DueAt.UTC()) {
+		return time.Time{}, false
+	}
+	var (
+		latest time.Time
+		found  bool
+	)
+	for _, at := range t.ReminderTimes() {
+		if at.After(now) {
+			break
+		}
+		latest, found = at, true
+	}
+	return latest, found
+}
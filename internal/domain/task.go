@@ -12,13 +12,27 @@ type Priority string
 
 // PriorityLow and related constants define package defaults.
 const (
+	// PriorityNone marks a task with no priority; boards render it without a priority marker.
+	PriorityNone   Priority = "none"
 	PriorityLow    Priority = "low"
 	PriorityMedium Priority = "medium"
 	PriorityHigh   Priority = "high"
+	// PriorityCritical ranks above high and gets a distinct marker on the board.
+	PriorityCritical Priority = "critical"
 )
 
-// validPriorities stores a package-level helper value.
-var validPriorities = []Priority{PriorityLow, PriorityMedium, PriorityHigh}
+// validPriorities stores the supported priorities from lowest to highest.
+var validPriorities = []Priority{PriorityNone, PriorityLow, PriorityMedium, PriorityHigh, PriorityCritical}
+
+// Priorities returns the supported priorities from lowest to highest.
+func Priorities() []Priority {
+	return slices.Clone(validPriorities)
+}
+
+// IsValidPriority reports whether priority is one of the supported priorities.
+func IsValidPriority(priority Priority) bool {
+	return slices.Contains(validPriorities, priority)
+}
 
 // Task represents task data used by this package.
 type Task struct {
@@ -117,7 +131,7 @@ func NewTask(in TaskInput, now time.Time) (Task, error) {
 	if in.Priority == "" {
 		in.Priority = PriorityMedium
 	}
-	if !slices.Contains(validPriorities, in.Priority) {
+	if !IsValidPriority(in.Priority) {
 		return Task{}, ErrInvalidPriority
 	}
 	if in.Kind == "" {
@@ -206,7 +220,7 @@ func (t *Task) UpdateDetails(title, description string, priority Priority, dueAt
 	if title == "" {
 		return ErrInvalidTitle
 	}
-	if !slices.Contains(validPriorities, priority) {
+	if !IsValidPriority(priority) {
 		return ErrInvalidPriority
 	}
 	t.Title = title
@@ -227,6 +241,9 @@ func (t *Task) UpdatePlanningMetadata(metadata TaskMetadata, actorID string, act
 	if err != nil {
 		return err
 	}
+	// Lifecycle bookkeeping is owned by state transitions, not planning edits.
+	normalized.ManuallyReopened = t.Metadata.ManuallyReopened
+	normalized.LifecycleStateExplicit = t.Metadata.LifecycleStateExplicit
 	t.Metadata = normalized
 	actorID = strings.TrimSpace(actorID)
 	if actorID == "" {
@@ -268,6 +285,25 @@ func (t *Task) SetLifecycleState(state LifecycleState, now time.Time) error {
 	return nil
 }
 
+// ChangeKind converts a task to another work kind and scope, such as promoting a task to a branch.
+func (t *Task) ChangeKind(kind WorkKind, scope KindAppliesTo, now time.Time) error {
+	kind = WorkKind(NormalizeKindID(KindID(kind)))
+	if kind == "" {
+		return ErrInvalidKind
+	}
+	scope = NormalizeKindAppliesTo(scope)
+	if !IsValidWorkItemAppliesTo(scope) {
+		return ErrInvalidKindAppliesTo
+	}
+	if scope == KindAppliesToSubtask && strings.TrimSpace(t.ParentID) == "" {
+		return ErrInvalidParentID
+	}
+	t.Kind = kind
+	t.Scope = scope
+	t.UpdatedAt = now.UTC()
+	return nil
+}
+
 // Reparent changes the parent relationship of a task.
 func (t *Task) Reparent(parentID string, now time.Time) error {
 	parentID = strings.TrimSpace(parentID)
@@ -129,6 +129,13 @@ type ResourceRef struct {
 	Notes          string       `json:"notes"`
 	Tags           []string     `json:"tags"`
 	LastVerifiedAt *time.Time   `json:"last_verified_at,omitempty"`
+	// MissingSince records when verification first found a local ref's path gone; cleared once it resolves again.
+	MissingSince *time.Time `json:"missing_since,omitempty"`
+}
+
+// IsStale reports whether the last resource verification could not find the ref's path.
+func (r ResourceRef) IsStale() bool {
+	return r.MissingSince != nil
 }
 
 // TaskMetadata stores rich planning context for an item.
@@ -140,6 +147,7 @@ type TaskMetadata struct {
 	DefinitionOfDone         string             `json:"definition_of_done"`
 	ValidationPlan           string             `json:"validation_plan"`
 	BlockedReason            string             `json:"blocked_reason"`
+	Assignee                 string             `json:"assignee,omitempty"` // owner used for board swimlanes
 	RiskNotes                string             `json:"risk_notes"`
 	CommandSnippets          []string           `json:"command_snippets"`
 	ExpectedOutputs          []string           `json:"expected_outputs"`
@@ -148,10 +156,15 @@ type TaskMetadata struct {
 	TransitionNotes          string             `json:"transition_notes"`
 	DependsOn                []string           `json:"depends_on"`
 	BlockedBy                []string           `json:"blocked_by"`
+	Reminders                []string           `json:"reminders,omitempty"`   // lead times before DueAt, e.g. "1d"
+	RecurEvery               string             `json:"recur_every,omitempty"` // repeat interval after completion, e.g. "1w"
+	Watched                  bool               `json:"watched,omitempty"`     // personal focus flag listed by the watched view
 	ContextBlocks            []ContextBlock     `json:"context_blocks"`
 	ResourceRefs             []ResourceRef      `json:"resource_refs"`
 	KindPayload              json.RawMessage    `json:"kind_payload,omitempty"`
 	CompletionContract       CompletionContract `json:"completion_contract"`
+	ManuallyReopened         bool               `json:"manually_reopened,omitempty"`
+	LifecycleStateExplicit   bool               `json:"lifecycle_state_explicit,omitempty"`
 }
 
 // normalizeLifecycleState canonicalizes lifecycle state aliases.
@@ -196,6 +209,7 @@ func normalizeTaskMetadata(meta TaskMetadata) (TaskMetadata, error) {
 	meta.DefinitionOfDone = strings.TrimSpace(meta.DefinitionOfDone)
 	meta.ValidationPlan = strings.TrimSpace(meta.ValidationPlan)
 	meta.BlockedReason = strings.TrimSpace(meta.BlockedReason)
+	meta.Assignee = strings.TrimSpace(meta.Assignee)
 	meta.RiskNotes = strings.TrimSpace(meta.RiskNotes)
 	meta.TransitionNotes = strings.TrimSpace(meta.TransitionNotes)
 	meta.CommandSnippets = normalizeStringList(meta.CommandSnippets)
@@ -204,6 +218,15 @@ func normalizeTaskMetadata(meta TaskMetadata) (TaskMetadata, error) {
 	meta.RelatedItems = normalizeStringList(meta.RelatedItems)
 	meta.DependsOn = normalizeStringList(meta.DependsOn)
 	meta.BlockedBy = normalizeStringList(meta.BlockedBy)
+	reminders, err := normalizeReminderOffsets(meta.Reminders)
+	if err != nil {
+		return TaskMetadata{}, err
+	}
+	meta.Reminders = reminders
+	meta.RecurEvery, err = NormalizeRecurrence(meta.RecurEvery)
+	if err != nil {
+		return TaskMetadata{}, err
+	}
 	meta.KindPayload = bytes.TrimSpace(meta.KindPayload)
 	if len(meta.KindPayload) > 0 && !json.Valid(meta.KindPayload) {
 		return TaskMetadata{}, ErrInvalidKindPayload
@@ -211,7 +234,6 @@ func normalizeTaskMetadata(meta TaskMetadata) (TaskMetadata, error) {
 	meta.CompletionContract.CompletionEvidence = normalizeStringList(meta.CompletionContract.CompletionEvidence)
 	meta.CompletionContract.CompletionNotes = strings.TrimSpace(meta.CompletionContract.CompletionNotes)
 
-	var err error
 	meta.CompletionContract.StartCriteria, err = normalizeChecklist(meta.CompletionContract.StartCriteria)
 	if err != nil {
 		return TaskMetadata{}, err
@@ -298,6 +320,10 @@ func normalizeTaskMetadata(meta TaskMetadata) (TaskMetadata, error) {
 			ts := ref.LastVerifiedAt.UTC().Truncate(time.Second)
 			ref.LastVerifiedAt = &ts
 		}
+		if ref.MissingSince != nil {
+			ts := ref.MissingSince.UTC().Truncate(time.Second)
+			ref.MissingSince = &ts
+		}
 		resourceRefs = append(resourceRefs, ref)
 	}
 	meta.ResourceRefs = resourceRefs
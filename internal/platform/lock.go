@@ -0,0 +1,151 @@
+package platform
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// ErrInstanceLocked reports that another live process holds the instance lock.
+var ErrInstanceLocked = errors.New("instance lock held by another process")
+
+// lockFileSuffix is appended to the database path to name its instance lock file.
+const lockFileSuffix = ".lock"
+
+// unreadableLockGrace is how long a lock file without a readable PID counts as held. Locks are linked into place
+// fully written, so such a file comes from an older version or a damaged disk; only once it outlives the grace
+// period is it treated as stale.
+const unreadableLockGrace = 30 * time.Second
+
+// InstanceLock represents one held single-instance lock file.
+type InstanceLock struct {
+	path string
+	pid  int
+}
+
+// InstanceLockPath returns the lock file path used for one database path.
+func InstanceLockPath(dbPath string) string {
+	return dbPath + lockFileSuffix
+}
+
+// AcquireInstanceLock takes the best-effort single-instance lock next to one database file.
+// The PID is written to a temp file first and hard-linked into place, so the lock never exists half-written.
+// Locks left behind by processes that are no longer running are treated as stale and replaced.
+func AcquireInstanceLock(dbPath string) (*InstanceLock, error) {
+	dbPath = strings.TrimSpace(dbPath)
+	if dbPath == "" {
+		return nil, fmt.Errorf("acquire instance lock: database path is required")
+	}
+	path := InstanceLockPath(dbPath)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("create lock dir: %w", err)
+	}
+	pid := os.Getpid()
+	tmpPath, err := writeLockTemp(path, pid)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmpPath)
+	// One retry covers replacing a stale lock; a second conflict means another launch won the race.
+	for attempt := 0; attempt < 2; attempt++ {
+		err := os.Link(tmpPath, path)
+		if err == nil {
+			return &InstanceLock{path: path, pid: pid}, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("create lock file %q: %w", path, err)
+		}
+		holder, ok := readLockPID(path)
+		if ok && holder != pid && processAlive(holder) {
+			return nil, fmt.Errorf("%w (pid %d, lock %s)", ErrInstanceLocked, holder, path)
+		}
+		if !ok && lockFileRecent(path) {
+			return nil, fmt.Errorf("%w (unreadable lock %s)", ErrInstanceLocked, path)
+		}
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("remove stale lock file %q: %w", path, err)
+		}
+	}
+	return nil, fmt.Errorf("%w (lock %s)", ErrInstanceLocked, path)
+}
+
+// Path returns the lock file path.
+func (l *InstanceLock) Path() string {
+	if l == nil {
+		return ""
+	}
+	return l.path
+}
+
+// Release removes the lock file when it is still owned by this process.
+func (l *InstanceLock) Release() error {
+	if l == nil || l.path == "" {
+		return nil
+	}
+	// Never delete a lock another process took over after ours was judged stale.
+	if holder, ok := readLockPID(l.path); ok && holder != l.pid {
+		return nil
+	}
+	if err := os.Remove(l.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("remove lock file %q: %w", l.path, err)
+	}
+	return nil
+}
+
+// writeLockTemp writes pid to a temp file beside the lock path and returns the temp file's path.
+func writeLockTemp(path string, pid int) (string, error) {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return "", fmt.Errorf("create lock temp file: %w", err)
+	}
+	_, writeErr := f.WriteString(strconv.Itoa(pid) + "\n")
+	closeErr := f.Close()
+	if writeErr != nil || closeErr != nil {
+		_ = os.Remove(f.Name())
+		return "", fmt.Errorf("write lock temp file %q: %w", f.Name(), errors.Join(writeErr, closeErr))
+	}
+	return f.Name(), nil
+}
+
+// lockFileRecent reports whether one lock file was modified within the unreadable-lock grace period.
+func lockFileRecent(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+	return time.Since(info.ModTime()) < unreadableLockGrace
+}
+
+// readLockPID reads the owner PID from one lock file.
+func readLockPID(path string) (int, bool) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return 0, false
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(content)))
+	if err != nil || pid <= 0 {
+		return 0, false
+	}
+	return pid, true
+}
+
+// processAlive reports whether one PID belongs to a running process.
+func processAlive(pid int) bool {
+	proc, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	if runtime.GOOS == "windows" {
+		// FindProcess opens a handle on Windows and fails for exited processes.
+		_ = proc.Release()
+		return true
+	}
+	err = proc.Signal(syscall.Signal(0))
+	return err == nil || errors.Is(err, syscall.EPERM)
+}
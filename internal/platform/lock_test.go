@@ -0,0 +1,109 @@
+package platform
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// TestAcquireInstanceLockConflictAndRelease verifies a held lock blocks other live holders until released.
+func TestAcquireInstanceLockConflictAndRelease(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "nested", "tillsyn.db")
+	lock, err := AcquireInstanceLock(dbPath)
+	if err != nil {
+		t.Fatalf("AcquireInstanceLock() error = %v", err)
+	}
+	content, err := os.ReadFile(lock.Path())
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if got := string(content); got != strconv.Itoa(os.Getpid())+"\n" {
+		t.Fatalf("unexpected lock content %q", got)
+	}
+
+	// Hand the lock to the parent process, which is alive but is not this process.
+	if err := os.WriteFile(lock.Path(), []byte(strconv.Itoa(os.Getppid())), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if _, err := AcquireInstanceLock(dbPath); !errors.Is(err, ErrInstanceLocked) {
+		t.Fatalf("expected ErrInstanceLocked, got %v", err)
+	}
+	// Release must leave a lock owned by another process in place.
+	if err := lock.Release(); err != nil {
+		t.Fatalf("Release() error = %v", err)
+	}
+	if _, err := os.Stat(lock.Path()); err != nil {
+		t.Fatalf("expected foreign lock to remain, got %v", err)
+	}
+
+	if err := os.Remove(lock.Path()); err != nil {
+		t.Fatalf("Remove() error = %v", err)
+	}
+	again, err := AcquireInstanceLock(dbPath)
+	if err != nil {
+		t.Fatalf("AcquireInstanceLock() after removal error = %v", err)
+	}
+	if err := again.Release(); err != nil {
+		t.Fatalf("Release() error = %v", err)
+	}
+	if _, err := os.Stat(again.Path()); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected lock file removed, got %v", err)
+	}
+}
+
+// TestAcquireInstanceLockReplacesStaleLock verifies locks from dead owners, and unreadable locks past the grace period, are replaced.
+func TestAcquireInstanceLockReplacesStaleLock(t *testing.T) {
+	cases := map[string]string{
+		"dead pid": "2147483646",
+		"garbage":  "not-a-pid",
+		"empty":    "",
+	}
+	old := time.Now().Add(-2 * unreadableLockGrace)
+	for name, content := range cases {
+		t.Run(name, func(t *testing.T) {
+			dbPath := filepath.Join(t.TempDir(), "tillsyn.db")
+			if err := os.WriteFile(InstanceLockPath(dbPath), []byte(content), 0o644); err != nil {
+				t.Fatalf("WriteFile() error = %v", err)
+			}
+			if err := os.Chtimes(InstanceLockPath(dbPath), old, old); err != nil {
+				t.Fatalf("Chtimes() error = %v", err)
+			}
+			lock, err := AcquireInstanceLock(dbPath)
+			if err != nil {
+				t.Fatalf("AcquireInstanceLock() error = %v", err)
+			}
+			t.Cleanup(func() { _ = lock.Release() })
+			holder, ok := readLockPID(lock.Path())
+			if !ok || holder != os.Getpid() {
+				t.Fatalf("expected lock owned by %d, got %d (ok=%t)", os.Getpid(), holder, ok)
+			}
+		})
+	}
+}
+
+// TestAcquireInstanceLockHoldsFreshUnreadableLock verifies an empty or unparsable lock still counts as held while it is recent.
+func TestAcquireInstanceLockHoldsFreshUnreadableLock(t *testing.T) {
+	for name, content := range map[string]string{"garbage": "not-a-pid", "empty": ""} {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			dbPath := filepath.Join(dir, "tillsyn.db")
+			if err := os.WriteFile(InstanceLockPath(dbPath), []byte(content), 0o644); err != nil {
+				t.Fatalf("WriteFile() error = %v", err)
+			}
+			if _, err := AcquireInstanceLock(dbPath); !errors.Is(err, ErrInstanceLocked) {
+				t.Fatalf("expected ErrInstanceLocked, got %v", err)
+			}
+			// The rejected attempt leaves the lock as found and no temp files behind.
+			entries, err := os.ReadDir(dir)
+			if err != nil {
+				t.Fatalf("ReadDir() error = %v", err)
+			}
+			if len(entries) != 1 {
+				t.Fatalf("expected only the lock file, got %d entries", len(entries))
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package platform
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// ErrOpenUnavailable reports that no graphical opener can be used, such as in headless sessions.
+var ErrOpenUnavailable = errors.New("no graphical opener available")
+
+// OpenPath opens one file, directory, or URL with the operating system's default handler.
+// It returns without waiting for the handler; headless sessions report ErrOpenUnavailable.
+func OpenPath(target string) error {
+	target = strings.TrimSpace(target)
+	if target == "" {
+		return fmt.Errorf("open path: target is required")
+	}
+	name, args, err := openCommand(runtime.GOOS, target, os.Getenv)
+	if err != nil {
+		return err
+	}
+	if _, err := exec.LookPath(name); err != nil {
+		return fmt.Errorf("%w: %s not found", ErrOpenUnavailable, name)
+	}
+	cmd := exec.Command(name, args...)
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("start %s: %w", name, err)
+	}
+	// Reap the opener in the background; its exit status says nothing useful about the handler.
+	go func() {
+		_ = cmd.Wait()
+	}()
+	return nil
+}
+
+// openCommand resolves the opener command and arguments for one operating system.
+func openCommand(goos, target string, getenv func(string) string) (string, []string, error) {
+	switch goos {
+	case "darwin":
+		return "open", []string{target}, nil
+	case "windows":
+		return "rundll32", []string{"url.dll,FileProtocolHandler", target}, nil
+	default:
+		// xdg-open needs a display server; over plain SSH or in containers there is nothing to open into.
+		if strings.TrimSpace(getenv("DISPLAY")) == "" && strings.TrimSpace(getenv("WAYLAND_DISPLAY")) == "" {
+			return "", nil, fmt.Errorf("%w: no DISPLAY or WAYLAND_DISPLAY set", ErrOpenUnavailable)
+		}
+		return "xdg-open", []string{target}, nil
+	}
+}
@@ -0,0 +1,41 @@
+package platform
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// TestOpenCommand verifies opener selection per OS and headless detection.
+func TestOpenCommand(t *testing.T) {
+	env := func(values map[string]string) func(string) string {
+		return func(key string) string { return values[key] }
+	}
+	cases := []struct {
+		name     string
+		goos     string
+		env      map[string]string
+		wantName string
+		wantArgs []string
+	}{
+		{name: "darwin", goos: "darwin", wantName: "open", wantArgs: []string{"/data"}},
+		{name: "windows", goos: "windows", wantName: "rundll32", wantArgs: []string{"url.dll,FileProtocolHandler", "/data"}},
+		{name: "x11", goos: "linux", env: map[string]string{"DISPLAY": ":0"}, wantName: "xdg-open", wantArgs: []string{"/data"}},
+		{name: "wayland", goos: "freebsd", env: map[string]string{"WAYLAND_DISPLAY": "wayland-0"}, wantName: "xdg-open", wantArgs: []string{"/data"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			name, args, err := openCommand(tc.goos, "/data", env(tc.env))
+			if err != nil {
+				t.Fatalf("openCommand() error = %v", err)
+			}
+			if name != tc.wantName || !slices.Equal(args, tc.wantArgs) {
+				t.Fatalf("openCommand() = %q %v, want %q %v", name, args, tc.wantName, tc.wantArgs)
+			}
+		})
+	}
+
+	if _, _, err := openCommand("linux", "/data", env(nil)); !errors.Is(err, ErrOpenUnavailable) {
+		t.Fatalf("expected headless linux to report ErrOpenUnavailable, got %v", err)
+	}
+}
@@ -19,6 +19,9 @@ type Paths struct {
 type Options struct {
 	AppName string
 	DevMode bool
+	// Home, when set, roots the config file, data dir, and database under one directory instead of the
+	// platform config and data locations. Dev mode still gets its own <app>-dev subdirectory.
+	Home string
 }
 
 // DefaultPaths returns default paths.
@@ -35,6 +38,9 @@ func DefaultPathsWithOptions(opts Options) (Paths, error) {
 	if opts.DevMode {
 		appName += "-dev"
 	}
+	if home := strings.TrimSpace(opts.Home); home != "" {
+		return HomePaths(home, appName)
+	}
 
 	configDir, err := os.UserConfigDir()
 	if err != nil {
@@ -63,6 +69,20 @@ func DefaultPathsWithOptions(opts Options) (Paths, error) {
 	return PathsFor(runtime.GOOS, env, configDir, dataDir, appName)
 }
 
+// HomePaths roots every path under one home directory: <home>/<app>/config.toml beside <home>/<app>/<app>.db.
+// A relative home resolves against the working directory so the paths stay stable after a chdir.
+func HomePaths(home, appName string) (Paths, error) {
+	home = strings.TrimSpace(home)
+	if home == "" {
+		return Paths{}, fmt.Errorf("empty home dir")
+	}
+	absHome, err := filepath.Abs(home)
+	if err != nil {
+		return Paths{}, fmt.Errorf("resolve home dir %q: %w", home, err)
+	}
+	return PathsFor("", nil, absHome, absHome, appName)
+}
+
 // PathsFor handles paths for.
 func PathsFor(goos string, env map[string]string, userConfigDir, userDataDir, appName string) (Paths, error) {
 	if userConfigDir == "" || userDataDir == "" {
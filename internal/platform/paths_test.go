@@ -127,3 +127,43 @@ func TestDefaultPathsWithOptionsDevMode(t *testing.T) {
 		t.Fatalf("expected dev db name, got %q", p.DBPath)
 	}
 }
+
+// TestDefaultPathsWithHomeOverride verifies a home override roots config, data, and db under one directory.
+func TestDefaultPathsWithHomeOverride(t *testing.T) {
+	home := t.TempDir()
+	p, err := DefaultPathsWithOptions(Options{AppName: "tillsyn", Home: home})
+	if err != nil {
+		t.Fatalf("DefaultPathsWithOptions() error = %v", err)
+	}
+	want := Paths{
+		ConfigPath: filepath.Join(home, "tillsyn", "config.toml"),
+		DataDir:    filepath.Join(home, "tillsyn"),
+		DBPath:     filepath.Join(home, "tillsyn", "tillsyn.db"),
+	}
+	if p != want {
+		t.Fatalf("unexpected home paths %#v, want %#v", p, want)
+	}
+
+	// Dev mode keeps its own subdirectory so dev data never mixes with a portable install.
+	dev, err := DefaultPathsWithOptions(Options{AppName: "tillsyn", DevMode: true, Home: home})
+	if err != nil {
+		t.Fatalf("DefaultPathsWithOptions(dev) error = %v", err)
+	}
+	if wantDB := filepath.Join(home, "tillsyn-dev", "tillsyn-dev.db"); dev.DBPath != wantDB {
+		t.Fatalf("unexpected dev db path %q, want %q", dev.DBPath, wantDB)
+	}
+}
+
+// TestHomePathsResolvesRelativeHome verifies relative homes become absolute paths.
+func TestHomePathsResolvesRelativeHome(t *testing.T) {
+	p, err := HomePaths("portable", "tillsyn")
+	if err != nil {
+		t.Fatalf("HomePaths() error = %v", err)
+	}
+	if !filepath.IsAbs(p.ConfigPath) || !filepath.IsAbs(p.DBPath) {
+		t.Fatalf("expected absolute paths, got %#v", p)
+	}
+	if _, err := HomePaths("  ", "tillsyn"); err == nil {
+		t.Fatal("expected error for empty home dir")
+	}
+}
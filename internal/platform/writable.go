@@ -0,0 +1,49 @@
+package platform
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+)
+
+// ErrPathNotWritable reports that a database path is blocked by permissions or a read-only filesystem.
+var ErrPathNotWritable = errors.New("path is not writable")
+
+// CheckDatabaseWritable verifies that one database file and its directory accept writes.
+// SQLite needs the directory as well as the file, since it creates journal files beside it.
+func CheckDatabaseWritable(dbPath string) error {
+	dbPath = strings.TrimSpace(dbPath)
+	if dbPath == "" {
+		return fmt.Errorf("check database path: path is required")
+	}
+	dir := filepath.Dir(dbPath)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return writableError(dir, err)
+	}
+	file, err := os.OpenFile(dbPath, os.O_RDWR, 0)
+	switch {
+	case err == nil:
+		_ = file.Close()
+	case !errors.Is(err, fs.ErrNotExist):
+		return writableError(dbPath, err)
+	}
+	probe, err := os.CreateTemp(dir, ".tillsyn-write-probe-*")
+	if err != nil {
+		return writableError(dir, err)
+	}
+	_ = probe.Close()
+	_ = os.Remove(probe.Name())
+	return nil
+}
+
+// writableError wraps permission and read-only filesystem failures with ErrPathNotWritable.
+func writableError(path string, err error) error {
+	if errors.Is(err, fs.ErrPermission) || errors.Is(err, syscall.EROFS) {
+		return fmt.Errorf("%w: %s: %w", ErrPathNotWritable, path, err)
+	}
+	return fmt.Errorf("check database path %s: %w", path, err)
+}
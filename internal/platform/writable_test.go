@@ -0,0 +1,55 @@
+package platform
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"syscall"
+	"testing"
+)
+
+// TestCheckDatabaseWritable verifies writable paths pass and blocked directories report ErrPathNotWritable.
+func TestCheckDatabaseWritable(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "nested", "tillsyn.db")
+	if err := CheckDatabaseWritable(dbPath); err != nil {
+		t.Fatalf("CheckDatabaseWritable() error = %v", err)
+	}
+	entries, err := os.ReadDir(filepath.Dir(dbPath))
+	if err != nil {
+		t.Fatalf("ReadDir() error = %v", err)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("expected the write probe to be cleaned up, got %d entries", len(entries))
+	}
+
+	// Permission bits do not bind root or Windows, so only check the blocked directory elsewhere.
+	if runtime.GOOS == "windows" || os.Geteuid() == 0 {
+		return
+	}
+	lockedDir := filepath.Join(t.TempDir(), "locked")
+	if err := os.Mkdir(lockedDir, 0o555); err != nil {
+		t.Fatalf("Mkdir() error = %v", err)
+	}
+	t.Cleanup(func() { _ = os.Chmod(lockedDir, 0o755) })
+	if err := CheckDatabaseWritable(filepath.Join(lockedDir, "tillsyn.db")); !errors.Is(err, ErrPathNotWritable) {
+		t.Fatalf("expected ErrPathNotWritable, got %v", err)
+	}
+}
+
+// TestWritableErrorClassifiesFailures verifies only permission and read-only filesystem errors map to ErrPathNotWritable.
+func TestWritableErrorClassifiesFailures(t *testing.T) {
+	readOnlyFS := &fs.PathError{Op: "open", Path: "/data/tillsyn.db", Err: syscall.EROFS}
+	if err := writableError("/data/tillsyn.db", readOnlyFS); !errors.Is(err, ErrPathNotWritable) || !errors.Is(err, syscall.EROFS) {
+		t.Fatalf("expected read-only filesystem to wrap ErrPathNotWritable and EROFS, got %v", err)
+	}
+	denied := &fs.PathError{Op: "open", Path: "/data", Err: fs.ErrPermission}
+	if err := writableError("/data", denied); !errors.Is(err, ErrPathNotWritable) {
+		t.Fatalf("expected permission error to wrap ErrPathNotWritable, got %v", err)
+	}
+	other := errors.New("disk on fire")
+	if err := writableError("/data", other); errors.Is(err, ErrPathNotWritable) || !errors.Is(err, other) {
+		t.Fatalf("expected unrelated error to pass through unclassified, got %v", err)
+	}
+}
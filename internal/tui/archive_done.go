@@ -0,0 +1,116 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/hylla/tillsyn/internal/app"
+)
+
+// archiveDoneLoadedMsg carries the done-column task ids found for one archive-done request.
+type archiveDoneLoadedMsg struct {
+	projectID string
+	taskIDs   []string
+	err       error
+}
+
+// startArchiveDone looks up every unarchived task in the current project's done columns.
+func (m Model) startArchiveDone() (tea.Model, tea.Cmd) {
+	if m.readOnly {
+		return m.readOnlyBlocked("archive done")
+	}
+	projectID, ok := m.currentProjectID()
+	if !ok {
+		m.status = "no project selected"
+		return m, nil
+	}
+	m.status = "finding done tasks..."
+	return m, func() tea.Msg {
+		tasks, err := m.svc.ListDoneTasks(context.Background(), projectID)
+		if err != nil {
+			return archiveDoneLoadedMsg{projectID: projectID, err: err}
+		}
+		taskIDs := make([]string, 0, len(tasks))
+		for _, task := range tasks {
+			taskIDs = append(taskIDs, task.ID)
+		}
+		return archiveDoneLoadedMsg{projectID: projectID, taskIDs: taskIDs}
+	}
+}
+
+// applyArchiveDoneLoaded confirms or applies the archive once done tasks are known.
+func (m Model) applyArchiveDoneLoaded(msg archiveDoneLoadedMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		m.status = "archive done failed: " + msg.err.Error()
+		return m, nil
+	}
+	// The project may have changed while the lookup ran; never archive another board's tasks.
+	if projectID, ok := m.currentProjectID(); !ok || projectID != msg.projectID {
+		m.status = "archive done cancelled: project changed"
+		return m, nil
+	}
+	taskIDs := m.unarchivedTaskIDs(msg.taskIDs)
+	if len(taskIDs) == 0 {
+		m.status = "no done tasks to archive"
+		return m, nil
+	}
+	if !m.confirmArchive {
+		return m.archiveDoneTaskIDs(taskIDs)
+	}
+	task, _ := m.taskByID(taskIDs[0])
+	m.openConfirmAction(confirmAction{
+		Kind:    "archive-done",
+		Task:    task,
+		TaskIDs: taskIDs,
+		Mode:    app.DeleteModeArchive,
+		Label:   "archive done",
+	})
+	return m, nil
+}
+
+// unarchivedTaskIDs keeps loaded task ids that are not archived yet, preserving order.
+func (m Model) unarchivedTaskIDs(taskIDs []string) []string {
+	out := make([]string, 0, len(taskIDs))
+	for _, taskID := range taskIDs {
+		task, ok := m.taskByID(taskID)
+		if !ok || task.ArchivedAt != nil {
+			continue
+		}
+		out = append(out, taskID)
+	}
+	return out
+}
+
+// archiveDoneTaskIDs archives the done tasks as one undoable batch and reports how many were archived.
+func (m Model) archiveDoneTaskIDs(taskIDs []string) (tea.Model, tea.Cmd) {
+	taskIDs = m.unarchivedTaskIDs(taskIDs)
+	if len(taskIDs) == 0 {
+		m.status = "no done tasks to archive"
+		return m, nil
+	}
+	next, cmd := m.deleteTaskIDs(taskIDs, app.DeleteModeArchive)
+	if cmd == nil {
+		return next, nil
+	}
+	return next, func() tea.Msg {
+		msg := cmd()
+		action, ok := msg.(actionMsg)
+		if !ok || action.err != nil {
+			return msg
+		}
+		action.status = fmt.Sprintf("archived %d done tasks", len(taskIDs))
+		if action.historyPush != nil {
+			history := *action.historyPush
+			history.Label = "archive done"
+			history.Summary = action.status
+			action.historyPush = &history
+		}
+		if action.activityItem != nil {
+			activity := *action.activityItem
+			activity.Summary = "archive done"
+			action.activityItem = &activity
+		}
+		return action
+	}
+}
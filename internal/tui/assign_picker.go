@@ -0,0 +1,216 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"image/color"
+	"slices"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/hylla/tillsyn/internal/app"
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// assignPickerMatchLimit caps how many ranked owners the assign picker lists.
+const assignPickerMatchLimit = 8
+
+// assignPickerItem is one owner offered by the assign picker.
+type assignPickerItem struct {
+	// Name is the owner written to the tasks; empty clears the assignee.
+	Name   string
+	Source string
+}
+
+// newAssignPicker constructs the assign picker; picking an owner assigns it to the picker's tasks.
+func newAssignPicker() picker[assignPickerItem] {
+	p := newPicker("assignee: ", "owner name", 120, assignPickerMatchLimit, func(item assignPickerItem) string {
+		if item.Source == "clear" {
+			return unassignedLabel
+		}
+		return item.Name
+	})
+	// A typed name nobody has used yet is offered as a new owner, and the unassigned entry always closes the list.
+	p.extras = func(query string, exact bool) []assignPickerItem {
+		out := make([]assignPickerItem, 0, 2)
+		if query != "" && !exact {
+			out = append(out, assignPickerItem{Name: query, Source: "new"})
+		}
+		return append(out, assignPickerItem{Name: "", Source: "clear"})
+	}
+	p.onSelect = func(m *Model, item assignPickerItem) tea.Cmd {
+		taskIDs := m.assignPickerTaskIDs
+		m.assignPickerTaskIDs = nil
+		return m.applyAssign(taskIDs, item.Name)
+	}
+	p.onCancel = func(m *Model) {
+		m.assignPickerTaskIDs = nil
+	}
+	return p
+}
+
+// startAssignPicker opens the assign picker for the selected tasks, or the focused task when nothing is selected.
+func (m *Model) startAssignPicker() tea.Cmd {
+	if m.readOnly {
+		m.status = readOnlyStatus("assign")
+		return nil
+	}
+	taskIDs := m.sortedSelectedTaskIDs()
+	if len(taskIDs) == 0 {
+		task, ok := m.selectedTaskInCurrentColumn()
+		if !ok {
+			m.status = "no task selected"
+			return nil
+		}
+		taskIDs = []string{task.ID}
+	}
+	m.assignPickerTaskIDs = taskIDs
+	m.mode = modeAssignPicker
+	m.help.ShowAll = false
+	m.status = fmt.Sprintf("assign %d tasks", len(taskIDs))
+	if len(taskIDs) == 1 {
+		m.status = "assign task"
+	}
+	return m.assignPicker.open(m.assignPickerCandidates())
+}
+
+// assignPickerCandidates lists the identity display name first, then every owner already used on loaded tasks by name.
+func (m Model) assignPickerCandidates() []assignPickerItem {
+	out := make([]assignPickerItem, 0)
+	seen := map[string]struct{}{}
+	if self := strings.TrimSpace(m.identityDisplayName); self != "" {
+		out = append(out, assignPickerItem{Name: self, Source: "you"})
+		seen[strings.ToLower(self)] = struct{}{}
+	}
+	owners := make([]string, 0)
+	for _, task := range m.tasks {
+		assignee := strings.TrimSpace(task.Metadata.Assignee)
+		if assignee == "" {
+			continue
+		}
+		if _, ok := seen[strings.ToLower(assignee)]; ok {
+			continue
+		}
+		seen[strings.ToLower(assignee)] = struct{}{}
+		owners = append(owners, assignee)
+	}
+	slices.SortFunc(owners, compareAssignees)
+	for _, owner := range owners {
+		out = append(out, assignPickerItem{Name: owner, Source: "seen"})
+	}
+	return out
+}
+
+// handleAssignPickerKey handles input while the assign picker modal is open.
+func (m Model) handleAssignPickerKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	cmd := updatePicker(&m, &m.assignPicker, msg)
+	return m, cmd
+}
+
+// applyAssign sets the assignee on every listed task as a single undoable history set.
+// Tasks already assigned to the owner are skipped; an empty assignee clears the owner.
+func (m *Model) applyAssign(taskIDs []string, assignee string) tea.Cmd {
+	if m.readOnly {
+		m.status = readOnlyStatus("assign")
+		return nil
+	}
+	assignee = strings.TrimSpace(assignee)
+	steps := make([]historyStep, 0, len(taskIDs))
+	for _, taskID := range m.normalizeKnownTaskIDs(taskIDs) {
+		task, ok := m.taskByID(taskID)
+		if !ok || strings.TrimSpace(task.Metadata.Assignee) == assignee {
+			continue
+		}
+		steps = append(steps, historyStep{
+			Kind:         historyStepAssign,
+			TaskID:       task.ID,
+			FromAssignee: strings.TrimSpace(task.Metadata.Assignee),
+			ToAssignee:   assignee,
+		})
+	}
+	owner := assignee
+	if owner == "" {
+		owner = unassignedLabel
+	}
+	if len(steps) == 0 {
+		m.status = fmt.Sprintf("assignee %s unchanged on %d tasks", owner, len(taskIDs))
+		return nil
+	}
+	status := fmt.Sprintf("assigned %d tasks to %s", len(steps), owner)
+	label := "assign " + owner
+	if assignee == "" {
+		status = fmt.Sprintf("unassigned %d tasks", len(steps))
+		label = "unassign"
+	}
+	if skipped := len(taskIDs) - len(steps); skipped > 0 {
+		status += fmt.Sprintf(" (%d unchanged)", skipped)
+	}
+	history := historyActionSet{
+		Label:    label,
+		Summary:  status,
+		Target:   fmt.Sprintf("%d tasks", len(steps)),
+		Steps:    append([]historyStep(nil), steps...),
+		Undoable: true,
+		At:       time.Now().UTC(),
+	}
+	activity := activityEntry{
+		At:      history.At,
+		Summary: history.Label,
+		Target:  history.Target,
+	}
+	return func() tea.Msg {
+		updated := make([]domain.Task, 0, len(steps))
+		for _, step := range steps {
+			task, err := m.replayAssignStep(step, false)
+			if err != nil {
+				return actionMsg{err: err}
+			}
+			updated = append(updated, task)
+		}
+		return actionMsg{
+			status:       status,
+			reload:       true,
+			historyPush:  &history,
+			activityItem: &activity,
+			upsertTasks:  updated,
+		}
+	}
+}
+
+// replayAssignStep writes the step's new owner, or its previous owner when undo is set, onto the task's current metadata.
+func (m Model) replayAssignStep(step historyStep, undo bool) (domain.Task, error) {
+	task, ok := m.taskByID(step.TaskID)
+	if !ok {
+		return domain.Task{}, fmt.Errorf("task %s is not loaded", step.TaskID)
+	}
+	metadata := task.Metadata
+	metadata.Assignee = step.ToAssignee
+	if undo {
+		metadata.Assignee = step.FromAssignee
+	}
+	return m.svc.UpdateTask(context.Background(), app.UpdateTaskInput{
+		TaskID:      task.ID,
+		Title:       task.Title,
+		Description: task.Description,
+		Priority:    task.Priority,
+		DueAt:       task.DueAt,
+		Labels:      task.Labels,
+		Metadata:    &metadata,
+	})
+}
+
+// renderAssignPickerOverlay renders the assign picker modal with its ranked owners.
+func (m Model) renderAssignPickerOverlay(accent, muted color.Color, maxWidth int) string {
+	title := "Assign Task"
+	if count := len(m.assignPickerTaskIDs); count > 1 {
+		title = fmt.Sprintf("Assign %d Tasks", count)
+	}
+	return m.assignPicker.render(accent, muted, maxWidth, pickerLayout[assignPickerItem]{
+		title:      title,
+		detail:     func(item assignPickerItem) string { return item.Source },
+		hint:       "type to filter or name someone new • ↑/↓ select • enter assign • esc cancel",
+		boxWidth:   64,
+		labelWidth: 32,
+	})
+}
@@ -0,0 +1,210 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"net/url"
+	"strings"
+
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// attachURLLinkField and attachURLTitleField index the attach-url inputs.
+const (
+	attachURLLinkField = iota
+	attachURLTitleField
+)
+
+// urlResourceStyle sets URL refs apart from local paths in task info.
+var urlResourceStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("39")).Underline(true)
+
+// startAttachURLForm opens a modal that reads one http(s) link and an optional title.
+// From the task form the ref is staged with the other resources; from task info it is saved right away.
+func (m *Model) startAttachURLForm(taskID string, back inputMode) tea.Cmd {
+	if m.readOnly {
+		m.status = readOnlyStatus("attach url")
+		return nil
+	}
+	m.attachURLBack = back
+	m.attachURLTaskID = strings.TrimSpace(taskID)
+	m.attachURLInputs = []textinput.Model{
+		newModalInput("", "https://...", "", 2048),
+		newModalInput("", "optional title", "", 120),
+	}
+	m.mode = modeAttachURL
+	m.help.ShowAll = false
+	m.status = "attach url"
+	return m.focusAttachURLField(attachURLLinkField)
+}
+
+// focusAttachURLField focuses one attach-url input.
+func (m *Model) focusAttachURLField(idx int) tea.Cmd {
+	if len(m.attachURLInputs) == 0 {
+		return nil
+	}
+	idx = clamp(idx, 0, len(m.attachURLInputs)-1)
+	m.attachURLFocus = idx
+	for i := range m.attachURLInputs {
+		m.attachURLInputs[i].Blur()
+	}
+	m.attachURLInputs[idx].CursorEnd()
+	return m.attachURLInputs[idx].Focus()
+}
+
+// closeAttachURLForm leaves the attach-url modal for the screen that opened it.
+func (m *Model) closeAttachURLForm() tea.Cmd {
+	m.mode = m.attachURLBack
+	m.attachURLInputs = nil
+	m.attachURLFocus = 0
+	m.attachURLTaskID = ""
+	if m.mode == modeAddTask || m.mode == modeEditTask {
+		return m.focusTaskFormField(m.formFocus)
+	}
+	return nil
+}
+
+// handleAttachURLKey handles input while the attach-url modal is open.
+func (m Model) handleAttachURLKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	if len(m.attachURLInputs) == 0 {
+		return m, m.closeAttachURLForm()
+	}
+	if handled, status := applyClipboardShortcutToInput(msg, &m.attachURLInputs[m.attachURLFocus]); handled {
+		m.status = status
+		return m, nil
+	}
+	switch {
+	case msg.Code == tea.KeyEscape || msg.String() == "esc":
+		cmd := m.closeAttachURLForm()
+		m.status = "cancelled"
+		return m, cmd
+	case msg.Code == tea.KeyTab || msg.String() == "tab" || msg.String() == "ctrl+i" || msg.String() == "down":
+		return m, m.focusAttachURLField((m.attachURLFocus + 1) % len(m.attachURLInputs))
+	case msg.String() == "shift+tab" || msg.String() == "backtab" || msg.String() == "up":
+		return m, m.focusAttachURLField((m.attachURLFocus + len(m.attachURLInputs) - 1) % len(m.attachURLInputs))
+	case msg.Code == tea.KeyEnter || msg.String() == "enter":
+		return m.submitAttachURL()
+	default:
+		var cmd tea.Cmd
+		m.attachURLInputs[m.attachURLFocus], cmd = m.attachURLInputs[m.attachURLFocus].Update(msg)
+		_ = scrubTextInputTerminalArtifacts(&m.attachURLInputs[m.attachURLFocus])
+		return m, cmd
+	}
+}
+
+// submitAttachURL validates the link and stages or saves the URL ref.
+// Invalid links keep the modal open with the reason in the status line.
+func (m Model) submitAttachURL() (tea.Model, tea.Cmd) {
+	link, err := parseResourceURL(m.attachURLInputs[attachURLLinkField].Value())
+	if err != nil {
+		m.status = err.Error()
+		return m, m.focusAttachURLField(attachURLLinkField)
+	}
+	ref := buildURLResourceRef(link, m.attachURLInputs[attachURLTitleField].Value())
+	taskID := m.attachURLTaskID
+	back := m.attachURLBack
+	cmd := m.closeAttachURLForm()
+	if back == modeAddTask || back == modeEditTask {
+		refs, added := appendResourceRefIfMissing(m.taskFormResourceRefs, ref)
+		if !added {
+			m.status = "resource already staged"
+			return m, cmd
+		}
+		m.taskFormResourceRefs = refs
+		m.status = "url staged"
+		return m, cmd
+	}
+	m.status = "attaching url..."
+	return m, func() tea.Msg {
+		return m.persistResourceRef(taskID, ref, "url attached")
+	}
+}
+
+// parseResourceURL checks that raw is an absolute http or https URL and returns it trimmed.
+func parseResourceURL(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", errors.New("url is required")
+	}
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return "", fmt.Errorf("invalid url: %w", err)
+	}
+	if scheme := strings.ToLower(parsed.Scheme); scheme != "http" && scheme != "https" {
+		return "", errors.New("url must start with http:// or https://")
+	}
+	if parsed.Hostname() == "" {
+		return "", errors.New("url must include a host")
+	}
+	return raw, nil
+}
+
+// buildURLResourceRef builds a URL resource reference; the title falls back to the link's host and path.
+// The link is not fetched, so the ref carries no verification time.
+func buildURLResourceRef(link, title string) domain.ResourceRef {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		if parsed, err := url.Parse(link); err == nil {
+			title = strings.TrimSuffix(parsed.Host+parsed.Path, "/")
+		}
+	}
+	return domain.ResourceRef{
+		ResourceType: domain.ResourceTypeURL,
+		Location:     link,
+		PathMode:     domain.PathModeAbsolute,
+		Title:        title,
+	}
+}
+
+// renderAttachURLOverlay renders the attach-url modal.
+func (m Model) renderAttachURLOverlay(accent, muted color.Color, maxWidth int) string {
+	style := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(accent).
+		Padding(0, 1)
+	inputWidth := 48
+	if maxWidth > 0 {
+		boxWidth := clamp(maxWidth, 40, 80)
+		style = style.Width(boxWidth)
+		inputWidth = max(18, boxWidth-12)
+	}
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(accent)
+	hintStyle := lipgloss.NewStyle().Foreground(muted)
+
+	lines := []string{titleStyle.Render("Attach URL")}
+	for i, label := range []string{"url", "title"} {
+		if i >= len(m.attachURLInputs) {
+			break
+		}
+		labelStyle := hintStyle
+		if i == m.attachURLFocus {
+			labelStyle = lipgloss.NewStyle().Bold(true).Foreground(accent)
+		}
+		in := m.attachURLInputs[i]
+		in.SetWidth(inputWidth)
+		lines = append(lines, labelStyle.Render(fmt.Sprintf("%-7s", label+":"))+" "+in.View())
+	}
+	lines = append(lines, hintStyle.Render("tab next field • enter attach • esc cancel"))
+	return style.Render(strings.Join(lines, "\n"))
+}
+
+// urlResourceTitle returns the title shown for a URL ref, falling back to the link itself.
+func urlResourceTitle(ref domain.ResourceRef) string {
+	if title := strings.TrimSpace(ref.Title); title != "" {
+		return title
+	}
+	return strings.TrimSpace(ref.Location)
+}
+
+// urlResourceSummary renders a URL ref as one plain-text row for the task form resources list.
+func urlResourceSummary(ref domain.ResourceRef, width int) string {
+	title := urlResourceTitle(ref)
+	location := strings.TrimSpace(ref.Location)
+	if title == location {
+		return fmt.Sprintf("%s %s", ref.ResourceType, truncate(location, width))
+	}
+	return fmt.Sprintf("%s %s <%s>", ref.ResourceType, truncate(title, 24), truncate(location, max(8, width-24)))
+}
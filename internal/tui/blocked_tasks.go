@@ -0,0 +1,45 @@
+package tui
+
+import (
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// blockedMarkerGlyph follows the title of a blocked board card.
+const blockedMarkerGlyph = "⊘"
+
+// blockedMarkerStyle colors the blocked marker apart from the red critical and attention markers.
+var blockedMarkerStyle = lipgloss.NewStyle().Foreground(lipgloss.Color(builtinTheme.Blocked)).Bold(true)
+
+// blockedDimStyle fades unselected blocked cards when board.dim_blocked is set.
+var blockedDimStyle = lipgloss.NewStyle().Foreground(lipgloss.Color(builtinTheme.BlockedDim))
+
+// taskIsBlocked reports whether an open task lists blockers or a blocked reason.
+// It uses the dependency rollup's definition of blocked; done and archived tasks never count.
+func (m Model) taskIsBlocked(task domain.Task) bool {
+	if !m.taskIsOpen(task) {
+		return false
+	}
+	return strings.TrimSpace(task.Metadata.BlockedReason) != "" || len(uniqueTrimmed(task.Metadata.BlockedBy)) > 0
+}
+
+// blockedTaskMarker renders the marker appended after a blocked card title, or "" for unblocked tasks.
+func (m Model) blockedTaskMarker(task domain.Task) string {
+	if !m.taskIsBlocked(task) {
+		return ""
+	}
+	return " " + blockedMarkerStyle.Render(blockedMarkerGlyph)
+}
+
+// blockedTaskCount counts blocked tasks among the loaded board tasks for the footer.
+func (m Model) blockedTaskCount() int {
+	count := 0
+	for _, task := range m.tasks {
+		if m.taskIsBlocked(task) {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,217 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/hylla/tillsyn/internal/app"
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// errBulkLabelUnavailable reports a service that cannot edit labels across tasks.
+var errBulkLabelUnavailable = errors.New("bulk label editing unavailable")
+
+// taskLabeler is the optional service extension used to add or remove one label across tasks in one batch.
+type taskLabeler interface {
+	BulkUpdateTaskLabels(context.Context, app.BulkLabelInput) ([]domain.Task, error)
+}
+
+// labelPickerBulkAction identifies which bulk label edit the label picker applies on enter.
+type labelPickerBulkAction string
+
+// label picker bulk actions; the empty value keeps the picker bound to the task form.
+const (
+	labelPickerBulkNone   labelPickerBulkAction = ""
+	labelPickerBulkAdd    labelPickerBulkAction = "add"
+	labelPickerBulkRemove labelPickerBulkAction = "remove"
+)
+
+// startBulkLabelPicker opens the label picker to add or remove one label across all selected tasks.
+func (m *Model) startBulkLabelPicker(action labelPickerBulkAction) tea.Cmd {
+	if m.readOnly {
+		m.status = readOnlyStatus("bulk label")
+		return nil
+	}
+	taskIDs := m.sortedSelectedTaskIDs()
+	if len(taskIDs) == 0 {
+		m.status = "no tasks selected"
+		return nil
+	}
+	items := m.bulkAddLabelPickerItems()
+	if action == labelPickerBulkRemove {
+		items = m.bulkRemoveLabelPickerItems(taskIDs)
+		if len(items) == 0 {
+			m.status = "selected tasks have no labels"
+			return nil
+		}
+	}
+	m.labelPickerBack = modeNone
+	m.labelPickerBulk = action
+	m.mode = modeLabelPicker
+	m.labelPickerInput.SetValue("")
+	m.labelPickerInput.CursorEnd()
+	m.labelPickerAllItems = items
+	m.refreshLabelPickerMatches()
+	m.labelPickerIndex = 0
+	m.status = fmt.Sprintf("%s label: %d selected tasks", action, len(taskIDs))
+	return m.labelPickerInput.Focus()
+}
+
+// bulkAddLabelPickerItems lists configured, suggested, and default labels for a bulk add.
+func (m Model) bulkAddLabelPickerItems() []labelPickerItem {
+	sources := m.labelSourcesForTask(domain.Task{})
+	out := make([]labelPickerItem, 0, len(sources.Global)+len(sources.Project))
+	for _, label := range sources.Global {
+		out = append(out, labelPickerItem{Label: label, Source: "global"})
+	}
+	for _, label := range sources.Project {
+		out = append(out, labelPickerItem{Label: label, Source: "project"})
+	}
+	for _, label := range m.labelSuggestions(48) {
+		out = append(out, labelPickerItem{Label: label, Source: "suggested"})
+	}
+	for _, label := range normalizeConfigLabels(defaultLabelSuggestionsSeed) {
+		out = append(out, labelPickerItem{Label: label, Source: "default"})
+	}
+	return out
+}
+
+// bulkRemoveLabelPickerItems lists labels carried by at least one selected task, tagged with how many carry it.
+func (m Model) bulkRemoveLabelPickerItems(taskIDs []string) []labelPickerItem {
+	counts := map[string]int{}
+	for _, taskID := range taskIDs {
+		task, ok := m.taskByID(taskID)
+		if !ok {
+			continue
+		}
+		for _, label := range task.Labels {
+			counts[strings.TrimSpace(strings.ToLower(label))]++
+		}
+	}
+	delete(counts, "")
+	labels := make([]string, 0, len(counts))
+	for label := range counts {
+		labels = append(labels, label)
+	}
+	slices.Sort(labels)
+	out := make([]labelPickerItem, 0, len(labels))
+	for _, label := range labels {
+		out = append(out, labelPickerItem{Label: label, Source: fmt.Sprintf("%d selected", counts[label])})
+	}
+	return out
+}
+
+// applyBulkLabel adds or removes one label on every selected task as a single undoable history set.
+// Tasks that already have (or already lack) the label are skipped so repeating the action is a no-op.
+func (m Model) applyBulkLabel(action labelPickerBulkAction, label string) (tea.Model, tea.Cmd) {
+	if m.readOnly {
+		return m.readOnlyBlocked("bulk label")
+	}
+	label = strings.TrimSpace(strings.ToLower(label))
+	if label == "" {
+		m.status = "no label chosen"
+		return m, nil
+	}
+	if action == labelPickerBulkAdd {
+		if err := m.validateAllowedLabels([]string{label}); err != nil {
+			m.status = err.Error()
+			return m, nil
+		}
+	}
+	taskIDs := m.sortedSelectedTaskIDs()
+	kind := historyStepAddLabel
+	if action == labelPickerBulkRemove {
+		kind = historyStepRemoveLabel
+	}
+	steps := make([]historyStep, 0, len(taskIDs))
+	for _, taskID := range taskIDs {
+		task, ok := m.taskByID(taskID)
+		if !ok || taskHasLabel(task, label) == (action == labelPickerBulkAdd) {
+			continue
+		}
+		steps = append(steps, historyStep{Kind: kind, TaskID: task.ID, Label: label})
+	}
+	verb, preposition := "added", "to"
+	if action == labelPickerBulkRemove {
+		verb, preposition = "removed", "from"
+	}
+	if len(steps) == 0 {
+		m.status = fmt.Sprintf("label %q unchanged on %d selected tasks", label, len(taskIDs))
+		return m, nil
+	}
+	status := fmt.Sprintf("%s label %q %s %d tasks", verb, label, preposition, len(steps))
+	if skipped := len(taskIDs) - len(steps); skipped > 0 {
+		status += fmt.Sprintf(" (%d unchanged)", skipped)
+	}
+	history := historyActionSet{
+		Label:    fmt.Sprintf("bulk %s label %s", action, label),
+		Summary:  status,
+		Target:   fmt.Sprintf("%d tasks", len(steps)),
+		Steps:    append([]historyStep(nil), steps...),
+		Undoable: true,
+		At:       time.Now().UTC(),
+	}
+	activity := activityEntry{
+		At:      history.At,
+		Summary: history.Label,
+		Target:  history.Target,
+	}
+	return m, func() tea.Msg {
+		updated, err := m.replayLabelSteps(steps, false)
+		if err != nil {
+			return actionMsg{err: err}
+		}
+		return actionMsg{
+			status:       status,
+			reload:       true,
+			historyPush:  &history,
+			activityItem: &activity,
+			upsertTasks:  updated,
+		}
+	}
+}
+
+// replayLabelSteps applies label history steps, or their inverses when undo is set, with one bulk service call per
+// label and direction, so every task in a set changes together or none does.
+func (m Model) replayLabelSteps(steps []historyStep, undo bool) ([]domain.Task, error) {
+	labeler, ok := m.svc.(taskLabeler)
+	if !ok {
+		return nil, errBulkLabelUnavailable
+	}
+	groups := []app.BulkLabelInput{}
+	for _, step := range steps {
+		remove := step.Kind == historyStepRemoveLabel
+		if undo {
+			remove = !remove
+		}
+		idx := slices.IndexFunc(groups, func(group app.BulkLabelInput) bool {
+			return group.Label == step.Label && group.Remove == remove
+		})
+		if idx < 0 {
+			groups = append(groups, app.BulkLabelInput{Label: step.Label, Remove: remove})
+			idx = len(groups) - 1
+		}
+		groups[idx].TaskIDs = append(groups[idx].TaskIDs, step.TaskID)
+	}
+	updated := make([]domain.Task, 0, len(steps))
+	for _, group := range groups {
+		tasks, err := labeler.BulkUpdateTaskLabels(context.Background(), group)
+		if err != nil {
+			return updated, err
+		}
+		updated = append(updated, tasks...)
+	}
+	return updated, nil
+}
+
+// taskHasLabel reports whether the task carries the label, ignoring case and surrounding space.
+func taskHasLabel(task domain.Task, label string) bool {
+	return slices.ContainsFunc(task.Labels, func(existing string) bool {
+		return strings.EqualFold(strings.TrimSpace(existing), label)
+	})
+}
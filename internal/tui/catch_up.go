@@ -0,0 +1,374 @@
+package tui
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image/color"
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// catch-up summary limits.
+const (
+	// catchUpLineLimit caps how many individual changes the catch-up summary lists.
+	catchUpLineLimit = 8
+	// catchUpEventLimit caps how many change events one catch-up reads back from the store.
+	catchUpEventLimit = 2000
+)
+
+// catchUpSummary describes changes made by other actors since the project was last viewed.
+type catchUpSummary struct {
+	ProjectID   string
+	ProjectName string
+	Since       time.Time
+	// SeenAt is the visit time stored as last seen once the summary is dismissed.
+	SeenAt    time.Time
+	Created   int
+	Moved     int
+	Completed int
+	Updated   int
+	Archived  int
+	Actors    []string
+	// Entries holds the newest changes first, capped at catchUpLineLimit.
+	Entries []activityEntry
+	// Truncated reports that the loaded changes may not reach back to Since.
+	Truncated bool
+}
+
+// catchUpWindow holds the change events after one last-seen time, newest first.
+type catchUpWindow struct {
+	since     time.Time
+	events    []domain.ChangeEvent
+	truncated bool
+}
+
+// catchUpLoadedMsg carries the changes for a focus catch-up that no board load applied.
+type catchUpLoadedMsg struct {
+	projectID string
+	window    *catchUpWindow
+	at        time.Time
+}
+
+// lastSeenSavedMsg reports the result of one last-seen write.
+type lastSeenSavedMsg struct {
+	err error
+}
+
+// readLastSeen reads the per-project last-seen timestamps; a missing file reports an empty map.
+func readLastSeen(path string) (map[string]time.Time, error) {
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return map[string]time.Time{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read last seen: %w", err)
+	}
+	seen := map[string]time.Time{}
+	if err := json.Unmarshal(content, &seen); err != nil {
+		return nil, fmt.Errorf("decode last seen %q: %w", path, err)
+	}
+	return seen, nil
+}
+
+// writeLastSeen records one project's last-seen time, keeping the other projects' entries.
+// Timestamps never move backwards, so a slow write cannot undo a newer one.
+func writeLastSeen(path, projectID string, at time.Time) error {
+	seen, err := readLastSeen(path)
+	if err != nil {
+		// A corrupt file only loses older catch-up history, so start over rather than failing every load.
+		seen = map[string]time.Time{}
+	}
+	if previous, ok := seen[projectID]; ok && !at.After(previous) {
+		return nil
+	}
+	seen[projectID] = at.UTC()
+	content, err := json.MarshalIndent(seen, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode last seen: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("create last seen dir: %w", err)
+	}
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, content, 0o600); err != nil {
+		return fmt.Errorf("write last seen: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("replace last seen: %w", err)
+	}
+	return nil
+}
+
+// loadLastSeenFor returns the stored last-seen time for one project, if any.
+func (m Model) loadLastSeenFor(projectID string) (time.Time, bool) {
+	if m.lastSeenPath == "" || projectID == "" {
+		return time.Time{}, false
+	}
+	seen, err := readLastSeen(m.lastSeenPath)
+	if err != nil {
+		return time.Time{}, false
+	}
+	at, ok := seen[projectID]
+	return at, ok
+}
+
+// applyLoadedProjectVisit records a board load as a visit and opens the catch-up summary the load fetched.
+// The first-ever visit of a project has nothing to compare against, so it is stored right away to start the history.
+func (m *Model) applyLoadedProjectVisit(msg loadedMsg) tea.Cmd {
+	projectID, ok := m.currentProjectID()
+	if m.lastSeenPath == "" || !ok || m.terminalBlurred {
+		return nil
+	}
+	// The launch picker and bootstrap screens hide the board, so they do not count as a visit.
+	if m.mode == modeProjectPicker || m.mode == modeBootstrapSettings {
+		return nil
+	}
+	_, visited := m.lastSeen[projectID]
+	m.recordProjectVisit(projectID, msg.catchUp, msg.loadedAt)
+	if !visited && !msg.lastSeenKnown {
+		return m.saveLastSeenCmd(projectID, m.lastSeen[projectID])
+	}
+	return nil
+}
+
+// recordProjectVisit advances one project's in-session last-seen time and opens the catch-up summary for window.
+// The stored time only advances once the summary is dismissed, so changes nobody acknowledged are shown again.
+func (m *Model) recordProjectVisit(projectID string, window *catchUpWindow, at time.Time) {
+	m.catchUpPending = false
+	if at.IsZero() {
+		at = time.Now().UTC()
+	}
+	// Board loads read the map from their own goroutine, so it is replaced rather than written in place.
+	lastSeen := maps.Clone(m.lastSeen)
+	if lastSeen == nil {
+		lastSeen = map[string]time.Time{}
+	}
+	lastSeen[projectID] = at
+	m.lastSeen = lastSeen
+	if window == nil || m.mode != modeNone {
+		return
+	}
+	summary, ok := m.buildCatchUpSummary(*window)
+	if !ok {
+		return
+	}
+	summary.ProjectID = projectID
+	summary.SeenAt = at
+	m.catchUp = summary
+	m.mode = modeCatchUp
+	m.help.ShowAll = false
+	m.status = "while you were away"
+}
+
+// saveLastSeenCmd stores one project's last-seen time in the background.
+func (m Model) saveLastSeenCmd(projectID string, at time.Time) tea.Cmd {
+	path := m.lastSeenPath
+	if path == "" || projectID == "" {
+		return nil
+	}
+	return func() tea.Msg {
+		return lastSeenSavedMsg{err: writeLastSeen(path, projectID, at)}
+	}
+}
+
+// dismissCatchUp closes the catch-up summary and stores the visit it acknowledged.
+func (m *Model) dismissCatchUp() tea.Cmd {
+	summary := m.catchUp
+	m.catchUp = catchUpSummary{}
+	return m.saveLastSeenCmd(summary.ProjectID, summary.SeenAt)
+}
+
+// loadCatchUpWindow fetches the changes one board load should summarize, or nil when it shows no summary.
+// The first visit of the session compares against the stored time; a pending focus catch-up against the previous visit.
+func (m Model) loadCatchUpWindow(ctx context.Context, projectID string, stored time.Time, storedKnown bool) *catchUpWindow {
+	if m.lastSeenPath == "" || projectID == "" {
+		return nil
+	}
+	since, visited := m.lastSeen[projectID]
+	switch {
+	case !visited && storedKnown:
+		since = stored
+	case visited && m.catchUpPending:
+	default:
+		return nil
+	}
+	return m.fetchCatchUpWindow(ctx, projectID, since)
+}
+
+// fetchCatchUpWindow lists the project's change events after since; a failed read shows no summary.
+func (m Model) fetchCatchUpWindow(ctx context.Context, projectID string, since time.Time) *catchUpWindow {
+	events, err := m.svc.ListProjectChangeEvents(ctx, projectID, catchUpEventLimit)
+	if err != nil {
+		return nil
+	}
+	window := &catchUpWindow{since: since, events: make([]domain.ChangeEvent, 0, len(events))}
+	for _, event := range events {
+		if event.OccurredAt.After(since) {
+			window.events = append(window.events, event)
+		}
+	}
+	// Events arrive newest first, so a full page that never reached since may have cut older changes off.
+	window.truncated = len(events) >= catchUpEventLimit && len(window.events) == len(events)
+	return window
+}
+
+// buildCatchUpSummary summarizes the changes in window that other actors made.
+func (m Model) buildCatchUpSummary(window catchUpWindow) (catchUpSummary, bool) {
+	summary := catchUpSummary{Since: window.since, Truncated: window.truncated}
+	if project, ok := m.currentProject(); ok {
+		summary.ProjectName = project.Name
+	}
+	selfID := strings.TrimSpace(m.identityActorID)
+	actorSeen := map[string]bool{}
+	changes := 0
+	for _, event := range window.events {
+		if selfID != "" && event.ActorID == selfID {
+			continue
+		}
+		entry := mapChangeEventToActivityEntry(event)
+		switch entry.Operation {
+		case domain.ChangeOperationCreate:
+			summary.Created++
+		case domain.ChangeOperationMove:
+			// A move into a done column is reported as a completion.
+			if state, ok := m.lifecycleStateForColumnID(entry.Metadata["to_column_id"]); ok && state == domain.StateDone {
+				summary.Completed++
+			} else {
+				summary.Moved++
+			}
+		case domain.ChangeOperationArchive, domain.ChangeOperationDelete:
+			summary.Archived++
+		default:
+			summary.Updated++
+		}
+		actor := strings.TrimSpace(entry.ActorName)
+		if actor == "" {
+			actor = entry.ActorID
+		}
+		if !actorSeen[actor] {
+			actorSeen[actor] = true
+			summary.Actors = append(summary.Actors, actor)
+		}
+		// Window events are newest first, which is the order the summary lists them in.
+		if len(summary.Entries) < catchUpLineLimit {
+			summary.Entries = append(summary.Entries, entry)
+		}
+		changes++
+	}
+	if changes == 0 {
+		return catchUpSummary{}, false
+	}
+	return summary, true
+}
+
+// catchUpCounts renders the non-zero change counts of one summary.
+func (s catchUpSummary) catchUpCounts() string {
+	parts := make([]string, 0, 5)
+	for _, part := range []struct {
+		count int
+		label string
+	}{
+		{s.Created, "created"},
+		{s.Moved, "moved"},
+		{s.Completed, "completed"},
+		{s.Updated, "updated"},
+		{s.Archived, "archived"},
+	} {
+		if part.count > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", part.count, part.label))
+		}
+	}
+	return strings.Join(parts, " • ")
+}
+
+// handleCatchUpKey handles input while the catch-up summary is open.
+func (m Model) handleCatchUpKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case msg.String() == "a":
+		cmd := m.dismissCatchUp()
+		m.mode = modeActivityLog
+		m.status = "activity log"
+		return m, cmd
+	case msg.Code == tea.KeyEscape || msg.String() == "esc" || msg.Code == tea.KeyEnter || msg.String() == "enter":
+		cmd := m.dismissCatchUp()
+		m.mode = modeNone
+		m.status = "ready"
+		// The summary may have pre-empted the draft recovery check, so run it now.
+		return m, tea.Batch(cmd, m.checkTaskFormDraftCmd())
+	default:
+		return m, nil
+	}
+}
+
+// renderCatchUpOverlay renders the catch-up summary of changes since the last visit.
+func (m Model) renderCatchUpOverlay(accent, muted color.Color, maxWidth int) string {
+	style := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(accent).
+		Padding(0, 1)
+	if maxWidth > 0 {
+		style = style.Width(clamp(maxWidth, 40, 76))
+	}
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(accent)
+	hintStyle := lipgloss.NewStyle().Foreground(muted)
+
+	summary := m.catchUp
+	since := "since " + summary.Since.Local().Format("2006-01-02 15:04")
+	if summary.ProjectName != "" {
+		since = summary.ProjectName + " " + since
+	}
+	lines := []string{
+		titleStyle.Render("While You Were Away"),
+		hintStyle.Render(since),
+		summary.catchUpCounts(),
+		hintStyle.Render("by " + truncate(strings.Join(summary.Actors, ", "), 64)),
+		"",
+	}
+	for _, entry := range summary.Entries {
+		actor := strings.TrimSpace(entry.ActorName)
+		if actor == "" {
+			actor = entry.ActorID
+		}
+		lines = append(lines, fmt.Sprintf("%s %s %s", hintStyle.Render(formatActivityTimestamp(entry.At)), truncate(entry.Summary+" "+entry.Target, 44), hintStyle.Render(actor)))
+	}
+	if summary.Truncated {
+		lines = append(lines, hintStyle.Render("older changes were left out of this summary"))
+	}
+	lines = append(lines, "", hintStyle.Render("enter/esc dismiss • a full activity log"))
+	return style.Render(strings.Join(lines, "\n"))
+}
+
+// recordFocusVisit loads the pending focus catch-up when no board load will apply it.
+func (m *Model) recordFocusVisit() tea.Cmd {
+	projectID, ok := m.currentProjectID()
+	if !m.catchUpPending || !ok {
+		return nil
+	}
+	m.catchUpPending = false
+	since, known := m.lastSeen[projectID]
+	at := time.Now().UTC()
+	if !known {
+		m.recordProjectVisit(projectID, nil, at)
+		return nil
+	}
+	return func() tea.Msg {
+		return catchUpLoadedMsg{projectID: projectID, window: m.fetchCatchUpWindow(context.Background(), projectID, since), at: at}
+	}
+}
+
+// applyCatchUpLoaded opens the focus catch-up once its changes are loaded, unless the user moved to another project.
+func (m *Model) applyCatchUpLoaded(msg catchUpLoadedMsg) {
+	if projectID, ok := m.currentProjectID(); !ok || projectID != msg.projectID {
+		return
+	}
+	m.recordProjectVisit(msg.projectID, msg.window, msg.at)
+}
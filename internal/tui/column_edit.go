@@ -0,0 +1,176 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"image/color"
+	"strconv"
+	"strings"
+
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/hylla/tillsyn/internal/app"
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// columnEditNameField and columnEditWIPField index the column-edit inputs.
+const (
+	columnEditNameField = iota
+	columnEditWIPField
+)
+
+// columnUpdater is the optional service extension used to rename columns and set their WIP limits.
+type columnUpdater interface {
+	UpdateColumn(context.Context, app.UpdateColumnInput) (domain.Column, error)
+}
+
+// startColumnEditForm opens a modal for renaming the focused column and setting its WIP limit.
+func (m *Model) startColumnEditForm() tea.Cmd {
+	if m.readOnly {
+		m.status = readOnlyStatus("edit column")
+		return nil
+	}
+	if len(m.columns) == 0 {
+		m.status = "no column selected"
+		return nil
+	}
+	column := m.columns[clamp(m.selectedColumn, 0, len(m.columns)-1)]
+	m.columnEditColumnID = column.ID
+	m.columnEditInputs = []textinput.Model{
+		newModalInput("", "column name", column.Name, 80),
+		newModalInput("", "0 for no limit", strconv.Itoa(column.WIPLimit), 6),
+	}
+	m.mode = modeEditColumn
+	m.help.ShowAll = false
+	m.status = "edit column"
+	return m.focusColumnEditField(columnEditNameField)
+}
+
+// focusColumnEditField focuses one column-edit input.
+func (m *Model) focusColumnEditField(idx int) tea.Cmd {
+	if len(m.columnEditInputs) == 0 {
+		return nil
+	}
+	idx = clamp(idx, 0, len(m.columnEditInputs)-1)
+	m.columnEditFocus = idx
+	for i := range m.columnEditInputs {
+		m.columnEditInputs[i].Blur()
+	}
+	m.columnEditInputs[idx].CursorEnd()
+	return m.columnEditInputs[idx].Focus()
+}
+
+// closeColumnEditForm leaves the column-edit modal and drops its inputs.
+func (m *Model) closeColumnEditForm() {
+	m.mode = modeNone
+	m.columnEditInputs = nil
+	m.columnEditFocus = 0
+	m.columnEditColumnID = ""
+}
+
+// handleColumnEditKey handles input while the column-edit modal is open.
+func (m Model) handleColumnEditKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	if len(m.columnEditInputs) == 0 {
+		m.closeColumnEditForm()
+		return m, nil
+	}
+	if handled, status := applyClipboardShortcutToInput(msg, &m.columnEditInputs[m.columnEditFocus]); handled {
+		m.status = status
+		return m, nil
+	}
+	switch {
+	case msg.Code == tea.KeyEscape || msg.String() == "esc":
+		m.closeColumnEditForm()
+		m.status = "cancelled"
+		return m, nil
+	case msg.Code == tea.KeyTab || msg.String() == "tab" || msg.String() == "ctrl+i" || msg.String() == "down":
+		return m, m.focusColumnEditField((m.columnEditFocus + 1) % len(m.columnEditInputs))
+	case msg.String() == "shift+tab" || msg.String() == "backtab" || msg.String() == "up":
+		return m, m.focusColumnEditField((m.columnEditFocus + len(m.columnEditInputs) - 1) % len(m.columnEditInputs))
+	case msg.Code == tea.KeyEnter || msg.String() == "enter":
+		return m.submitColumnEdit()
+	default:
+		var cmd tea.Cmd
+		m.columnEditInputs[m.columnEditFocus], cmd = m.columnEditInputs[m.columnEditFocus].Update(msg)
+		_ = scrubTextInputTerminalArtifacts(&m.columnEditInputs[m.columnEditFocus])
+		return m, cmd
+	}
+}
+
+// submitColumnEdit validates the column-edit inputs and persists them.
+// Invalid input keeps the modal open with the reason in the status line.
+func (m Model) submitColumnEdit() (tea.Model, tea.Cmd) {
+	if m.readOnly {
+		m.closeColumnEditForm()
+		return m.readOnlyBlocked("edit column")
+	}
+	updater, ok := m.svc.(columnUpdater)
+	if !ok {
+		m.closeColumnEditForm()
+		m.status = "column editing unavailable"
+		return m, nil
+	}
+	name := strings.TrimSpace(m.columnEditInputs[columnEditNameField].Value())
+	if name == "" {
+		m.status = "column name is required"
+		return m, m.focusColumnEditField(columnEditNameField)
+	}
+	for _, column := range m.columns {
+		if column.ID != m.columnEditColumnID && strings.EqualFold(strings.TrimSpace(column.Name), name) {
+			m.status = fmt.Sprintf("column %q already exists", column.Name)
+			return m, m.focusColumnEditField(columnEditNameField)
+		}
+	}
+	wipRaw := strings.TrimSpace(m.columnEditInputs[columnEditWIPField].Value())
+	wipLimit := 0
+	if wipRaw != "" {
+		parsed, err := strconv.Atoi(wipRaw)
+		if err != nil || parsed < 0 {
+			m.status = "wip limit must be a non-negative integer"
+			return m, m.focusColumnEditField(columnEditWIPField)
+		}
+		wipLimit = parsed
+	}
+	in := app.UpdateColumnInput{ColumnID: m.columnEditColumnID, Name: name, WIPLimit: wipLimit}
+	m.closeColumnEditForm()
+	m.status = "saving column..."
+	return m, func() tea.Msg {
+		if _, err := updater.UpdateColumn(context.Background(), in); err != nil {
+			return actionMsg{err: fmt.Errorf("update column: %w", err)}
+		}
+		return actionMsg{status: "column updated", reload: true, focusColumnID: in.ColumnID}
+	}
+}
+
+// renderColumnEditOverlay renders the column-edit modal.
+func (m Model) renderColumnEditOverlay(accent, muted color.Color, maxWidth int) string {
+	style := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(accent).
+		Padding(0, 1)
+	inputWidth := 40
+	if maxWidth > 0 {
+		boxWidth := clamp(maxWidth, 40, 64)
+		style = style.Width(boxWidth)
+		inputWidth = max(18, boxWidth-16)
+	}
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(accent)
+	hintStyle := lipgloss.NewStyle().Foreground(muted)
+
+	lines := []string{titleStyle.Render("Edit Column")}
+	for i, label := range []string{"name", "wip limit"} {
+		if i >= len(m.columnEditInputs) {
+			break
+		}
+		labelStyle := hintStyle
+		if i == m.columnEditFocus {
+			labelStyle = lipgloss.NewStyle().Bold(true).Foreground(accent)
+		}
+		in := m.columnEditInputs[i]
+		in.SetWidth(inputWidth)
+		lines = append(lines, labelStyle.Render(fmt.Sprintf("%-11s", label+":"))+" "+in.View())
+	}
+	lines = append(lines, hintStyle.Render("tab next field • enter save • esc cancel"))
+	return style.Render(strings.Join(lines, "\n"))
+}
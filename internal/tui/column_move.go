@@ -0,0 +1,102 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// errColumnMoveUnavailable reports a service that cannot reorder columns.
+var errColumnMoveUnavailable = errors.New("column reordering unavailable")
+
+// columnMover is the optional service extension used to reorder board columns.
+type columnMover interface {
+	MoveColumn(context.Context, string, int) (domain.Column, error)
+}
+
+// moveFocusedColumn moves the focused column one slot left or right and records the move for undo.
+func (m Model) moveFocusedColumn(delta int) (tea.Model, tea.Cmd) {
+	if m.readOnly {
+		return m.readOnlyBlocked("move column")
+	}
+	mover, ok := m.svc.(columnMover)
+	if !ok {
+		m.status = errColumnMoveUnavailable.Error()
+		return m, nil
+	}
+	if len(m.columns) == 0 {
+		m.status = "no column selected"
+		return m, nil
+	}
+	from := clamp(m.selectedColumn, 0, len(m.columns)-1)
+	to := from + delta
+	if to < 0 || to >= len(m.columns) {
+		m.status = "column already at the edge"
+		return m, nil
+	}
+	column := m.columns[from]
+	direction := "right"
+	if delta < 0 {
+		direction = "left"
+	}
+	// Positions are board indexes of active columns, which is what MoveColumn expects.
+	history := historyActionSet{
+		Label:   "move column",
+		Summary: "column moved " + direction,
+		Target:  column.Name,
+		Steps: []historyStep{{
+			Kind:         historyStepColumnMove,
+			ColumnID:     column.ID,
+			FromPosition: from,
+			ToPosition:   to,
+		}},
+		Undoable: true,
+		At:       time.Now().UTC(),
+	}
+	activity := activityEntry{
+		At:      history.At,
+		Summary: history.Label,
+		Target:  column.Name,
+	}
+	return m, func() tea.Msg {
+		if _, err := mover.MoveColumn(context.Background(), column.ID, to); err != nil {
+			return actionMsg{err: fmt.Errorf("move column: %w", err)}
+		}
+		return actionMsg{
+			status:        history.Summary,
+			reload:        true,
+			focusColumnID: column.ID,
+			historyPush:   &history,
+			activityItem:  &activity,
+		}
+	}
+}
+
+// replayColumnMove applies one column history step in the undo or redo direction.
+func (m Model) replayColumnMove(step historyStep, undo bool) error {
+	mover, ok := m.svc.(columnMover)
+	if !ok {
+		return errColumnMoveUnavailable
+	}
+	position := step.ToPosition
+	if undo {
+		position = step.FromPosition
+	}
+	_, err := mover.MoveColumn(context.Background(), step.ColumnID, position)
+	return err
+}
+
+// focusColumnByID selects the column with the given id, keeping the current selection when it is gone.
+func (m *Model) focusColumnByID(columnID string) {
+	for idx, column := range m.columns {
+		if column.ID == columnID {
+			m.selectedColumn = idx
+			m.clampSelections()
+			return
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/hylla/tillsyn/internal/app"
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// columnTaskPager is the optional service extension used for lazy per-column task loading.
+type columnTaskPager interface {
+	ListTasksPage(context.Context, app.ListTasksPageInput) (app.TaskPage, error)
+}
+
+// columnPageLoadedMsg carries one additional page of tasks for a lazily loaded column.
+type columnPageLoadedMsg struct {
+	projectID  string
+	columnID   string
+	offset     int
+	nextOffset int
+	tasks      []domain.Task
+	hasMore    bool
+	count      app.TaskPageCount
+	err        error
+}
+
+// columnPageState is the per-column paging progress of one board load.
+// Offsets count board rows, matching the pager's offsets rather than the loaded task count.
+type columnPageState struct {
+	hasMore map[string]bool
+	offsets map[string]int
+	counts  map[string]app.TaskPageCount
+}
+
+// lazyColumnPager returns the paging service when lazy column loading is enabled and supported.
+func (m Model) lazyColumnPager() (columnTaskPager, bool) {
+	if m.columnPageSize <= 0 {
+		return nil, false
+	}
+	pager, ok := m.svc.(columnTaskPager)
+	return pager, ok
+}
+
+// loadColumnPagedTasks loads each column up to its previously loaded depth, then pages on demand to reach a pending focus target.
+func (m Model) loadColumnPagedTasks(ctx context.Context, pager columnTaskPager, projectID string, columns []domain.Column) ([]domain.Task, columnPageState, error) {
+	tasks := make([]domain.Task, 0)
+	hasMore := make(map[string]bool, len(columns))
+	offsets := make(map[string]int, len(columns))
+	counts := make(map[string]app.TaskPageCount, len(columns))
+	state := columnPageState{hasMore: hasMore, offsets: offsets, counts: counts}
+	fetch := func(columnID string, limit int) (bool, error) {
+		page, err := pager.ListTasksPage(ctx, app.ListTasksPageInput{
+			ProjectID:       projectID,
+			ColumnID:        columnID,
+			IncludeArchived: m.showArchived,
+			Limit:           limit,
+			Offset:          offsets[columnID],
+		})
+		if err != nil {
+			return false, err
+		}
+		tasks = append(tasks, page.Tasks...)
+		offsets[columnID] = page.NextOffset
+		hasMore[columnID] = page.HasMore
+		counts[columnID] = page.Count
+		return page.HasMore && len(page.Tasks) > 0, nil
+	}
+
+	for _, column := range columns {
+		// Reloads keep every page the user already scrolled through so the selection does not jump back.
+		want := max(m.columnPageSize, m.columnLoadedCounts[column.ID])
+		for {
+			more, err := fetch(column.ID, want-offsets[column.ID])
+			if err != nil {
+				return nil, columnPageState{}, err
+			}
+			if !more || offsets[column.ID] >= want {
+				break
+			}
+		}
+	}
+
+	focusID := strings.TrimSpace(m.pendingFocusTaskID)
+	if focusID == "" {
+		focusID = strings.TrimSpace(m.pendingOpenTaskInfoID)
+	}
+	if focusID == "" || containsTaskID(tasks, focusID) {
+		return tasks, state, nil
+	}
+	// Search jumps and notifications can target tasks outside the loaded window; keep paging until found.
+	for _, column := range columns {
+		for hasMore[column.ID] {
+			if err := ctx.Err(); err != nil {
+				return nil, columnPageState{}, err
+			}
+			before := len(tasks)
+			more, err := fetch(column.ID, m.columnPageSize)
+			if err != nil {
+				return nil, columnPageState{}, err
+			}
+			if containsTaskID(tasks[before:], focusID) {
+				return tasks, state, nil
+			}
+			if !more {
+				break
+			}
+		}
+	}
+	return tasks, state, nil
+}
+
+// containsTaskID reports whether tasks include one id.
+func containsTaskID(tasks []domain.Task, taskID string) bool {
+	for _, task := range tasks {
+		if task.ID == taskID {
+			return true
+		}
+	}
+	return false
+}
+
+// applyColumnPageState records per-column loaded depth and board-row counts from one full board load.
+func (m *Model) applyColumnPageState(msg loadedMsg) {
+	m.columnPageInFlight = ""
+	m.columnHasMore = maps.Clone(msg.columnHasMore)
+	m.columnLoadedCounts = maps.Clone(msg.columnOffsets)
+	m.columnTaskCounts = maps.Clone(msg.columnCounts)
+}
+
+// columnHeaderCounts returns a column's board-row count and unarchived count for its header and WIP check.
+// Partially loaded columns report the pager's counts; fully loaded ones count their rows, which include local edits.
+func (m Model) columnHeaderCounts(columnID string, colTasks []domain.Task) (int, int) {
+	if count, ok := m.columnTaskCounts[columnID]; ok && m.columnHasMore[columnID] && strings.TrimSpace(m.projectionRootTaskID) == "" {
+		return count.Total, count.Active
+	}
+	active := 0
+	for _, task := range colTasks {
+		if task.ArchivedAt == nil {
+			active++
+		}
+	}
+	return len(colTasks), active
+}
+
+// nextColumnPageCmd requests the next page for the focused column once the selection reaches its loaded end.
+func (m *Model) nextColumnPageCmd() tea.Cmd {
+	pager, ok := m.lazyColumnPager()
+	if !ok || m.searchApplied || m.columnPageInFlight != "" {
+		return nil
+	}
+	columnID, ok := m.currentColumnID()
+	if !ok || !m.columnHasMore[columnID] {
+		return nil
+	}
+	if tasks := m.currentColumnTasks(); m.selectedTask < len(tasks)-1 {
+		return nil
+	}
+	projectID, ok := m.currentProjectID()
+	if !ok {
+		return nil
+	}
+	m.columnPageInFlight = columnID
+	offset := m.columnLoadedCounts[columnID]
+	in := app.ListTasksPageInput{
+		ProjectID:       projectID,
+		ColumnID:        columnID,
+		IncludeArchived: m.showArchived,
+		Limit:           m.columnPageSize,
+		Offset:          offset,
+	}
+	return func() tea.Msg {
+		page, err := pager.ListTasksPage(context.Background(), in)
+		if err != nil {
+			return columnPageLoadedMsg{projectID: projectID, columnID: columnID, offset: offset, err: err}
+		}
+		return columnPageLoadedMsg{
+			projectID:  projectID,
+			columnID:   columnID,
+			offset:     offset,
+			nextOffset: page.NextOffset,
+			tasks:      page.Tasks,
+			hasMore:    page.HasMore,
+			count:      page.Count,
+		}
+	}
+}
+
+// applyColumnPage merges one lazily loaded page into the board.
+func (m *Model) applyColumnPage(msg columnPageLoadedMsg) {
+	if m.columnPageInFlight == msg.columnID {
+		m.columnPageInFlight = ""
+	}
+	if msg.err != nil {
+		m.status = "load more failed: " + msg.err.Error()
+		return
+	}
+	projectID, ok := m.currentProjectID()
+	if !ok || projectID != msg.projectID || m.columnLoadedCounts[msg.columnID] != msg.offset {
+		// The board moved on (project switch or a full reload) since this page was requested.
+		return
+	}
+	added := 0
+	for _, task := range msg.tasks {
+		// Optimistic inserts may already hold rows from this page.
+		if _, exists := m.taskByID(task.ID); exists {
+			continue
+		}
+		m.tasks = append(m.tasks, task)
+		added++
+	}
+	offsets := maps.Clone(m.columnLoadedCounts)
+	offsets[msg.columnID] = msg.nextOffset
+	m.columnLoadedCounts = offsets
+	hasMore := maps.Clone(m.columnHasMore)
+	hasMore[msg.columnID] = msg.hasMore
+	m.columnHasMore = hasMore
+	counts := maps.Clone(m.columnTaskCounts)
+	if counts == nil {
+		counts = map[string]app.TaskPageCount{}
+	}
+	counts[msg.columnID] = msg.count
+	m.columnTaskCounts = counts
+	m.clampSelections()
+	if added > 0 {
+		m.status = fmt.Sprintf("loaded %d more tasks", added)
+	}
+}
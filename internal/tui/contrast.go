@@ -0,0 +1,88 @@
+package tui
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// WCAG 2.x contrast thresholds for normal and large/bold text.
+const (
+	ContrastAANormal = 4.5
+	ContrastAALarge  = 3.0
+)
+
+// rgb stores one 8-bit-per-channel color.
+type rgb struct {
+	r, g, b uint8
+}
+
+// ansi16 lists the xterm default RGB values for the standard 16 colors.
+var ansi16 = [16]rgb{
+	{0, 0, 0}, {205, 0, 0}, {0, 205, 0}, {205, 205, 0},
+	{0, 0, 238}, {205, 0, 205}, {0, 205, 205}, {229, 229, 229},
+	{127, 127, 127}, {255, 0, 0}, {0, 255, 0}, {255, 255, 0},
+	{92, 92, 255}, {255, 0, 255}, {0, 255, 255}, {255, 255, 255},
+}
+
+// ContrastRatio computes the WCAG contrast ratio, from 1 to 21, between two theme colors.
+// Colors use the same ANSI index or #RRGGBB forms config validation accepts.
+func ContrastRatio(fg, bg string) (float64, error) {
+	a, err := parseThemeColor(fg)
+	if err != nil {
+		return 0, err
+	}
+	b, err := parseThemeColor(bg)
+	if err != nil {
+		return 0, err
+	}
+	la, lb := relativeLuminance(a), relativeLuminance(b)
+	if la < lb {
+		la, lb = lb, la
+	}
+	return (la + 0.05) / (lb + 0.05), nil
+}
+
+// parseThemeColor resolves one validated ANSI index or #RRGGBB color to RGB.
+func parseThemeColor(raw string) (rgb, error) {
+	value := strings.TrimSpace(raw)
+	if !domain.ValidColor(value) {
+		return rgb{}, fmt.Errorf("color %q must be an ANSI index 0-255 or #RRGGBB", raw)
+	}
+	if hex, ok := strings.CutPrefix(value, "#"); ok {
+		n, _ := strconv.ParseUint(hex, 16, 32)
+		return rgb{r: uint8(n >> 16), g: uint8(n >> 8), b: uint8(n)}, nil
+	}
+	idx, _ := strconv.Atoi(value)
+	return ansi256ToRGB(idx), nil
+}
+
+// ansi256ToRGB converts one xterm 256-color index to RGB.
+func ansi256ToRGB(idx int) rgb {
+	switch {
+	case idx < 16:
+		return ansi16[idx]
+	case idx < 232:
+		levels := [6]uint8{0, 95, 135, 175, 215, 255}
+		idx -= 16
+		return rgb{r: levels[idx/36], g: levels[(idx/6)%6], b: levels[idx%6]}
+	default:
+		gray := uint8(8 + (idx-232)*10)
+		return rgb{r: gray, g: gray, b: gray}
+	}
+}
+
+// relativeLuminance computes WCAG relative luminance in [0, 1].
+func relativeLuminance(c rgb) float64 {
+	channel := func(v uint8) float64 {
+		s := float64(v) / 255
+		if s <= 0.03928 {
+			return s / 12.92
+		}
+		return math.Pow((s+0.055)/1.055, 2.4)
+	}
+	return 0.2126*channel(c.r) + 0.7152*channel(c.g) + 0.0722*channel(c.b)
+}
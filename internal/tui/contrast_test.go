@@ -0,0 +1,34 @@
+package tui
+
+import (
+	"math"
+	"testing"
+)
+
+// TestContrastRatioKnownValues verifies WCAG ratios for reference color pairs and shared color validation.
+func TestContrastRatioKnownValues(t *testing.T) {
+	cases := []struct {
+		fg, bg string
+		want   float64
+	}{
+		{fg: "#ffffff", bg: "#000000", want: 21},
+		{fg: "15", bg: "0", want: 21},
+		{fg: "#777777", bg: "#ffffff", want: 4.48},
+		{fg: "#000000", bg: "#000000", want: 1},
+	}
+	for _, tc := range cases {
+		got, err := ContrastRatio(tc.fg, tc.bg)
+		if err != nil {
+			t.Fatalf("ContrastRatio(%q, %q) error = %v", tc.fg, tc.bg, err)
+		}
+		if math.Abs(got-tc.want) > 0.01 {
+			t.Fatalf("ContrastRatio(%s, %s) = %.3f, want %.2f", tc.fg, tc.bg, got, tc.want)
+		}
+	}
+	// Config validation rejects these, so the check must too.
+	for _, bad := range []string{"300", "#777", "nope"} {
+		if _, err := ContrastRatio(bad, "0"); err == nil {
+			t.Fatalf("expected ContrastRatio(%q) to fail", bad)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/hylla/tillsyn/internal/app"
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// taskKindChanger is the optional service extension used to convert a task to another kind in place.
+type taskKindChanger interface {
+	ChangeTaskKind(context.Context, app.ChangeTaskKindInput) (domain.Task, error)
+}
+
+// convertSelectedTaskKind converts the selected task to kind, keeping its id, history, and children.
+func (m Model) convertSelectedTaskKind(kind domain.WorkKind) (tea.Model, tea.Cmd) {
+	if m.readOnly {
+		return m.readOnlyBlocked("convert to " + string(kind))
+	}
+	changer, ok := m.svc.(taskKindChanger)
+	if !ok {
+		m.status = "convert unavailable"
+		return m, nil
+	}
+	task, ok := m.selectedTaskInCurrentColumn()
+	if !ok {
+		m.status = "no task selected"
+		return m, nil
+	}
+	if task.Kind == kind {
+		m.status = fmt.Sprintf("already a %s", kind)
+		return m, nil
+	}
+	taskID := task.ID
+	return m, func() tea.Msg {
+		converted, err := changer.ChangeTaskKind(context.Background(), app.ChangeTaskKindInput{TaskID: taskID, Kind: kind})
+		if err != nil {
+			return actionMsg{err: fmt.Errorf("convert to %s: %w", kind, err)}
+		}
+		return actionMsg{
+			status:      fmt.Sprintf("converted %q to %s", converted.Title, converted.Kind),
+			reload:      true,
+			focusTaskID: converted.ID,
+		}
+	}
+}
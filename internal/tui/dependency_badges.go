@@ -0,0 +1,68 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// DependencyBadge names one compact dependency indicator rendered after a board card title.
+type DependencyBadge string
+
+// DependencyBadgeBlockedBy and related constants define supported card dependency badges.
+const (
+	// DependencyBadgeBlockedBy counts unresolved depends_on and blocked_by references, rendered as ⛔N.
+	DependencyBadgeBlockedBy DependencyBadge = "blocked_by"
+	// DependencyBadgeBlocks counts open tasks waiting on this one, rendered as →N.
+	DependencyBadgeBlocks DependencyBadge = "blocks"
+)
+
+// normalizeDependencyBadges lowercases, dedupes, and drops unknown badge names while keeping order.
+func normalizeDependencyBadges(raw []DependencyBadge) []DependencyBadge {
+	out := make([]DependencyBadge, 0, len(raw))
+	for _, badge := range raw {
+		badge = DependencyBadge(strings.ToLower(strings.TrimSpace(string(badge))))
+		switch badge {
+		case DependencyBadgeBlockedBy, DependencyBadgeBlocks:
+		default:
+			continue
+		}
+		if !slices.Contains(out, badge) {
+			out = append(out, badge)
+		}
+	}
+	return out
+}
+
+// taskIsOpen reports whether a task still participates in dependency badges: not archived and not done.
+func (m Model) taskIsOpen(task domain.Task) bool {
+	return task.ArchivedAt == nil && m.lifecycleStateForTask(task) != domain.StateDone
+}
+
+// taskDependencyBadges renders the configured dependency badges for one card, or "" when none apply.
+// Counts come from the project's dependency rollup, which leaves out archived and done tasks since they no longer
+// block or wait on anything.
+func (m Model) taskDependencyBadges(task domain.Task) string {
+	if len(m.dependencyBadges) == 0 {
+		return ""
+	}
+	parts := make([]string, 0, len(m.dependencyBadges))
+	for _, badge := range m.dependencyBadges {
+		switch badge {
+		case DependencyBadgeBlockedBy:
+			if count := m.dependencyRollup.UnresolvedByTask[task.ID]; count > 0 {
+				parts = append(parts, fmt.Sprintf("⛔%d", count))
+			}
+		case DependencyBadgeBlocks:
+			if count := m.dependencyRollup.BlockingByTask[task.ID]; count > 0 && m.taskIsOpen(task) {
+				parts = append(parts, fmt.Sprintf("→%d", count))
+			}
+		}
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return " " + strings.Join(parts, " ")
+}
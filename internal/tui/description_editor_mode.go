@@ -38,12 +38,7 @@ type descriptionEditorFrame struct {
 
 // renderDescriptionEditorModeView renders the dedicated full-screen description editor surface.
 func (m Model) renderDescriptionEditorModeView() tea.View {
-	accent := lipgloss.Color("62")
-	if project, ok := m.currentProject(); ok {
-		accent = projectAccentColor(project)
-	}
-	muted := lipgloss.Color("241")
-	dim := lipgloss.Color("239")
+	accent, muted, dim := m.surfaceColors()
 
 	sectionTitleStyle := lipgloss.NewStyle().Bold(true).Foreground(accent)
 	title := "Description Editor"
@@ -103,12 +98,7 @@ func (m Model) renderDescriptionEditorModeView() tea.View {
 
 // descriptionEditorLayout computes render dimensions for edit/preview submodes.
 func (m Model) descriptionEditorLayout() descriptionEditorLayoutMetrics {
-	accent := lipgloss.Color("62")
-	if project, ok := m.currentProject(); ok {
-		accent = projectAccentColor(project)
-	}
-	muted := lipgloss.Color("241")
-	dim := lipgloss.Color("239")
+	accent, muted, dim := m.surfaceColors()
 	title := "Description Editor"
 	subtitle := "mode: edit"
 	if m.descriptionEditorMode == descriptionEditorViewModePreview {
@@ -333,7 +323,7 @@ func (m Model) descriptionEditorProjectLabel(projectID string) string {
 			if strings.TrimSpace(project.ID) != projectID {
 				continue
 			}
-			if label := strings.TrimSpace(projectDisplayName(project)); label != "" {
+			if label := strings.TrimSpace(m.projectDisplayName(project)); label != "" {
 				return label
 			}
 			return projectID
@@ -341,7 +331,7 @@ func (m Model) descriptionEditorProjectLabel(projectID string) string {
 		return projectID
 	}
 	if project, ok := m.currentProject(); ok {
-		if label := strings.TrimSpace(projectDisplayName(project)); label != "" {
+		if label := strings.TrimSpace(m.projectDisplayName(project)); label != "" {
 			return label
 		}
 		if id := strings.TrimSpace(project.ID); id != "" {
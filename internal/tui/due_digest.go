@@ -0,0 +1,172 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"image/color"
+	"slices"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// dueDigestLineLimit caps how many tasks the startup due digest lists.
+const dueDigestLineLimit = 10
+
+// dueDigestItem is one overdue or due-today task with the project it belongs to.
+type dueDigestItem struct {
+	ProjectName string
+	Task        domain.Task
+	Overdue     bool
+}
+
+// dueDigestSummary lists the most urgent due tasks across projects, earliest due first.
+type dueDigestSummary struct {
+	Overdue  int
+	DueToday int
+	// Items holds at most dueDigestLineLimit tasks; the counts cover every match.
+	Items []dueDigestItem
+}
+
+// dueDigestLoadedMsg carries the startup due digest once every project has been scanned.
+type dueDigestLoadedMsg struct {
+	summary dueDigestSummary
+	err     error
+}
+
+// startupDueDigestCmd scans all projects for overdue and due-today tasks once per session.
+// The launch picker and bootstrap screens hide the board, so the digest waits for the first board load.
+func (m *Model) startupDueDigestCmd() tea.Cmd {
+	if !m.startupDueDigest || m.startupDueDigestChecked {
+		return nil
+	}
+	if m.mode == modeProjectPicker || m.mode == modeBootstrapSettings {
+		return nil
+	}
+	m.startupDueDigestChecked = true
+	svc := m.svc
+	now := time.Now()
+	return func() tea.Msg {
+		summary, err := loadDueDigest(context.Background(), svc, now)
+		return dueDigestLoadedMsg{summary: summary, err: err}
+	}
+}
+
+// loadDueDigest collects unfinished tasks due before the end of now's local day from every active project.
+func loadDueDigest(ctx context.Context, svc Service, now time.Time) (dueDigestSummary, error) {
+	projects, err := svc.ListProjects(ctx, false)
+	if err != nil {
+		return dueDigestSummary{}, fmt.Errorf("list projects: %w", err)
+	}
+	year, month, day := now.Date()
+	endOfDay := time.Date(year, month, day+1, 0, 0, 0, 0, now.Location())
+	items := make([]dueDigestItem, 0)
+	for _, project := range projects {
+		if project.ArchivedAt != nil {
+			continue
+		}
+		tasks, err := svc.ListTasks(ctx, project.ID, false)
+		if err != nil {
+			return dueDigestSummary{}, fmt.Errorf("list tasks for project %s: %w", project.Name, err)
+		}
+		for _, task := range tasks {
+			if task.ArchivedAt != nil || task.DueAt == nil || !task.DueAt.Before(endOfDay) {
+				continue
+			}
+			if task.LifecycleState == domain.StateDone || task.LifecycleState == domain.StateArchived {
+				continue
+			}
+			items = append(items, dueDigestItem{
+				ProjectName: project.Name,
+				Task:        task,
+				Overdue:     task.DueAt.Before(now),
+			})
+		}
+	}
+	slices.SortStableFunc(items, func(a, b dueDigestItem) int {
+		return a.Task.DueAt.Compare(*b.Task.DueAt)
+	})
+	summary := dueDigestSummary{}
+	for _, item := range items {
+		if item.Overdue {
+			summary.Overdue++
+		} else {
+			summary.DueToday++
+		}
+	}
+	summary.Items = items[:min(len(items), dueDigestLineLimit)]
+	return summary, nil
+}
+
+// applyDueDigestLoaded opens the digest over the board or a startup summary, unless the user has already moved on.
+func (m Model) applyDueDigestLoaded(msg dueDigestLoadedMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		m.status = "due digest failed: " + msg.err.Error()
+		return m, nil
+	}
+	if len(msg.summary.Items) == 0 {
+		return m, nil
+	}
+	switch m.mode {
+	case modeNone, modeCatchUp, modeRecoverDraft:
+	default:
+		return m, nil
+	}
+	m.dueDigest = msg.summary
+	m.dueDigestBack = m.mode
+	m.mode = modeDueDigest
+	m.help.ShowAll = false
+	m.status = "due today"
+	return m, nil
+}
+
+// handleDueDigestKey dismisses the digest on any key and returns to whatever it covered.
+func (m Model) handleDueDigestKey(tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	m.dueDigest = dueDigestSummary{}
+	m.mode = m.dueDigestBack
+	m.dueDigestBack = modeNone
+	if m.mode != modeNone {
+		return m, nil
+	}
+	m.status = "ready"
+	// The digest may have pre-empted the draft recovery check, so run it now.
+	return m, m.checkTaskFormDraftCmd()
+}
+
+// renderDueDigestOverlay renders the startup list of overdue and due-today tasks.
+func (m Model) renderDueDigestOverlay(accent, muted color.Color, maxWidth int) string {
+	style := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(accent).
+		Padding(0, 1)
+	if maxWidth > 0 {
+		style = style.Width(clamp(maxWidth, 40, 76))
+	}
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(accent)
+	hintStyle := lipgloss.NewStyle().Foreground(muted)
+	overdueStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(m.theme().Warning))
+
+	digest := m.dueDigest
+	lines := []string{
+		titleStyle.Render("Due Today"),
+		hintStyle.Render(fmt.Sprintf("%d overdue • %d due today", digest.Overdue, digest.DueToday)),
+		"",
+	}
+	for _, item := range digest.Items {
+		due := formatDueValue(item.Task.DueAt)
+		if item.Overdue {
+			due = overdueStyle.Render(due)
+		} else {
+			due = hintStyle.Render(due)
+		}
+		lines = append(lines, fmt.Sprintf("%s %s %s", due, hintStyle.Render(truncate(item.ProjectName, 16)), truncate(item.Task.Title, 40)))
+	}
+	if more := digest.Overdue + digest.DueToday - len(digest.Items); more > 0 {
+		lines = append(lines, hintStyle.Render(fmt.Sprintf("+%d more", more)))
+	}
+	lines = append(lines, "", hintStyle.Render("press any key to continue"))
+	return style.Render(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,108 @@
+package tui
+
+import (
+	"cmp"
+	"image/color"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+const (
+	// duplicateTitleMinScore is the fuzzy score a title pair must reach to count as a likely duplicate.
+	// Substring matches score above 4000; tight subsequences, such as one-letter typos, land just under 3000.
+	duplicateTitleMinScore = 2980
+	// duplicateTitleMinRunes skips very short titles, which would otherwise match nearly everything.
+	duplicateTitleMinRunes = 4
+	// duplicateTitleMatchLimit caps how many similar tasks the warning lists.
+	duplicateTitleMatchLimit = 5
+)
+
+// similarTaskTitles returns non-archived project tasks whose titles closely match title, closest first.
+func (m Model) similarTaskTitles(title string) []domain.Task {
+	title = normalizeCommandPaletteToken(title)
+	if utf8.RuneCountInString(title) < duplicateTitleMinRunes {
+		return nil
+	}
+	type scoredTask struct {
+		task  domain.Task
+		score int
+	}
+	scored := make([]scoredTask, 0)
+	for _, task := range m.tasks {
+		if task.ArchivedAt != nil {
+			continue
+		}
+		existing := normalizeCommandPaletteToken(task.Title)
+		if utf8.RuneCountInString(existing) < duplicateTitleMinRunes {
+			continue
+		}
+		// Score both directions so a longer or shorter restatement of an existing title still matches.
+		score, _ := fuzzyScore(title, existing)
+		if reverse, ok := fuzzyScore(existing, title); ok {
+			score = max(score, reverse)
+		}
+		if score < duplicateTitleMinScore {
+			continue
+		}
+		scored = append(scored, scoredTask{task: task, score: score})
+	}
+	slices.SortStableFunc(scored, func(a, b scoredTask) int {
+		return cmp.Compare(b.score, a.score)
+	})
+	out := make([]domain.Task, 0, min(len(scored), duplicateTitleMatchLimit))
+	for _, entry := range scored[:min(len(scored), duplicateTitleMatchLimit)] {
+		out = append(out, entry.task)
+	}
+	return out
+}
+
+// handleDuplicateTitleKey handles input while the duplicate-title warning is open.
+func (m Model) handleDuplicateTitleKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case msg.Code == tea.KeyEscape || msg.String() == "esc" || msg.String() == "n":
+		m.mode = modeAddTask
+		m.duplicateTitleMatches = nil
+		m.status = "create cancelled; form kept"
+		return m, nil
+	case msg.Code == tea.KeyEnter || msg.String() == "enter" || msg.String() == "y":
+		m.mode = modeAddTask
+		m.duplicateTitleMatches = nil
+		m.duplicateTitleConfirmed = true
+		return m.submitInputMode()
+	default:
+		return m, nil
+	}
+}
+
+// renderDuplicateTitleOverlay renders the duplicate-title warning with the similar tasks it found.
+func (m Model) renderDuplicateTitleOverlay(accent, muted color.Color, maxWidth int) string {
+	style := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(accent).
+		Padding(0, 1)
+	if maxWidth > 0 {
+		style = style.Width(clamp(maxWidth, 36, 64))
+	}
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(accent)
+	hintStyle := lipgloss.NewStyle().Foreground(muted)
+
+	columnNames := make(map[string]string, len(m.columns))
+	for _, column := range m.columns {
+		columnNames[column.ID] = column.Name
+	}
+	lines := []string{titleStyle.Render("Similar Task Exists"), hintStyle.Render("this project already has:"), ""}
+	for _, task := range m.duplicateTitleMatches {
+		line := "• " + truncate(task.Title, 48)
+		if name, ok := columnNames[task.ColumnID]; ok {
+			line += hintStyle.Render(" (" + name + ")")
+		}
+		lines = append(lines, line)
+	}
+	lines = append(lines, "", hintStyle.Render("enter create anyway • esc back to form"))
+	return style.Render(strings.Join(lines, "\n"))
+}
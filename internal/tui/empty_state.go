@@ -0,0 +1,61 @@
+package tui
+
+import (
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+)
+
+// defaultEmptyColumnText is the placeholder rendered in columns without visible tasks.
+const defaultEmptyColumnText = "(empty)"
+
+// defaultEmptyBoardMessage is the headline rendered before any project exists.
+const defaultEmptyBoardMessage = "No projects yet."
+
+// emptyColumnLabel returns the configured empty-column placeholder.
+func (m Model) emptyColumnLabel() string {
+	if m.emptyColumnText != "" {
+		return m.emptyColumnText
+	}
+	return defaultEmptyColumnText
+}
+
+// emptyColumnHint suggests the next action for one empty column based on board state.
+func (m Model) emptyColumnHint(colIdx int, boardFocused bool) string {
+	switch {
+	case m.searchApplied:
+		return "no tasks match the active search"
+	case strings.TrimSpace(m.projectionRootTaskID) != "":
+		return bindingHelpKey(m.keys.clearFocus) + " returns to full board"
+	case len(m.tasks) == 0 && colIdx == 0:
+		// A brand-new project gets one prominent nudge instead of a hint in every column.
+		return bindingHelpKey(m.keys.addTask) + " adds your first task"
+	case len(m.tasks) > 0 && boardFocused && colIdx == m.selectedColumn:
+		return bindingHelpKey(m.keys.addTask) + " adds a task here"
+	default:
+		return ""
+	}
+}
+
+// emptyBoardLines returns the no-projects guidance shown before the first project exists.
+func (m Model) emptyBoardLines() []string {
+	lines := make([]string, 0, 4)
+	message := m.emptyBoardMessage
+	if message == "" {
+		message = defaultEmptyBoardMessage
+	}
+	for line := range strings.SplitSeq(message, "\n") {
+		lines = append(lines, strings.TrimRight(line, " \t"))
+	}
+	// Hints follow the active keymap so custom bindings stay accurate.
+	return append(lines,
+		"Press "+bindingHelpKey(m.keys.newProject)+" to create your first project.",
+		"Press "+bindingHelpKey(m.keys.commandPalette)+" for the command palette.",
+		"Press "+bindingHelpKey(m.keys.quit)+" to quit.",
+	)
+}
+
+// bindingHelpKey returns the display key for one binding.
+func bindingHelpKey(binding key.Binding) string {
+	return binding.Help().Key
+}
@@ -0,0 +1,164 @@
+package tui
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+)
+
+// footerHelpLevel selects which key category the board footer lists.
+type footerHelpLevel int
+
+// footerHelpBasics and related constants define the footer categories in cycle order.
+const (
+	footerHelpBasics footerHelpLevel = iota
+	footerHelpNavigation
+	footerHelpTasks
+	footerHelpSelection
+	footerHelpSearch
+)
+
+// footerHelpLevelNames holds the persisted name of each footer category, indexed by level.
+var footerHelpLevelNames = []string{"basics", "navigation", "tasks", "selection", "search"}
+
+// String returns the persisted category name.
+func (l footerHelpLevel) String() string {
+	if l < 0 || int(l) >= len(footerHelpLevelNames) {
+		return footerHelpLevelNames[footerHelpBasics]
+	}
+	return footerHelpLevelNames[l]
+}
+
+// parseFooterHelpLevel resolves one persisted category name, falling back to basics.
+func parseFooterHelpLevel(raw string) footerHelpLevel {
+	if idx := slices.Index(footerHelpLevelNames, raw); idx >= 0 {
+		return footerHelpLevel(idx)
+	}
+	return footerHelpBasics
+}
+
+// viewState stores UI choices that persist across sessions without being configuration.
+type viewState struct {
+	FooterHelp string `json:"footer_help,omitempty"`
+}
+
+// viewStateSavedMsg reports the result of one view-state write.
+type viewStateSavedMsg struct {
+	err error
+}
+
+// readViewState reads persisted view state; a missing file reports the zero state.
+func readViewState(path string) (viewState, error) {
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return viewState{}, nil
+	}
+	if err != nil {
+		return viewState{}, fmt.Errorf("read view state: %w", err)
+	}
+	var state viewState
+	if err := json.Unmarshal(content, &state); err != nil {
+		return viewState{}, fmt.Errorf("decode view state %q: %w", path, err)
+	}
+	return state, nil
+}
+
+// writeViewState replaces the persisted view state through a temp file so readers never see a partial write.
+func writeViewState(path string, state viewState) error {
+	content, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode view state: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("create view state dir: %w", err)
+	}
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, content, 0o600); err != nil {
+		return fmt.Errorf("write view state: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("replace view state: %w", err)
+	}
+	return nil
+}
+
+// cycleFooterHelp advances the board footer to the next key category and persists the choice.
+func (m *Model) cycleFooterHelp() tea.Cmd {
+	m.footerHelp = (m.footerHelp + 1) % footerHelpLevel(len(footerHelpLevelNames))
+	m.status = "footer keys: " + m.footerHelp.String()
+	if m.viewStatePath == "" {
+		return nil
+	}
+	path, state := m.viewStatePath, viewState{FooterHelp: m.footerHelp.String()}
+	return func() tea.Msg {
+		return viewStateSavedMsg{err: writeViewState(path, state)}
+	}
+}
+
+// boardFooterHelpBindings returns the board footer keys for the selected category.
+// Categories past basics end with the full-help and cycle keys; basics keeps its original layout and lists H in full help.
+func (m Model) boardFooterHelpBindings() []key.Binding {
+	var bindings []key.Binding
+	switch m.footerHelp {
+	case footerHelpNavigation:
+		bindings = []key.Binding{
+			helpBinding("h/l", "columns"),
+			helpBinding("j/k", "tasks"),
+			helpBinding("f/F", "subtree"),
+			helpBinding("</>", "move column"),
+			footerBinding(m.keys.projects, "projects"),
+			footerBinding(m.keys.jumpToTask, "jump"),
+		}
+	case footerHelpTasks:
+		bindings = []key.Binding{
+			footerBinding(m.keys.addTask, "new"),
+			footerBinding(m.keys.editTask, "edit"),
+			helpBinding("[/]", "move"),
+			footerBinding(m.keys.deleteTask, "delete"),
+			footerBinding(m.keys.restoreTask, "restore"),
+			footerBinding(m.keys.toggleWatch, "watch"),
+			footerBinding(m.keys.undo, "undo"),
+		}
+	case footerHelpSelection:
+		bindings = []key.Binding{
+			footerBinding(m.keys.multiSelect, "select"),
+			helpBinding("esc", "clear"),
+			footerBinding(m.keys.quickAssign, "assign"),
+			footerBinding(m.keys.quickActions, "actions"),
+			footerBinding(m.keys.toggleSelectMode, "text select"),
+		}
+	case footerHelpSearch:
+		bindings = []key.Binding{
+			footerBinding(m.keys.search, "search"),
+			helpBinding("esc", "clear"),
+			footerBinding(m.keys.toggleArchived, "archived"),
+			footerBinding(m.keys.activityLog, "activity"),
+			footerBinding(m.keys.inbox, "inbox"),
+		}
+	default:
+		return []key.Binding{
+			helpBinding("n", "new task"),
+			helpBinding("enter", "task info"),
+			helpBinding("e", "edit"),
+			helpBinding("tab", "panels"),
+			helpBinding("/", "search"),
+			helpBinding(":", "commands"),
+			helpBinding("q", "quit"),
+			helpBinding("?", "help"),
+		}
+	}
+	next := (m.footerHelp + 1) % footerHelpLevel(len(footerHelpLevelNames))
+	return append(bindings, helpBinding("?", "help"), footerBinding(m.keys.footerHelp, next.String()+" keys"))
+}
+
+// footerBinding copies one binding with a shorter footer description, keeping any configured key.
+func footerBinding(binding key.Binding, desc string) key.Binding {
+	binding.SetHelp(binding.Help().Key, desc)
+	return binding
+}
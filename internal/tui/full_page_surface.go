@@ -27,7 +27,7 @@ const appScreenHeaderGapY = 1
 
 // appInnerWidth returns the shared usable width between the outer gutters.
 func (m Model) appInnerWidth() int {
-	innerWidth := max(36, m.width-2*tuiOuterHorizontalPadding)
+	innerWidth := max(36, m.width-2*m.outerHorizontalPadding())
 	if m.width <= 0 {
 		return 0
 	}
@@ -47,31 +47,52 @@ func (m Model) renderBottomHelpLine(muted, dim color.Color, innerWidth int) stri
 		Render(helpBubble.View(m.activeBottomHelpKeyMap()))
 }
 
+// outerHorizontalPadding returns the left/right gutter width for the current terminal width.
+func (m Model) outerHorizontalPadding() int {
+	if m.compactPaddingBelow > 0 && m.width > 0 && m.width < m.compactPaddingBelow {
+		return 0
+	}
+	return tuiOuterHorizontalPadding
+}
+
 // applyOuterHorizontalPadding wraps content in the shared left/right gutter.
-func applyOuterHorizontalPadding(content string) string {
-	if tuiOuterHorizontalPadding <= 0 {
+func (m Model) applyOuterHorizontalPadding(content string) string {
+	padding := m.outerHorizontalPadding()
+	if padding <= 0 {
 		return content
 	}
 	return lipgloss.NewStyle().
-		PaddingLeft(tuiOuterHorizontalPadding).
-		PaddingRight(tuiOuterHorizontalPadding).
+		PaddingLeft(padding).
+		PaddingRight(padding).
 		Render(content)
 }
 
 // appHeaderBlock renders the shared TILLSYN header with inline path context and the divider rule below it.
 func (m Model) appHeaderBlock(statusStyle lipgloss.Style, innerWidth int) string {
-	headerAccent := lipgloss.Color("62")
+	theme := m.theme()
+	headerAccent := lipgloss.Color(theme.Accent)
 	header := headerMarkStyle().
 		BorderForeground(headerAccent).
-		Foreground(lipgloss.Color("252")).
+		Foreground(lipgloss.Color(theme.Text)).
 		Render(headerMarkText)
 	row := header
-	if pathText := m.appHeaderPathText(max(16, innerWidth-lipgloss.Width(header)-2)); pathText != "" {
-		pathWidth := max(16, innerWidth-lipgloss.Width(header)-2)
+	pathWidth := max(16, innerWidth-lipgloss.Width(header)-2)
+	label := ""
+	if m.readOnly {
+		label = renderReadOnlyBadge()
+		pathWidth = max(16, pathWidth-lipgloss.Width(label)-2)
+	}
+	if pathText := m.appHeaderPathText(pathWidth); pathText != "" {
+		if label != "" {
+			label += "  "
+		}
+		label += statusStyle.Render(pathText)
+	}
+	if label != "" {
 		pathBlock := lipgloss.NewStyle().
 			MarginLeft(2).
-			Width(pathWidth).
-			Render(lipgloss.PlaceVertical(lipgloss.Height(header), lipgloss.Center, statusStyle.Render(pathText)))
+			Width(max(16, innerWidth-lipgloss.Width(header)-2)).
+			Render(lipgloss.PlaceVertical(lipgloss.Height(header), lipgloss.Center, label))
 		row = lipgloss.JoinHorizontal(lipgloss.Top, header, pathBlock)
 	}
 	if innerWidth > 0 {
@@ -87,7 +108,7 @@ func (m Model) appHeaderBlock(statusStyle lipgloss.Style, innerWidth int) string
 func (m Model) appHeaderPathText(maxWidth int) string {
 	projectName := ""
 	if project, ok := m.currentProject(); ok {
-		projectName = projectDisplayName(project)
+		projectName = m.projectDisplayName(project)
 	}
 	if path, _ := m.projectionPathWithProject(projectName); path != "" {
 		return "path: " + collapsePathForDisplay(path, max(12, maxWidth-6))
@@ -210,8 +231,8 @@ func (m Model) renderFullPageSurfaceView(accent, muted, dim color.Color, metrics
 		sections = append(sections, "")
 	}
 	content := strings.Join(sections, "\n")
-	content = applyOuterHorizontalPadding(content)
-	metrics.helpLine = applyOuterHorizontalPadding(metrics.helpLine)
+	content = m.applyOuterHorizontalPadding(content)
+	metrics.helpLine = m.applyOuterHorizontalPadding(metrics.helpLine)
 	if m.height > 0 {
 		content = fitLines(content, max(0, m.height-lipgloss.Height(metrics.helpLine)))
 	}
@@ -230,6 +251,7 @@ func (m Model) renderFullPageSurfaceView(accent, muted, dim color.Color, metrics
 	view := tea.NewView(fullContent)
 	view.MouseMode = m.activeMouseMode()
 	view.AltScreen = true
+	view.ReportFocus = m.refreshOnFocus
 	return view
 }
 
@@ -0,0 +1,137 @@
+package tui
+
+import (
+	"cmp"
+	"image/color"
+	"slices"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+// goToColumnMatchLimit caps how many ranked column matches the go-to-column modal lists.
+const goToColumnMatchLimit = 8
+
+// startGoToColumnMode opens a modal that fuzzy-matches a typed column name in the current project.
+func (m *Model) startGoToColumnMode() tea.Cmd {
+	if len(m.columns) == 0 {
+		m.status = "no columns"
+		return nil
+	}
+	m.mode = modeGoToColumn
+	m.help.ShowAll = false
+	m.goToColumnInput.SetValue("")
+	m.goToColumnIndex = 0
+	m.status = "go to column"
+	return m.goToColumnInput.Focus()
+}
+
+// goToColumnMatches returns up to goToColumnMatchLimit column indexes matching the typed query, best fuzzy score first.
+// Ties keep board order so an empty query lists columns left to right.
+func (m Model) goToColumnMatches() []int {
+	type scoredColumn struct {
+		idx   int
+		score int
+	}
+	query := strings.TrimSpace(m.goToColumnInput.Value())
+	scored := make([]scoredColumn, 0, len(m.columns))
+	for idx, column := range m.columns {
+		score, ok := fuzzyScore(query, column.Name)
+		if !ok {
+			continue
+		}
+		scored = append(scored, scoredColumn{idx: idx, score: score})
+	}
+	slices.SortStableFunc(scored, func(a, b scoredColumn) int {
+		return cmp.Compare(b.score, a.score)
+	})
+	out := make([]int, 0, min(len(scored), goToColumnMatchLimit))
+	for _, entry := range scored[:min(len(scored), goToColumnMatchLimit)] {
+		out = append(out, entry.idx)
+	}
+	return out
+}
+
+// handleGoToColumnKey handles input while the go-to-column modal is open.
+func (m Model) handleGoToColumnKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	if handled, status := applyClipboardShortcutToInput(msg, &m.goToColumnInput); handled {
+		m.status = status
+		m.goToColumnIndex = 0
+		return m, nil
+	}
+	matches := m.goToColumnMatches()
+	m.goToColumnIndex = clamp(m.goToColumnIndex, 0, max(0, len(matches)-1))
+	switch {
+	case msg.Code == tea.KeyEscape || msg.String() == "esc":
+		m.mode = modeNone
+		m.goToColumnInput.Blur()
+		m.status = "cancelled"
+		return m, nil
+	case msg.Code == tea.KeyDown:
+		if m.goToColumnIndex < len(matches)-1 {
+			m.goToColumnIndex++
+		}
+		return m, nil
+	case msg.Code == tea.KeyUp:
+		if m.goToColumnIndex > 0 {
+			m.goToColumnIndex--
+		}
+		return m, nil
+	case msg.Code == tea.KeyEnter || msg.String() == "enter":
+		if len(matches) == 0 {
+			m.status = "no column matches " + strings.TrimSpace(m.goToColumnInput.Value())
+			return m, nil
+		}
+		idx := matches[m.goToColumnIndex]
+		m.mode = modeNone
+		m.goToColumnInput.Blur()
+		m.setPanelFocusIndex(idx, false)
+		m.selectedTask = 0
+		m.status = "column: " + m.columns[idx].Name
+		return m, nil
+	default:
+		var cmd tea.Cmd
+		m.goToColumnInput, cmd = m.goToColumnInput.Update(msg)
+		_ = scrubTextInputTerminalArtifacts(&m.goToColumnInput)
+		// Typing changes the ranking, so start again from the best match.
+		m.goToColumnIndex = 0
+		return m, cmd
+	}
+}
+
+// renderGoToColumnOverlay renders the go-to-column modal with its ranked matches.
+func (m Model) renderGoToColumnOverlay(accent, muted color.Color, maxWidth int) string {
+	style := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(accent).
+		Padding(0, 1)
+	inputWidth := 40
+	if maxWidth > 0 {
+		boxWidth := clamp(maxWidth, 36, 64)
+		style = style.Width(boxWidth)
+		inputWidth = max(18, boxWidth-10)
+	}
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(accent)
+	hintStyle := lipgloss.NewStyle().Foreground(muted)
+	selectedStyle := lipgloss.NewStyle().Bold(true).Foreground(accent)
+
+	in := m.goToColumnInput
+	in.SetWidth(inputWidth)
+	lines := []string{titleStyle.Render("Go To Column"), in.View(), ""}
+	matches := m.goToColumnMatches()
+	if len(matches) == 0 {
+		lines = append(lines, hintStyle.Render("no matching columns"))
+	}
+	selected := clamp(m.goToColumnIndex, 0, max(0, len(matches)-1))
+	for pos, idx := range matches {
+		name := truncate(m.columns[idx].Name, 48)
+		if pos == selected {
+			lines = append(lines, selectedStyle.Render("› "+name))
+			continue
+		}
+		lines = append(lines, "  "+name)
+	}
+	lines = append(lines, hintStyle.Render("type to filter • ↑/↓ select • enter go • esc cancel"))
+	return style.Render(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,69 @@
+package tui
+
+import (
+	"strings"
+
+	"charm.land/lipgloss/v2"
+)
+
+// HighlightStyle selects how the focused task row is emphasized on the board.
+type HighlightStyle string
+
+// HighlightStyleColor and related constants define supported focused-row treatments.
+const (
+	// HighlightStyleColor renders the row in the highlight color and bold (the default).
+	HighlightStyleColor HighlightStyle = "color"
+	// HighlightStyleBold renders the row bold in the normal foreground, for monochrome terminals.
+	HighlightStyleBold HighlightStyle = "bold"
+	// HighlightStyleUnderline renders the row underlined in the highlight color.
+	HighlightStyleUnderline HighlightStyle = "underline"
+	// HighlightStyleReverse renders the row with reversed video so the highlight color fills the background.
+	HighlightStyleReverse HighlightStyle = "reverse"
+	// HighlightStyleBar marks the row with a thick highlight-colored left bar and bold text.
+	HighlightStyleBar HighlightStyle = "bar"
+)
+
+// normalizeHighlightStyle maps raw config text to a supported style, defaulting to color.
+func normalizeHighlightStyle(raw HighlightStyle) HighlightStyle {
+	switch style := HighlightStyle(strings.ToLower(strings.TrimSpace(string(raw)))); style {
+	case HighlightStyleBold, HighlightStyleUnderline, HighlightStyleReverse, HighlightStyleBar:
+		return style
+	default:
+		return HighlightStyleColor
+	}
+}
+
+// selectedTaskRowStyle returns the lipgloss style for the focused task row.
+func (m Model) selectedTaskRowStyle(multiSelected bool) lipgloss.Style {
+	highlight := m.selectedTaskHighlightColor()
+	var style lipgloss.Style
+	switch normalizeHighlightStyle(m.highlightStyle) {
+	case HighlightStyleBold, HighlightStyleBar:
+		style = lipgloss.NewStyle().Bold(true)
+	case HighlightStyleUnderline:
+		style = lipgloss.NewStyle().Foreground(highlight).Underline(true)
+	case HighlightStyleReverse:
+		style = lipgloss.NewStyle().Foreground(highlight).Reverse(true)
+	default:
+		style = lipgloss.NewStyle().Foreground(highlight).Bold(true)
+	}
+	if multiSelected {
+		// Focused rows that are also multi-selected keep the underline cue in every style.
+		style = style.Underline(true)
+	}
+	return style
+}
+
+// renderSelectedTaskTitle styles one focused task title, swapping the cursor bar for a colored block in bar style.
+func (m Model) renderSelectedTaskTitle(title string, multiSelected bool) string {
+	style := m.selectedTaskRowStyle(multiSelected)
+	if normalizeHighlightStyle(m.highlightStyle) != HighlightStyleBar {
+		return style.Render(title)
+	}
+	rest, ok := strings.CutPrefix(title, "│")
+	if !ok {
+		return style.Render(title)
+	}
+	bar := lipgloss.NewStyle().Foreground(m.selectedTaskHighlightColor()).Render("▌")
+	return bar + style.Render(rest)
+}
@@ -0,0 +1,241 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"image/color"
+	"slices"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/hylla/tillsyn/internal/app"
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// inboxViewWindow caps how many inbox rows render at once.
+const inboxViewWindow = 12
+
+// inboxColumn returns the default intake column that inbox triage reads from.
+func (m Model) inboxColumn() (domain.Column, bool) {
+	if len(m.columns) == 0 {
+		return domain.Column{}, false
+	}
+	return m.columns[0], true
+}
+
+// inboxTasks returns unscheduled, unsorted tasks: active rows in the first column without a due date.
+func (m Model) inboxTasks() []domain.Task {
+	column, ok := m.inboxColumn()
+	if !ok {
+		return nil
+	}
+	out := make([]domain.Task, 0)
+	for _, task := range m.boardTasksForColumn(column.ID) {
+		if task.DueAt != nil || task.ArchivedAt != nil {
+			continue
+		}
+		out = append(out, task)
+	}
+	return out
+}
+
+// selectedInboxTask returns the highlighted inbox task.
+func (m Model) selectedInboxTask() (domain.Task, bool) {
+	tasks := m.inboxTasks()
+	if len(tasks) == 0 {
+		return domain.Task{}, false
+	}
+	return tasks[clamp(m.inboxIndex, 0, len(tasks)-1)], true
+}
+
+// openInbox enters inbox triage mode for the current project.
+func (m *Model) openInbox() {
+	if _, ok := m.inboxColumn(); !ok {
+		m.status = "inbox unavailable: project has no columns"
+		return
+	}
+	m.mode = modeInbox
+	m.inboxIndex = 0
+	m.help.ShowAll = false
+	m.status = fmt.Sprintf("inbox: %d to triage", len(m.inboxTasks()))
+}
+
+// handleInboxKey handles inbox triage input.
+func (m Model) handleInboxKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	tasks := m.inboxTasks()
+	m.inboxIndex = clamp(m.inboxIndex, 0, max(0, len(tasks)-1))
+	switch {
+	case msg.Code == tea.KeyEscape || msg.String() == "esc" || key.Matches(msg, m.keys.inbox):
+		m.mode = modeNone
+		m.status = "ready"
+		return m, nil
+	case key.Matches(msg, m.keys.undo):
+		return m.undoLastMutation()
+	case key.Matches(msg, m.keys.redo):
+		return m.redoLastMutation()
+	case key.Matches(msg, m.keys.moveDown):
+		if m.inboxIndex < len(tasks)-1 {
+			m.inboxIndex++
+		}
+		return m, nil
+	case key.Matches(msg, m.keys.moveUp):
+		if m.inboxIndex > 0 {
+			m.inboxIndex--
+		}
+		return m, nil
+	}
+
+	task, ok := m.selectedInboxTask()
+	if !ok {
+		m.status = "inbox empty"
+		return m, nil
+	}
+	switch {
+	case msg.Code == tea.KeyEnter || msg.String() == "enter":
+		m.openTaskInfo(task.ID, "task info")
+		return m, nil
+	case key.Matches(msg, m.keys.moveRight) || key.Matches(msg, m.keys.moveTaskRight):
+		return m.sendInboxTaskToColumn(task, 1)
+	case inboxColumnKey(msg) > 0:
+		return m.sendInboxTaskToColumn(task, inboxColumnKey(msg)-1)
+	case msg.String() == "p":
+		task.Priority = nextPriorityOption(task.Priority)
+		return m, m.inboxUpdateTaskCmd(task, "priority "+string(task.Priority))
+	case msg.String() == "t", msg.String() == "m", msg.String() == "w":
+		days := map[string]int{"t": 0, "m": 1, "w": 7}[msg.String()]
+		dueAt, err := parseDueInput(time.Now().In(time.Local).AddDate(0, 0, days).Format("2006-01-02"), nil)
+		if err != nil {
+			m.status = "due failed: " + err.Error()
+			return m, nil
+		}
+		task.DueAt = dueAt
+		return m, m.inboxUpdateTaskCmd(task, "due "+formatDueValue(dueAt))
+	default:
+		return m, nil
+	}
+}
+
+// inboxColumnKey returns the 1-based column number for digit keys, or 0 for any other key.
+func inboxColumnKey(msg tea.KeyPressMsg) int {
+	text := msg.String()
+	if len(text) != 1 || text[0] < '1' || text[0] > '9' {
+		return 0
+	}
+	return int(text[0] - '0')
+}
+
+// sendInboxTaskToColumn moves one inbox task to the column at columnIdx as a single undoable action.
+// The dispatched task leaves the inbox, so the same row index lands on the next task to triage.
+func (m Model) sendInboxTaskToColumn(task domain.Task, columnIdx int) (tea.Model, tea.Cmd) {
+	if m.readOnly {
+		return m.readOnlyBlocked("move task")
+	}
+	if columnIdx < 0 || columnIdx >= len(m.columns) {
+		m.status = fmt.Sprintf("no column %d", columnIdx+1)
+		return m, nil
+	}
+	fromIdx := slices.IndexFunc(m.columns, func(column domain.Column) bool {
+		return column.ID == task.ColumnID
+	})
+	if fromIdx < 0 {
+		m.status = "task column not found"
+		return m, nil
+	}
+	if fromIdx == columnIdx {
+		m.status = "task already in " + m.columns[columnIdx].Name
+		return m, nil
+	}
+	return m.moveTaskIDs([]string{task.ID}, columnIdx-fromIdx, "inbox dispatch", task.Title+" → "+m.columns[columnIdx].Name, false)
+}
+
+// nextPriorityOption cycles through priorityOptions, wrapping after the highest priority.
+func nextPriorityOption(current domain.Priority) domain.Priority {
+	idx := slices.Index(priorityOptions, current)
+	return priorityOptions[(idx+1)%len(priorityOptions)]
+}
+
+// inboxUpdateTaskCmd persists one triaged task's priority and due fields.
+func (m Model) inboxUpdateTaskCmd(task domain.Task, status string) tea.Cmd {
+	if m.readOnly {
+		return readOnlyBlockedCmd("edit task")
+	}
+	return func() tea.Msg {
+		updated, err := m.svc.UpdateTask(context.Background(), app.UpdateTaskInput{
+			TaskID:      task.ID,
+			Title:       task.Title,
+			Description: task.Description,
+			Priority:    task.Priority,
+			DueAt:       task.DueAt,
+			Labels:      append([]string(nil), task.Labels...),
+		})
+		if err != nil {
+			return actionMsg{err: err}
+		}
+		return actionMsg{
+			status:      status,
+			reload:      true,
+			upsertTasks: []domain.Task{updated},
+		}
+	}
+}
+
+// renderInboxOverlay renders the single-column inbox triage list.
+func (m Model) renderInboxOverlay(accent, muted color.Color, maxWidth int) string {
+	style := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(accent).
+		Padding(0, 1)
+	titleWidth := 48
+	if maxWidth > 0 {
+		boxWidth := clamp(maxWidth, 44, 96)
+		style = style.Width(boxWidth)
+		titleWidth = max(16, boxWidth-24)
+	}
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(accent)
+	hintStyle := lipgloss.NewStyle().Foreground(muted)
+	selectedStyle := lipgloss.NewStyle().Bold(true).Foreground(accent)
+
+	tasks := m.inboxTasks()
+	header := "Inbox"
+	if column, ok := m.inboxColumn(); ok {
+		header = fmt.Sprintf("Inbox • %s • %d to triage", column.Name, len(tasks))
+	}
+	lines := []string{titleStyle.Render(header)}
+	if len(tasks) == 0 {
+		lines = append(lines, hintStyle.Render("(inbox zero)"))
+	} else {
+		selected := clamp(m.inboxIndex, 0, len(tasks)-1)
+		// Keep the selected row inside the rendered window while triaging long inboxes.
+		start := clamp(selected-inboxViewWindow/2, 0, max(0, len(tasks)-inboxViewWindow))
+		end := min(len(tasks), start+inboxViewWindow)
+		for idx := start; idx < end; idx++ {
+			task := tasks[idx]
+			row := fmt.Sprintf("%s  %-8s", truncate(task.Title, titleWidth), string(task.Priority))
+			if idx == selected {
+				lines = append(lines, selectedStyle.Render("› "+row))
+				continue
+			}
+			lines = append(lines, "  "+row)
+		}
+		if end < len(tasks) {
+			lines = append(lines, hintStyle.Render(fmt.Sprintf("… %d more", len(tasks)-end)))
+		}
+	}
+	if next := m.inboxDispatchTarget(); next != "" {
+		lines = append(lines, hintStyle.Render("1-9 send to column • l/] send to "+next+" • p priority • t/m/w due • enter info • esc close"))
+	} else {
+		lines = append(lines, hintStyle.Render("p priority • t/m/w due • enter info • esc close"))
+	}
+	return style.Render(strings.Join(lines, "\n"))
+}
+
+// inboxDispatchTarget returns the column name that l/] sends inbox tasks to.
+func (m Model) inboxDispatchTarget() string {
+	if len(m.columns) < 2 {
+		return ""
+	}
+	return m.columns[1].Name
+}
@@ -0,0 +1,125 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// jumpToTaskMsg carries the task resolved for one jump-to-task request.
+type jumpToTaskMsg struct {
+	query string
+	task  domain.Task
+	found bool
+	err   error
+}
+
+// startJumpToTaskMode opens a modal that reads a task ID to jump to.
+func (m *Model) startJumpToTaskMode() tea.Cmd {
+	m.mode = modeJumpToTask
+	m.help.ShowAll = false
+	m.jumpTaskInput.SetValue("")
+	m.status = "jump to task"
+	return m.jumpTaskInput.Focus()
+}
+
+// handleJumpToTaskKey handles input while the jump-to-task modal is open.
+func (m Model) handleJumpToTaskKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	if handled, status := applyClipboardShortcutToInput(msg, &m.jumpTaskInput); handled {
+		m.status = status
+		return m, nil
+	}
+	switch {
+	case msg.Code == tea.KeyEscape || msg.String() == "esc":
+		m.mode = modeNone
+		m.jumpTaskInput.Blur()
+		m.status = "cancelled"
+		return m, nil
+	case msg.Code == tea.KeyEnter || msg.String() == "enter":
+		query := strings.TrimSpace(m.jumpTaskInput.Value())
+		if query == "" {
+			m.status = "task id required"
+			return m, nil
+		}
+		m.mode = modeNone
+		m.jumpTaskInput.Blur()
+		m.status = "looking up task..."
+		currentID, _ := m.currentProjectID()
+		return m, m.resolveJumpTask(query, currentID)
+	default:
+		var cmd tea.Cmd
+		m.jumpTaskInput, cmd = m.jumpTaskInput.Update(msg)
+		_ = scrubTextInputTerminalArtifacts(&m.jumpTaskInput)
+		return m, cmd
+	}
+}
+
+// resolveJumpTask finds one task by ID across every loaded project, preferred project first.
+// An exact ID match wins; otherwise a unique ID prefix is accepted so short pasted IDs still resolve.
+func (m Model) resolveJumpTask(query, preferredProjectID string) tea.Cmd {
+	projectIDs := make([]string, 0, len(m.projects))
+	for _, project := range m.projects {
+		if project.ID == preferredProjectID {
+			projectIDs = append([]string{project.ID}, projectIDs...)
+			continue
+		}
+		projectIDs = append(projectIDs, project.ID)
+	}
+	svc := m.svc
+	return func() tea.Msg {
+		needle := strings.ToLower(query)
+		prefixMatches := make([]domain.Task, 0)
+		for _, projectID := range projectIDs {
+			tasks, err := svc.ListTasks(context.Background(), projectID, true)
+			if err != nil {
+				return jumpToTaskMsg{query: query, err: err}
+			}
+			for _, task := range tasks {
+				id := strings.ToLower(task.ID)
+				if id == needle {
+					return jumpToTaskMsg{query: query, task: task, found: true}
+				}
+				if strings.HasPrefix(id, needle) {
+					prefixMatches = append(prefixMatches, task)
+				}
+			}
+		}
+		if len(prefixMatches) == 1 {
+			return jumpToTaskMsg{query: query, task: prefixMatches[0], found: true}
+		}
+		return jumpToTaskMsg{query: query}
+	}
+}
+
+// applyJumpToTaskResult focuses the resolved task, switching projects or widening filters when needed.
+func (m Model) applyJumpToTaskResult(msg jumpToTaskMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		m.status = "jump failed: " + msg.err.Error()
+		return m, nil
+	}
+	if !msg.found {
+		m.forgetRecentTask(msg.query)
+		m.startWarningModal("Task Not Found", fmt.Sprintf("no task matches id %q", msg.query))
+		m.status = "task not found"
+		return m, nil
+	}
+	task := msg.task
+	if currentID, ok := m.currentProjectID(); ok && currentID == task.ProjectID && m.focusTaskByID(task.ID) {
+		m.status = "jumped to " + task.Title
+		return m, nil
+	}
+	// The task is in another project or hidden by the current view, so reload with it focused.
+	m.pendingProjectID = task.ProjectID
+	m.pendingFocusTaskID = task.ID
+	m.projectionRootTaskID = ""
+	m.searchApplied = false
+	m.searchQuery = ""
+	if task.ArchivedAt != nil {
+		m.showArchived = true
+	}
+	m.status = "jumped to " + task.Title
+	return m, m.requestReload()
+}
@@ -1,11 +1,13 @@
 package tui
 
 import (
+	"slices"
 	"strings"
 	"unicode"
 	"unicode/utf8"
 
 	"charm.land/bubbles/v2/key"
+	"github.com/hylla/tillsyn/internal/config"
 )
 
 // keyMap represents key map data used by this package.
@@ -13,6 +15,7 @@ type keyMap struct {
 	quit             key.Binding
 	reload           key.Binding
 	toggleHelp       key.Binding
+	footerHelp       key.Binding
 	moveLeft         key.Binding
 	moveRight        key.Binding
 	moveUp           key.Binding
@@ -28,6 +31,8 @@ type keyMap struct {
 	archiveTask      key.Binding
 	moveTaskLeft     key.Binding
 	moveTaskRight    key.Binding
+	moveColumnLeft   key.Binding
+	moveColumnRight  key.Binding
 	hardDeleteTask   key.Binding
 	restoreTask      key.Binding
 	search           key.Binding
@@ -38,54 +43,93 @@ type keyMap struct {
 	clearFocus       key.Binding
 	multiSelect      key.Binding
 	activityLog      key.Binding
+	inbox            key.Binding
+	previousProject  key.Binding
+	jumpToTask       key.Binding
+	quickAssign      key.Binding
+	toggleWatch      key.Binding
 	undo             key.Binding
 	redo             key.Binding
 }
 
-// newKeyMap constructs key map.
-func newKeyMap() keyMap {
-	return keyMap{
-		quit:             key.NewBinding(key.WithKeys("q", "ctrl+c"), key.WithHelp("q", "quit")),
-		reload:           key.NewBinding(key.WithKeys("r"), key.WithHelp("r", "reload")),
-		toggleHelp:       key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "toggle help")),
-		moveLeft:         key.NewBinding(key.WithKeys("h", "left"), key.WithHelp("h/←", "column left")),
-		moveRight:        key.NewBinding(key.WithKeys("l", "right"), key.WithHelp("l/→", "column right")),
-		moveUp:           key.NewBinding(key.WithKeys("k", "up"), key.WithHelp("k/↑", "move up")),
-		moveDown:         key.NewBinding(key.WithKeys("j", "down"), key.WithHelp("j/↓", "move down")),
-		addTask:          key.NewBinding(key.WithKeys("n"), key.WithHelp("n", "new task")),
-		taskInfo:         key.NewBinding(key.WithKeys("i", "enter"), key.WithHelp("i/enter", "task info")),
-		editTask:         key.NewBinding(key.WithKeys("e"), key.WithHelp("e", "edit task")),
-		newProject:       key.NewBinding(key.WithKeys("N"), key.WithHelp("N", "new project")),
-		editProject:      key.NewBinding(key.WithKeys("M"), key.WithHelp("M", "edit project")),
-		commandPalette:   key.NewBinding(key.WithKeys(":"), key.WithHelp(":", "command palette")),
-		quickActions:     key.NewBinding(key.WithKeys("."), key.WithHelp(".", "quick actions")),
-		deleteTask:       key.NewBinding(key.WithKeys("d"), key.WithHelp("d", "delete (default)")),
-		archiveTask:      key.NewBinding(key.WithKeys("a"), key.WithHelp("a", "archive task")),
-		moveTaskLeft:     key.NewBinding(key.WithKeys("["), key.WithHelp("[", "move task left")),
-		moveTaskRight:    key.NewBinding(key.WithKeys("]"), key.WithHelp("]", "move task right")),
-		hardDeleteTask:   key.NewBinding(key.WithKeys("D", "shift+d"), key.WithHelp("D", "hard delete")),
-		restoreTask:      key.NewBinding(key.WithKeys("u"), key.WithHelp("u", "restore task")),
-		search:           key.NewBinding(key.WithKeys("/"), key.WithHelp("/", "search")),
-		projects:         key.NewBinding(key.WithKeys("p", "P"), key.WithHelp("p/P", "project picker")),
-		toggleArchived:   key.NewBinding(key.WithKeys("t"), key.WithHelp("t", "toggle archived")),
-		toggleSelectMode: key.NewBinding(key.WithKeys("ctrl+y"), key.WithHelp("ctrl+y", "text select mode")),
-		focusSubtree:     key.NewBinding(key.WithKeys("f"), key.WithHelp("f", "focus subtree")),
-		clearFocus:       key.NewBinding(key.WithKeys("F", "shift+f"), key.WithHelp("F", "full board")),
-		multiSelect:      key.NewBinding(key.WithKeys(" ", "space"), key.WithHelp("space", "toggle select")),
-		activityLog:      key.NewBinding(key.WithKeys("g"), key.WithHelp("g", "activity log")),
-		undo:             key.NewBinding(key.WithKeys("ctrl+z"), key.WithHelp("ctrl+z", "undo")),
-		redo:             key.NewBinding(key.WithKeys("ctrl+shift+z"), key.WithHelp("ctrl+shift+z", "redo")),
+// newKeyMap constructs the built-in key map from config.KeyActions and applies cfg overrides on top.
+func newKeyMap(cfg KeyConfig) keyMap {
+	var k keyMap
+	bindings := k.actionBindings()
+	for _, action := range config.KeyActions {
+		binding, ok := bindings[action.Name]
+		if !ok {
+			continue
+		}
+		keys, helpKeys := parseBindingKeys(action.Defaults, "")
+		if action.HelpKeys != "" {
+			helpKeys = action.HelpKeys
+		}
+		*binding = key.NewBinding(key.WithKeys(keys...), key.WithHelp(helpKeys, action.Help))
 	}
+	k.applyConfig(cfg)
+	return k
 }
 
-// applyConfig applies user keybinding overrides.
+// actionBindings maps each config.KeyActions name to the binding it drives.
+func (k *keyMap) actionBindings() map[string]*key.Binding {
+	return map[string]*key.Binding{
+		"quit":               &k.quit,
+		"reload":             &k.reload,
+		"toggle_help":        &k.toggleHelp,
+		"footer_help":        &k.footerHelp,
+		"move_left":          &k.moveLeft,
+		"move_right":         &k.moveRight,
+		"move_up":            &k.moveUp,
+		"move_down":          &k.moveDown,
+		"add_task":           &k.addTask,
+		"task_info":          &k.taskInfo,
+		"edit_task":          &k.editTask,
+		"new_project":        &k.newProject,
+		"edit_project":       &k.editProject,
+		"command_palette":    &k.commandPalette,
+		"quick_actions":      &k.quickActions,
+		"delete_task":        &k.deleteTask,
+		"archive_task":       &k.archiveTask,
+		"move_task_left":     &k.moveTaskLeft,
+		"move_task_right":    &k.moveTaskRight,
+		"move_column_left":   &k.moveColumnLeft,
+		"move_column_right":  &k.moveColumnRight,
+		"hard_delete_task":   &k.hardDeleteTask,
+		"restore_task":       &k.restoreTask,
+		"search":             &k.search,
+		"projects":           &k.projects,
+		"toggle_archived":    &k.toggleArchived,
+		"toggle_select_mode": &k.toggleSelectMode,
+		"focus_subtree":      &k.focusSubtree,
+		"clear_focus":        &k.clearFocus,
+		"multi_select":       &k.multiSelect,
+		"activity_log":       &k.activityLog,
+		"inbox":              &k.inbox,
+		"previous_project":   &k.previousProject,
+		"jump_to_task":       &k.jumpToTask,
+		"quick_assign":       &k.quickAssign,
+		"toggle_watch":       &k.toggleWatch,
+		"undo":               &k.undo,
+		"redo":               &k.redo,
+	}
+}
+
+// applyConfig applies user keybinding overrides; blank values keep the built-in keys.
 func (k *keyMap) applyConfig(cfg KeyConfig) {
-	configureBinding(&k.commandPalette, cfg.CommandPalette, ":", "command palette")
-	configureBinding(&k.quickActions, cfg.QuickActions, ".", "quick actions")
-	configureBinding(&k.multiSelect, cfg.MultiSelect, " ", "toggle select")
-	configureBinding(&k.activityLog, cfg.ActivityLog, "g", "activity log")
-	configureBinding(&k.undo, cfg.Undo, "ctrl+z", "undo")
-	configureBinding(&k.redo, cfg.Redo, "ctrl+shift+z", "redo")
+	bindings := k.actionBindings()
+	for _, action := range config.KeyActions {
+		binding, ok := bindings[action.Name]
+		raw := action.Value(config.KeyConfig(cfg))
+		if !ok || strings.TrimSpace(raw) == "" {
+			continue
+		}
+		// Restating the built-in keys keeps the built-in help glyphs, such as "h/←".
+		if keys, _ := parseBindingKeys(raw, ""); slices.Equal(keys, binding.Keys()) {
+			continue
+		}
+		configureBinding(binding, raw, "", binding.Help().Desc)
+	}
 }
 
 // ShortHelp handles short help.
@@ -98,9 +142,9 @@ func (k keyMap) ShortHelp() []key.Binding {
 // FullHelp handles full help.
 func (k keyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
-		{k.addTask, k.taskInfo, k.editTask, k.newProject, k.editProject, k.commandPalette, k.quickActions, k.search, k.projects, k.toggleArchived, k.toggleSelectMode, k.focusSubtree, k.clearFocus, k.toggleHelp, k.reload, k.quit},
-		{k.moveLeft, k.moveRight, k.moveUp, k.moveDown, k.moveTaskLeft, k.moveTaskRight},
-		{k.deleteTask, k.hardDeleteTask, k.restoreTask, k.multiSelect, k.undo, k.redo, k.activityLog},
+		{k.addTask, k.taskInfo, k.editTask, k.newProject, k.editProject, k.commandPalette, k.quickActions, k.search, k.projects, k.previousProject, k.jumpToTask, k.toggleArchived, k.toggleSelectMode, k.focusSubtree, k.clearFocus, k.toggleHelp, k.footerHelp, k.reload, k.quit},
+		{k.moveLeft, k.moveRight, k.moveUp, k.moveDown, k.moveTaskLeft, k.moveTaskRight, k.moveColumnLeft, k.moveColumnRight},
+		{k.deleteTask, k.hardDeleteTask, k.restoreTask, k.multiSelect, k.quickAssign, k.toggleWatch, k.undo, k.redo, k.activityLog, k.inbox},
 	}
 }
 
@@ -112,11 +156,31 @@ func configureBinding(binding *key.Binding, raw, fallback, desc string) {
 }
 
 // parseBindingKeys normalizes configured key text into key-matcher inputs and help text.
+// A comma-separated value such as "h,left" binds every listed key; a lone "," binds the comma key.
 func parseBindingKeys(raw, fallback string) ([]string, string) {
 	value := strings.TrimSpace(raw)
 	if value == "" {
 		value = fallback
 	}
+	if value == "," || !strings.Contains(value, ",") {
+		return parseBindingKey(value)
+	}
+	keys := make([]string, 0, 2)
+	helps := make([]string, 0, 2)
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		partKeys, partHelp := parseBindingKey(part)
+		keys = append(keys, partKeys...)
+		helps = append(helps, partHelp)
+	}
+	return keys, strings.Join(helps, "/")
+}
+
+// parseBindingKey normalizes one configured key into key-matcher inputs and help text.
+func parseBindingKey(value string) ([]string, string) {
 	if strings.EqualFold(value, "space") || value == " " {
 		return []string{" ", "space"}, "space"
 	}
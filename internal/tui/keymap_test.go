@@ -1,9 +1,11 @@
 package tui
 
 import (
+	"reflect"
 	"testing"
 
 	"charm.land/bubbles/v2/key"
+	"github.com/hylla/tillsyn/internal/config"
 )
 
 // TestParseBindingKeys verifies key parsing behavior for configured overrides.
@@ -64,7 +66,7 @@ func TestConfigureBinding(t *testing.T) {
 
 // TestKeyMapApplyConfig verifies dynamic key map override behavior.
 func TestKeyMapApplyConfig(t *testing.T) {
-	k := newKeyMap()
+	k := newKeyMap(KeyConfig{})
 	k.applyConfig(KeyConfig{
 		CommandPalette: ";",
 		QuickActions:   ",",
@@ -95,9 +97,43 @@ func TestKeyMapApplyConfig(t *testing.T) {
 	assertKeys("redo", k.redo, "R", "shift+r")
 }
 
+// TestKeyMapCoversConfigKeyActions verifies every config action drives exactly one TUI binding and every binding has an action.
+func TestKeyMapCoversConfigKeyActions(t *testing.T) {
+	var k keyMap
+	bindings := k.actionBindings()
+	seen := map[*key.Binding]string{}
+	for _, action := range config.KeyActions {
+		binding, ok := bindings[action.Name]
+		if !ok {
+			t.Fatalf("config action %q has no TUI binding", action.Name)
+		}
+		if other, dup := seen[binding]; dup {
+			t.Fatalf("config actions %q and %q drive the same binding", other, action.Name)
+		}
+		seen[binding] = action.Name
+	}
+	if got, want := len(bindings), len(config.KeyActions); got != want {
+		t.Fatalf("expected %d TUI bindings for %d config actions, got %d", want, want, got)
+	}
+	if got := reflect.TypeFor[keyMap]().NumField(); got != len(config.KeyActions) {
+		t.Fatalf("expected every keyMap binding to come from config.KeyActions, got %d fields for %d actions", got, len(config.KeyActions))
+	}
+
+	built := newKeyMap(KeyConfig{})
+	if got := built.undo.Keys(); len(got) != 1 || got[0] != "ctrl+z" {
+		t.Fatalf("unexpected built-in undo keys %#v", got)
+	}
+	if help := built.moveLeft.Help(); help.Key != "h/←" || help.Desc != "column left" {
+		t.Fatalf("expected table help glyphs, got %#v", help)
+	}
+	if help := built.quit.Help(); help.Key != "q" || len(built.quit.Keys()) != 2 {
+		t.Fatalf("unexpected quit binding keys=%#v help=%#v", built.quit.Keys(), help)
+	}
+}
+
 // TestKeyMapDefaultsIncludeProjectionKeys verifies subtree projection key defaults.
 func TestKeyMapDefaultsIncludeProjectionKeys(t *testing.T) {
-	k := newKeyMap()
+	k := newKeyMap(KeyConfig{})
 	if got := k.focusSubtree.Keys(); len(got) != 1 || got[0] != "f" {
 		t.Fatalf("unexpected focus subtree keys %#v", got)
 	}
@@ -106,3 +142,45 @@ func TestKeyMapDefaultsIncludeProjectionKeys(t *testing.T) {
 		t.Fatalf("unexpected clear focus keys %#v", gotClear)
 	}
 }
+
+// TestNewKeyMapRemapsNavigation verifies list overrides replace built-in keys while restated defaults keep their help glyphs.
+func TestNewKeyMapRemapsNavigation(t *testing.T) {
+	keys, help := parseBindingKeys(" a , Left ,", "h")
+	if len(keys) != 2 || keys[0] != "a" || keys[1] != "left" || help != "a/Left" {
+		t.Fatalf("unexpected list parse keys=%#v help=%q", keys, help)
+	}
+	if keys, help := parseBindingKeys(",", "."); len(keys) != 1 || keys[0] != "," || help != "," {
+		t.Fatalf("expected a lone comma to bind the comma key, got keys=%#v help=%q", keys, help)
+	}
+
+	k := newKeyMap(KeyConfig{
+		MoveLeft:  "left",
+		MoveDown:  "down,ctrl+n",
+		MoveRight: "l,right",
+	})
+	if got := k.moveLeft.Keys(); len(got) != 1 || got[0] != "left" {
+		t.Fatalf("unexpected remapped move left keys %#v", got)
+	}
+	if k.moveLeft.Help().Key != "left" || k.moveLeft.Help().Desc != "column left" {
+		t.Fatalf("expected remapped help to keep the action description, got %#v", k.moveLeft.Help())
+	}
+	if got := k.moveDown.Keys(); len(got) != 2 || got[0] != "down" || got[1] != "ctrl+n" {
+		t.Fatalf("unexpected remapped move down keys %#v", got)
+	}
+	// Restating the defaults keeps the built-in arrow glyph in help.
+	if k.moveRight.Help().Key != "l/→" {
+		t.Fatalf("expected built-in move right help, got %#v", k.moveRight.Help())
+	}
+	// Unset actions keep their built-in keys.
+	if got := k.moveUp.Keys(); len(got) != 2 || got[0] != "k" || got[1] != "up" {
+		t.Fatalf("unexpected move up keys %#v", got)
+	}
+
+	// Reloading config without the override restores the built-in binding.
+	m := Model{}
+	WithKeyConfig(KeyConfig{MoveLeft: "left"})(&m)
+	WithKeyConfig(KeyConfig{})(&m)
+	if got := m.keys.moveLeft.Keys(); len(got) != 2 || got[0] != "h" || got[1] != "left" {
+		t.Fatalf("expected reload to restore built-in move left keys, got %#v", got)
+	}
+}
@@ -0,0 +1,47 @@
+package tui
+
+import (
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// labelColorGlyph is the board marker drawn before titles whose first label has a configured color.
+const labelColorGlyph = "● "
+
+// taskLabelGlyph returns the plain and colored label glyph for a task, or empty strings when its first
+// label has no configured color, so boards without [labels.colors] render exactly as before.
+func (m Model) taskLabelGlyph(task domain.Task) (string, string) {
+	if len(task.Labels) == 0 {
+		return "", ""
+	}
+	value, ok := m.labelColors[strings.TrimSpace(strings.ToLower(task.Labels[0]))]
+	if !ok {
+		return "", ""
+	}
+	return labelColorGlyph, lipgloss.NewStyle().Foreground(lipgloss.Color(value)).Render(labelColorGlyph)
+}
+
+// normalizeLabelColors lowercases label keys and drops empty labels and invalid colors.
+func normalizeLabelColors(colors map[string]string) map[string]string {
+	out := make(map[string]string, len(colors))
+	for rawLabel, rawColor := range colors {
+		label := strings.TrimSpace(strings.ToLower(rawLabel))
+		value := strings.TrimSpace(rawColor)
+		if label == "" || !domain.ValidColor(value) {
+			continue
+		}
+		out[label] = value
+	}
+	return out
+}
+
+// renderTaskTitleRow styles a board title row around the label glyph.
+// With a glyph the head and title are styled separately so the glyph keeps its own color.
+func renderTaskTitleRow(head, glyph, title string, render func(string) string) string {
+	if glyph == "" {
+		return render(head + title)
+	}
+	return render(head) + glyph + render(title)
+}
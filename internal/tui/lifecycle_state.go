@@ -0,0 +1,66 @@
+package tui
+
+import (
+	"context"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// taskLifecycleSetter is the optional service extension used to set a task lifecycle state independent of its column.
+type taskLifecycleSetter interface {
+	SetTaskLifecycleState(context.Context, string, domain.LifecycleState) (domain.Task, error)
+}
+
+// nextExplicitLifecycleState returns the next state in the task-info cycle: column mapping, todo, progress, done.
+// An empty result clears the explicit state.
+func nextExplicitLifecycleState(task domain.Task) domain.LifecycleState {
+	if !task.Metadata.LifecycleStateExplicit {
+		return domain.StateTodo
+	}
+	switch task.LifecycleState {
+	case domain.StateTodo:
+		return domain.StateProgress
+	case domain.StateProgress:
+		return domain.StateDone
+	default:
+		return ""
+	}
+}
+
+// taskStateLabel renders one task state and marks explicitly set states.
+func taskStateLabel(task domain.Task) string {
+	label := fallbackText(string(task.LifecycleState), "-")
+	if task.Metadata.LifecycleStateExplicit {
+		return label + " (explicit)"
+	}
+	return label
+}
+
+// cycleTaskLifecycleState advances one task to the next explicit lifecycle state.
+func (m Model) cycleTaskLifecycleState(task domain.Task) (tea.Model, tea.Cmd) {
+	if m.readOnly {
+		return m.readOnlyBlocked("lifecycle state")
+	}
+	setter, ok := m.svc.(taskLifecycleSetter)
+	if !ok {
+		m.status = "explicit state unavailable"
+		return m, nil
+	}
+	next := nextExplicitLifecycleState(task)
+	return m, func() tea.Msg {
+		updated, err := setter.SetTaskLifecycleState(context.Background(), task.ID, next)
+		if err != nil {
+			return actionMsg{err: err}
+		}
+		status := "state: " + taskStateLabel(updated)
+		if next == "" {
+			status = "state follows column: " + string(updated.LifecycleState)
+		}
+		return actionMsg{
+			status:      status,
+			reload:      true,
+			upsertTasks: []domain.Task{updated},
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"sync"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// loadController cancels superseded board loads so only the latest request keeps running.
+type loadController struct {
+	mu     sync.Mutex
+	cancel context.CancelFunc
+}
+
+// newLoadController constructs one shared load controller for a model and its copies.
+func newLoadController() *loadController {
+	return &loadController{}
+}
+
+// begin cancels any in-flight load and returns a fresh cancelable context for the next one.
+func (c *loadController) begin() (context.Context, context.CancelFunc) {
+	if c == nil {
+		return context.WithCancel(context.Background())
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cancel != nil {
+		c.cancel()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
+	return ctx, cancel
+}
+
+// reloadFlushMsg fires when a debounced reload request window closes.
+type reloadFlushMsg struct {
+	seq uint64
+}
+
+// requestReload coalesces reload requests so a burst of actions triggers one board load.
+func (m *Model) requestReload() tea.Cmd {
+	m.reloadSeq++
+	if m.reloadDebounce <= 0 {
+		return m.loadData
+	}
+	seq := m.reloadSeq
+	return tea.Tick(m.reloadDebounce, func(time.Time) tea.Msg {
+		return reloadFlushMsg{seq: seq}
+	})
+}
+
+// handleReloadFlush starts a load only for the newest request in a debounced burst.
+func (m Model) handleReloadFlush(msg reloadFlushMsg) (tea.Model, tea.Cmd) {
+	if msg.seq != m.reloadSeq {
+		// A newer request is still waiting out its window; let it perform the load.
+		return m, nil
+	}
+	return m, m.loadData
+}
+
+// loadedMsgFingerprint hashes the board-visible fields of one load result for change detection.
+func loadedMsgFingerprint(msg loadedMsg) uint64 {
+	h := fnv.New64a()
+	write := func(parts ...any) {
+		for _, part := range parts {
+			fmt.Fprint(h, part, "\x1f")
+		}
+		_, _ = h.Write([]byte{'\x1e'})
+	}
+	write("projects", len(msg.projects), msg.selectedProject)
+	for _, project := range msg.projects {
+		write(project.ID, project.Name, project.UpdatedAt.UnixNano(), project.ArchivedAt != nil)
+	}
+	write("columns", len(msg.columns))
+	for _, column := range msg.columns {
+		write(column.ID, column.Name, column.Position, column.WIPLimit, column.UpdatedAt.UnixNano(), column.ArchivedAt != nil, msg.columnHasMore[column.ID], msg.columnCounts[column.ID].Total, msg.columnCounts[column.ID].Active)
+	}
+	write("tasks", len(msg.tasks))
+	for _, task := range msg.tasks {
+		dueAt := int64(0)
+		if task.DueAt != nil {
+			dueAt = task.DueAt.UnixNano()
+		}
+		write(
+			task.ID,
+			task.ParentID,
+			task.ColumnID,
+			task.Position,
+			task.Title,
+			task.Priority,
+			task.LifecycleState,
+			strings.Join(task.Labels, ","),
+			dueAt,
+			task.UpdatedAt.UnixNano(),
+			task.ArchivedAt != nil,
+		)
+	}
+	write("activity", len(msg.activityEntries))
+	for _, entry := range msg.activityEntries {
+		write(entry.EventID, entry.At.UnixNano())
+	}
+	write("attention", len(msg.attentionItems))
+	for _, item := range msg.attentionItems {
+		write(item.ID, item.State, item.RequiresUserAction)
+	}
+	write("notices", len(msg.globalNotices), msg.globalNoticesPartialCount)
+	for _, notice := range msg.globalNotices {
+		write(notice.StableKey)
+	}
+	write("orphans", len(msg.orphanedTasks))
+	for _, task := range msg.orphanedTasks {
+		write(task.ID)
+	}
+	write("rollup", msg.rollup)
+	return h.Sum64()
+}
+
+// autoRefreshUnchanged reports whether one auto-refresh result matches the board already on screen.
+func (m Model) autoRefreshUnchanged(msg loadedMsg) bool {
+	if msg.err != nil || m.lastLoadedFingerprint == 0 {
+		return false
+	}
+	if m.pendingProjectID != "" || m.pendingFocusTaskID != "" || strings.TrimSpace(m.pendingOpenTaskInfoID) != "" {
+		return false
+	}
+	return loadedMsgFingerprint(msg) == m.lastLoadedFingerprint
+}
+
+// applyOptimisticTaskChanges patches the in-memory board with mutation results ahead of the reconcile reload.
+func (m *Model) applyOptimisticTaskChanges(msg actionMsg) {
+	if len(msg.upsertTasks) == 0 && len(msg.removeTaskIDs) == 0 && len(msg.archivedTaskIDs) == 0 {
+		return
+	}
+	project, ok := m.currentProject()
+	if !ok {
+		return
+	}
+	drop := map[string]struct{}{}
+	for _, taskID := range msg.removeTaskIDs {
+		drop[strings.TrimSpace(taskID)] = struct{}{}
+	}
+	archivedAt := time.Now().UTC()
+	archived := map[string]struct{}{}
+	for _, taskID := range msg.archivedTaskIDs {
+		taskID = strings.TrimSpace(taskID)
+		if m.showArchived {
+			archived[taskID] = struct{}{}
+			continue
+		}
+		drop[taskID] = struct{}{}
+	}
+	upserts := map[string]domain.Task{}
+	order := make([]string, 0, len(msg.upsertTasks))
+	for _, task := range msg.upsertTasks {
+		// Results for another project (or hidden archived rows) are left to the reconcile load.
+		if task.ProjectID != project.ID || (task.ArchivedAt != nil && !m.showArchived) {
+			continue
+		}
+		if _, seen := upserts[task.ID]; !seen {
+			order = append(order, task.ID)
+		}
+		upserts[task.ID] = task
+	}
+
+	tasks := make([]domain.Task, 0, len(m.tasks)+len(upserts))
+	for _, task := range m.tasks {
+		if _, ok := drop[task.ID]; ok {
+			continue
+		}
+		if updated, ok := upserts[task.ID]; ok {
+			task = updated
+			delete(upserts, task.ID)
+		}
+		if _, ok := archived[task.ID]; ok && task.ArchivedAt == nil {
+			task.ArchivedAt = &archivedAt
+		}
+		tasks = append(tasks, task)
+	}
+	for _, taskID := range order {
+		if task, ok := upserts[taskID]; ok {
+			tasks = append(tasks, task)
+		}
+	}
+	m.tasks = tasks
+	if msg.focusTaskID == "" || !m.focusTaskByID(msg.focusTaskID) {
+		m.clampSelections()
+	}
+}
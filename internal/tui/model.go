@@ -2,6 +2,7 @@ package tui
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"image/color"
 	"os"
@@ -32,9 +33,11 @@ type Service interface {
 	ListProjects(context.Context, bool) ([]domain.Project, error)
 	ListColumns(context.Context, string, bool) ([]domain.Column, error)
 	ListTasks(context.Context, string, bool) ([]domain.Task, error)
+	ListDoneTasks(context.Context, string) ([]domain.Task, error)
 	CreateComment(context.Context, app.CreateCommentInput) (domain.Comment, error)
 	ListCommentsByTarget(context.Context, app.ListCommentsByTargetInput) ([]domain.Comment, error)
 	ListProjectChangeEvents(context.Context, string, int) ([]domain.ChangeEvent, error)
+	ListProjectActivity(context.Context) ([]app.ProjectActivity, error)
 	ListAttentionItems(context.Context, app.ListAttentionItemsInput) ([]domain.AttentionItem, error)
 	GetProjectDependencyRollup(context.Context, string) (domain.DependencyRollup, error)
 	SearchTaskMatches(context.Context, app.SearchTasksFilter) ([]app.TaskMatch, error)
@@ -102,6 +105,21 @@ const (
 	modeDependencyInspector
 	modeDescriptionEditor
 	modeThread
+	modeInbox
+	modeJumpToTask
+	modeRecentTasks
+	modeExportTask
+	modeGoToColumn
+	modeRecoverDraft
+	modeDuplicateTitle
+	modeCatchUp
+	modeEditColumn
+	modeTemplatePicker
+	modeDueDigest
+	modeAssignPicker
+	modeAttachURL
+	modeSavedSearch
+	modeWatchedTasks
 )
 
 // descriptionEditorTarget identifies which form field receives markdown-description editor output.
@@ -136,6 +154,8 @@ var taskFormFields = []string{
 	"acceptance_criteria",
 	"validation_plan",
 	"risk_notes",
+	"reminders",
+	"assignee",
 }
 
 // terminalProbeArtifactWithPrefixPattern matches leaked OSC 10/11 rgb probe artifacts with dangling rgb-triplet prefixes.
@@ -161,6 +181,8 @@ const (
 	taskFieldAcceptanceCriteria
 	taskFieldValidationPlan
 	taskFieldRiskNotes
+	taskFieldReminders
+	taskFieldAssignee
 	taskFieldComments
 	taskFieldSubtasks
 	taskFieldResources
@@ -184,6 +206,8 @@ const (
 	projectFieldHomepage
 	projectFieldTags
 	projectFieldRootPath
+	// projectFieldColumns is only present while creating a project; columns are edited on the board afterward.
+	projectFieldColumns
 )
 
 // activity log limits used by modal rendering and retention.
@@ -224,12 +248,11 @@ const (
 // defaultLabelSuggestionsSeed provides baseline label suggestions before user/project customization exists.
 var defaultLabelSuggestionsSeed = []string{"todo", "blocked", "urgent", "bug", "feature", "docs"}
 
-// priorityOptions stores a package-level helper value.
-var priorityOptions = []domain.Priority{
-	domain.PriorityLow,
-	domain.PriorityMedium,
-	domain.PriorityHigh,
-}
+// priorityOptions lists the form and triage priority cycle from lowest to highest.
+var priorityOptions = domain.Priorities()
+
+// priorityValidationStatus explains which priority values the task forms accept.
+const priorityValidationStatus = "priority must be none|low|medium|high|critical"
 
 // duePickerOption defines a functional option for model configuration.
 type duePickerOption struct {
@@ -257,8 +280,11 @@ var quickActionSpecs = []quickActionSpec{
 	{ID: "edit-task", Label: "Edit Task"},
 	{ID: "move-left", Label: "Move Left"},
 	{ID: "move-right", Label: "Move Right"},
+	{ID: "edit-column", Label: "Edit Column"},
 	{ID: "archive-task", Label: "Archive Task"},
+	{ID: "archive-subtree", Label: "Archive Subtree"},
 	{ID: "restore-task", Label: "Restore Task"},
+	{ID: "restore-subtree", Label: "Restore Subtree"},
 	{ID: "hard-delete", Label: "Hard Delete"},
 	{ID: "toggle-selection", Label: "Toggle Selection"},
 	{ID: "clear-selection", Label: "Clear Selection"},
@@ -269,6 +295,7 @@ var quickActionSpecs = []quickActionSpec{
 	{ID: "undo", Label: "Undo"},
 	{ID: "redo", Label: "Redo"},
 	{ID: "activity-log", Label: "Activity Log"},
+	{ID: "verify-resources", Label: "Verify Resources"},
 }
 
 // canonicalSearchStates stores canonical searchable lifecycle states.
@@ -343,6 +370,8 @@ type confirmAction struct {
 	TaskIDs []string
 	Mode    app.DeleteMode
 	Label   string
+	// Detail names the target of confirmations that act on neither a task nor a project.
+	Detail string
 }
 
 // activityEntry describes one recorded user action for the in-app activity log.
@@ -426,20 +455,33 @@ type historyStepKind string
 
 // history step kinds used for undo/redo.
 const (
-	historyStepMove       historyStepKind = "move"
-	historyStepArchive    historyStepKind = "archive"
-	historyStepRestore    historyStepKind = "restore"
-	historyStepHardDelete historyStepKind = "hard-delete"
+	historyStepMove        historyStepKind = "move"
+	historyStepArchive     historyStepKind = "archive"
+	historyStepRestore     historyStepKind = "restore"
+	historyStepHardDelete  historyStepKind = "hard-delete"
+	historyStepColumnMove  historyStepKind = "column-move"
+	historyStepAddLabel    historyStepKind = "add-label"
+	historyStepRemoveLabel historyStepKind = "remove-label"
+	historyStepEdit        historyStepKind = "edit"
+	historyStepAssign      historyStepKind = "assign"
 )
 
 // historyStep describes one mutation required to replay or reverse a change.
+// Column moves set ColumnID and use the positions as board column indexes; label steps set Label;
+// assign steps set FromAssignee and ToAssignee; edit steps carry the task's editable fields from before and after the edit.
 type historyStep struct {
 	Kind         historyStepKind
 	TaskID       string
+	ColumnID     string
 	FromColumnID string
 	FromPosition int
 	ToColumnID   string
 	ToPosition   int
+	Label        string
+	FromAssignee string
+	ToAssignee   string
+	EditBefore   *taskEditSnapshot
+	EditAfter    *taskEditSnapshot
 }
 
 // historyActionSet describes one logical user mutation for undo/redo.
@@ -468,8 +510,9 @@ type Model struct {
 	help help.Model
 	keys keyMap
 
-	taskFields        TaskFieldConfig
-	defaultDeleteMode app.DeleteMode
+	taskFields         TaskFieldConfig
+	defaultDeleteMode  app.DeleteMode
+	parentDeletePolicy app.ParentDeletePolicy
 
 	projects                 []domain.Project
 	selectedProject          int
@@ -493,14 +536,31 @@ type Model struct {
 	bootstrapDisplayInput       textinput.Model
 	pathsRootInput              textinput.Model
 	highlightColorInput         textinput.Model
+	jumpTaskInput               textinput.Model
+	goToColumnInput             textinput.Model
+	goToColumnIndex             int
+	templatePicker              picker[namedTaskTemplate]
+	savedSearchPicker           picker[namedSavedSearch]
+	savedSearchSaving           bool
+	assignPicker                picker[assignPickerItem]
+	assignPickerTaskIDs         []string
 	dependencyInput             textinput.Model
 	threadInput                 textarea.Model
 	threadDetailsInput          textarea.Model
 	descriptionEditorInput      textarea.Model
 	searchFocus                 int
+	searchDueFromInput          textinput.Model
+	searchDueToInput            textinput.Model
+	searchDueAfter              time.Time
+	searchDueBefore             time.Time
+	searchCreatedFromInput      textinput.Model
+	searchCreatedToInput        textinput.Model
+	searchCreatedAfter          time.Time
+	searchCreatedBefore         time.Time
 	searchStateCursor           int
 	searchLevelCursor           int
 	searchCrossProject          bool
+	searchIncludeComments       bool
 	searchDefaultCrossProject   bool
 	searchDefaultIncludeArchive bool
 	searchStates                []string
@@ -538,16 +598,24 @@ type Model struct {
 	pickerBack           inputMode
 	duePickerDateInput   textinput.Model
 	duePickerTimeInput   textinput.Model
+	duePickerRepeatInput textinput.Model
 	// taskFormResourceRefs stages resource refs while creating or editing a task.
 	taskFormResourceRefs []domain.ResourceRef
+	// taskFormRecurEvery stages the normalized repeat interval chosen in the due picker.
+	taskFormRecurEvery string
 	// taskFormSubtaskCursor tracks the focused subtask row in edit mode (0 = create new).
 	taskFormSubtaskCursor int
 	// taskFormResourceCursor tracks the focused resource row in edit mode (0 = attach new).
 	taskFormResourceCursor int
 	// taskFormResourceEditIndex tracks which staged resource row is being replaced from picker flow (-1 = append).
 	taskFormResourceEditIndex int
+	// taskFormBaseline and projectFormBaseline hold the form values as opened, to tell typed changes apart.
+	taskFormBaseline    map[string]string
+	projectFormBaseline map[string]string
 
-	projectPickerIndex             int
+	projectPickerIndex int
+	// projectPickerMarked holds the picker projects marked for a multi-project export.
+	projectPickerMarked            map[string]struct{}
 	projectFormInputs              []textinput.Model
 	projectFormFocus               int
 	projectFormDescription         string
@@ -560,6 +628,13 @@ type Model struct {
 	descriptionEditorUndo          []string
 	descriptionEditorRedo          []string
 	labelsConfigInputs             []textinput.Model
+	columnEditInputs               []textinput.Model
+	columnEditFocus                int
+	columnEditColumnID             string
+	attachURLInputs                []textinput.Model
+	attachURLFocus                 int
+	attachURLBack                  inputMode
+	attachURLTaskID                string
 	labelsConfigFocus              int
 	labelsConfigSlug               string
 	labelsConfigBranchTaskID       string
@@ -570,6 +645,8 @@ type Model struct {
 	taskInfoOriginTaskID           string
 	taskInfoPath                   []string
 	taskInfoSubtaskIdx             int
+	taskInfoResourceIdx            int
+	taskInfoDescriptionRaw         bool
 	taskInfoComments               []domain.Comment
 	taskInfoCommentsError          string
 	taskFormParentID               string
@@ -577,6 +654,7 @@ type Model struct {
 	taskFormScope                  domain.KindAppliesTo
 	pendingProjectID               string
 	pendingFocusTaskID             string
+	pendingFocusColumnID           string
 	pendingActivityJumpTask        string
 	pendingOpenTaskInfoID          string
 	pendingOpenActivityLog         bool
@@ -590,19 +668,45 @@ type Model struct {
 	confirmArchive    bool
 	confirmHardDelete bool
 	confirmRestore    bool
-	pendingConfirm    confirmAction
-	confirmChoice     int
-	warningTitle      string
-	warningBody       string
+	// confirmQuit asks before ctrl+c discards unsaved modal input; quitConfirmBack is the modal to return to on cancel.
+	confirmQuit     bool
+	quitConfirmBack inputMode
+	// confirmDefaultCancel highlights cancel when a confirm dialog opens, so a reflexive enter does nothing.
+	confirmDefaultCancel bool
+	pendingConfirm       confirmAction
+	confirmChoice        int
+	warningTitle         string
+	warningBody          string
 
 	boardGroupBy    string
 	showWIPWarnings bool
-	dueSoonWindows  []time.Duration
-	showDueSummary  bool
-	searchRoots     []string
-	projectRoots    map[string]string
-	defaultRootDir  string
-	highlightColor  string
+	// dimBlocked fades unselected blocked cards so attention goes to unblocked work.
+	dimBlocked bool
+	// projectSort orders the project picker and tabs; pinnedProjects ranks slugs for the pinned sort.
+	projectSort    string
+	pinnedProjects []string
+	// warnDuplicateTitles pauses task creation on titles that closely match an existing task.
+	warnDuplicateTitles     bool
+	duplicateTitleMatches   []domain.Task
+	duplicateTitleConfirmed bool
+	dueSoonWindows          []time.Duration
+	showDueSummary          bool
+	// renderIcons draws emoji project icons; terminals that mismeasure emoji width can turn it off.
+	renderIcons bool
+	// defaultReminders prefills the reminders field on new task forms.
+	defaultReminders []string
+	// dependencyBadges lists the dependency badges rendered after card titles, in order.
+	dependencyBadges []DependencyBadge
+	// statusSegments orders the summary lines rendered below the board.
+	statusSegments []StatusSegment
+	// emptyColumnText and emptyBoardMessage override built-in empty-state copy when non-empty.
+	emptyColumnText   string
+	emptyBoardMessage string
+	searchRoots       []string
+	projectRoots      map[string]string
+	defaultRootDir    string
+	highlightColor    string
+	highlightStyle    HighlightStyle
 
 	projectionRootTaskID string
 
@@ -636,6 +740,7 @@ type Model struct {
 	resourcePickerFilter textinput.Model
 
 	labelPickerBack     inputMode
+	labelPickerBulk     labelPickerBulkAction
 	labelPickerIndex    int
 	labelPickerItems    []labelPickerItem
 	labelPickerAllItems []labelPickerItem
@@ -651,6 +756,8 @@ type Model struct {
 	allowedLabelGlobal   []string
 	allowedLabelProject  map[string][]string
 	enforceAllowedLabels bool
+	// labelColors maps lowercased labels to the board glyph color for tasks whose first label they are.
+	labelColors map[string]string
 
 	mouseSelectionMode bool
 
@@ -659,6 +766,8 @@ type Model struct {
 	saveBootstrap   SaveBootstrapConfigFunc
 	saveLabels      SaveLabelsConfigFunc
 
+	saveHighlightColor SaveHighlightColorFunc
+
 	identityDisplayName      string
 	identityActorID          string
 	identityDefaultActorType string
@@ -684,6 +793,107 @@ type Model struct {
 	autoRefreshInterval time.Duration
 	autoRefreshArmed    bool
 	autoRefreshInFlight bool
+	// refreshOnFocus requests terminal focus events and refreshes the board when the terminal regains focus.
+	refreshOnFocus bool
+	// readOnly blocks task, project, and comment mutations; config edits stay available.
+	readOnly bool
+	// hideNoticesPanel drops the notices side panel regardless of terminal width.
+	hideNoticesPanel bool
+	// noticesPanelMinWidth drops the notices side panel below this terminal width; 0 uses the fit rule only.
+	noticesPanelMinWidth int
+	// compactPaddingBelow drops the outer gutter below this terminal width; 0 always keeps it.
+	compactPaddingBelow int
+
+	// dataDir is the resolved application data directory opened by the open-data-dir command.
+	dataDir string
+	// draftDir holds task-form drafts in one directory per project; autosave is off when empty or the interval is zero.
+	draftDir              string
+	draftAutosaveInterval time.Duration
+	// draftGate orders draft writes against discards; draftTickArmed tracks a pending autosave tick.
+	draftGate      *taskFormDraftGate
+	draftTickArmed bool
+	// taskFormDraftSaved is the last written draft content, used to skip unchanged writes.
+	taskFormDraftSaved string
+	// taskFormDraftDeferred marks an open form whose draft recovery was put off, so cancelling it keeps the draft.
+	taskFormDraftDeferred bool
+	taskFormDraftChecked  map[string]struct{}
+	recoverDraft          taskFormDraft
+	// recoverDraftBack is the task form a recovery prompt was raised over, or modeNone for the launch prompt.
+	recoverDraftBack inputMode
+
+	// lastSeenPath stores per-project last-seen times for the catch-up summary; empty disables it.
+	lastSeenPath string
+	// lastSeen holds the last-seen time of each project visited this session.
+	lastSeen map[string]time.Time
+	// catchUpPending asks the next board load to show the catch-up summary, set when the terminal regains focus.
+	catchUpPending bool
+	// terminalBlurred is set while the terminal reports lost focus, so background refreshes do not count as visits.
+	terminalBlurred bool
+	catchUp         catchUpSummary
+
+	// startupDueDigest lists overdue and due-today tasks across projects once per session, before the board.
+	startupDueDigest        bool
+	startupDueDigestChecked bool
+	dueDigest               dueDigestSummary
+	dueDigestBack           inputMode
+
+	// viewStatePath stores UI choices such as the footer key category; empty keeps them for this session only.
+	viewStatePath string
+	footerHelp    footerHelpLevel
+
+	// loads is shared across model copies so a newer board load can cancel an older one.
+	loads                 *loadController
+	reloadDebounce        time.Duration
+	reloadSeq             uint64
+	lastLoadedFingerprint uint64
+	// boardLoaded is set once the first board load lands; nothing is drawn before then.
+	boardLoaded bool
+
+	// columnPageSize enables lazy per-column task loading when positive.
+	// columnLoadedCounts holds each column's paging offset in board rows; columnTaskCounts its full board-row counts.
+	columnPageSize     int
+	columnLoadedCounts map[string]int
+	columnHasMore      map[string]bool
+	columnTaskCounts   map[string]app.TaskPageCount
+	columnPageInFlight string
+
+	// inboxIndex tracks the highlighted row while inbox triage mode is open.
+	inboxIndex int
+
+	// titleWrap wraps board card titles over up to titleMaxLines rows instead of truncating them.
+	titleWrap     bool
+	titleMaxLines int
+
+	// recentTasks lists tasks opened in task info this session, newest first; it survives reloads.
+	recentTasks     []recentTaskEntry
+	recentTaskIndex int
+
+	// watchedTasks holds the watched view's rows across projects while it is open.
+	watchedTasks     []watchedTaskItem
+	watchedTaskIndex int
+
+	// exportTaskID is the task the export-card modal targets; format and subtask choices persist for the session.
+	exportTaskID       string
+	exportTaskBack     inputMode
+	exportCardFormat   app.TaskCardFormat
+	exportCardSubtasks bool
+
+	// activeProjectID and previousProjectID back the switch-to-previous-project toggle for the session.
+	activeProjectID   string
+	previousProjectID string
+
+	// projectProfiles holds per-project view overrides; globalView keeps the configured defaults they overlay.
+	projectProfiles map[string]ProjectProfile
+	globalView      projectViewSettings
+
+	// taskTemplates holds named new-task defaults keyed by lowercased template name.
+	taskTemplates map[string]TaskTemplate
+	// savedSearches holds named search filter sets keyed by lowercased search name.
+	savedSearches   map[string]SavedSearch
+	saveSavedSearch SaveSavedSearchFunc
+
+	// orphanedTasks lists current-project subtasks whose parent no longer exists.
+	orphanedTasks []domain.Task
 }
 
 // loadedMsg carries message data through update handling.
@@ -700,6 +910,16 @@ type loadedMsg struct {
 	err                       error
 	attentionItemsCount       int
 	attentionUserActionCount  int
+	columnHasMore             map[string]bool
+	columnOffsets             map[string]int
+	columnCounts              map[string]app.TaskPageCount
+	orphanedTasks             []domain.Task
+	// loadedAt is when the load started; lastSeenAt is the selected project's stored last-seen time.
+	loadedAt      time.Time
+	lastSeenAt    time.Time
+	lastSeenKnown bool
+	// catchUp holds the changes to summarize when this load opens the catch-up view.
+	catchUp *catchUpWindow
 }
 
 // resourcePickerLoadedMsg carries resource picker directory entries.
@@ -719,12 +939,17 @@ type actionMsg struct {
 	projectRootSlug string
 	projectRootPath string
 	focusTaskID     string
+	focusColumnID   string
 	clearSelect     bool
 	clearTaskIDs    []string
 	historyPush     *historyActionSet
 	historyUndo     *historyActionSet
 	historyRedo     *historyActionSet
 	activityItem    *activityEntry
+	// upsertTasks, removeTaskIDs, and archivedTaskIDs carry mutation results applied before the reconcile reload.
+	upsertTasks     []domain.Task
+	removeTaskIDs   []string
+	archivedTaskIDs []string
 }
 
 // autoRefreshTickMsg triggers a periodic external-state refresh attempt.
@@ -817,6 +1042,16 @@ func NewModel(svc Service, opts ...Option) Model {
 	highlightColorInput.Placeholder = "ansi index (e.g. 212) or #RRGGBB"
 	highlightColorInput.CharLimit = 32
 	configureTextInputClipboardBindings(&highlightColorInput)
+	jumpTaskInput := textinput.New()
+	jumpTaskInput.Prompt = "id: "
+	jumpTaskInput.Placeholder = "task id or unique id prefix"
+	jumpTaskInput.CharLimit = 128
+	configureTextInputClipboardBindings(&jumpTaskInput)
+	goToColumnInput := textinput.New()
+	goToColumnInput.Prompt = "column: "
+	goToColumnInput.Placeholder = "column name"
+	goToColumnInput.CharLimit = 80
+	configureTextInputClipboardBindings(&goToColumnInput)
 	dependencyInput := textinput.New()
 	dependencyInput.Prompt = "query: "
 	dependencyInput.Placeholder = "search title, description, labels"
@@ -867,6 +1102,11 @@ func NewModel(svc Service, opts ...Option) Model {
 	duePickerTimeInput.Placeholder = "17:00"
 	duePickerTimeInput.CharLimit = 16
 	configureTextInputClipboardBindings(&duePickerTimeInput)
+	duePickerRepeatInput := textinput.New()
+	duePickerRepeatInput.Prompt = ""
+	duePickerRepeatInput.Placeholder = "1w | 3d | 1mo (empty = no repeat)"
+	duePickerRepeatInput.CharLimit = 16
+	configureTextInputClipboardBindings(&duePickerRepeatInput)
 	labelPickerInput := textinput.New()
 	labelPickerInput.Prompt = "filter: "
 	labelPickerInput.Placeholder = "type to fuzzy-find labels"
@@ -876,14 +1116,25 @@ func NewModel(svc Service, opts ...Option) Model {
 		svc:                            svc,
 		status:                         "loading...",
 		help:                           h,
-		keys:                           newKeyMap(),
+		keys:                           newKeyMap(KeyConfig{}),
 		taskFields:                     DefaultTaskFieldConfig(),
 		defaultDeleteMode:              app.DeleteModeArchive,
+		parentDeletePolicy:             app.ParentDeletePolicyBlock,
+		draftGate:                      &taskFormDraftGate{},
 		searchInput:                    searchInput,
+		searchDueFromInput:             newSearchDateInput(),
+		searchDueToInput:               newSearchDateInput(),
+		searchCreatedFromInput:         newSearchDateInput(),
+		searchCreatedToInput:           newSearchDateInput(),
 		commandInput:                   commandInput,
 		bootstrapDisplayInput:          bootstrapDisplayInput,
 		pathsRootInput:                 pathsRootInput,
 		highlightColorInput:            highlightColorInput,
+		jumpTaskInput:                  jumpTaskInput,
+		goToColumnInput:                goToColumnInput,
+		templatePicker:                 newTemplatePicker(),
+		savedSearchPicker:              newSavedSearchPicker(),
+		assignPicker:                   newAssignPicker(),
 		dependencyInput:                dependencyInput,
 		threadInput:                    threadInput,
 		threadDetailsInput:             threadDetailsInput,
@@ -894,6 +1145,7 @@ func NewModel(svc Service, opts ...Option) Model {
 		resourcePickerFilter:           resourcePickerFilter,
 		duePickerDateInput:             duePickerDateInput,
 		duePickerTimeInput:             duePickerTimeInput,
+		duePickerRepeatInput:           duePickerRepeatInput,
 		labelPickerInput:               labelPickerInput,
 		searchStates:                   []string{"todo", "progress", "done"},
 		searchDefaultStates:            []string{"todo", "progress", "done"},
@@ -902,10 +1154,17 @@ func NewModel(svc Service, opts ...Option) Model {
 		dependencyStates:               []string{"todo", "progress", "done"},
 		launchPicker:                   false,
 		boardGroupBy:                   "none",
+		projectSort:                    projectSortNone,
 		showWIPWarnings:                true,
 		dueSoonWindows:                 []time.Duration{24 * time.Hour, time.Hour},
 		showDueSummary:                 true,
+		renderIcons:                    true,
+		statusSegments:                 slices.Clone(defaultStatusSegments),
 		highlightColor:                 defaultHighlightColor,
+		projectProfiles:                map[string]ProjectProfile{},
+		taskTemplates:                  map[string]TaskTemplate{},
+		savedSearches:                  map[string]SavedSearch{},
+		globalView:                     projectViewSettings{taskFields: DefaultTaskFieldConfig(), boardGroupBy: "none"},
 		selectedTaskIDs:                map[string]struct{}{},
 		activityLog:                    []activityEntry{},
 		noticesPanel:                   noticesPanelFocusProject,
@@ -915,6 +1174,7 @@ func NewModel(svc Service, opts ...Option) Model {
 		confirmArchive:                 true,
 		confirmHardDelete:              true,
 		confirmRestore:                 false,
+		confirmDefaultCancel:           true,
 		taskFormKind:                   domain.WorkKindTask,
 		taskFormScope:                  domain.KindAppliesToTask,
 		allowedLabelProject:            map[string][]string{},
@@ -927,6 +1187,7 @@ func NewModel(svc Service, opts ...Option) Model {
 		taskFormResourceEditIndex:      -1,
 		bootstrapActorIndex:            0,
 		bootstrapRoots:                 []string{},
+		loads:                          newLoadController(),
 	}
 	if cwd, err := os.Getwd(); err == nil {
 		m.defaultRootDir = cwd
@@ -967,7 +1228,7 @@ func (m Model) loadDataForAutoRefreshCmd() tea.Cmd {
 // shouldAutoRefresh reports whether auto-refresh can run without disrupting active input flows.
 func (m Model) shouldAutoRefresh() bool {
 	switch m.mode {
-	case modeNone, modeTaskInfo, modeActivityLog:
+	case modeNone, modeTaskInfo, modeActivityLog, modeInbox:
 		return true
 	default:
 		return false
@@ -980,6 +1241,10 @@ func (m *Model) applyLoadedMsg(msg loadedMsg) tea.Cmd {
 	defer m.markGlobalNoticeApplyLoadedCompletion(applyStartedAt, msg.err)
 
 	if msg.err != nil {
+		if errors.Is(msg.err, context.Canceled) {
+			// A newer load superseded this one; keep the current board until it lands.
+			return nil
+		}
 		m.err = msg.err
 		return nil
 	}
@@ -988,10 +1253,13 @@ func (m *Model) applyLoadedMsg(msg loadedMsg) tea.Cmd {
 		previousGlobalNoticesKey = strings.TrimSpace(selectedGlobalNotice.StableKey)
 	}
 	m.err = nil
+	m.boardLoaded = true
+	m.lastLoadedFingerprint = loadedMsgFingerprint(msg)
 	m.projects = msg.projects
 	m.selectedProject = msg.selectedProject
 	m.columns = msg.columns
 	m.tasks = msg.tasks
+	m.applyColumnPageState(msg)
 	if msg.activityEntries != nil {
 		m.activityLog = append([]activityEntry(nil), msg.activityEntries...)
 	}
@@ -1004,7 +1272,11 @@ func (m *Model) applyLoadedMsg(msg loadedMsg) tea.Cmd {
 	}
 	m.globalNoticesPartialCount = max(0, msg.globalNoticesPartialCount)
 	m.dependencyRollup = msg.rollup
+	m.orphanedTasks = msg.orphanedTasks
 	m.warnings = buildScopeWarnings(msg.attentionItemsCount, msg.attentionUserActionCount, m.globalNoticesPartialCount)
+	if warning := orphanedTaskWarning(len(m.orphanedTasks)); warning != "" {
+		m.warnings = append(m.warnings, warning)
+	}
 	if len(m.projects) == 0 {
 		m.selectedProject = 0
 		m.selectedColumn = 0
@@ -1017,6 +1289,7 @@ func (m *Model) applyLoadedMsg(msg loadedMsg) tea.Cmd {
 		m.globalNotices = []globalNoticesPanelItem{}
 		m.globalNoticesIdx = 0
 		m.globalNoticesPartialCount = 0
+		m.orphanedTasks = nil
 		m.pendingOpenActivityLog = false
 		m.clearPendingNotificationThread()
 		m.completeGlobalNoticeTransition("no_projects")
@@ -1044,6 +1317,8 @@ func (m *Model) applyLoadedMsg(msg loadedMsg) tea.Cmd {
 		m.traceGlobalNoticePending("clear", "pending_project_id", pendingProjectID, "reason", "apply_loaded")
 		m.pendingProjectID = ""
 	}
+	m.trackActiveProject()
+	m.applyProjectProfile()
 	if m.projectionRootTaskID != "" {
 		if _, ok := m.taskByID(m.projectionRootTaskID); !ok {
 			m.projectionRootTaskID = ""
@@ -1053,6 +1328,10 @@ func (m *Model) applyLoadedMsg(msg loadedMsg) tea.Cmd {
 	m.clampSelections()
 	m.retainSelectionForLoadedTasks()
 	m.normalizePanelFocus()
+	if m.pendingFocusColumnID != "" {
+		m.focusColumnByID(m.pendingFocusColumnID)
+		m.pendingFocusColumnID = ""
+	}
 	if m.pendingFocusTaskID != "" {
 		pendingFocusTaskID := m.pendingFocusTaskID
 		m.focusTaskByID(pendingFocusTaskID)
@@ -1213,12 +1492,56 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.normalizePanelFocus()
 		return m, nil
 
+	case reloadFlushMsg:
+		return m.handleReloadFlush(msg)
+
+	case columnPageLoadedMsg:
+		m.applyColumnPage(msg)
+		return m, nil
+
 	case loadedMsg:
 		m.autoRefreshInFlight = false
 		if cmd := m.applyLoadedMsg(msg); cmd != nil {
 			return m, cmd
 		}
-		return m, m.scheduleAutoRefreshTickCmd()
+		// Record the visit first: an open catch-up summary defers the draft check until it is dismissed.
+		visitCmd := m.applyLoadedProjectVisit(msg)
+		return m, tea.Batch(m.scheduleAutoRefreshTickCmd(), m.scheduleTaskFormDraftTickCmd(), visitCmd, m.checkTaskFormDraftCmd(), m.startupDueDigestCmd())
+
+	case catchUpLoadedMsg:
+		m.applyCatchUpLoaded(msg)
+		return m, nil
+
+	case lastSeenSavedMsg:
+		if msg.err != nil {
+			m.status = "last-seen save failed: " + msg.err.Error()
+		}
+		return m, nil
+
+	case dueDigestLoadedMsg:
+		return m.applyDueDigestLoaded(msg)
+
+	case watchedTasksLoadedMsg:
+		return m.applyWatchedTasksLoaded(msg)
+
+	case viewStateSavedMsg:
+		if msg.err != nil {
+			m.status = "view state save failed: " + msg.err.Error()
+		}
+		return m, nil
+
+	case taskFormDraftTickMsg:
+		m.draftTickArmed = false
+		return m, tea.Batch(m.autosaveTaskFormDraftCmd(), m.scheduleTaskFormDraftTickCmd())
+
+	case taskFormDraftSavedMsg:
+		if msg.err != nil {
+			m.status = "draft autosave failed: " + msg.err.Error()
+		}
+		return m, nil
+
+	case taskFormDraftLoadedMsg:
+		return m.applyTaskFormDraftLoaded(msg)
 
 	case autoRefreshTickMsg:
 		m.autoRefreshArmed = false
@@ -1231,20 +1554,41 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.autoRefreshInFlight = true
 		return m, m.loadDataForAutoRefreshCmd()
 
+	case tea.BlurMsg:
+		m.terminalBlurred = true
+		return m, nil
+
+	case tea.FocusMsg:
+		m.terminalBlurred = false
+		m.catchUpPending = m.lastSeenPath != ""
+		if !m.refreshOnFocus || m.autoRefreshInFlight || !m.shouldAutoRefresh() {
+			// Without a refresh, summarize the activity background refreshes loaded while the terminal was away.
+			return m, m.recordFocusVisit()
+		}
+		// Focus refreshes share the guarded auto-refresh path, so unchanged data and input modes are left alone.
+		m.autoRefreshInFlight = true
+		return m, m.loadDataForAutoRefreshCmd()
+
 	case autoRefreshLoadedMsg:
 		m.autoRefreshInFlight = false
 		if msg.err != nil {
 			m.status = "auto refresh failed: " + msg.err.Error()
 			return m, m.scheduleAutoRefreshTickCmd()
 		}
+		if errors.Is(msg.data.err, context.Canceled) {
+			return m, m.scheduleAutoRefreshTickCmd()
+		}
 		if msg.data.err != nil {
 			m.status = "auto refresh failed: " + msg.data.err.Error()
 			return m, m.scheduleAutoRefreshTickCmd()
 		}
+		if m.autoRefreshUnchanged(msg.data) {
+			return m, tea.Batch(m.scheduleAutoRefreshTickCmd(), m.recordFocusVisit())
+		}
 		if cmd := m.applyLoadedMsg(msg.data); cmd != nil {
 			return m, cmd
 		}
-		return m, m.scheduleAutoRefreshTickCmd()
+		return m, tea.Batch(m.scheduleAutoRefreshTickCmd(), m.applyLoadedProjectVisit(msg.data))
 
 	case resourcePickerLoadedMsg:
 		if msg.err != nil {
@@ -1260,8 +1604,17 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case projectsExportedMsg:
+		m.applyProjectsExported(msg)
+		return m, nil
+
 	case actionMsg:
 		if msg.err != nil {
+			if status, ok := recoverableErrorStatus(msg.err); ok {
+				m.err = nil
+				m.status = status
+				return m, nil
+			}
 			m.err = msg.err
 			return m, nil
 		}
@@ -1285,6 +1638,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if msg.focusTaskID != "" {
 			m.pendingFocusTaskID = msg.focusTaskID
 		}
+		if msg.focusColumnID != "" {
+			m.pendingFocusColumnID = msg.focusColumnID
+		}
 		if msg.clearSelect {
 			m.clearSelection()
 		}
@@ -1303,11 +1659,19 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if msg.activityItem != nil {
 			m.appendActivity(*msg.activityItem)
 		}
+		m.applyOptimisticTaskChanges(msg)
 		if msg.reload {
-			return m, m.loadData
+			cmd := m.requestReload()
+			return m, cmd
 		}
 		return m, nil
 
+	case jumpToTaskMsg:
+		return m.applyJumpToTaskResult(msg)
+
+	case archiveDoneLoadedMsg:
+		return m.applyArchiveDoneLoaded(msg)
+
 	case searchResultsMsg:
 		if msg.err != nil {
 			m.err = msg.err
@@ -1354,7 +1718,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		m.applyRuntimeConfig(msg.config)
 		m.status = "config reloaded"
-		return m, m.loadData
+		// A reloaded refresh or autosave interval may enable polling that was previously off.
+		return m, tea.Batch(m.requestReload(), m.scheduleAutoRefreshTickCmd(), m.scheduleTaskFormDraftTickCmd())
 
 	case projectRootSavedMsg:
 		if msg.err != nil {
@@ -1429,9 +1794,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case tea.KeyPressMsg:
-		// Always honor terminal interrupt for deterministic emergency exit across all modes.
+		// Always honor terminal interrupt across all modes; confirm.quit only adds a prompt over unsaved input.
 		if msg.String() == "ctrl+c" {
-			return m, tea.Quit
+			return m.interruptQuit()
 		}
 		m.traceGlobalNoticeKeyDispatch(msg)
 		if m.mode != modeNone {
@@ -1456,12 +1821,22 @@ func (m Model) View() tea.View {
 		v := tea.NewView("error: " + m.err.Error() + "\n\npress r to retry • q quit\n")
 		v.MouseMode = m.activeMouseMode()
 		v.AltScreen = true
+		v.ReportFocus = m.refreshOnFocus
 		return v
 	}
 	if !m.ready {
 		v := tea.NewView("loading...")
 		v.MouseMode = m.activeMouseMode()
 		v.AltScreen = true
+		v.ReportFocus = m.refreshOnFocus
+		return v
+	}
+	// An empty frame until the first load lands keeps startup from flashing the empty-board guidance.
+	if !m.boardLoaded {
+		v := tea.NewView("")
+		v.MouseMode = m.activeMouseMode()
+		v.AltScreen = true
+		v.ReportFocus = m.refreshOnFocus
 		return v
 	}
 	if m.mode == modeDescriptionEditor {
@@ -1474,19 +1849,14 @@ func (m Model) View() tea.View {
 		return m.renderFullPageNodeModeView()
 	}
 	if len(m.projects) == 0 {
-		accent := lipgloss.Color("62")
-		muted := lipgloss.Color("241")
-		dim := lipgloss.Color("239")
-		titleStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("252"))
+		theme := m.theme()
+		accent := lipgloss.Color(theme.Accent)
+		muted := lipgloss.Color(theme.Muted)
+		dim := lipgloss.Color(theme.Dim)
+		titleStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(theme.Text))
 		helpStyle := lipgloss.NewStyle().Foreground(muted)
 		statusStyle := lipgloss.NewStyle().Foreground(dim)
-		sections := []string{
-			titleStyle.Render("tillsyn"),
-			"",
-			"No projects yet.",
-			"Press N to create your first project.",
-			"Press q to quit.",
-		}
+		sections := append([]string{titleStyle.Render("tillsyn"), ""}, m.emptyBoardLines()...)
 		if strings.TrimSpace(m.status) != "" && m.status != "ready" {
 			sections = append(sections, "", statusStyle.Render(m.status))
 		}
@@ -1495,8 +1865,8 @@ func (m Model) View() tea.View {
 		if innerWidth > 0 {
 			content = lipgloss.NewStyle().Width(innerWidth).Render(content)
 		}
-		content = applyOuterHorizontalPadding(content)
-		helpLine := applyOuterHorizontalPadding(m.renderBottomHelpLine(muted, dim, innerWidth))
+		content = m.applyOuterHorizontalPadding(content)
+		helpLine := m.applyOuterHorizontalPadding(m.renderBottomHelpLine(muted, dim, innerWidth))
 		contentHeight := lipgloss.Height(content)
 		if m.height > 0 {
 			helpHeight := lipgloss.Height(helpLine)
@@ -1512,12 +1882,12 @@ func (m Model) View() tea.View {
 					body = fitLines(body, max(0, m.height-helpHeight))
 				}
 				headerBlock := m.appHeaderBlock(statusStyle, innerWidth)
-				body = lipgloss.PlaceHorizontal(max(1, innerWidth-(2*tuiOuterHorizontalPadding)), lipgloss.Center, body)
+				body = lipgloss.PlaceHorizontal(max(1, innerWidth-(2*m.outerHorizontalPadding())), lipgloss.Center, body)
 				fullBody := strings.Join([]string{headerBlock, "", body, ""}, "\n")
 				if innerWidth > 0 {
 					fullBody = lipgloss.NewStyle().
-						PaddingLeft(tuiOuterHorizontalPadding).
-						PaddingRight(tuiOuterHorizontalPadding).
+						PaddingLeft(m.outerHorizontalPadding()).
+						PaddingRight(m.outerHorizontalPadding()).
 						Render(fullBody)
 				}
 				fullContent = fullBody + "\n" + helpLine
@@ -1532,13 +1902,15 @@ func (m Model) View() tea.View {
 		v := tea.NewView(fullContent)
 		v.MouseMode = m.activeMouseMode()
 		v.AltScreen = true
+		v.ReportFocus = m.refreshOnFocus
 		return v
 	}
 
 	project := m.projects[clamp(m.selectedProject, 0, len(m.projects)-1)]
-	accent := projectAccentColor(project)
-	muted := lipgloss.Color("241")
-	dim := lipgloss.Color("239")
+	theme := m.theme()
+	accent := m.projectAccentColor(project)
+	muted := lipgloss.Color(theme.Muted)
+	dim := lipgloss.Color(theme.Dim)
 
 	helpStyle := lipgloss.NewStyle().Foreground(muted)
 	statusStyle := lipgloss.NewStyle().Foreground(dim)
@@ -1572,15 +1944,12 @@ func (m Model) View() tea.View {
 		selColStyle := baseColStyle.Copy().BorderForeground(accent)
 		normColStyle := baseColStyle.Copy()
 		colTitle := lipgloss.NewStyle().Bold(true).Foreground(accent)
-		archivedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("243"))
-		highlight := m.selectedTaskHighlightColor()
-		selectedTaskStyle := lipgloss.NewStyle().Foreground(highlight).Bold(true)
-		selectedMultiTaskStyle := lipgloss.NewStyle().Foreground(highlight).Bold(true).Underline(true)
+		archivedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(theme.Archived))
 		// Multi-select should be indicated by marker stars only; avoid extra row background fill.
 		multiSelectedTaskStyle := lipgloss.NewStyle()
 		itemSubStyle := lipgloss.NewStyle().Foreground(muted)
 		groupStyle := lipgloss.NewStyle().Bold(true).Foreground(muted)
-		warningStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("203"))
+		warningStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(theme.Warning))
 
 		for colIdx, column := range m.columns {
 			colRenderWidth := colWidth + extraBoardWidthPerColumn
@@ -1592,14 +1961,9 @@ func (m Model) View() tea.View {
 			for _, task := range colTasks {
 				parentByID[task.ID] = task.ParentID
 			}
-			activeCount := 0
-			for _, task := range colTasks {
-				if task.ArchivedAt == nil {
-					activeCount++
-				}
-			}
+			totalCount, activeCount := m.columnHeaderCounts(column.ID, colTasks)
 
-			colHeader := fmt.Sprintf("%s (%d)", column.Name, len(colTasks))
+			colHeader := fmt.Sprintf("%s (%d)", column.Name, totalCount)
 			if column.WIPLimit > 0 {
 				colHeader = fmt.Sprintf("%s (%d/%d)", column.Name, activeCount, column.WIPLimit)
 			}
@@ -1613,7 +1977,10 @@ func (m Model) View() tea.View {
 			selectedEnd := -1
 
 			if len(colTasks) == 0 {
-				taskLines = append(taskLines, archivedStyle.Render("(empty)"))
+				taskLines = append(taskLines, archivedStyle.Render(m.emptyColumnLabel()))
+				if hint := m.emptyColumnHint(colIdx, boardPanelFocused); hint != "" {
+					taskLines = append(taskLines, itemSubStyle.Render(hint))
+				}
 			} else {
 				prevGroup := ""
 				for taskIdx, task := range colTasks {
@@ -1646,30 +2013,65 @@ func (m Model) View() tea.View {
 					if attentionCount > 0 {
 						attentionSuffix = fmt.Sprintf(" !%d", attentionCount)
 					}
-					titleWidth := max(1, colRenderWidth-(10+2*min(depth, 4))-utf8.RuneCountInString(attentionSuffix))
-					title := prefix + indent + truncate(task.Title, titleWidth) + attentionSuffix
+					attentionSuffix += m.taskDependencyBadges(task)
+					criticalMarker := m.criticalPriorityMarker(task)
+					blockedMarker := m.blockedTaskMarker(task)
+					watchedMarker := watchedTaskMarker(task)
+					dimBlocked := m.dimBlocked && blockedMarker != "" && !selected
+					plainGlyph, labelGlyph := m.taskLabelGlyph(task)
+					titleRows := m.boardTitleLines(task.Title, m.cardTitleWidth(task, depth, colRenderWidth, taskByID))
+					titleHead := prefix + indent
+					title := titleRows[0] + attentionSuffix
+					// Wrapped continuation rows keep the indent but not the selection markers.
+					titleRows = titleRows[1:]
+					for idx, row := range titleRows {
+						titleRows[idx] = "   " + indent + row
+					}
 					sub := m.taskListSecondary(task)
 					if sub != "" {
 						sub = indent + truncate(sub, max(1, colRenderWidth-(10+2*min(depth, 4))))
 					}
 					if task.ArchivedAt != nil {
-						title = archivedStyle.Render(title)
+						// Archived rows keep the glyph shape but not its color.
+						title = archivedStyle.Render(titleHead + plainGlyph + title)
+						for idx, row := range titleRows {
+							titleRows[idx] = archivedStyle.Render(row)
+						}
 						if sub != "" {
 							sub = archivedStyle.Render(sub)
 						}
 					} else {
 						switch {
-						case selected && multiSelected:
-							title = selectedMultiTaskStyle.Render(title)
 						case selected:
-							title = selectedTaskStyle.Render(title)
+							title = renderTaskTitleRow(titleHead, labelGlyph, title, func(s string) string {
+								return m.renderSelectedTaskTitle(s, multiSelected)
+							})
+							for idx, row := range titleRows {
+								titleRows[idx] = m.renderSelectedTaskTitle(row, multiSelected)
+							}
+						case dimBlocked:
+							// Dimmed rows drop the label color too so blocked cards recede as a whole.
+							title = blockedDimStyle.Render(titleHead + plainGlyph + title)
+							for idx, row := range titleRows {
+								titleRows[idx] = blockedDimStyle.Render(row)
+							}
+							if sub != "" {
+								sub = blockedDimStyle.Render(sub)
+							}
 						case multiSelected:
-							title = multiSelectedTaskStyle.Render(title)
+							title = renderTaskTitleRow(titleHead, labelGlyph, title, func(s string) string {
+								return multiSelectedTaskStyle.Render(s)
+							})
+						default:
+							title = titleHead + labelGlyph + title
 						}
+						// Markers are appended after row styling so their colors survive the selection highlight.
+						title += criticalMarker + blockedMarker + watchedMarker
 					}
 
 					rowStart := len(taskLines)
 					taskLines = append(taskLines, title)
+					taskLines = append(taskLines, titleRows...)
 					if sub != "" {
 						// Keep selection/focus markers on the title row only to avoid duplicate stars/cursor bars.
 						subPrefix := "   "
@@ -1763,26 +2165,14 @@ func (m Model) View() tea.View {
 	if m.help.ShowAll {
 		overlay = m.renderHelpOverlay(accent, muted, dim, helpStyle, m.width-8)
 	}
-	infoLine := m.renderInfoLine(project, muted)
 
 	sections := []string{headerBlock, "", mainArea}
-	if infoLine != "" {
-		sections = append(sections, infoLine)
-	}
-	if strings.TrimSpace(m.projectionRootTaskID) != "" {
-		sections = append(sections, statusStyle.Render(fmt.Sprintf("subtree focus active • %s full board", m.keys.clearFocus.Help().Key)))
-	}
-	if count := len(m.selectedTaskIDs); count > 0 {
-		sections = append(sections, statusStyle.Render(fmt.Sprintf("%d tasks selected • %s toggle • esc clear", count, m.keys.multiSelect.Help().Key)))
-	}
-	if status := m.boardStatusText(); status != "" {
-		sections = append(sections, statusStyle.Render(status))
-	}
+	sections = append(sections, m.statusSegmentLines(project, statusStyle, muted, attentionTotal, attentionBlocked)...)
 	content := strings.Join(sections, "\n")
-	content = applyOuterHorizontalPadding(content)
+	content = m.applyOuterHorizontalPadding(content)
 
 	innerWidth := layoutWidth
-	helpLine := applyOuterHorizontalPadding(m.renderBottomHelpLine(muted, dim, innerWidth))
+	helpLine := m.applyOuterHorizontalPadding(m.renderBottomHelpLine(muted, dim, innerWidth))
 
 	contentHeight := lipgloss.Height(content)
 	if m.height > 0 {
@@ -1796,13 +2186,13 @@ func (m Model) View() tea.View {
 		if !m.help.ShowAll && isFullPageNodeMode(m.mode) {
 			body := overlay
 			fullSections := []string{headerBlock, ""}
-			body = lipgloss.PlaceHorizontal(max(1, innerWidth-(2*tuiOuterHorizontalPadding)), lipgloss.Center, body)
+			body = lipgloss.PlaceHorizontal(max(1, innerWidth-(2*m.outerHorizontalPadding())), lipgloss.Center, body)
 			fullSections = append(fullSections, body, "")
 			fullBody := strings.Join(fullSections, "\n")
 			if layoutWidth > 0 {
 				fullBody = lipgloss.NewStyle().
-					PaddingLeft(tuiOuterHorizontalPadding).
-					PaddingRight(tuiOuterHorizontalPadding).
+					PaddingLeft(m.outerHorizontalPadding()).
+					PaddingRight(m.outerHorizontalPadding()).
 					Render(fullBody)
 			}
 			if m.height > 0 {
@@ -1822,6 +2212,7 @@ func (m Model) View() tea.View {
 	view := tea.NewView(fullContent)
 	view.MouseMode = m.activeMouseMode()
 	view.AltScreen = true
+	view.ReportFocus = m.refreshOnFocus
 	return view
 }
 
@@ -1835,10 +2226,13 @@ func (m Model) activeMouseMode() tea.MouseMode {
 
 // loadData loads required data for the current operation.
 func (m Model) loadData() tea.Msg {
+	ctx, cancel := m.loads.begin()
+	defer cancel()
 	totalStartedAt := time.Now()
+	loadedAt := totalStartedAt.UTC()
 
 	projectsStartedAt := time.Now()
-	projects, err := m.svc.ListProjects(context.Background(), m.showArchivedProjects)
+	projects, err := m.svc.ListProjects(ctx, m.showArchivedProjects)
 	m.traceLoadDataStage("projects", projectsStartedAt, err, "count", len(projects), "show_archived_projects", m.showArchivedProjects)
 	if err != nil {
 		m.traceLoadDataStage("total", totalStartedAt, err, "project_count", 0, "column_count", 0, "task_count", 0)
@@ -1848,8 +2242,18 @@ func (m Model) loadData() tea.Msg {
 		m.traceLoadDataStage("total", totalStartedAt, nil, "project_count", 0, "column_count", 0, "task_count", 0)
 		return loadedMsg{projects: projects}
 	}
+	projects = m.sortProjects(ctx, projects)
 
 	projectIdx := clamp(m.selectedProject, 0, len(projects)-1)
+	// Follow the active project by id; recency and task-count sorts can reorder projects between loads.
+	if currentProjectID, ok := m.currentProjectID(); ok {
+		for idx, project := range projects {
+			if project.ID == currentProjectID {
+				projectIdx = idx
+				break
+			}
+		}
+	}
 	if pendingProjectID := strings.TrimSpace(m.pendingProjectID); pendingProjectID != "" {
 		for idx, project := range projects {
 			if project.ID == pendingProjectID {
@@ -1859,8 +2263,10 @@ func (m Model) loadData() tea.Msg {
 		}
 	}
 	projectID := projects[projectIdx].ID
+	// The loaded project's profile (page size in particular) must shape this load, not the previous project's.
+	m.applyViewSettings(m.viewSettingsFor(projects[projectIdx], true))
 	columnsStartedAt := time.Now()
-	columns, err := m.svc.ListColumns(context.Background(), projectID, false)
+	columns, err := m.svc.ListColumns(ctx, projectID, false)
 	m.traceLoadDataStage("columns", columnsStartedAt, err, "project_id", projectID, "count", len(columns))
 	if err != nil {
 		m.traceLoadDataStage("total", totalStartedAt, err, "project_count", len(projects), "column_count", 0, "task_count", 0)
@@ -1869,20 +2275,26 @@ func (m Model) loadData() tea.Msg {
 
 	tasksStartedAt := time.Now()
 	var tasks []domain.Task
+	var columnPages columnPageState
 	searchFilterActive := m.searchApplied
 	searchMatchCount := 0
 	taskSource := "list_tasks"
 	if searchFilterActive {
-		matches, searchErr := m.svc.SearchTaskMatches(context.Background(), app.SearchTasksFilter{
+		matches, searchErr := m.svc.SearchTaskMatches(ctx, app.SearchTasksFilter{
 			ProjectID:       projectID,
 			Query:           m.searchQuery,
 			CrossProject:    m.searchCrossProject,
 			IncludeArchived: m.searchIncludeArchived,
+			IncludeComments: m.searchIncludeComments,
 			States:          append([]string(nil), m.searchStates...),
 			Levels:          canonicalSearchLevels(m.searchLevels),
 			Kinds:           append([]string(nil), m.searchKinds...),
 			LabelsAny:       append([]string(nil), m.searchLabelsAny...),
 			LabelsAll:       append([]string(nil), m.searchLabelsAll...),
+			DueAfter:        m.searchDueAfter,
+			DueBefore:       m.searchDueBefore,
+			CreatedAfter:    m.searchCreatedAfter,
+			CreatedBefore:   m.searchCreatedBefore,
 			Mode:            app.SearchModeHybrid,
 			Sort:            app.SearchSortRankDesc,
 			Limit:           defaultSearchResultsLimit,
@@ -1901,8 +2313,11 @@ func (m Model) loadData() tea.Msg {
 				tasks = append(tasks, match.Task)
 			}
 		}
+	} else if pager, ok := m.lazyColumnPager(); ok {
+		taskSource = "column_pages"
+		tasks, columnPages, err = m.loadColumnPagedTasks(ctx, pager, projectID, columns)
 	} else {
-		tasks, err = m.svc.ListTasks(context.Background(), projectID, m.showArchived)
+		tasks, err = m.svc.ListTasks(ctx, projectID, m.showArchived)
 	}
 	m.traceLoadDataStage("tasks_search", tasksStartedAt, err, "project_id", projectID, "source", taskSource, "search_active", searchFilterActive, "tasks_count", len(tasks), "search_match_count", searchMatchCount)
 	if err != nil {
@@ -1910,7 +2325,7 @@ func (m Model) loadData() tea.Msg {
 		return loadedMsg{err: err}
 	}
 	rollupStartedAt := time.Now()
-	rollup, err := m.svc.GetProjectDependencyRollup(context.Background(), projectID)
+	rollup, err := m.svc.GetProjectDependencyRollup(ctx, projectID)
 	m.traceLoadDataStage(
 		"rollup",
 		rollupStartedAt,
@@ -1937,10 +2352,12 @@ func (m Model) loadData() tea.Msg {
 	eventsStartedAt := time.Now()
 	activityEntries := []activityEntry{}
 	events := []domain.ChangeEvent{}
-	events, activityErr := m.svc.ListProjectChangeEvents(context.Background(), projectID, activityLogMaxItems)
+	events, activityErr := m.svc.ListProjectChangeEvents(ctx, projectID, activityLogMaxItems)
 	if activityErr == nil {
 		activityEntries = mapChangeEventsToActivityEntries(events)
 	}
+	lastSeenAt, lastSeenKnown := m.loadLastSeenFor(projectID)
+	catchUp := m.loadCatchUpWindow(ctx, projectID, lastSeenAt, lastSeenKnown)
 	m.traceLoadDataStage("events", eventsStartedAt, activityErr, "project_id", projectID, "events_count", len(events), "activity_entries_count", len(activityEntries))
 
 	attentionStartedAt := time.Now()
@@ -1948,7 +2365,11 @@ func (m Model) loadData() tea.Msg {
 	globalNotices := make([]globalNoticesPanelItem, 0)
 	globalNoticesPartialCount := 0
 	for _, project := range projects {
-		projectAttention, attentionErr := m.svc.ListAttentionItems(context.Background(), app.ListAttentionItemsInput{
+		if err := ctx.Err(); err != nil {
+			m.traceLoadDataStage("total", totalStartedAt, err, "project_count", len(projects), "column_count", len(columns), "task_count", len(tasks))
+			return loadedMsg{err: err}
+		}
+		projectAttention, attentionErr := m.svc.ListAttentionItems(ctx, app.ListAttentionItemsInput{
 			Level: domain.LevelTupleInput{
 				ProjectID: project.ID,
 				ScopeType: domain.ScopeLevelProject,
@@ -1981,7 +2402,7 @@ func (m Model) loadData() tea.Msg {
 			if !item.RequiresUserAction {
 				continue
 			}
-			globalNotices = append(globalNotices, globalNoticesPanelItemFromAttention(project, item))
+			globalNotices = append(globalNotices, m.globalNoticesPanelItemFromAttention(project, item))
 		}
 	}
 	m.traceLoadDataStage(
@@ -2024,6 +2445,14 @@ func (m Model) loadData() tea.Msg {
 		rollup:                    rollup,
 		attentionItemsCount:       len(attentionItems),
 		attentionUserActionCount:  requiresUserAction,
+		columnHasMore:             columnPages.hasMore,
+		columnOffsets:             columnPages.offsets,
+		columnCounts:              columnPages.counts,
+		orphanedTasks:             m.loadOrphanedTasks(ctx, projectID),
+		loadedAt:                  loadedAt,
+		lastSeenAt:                lastSeenAt,
+		lastSeenKnown:             lastSeenKnown,
+		catchUp:                   catchUp,
 	}
 }
 
@@ -2035,11 +2464,16 @@ func (m Model) loadSearchMatches() tea.Msg {
 		Query:           m.searchQuery,
 		CrossProject:    m.searchCrossProject,
 		IncludeArchived: m.searchIncludeArchived,
+		IncludeComments: m.searchIncludeComments,
 		States:          append([]string(nil), m.searchStates...),
 		Levels:          canonicalSearchLevels(m.searchLevels),
 		Kinds:           append([]string(nil), m.searchKinds...),
 		LabelsAny:       append([]string(nil), m.searchLabelsAny...),
 		LabelsAll:       append([]string(nil), m.searchLabelsAll...),
+		DueAfter:        m.searchDueAfter,
+		DueBefore:       m.searchDueBefore,
+		CreatedAfter:    m.searchCreatedAfter,
+		CreatedBefore:   m.searchCreatedBefore,
 		Mode:            app.SearchModeHybrid,
 		Sort:            app.SearchSortRankDesc,
 		Limit:           defaultSearchResultsLimit,
@@ -2121,7 +2555,8 @@ func (m Model) jumpToActivityNode() (tea.Model, tea.Cmd) {
 		m.pendingFocusTaskID = workItemID
 		m.pendingActivityJumpTask = workItemID
 		m.status = "loading activity node..."
-		return m, m.loadData
+		cmd := m.requestReload()
+		return m, cmd
 	}
 	m.status = "activity node unavailable (possibly hard-deleted)"
 	return m, nil
@@ -2258,7 +2693,7 @@ func (m *Model) startSearchMode() tea.Cmd {
 	m.searchStateCursor = 0
 	m.searchLevelCursor = 0
 	m.status = "search"
-	return m.searchInput.Focus()
+	return m.syncSearchInputFocus()
 }
 
 // startCommandPalette starts command palette.
@@ -2451,6 +2886,10 @@ func (m *Model) startQuickActions() tea.Cmd {
 
 // startProjectForm starts project form.
 func (m *Model) startProjectForm(project *domain.Project) tea.Cmd {
+	if m.readOnly {
+		m.status = readOnlyStatus("project form")
+		return nil
+	}
 	m.projectFormFocus = 0
 	m.taskInfoBody.SetYOffset(0)
 	m.taskInfoBody.SetContent("")
@@ -2485,17 +2924,25 @@ func (m *Model) startProjectForm(project *domain.Project) tea.Cmd {
 	} else {
 		m.mode = modeAddProject
 		m.status = "new project"
+		m.projectFormInputs = append(m.projectFormInputs, newModalInput("", "csv column names (optional, default columns when empty)", "", 240))
 	}
 	m.syncProjectFormDescriptionDisplay()
+	m.projectFormBaseline = m.projectFormValues()
 	return m.focusProjectFormField(0)
 }
 
 // startTaskForm starts task form.
 func (m *Model) startTaskForm(task *domain.Task) tea.Cmd {
+	if m.readOnly {
+		m.status = readOnlyStatus("task form")
+		return nil
+	}
 	m.formFocus = 0
 	m.taskInfoBody.SetYOffset(0)
 	m.taskInfoBody.SetContent("")
-	m.priorityIdx = 1
+	m.taskFormDraftSaved = ""
+	m.taskFormDraftDeferred = false
+	m.priorityIdx = priorityIndex(domain.PriorityMedium)
 	m.duePicker = 0
 	m.pickerBack = modeNone
 	m.input = ""
@@ -2503,6 +2950,7 @@ func (m *Model) startTaskForm(task *domain.Task) tea.Cmd {
 	m.taskFormKind = domain.WorkKindTask
 	m.taskFormScope = domain.KindAppliesToTask
 	m.taskFormResourceRefs = nil
+	m.taskFormRecurEvery = ""
 	m.taskFormSubtaskCursor = 0
 	m.taskFormResourceCursor = 0
 	m.taskFormResourceEditIndex = -1
@@ -2519,6 +2967,8 @@ func (m *Model) startTaskForm(task *domain.Task) tea.Cmd {
 		newModalInput("", "acceptance criteria (optional)", "", 400),
 		newModalInput("", "validation plan (optional)", "", 400),
 		newModalInput("", "risk notes (optional)", "", 400),
+		newModalInput("", "csv lead times before due, e.g. 1d,2h", "", 80),
+		newModalInput("", "owner (optional)", "", 120),
 	}
 	m.formInputs[taskFieldPriority].SetValue(string(priorityOptions[m.priorityIdx]))
 	m.taskFormDescription = ""
@@ -2557,7 +3007,14 @@ func (m *Model) startTaskForm(task *domain.Task) tea.Cmd {
 		if riskNotes := strings.TrimSpace(task.Metadata.RiskNotes); riskNotes != "" {
 			m.formInputs[taskFieldRiskNotes].SetValue(riskNotes)
 		}
+		if len(task.Metadata.Reminders) > 0 {
+			m.formInputs[taskFieldReminders].SetValue(strings.Join(task.Metadata.Reminders, ","))
+		}
+		if assignee := strings.TrimSpace(task.Metadata.Assignee); assignee != "" {
+			m.formInputs[taskFieldAssignee].SetValue(assignee)
+		}
 		m.taskFormResourceRefs = append([]domain.ResourceRef(nil), task.Metadata.ResourceRefs...)
+		m.taskFormRecurEvery = task.Metadata.RecurEvery
 		m.mode = modeEditTask
 		m.editingTaskID = task.ID
 		m.loadTaskInfoComments(task.ID)
@@ -2566,6 +3023,7 @@ func (m *Model) startTaskForm(task *domain.Task) tea.Cmd {
 		m.formInputs[taskFieldPriority].Placeholder = "medium"
 		m.formInputs[taskFieldDue].Placeholder = "-"
 		m.formInputs[taskFieldLabels].Placeholder = "-"
+		m.formInputs[taskFieldReminders].SetValue(strings.Join(m.defaultReminders, ","))
 		m.mode = modeAddTask
 		m.editingTaskID = ""
 		m.status = "new task"
@@ -2575,7 +3033,8 @@ func (m *Model) startTaskForm(task *domain.Task) tea.Cmd {
 	}
 	m.syncTaskFormDescriptionDisplay()
 	m.refreshTaskFormLabelSuggestions()
-	return m.focusTaskFormField(0)
+	m.taskFormBaseline = m.taskFormValues()
+	return tea.Batch(m.focusTaskFormField(0), m.checkOpenTaskFormDraftCmd())
 }
 
 // newTaskDefaultsForActiveBoardScope infers parent/kind/scope defaults from active focused scope.
@@ -2603,6 +3062,10 @@ func (m Model) newTaskDefaultsForActiveBoardScope() (string, domain.WorkKind, do
 
 // startSubtaskForm opens the task form preconfigured for a child item.
 func (m *Model) startSubtaskForm(parent domain.Task) tea.Cmd {
+	if m.readOnly {
+		m.status = readOnlyStatus("new subtask")
+		return nil
+	}
 	cmd := m.startTaskForm(nil)
 	m.taskFormParentID = parent.ID
 	m.taskFormKind = domain.WorkKindSubtask
@@ -2614,6 +3077,10 @@ func (m *Model) startSubtaskForm(parent domain.Task) tea.Cmd {
 
 // startBranchForm opens the task form preconfigured for a branch work item.
 func (m *Model) startBranchForm(parent *domain.Task) tea.Cmd {
+	if m.readOnly {
+		m.status = readOnlyStatus("new branch")
+		return nil
+	}
 	cmd := m.startTaskForm(nil)
 	m.taskFormKind = domain.WorkKind("branch")
 	m.taskFormScope = domain.KindAppliesToBranch
@@ -2631,6 +3098,10 @@ func (m *Model) startBranchForm(parent *domain.Task) tea.Cmd {
 
 // startPhaseForm opens the task form preconfigured for a phase work item.
 func (m *Model) startPhaseForm(parent *domain.Task) tea.Cmd {
+	if m.readOnly {
+		m.status = readOnlyStatus("new phase")
+		return nil
+	}
 	cmd := m.startTaskForm(nil)
 	m.taskFormKind = domain.WorkKindPhase
 	m.taskFormScope = domain.KindAppliesToPhase
@@ -2722,7 +3193,9 @@ func (m Model) taskFormFocusOrder() []int {
 		taskFieldSubtasks,
 		taskFieldPriority,
 		taskFieldDue,
+		taskFieldReminders,
 		taskFieldLabels,
+		taskFieldAssignee,
 		taskFieldDependsOn,
 		taskFieldBlockedBy,
 		taskFieldBlockedReason,
@@ -2753,7 +3226,7 @@ func isTaskFormActionField(field int) bool {
 
 // isTaskFormDirectTextInputField reports whether the focused task-form field should consume printable text directly.
 func isTaskFormDirectTextInputField(field int) bool {
-	return field == taskFieldTitle
+	return field == taskFieldTitle || field == taskFieldReminders || field == taskFieldAssignee
 }
 
 // isProjectFormDirectTextInputField reports whether the focused project-form field should consume printable text directly.
@@ -3029,6 +3502,10 @@ func (m *Model) startProjectDescriptionEditor(seed tea.KeyPressMsg) tea.Cmd {
 
 // startThreadDescriptionEditor opens the full-screen markdown description editor for thread details.
 func (m *Model) startThreadDescriptionEditor() tea.Cmd {
+	if m.readOnly {
+		m.status = readOnlyStatus("edit description")
+		return nil
+	}
 	if m == nil {
 		return nil
 	}
@@ -3053,6 +3530,10 @@ func (m *Model) startThreadDescriptionEditor() tea.Cmd {
 
 // startTaskInfoDescriptionEditor opens the full-screen markdown description editor in preview mode from task-info.
 func (m *Model) startTaskInfoDescriptionEditor(task domain.Task) tea.Cmd {
+	if m.readOnly {
+		m.status = readOnlyStatus("edit description")
+		return nil
+	}
 	if m == nil {
 		return nil
 	}
@@ -3254,6 +3735,10 @@ func (m *Model) closeDescriptionEditor(saved bool) tea.Cmd {
 
 // startLabelsConfigForm opens a modal for editing global/project/branch/phase label defaults.
 func (m *Model) startLabelsConfigForm() tea.Cmd {
+	if m.readOnly {
+		m.status = readOnlyStatus("labels config")
+		return nil
+	}
 	project, ok := m.currentProject()
 	if !ok {
 		m.status = "no project selected"
@@ -3360,11 +3845,21 @@ func (m Model) taskFormValues() map[string]string {
 		out[key] = sanitizeFormFieldValue(m.formInputs[i].Value())
 	}
 	out["description"] = sanitizeFormFieldValue(m.taskFormDescription)
+	out["recur_every"] = m.taskFormRecurEvery
 	return out
 }
 
 // allowedLabelsForSelectedProject returns merged global + project-scoped allowed labels.
 func (m Model) allowedLabelsForSelectedProject() []string {
+	slug := ""
+	if project, ok := m.currentProject(); ok {
+		slug = project.Slug
+	}
+	return m.allowedLabelsForProject(slug)
+}
+
+// allowedLabelsForProject returns merged global + project-scoped allowed labels for one project slug.
+func (m Model) allowedLabelsForProject(projectSlug string) []string {
 	out := make([]string, 0)
 	seen := map[string]struct{}{}
 	appendUnique := func(labels []string) {
@@ -3381,15 +3876,13 @@ func (m Model) allowedLabelsForSelectedProject() []string {
 		}
 	}
 	appendUnique(m.allowedLabelGlobal)
-	if project, ok := m.currentProject(); ok {
-		appendUnique(m.allowedLabelProject[strings.TrimSpace(strings.ToLower(project.Slug))])
-	}
+	appendUnique(m.allowedLabelProject[strings.TrimSpace(strings.ToLower(projectSlug))])
 	sort.Strings(out)
 	return out
 }
 
 // projectFormFields stores a package-level helper value.
-var projectFormFields = []string{"name", "description", "owner", "icon", "color", "homepage", "tags", "root_path"}
+var projectFormFields = []string{"name", "description", "owner", "icon", "color", "homepage", "tags", "root_path", "columns"}
 
 // projectFormValues returns project form values.
 func (m Model) projectFormValues() map[string]string {
@@ -3676,39 +4169,33 @@ func (m Model) buildTaskMetadataFromForm(vals map[string]string, current domain.
 	default:
 		meta.RiskNotes = riskNotes
 	}
+	meta.Assignee = parseAssigneeInput(vals["assignee"], current.Assignee)
 	meta.ResourceRefs = append([]domain.ResourceRef(nil), m.taskFormResourceRefs...)
+	if recurEvery, ok := vals["recur_every"]; ok {
+		meta.RecurEvery = recurEvery
+	}
 	return meta
 }
 
-// validateAllowedLabels enforces label allowlists when configured.
+// validateAllowedLabels checks labels against the selected project's allowlist when enforcement is configured,
+// so a form can stay open on a rejected label instead of failing in app.Service.
 func (m Model) validateAllowedLabels(labels []string) error {
-	if !m.enforceAllowedLabels || len(labels) == 0 {
+	if !m.enforceAllowedLabels {
 		return nil
 	}
-	allowed := m.allowedLabelsForSelectedProject()
-	if len(allowed) == 0 {
-		return fmt.Errorf("no labels configured for current project; disable labels.enforce_allowed to allow free-form labels")
-	}
-	allowedSet := map[string]struct{}{}
-	for _, label := range allowed {
-		allowedSet[strings.TrimSpace(strings.ToLower(label))] = struct{}{}
-	}
-	disallowed := make([]string, 0)
-	for _, raw := range labels {
-		label := strings.TrimSpace(strings.ToLower(raw))
-		if label == "" {
-			continue
-		}
-		if _, ok := allowedSet[label]; ok {
-			continue
-		}
-		disallowed = append(disallowed, label)
+	slug := ""
+	if project, ok := m.currentProject(); ok {
+		slug = project.Slug
 	}
-	if len(disallowed) == 0 {
-		return nil
+	return app.CheckLabelsAllowed(slug, labels, m.allowedLabelsForSelectedProject())
+}
+
+// withLabelAllowlist asks app.Service to enforce the configured label allowlists on the task write ctx carries.
+func (m Model) withLabelAllowlist(ctx context.Context) context.Context {
+	if !m.enforceAllowedLabels {
+		return ctx
 	}
-	sort.Strings(disallowed)
-	return fmt.Errorf("labels not allowed: %s", strings.Join(disallowed, ", "))
+	return app.WithLabelAllowlist(ctx, m.allowedLabelsForProject)
 }
 
 // canonicalSearchStates normalizes configured and user-selected search states.
@@ -3858,7 +4345,7 @@ func (m Model) isNoticesPanelVisible() bool {
 	if len(m.columns) == 0 {
 		return false
 	}
-	layoutWidth := max(0, m.width-2*tuiOuterHorizontalPadding)
+	layoutWidth := max(0, m.width-2*m.outerHorizontalPadding())
 	return m.noticesPanelWidth(layoutWidth) > 0
 }
 
@@ -4013,8 +4500,11 @@ func windowBounds(total, selected, windowSize int) (int, int) {
 
 // applySearchFilter applies current search values and returns the follow-up command.
 func (m *Model) applySearchFilter() tea.Cmd {
+	if ok, cmd := m.applySearchDateWindows(); !ok {
+		return cmd
+	}
 	m.mode = modeNone
-	m.searchInput.Blur()
+	m.blurSearchInputs()
 	m.searchQuery = strings.TrimSpace(m.searchInput.Value())
 	m.searchStates = canonicalSearchStates(m.searchStates)
 	m.searchLevels = canonicalSearchLevels(m.searchLevels)
@@ -4024,7 +4514,7 @@ func (m *Model) applySearchFilter() tea.Cmd {
 	if m.searchCrossProject {
 		return m.loadSearchMatches
 	}
-	return m.loadData
+	return m.requestReload()
 }
 
 // clearSearchQuery clears only the search query.
@@ -4036,7 +4526,7 @@ func (m *Model) clearSearchQuery() tea.Cmd {
 	if m.searchCrossProject {
 		return m.loadSearchMatches
 	}
-	return m.loadData
+	return m.requestReload()
 }
 
 // resetSearchFilters resets query and filters back to defaults.
@@ -4045,19 +4535,29 @@ func (m *Model) resetSearchFilters() tea.Cmd {
 	m.searchInput.SetValue("")
 	m.searchCrossProject = m.searchDefaultCrossProject
 	m.searchIncludeArchived = m.searchDefaultIncludeArchive
+	m.searchIncludeComments = false
 	m.searchStates = canonicalSearchStates(m.searchDefaultStates)
 	m.searchLevels = canonicalSearchLevels(m.searchDefaultLevels)
 	m.searchKinds = nil
 	m.searchLabelsAny = nil
 	m.searchLabelsAll = nil
+	m.searchDueFromInput.SetValue("")
+	m.searchDueToInput.SetValue("")
+	m.searchDueAfter = time.Time{}
+	m.searchDueBefore = time.Time{}
+	m.searchCreatedFromInput.SetValue("")
+	m.searchCreatedToInput.SetValue("")
+	m.searchCreatedAfter = time.Time{}
+	m.searchCreatedBefore = time.Time{}
 	m.searchApplied = false
 	m.status = "filters reset"
-	return m.loadData
+	return m.requestReload()
 }
 
 // applyRuntimeConfig applies runtime-updateable settings from a reload callback.
 func (m *Model) applyRuntimeConfig(cfg RuntimeConfig) {
 	WithRuntimeConfig(cfg)(m)
+	m.applyProjectProfile()
 	if actorID := strings.TrimSpace(cfg.Identity.ActorID); actorID != "" {
 		m.identityActorID = actorID
 	}
@@ -4180,6 +4680,7 @@ func (m Model) saveProjectRootCmd(projectSlug, rootPath string) tea.Cmd {
 func commandPaletteItems() []commandPaletteItem {
 	return []commandPaletteItem{
 		{Command: "new-task", Aliases: []string{"task-new"}, Description: "create a new task"},
+		{Command: "new-from-template", Aliases: []string{"template", "task-template"}, Description: "create a new task pre-filled from a configured template"},
 		{Command: "new-subtask", Aliases: []string{"task-subtask"}, Description: "create subtask for selected item"},
 		{Command: "new-branch", Aliases: []string{"branch-new"}, Description: "create a new branch"},
 		{Command: "new-phase", Aliases: []string{"phase-new"}, Description: "create a new phase"},
@@ -4187,6 +4688,9 @@ func commandPaletteItems() []commandPaletteItem {
 		{Command: "archive-branch", Aliases: []string{"branch-archive"}, Description: "archive selected branch"},
 		{Command: "delete-branch", Aliases: []string{"branch-delete"}, Description: "hard delete selected branch"},
 		{Command: "restore-branch", Aliases: []string{"branch-restore"}, Description: "restore selected archived branch"},
+		{Command: "convert-to-branch", Aliases: []string{"promote-branch"}, Description: "convert selected item to a branch, keeping its children"},
+		{Command: "convert-to-phase", Aliases: []string{"promote-phase"}, Description: "convert selected item to a phase, keeping its children"},
+		{Command: "convert-to-task", Aliases: []string{"demote-task"}, Description: "convert selected branch or phase back to a task"},
 		{Command: "edit-task", Aliases: []string{"task-edit"}, Description: "edit selected task"},
 		{Command: "thread-item", Aliases: []string{"item-thread", "task-thread"}, Description: "open selected work-item thread"},
 		{Command: "new-project", Aliases: []string{"project-new"}, Description: "create a new project"},
@@ -4200,6 +4704,8 @@ func commandPaletteItems() []commandPaletteItem {
 		{Command: "search-project", Aliases: []string{}, Description: "set search scope to current project"},
 		{Command: "clear-query", Aliases: []string{"clear-search-query"}, Description: "clear search text only"},
 		{Command: "reset-filters", Aliases: []string{"clear-search"}, Description: "reset query + states + scope + archived"},
+		{Command: "save-search", Aliases: []string{"search-save"}, Description: "save the current search filters under a name"},
+		{Command: "load-search", Aliases: []string{"search-load"}, Description: "apply and run a saved search"},
 		{Command: "toggle-archived", Aliases: []string{}, Description: "toggle archived visibility"},
 		{Command: "toggle-selection-mode", Aliases: []string{"select-mode", "text-select"}, Description: "toggle mouse text-selection mode"},
 		{Command: "focus-subtree", Aliases: []string{"zoom-task"}, Description: "show selected task subtree only"},
@@ -4208,7 +4714,13 @@ func commandPaletteItems() []commandPaletteItem {
 		{Command: "clear-selection", Aliases: []string{"selection-clear"}, Description: "clear all selected tasks"},
 		{Command: "bulk-move-left", Aliases: []string{"move-left-selected"}, Description: "move selected tasks to previous column"},
 		{Command: "bulk-move-right", Aliases: []string{"move-right-selected"}, Description: "move selected tasks to next column"},
+		{Command: "archive-subtree", Aliases: []string{"archive-tree"}, Description: "archive selected task with all subtasks"},
+		{Command: "restore-subtree", Aliases: []string{"restore-tree"}, Description: "restore archived task with all subtasks"},
+		{Command: "bulk-add-label", Aliases: []string{"label-selected"}, Description: "pick a label and add it to every selected task"},
+		{Command: "assign", Aliases: []string{"quick-assign", "assign-selected"}, Description: "assign the selected tasks (or focused task) to an owner"},
+		{Command: "bulk-remove-label", Aliases: []string{"unlabel-selected"}, Description: "pick a label and remove it from every selected task"},
 		{Command: "bulk-archive", Aliases: []string{"archive-selected"}, Description: "archive selected tasks"},
+		{Command: "archive-done", Aliases: []string{"archive-all-done", "sweep-done"}, Description: "archive every done task in the current project as one undoable batch"},
 		{Command: "bulk-delete", Aliases: []string{"delete-selected"}, Description: "hard delete selected tasks"},
 		{Command: "undo", Aliases: []string{}, Description: "undo last mutation"},
 		{Command: "redo", Aliases: []string{}, Description: "redo last undone mutation"},
@@ -4218,6 +4730,17 @@ func commandPaletteItems() []commandPaletteItem {
 		{Command: "labels-config", Aliases: []string{"labels", "edit-labels"}, Description: "edit global/project/branch/phase labels"},
 		{Command: "highlight-color", Aliases: []string{"set-highlight", "focus-color"}, Description: "set focused-row highlight color"},
 		{Command: "activity-log", Aliases: []string{"log"}, Description: "open recent activity modal"},
+		{Command: "inbox", Aliases: []string{"triage"}, Description: "triage undated tasks in the first column"},
+		{Command: "previous-project", Aliases: []string{"last-project", "alt-project"}, Description: "switch to the previously active project"},
+		{Command: "open-data-dir", Aliases: []string{"data-dir", "open-data"}, Description: "open the data directory in the file manager (shows the path when headless)"},
+		{Command: "go-to-column", Aliases: []string{"goto-column", "column"}, Description: "fuzzy-match a column name and focus it"},
+		{Command: "jump-to-task", Aliases: []string{"goto-id", "task-id"}, Description: "jump to a task by id across projects"},
+		{Command: "recent-tasks", Aliases: []string{"recent", "recently-viewed"}, Description: "pick a recently viewed task and jump back to it"},
+		{Command: "watched", Aliases: []string{"watchlist", "watched-tasks"}, Description: "list watched tasks across projects and jump to one"},
+		{Command: "toggle-watch", Aliases: []string{"watch", "unwatch"}, Description: "watch or unwatch the focused task"},
+		{Command: "verify-resources", Aliases: []string{"check-resources"}, Description: "check that local resource refs in the current project still exist and flag missing ones"},
+		{Command: "attach-url", Aliases: []string{"add-url", "link"}, Description: "attach an http(s) link to the selected task"},
+		{Command: "export-task", Aliases: []string{"task-card", "share-task"}, Description: "copy the selected task (optionally with subtasks) as a markdown or json card"},
 		{Command: "help", Aliases: []string{}, Description: "open help modal"},
 		{Command: "quit", Aliases: []string{"exit"}, Description: "quit tillsyn"},
 	}
@@ -4376,14 +4899,12 @@ func (m Model) commandToExecute() string {
 	return normalizeCommandPaletteToken(m.commandInput.Value())
 }
 
-// priorityIndex handles priority index.
+// priorityIndex returns the option index for one priority, falling back to medium.
 func priorityIndex(priority domain.Priority) int {
-	for i, p := range priorityOptions {
-		if p == priority {
-			return i
-		}
+	if idx := slices.Index(priorityOptions, priority); idx >= 0 {
+		return idx
 	}
-	return 1
+	return slices.Index(priorityOptions, domain.PriorityMedium)
 }
 
 // cyclePriority handles cycle priority.
@@ -4414,6 +4935,9 @@ func (m *Model) startDuePicker() {
 	m.duePickerTimeInput.SetValue("")
 	m.duePickerTimeInput.CursorEnd()
 	m.duePickerTimeInput.Blur()
+	m.duePickerRepeatInput.SetValue(m.taskFormRecurEvery)
+	m.duePickerRepeatInput.CursorEnd()
+	m.duePickerRepeatInput.Blur()
 	_ = m.duePickerDateInput.Focus()
 	m.duePickerIncludeTime = false
 	if len(m.formInputs) > taskFieldDue {
@@ -4655,10 +5179,11 @@ func parseDuePickerTimeToken(raw string) (int, int, bool) {
 }
 
 // duePickerFocusSlots returns the ordered focus slots for due-picker controls.
+// Slot 4 is the repeat input, which sits above the options list even though it was added last.
 func (m Model) duePickerFocusSlots() []int {
-	slots := []int{0, 1, 3}
+	slots := []int{0, 1, 4, 3}
 	if m.duePickerIncludeTime {
-		slots = []int{0, 1, 2, 3}
+		slots = []int{0, 1, 2, 4, 3}
 	}
 	return slots
 }
@@ -4667,6 +5192,7 @@ func (m Model) duePickerFocusSlots() []int {
 func (m *Model) focusDuePickerSlot(slot int) tea.Cmd {
 	m.duePickerDateInput.Blur()
 	m.duePickerTimeInput.Blur()
+	m.duePickerRepeatInput.Blur()
 	m.duePickerFocus = slot
 	switch slot {
 	case 1:
@@ -4677,11 +5203,24 @@ func (m *Model) focusDuePickerSlot(slot int) tea.Cmd {
 			return nil
 		}
 		return m.duePickerTimeInput.Focus()
+	case 4:
+		return m.duePickerRepeatInput.Focus()
 	default:
 		return nil
 	}
 }
 
+// applyDuePickerRepeat validates the due-picker repeat input into the task form, reporting false when it is invalid.
+func (m *Model) applyDuePickerRepeat() bool {
+	recurEvery, err := domain.NormalizeRecurrence(m.duePickerRepeatInput.Value())
+	if err != nil {
+		m.status = "repeat must be empty or an interval such as 3d, 1w, 1mo, or 1y"
+		return false
+	}
+	m.taskFormRecurEvery = recurEvery
+	return true
+}
+
 // cycleDuePickerFocus advances due-picker focus to the next/previous control.
 func (m *Model) cycleDuePickerFocus(delta int) tea.Cmd {
 	slots := m.duePickerFocusSlots()
@@ -4714,6 +5253,7 @@ func (m *Model) setDuePickerIncludeTime(enabled bool) tea.Cmd {
 // startLabelPicker opens a modal picker with inherited label suggestions.
 func (m *Model) startLabelPicker() tea.Cmd {
 	m.labelPickerBack = m.mode
+	m.labelPickerBulk = labelPickerBulkNone
 	m.mode = modeLabelPicker
 	m.labelPickerInput.SetValue("")
 	m.labelPickerInput.CursorEnd()
@@ -5197,13 +5737,17 @@ func (m Model) jumpToDependencyCandidateTask() (tea.Model, tea.Cmd) {
 	}
 	m.dependencyInput.Blur()
 	m.status = "jumping to dependency"
-	return m, m.loadData
+	cmd := m.requestReload()
+	return m, cmd
 }
 
 // updateTaskMetadataCmd persists one metadata update for the provided task fields.
 func (m Model) updateTaskMetadataCmd(task domain.Task, metadata domain.TaskMetadata, status string) tea.Cmd {
+	if m.readOnly {
+		return readOnlyBlockedCmd("edit task")
+	}
 	return func() tea.Msg {
-		_, err := m.svc.UpdateTask(context.Background(), app.UpdateTaskInput{
+		updated, err := m.svc.UpdateTask(context.Background(), app.UpdateTaskInput{
 			TaskID:      task.ID,
 			Title:       task.Title,
 			Description: task.Description,
@@ -5219,6 +5763,7 @@ func (m Model) updateTaskMetadataCmd(task domain.Task, metadata domain.TaskMetad
 			status:      status,
 			reload:      true,
 			focusTaskID: task.ID,
+			upsertTasks: []domain.Task{updated},
 		}
 	}
 }
@@ -5775,6 +6320,9 @@ func (m *Model) attachResourcePickerEntry(entry resourcePickerEntry) tea.Cmd {
 
 // attachResourceEntry persists one filesystem reference through task metadata update.
 func (m Model) attachResourceEntry(path string, isDir bool) tea.Cmd {
+	if m.readOnly {
+		return readOnlyBlockedCmd("attach resource")
+	}
 	taskID := strings.TrimSpace(m.resourcePickerTaskID)
 	root := strings.TrimSpace(m.resourcePickerRoot)
 	return func() tea.Msg {
@@ -5782,34 +6330,39 @@ func (m Model) attachResourceEntry(path string, isDir bool) tea.Cmd {
 		if err != nil {
 			return actionMsg{status: err.Error()}
 		}
-		task, ok := m.taskByID(taskID)
-		if !ok {
-			return actionMsg{status: "resource attach failed: task not found"}
-		}
-		ref := buildResourceRef(root, normalizedPath, isDir)
-		refs, added := appendResourceRefIfMissing(task.Metadata.ResourceRefs, ref)
-		if !added {
-			return actionMsg{status: "resource already attached"}
-		}
-		meta := task.Metadata
-		meta.ResourceRefs = refs
-		_, err = m.svc.UpdateTask(context.Background(), app.UpdateTaskInput{
-			TaskID:      task.ID,
-			Title:       task.Title,
-			Description: task.Description,
-			Priority:    task.Priority,
-			DueAt:       task.DueAt,
-			Labels:      append([]string(nil), task.Labels...),
-			Metadata:    &meta,
-		})
-		if err != nil {
-			return actionMsg{err: err}
-		}
-		return actionMsg{
-			status:      "resource attached",
-			reload:      true,
-			focusTaskID: task.ID,
-		}
+		return m.persistResourceRef(taskID, buildResourceRef(root, normalizedPath, isDir), "resource attached")
+	}
+}
+
+// persistResourceRef appends one resource ref to a task's metadata and saves it, skipping duplicates.
+func (m Model) persistResourceRef(taskID string, ref domain.ResourceRef, status string) tea.Msg {
+	task, ok := m.taskByID(taskID)
+	if !ok {
+		return actionMsg{status: "resource attach failed: task not found"}
+	}
+	refs, added := appendResourceRefIfMissing(task.Metadata.ResourceRefs, ref)
+	if !added {
+		return actionMsg{status: "resource already attached"}
+	}
+	meta := task.Metadata
+	meta.ResourceRefs = refs
+	updated, err := m.svc.UpdateTask(context.Background(), app.UpdateTaskInput{
+		TaskID:      task.ID,
+		Title:       task.Title,
+		Description: task.Description,
+		Priority:    task.Priority,
+		DueAt:       task.DueAt,
+		Labels:      append([]string(nil), task.Labels...),
+		Metadata:    &meta,
+	})
+	if err != nil {
+		return actionMsg{err: err}
+	}
+	return actionMsg{
+		status:      status,
+		reload:      true,
+		focusTaskID: task.ID,
+		upsertTasks: []domain.Task{updated},
 	}
 }
 
@@ -6176,6 +6729,8 @@ func (m Model) handleNormalModeKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 	case key.Matches(msg, m.keys.toggleHelp):
 		m.toggleHelpOverlay()
 		return m, nil
+	case key.Matches(msg, m.keys.footerHelp):
+		return m, m.cycleFooterHelp()
 	case msg.String() == "esc":
 		if m.help.ShowAll {
 			m.toggleHelpOverlay()
@@ -6190,7 +6745,8 @@ func (m Model) handleNormalModeKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 			m.searchApplied = false
 			m.searchQuery = ""
 			m.status = "search cleared"
-			return m, m.loadData
+			cmd := m.requestReload()
+			return m, cmd
 		}
 		if count := m.clearSelection(); count > 0 {
 			m.status = fmt.Sprintf("cleared %d selected tasks", count)
@@ -6209,7 +6765,8 @@ func (m Model) handleNormalModeKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	case key.Matches(msg, m.keys.reload):
 		m.status = "reloading..."
-		return m, m.loadData
+		cmd := m.requestReload()
+		return m, cmd
 	case isForwardTabKey(msg):
 		_ = m.cyclePanelFocus(1, true, true)
 		m.status = ""
@@ -6285,7 +6842,8 @@ func (m Model) handleBoardPanelNormalKey(msg tea.KeyPressMsg) (tea.Model, tea.Cm
 		if len(tasks) > 0 && m.selectedTask < len(tasks)-1 {
 			m.selectedTask++
 		}
-		return m, nil
+		cmd := m.nextColumnPageCmd()
+		return m, cmd
 	case key.Matches(msg, m.keys.moveUp):
 		if m.selectedTask > 0 {
 			m.selectedTask--
@@ -6315,6 +6873,17 @@ func (m Model) handleBoardPanelNormalKey(msg tea.KeyPressMsg) (tea.Model, tea.Cm
 		return m, nil
 	case key.Matches(msg, m.keys.activityLog):
 		return m, m.openActivityLog()
+	case key.Matches(msg, m.keys.inbox):
+		m.openInbox()
+		return m, nil
+	case key.Matches(msg, m.keys.previousProject):
+		return m.switchToPreviousProject()
+	case key.Matches(msg, m.keys.jumpToTask):
+		return m, m.startJumpToTaskMode()
+	case key.Matches(msg, m.keys.quickAssign):
+		return m, m.startAssignPicker()
+	case key.Matches(msg, m.keys.toggleWatch):
+		return m.toggleFocusedTaskWatch()
 	case key.Matches(msg, m.keys.undo):
 		return m.undoLastMutation()
 	case key.Matches(msg, m.keys.redo):
@@ -6397,6 +6966,10 @@ func (m Model) handleBoardPanelNormalKey(msg tea.KeyPressMsg) (tea.Model, tea.Cm
 			return m.moveSelectedTasks(1)
 		}
 		return m.moveSelectedTask(1)
+	case key.Matches(msg, m.keys.moveColumnLeft):
+		return m.moveFocusedColumn(-1)
+	case key.Matches(msg, m.keys.moveColumnRight):
+		return m.moveFocusedColumn(1)
 	case key.Matches(msg, m.keys.deleteTask):
 		return m.confirmDeleteAction(m.defaultDeleteMode, m.confirmDelete, "delete task")
 	case key.Matches(msg, m.keys.hardDeleteTask):
@@ -6412,7 +6985,8 @@ func (m Model) handleBoardPanelNormalKey(msg tea.KeyPressMsg) (tea.Model, tea.Cm
 		}
 		m.selectedTask = 0
 		m.clearSelection()
-		return m, m.loadData
+		cmd := m.requestReload()
+		return m, cmd
 	default:
 		return m, nil
 	}
@@ -6472,6 +7046,66 @@ func (m Model) handleInputModeKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 		}
 	}
 
+	if m.mode == modeInbox {
+		return m.handleInboxKey(msg)
+	}
+
+	if m.mode == modeJumpToTask {
+		return m.handleJumpToTaskKey(msg)
+	}
+
+	if m.mode == modeRecentTasks {
+		return m.handleRecentTasksKey(msg)
+	}
+
+	if m.mode == modeWatchedTasks {
+		return m.handleWatchedTasksKey(msg)
+	}
+
+	if m.mode == modeExportTask {
+		return m.handleExportTaskKey(msg)
+	}
+
+	if m.mode == modeGoToColumn {
+		return m.handleGoToColumnKey(msg)
+	}
+
+	if m.mode == modeTemplatePicker {
+		return m.handleTemplatePickerKey(msg)
+	}
+
+	if m.mode == modeSavedSearch {
+		return m.handleSavedSearchKey(msg)
+	}
+
+	if m.mode == modeAssignPicker {
+		return m.handleAssignPickerKey(msg)
+	}
+
+	if m.mode == modeAttachURL {
+		return m.handleAttachURLKey(msg)
+	}
+
+	if m.mode == modeEditColumn {
+		return m.handleColumnEditKey(msg)
+	}
+
+	if m.mode == modeRecoverDraft {
+		return m.handleRecoverDraftKey(msg)
+	}
+
+	if m.mode == modeDuplicateTitle {
+		return m.handleDuplicateTitleKey(msg)
+	}
+
+	if m.mode == modeCatchUp {
+		return m.handleCatchUpKey(msg)
+	}
+
+	if m.mode == modeDueDigest {
+		return m.handleDueDigestKey(msg)
+	}
+
 	if m.mode == modeDescriptionEditor {
 		if m.descriptionEditorMode == descriptionEditorViewModeEdit {
 			if handled, status := applyClipboardShortcutToTextArea(msg, &m.descriptionEditorInput); handled {
@@ -6583,6 +7217,9 @@ func (m Model) handleInputModeKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 			if m.threadPanelFocus != threadPanelComments {
 				return m, nil
 			}
+			if m.readOnly {
+				return m.readOnlyBlocked("comment")
+			}
 			m.threadComposerActive = true
 			m.resetThreadComposerHistory()
 			m.status = "ready"
@@ -6731,6 +7368,9 @@ func (m Model) handleInputModeKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 			case threadPanelDetails:
 				return m.startThreadEditFlow()
 			case threadPanelComments:
+				if m.readOnly {
+					return m.readOnlyBlocked("comment")
+				}
 				m.threadComposerActive = true
 				m.resetThreadComposerHistory()
 				m.status = "ready"
@@ -6783,6 +7423,10 @@ func (m Model) handleInputModeKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 			return m, nil
 		case msg.String() == "d":
 			return m, m.startTaskInfoDescriptionEditor(task)
+		case msg.String() == "r":
+			m.toggleTaskInfoDescriptionRaw()
+			m.syncTaskInfoDetailsViewport(task)
+			return m, nil
 		case msg.String() == "j" || msg.String() == "down":
 			m.taskInfoBody.ScrollDown(1)
 			if len(subtasks) > 0 && m.taskInfoSubtaskIdx < len(subtasks)-1 {
@@ -6809,8 +7453,36 @@ func (m Model) handleInputModeKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 			return m, m.startTaskForm(&task)
 		case msg.String() == "s":
 			return m, m.startSubtaskForm(task)
+		case msg.String() == "u":
+			return m, m.startAttachURLForm(task.ID, modeTaskInfo)
+		case msg.Code == tea.KeyTab || msg.String() == "tab" || msg.String() == "shift+tab" || msg.String() == "backtab":
+			if count := len(task.Metadata.ResourceRefs); count > 0 {
+				step := 1
+				if msg.String() == "shift+tab" || msg.String() == "backtab" {
+					step = count - 1
+				}
+				m.taskInfoResourceIdx = (clamp(m.taskInfoResourceIdx, 0, count-1) + step) % count
+				m.syncTaskInfoBodyViewport(task)
+			}
+			return m, nil
+		case msg.String() == "o":
+			refs := task.Metadata.ResourceRefs
+			if len(refs) == 0 {
+				m.status = "no resources to open"
+				return m, nil
+			}
+			return m, m.openResourceRefCmd(task, refs[clamp(m.taskInfoResourceIdx, 0, len(refs)-1)])
 		case msg.String() == "c":
 			return m.startTaskThread(task, modeTaskInfo)
+		case msg.String() == "x":
+			m.startTaskCardExport(task.ID, modeTaskInfo)
+			return m, nil
+		case msg.String() == "y":
+			return m, copyTaskTextCmd("task id", task.ID)
+		case msg.String() == "Y":
+			return m, copyTaskTextCmd("task path", m.taskHierarchyPath(task))
+		case msg.String() == "t":
+			return m.cycleTaskLifecycleState(task)
 		case msg.String() == " " || msg.String() == "space":
 			return m.toggleFocusedSubtaskCompletion(task)
 		case msg.String() == "[":
@@ -7128,8 +7800,14 @@ func (m Model) handleInputModeKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 		switch {
 		case msg.String() == "esc":
 			m.mode = modeNone
+			m.projectPickerMarked = nil
 			m.status = "cancelled"
 			return m, nil
+		case msg.String() == "space" || msg.String() == " ":
+			m.toggleProjectPickerMark()
+			return m, nil
+		case msg.String() == "x":
+			return m, m.exportPickerProjectsCmd()
 		case msg.String() == "A" || msg.String() == "shift+a":
 			m.showArchivedProjects = !m.showArchivedProjects
 			if m.showArchivedProjects {
@@ -7137,7 +7815,8 @@ func (m Model) handleInputModeKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 			} else {
 				m.status = "hiding archived projects"
 			}
-			return m, m.loadData
+			cmd := m.requestReload()
+			return m, cmd
 		case key.Matches(msg, m.keys.newProject):
 			return m, m.startProjectForm(nil)
 		case msg.String() == "j" || msg.String() == "down" || msg.String() == "right":
@@ -7158,17 +7837,18 @@ func (m Model) handleInputModeKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 			m.selectedColumn = 0
 			m.selectedTask = 0
 			m.mode = modeNone
+			m.projectPickerMarked = nil
 			m.status = ""
-			return m, m.loadData
+			cmd := m.requestReload()
+			return m, cmd
 		default:
 			return m, nil
 		}
 	}
 
 	if m.mode == modeSearch {
-		const searchFocusSlots = 6
-		if m.searchFocus == 0 {
-			if handled, status := applyClipboardShortcutToInput(msg, &m.searchInput); handled {
+		if in := m.searchFocusedTextInput(); in != nil {
+			if handled, status := applyClipboardShortcutToInput(msg, in); handled {
 				m.status = status
 				m.searchQuery = strings.TrimSpace(m.searchInput.Value())
 				return m, nil
@@ -7177,62 +7857,38 @@ func (m Model) handleInputModeKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 		switch {
 		case msg.Code == tea.KeyEscape || msg.String() == "esc":
 			m.mode = modeNone
-			m.searchInput.Blur()
+			m.blurSearchInputs()
 			m.status = "cancelled"
 			return m, nil
 		case msg.Code == tea.KeyTab || msg.String() == "tab" || msg.String() == "ctrl+i":
 			m.searchFocus = wrapIndex(m.searchFocus, 1, searchFocusSlots)
-			if m.searchFocus == 0 {
-				return m, m.searchInput.Focus()
-			}
-			m.searchInput.Blur()
-			return m, nil
+			return m, m.syncSearchInputFocus()
 		case msg.String() == "shift+tab" || msg.String() == "backtab":
 			m.searchFocus = wrapIndex(m.searchFocus, -1, searchFocusSlots)
-			if m.searchFocus == 0 {
-				return m, m.searchInput.Focus()
-			}
-			m.searchInput.Blur()
-			return m, nil
+			return m, m.syncSearchInputFocus()
 		case msg.String() == "down":
 			m.searchFocus = wrapIndex(m.searchFocus, 1, searchFocusSlots)
-			if m.searchFocus == 0 {
-				return m, m.searchInput.Focus()
-			}
-			m.searchInput.Blur()
-			return m, nil
+			return m, m.syncSearchInputFocus()
 		case msg.String() == "up":
 			m.searchFocus = wrapIndex(m.searchFocus, -1, searchFocusSlots)
-			if m.searchFocus == 0 {
-				return m, m.searchInput.Focus()
-			}
-			m.searchInput.Blur()
-			return m, nil
-		case msg.String() == "j" && m.searchFocus != 0:
+			return m, m.syncSearchInputFocus()
+		case msg.String() == "j" && !m.searchFocusTakesText():
 			m.searchFocus = wrapIndex(m.searchFocus, 1, searchFocusSlots)
-			if m.searchFocus == 0 {
-				return m, m.searchInput.Focus()
-			}
-			m.searchInput.Blur()
-			return m, nil
-		case msg.String() == "k" && m.searchFocus != 0:
+			return m, m.syncSearchInputFocus()
+		case msg.String() == "k" && !m.searchFocusTakesText():
 			m.searchFocus = wrapIndex(m.searchFocus, -1, searchFocusSlots)
-			if m.searchFocus == 0 {
-				return m, m.searchInput.Focus()
-			}
-			m.searchInput.Blur()
-			return m, nil
-		case msg.String() == "ctrl+p" && m.searchFocus != 0:
+			return m, m.syncSearchInputFocus()
+		case msg.String() == "ctrl+p" && !m.searchFocusTakesText():
 			m.searchCrossProject = !m.searchCrossProject
 			return m, nil
-		case msg.String() == "ctrl+a" && m.searchFocus != 0:
+		case msg.String() == "ctrl+a" && !m.searchFocusTakesText():
 			m.searchIncludeArchived = !m.searchIncludeArchived
 			return m, nil
-		case msg.String() == "ctrl+u" && m.searchFocus != 0:
+		case msg.String() == "ctrl+u" && !m.searchFocusTakesText():
 			return m, m.clearSearchQuery()
-		case msg.String() == "ctrl+r" && m.searchFocus != 0:
+		case msg.String() == "ctrl+r" && !m.searchFocusTakesText():
 			return m, m.resetSearchFilters()
-		case (msg.String() == "h" || msg.String() == "left") && m.searchFocus != 0:
+		case (msg.String() == "h" || msg.String() == "left") && !m.searchFocusTakesText():
 			switch m.searchFocus {
 			case 1:
 				m.searchStateCursor = wrapIndex(m.searchStateCursor, -1, len(canonicalSearchStatesOrdered))
@@ -7242,9 +7898,11 @@ func (m Model) handleInputModeKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 				m.searchCrossProject = !m.searchCrossProject
 			case 4:
 				m.searchIncludeArchived = !m.searchIncludeArchived
+			case 5:
+				m.searchIncludeComments = !m.searchIncludeComments
 			}
 			return m, nil
-		case (msg.String() == "l" || msg.String() == "right") && m.searchFocus != 0:
+		case (msg.String() == "l" || msg.String() == "right") && !m.searchFocusTakesText():
 			switch m.searchFocus {
 			case 1:
 				m.searchStateCursor = wrapIndex(m.searchStateCursor, 1, len(canonicalSearchStatesOrdered))
@@ -7254,9 +7912,11 @@ func (m Model) handleInputModeKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 				m.searchCrossProject = !m.searchCrossProject
 			case 4:
 				m.searchIncludeArchived = !m.searchIncludeArchived
+			case 5:
+				m.searchIncludeComments = !m.searchIncludeComments
 			}
 			return m, nil
-		case (msg.String() == " " || msg.String() == "space") && m.searchFocus != 0:
+		case (msg.String() == " " || msg.String() == "space") && !m.searchFocusTakesText():
 			switch m.searchFocus {
 			case 1:
 				if len(canonicalSearchStatesOrdered) > 0 {
@@ -7272,6 +7932,8 @@ func (m Model) handleInputModeKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 				m.searchCrossProject = !m.searchCrossProject
 			case 4:
 				m.searchIncludeArchived = !m.searchIncludeArchived
+			case 5:
+				m.searchIncludeComments = !m.searchIncludeComments
 			}
 			return m, nil
 		case msg.Code == tea.KeyEnter || msg.String() == "enter":
@@ -7294,19 +7956,22 @@ func (m Model) handleInputModeKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 			case 4:
 				m.searchIncludeArchived = !m.searchIncludeArchived
 				return m, nil
+			case 5:
+				m.searchIncludeComments = !m.searchIncludeComments
+				return m, nil
 			default:
 				return m, m.applySearchFilter()
 			}
 		default:
-			if m.searchFocus == 0 {
-				var cmd tea.Cmd
-				m.searchInput, cmd = m.searchInput.Update(msg)
-				_ = scrubTextInputTerminalArtifacts(&m.searchInput)
-				m.searchQuery = strings.TrimSpace(m.searchInput.Value())
-				return m, cmd
-			} else {
+			in := m.searchFocusedTextInput()
+			if in == nil {
 				return m, nil
 			}
+			var cmd tea.Cmd
+			*in, cmd = in.Update(msg)
+			_ = scrubTextInputTerminalArtifacts(in)
+			m.searchQuery = strings.TrimSpace(m.searchInput.Value())
+			return m, cmd
 		}
 	}
 
@@ -7342,7 +8007,8 @@ func (m Model) handleInputModeKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 			m.pendingFocusTaskID = match.Task.ID
 			m.mode = modeNone
 			m.status = "jumped to match"
-			return m, m.loadData
+			cmd := m.requestReload()
+			return m, cmd
 		default:
 			return m, nil
 		}
@@ -7398,9 +8064,7 @@ func (m Model) handleInputModeKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 	if m.mode == modeConfirmAction {
 		switch msg.String() {
 		case "esc", "n":
-			m.mode = modeNone
-			m.pendingConfirm = confirmAction{}
-			m.status = "cancelled"
+			m.cancelConfirmAction()
 			return m, nil
 		case "h", "left", "l", "right":
 			if m.confirmChoice == 0 {
@@ -7418,9 +8082,7 @@ func (m Model) handleInputModeKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 			return m.applyConfirmedAction(action)
 		case "enter":
 			if m.confirmChoice == 1 {
-				m.mode = modeNone
-				m.pendingConfirm = confirmAction{}
-				m.status = "cancelled"
+				m.cancelConfirmAction()
 				return m, nil
 			}
 			m.mode = modeNone
@@ -7482,6 +8144,12 @@ func (m Model) handleInputModeKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 				return m, nil
 			}
 		}
+		if m.duePickerFocus == 4 {
+			if handled, status := applyClipboardShortcutToInput(msg, &m.duePickerRepeatInput); handled {
+				m.status = status
+				return m, nil
+			}
+		}
 		options := m.duePickerOptions()
 		switch msg.String() {
 		case "esc":
@@ -7517,6 +8185,7 @@ func (m Model) handleInputModeKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 			}
 			m.duePickerDateInput.Blur()
 			m.duePickerTimeInput.Blur()
+			m.duePickerRepeatInput.Blur()
 			m.duePickerFocus = 3
 			if m.duePicker < len(options)-1 {
 				m.duePicker++
@@ -7531,6 +8200,7 @@ func (m Model) handleInputModeKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 			}
 			m.duePickerDateInput.Blur()
 			m.duePickerTimeInput.Blur()
+			m.duePickerRepeatInput.Blur()
 			m.duePickerFocus = 3
 			if m.duePicker > 0 {
 				m.duePicker--
@@ -7546,12 +8216,18 @@ func (m Model) handleInputModeKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 				m.duePickerTimeInput.SetValue("")
 				m.duePickerTimeInput.CursorEnd()
 				m.duePicker = 0
+			case 4:
+				m.duePickerRepeatInput.SetValue("")
+				m.duePickerRepeatInput.CursorEnd()
 			}
 			return m, nil
 		case "enter":
 			if m.duePickerFocus == 0 {
 				return m, m.setDuePickerIncludeTime(!m.duePickerIncludeTime)
 			}
+			if !m.applyDuePickerRepeat() {
+				return m, m.focusDuePickerSlot(4)
+			}
 			if len(options) == 0 || len(m.formInputs) <= taskFieldDue {
 				m.mode = m.pickerBack
 				m.pickerBack = modeNone
@@ -7586,6 +8262,11 @@ func (m Model) handleInputModeKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 					m.duePicker = 0
 				}
 				return m, cmd
+			case 4:
+				var cmd tea.Cmd
+				m.duePickerRepeatInput, cmd = m.duePickerRepeatInput.Update(msg)
+				_ = scrubTextInputTerminalArtifacts(&m.duePickerRepeatInput)
+				return m, cmd
 			default:
 				return m, nil
 			}
@@ -7704,6 +8385,7 @@ func (m Model) handleInputModeKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 		case "esc":
 			m.mode = m.labelPickerBack
 			m.labelPickerInput.Blur()
+			m.labelPickerBulk = labelPickerBulkNone
 			m.status = "label picker cancelled"
 			if m.mode == modeAddTask || m.mode == modeEditTask {
 				return m, m.focusTaskFormField(taskFieldLabels)
@@ -7726,6 +8408,23 @@ func (m Model) handleInputModeKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 			}
 			return m, nil
 		case "enter":
+			if action := m.labelPickerBulk; action != labelPickerBulkNone {
+				label := ""
+				if len(m.labelPickerItems) > 0 {
+					label = m.labelPickerItems[clamp(m.labelPickerIndex, 0, len(m.labelPickerItems)-1)].Label
+				} else if action == labelPickerBulkAdd {
+					// Bulk add accepts a typed label that no suggestion matches yet.
+					label = m.labelPickerInput.Value()
+				}
+				if strings.TrimSpace(label) == "" {
+					m.status = "no label chosen"
+					return m, nil
+				}
+				m.mode = m.labelPickerBack
+				m.labelPickerInput.Blur()
+				m.labelPickerBulk = labelPickerBulkNone
+				return m.applyBulkLabel(action, label)
+			}
 			if len(m.labelPickerItems) == 0 || len(m.formInputs) <= taskFieldLabels {
 				m.mode = m.labelPickerBack
 				m.labelPickerInput.Blur()
@@ -7788,6 +8487,7 @@ func (m Model) handleInputModeKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 		}
 		switch {
 		case msg.Code == tea.KeyEscape || msg.String() == "esc":
+			draftPath := m.taskFormDraftPath()
 			m.mode = modeNone
 			m.formInputs = nil
 			m.formFocus = 0
@@ -7797,11 +8497,17 @@ func (m Model) handleInputModeKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 			m.taskFormKind = domain.WorkKindTask
 			m.taskFormScope = domain.KindAppliesToTask
 			m.taskFormResourceRefs = nil
+			m.taskFormRecurEvery = ""
 			m.taskFormSubtaskCursor = 0
 			m.taskFormResourceCursor = 0
 			m.taskFormResourceEditIndex = -1
 			m.status = "cancelled"
-			return m, nil
+			// A draft put off at open survives the cancel unless this form already autosaved over it.
+			if m.taskFormDraftDeferred && m.taskFormDraftSaved == "" {
+				m.taskFormDraftDeferred = false
+				return m, nil
+			}
+			return m, m.discardTaskFormDraftCmd(draftPath)
 		case msg.Code == tea.KeyTab || msg.String() == "tab" || msg.String() == "ctrl+i":
 			return m, m.moveTaskFormFocus(1, false)
 		case msg.String() == "shift+tab" || msg.String() == "backtab":
@@ -7822,6 +8528,8 @@ func (m Model) handleInputModeKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
 			}
 			m.syncTaskFormViewportToFocus()
 			return m, nil
+		case m.formFocus == taskFieldResources && msg.String() == "u":
+			return m, m.startAttachURLForm(m.editingTaskID, m.mode)
 		case msg.String() == "down":
 			return m, m.moveTaskFormFocus(1, true)
 		case msg.String() == "up":
@@ -8122,6 +8830,13 @@ func spliceRunes(value string, runePos int, insert string) (string, int) {
 
 // submitInputMode submits input mode.
 func (m Model) submitInputMode() (tea.Model, tea.Cmd) {
+	if m.readOnly {
+		switch m.mode {
+		case modeAddTask, modeEditTask, modeRenameTask, modeAddProject, modeEditProject, modeLabelsConfig:
+			m.mode = modeNone
+			return m.readOnlyBlocked("save")
+		}
+	}
 	switch m.mode {
 	case modeAddTask:
 		if text := strings.TrimSpace(m.input); text != "" {
@@ -8143,10 +8858,8 @@ func (m Model) submitInputMode() (tea.Model, tea.Cmd) {
 		if priority == "" {
 			priority = domain.PriorityMedium
 		}
-		switch priority {
-		case domain.PriorityLow, domain.PriorityMedium, domain.PriorityHigh:
-		default:
-			m.status = "priority must be low|medium|high"
+		if !domain.IsValidPriority(priority) {
+			m.status = priorityValidationStatus
 			return m, nil
 		}
 		dueAt, err := parseDueInput(vals["due"], nil)
@@ -8159,7 +8872,25 @@ func (m Model) submitInputMode() (tea.Model, tea.Cmd) {
 			m.status = err.Error()
 			return m, nil
 		}
+		reminders, err := parseRemindersInput(vals["reminders"], nil)
+		if err != nil {
+			m.status = err.Error()
+			return m, nil
+		}
+		// Pause once on likely duplicates; confirming the warning resubmits with the check skipped.
+		if m.warnDuplicateTitles && !m.duplicateTitleConfirmed {
+			if similar := m.similarTaskTitles(title); len(similar) > 0 {
+				m.duplicateTitleMatches = similar
+				m.mode = modeDuplicateTitle
+				m.help.ShowAll = false
+				m.status = "similar task exists"
+				return m, nil
+			}
+		}
+		m.duplicateTitleConfirmed = false
 		metadata := m.buildTaskMetadataFromForm(vals, domain.TaskMetadata{})
+		metadata.Reminders = reminders
+		draftPath := m.taskFormDraftPath()
 		parentID := m.taskFormParentID
 		kind := m.taskFormKind
 		scope := m.taskFormScope
@@ -8171,12 +8902,13 @@ func (m Model) submitInputMode() (tea.Model, tea.Cmd) {
 		m.taskFormKind = domain.WorkKindTask
 		m.taskFormScope = domain.KindAppliesToTask
 		m.taskFormResourceRefs = nil
+		m.taskFormRecurEvery = ""
 		m.taskFormSubtaskCursor = 0
 		m.taskFormResourceCursor = 0
 		m.taskFormResourceEditIndex = -1
 		m.traceFormControlCharacterGuard("task", "create", "title", title)
 		m.traceFormControlCharacterGuard("task", "create", "description", vals["description"])
-		return m.createTask(app.CreateTaskInput{
+		updated, cmd := m.createTask(app.CreateTaskInput{
 			ParentID:    parentID,
 			Kind:        kind,
 			Scope:       scope,
@@ -8187,6 +8919,11 @@ func (m Model) submitInputMode() (tea.Model, tea.Cmd) {
 			Labels:      labels,
 			Metadata:    metadata,
 		})
+		next, ok := updated.(Model)
+		if !ok {
+			return updated, cmd
+		}
+		return next, next.discardTaskFormDraftOnSuccess(draftPath, cmd)
 	case modeSearch:
 		return m, m.applySearchFilter()
 	case modeRenameTask:
@@ -8204,11 +8941,16 @@ func (m Model) submitInputMode() (tea.Model, tea.Cmd) {
 		}
 		taskID := task.ID
 		return m, func() tea.Msg {
-			_, err := m.svc.RenameTask(context.Background(), taskID, text)
+			renamed, err := m.svc.RenameTask(context.Background(), taskID, text)
 			if err != nil {
 				return actionMsg{err: err}
 			}
-			return actionMsg{status: "task renamed", reload: true}
+			return actionMsg{
+				status:      "task renamed",
+				reload:      true,
+				upsertTasks: []domain.Task{renamed},
+				historyPush: taskEditHistory("rename task", task, renamed),
+			}
 		}
 	case modeEditTask:
 		vals := m.taskFormValues()
@@ -8239,19 +8981,25 @@ func (m Model) submitInputMode() (tea.Model, tea.Cmd) {
 			m.input = ""
 			m.editingTaskID = ""
 			m.taskFormResourceRefs = nil
+			m.taskFormRecurEvery = ""
 			m.taskFormSubtaskCursor = 0
 			m.taskFormResourceCursor = 0
 			m.taskFormResourceEditIndex = -1
 			in.TaskID = taskID
 			m.traceFormControlCharacterGuard("task", "update", "title", in.Title)
 			m.traceFormControlCharacterGuard("task", "update", "description", in.Description)
-			return m, func() tea.Msg {
-				_, updateErr := m.svc.UpdateTask(context.Background(), in)
+			return m, m.discardTaskFormDraftOnSuccess(m.taskFormDraftPath(), func() tea.Msg {
+				updated, updateErr := m.svc.UpdateTask(m.withLabelAllowlist(context.Background()), in)
 				if updateErr != nil {
 					return actionMsg{err: updateErr}
 				}
-				return actionMsg{status: "task updated", reload: true}
-			}
+				return actionMsg{
+					status:      "task updated",
+					reload:      true,
+					upsertTasks: []domain.Task{updated},
+					historyPush: taskEditHistory("edit task", task, updated),
+				}
+			})
 		}
 
 		title := vals["title"]
@@ -8264,10 +9012,8 @@ func (m Model) submitInputMode() (tea.Model, tea.Cmd) {
 		if priority == "" {
 			priority = task.Priority
 		}
-		switch priority {
-		case domain.PriorityLow, domain.PriorityMedium, domain.PriorityHigh:
-		default:
-			m.status = "priority must be low|medium|high"
+		if !domain.IsValidPriority(priority) {
+			m.status = priorityValidationStatus
 			return m, nil
 		}
 
@@ -8281,7 +9027,13 @@ func (m Model) submitInputMode() (tea.Model, tea.Cmd) {
 			m.status = err.Error()
 			return m, nil
 		}
+		reminders, err := parseRemindersInput(vals["reminders"], task.Metadata.Reminders)
+		if err != nil {
+			m.status = err.Error()
+			return m, nil
+		}
 		metadata := m.buildTaskMetadataFromForm(vals, task.Metadata)
+		metadata.Reminders = reminders
 
 		m.mode = modeNone
 		m.formInputs = nil
@@ -8291,6 +9043,7 @@ func (m Model) submitInputMode() (tea.Model, tea.Cmd) {
 		m.taskFormKind = domain.WorkKindTask
 		m.taskFormScope = domain.KindAppliesToTask
 		m.taskFormResourceRefs = nil
+		m.taskFormRecurEvery = ""
 		m.taskFormSubtaskCursor = 0
 		m.taskFormResourceCursor = 0
 		m.taskFormResourceEditIndex = -1
@@ -8305,13 +9058,18 @@ func (m Model) submitInputMode() (tea.Model, tea.Cmd) {
 			Labels:      labels,
 			Metadata:    &metadata,
 		}
-		return m, func() tea.Msg {
-			_, updateErr := m.svc.UpdateTask(context.Background(), in)
+		return m, m.discardTaskFormDraftOnSuccess(m.taskFormDraftPath(), func() tea.Msg {
+			updated, updateErr := m.svc.UpdateTask(m.withLabelAllowlist(context.Background()), in)
 			if updateErr != nil {
 				return actionMsg{err: updateErr}
 			}
-			return actionMsg{status: "task updated", reload: true}
-		}
+			return actionMsg{
+				status:      "task updated",
+				reload:      true,
+				upsertTasks: []domain.Task{updated},
+				historyPush: taskEditHistory("edit task", task, updated),
+			}
+		})
 	case modeLabelsConfig:
 		if len(m.labelsConfigInputs) < 4 {
 			m.status = "labels config unavailable"
@@ -8386,11 +9144,24 @@ func (m Model) submitInputMode() (tea.Model, tea.Cmd) {
 		if value == "" {
 			value = defaultHighlightColor
 		}
+		if !domain.ValidColor(value) {
+			m.status = "highlight color must be an ansi index (0-255) or #RRGGBB"
+			return m, nil
+		}
 		m.highlightColor = value
 		m.mode = modeNone
 		m.highlightColorInput.Blur()
 		m.status = "highlight color updated"
-		return m, nil
+		if m.saveHighlightColor == nil {
+			return m, nil
+		}
+		save := m.saveHighlightColor
+		return m, func() tea.Msg {
+			if err := save(value); err != nil {
+				return actionMsg{err: fmt.Errorf("save highlight color: %w", err)}
+			}
+			return actionMsg{status: "highlight color saved"}
+		}
 	case modeAddProject, modeEditProject:
 		isAdd := m.mode == modeAddProject
 		vals := m.projectFormValues()
@@ -8411,6 +9182,7 @@ func (m Model) submitInputMode() (tea.Model, tea.Cmd) {
 			Homepage: vals["homepage"],
 			Tags:     parseLabelsInput(vals["tags"], nil),
 		}
+		columns := parseLabelsInput(vals["columns"], nil)
 		description := vals["description"]
 		projectID := m.editingProjectID
 		projectOp := "update"
@@ -8430,6 +9202,7 @@ func (m Model) submitInputMode() (tea.Model, tea.Cmd) {
 					Name:        name,
 					Description: description,
 					Metadata:    metadata,
+					Columns:     columns,
 				})
 				if err != nil {
 					return actionMsg{err: err}
@@ -8485,6 +9258,12 @@ func (m Model) executeCommandPalette(command string) (tea.Model, tea.Cmd) {
 		return m, nil
 	case "new-task", "task-new":
 		return m, m.startTaskForm(nil)
+	case "new-from-template", "template", "task-template":
+		return m, m.startTemplatePickerMode()
+	case "save-search", "search-save":
+		return m, m.startSavedSearchMode(true)
+	case "load-search", "search-load":
+		return m, m.startSavedSearchMode(false)
 	case "new-subtask", "task-subtask":
 		task, ok := m.selectedTaskInCurrentColumn()
 		if !ok {
@@ -8549,6 +9328,12 @@ func (m Model) executeCommandPalette(command string) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 		return m.confirmRestoreAction()
+	case "convert-to-branch", "promote-branch":
+		return m.convertSelectedTaskKind(domain.WorkKind("branch"))
+	case "convert-to-phase", "promote-phase":
+		return m.convertSelectedTaskKind(domain.WorkKindPhase)
+	case "convert-to-task", "demote-task":
+		return m.convertSelectedTaskKind(domain.WorkKindTask)
 	case "edit-task", "task-edit":
 		task, ok := m.selectedTaskInCurrentColumn()
 		if !ok {
@@ -8596,7 +9381,8 @@ func (m Model) executeCommandPalette(command string) (tea.Model, tea.Cmd) {
 		} else {
 			m.status = "hiding archived tasks"
 		}
-		return m, m.loadData
+		cmd := m.requestReload()
+		return m, cmd
 	case "toggle-selection-mode", "select-mode", "text-select":
 		m.mouseSelectionMode = !m.mouseSelectionMode
 		m.status = "ready"
@@ -8658,6 +9444,18 @@ func (m Model) executeCommandPalette(command string) (tea.Model, tea.Cmd) {
 		return m.moveSelectedTasks(-1)
 	case "bulk-move-right", "move-right-selected":
 		return m.moveSelectedTasks(1)
+	case "archive-subtree", "archive-tree":
+		return m.confirmArchiveSubtreeAction()
+	case "restore-subtree", "restore-tree":
+		return m.restoreTaskSubtree()
+	case "bulk-add-label", "label-selected":
+		return m, m.startBulkLabelPicker(labelPickerBulkAdd)
+	case "assign", "quick-assign", "assign-selected":
+		return m, m.startAssignPicker()
+	case "bulk-remove-label", "unlabel-selected":
+		return m, m.startBulkLabelPicker(labelPickerBulkRemove)
+	case "archive-done", "archive-all-done", "sweep-done":
+		return m.startArchiveDone()
 	case "bulk-archive", "archive-selected":
 		return m.confirmBulkDeleteAction(app.DeleteModeArchive, m.confirmArchive, "archive selected")
 	case "bulk-delete", "delete-selected":
@@ -8679,6 +9477,42 @@ func (m Model) executeCommandPalette(command string) (tea.Model, tea.Cmd) {
 		return m, m.startHighlightColorMode()
 	case "activity-log", "log":
 		return m, m.openActivityLog()
+	case "inbox", "triage":
+		m.openInbox()
+		return m, nil
+	case "previous-project", "last-project", "alt-project":
+		return m.switchToPreviousProject()
+	case "open-data-dir", "data-dir", "open-data":
+		m.status = "opening data dir..."
+		return m, m.openDataDirCmd()
+	case "go-to-column", "goto-column", "column":
+		return m, m.startGoToColumnMode()
+	case "jump-to-task", "goto-id", "task-id":
+		return m, m.startJumpToTaskMode()
+	case "recent-tasks", "recent", "recently-viewed":
+		m.openRecentTasks()
+		return m, nil
+	case "watched", "watchlist", "watched-tasks":
+		return m, m.openWatchedTasks()
+	case "toggle-watch", "watch", "unwatch":
+		return m.toggleFocusedTaskWatch()
+	case "verify-resources", "check-resources":
+		return m.verifyProjectResources()
+	case "attach-url", "add-url", "link":
+		task, ok := m.selectedTaskInCurrentColumn()
+		if !ok {
+			m.status = "no task selected"
+			return m, nil
+		}
+		return m, m.startAttachURLForm(task.ID, modeNone)
+	case "export-task", "task-card", "share-task":
+		task, ok := m.selectedTaskInCurrentColumn()
+		if !ok {
+			m.status = "no task selected"
+			return m, nil
+		}
+		m.startTaskCardExport(task.ID, modeNone)
+		return m, nil
 	case "help":
 		m.help.ShowAll = true
 		m.status = "help"
@@ -8730,6 +9564,23 @@ func (m Model) quickActionAvailability(actionID string, hasTask bool, hasSelecti
 			return true, ""
 		}
 		return false, "no archived task selected"
+	case "archive-subtree":
+		task, ok := m.selectedTaskInCurrentColumn()
+		if !ok {
+			return false, "no task selected"
+		}
+		if _, ok := m.svc.(taskSubtreeArchiver); !ok {
+			return false, "not supported"
+		}
+		if len(m.descendantTaskIDs(task.ID)) == 0 {
+			return false, "task has no subtasks"
+		}
+		return true, ""
+	case "restore-subtree":
+		if _, ok := m.svc.(taskSubtreeArchiver); !ok {
+			return false, "not supported"
+		}
+		return m.quickActionAvailability("restore-task", hasTask, hasSelection)
 	case "move-left":
 		if !hasTask {
 			return false, "no task selected"
@@ -8746,6 +9597,14 @@ func (m Model) quickActionAvailability(actionID string, hasTask bool, hasSelecti
 			return false, "already at last column"
 		}
 		return true, ""
+	case "edit-column":
+		if len(m.columns) == 0 {
+			return false, "no column selected"
+		}
+		if _, ok := m.svc.(columnUpdater); !ok {
+			return false, "not supported"
+		}
+		return true, ""
 	case "clear-selection":
 		if !hasSelection {
 			return false, "selection already empty"
@@ -8784,6 +9643,14 @@ func (m Model) quickActionAvailability(actionID string, hasTask bool, hasSelecti
 		return true, ""
 	case "activity-log":
 		return true, ""
+	case "verify-resources":
+		if _, ok := m.svc.(resourceVerifier); !ok {
+			return false, "not supported"
+		}
+		if _, ok := m.currentProject(); !ok {
+			return false, "no project selected"
+		}
+		return true, ""
 	default:
 		return false, "unknown action"
 	}
@@ -8828,10 +9695,16 @@ func (m Model) applyQuickAction() (tea.Model, tea.Cmd) {
 		return m.moveSelectedTask(-1)
 	case "move-right":
 		return m.moveSelectedTask(1)
+	case "edit-column":
+		return m, m.startColumnEditForm()
 	case "archive-task":
 		return m.confirmDeleteAction(app.DeleteModeArchive, m.confirmArchive, "archive task")
+	case "archive-subtree":
+		return m.confirmArchiveSubtreeAction()
 	case "restore-task":
 		return m.confirmRestoreAction()
+	case "restore-subtree":
+		return m.restoreTaskSubtree()
 	case "hard-delete":
 		return m.confirmDeleteAction(app.DeleteModeHard, m.confirmHardDelete, "hard delete task")
 	case "toggle-selection":
@@ -8868,6 +9741,8 @@ func (m Model) applyQuickAction() (tea.Model, tea.Cmd) {
 		return m.redoLastMutation()
 	case "activity-log":
 		return m, m.openActivityLog()
+	case "verify-resources":
+		return m.verifyProjectResources()
 	default:
 		m.status = "unknown quick action"
 		return m, nil
@@ -8876,6 +9751,9 @@ func (m Model) applyQuickAction() (tea.Model, tea.Cmd) {
 
 // createTask creates task.
 func (m Model) createTask(in app.CreateTaskInput) (tea.Model, tea.Cmd) {
+	if m.readOnly {
+		return m.readOnlyBlocked("create task")
+	}
 	projectID, ok := m.currentProjectID()
 	if !ok {
 		m.status = "no active project"
@@ -8889,11 +9767,11 @@ func (m Model) createTask(in app.CreateTaskInput) (tea.Model, tea.Cmd) {
 	in.ProjectID = projectID
 	in.ColumnID = columnID
 	return m, func() tea.Msg {
-		task, err := m.svc.CreateTask(context.Background(), in)
+		task, err := m.svc.CreateTask(m.withLabelAllowlist(context.Background()), in)
 		if err != nil {
 			return actionMsg{err: err}
 		}
-		return actionMsg{status: "task created", reload: true, focusTaskID: task.ID}
+		return actionMsg{status: "task created", reload: true, focusTaskID: task.ID, upsertTasks: []domain.Task{task}}
 	}
 }
 
@@ -8923,6 +9801,9 @@ func (m Model) moveSelectedTasks(delta int) (tea.Model, tea.Cmd) {
 
 // moveTaskIDs moves the provided task ids and records undo/redo history.
 func (m Model) moveTaskIDs(taskIDs []string, delta int, label, target string, bulk bool) (tea.Model, tea.Cmd) {
+	if m.readOnly {
+		return m.readOnlyBlocked("move task")
+	}
 	steps := m.buildMoveSteps(taskIDs, delta)
 	if len(steps) == 0 {
 		m.status = "no movable tasks selected"
@@ -8954,10 +9835,13 @@ func (m Model) moveTaskIDs(taskIDs []string, delta int, label, target string, bu
 		Target:  target,
 	}
 	return m, func() tea.Msg {
+		moved := make([]domain.Task, 0, len(steps))
 		for _, step := range steps {
-			if _, err := m.svc.MoveTask(context.Background(), step.TaskID, step.ToColumnID, step.ToPosition); err != nil {
+			task, err := m.svc.MoveTask(context.Background(), step.TaskID, step.ToColumnID, step.ToPosition)
+			if err != nil {
 				return actionMsg{err: err}
 			}
+			moved = append(moved, task)
 		}
 		return actionMsg{
 			status:       status,
@@ -8965,6 +9849,7 @@ func (m Model) moveTaskIDs(taskIDs []string, delta int, label, target string, bu
 			focusTaskID:  focusTaskID,
 			historyPush:  &history,
 			activityItem: &activity,
+			upsertTasks:  moved,
 		}
 	}
 }
@@ -8981,6 +9866,9 @@ func (m Model) deleteSelectedTask(mode app.DeleteMode) (tea.Model, tea.Cmd) {
 
 // deleteTaskIDs archives/deletes task ids and records undo metadata when possible.
 func (m Model) deleteTaskIDs(taskIDs []string, mode app.DeleteMode) (tea.Model, tea.Cmd) {
+	if m.readOnly {
+		return m.readOnlyBlocked("delete task")
+	}
 	ids := m.normalizeKnownTaskIDs(taskIDs)
 	if len(ids) == 0 {
 		m.status = "no tasks selected"
@@ -9038,22 +9926,42 @@ func (m Model) deleteTaskIDs(taskIDs []string, mode app.DeleteMode) (tea.Model,
 	}
 	return m, func() tea.Msg {
 		for _, taskID := range ids {
-			if err := m.svc.DeleteTask(context.Background(), taskID, mode); err != nil {
+			if err := m.svc.DeleteTask(app.WithParentDeletePolicy(context.Background(), m.parentDeletePolicy), taskID, mode); err != nil {
 				return actionMsg{err: err}
 			}
 		}
-		return actionMsg{
+		msg := actionMsg{
 			status:       status,
 			reload:       true,
 			clearTaskIDs: ids,
 			historyPush:  &history,
 			activityItem: &activity,
 		}
+		if mode == app.DeleteModeHard {
+			msg.removeTaskIDs = ids
+		} else {
+			msg.archivedTaskIDs = ids
+		}
+		return msg
 	}
 }
 
+// openConfirmAction opens the confirmation modal for one action with the configured default choice highlighted.
+func (m *Model) openConfirmAction(action confirmAction) {
+	m.mode = modeConfirmAction
+	m.pendingConfirm = action
+	m.confirmChoice = 0
+	if m.confirmDefaultCancel {
+		m.confirmChoice = 1
+	}
+	m.status = "confirm action"
+}
+
 // confirmDeleteAction opens a confirmation modal when configured, or executes directly.
 func (m Model) confirmDeleteAction(mode app.DeleteMode, needsConfirm bool, label string) (tea.Model, tea.Cmd) {
+	if m.readOnly {
+		return m.readOnlyBlocked("delete task")
+	}
 	task, ok := m.selectedTaskInCurrentColumn()
 	if !ok {
 		m.status = "no task selected"
@@ -9066,21 +9974,21 @@ func (m Model) confirmDeleteAction(mode app.DeleteMode, needsConfirm bool, label
 	if !needsConfirm {
 		return m.deleteTaskIDs([]string{task.ID}, mode)
 	}
-	m.mode = modeConfirmAction
-	m.pendingConfirm = confirmAction{
+	m.openConfirmAction(confirmAction{
 		Kind:    "delete",
 		Task:    task,
 		TaskIDs: []string{task.ID},
 		Mode:    mode,
 		Label:   label,
-	}
-	m.confirmChoice = 1
-	m.status = "confirm action"
+	})
 	return m, nil
 }
 
 // confirmBulkDeleteAction confirms and applies bulk archive/hard-delete operations.
 func (m Model) confirmBulkDeleteAction(mode app.DeleteMode, needsConfirm bool, label string) (tea.Model, tea.Cmd) {
+	if m.readOnly {
+		return m.readOnlyBlocked("delete task")
+	}
 	taskIDs := m.sortedSelectedTaskIDs()
 	if len(taskIDs) == 0 {
 		m.status = "no tasks selected"
@@ -9090,19 +9998,63 @@ func (m Model) confirmBulkDeleteAction(mode app.DeleteMode, needsConfirm bool, l
 		return m.deleteTaskIDs(taskIDs, mode)
 	}
 	task, _ := m.taskByID(taskIDs[0])
-	m.mode = modeConfirmAction
-	m.pendingConfirm = confirmAction{
+	m.openConfirmAction(confirmAction{
 		Kind:    "delete",
 		Task:    task,
 		TaskIDs: taskIDs,
 		Mode:    mode,
 		Label:   label,
-	}
-	m.confirmChoice = 1
-	m.status = "confirm action"
+	})
 	return m, nil
 }
 
+// hardDeleteSubtaskImpact describes what the parent delete policy does to subtasks of the tasks being deleted.
+// Subtasks that are themselves in the delete set are not counted.
+func (m Model) hardDeleteSubtaskImpact(taskIDs []string) string {
+	deleting := make(map[string]struct{}, len(taskIDs))
+	for _, taskID := range taskIDs {
+		deleting[strings.TrimSpace(taskID)] = struct{}{}
+	}
+	directCount := 0
+	descendantCount := 0
+	queue := slices.Clone(taskIDs)
+	seen := map[string]struct{}{}
+	for depth := 0; len(queue) > 0; depth++ {
+		next := make([]string, 0)
+		for _, parentID := range queue {
+			for _, task := range m.tasks {
+				if task.ParentID != parentID {
+					continue
+				}
+				if _, ok := seen[task.ID]; ok {
+					continue
+				}
+				seen[task.ID] = struct{}{}
+				next = append(next, task.ID)
+				if _, ok := deleting[task.ID]; ok {
+					continue
+				}
+				descendantCount++
+				if depth == 0 {
+					directCount++
+				}
+			}
+		}
+		queue = next
+	}
+	if descendantCount == 0 {
+		return ""
+	}
+	switch m.parentDeletePolicy {
+	case app.ParentDeletePolicyReparent:
+		return fmt.Sprintf("%d direct subtasks move up one level", directCount)
+	case app.ParentDeletePolicyBlock:
+		return fmt.Sprintf("blocked: %d subtasks must be moved or deleted first", descendantCount)
+	default:
+		return fmt.Sprintf("also deletes %d subtasks", descendantCount)
+	}
+}
+
 // restoreTask restores the most-recent archived task or selected archived task.
 func (m Model) restoreTask() (tea.Model, tea.Cmd) {
 	taskID := m.lastArchivedTaskID
@@ -9121,6 +10073,9 @@ func (m Model) restoreTask() (tea.Model, tea.Cmd) {
 
 // restoreTaskIDs restores tasks and records undo history.
 func (m Model) restoreTaskIDs(taskIDs []string, status, label string) (tea.Model, tea.Cmd) {
+	if m.readOnly {
+		return m.readOnlyBlocked("restore task")
+	}
 	ids := make([]string, 0, len(taskIDs))
 	for _, taskID := range taskIDs {
 		taskID = strings.TrimSpace(taskID)
@@ -9154,22 +10109,35 @@ func (m Model) restoreTaskIDs(taskIDs []string, status, label string) (tea.Model
 		Target:  fmt.Sprintf("%d tasks", len(ids)),
 	}
 	return m, func() tea.Msg {
+		restored := make([]domain.Task, 0, len(ids))
 		for _, taskID := range ids {
-			if _, err := m.svc.RestoreTask(context.Background(), taskID); err != nil {
+			task, err := m.svc.RestoreTask(context.Background(), taskID)
+			if err != nil {
 				return actionMsg{err: err}
 			}
+			restored = append(restored, task)
+		}
+		if len(restored) == 1 {
+			// Restoring a parent alone leaves its archived subtasks behind, so point at the subtree restore.
+			if hint := m.archivedSubtaskRestoreHint(context.Background(), restored[0]); hint != "" {
+				status = status + " • " + hint
+			}
 		}
 		return actionMsg{
 			status:       status,
 			reload:       true,
 			historyPush:  &history,
 			activityItem: &activity,
+			upsertTasks:  restored,
 		}
 	}
 }
 
 // confirmRestoreAction opens restore confirmation when configured, or executes directly.
 func (m Model) confirmRestoreAction() (tea.Model, tea.Cmd) {
+	if m.readOnly {
+		return m.readOnlyBlocked("restore task")
+	}
 	task, ok := m.selectedTaskInCurrentColumn()
 	if ok && task.ArchivedAt == nil {
 		ok = false
@@ -9177,21 +10145,21 @@ func (m Model) confirmRestoreAction() (tea.Model, tea.Cmd) {
 	if !m.confirmRestore || !ok {
 		return m.restoreTask()
 	}
-	m.mode = modeConfirmAction
-	m.pendingConfirm = confirmAction{
+	m.openConfirmAction(confirmAction{
 		Kind:    "restore",
 		Task:    task,
 		TaskIDs: []string{task.ID},
 		Mode:    app.DeleteModeArchive,
 		Label:   "restore task",
-	}
-	m.confirmChoice = 1
-	m.status = "confirm action"
+	})
 	return m, nil
 }
 
 // archiveCurrentProject archives the active project with optional confirmation.
 func (m Model) archiveCurrentProject(needsConfirm bool) (tea.Model, tea.Cmd) {
+	if m.readOnly {
+		return m.readOnlyBlocked("archive project")
+	}
 	project, ok := m.currentProject()
 	if !ok {
 		m.status = "no project selected"
@@ -9202,14 +10170,11 @@ func (m Model) archiveCurrentProject(needsConfirm bool) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 	if needsConfirm {
-		m.mode = modeConfirmAction
-		m.pendingConfirm = confirmAction{
+		m.openConfirmAction(confirmAction{
 			Kind:    "archive-project",
 			Project: project,
 			Label:   "archive project",
-		}
-		m.confirmChoice = 1
-		m.status = "confirm action"
+		})
 		return m, nil
 	}
 	projectID := project.ID
@@ -9233,6 +10198,9 @@ func (m Model) archiveCurrentProject(needsConfirm bool) (tea.Model, tea.Cmd) {
 
 // restoreCurrentProject restores the active archived project with optional confirmation.
 func (m Model) restoreCurrentProject(needsConfirm bool) (tea.Model, tea.Cmd) {
+	if m.readOnly {
+		return m.readOnlyBlocked("restore project")
+	}
 	project, ok := m.currentProject()
 	if !ok {
 		m.status = "no project selected"
@@ -9243,14 +10211,11 @@ func (m Model) restoreCurrentProject(needsConfirm bool) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 	if needsConfirm {
-		m.mode = modeConfirmAction
-		m.pendingConfirm = confirmAction{
+		m.openConfirmAction(confirmAction{
 			Kind:    "restore-project",
 			Project: project,
 			Label:   "restore project",
-		}
-		m.confirmChoice = 1
-		m.status = "confirm action"
+		})
 		return m, nil
 	}
 	projectID := project.ID
@@ -9265,20 +10230,20 @@ func (m Model) restoreCurrentProject(needsConfirm bool) (tea.Model, tea.Cmd) {
 
 // deleteCurrentProject hard-deletes the active project with optional confirmation.
 func (m Model) deleteCurrentProject(needsConfirm bool) (tea.Model, tea.Cmd) {
+	if m.readOnly {
+		return m.readOnlyBlocked("delete project")
+	}
 	project, ok := m.currentProject()
 	if !ok {
 		m.status = "no project selected"
 		return m, nil
 	}
 	if needsConfirm {
-		m.mode = modeConfirmAction
-		m.pendingConfirm = confirmAction{
+		m.openConfirmAction(confirmAction{
 			Kind:    "delete-project",
 			Project: project,
 			Label:   "delete project",
-		}
-		m.confirmChoice = 1
-		m.status = "confirm action"
+		})
 		return m, nil
 	}
 	projectID := project.ID
@@ -9308,18 +10273,24 @@ func (m Model) deleteCurrentProject(needsConfirm bool) (tea.Model, tea.Cmd) {
 // applyConfirmedAction executes a previously confirmed action.
 func (m Model) applyConfirmedAction(action confirmAction) (tea.Model, tea.Cmd) {
 	switch action.Kind {
+	case quitConfirmKind:
+		return m, tea.Quit
 	case "delete":
 		taskIDs := action.TaskIDs
 		if len(taskIDs) == 0 && strings.TrimSpace(action.Task.ID) != "" {
 			taskIDs = []string{action.Task.ID}
 		}
 		return m.deleteTaskIDs(taskIDs, action.Mode)
+	case "archive-done":
+		return m.archiveDoneTaskIDs(action.TaskIDs)
 	case "restore":
 		taskIDs := action.TaskIDs
 		if len(taskIDs) == 0 && strings.TrimSpace(action.Task.ID) != "" {
 			taskIDs = []string{action.Task.ID}
 		}
 		return m.restoreTaskIDs(taskIDs, "task restored", "restore task")
+	case "archive-subtree":
+		return m.archiveTaskSubtree(action.Task.ID)
 	case "archive-project":
 		if projectID := strings.TrimSpace(action.Project.ID); projectID != "" {
 			for idx, project := range m.projects {
@@ -9478,6 +10449,8 @@ func (m Model) handleMouseWheel(msg tea.MouseWheelMsg) (tea.Model, tea.Cmd) {
 		if m.selectedTask < len(tasks)-1 {
 			m.selectedTask++
 		}
+		cmd := m.nextColumnPageCmd()
+		return m, cmd
 	}
 	return m, nil
 }
@@ -9753,6 +10726,9 @@ func (m *Model) applyRedoTransition(set historyActionSet) {
 
 // undoLastMutation reverses the most recent undoable mutation set.
 func (m Model) undoLastMutation() (tea.Model, tea.Cmd) {
+	if m.readOnly {
+		return m.readOnlyBlocked("undo")
+	}
 	if len(m.undoStack) == 0 {
 		m.status = "nothing to undo"
 		return m, nil
@@ -9773,6 +10749,9 @@ func (m Model) undoLastMutation() (tea.Model, tea.Cmd) {
 
 // redoLastMutation reapplies the most recently undone mutation set.
 func (m Model) redoLastMutation() (tea.Model, tea.Cmd) {
+	if m.readOnly {
+		return m.readOnlyBlocked("redo")
+	}
 	if len(m.redoStack) == 0 {
 		m.status = "nothing to redo"
 		return m, nil
@@ -9789,8 +10768,15 @@ func (m Model) executeHistorySet(set historyActionSet, undo bool) tea.Cmd {
 	}
 	return func() tea.Msg {
 		clearIDs := make([]string, 0, len(steps))
+		focusColumnID := ""
+		labelSteps := []historyStep{}
 		for _, step := range steps {
 			switch step.Kind {
+			case historyStepColumnMove:
+				if err := m.replayColumnMove(step, undo); err != nil {
+					return actionMsg{err: err}
+				}
+				focusColumnID = step.ColumnID
 			case historyStepMove:
 				columnID := step.ToColumnID
 				position := step.ToPosition
@@ -9823,22 +10809,39 @@ func (m Model) executeHistorySet(set historyActionSet, undo bool) tea.Cmd {
 						return actionMsg{err: err}
 					}
 				}
+			case historyStepAddLabel, historyStepRemoveLabel:
+				labelSteps = append(labelSteps, step)
+			case historyStepAssign:
+				if _, err := m.replayAssignStep(step, undo); err != nil {
+					return actionMsg{err: err}
+				}
+			case historyStepEdit:
+				if err := m.replayEditStep(step, undo); err != nil {
+					return actionMsg{err: err}
+				}
 			case historyStepHardDelete:
 				if undo {
 					return actionMsg{status: "undo failed: hard delete cannot be restored"}
 				}
-				if err := m.svc.DeleteTask(context.Background(), step.TaskID, app.DeleteModeHard); err != nil {
+				if err := m.svc.DeleteTask(app.WithParentDeletePolicy(context.Background(), m.parentDeletePolicy), step.TaskID, app.DeleteModeHard); err != nil {
 					return actionMsg{err: err}
 				}
 				clearIDs = append(clearIDs, step.TaskID)
 			}
 		}
+		// Label steps replay as one batch so an undo never leaves the set half-applied.
+		if len(labelSteps) > 0 {
+			if _, err := m.replayLabelSteps(labelSteps, undo); err != nil {
+				return actionMsg{err: err}
+			}
+		}
 		status := "redo complete"
 		activitySummary := "redo"
 		msg := actionMsg{
-			reload:       true,
-			clearTaskIDs: clearIDs,
-			historyRedo:  &set,
+			reload:        true,
+			focusColumnID: focusColumnID,
+			clearTaskIDs:  clearIDs,
+			historyRedo:   &set,
 		}
 		if undo {
 			status = "undo complete"
@@ -9925,12 +10928,16 @@ func (m Model) groupLabelForTask(task domain.Task) string {
 	switch normalizeBoardGroupBy(m.boardGroupBy) {
 	case "priority":
 		switch task.Priority {
+		case domain.PriorityCritical:
+			return "Priority: Critical"
 		case domain.PriorityHigh:
 			return "Priority: High"
 		case domain.PriorityMedium:
 			return "Priority: Medium"
 		case domain.PriorityLow:
 			return "Priority: Low"
+		case domain.PriorityNone:
+			return "Priority: None"
 		default:
 			return "Priority: Unknown"
 		}
@@ -9947,6 +10954,8 @@ func (m Model) groupLabelForTask(task domain.Task) string {
 		default:
 			return "State: Unknown"
 		}
+	case "assignee":
+		return "Assignee: " + taskAssigneeLabel(task)
 	default:
 		return "Tasks"
 	}
@@ -10034,6 +11043,9 @@ func (m Model) tasksForColumn(columnID string) []domain.Task {
 			iRank := taskGroupRank(ordered[i], groupBy)
 			jRank := taskGroupRank(ordered[j], groupBy)
 			if iRank == jRank {
+				if groupBy == "assignee" {
+					return compareAssignees(ordered[i].Metadata.Assignee, ordered[j].Metadata.Assignee) < 0
+				}
 				return false
 			}
 			return iRank < jRank
@@ -10378,14 +11390,18 @@ func taskGroupRank(task domain.Task, groupBy string) int {
 	switch normalizeBoardGroupBy(groupBy) {
 	case "priority":
 		switch task.Priority {
-		case domain.PriorityHigh:
+		case domain.PriorityCritical:
 			return 0
-		case domain.PriorityMedium:
+		case domain.PriorityHigh:
 			return 1
-		case domain.PriorityLow:
+		case domain.PriorityMedium:
 			return 2
-		default:
+		case domain.PriorityLow:
 			return 3
+		case domain.PriorityNone:
+			return 4
+		default:
+			return 5
 		}
 	case "state":
 		switch strings.ToLower(strings.TrimSpace(string(task.LifecycleState))) {
@@ -10400,6 +11416,12 @@ func taskGroupRank(task domain.Task, groupBy string) int {
 		default:
 			return 4
 		}
+	case "assignee":
+		// Owners sort by name within rank 0 (see tasksForColumn); unassigned work trails them.
+		if strings.TrimSpace(task.Metadata.Assignee) == "" {
+			return 1
+		}
+		return 0
 	default:
 		return 0
 	}
@@ -10660,7 +11682,7 @@ func (m Model) renderProjectTabs(accent, dim color.Color) string {
 
 	parts := make([]string, 0, len(m.projects))
 	for idx, p := range m.projects {
-		label := projectDisplayLabel(p)
+		label := m.projectDisplayLabel(p)
 		if idx == m.selectedProject {
 			parts = append(parts, active.Render("["+label+"]"))
 		} else {
@@ -10671,42 +11693,54 @@ func (m Model) renderProjectTabs(accent, dim color.Color) string {
 }
 
 // projectDisplayName returns one user-facing project name with an optional icon prefix.
-func projectDisplayName(project domain.Project) string {
+func (m Model) projectDisplayName(project domain.Project) string {
 	name := strings.TrimSpace(project.Name)
 	if name == "" {
 		name = strings.TrimSpace(project.ID)
 	}
-	if icon := strings.TrimSpace(project.Metadata.Icon); icon != "" {
+	if icon := m.projectIconText(project); icon != "" {
 		return icon + " " + name
 	}
 	return name
 }
 
+// projectIconText returns the icon to render for one project.
+// With icon rendering off, only plain-ASCII markers survive; emoji are dropped so terminals
+// that mismeasure their width keep tabs and columns aligned, and the name remains the label.
+func (m Model) projectIconText(project domain.Project) string {
+	icon := strings.TrimSpace(project.Metadata.Icon)
+	if m.renderIcons || icon == "" {
+		return icon
+	}
+	for _, r := range icon {
+		if r > unicode.MaxASCII || !unicode.IsPrint(r) {
+			return ""
+		}
+	}
+	return icon
+}
+
 // projectDisplayLabel returns one user-facing project label with archive marker text.
-func projectDisplayLabel(project domain.Project) string {
-	label := projectDisplayName(project)
+func (m Model) projectDisplayLabel(project domain.Project) string {
+	label := m.projectDisplayName(project)
 	if project.ArchivedAt != nil {
 		label += " (archived)"
 	}
 	return label
 }
 
-// projectAccentColor returns the project-specific accent color or the default accent.
-func projectAccentColor(project domain.Project) color.Color {
+// projectAccentColor returns the project-specific accent color or the theme accent.
+func (m Model) projectAccentColor(project domain.Project) color.Color {
 	value := strings.TrimSpace(project.Metadata.Color)
 	if value == "" {
-		return lipgloss.Color("62")
+		return lipgloss.Color(m.theme().Accent)
 	}
 	return lipgloss.Color(value)
 }
 
 // selectedTaskHighlightColor returns the configured board-selection highlight color.
 func (m Model) selectedTaskHighlightColor() color.Color {
-	value := strings.TrimSpace(m.highlightColor)
-	if value == "" {
-		value = defaultHighlightColor
-	}
-	return lipgloss.Color(value)
+	return lipgloss.Color(m.theme().Highlight)
 }
 
 // canFocusNoticesPanel reports whether the notices panel can accept keyboard focus.
@@ -10840,7 +11874,7 @@ func globalNoticesStableKey(projectID, attentionID string, scopeType domain.Scop
 }
 
 // globalNoticesPanelItemFromAttention maps one attention item into a global notifications panel row.
-func globalNoticesPanelItemFromAttention(project domain.Project, item domain.AttentionItem) globalNoticesPanelItem {
+func (m Model) globalNoticesPanelItemFromAttention(project domain.Project, item domain.AttentionItem) globalNoticesPanelItem {
 	summary := strings.TrimSpace(item.Summary)
 	if summary == "" {
 		summary = "attention item"
@@ -10856,7 +11890,7 @@ func globalNoticesPanelItemFromAttention(project domain.Project, item domain.Att
 		StableKey:         globalNoticesStableKey(projectID, attentionID, scopeType, scopeID, summary),
 		AttentionID:       attentionID,
 		ProjectID:         projectID,
-		ProjectLabel:      projectDisplayName(project),
+		ProjectLabel:      m.projectDisplayName(project),
 		ScopeType:         scopeType,
 		ScopeID:           scopeID,
 		Summary:           summary,
@@ -11015,14 +12049,16 @@ func (m Model) noticesWarningPanelItems() []noticesPanelItem {
 		}
 		out = append(out, row)
 	}
-	overdue, dueSoon := m.dueCounts(time.Now().UTC())
+	now := time.Now().UTC()
+	overdue, dueSoon := m.dueCounts(now)
 	if overdue > 0 {
 		out = append(out, noticesPanelItem{Label: fmt.Sprintf("overdue: %d", overdue)})
 	}
 	if dueSoon > 0 {
 		out = append(out, noticesPanelItem{Label: fmt.Sprintf("due soon: %d", dueSoon)})
 	}
-	return out
+	out = append(out, m.reminderPanelItems(now)...)
+	return append(out, m.orphanedTaskPanelItems()...)
 }
 
 // noticesAttentionPanelItems builds selectable action-required rows from persisted attention records.
@@ -11330,7 +12366,8 @@ func (m Model) activateGlobalNoticesSelection() (tea.Model, tea.Cmd) {
 			m.clearPendingNotificationThread()
 		}
 		m.status = "loading global notification..."
-		return m, m.loadData
+		cmd := m.requestReload()
+		return m, cmd
 	}
 
 	if m.openTaskInfo(taskID, "task info") {
@@ -11354,7 +12391,8 @@ func (m Model) activateGlobalNoticesSelection() (tea.Model, tea.Cmd) {
 			m.clearPendingNotificationThread()
 		}
 		m.status = "loading notification task..."
-		return m, m.loadData
+		cmd := m.requestReload()
+		return m, cmd
 	}
 	if !m.showArchived {
 		m.traceGlobalNoticeBranch("task_reload_include_archived")
@@ -11369,7 +12407,8 @@ func (m Model) activateGlobalNoticesSelection() (tea.Model, tea.Cmd) {
 			m.clearPendingNotificationThread()
 		}
 		m.status = "loading notification task..."
-		return m, m.loadData
+		cmd := m.requestReload()
+		return m, cmd
 	}
 	if hasThreadTarget {
 		m.traceGlobalNoticeBranch("task_open_thread_fallback")
@@ -11854,6 +12893,7 @@ func (m Model) helpOverlayScreenTitleAndLines() (string, []string) {
 			panelLine,
 			"ctrl+y toggles text selection mode; ctrl+c/ctrl+v copy/paste in text inputs",
 			"ctrl+z undo; ctrl+shift+z redo; g activity log; q quit",
+			"H cycles the footer through basics, navigation, tasks, selection, and search keys",
 		}
 	case modeAddTask:
 		return "new task", []string{
@@ -11864,6 +12904,7 @@ func (m Model) helpOverlayScreenTitleAndLines() (string, []string) {
 			"labels field: enter or e opens label picker",
 			"depends_on/blocked_by fields: enter or e opens dependency picker",
 			"subtasks, comments, and resources are action rows; enter or e opens the selected action",
+			"resources field: u attaches an http(s) link",
 			"ctrl+s saves form",
 		}
 	case modeEditTask:
@@ -11876,14 +12917,15 @@ func (m Model) helpOverlayScreenTitleAndLines() (string, []string) {
 			"depends_on/blocked_by fields: enter or e opens dependency picker",
 			"subtasks section: left/right selects row; enter or e opens selected row",
 			"comments section: enter or e opens thread/comments",
-			"resources section: left/right selects row; enter or e opens resource picker",
+			"resources section: left/right selects row; enter or e opens resource picker; u attaches an http(s) link",
 			"ctrl+s saves form",
 		}
 	case modeSearch:
 		return "search", []string{
-			"tab cycles query, states, levels, scope, archived, and apply",
+			"tab cycles query, states, levels, scope, archived, comments, due window, and apply",
+			"due from/to take YYYY-MM-DD, YYYY-MM-DD HH:MM, or RFC3339; a date-only due to includes that day; blank or - leaves it open",
 			"space or enter toggles the focused state/level/scope option",
-			"h/l cycles state/level cursors and toggles scope/archived",
+			"h/l cycles state/level cursors and toggles scope/archived/comments",
 			"ctrl+u clears query; ctrl+r resets filters; esc cancels",
 		}
 	case modeRenameTask:
@@ -11893,8 +12935,9 @@ func (m Model) helpOverlayScreenTitleAndLines() (string, []string) {
 		}
 	case modeDuePicker:
 		return "due picker", []string{
-			"tab cycles include-time, date, time, and options list focus",
+			"tab cycles include-time, date, time, repeat, and options list focus",
 			"space toggles include time when toggle is focused",
+			"repeat takes an interval such as 3d, 1w, 1mo, or 1y; completing the task creates the next one",
 			"type date/time in the picker to update dynamic suggestions",
 			"j/k navigates options list; enter applies; esc cancels",
 		}
@@ -11902,6 +12945,7 @@ func (m Model) helpOverlayScreenTitleAndLines() (string, []string) {
 		return "project picker", []string{
 			"j/k or mouse wheel changes selection",
 			"enter chooses project",
+			"space marks projects; x exports the marked (or highlighted) projects to one snapshot under <data dir>/exports",
 			"N opens new-project form",
 			"A toggles archived project visibility in picker",
 			"esc closes picker",
@@ -11912,7 +12956,11 @@ func (m Model) helpOverlayScreenTitleAndLines() (string, []string) {
 			"backspace moves to parent task info when available",
 			"pgup/pgdown, home/end, or ctrl+u/ctrl+d scroll the full info body",
 			"d opens full-screen details preview; tab toggles edit mode there",
-			"e edit; s create subtask; c thread view",
+			"r toggles the description between rendered markdown and raw source",
+			"e edit; s create subtask; c thread view; x export a shareable card; u attach a link",
+			"y copies the task ID; Y copies its project and hierarchy path",
+			"tab/shift+tab highlight a resource; o opens it with the system default app (local paths must stay inside the project root)",
+			"t cycles an explicit state (todo, progress, done) independent of the column, then back to the column default",
 			"[ / ] move task between columns; esc back/close",
 		}
 	case modeAddProject:
@@ -11957,7 +13005,7 @@ func (m Model) helpOverlayScreenTitleAndLines() (string, []string) {
 	case modeConfirmAction:
 		return "confirm action", []string{
 			"h/l switches confirm vs cancel",
-			"enter applies highlighted choice",
+			"enter applies highlighted choice; [confirm].default_cancel picks the initial highlight",
 			"y confirms immediately; n cancels; esc cancels",
 		}
 	case modeWarning:
@@ -11970,6 +13018,14 @@ func (m Model) helpOverlayScreenTitleAndLines() (string, []string) {
 			"esc closes activity log",
 			"ctrl+z undo and ctrl+shift+z redo remain available",
 		}
+	case modeInbox:
+		return "inbox", []string{
+			"lists undated tasks in the first column",
+			"j/k moves selection; enter opens task info",
+			"1-9 sends the task to that column and advances; l or ] sends it to the next column",
+			"p cycles priority; t/m/w set due today, tomorrow, next week",
+			"esc closes inbox; ctrl+z undoes each column move",
+		}
 	case modeActivityEventInfo:
 		return "activity event", []string{
 			"enter/g jumps to event node when available",
@@ -12017,6 +13073,101 @@ func (m Model) helpOverlayScreenTitleAndLines() (string, []string) {
 			"empty value resets default color",
 			"enter saves; esc cancels",
 		}
+	case modeExportTask:
+		return "export task card", []string{
+			"exports the task with its dependencies and resource refs; subtasks are optional",
+			"f/tab switches markdown and json; s/space toggles subtasks",
+			"enter copies the card to the clipboard; esc cancels",
+			"till export --task <id> writes the same card to stdout or a file",
+		}
+	case modeDueDigest:
+		return "due today", []string{
+			"overdue tasks and tasks due before the end of today, across every active project",
+			"sorted by due time; done and archived tasks are left out",
+			"any key dismisses and returns to the board",
+			"enable or disable with [ui].startup_due_digest",
+		}
+	case modeCatchUp:
+		return "while you were away", []string{
+			"changes other users and agents made since you last viewed this project",
+			"completed counts moves into a done column; the list shows the newest changes first",
+			"enter/esc dismisses; a opens the full activity log",
+		}
+	case modeDuplicateTitle:
+		return "similar task exists", []string{
+			"the new title closely matches tasks already in this project",
+			"enter creates the task anyway; esc returns to the form with its values kept",
+			"disable with [board].warn_duplicate_titles = false",
+		}
+	case modeRecoverDraft:
+		return "recover unsaved task", []string{
+			"a task form was still open when tillsyn last exited for this project",
+			"enter reopens the form with the saved values; d deletes the draft",
+			"esc keeps the draft and asks again on the next launch or when that form opens",
+		}
+	case modeEditColumn:
+		return "edit column", []string{
+			"renames the focused column and sets its WIP limit; 0 means no limit",
+			"names must be unique within the project",
+			"tab/shift+tab moves between fields; enter saves; esc cancels",
+		}
+	case modeTemplatePicker:
+		return "new from template", []string{
+			"type part of a template name; templates come from the [templates] config section",
+			"↑/↓ moves selection; enter opens the new-task form pre-filled from the template",
+			"pre-filled values are only defaults; edits made in the form are what gets saved",
+			"esc cancels",
+		}
+	case modeSavedSearch:
+		if m.savedSearchSaving {
+			return "save search", []string{
+				"saves the current query, scope, states, levels, archived/comments toggles, and due window under a name",
+				"saved searches are written to the [searches] config section; saving an existing name replaces it",
+				"tab copies the highlighted name into the input; enter saves; esc cancels",
+			}
+		}
+		return "load search", []string{
+			"type part of a saved search name; searches come from the [searches] config section",
+			"↑/↓ moves selection; enter applies the saved filters and runs the search",
+			"esc cancels",
+		}
+	case modeAssignPicker:
+		return "assign", []string{
+			"assigns every selected task, or the focused task when nothing is selected",
+			"lists your identity display name and owners already used on this board; typing a new name offers it too",
+			"↑/↓ moves selection; enter assigns; (unassigned) clears the owner",
+			"the whole batch is one undo step; esc cancels",
+		}
+	case modeAttachURL:
+		return "attach url", []string{
+			"paste or type an http:// or https:// link; the title is optional and defaults to the link host and path",
+			"from the task form the link is staged with the other resources; from task info it is saved immediately",
+			"links already attached to the task are skipped",
+			"tab switches fields; enter attaches; esc cancels",
+		}
+	case modeGoToColumn:
+		return "go to column", []string{
+			"type part of a column name; matches are fuzzy-ranked within the current project",
+			"↑/↓ moves selection; enter focuses the column and resets task selection",
+			"esc cancels",
+		}
+	case modeWatchedTasks:
+		return "watched", []string{
+			"lists watched tasks from every active project, whatever column they are in",
+			"j/k moves selection; enter jumps to the task and switches project when needed",
+			m.keys.toggleWatch.Help().Key + " unwatches the selected task; esc closes",
+		}
+	case modeRecentTasks:
+		return "recently viewed", []string{
+			"lists tasks opened in task info this session, newest first",
+			"j/k moves selection; enter jumps to the task and switches project when needed",
+			"esc closes",
+		}
+	case modeJumpToTask:
+		return "jump to task", []string{
+			"type or paste a task id; a unique id prefix also works",
+			"enter jumps and switches project when needed; esc cancels",
+		}
 	case modeBootstrapSettings:
 		return "bootstrap settings", []string{
 			"tab cycles name, default path, and save focus",
@@ -12070,10 +13221,23 @@ func (m Model) taskIndexAtRow(tasks []domain.Task, row int) int {
 	if row <= 0 {
 		return 0
 	}
+	parentByID := map[string]string{}
+	for _, task := range tasks {
+		parentByID[task.ID] = task.ParentID
+	}
+	var taskByID map[string]domain.Task
+	if m.titleWrap {
+		taskByID = m.tasksByID()
+	}
 	current := 0
 	for idx, task := range tasks {
 		start := current
 		span := 1
+		if m.titleWrap {
+			// Use the board's own title width so wrapped titles map clicks to the right card.
+			width := m.cardTitleWidth(task, taskDepth(task.ID, parentByID, 0), m.columnWidth(), taskByID)
+			span = len(m.boardTitleLines(task.Title, width))
+		}
 		if m.taskListSecondary(task) != "" {
 			span++
 		}
@@ -12089,13 +13253,34 @@ func (m Model) taskIndexAtRow(tasks []domain.Task, row int) int {
 	return len(tasks) - 1
 }
 
+// cardTitleWidth returns the width left for a board card title in a column of columnWidth, after the row prefix,
+// the indent for depth (capped at four levels), and every glyph drawn on the title row: attention and dependency
+// badges, the critical, blocked, and watched markers, and the label glyph.
+func (m Model) cardTitleWidth(task domain.Task, depth, columnWidth int, taskByID map[string]domain.Task) int {
+	markers := m.taskDependencyBadges(task) + m.criticalPriorityMarker(task) + m.blockedTaskMarker(task) + watchedTaskMarker(task)
+	if count := m.taskAttentionCount(task, taskByID); count > 0 {
+		markers += fmt.Sprintf(" !%d", count)
+	}
+	_, labelGlyph := m.taskLabelGlyph(task)
+	return max(1, columnWidth-(10+2*min(depth, 4))-lipgloss.Width(markers)-lipgloss.Width(labelGlyph))
+}
+
+// criticalPriorityMarker returns the red title marker for open critical-priority tasks, or "" otherwise.
+func (m Model) criticalPriorityMarker(task domain.Task) string {
+	if !m.taskFields.ShowPriority || task.Priority != domain.PriorityCritical || !m.taskIsOpen(task) {
+		return ""
+	}
+	return " " + lipgloss.NewStyle().Foreground(lipgloss.Color(m.theme().Critical)).Bold(true).Render("!!")
+}
+
 // cardMeta handles card meta.
 func (m Model) cardMeta(task domain.Task) string {
 	parts := make([]string, 0, 4)
 	if marker := taskHierarchyMarker(task); marker != "" {
 		parts = append(parts, marker)
 	}
-	if m.taskFields.ShowPriority {
+	// Tasks without a priority carry no priority marker.
+	if m.taskFields.ShowPriority && task.Priority != domain.PriorityNone {
 		parts = append(parts, string(task.Priority))
 	}
 	if task.Kind != domain.WorkKindSubtask {
@@ -12211,11 +13396,13 @@ func (m *Model) openTaskInfo(taskID string, status string) bool {
 	m.taskInfoOriginTaskID = taskID
 	m.taskInfoPath = []string{taskID}
 	m.taskInfoSubtaskIdx = 0
+	m.taskInfoResourceIdx = 0
 	m.taskInfoDetails.SetYOffset(0)
 	m.taskInfoBody.SetYOffset(0)
 	m.loadTaskInfoComments(taskID)
 	m.syncTaskInfoDetailsViewport(task)
 	m.syncTaskInfoBodyViewport(task)
+	m.recordRecentTask(task)
 	if strings.TrimSpace(status) == "" {
 		status = "task info"
 	}
@@ -12230,6 +13417,7 @@ func (m *Model) closeTaskInfo(status string) {
 	m.taskInfoOriginTaskID = ""
 	m.taskInfoPath = nil
 	m.taskInfoSubtaskIdx = 0
+	m.taskInfoResourceIdx = 0
 	m.taskInfoDetails.SetYOffset(0)
 	m.taskInfoBody.SetYOffset(0)
 	m.clearTaskInfoComments()
@@ -12295,6 +13483,9 @@ func (m Model) taskDescriptionPreviewViewportForContentWidth(markdown string, co
 
 // taskInfoDescriptionViewport builds the bounded markdown-details viewport for task-info rendering.
 func (m Model) taskInfoDescriptionViewport(task domain.Task, boxWidth int) viewport.Model {
+	if m.taskInfoDescriptionRaw {
+		return m.rawDescriptionPreviewViewport(task.Description, boxWidth)
+	}
 	return m.taskDescriptionPreviewViewport(task.Description, boxWidth)
 }
 
@@ -12303,7 +13494,7 @@ func (m *Model) syncTaskInfoDetailsViewport(task domain.Task) {
 	if m == nil {
 		return
 	}
-	m.taskInfoDetails = m.taskDescriptionPreviewViewport(task.Description, taskInfoOverlayBoxWidth(max(0, m.fullPageNodeContentWidth())))
+	m.taskInfoDetails = m.taskInfoDescriptionViewport(task, taskInfoOverlayBoxWidth(max(0, m.fullPageNodeContentWidth())))
 }
 
 func (m Model) fullPageNodeScreenHasPath() bool {
@@ -12315,7 +13506,7 @@ func (m Model) fullPageNodeContentWidth() int {
 	if m.width <= 0 {
 		return 96
 	}
-	return max(36, m.width-(2*tuiOuterHorizontalPadding))
+	return max(36, m.width-(2*m.outerHorizontalPadding()))
 }
 
 // fullPageNodeBodyHeight resolves the scrollable viewport height for full-page node surfaces.
@@ -12348,19 +13539,14 @@ func (m *Model) syncTaskFormViewportToFocus() {
 	if m == nil || (m.mode != modeAddTask && m.mode != modeEditTask) {
 		return
 	}
-	accent := lipgloss.Color("62")
-	if project, ok := m.currentProject(); ok {
-		accent = projectAccentColor(project)
-	}
-	muted := lipgloss.Color("241")
-	dim := lipgloss.Color("239")
+	accent, muted, dim := m.surfaceColors()
 	boxWidth := taskInfoOverlayBoxWidth(max(0, m.fullPageNodeContentWidth()))
 	title := "New " + m.taskFormNodeLabel()
 	if m.mode == modeEditTask {
 		title = "Edit " + m.taskFormNodeLabel()
 	}
 	metrics := m.fullPageSurfaceMetrics(accent, muted, dim, boxWidth, title, m.taskFormHeaderMeta(), "")
-	bodyLines, focusLine := m.taskFormBodyLines(metrics.contentWidth, lipgloss.NewStyle(), lipgloss.Color("252"))
+	bodyLines, focusLine := m.taskFormBodyLines(metrics.contentWidth, lipgloss.NewStyle(), lipgloss.Color(m.theme().Text))
 	prevYOffset := m.taskInfoBody.YOffset()
 	m.taskInfoBody.SetWidth(metrics.contentWidth)
 	m.taskInfoBody.SetHeight(max(1, metrics.bodyHeight))
@@ -12468,7 +13654,11 @@ func (m Model) taskFormActionFieldSummary(field int) string {
 	switch field {
 	case taskFieldDue:
 		if field >= 0 && field < len(m.formInputs) {
-			return strings.TrimSpace(m.formInputs[field].Value())
+			summary := strings.TrimSpace(m.formInputs[field].Value())
+			if m.taskFormRecurEvery != "" {
+				summary = strings.TrimSpace(summary + " • every " + m.taskFormRecurEvery)
+			}
+			return summary
 		}
 	case taskFieldLabels:
 		if field >= 0 && field < len(m.formInputs) {
@@ -12609,14 +13799,14 @@ func (m Model) taskFormBodyLines(contentWidth int, hintStyle lipgloss.Style, acc
 	}
 
 	if warning := dueWarning(m.formInputs[taskFieldDue].Value(), time.Now().UTC()); warning != "" {
-		lines = append(lines, lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("203")).Render(warning))
+		lines = append(lines, lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(m.theme().Warning)).Render(warning))
 	}
 
 	priorityLabel := hintStyle.Render("priority:")
 	if m.formFocus == taskFieldPriority {
 		priorityLabel = focusStyle.Render("priority:")
 	}
-	priorityLine := priorityLabel + " " + m.renderPriorityPicker(accent, lipgloss.Color("241"))
+	priorityLine := priorityLabel + " " + m.renderPriorityPicker(accent, lipgloss.Color(m.theme().Muted))
 	if m.formFocus == taskFieldPriority {
 		priorityLine = markViewportFocus(priorityLine)
 	}
@@ -12626,7 +13816,35 @@ func (m Model) taskFormBodyLines(contentWidth int, hintStyle lipgloss.Style, acc
 	}
 
 	appendTaskFormActionRow(&lines, hintStyle, focusStyle, taskFieldDue, m.formFocus, "due", m.taskFormActionFieldSummary(taskFieldDue), &focusLine)
+	remindersInput := m.formInputs[taskFieldReminders]
+	remindersInput.SetWidth(max(18, contentWidth-12))
+	remindersLabel := hintStyle.Render("reminders:")
+	if m.formFocus == taskFieldReminders {
+		remindersLabel = focusStyle.Render("reminders:")
+	}
+	remindersLine := remindersLabel + " " + remindersInput.View()
+	if m.formFocus == taskFieldReminders {
+		remindersLine = markViewportFocus(remindersLine)
+	}
+	lines = append(lines, remindersLine)
+	if m.formFocus == taskFieldReminders {
+		setFocus()
+	}
 	appendTaskFormActionRow(&lines, hintStyle, focusStyle, taskFieldLabels, m.formFocus, "labels", m.taskFormActionFieldSummary(taskFieldLabels), &focusLine)
+	assigneeInput := m.formInputs[taskFieldAssignee]
+	assigneeInput.SetWidth(max(18, contentWidth-11))
+	assigneeLabel := hintStyle.Render("assignee:")
+	if m.formFocus == taskFieldAssignee {
+		assigneeLabel = focusStyle.Render("assignee:")
+	}
+	assigneeLine := assigneeLabel + " " + assigneeInput.View()
+	if m.formFocus == taskFieldAssignee {
+		assigneeLine = markViewportFocus(assigneeLine)
+	}
+	lines = append(lines, assigneeLine)
+	if m.formFocus == taskFieldAssignee {
+		setFocus()
+	}
 
 	lines = append(lines, "")
 	lines = append(lines, hintStyle.Render("dependencies:"))
@@ -12724,6 +13942,9 @@ func (m Model) taskFormBodyLines(contentWidth int, hintStyle lipgloss.Style, acc
 				location = strings.TrimSpace(ref.BaseAlias) + ":" + location
 			}
 			line := "  " + fmt.Sprintf("%s %s", ref.ResourceType, truncate(location, 56))
+			if ref.ResourceType == domain.ResourceTypeURL {
+				line = "  " + urlResourceSummary(ref, 56)
+			}
 			if m.formFocus == taskFieldResources && selectedResourceRow == idx+1 {
 				line = markViewportFocus(activeRowStyle.Render("> " + strings.TrimSpace(line)))
 				focusLine = len(lines)
@@ -12800,6 +14021,9 @@ func (m Model) projectFormBodyLines(contentWidth int, hintStyle lipgloss.Style,
 	renderProjectInput("homepage", projectFieldHomepage)
 	renderProjectInput("tags", projectFieldTags)
 	renderProjectInput("root_path", projectFieldRootPath)
+	if len(m.projectFormInputs) > projectFieldColumns {
+		renderProjectInput("columns", projectFieldColumns)
+	}
 
 	if m.mode == modeEditProject && strings.TrimSpace(m.editingProjectID) != "" {
 		for _, project := range m.projects {
@@ -12835,14 +14059,23 @@ func (m Model) taskInfoBodyLines(task domain.Task, boxWidth, contentWidth int, h
 	}
 	lines := []string{task.Title, ""}
 	detailsViewport := m.taskInfoDescriptionViewport(task, boxWidth)
-	lines = append(lines, hintStyle.Render("description:"))
+	lines = append(lines, hintStyle.Render(m.taskInfoDescriptionLabel()))
 	lines = append(lines, detailsViewport.View())
 	lines = append(lines, "")
 	lines = append(lines, hintStyle.Render("priority: "+string(task.Priority)))
 	lines = append(lines, hintStyle.Render("due: "+due))
+	if task.Metadata.RecurEvery != "" {
+		lines = append(lines, hintStyle.Render("repeats: every "+task.Metadata.RecurEvery))
+	}
+	if len(task.Metadata.Reminders) > 0 {
+		lines = append(lines, hintStyle.Render("reminders: "+strings.Join(task.Metadata.Reminders, ", ")+" before due"))
+	}
 	lines = append(lines, hintStyle.Render("labels: "+labels))
+	if assignee := strings.TrimSpace(task.Metadata.Assignee); assignee != "" {
+		lines = append(lines, hintStyle.Render("assignee: "+assignee))
+	}
 	if warning := m.taskDueWarning(task, time.Now().UTC()); warning != "" {
-		lines = append(lines, lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("203")).Render(warning))
+		lines = append(lines, lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(m.theme().Warning)).Render(warning))
 	}
 
 	subtasks := m.subtasksForParent(task.ID)
@@ -12921,20 +14154,41 @@ func (m Model) taskInfoBodyLines(task domain.Task, boxWidth, contentWidth int, h
 	}
 
 	lines = append(lines, "")
-	lines = append(lines, hintStyle.Render("resources:"))
+	resourcesHeader := hintStyle.Render("resources:")
+	if stale := staleResourceCount(task); stale > 0 {
+		resourcesHeader += " " + staleResourceStyle.Render(fmt.Sprintf("%s %d stale", staleResourceGlyph, stale))
+	}
+	lines = append(lines, resourcesHeader)
 	if len(task.Metadata.ResourceRefs) == 0 {
 		lines = append(lines, hintStyle.Render("(none)"))
 	} else {
-		for idx, ref := range task.Metadata.ResourceRefs {
-			if idx >= 4 {
-				lines = append(lines, hintStyle.Render(fmt.Sprintf("+%d more", len(task.Metadata.ResourceRefs)-idx)))
-				break
+		refs := task.Metadata.ResourceRefs
+		selected := clamp(m.taskInfoResourceIdx, 0, len(refs)-1)
+		// Show at most four refs, sliding the window so the highlighted one stays visible.
+		start := max(0, selected-3)
+		end := min(len(refs), start+4)
+		for idx := start; idx < end; idx++ {
+			ref := refs[idx]
+			marker := "  "
+			if idx == selected {
+				marker = "› "
+			}
+			if ref.ResourceType == domain.ResourceTypeURL {
+				lines = append(lines, marker+hintStyle.Render("link ")+urlResourceStyle.Render(truncate(urlResourceTitle(ref), 32))+hintStyle.Render(" "+truncate(ref.Location, 48)))
+				continue
 			}
 			location := strings.TrimSpace(ref.Location)
 			if ref.PathMode == domain.PathModeRelative && strings.TrimSpace(ref.BaseAlias) != "" {
 				location = strings.TrimSpace(ref.BaseAlias) + ":" + location
 			}
-			lines = append(lines, hintStyle.Render(fmt.Sprintf("%s %s", ref.ResourceType, truncate(location, 48))))
+			line := marker + hintStyle.Render(fmt.Sprintf("%s %s", ref.ResourceType, truncate(location, 48)))
+			if ref.IsStale() {
+				line += " " + staleResourceStyle.Render("(missing)")
+			}
+			lines = append(lines, line)
+		}
+		if hidden := len(refs) - (end - start); hidden > 0 {
+			lines = append(lines, hintStyle.Render(fmt.Sprintf("+%d more", hidden)))
 		}
 	}
 	renderMetadataMarkdown := func(label, value string) {
@@ -12972,7 +14226,7 @@ func (m Model) taskInfoBodyLines(task domain.Task, boxWidth, contentWidth int, h
 	lines = append(lines, hintStyle.Render("parent: "+fallbackText(strings.TrimSpace(task.ParentID), "-")))
 	lines = append(lines, hintStyle.Render("kind: "+fallbackText(strings.TrimSpace(string(task.Kind)), "-")))
 	lines = append(lines, hintStyle.Render("scope: "+string(task.Scope)))
-	lines = append(lines, hintStyle.Render("state: "+fallbackText(strings.TrimSpace(string(task.LifecycleState)), "-")))
+	lines = append(lines, hintStyle.Render("state: "+taskStateLabel(task)))
 	lines = append(lines, hintStyle.Render("column: "+fallbackText(strings.TrimSpace(task.ColumnID), "-")))
 	lines = append(lines, hintStyle.Render(fmt.Sprintf("position: %d", task.Position)))
 	lines = append(lines, hintStyle.Render("created_at: "+task.CreatedAt.In(time.Local).Format(time.RFC3339)))
@@ -13004,12 +14258,7 @@ func (m *Model) syncTaskInfoBodyViewport(task domain.Task) {
 	if m == nil {
 		return
 	}
-	accent := lipgloss.Color("62")
-	if project, ok := m.currentProject(); ok {
-		accent = projectAccentColor(project)
-	}
-	muted := lipgloss.Color("241")
-	dim := lipgloss.Color("239")
+	accent, muted, dim := m.surfaceColors()
 	boxWidth := taskInfoOverlayBoxWidth(max(0, m.fullPageNodeContentWidth()))
 	metrics := m.fullPageSurfaceMetrics(accent, muted, dim, boxWidth, taskInfoNodeLabel(task)+" Info", m.taskInfoHeaderMeta(task), "")
 	prevYOffset := m.taskInfoBody.YOffset()
@@ -13058,6 +14307,7 @@ func (m *Model) stepBackTaskInfoPath() bool {
 		}
 		m.taskInfoTaskID = prevID
 		m.taskInfoSubtaskIdx = 0
+		m.taskInfoResourceIdx = 0
 		m.taskInfoDetails.SetYOffset(0)
 		m.taskInfoBody.SetYOffset(0)
 		m.loadTaskInfoComments(prevID)
@@ -13082,6 +14332,7 @@ func (m *Model) stepBackTaskInfo(task domain.Task) bool {
 	}
 	m.taskInfoTaskID = parentID
 	m.taskInfoSubtaskIdx = 0
+	m.taskInfoResourceIdx = 0
 	m.taskInfoDetails.SetYOffset(0)
 	m.taskInfoBody.SetYOffset(0)
 	m.loadTaskInfoComments(parentID)
@@ -13298,6 +14549,9 @@ func (m Model) firstIncompleteColumnIndex() (int, bool) {
 
 // toggleFocusedSubtaskCompletion toggles done/non-done state for the focused subtask in task-info mode.
 func (m Model) toggleFocusedSubtaskCompletion(parent domain.Task) (tea.Model, tea.Cmd) {
+	if m.readOnly {
+		return m.readOnlyBlocked("subtask completion")
+	}
 	subtasks := m.subtasksForParent(parent.ID)
 	if len(subtasks) == 0 {
 		m.status = "no subtasks"
@@ -13560,22 +14814,14 @@ func (m Model) activeBottomHelpKeyMap() staticHelpKeyMap {
 		return staticHelpKeyMap{short: short, full: [][]key.Binding{short}}
 	default:
 		if m.mode == modeNone {
-			short := []key.Binding{
-				helpBinding("n", "new task"),
-				helpBinding("enter", "task info"),
-				helpBinding("e", "edit"),
-				helpBinding("tab", "panels"),
-				helpBinding("/", "search"),
-				helpBinding(":", "commands"),
-				helpBinding("q", "quit"),
-				helpBinding("?", "help"),
-			}
+			short := m.boardFooterHelpBindings()
 			full := [][]key.Binding{
 				short,
 				{
 					helpBinding("h/l", "columns"),
 					helpBinding("j/k", "tasks"),
 					helpBinding("[/]", "move"),
+					helpBinding("</>", "move column"),
 					helpBinding("space", "select"),
 					helpBinding("f/F", "subtree"),
 					helpBinding(":/.", "actions"),
@@ -13646,12 +14892,7 @@ func renderNodeModalViewport(accent, muted color.Color, boxWidth int, title, sub
 
 // renderFullPageNodeModeView renders task/project info and form modes through one measured full-page surface contract.
 func (m Model) renderFullPageNodeModeView() tea.View {
-	accent := lipgloss.Color("62")
-	if project, ok := m.currentProject(); ok {
-		accent = projectAccentColor(project)
-	}
-	muted := lipgloss.Color("241")
-	dim := lipgloss.Color("239")
+	accent, muted, dim := m.surfaceColors()
 	hintStyle := lipgloss.NewStyle().Foreground(muted)
 	boxWidth := taskInfoOverlayBoxWidth(max(0, m.fullPageNodeContentWidth()))
 
@@ -13708,6 +14949,35 @@ func (m Model) renderFullPageNodeModeView() tea.View {
 // renderModeOverlay renders output for the current model state.
 func (m Model) renderModeOverlay(accent, muted, dim color.Color, helpStyle lipgloss.Style, maxWidth int) string {
 	switch m.mode {
+	case modeInbox:
+		return m.renderInboxOverlay(accent, muted, maxWidth)
+	case modeRecentTasks:
+		return m.renderRecentTasksOverlay(accent, muted, maxWidth)
+	case modeWatchedTasks:
+		return m.renderWatchedTasksOverlay(accent, muted, maxWidth)
+	case modeExportTask:
+		return m.renderExportTaskOverlay(accent, muted, maxWidth)
+	case modeGoToColumn:
+		return m.renderGoToColumnOverlay(accent, muted, maxWidth)
+	case modeTemplatePicker:
+		return m.renderTemplatePickerOverlay(accent, muted, maxWidth)
+	case modeSavedSearch:
+		return m.renderSavedSearchOverlay(accent, muted, maxWidth)
+	case modeAssignPicker:
+		return m.renderAssignPickerOverlay(accent, muted, maxWidth)
+	case modeAttachURL:
+		return m.renderAttachURLOverlay(accent, muted, maxWidth)
+	case modeEditColumn:
+		return m.renderColumnEditOverlay(accent, muted, maxWidth)
+	case modeRecoverDraft:
+		return m.renderRecoverDraftOverlay(accent, muted, maxWidth)
+	case modeDuplicateTitle:
+		return m.renderDuplicateTitleOverlay(accent, muted, maxWidth)
+	case modeCatchUp:
+		return m.renderCatchUpOverlay(accent, muted, maxWidth)
+	case modeDueDigest:
+		return m.renderDueDigestOverlay(accent, muted, maxWidth)
+
 	case modeActivityLog:
 		style := lipgloss.NewStyle().
 			Border(lipgloss.RoundedBorder()).
@@ -13912,8 +15182,18 @@ func (m Model) renderModeOverlay(accent, muted, dim color.Color, helpStyle lipgl
 		hintStyle := lipgloss.NewStyle().Foreground(muted)
 		filterInput := m.labelPickerInput
 		filterInput.SetWidth(max(18, min(56, maxWidth-22)))
+		title := "Label Picker"
+		enterHint := "enter add label"
+		switch m.labelPickerBulk {
+		case labelPickerBulkAdd:
+			title = fmt.Sprintf("Add Label To %d Selected", len(m.selectedTaskIDs))
+			enterHint = "enter add to selected"
+		case labelPickerBulkRemove:
+			title = fmt.Sprintf("Remove Label From %d Selected", len(m.selectedTaskIDs))
+			enterHint = "enter remove from selected"
+		}
 		lines := []string{
-			titleStyle.Render("Label Picker"),
+			titleStyle.Render(title),
 			hintStyle.Render("filter: ") + filterInput.View(),
 			hintStyle.Render("sources: global/project/branch/phase/suggested/default"),
 		}
@@ -13932,7 +15212,7 @@ func (m Model) renderModeOverlay(accent, muted, dim color.Color, helpStyle lipgl
 				}
 			}
 		}
-		lines = append(lines, hintStyle.Render("type to filter • j/k navigate • enter add label • ctrl+u clear • esc close"))
+		lines = append(lines, hintStyle.Render("type to filter • j/k navigate • "+enterHint+" • ctrl+u clear • esc close"))
 		return style.Render(strings.Join(lines, "\n"))
 
 	case modeDuePicker:
@@ -13970,6 +15250,13 @@ func (m Model) renderModeOverlay(accent, muted, dim color.Color, helpStyle lipgl
 			}
 			lines = append(lines, timeLine)
 		}
+		repeatInput := m.duePickerRepeatInput
+		repeatInput.SetWidth(max(12, min(36, maxWidth-20)))
+		repeatLine := "repeat: " + repeatInput.View()
+		if m.duePickerFocus == 4 {
+			repeatLine = focusedStyle.Render("repeat:") + " " + repeatInput.View()
+		}
+		lines = append(lines, repeatLine)
 		lines = append(lines, "")
 		options := m.duePickerOptions()
 		start, end := windowBounds(len(options), m.duePicker, 10)
@@ -14008,7 +15295,10 @@ func (m Model) renderModeOverlay(accent, muted, dim color.Color, helpStyle lipgl
 				if idx == m.projectPickerIndex {
 					cursor = "> "
 				}
-				label := projectDisplayLabel(p)
+				label := m.projectDisplayLabel(p)
+				if _, marked := m.projectPickerMarked[p.ID]; marked {
+					label = "✓ " + label
+				}
 				lines = append(lines, cursor+label)
 			}
 		}
@@ -14016,7 +15306,7 @@ func (m Model) renderModeOverlay(accent, muted, dim color.Color, helpStyle lipgl
 		if len(m.projects) == 0 {
 			lines = append(lines, helpStyle.Render("enter/N create • A toggle archived • esc close"))
 		} else {
-			lines = append(lines, helpStyle.Render("j/k or wheel • enter choose • N new • A toggle archived • esc cancel"))
+			lines = append(lines, helpStyle.Render("j/k or wheel • enter choose • space mark • x export • N new • A toggle archived • esc cancel"))
 		}
 		return pickerStyle.Render(strings.Join(lines, "\n"))
 
@@ -14050,6 +15340,9 @@ func (m Model) renderModeOverlay(accent, muted, dim color.Color, helpStyle lipgl
 					levelLabel = "-"
 				}
 				row := fmt.Sprintf("%s%s • %s • %s • %s", cursor, match.Project.Name, levelLabel, match.StateID, truncate(match.Task.Title, 40))
+				if match.CommentMatch {
+					row += " " + hintStyle.Render("[in comments]")
+				}
 				lines = append(lines, row)
 			}
 		}
@@ -14252,7 +15545,7 @@ func (m Model) renderModeOverlay(accent, muted, dim color.Color, helpStyle lipgl
 		hintStyle := lipgloss.NewStyle().Foreground(muted)
 		projectLabel := "(none)"
 		if project, ok := m.currentProject(); ok {
-			projectLabel = projectDisplayName(project)
+			projectLabel = m.projectDisplayName(project)
 			if slug := strings.TrimSpace(strings.ToLower(project.Slug)); slug != "" {
 				projectLabel += " (" + slug + ")"
 			}
@@ -14281,6 +15574,9 @@ func (m Model) renderModeOverlay(accent, muted, dim color.Color, helpStyle lipgl
 		if len(m.pendingConfirm.TaskIDs) > 1 {
 			targetTitle = fmt.Sprintf("%d selected tasks", len(m.pendingConfirm.TaskIDs))
 		}
+		if m.pendingConfirm.Kind == "archive-done" {
+			targetTitle = fmt.Sprintf("%d tasks in done columns", len(m.pendingConfirm.TaskIDs))
+		}
 		if strings.TrimSpace(m.pendingConfirm.Project.ID) != "" {
 			targetTitle = strings.TrimSpace(m.pendingConfirm.Project.Name)
 			if targetTitle == "" {
@@ -14288,6 +15584,9 @@ func (m Model) renderModeOverlay(accent, muted, dim color.Color, helpStyle lipgl
 			}
 			targetTitle = "project " + targetTitle
 		}
+		if detail := strings.TrimSpace(m.pendingConfirm.Detail); detail != "" {
+			targetTitle = detail
+		}
 		if targetTitle == "" {
 			targetTitle = "(unknown target)"
 		}
@@ -14301,20 +15600,30 @@ func (m Model) renderModeOverlay(accent, muted, dim color.Color, helpStyle lipgl
 		lines := []string{
 			titleStyle.Render("Confirm Action"),
 			fmt.Sprintf("%s: %s", m.pendingConfirm.Label, targetTitle),
-			confirmStyle.Render("[confirm]") + "  " + cancelStyle.Render("[cancel]"),
-			hintStyle.Render("enter apply • esc cancel • h/l switch • y confirm • n cancel"),
 		}
+		if m.pendingConfirm.Kind == "delete" && m.pendingConfirm.Mode == app.DeleteModeHard {
+			if impact := m.hardDeleteSubtaskImpact(m.pendingConfirm.TaskIDs); impact != "" {
+				lines = append(lines, lipgloss.NewStyle().Foreground(lipgloss.Color(m.theme().Warning)).Render(impact))
+			}
+		}
+		if m.pendingConfirm.Kind == "archive-subtree" {
+			lines = append(lines, hintStyle.Render(fmt.Sprintf("also archives %d subtasks", len(m.descendantTaskIDs(m.pendingConfirm.Task.ID)))))
+		}
+		lines = append(lines,
+			confirmStyle.Render("[confirm]")+"  "+cancelStyle.Render("[cancel]"),
+			hintStyle.Render("enter apply • esc cancel • h/l switch • y confirm • n cancel"),
+		)
 		return style.Render(strings.Join(lines, "\n"))
 
 	case modeWarning:
 		style := lipgloss.NewStyle().
 			Border(lipgloss.RoundedBorder()).
-			BorderForeground(lipgloss.Color("203")).
+			BorderForeground(lipgloss.Color(m.theme().Warning)).
 			Padding(0, 1)
 		if maxWidth > 0 {
 			style = style.Width(clamp(maxWidth, 36, 96))
 		}
-		titleStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("203"))
+		titleStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(m.theme().Warning))
 		hintStyle := lipgloss.NewStyle().Foreground(muted)
 		title := strings.TrimSpace(m.warningTitle)
 		if title == "" {
@@ -14349,8 +15658,8 @@ func (m Model) renderModeOverlay(accent, muted, dim color.Color, helpStyle lipgl
 			const quickActionWindowSize = 11
 			start, end := windowBounds(len(actions), m.quickActionIndex, quickActionWindowSize)
 			enabledActiveStyle := lipgloss.NewStyle().Bold(true).Foreground(accent)
-			disabledStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("243"))
-			disabledActiveStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("243"))
+			disabledStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(m.theme().Archived))
+			disabledActiveStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(m.theme().Archived))
 			for idx := start; idx < end; idx++ {
 				action := actions[idx]
 				cursor := "  "
@@ -14381,7 +15690,7 @@ func (m Model) renderModeOverlay(accent, muted, dim color.Color, helpStyle lipgl
 	case modeDescriptionEditor:
 		return ""
 
-	case modeAddTask, modeSearch, modeRenameTask, modeEditTask, modeAddProject, modeEditProject, modeLabelsConfig, modeHighlightColor:
+	case modeAddTask, modeSearch, modeRenameTask, modeEditTask, modeAddProject, modeEditProject, modeLabelsConfig, modeHighlightColor, modeJumpToTask:
 		title := "Input"
 		hint := "enter save • esc cancel • tab next field"
 		switch m.mode {
@@ -14405,6 +15714,9 @@ func (m Model) renderModeOverlay(accent, muted, dim color.Color, helpStyle lipgl
 		case modeHighlightColor:
 			title = "Highlight Color"
 			hint = "enter save • esc cancel • empty resets to default"
+		case modeJumpToTask:
+			title = "Jump To Task"
+			hint = "enter jump • esc cancel"
 		}
 
 		hintStyle := lipgloss.NewStyle().Foreground(muted)
@@ -14517,8 +15829,34 @@ func (m Model) renderModeOverlay(accent, muted, dim color.Color, helpStyle lipgl
 			} else {
 				lines = append(lines, archivedLabel.Render("archived: hidden"))
 			}
-			applyLabel := hintStyle
+			commentsLabel := lipgloss.NewStyle().Foreground(muted)
 			if m.searchFocus == 5 {
+				commentsLabel = lipgloss.NewStyle().Bold(true).Foreground(accent)
+			}
+			if m.searchIncludeComments {
+				lines = append(lines, commentsLabel.Render("comments: searched"))
+			} else {
+				lines = append(lines, commentsLabel.Render("comments: skipped"))
+			}
+			for _, due := range []struct {
+				slot  int
+				label string
+				input textinput.Model
+			}{
+				{slot: searchFocusDueFrom, label: "due from:", input: m.searchDueFromInput},
+				{slot: searchFocusDueTo, label: "due to:", input: m.searchDueToInput},
+				{slot: searchFocusCreatedFrom, label: "created from:", input: m.searchCreatedFromInput},
+				{slot: searchFocusCreatedTo, label: "created to:", input: m.searchCreatedToInput},
+			} {
+				dueLabel := lipgloss.NewStyle().Foreground(muted)
+				if m.searchFocus == due.slot {
+					dueLabel = lipgloss.NewStyle().Bold(true).Foreground(accent)
+				}
+				due.input.SetWidth(max(18, contentWidth-16))
+				lines = append(lines, dueLabel.Render(fmt.Sprintf("%-13s", due.label))+" "+due.input.View())
+			}
+			applyLabel := hintStyle
+			if m.searchFocus == searchFocusApply {
 				applyLabel = lipgloss.NewStyle().Bold(true).Foreground(accent)
 			}
 			lines = append(lines, applyLabel.Render("[ apply search ]"))
@@ -14544,6 +15882,11 @@ func (m Model) renderModeOverlay(accent, muted, dim color.Color, helpStyle lipgl
 			lines = append(lines, hintStyle.Render("focused-row color (ansi index or #RRGGBB)"))
 			lines = append(lines, "value: "+in.View())
 			lines = append(lines, hintStyle.Render("example: 212 (fuchsia)"))
+		case modeJumpToTask:
+			in := m.jumpTaskInput
+			in.SetWidth(max(18, contentWidth-10))
+			lines = append(lines, hintStyle.Render("searches every project, archived tasks included"))
+			lines = append(lines, in.View())
 		default:
 			lines = append(lines, m.input)
 		}
@@ -14618,10 +15961,8 @@ func parseTaskEditInput(raw string, current domain.Task) (app.UpdateTaskInput, e
 	if priority == "" {
 		priority = current.Priority
 	}
-	switch priority {
-	case domain.PriorityLow, domain.PriorityMedium, domain.PriorityHigh:
-	default:
-		return app.UpdateTaskInput{}, fmt.Errorf("priority must be low|medium|high")
+	if !domain.IsValidPriority(priority) {
+		return app.UpdateTaskInput{}, errors.New(priorityValidationStatus)
 	}
 
 	dueAt, err := parseDueInput(parts[3], current.DueAt)
@@ -14683,6 +16024,8 @@ func (m Model) modeLabel() string {
 		return "actions"
 	case modeActivityLog:
 		return "activity"
+	case modeInbox:
+		return "inbox"
 	case modeActivityEventInfo:
 		return "activity-event"
 	case modeConfirmAction:
@@ -14699,6 +16042,34 @@ func (m Model) modeLabel() string {
 		return "labels-config"
 	case modeHighlightColor:
 		return "highlight-color"
+	case modeJumpToTask:
+		return "jump"
+	case modeRecentTasks:
+		return "recent"
+	case modeWatchedTasks:
+		return "watched"
+	case modeExportTask:
+		return "export"
+	case modeGoToColumn:
+		return "column"
+	case modeTemplatePicker:
+		return "template"
+	case modeSavedSearch:
+		return "saved search"
+	case modeAssignPicker:
+		return "assign"
+	case modeAttachURL:
+		return "attach-url"
+	case modeEditColumn:
+		return "edit-column"
+	case modeRecoverDraft:
+		return "recover"
+	case modeDuplicateTitle:
+		return "duplicate"
+	case modeCatchUp:
+		return "away"
+	case modeDueDigest:
+		return "due"
 	case modeBootstrapSettings:
 		return "bootstrap"
 	case modeDependencyInspector:
@@ -14724,11 +16095,11 @@ func (m Model) modePrompt() string {
 	case modeEditTask:
 		return "edit " + strings.ToLower(m.taskFormNodeLabel()) + ": enter/e opens field actions, ctrl+s saves, up/down wrap fields, left/right list rows, esc cancels"
 	case modeDuePicker:
-		return "due picker: tab focus controls, type date/time in picker, j/k navigate list, enter apply, esc cancel"
+		return "due picker: tab focus controls, type date/time/repeat in picker, j/k navigate list, enter apply, esc cancel"
 	case modeProjectPicker:
-		return "project picker: j/k select, enter choose, N new project, A archived toggle, esc cancel"
+		return "project picker: j/k select, enter choose, space mark, x export, N new project, A archived toggle, esc cancel"
 	case modeTaskInfo:
-		return "task info: d details preview, arrows or j/k scroll, pgup/pgdown/home/end jump, e edit, s new subtask, c thread, [ / ] move, space toggles subtask complete, backspace parent, esc back"
+		return "task info: d details preview, r raw/rendered description, arrows or j/k scroll, pgup/pgdown/home/end jump, e edit, s new subtask, u attach url, tab/o select/open resource, c thread, x export, y/Y copy id/path, t state, [ / ] move, space toggles subtask complete, backspace parent, esc back"
 	case modeAddProject:
 		return "new project: enter save, i edit description, r pick root_path, esc cancel"
 	case modeEditProject:
@@ -14741,6 +16112,8 @@ func (m Model) modePrompt() string {
 		return "quick actions: j/k select, enter run, esc close"
 	case modeActivityLog:
 		return "activity log: esc close"
+	case modeInbox:
+		return "inbox: j/k select, 1-9 send to column, l send right, p priority, t/m/w due, enter info, esc close"
 	case modeActivityEventInfo:
 		return "activity event: enter/g go to node, esc back"
 	case modeConfirmAction:
@@ -14757,6 +16130,37 @@ func (m Model) modePrompt() string {
 		return "labels config: enter save, esc cancel"
 	case modeHighlightColor:
 		return "highlight color: enter save, esc cancel"
+	case modeJumpToTask:
+		return "jump to task: type or paste id, enter jump, esc cancel"
+	case modeRecentTasks:
+		return "recently viewed: j/k select, enter jump, esc close"
+	case modeWatchedTasks:
+		return "watched: j/k select, enter jump, " + m.keys.toggleWatch.Help().Key + " unwatch, esc close"
+	case modeExportTask:
+		return "export task card: f format, s subtasks, enter copy, esc cancel"
+	case modeGoToColumn:
+		return "go to column: type name, ↑/↓ select, enter go, esc cancel"
+	case modeTemplatePicker:
+		return "new from template: type name, ↑/↓ select, enter open form, esc cancel"
+	case modeSavedSearch:
+		if m.savedSearchSaving {
+			return "save search: type name, tab reuse selected, enter save, esc cancel"
+		}
+		return "load search: type name, ↑/↓ select, enter load, esc cancel"
+	case modeAssignPicker:
+		return "assign: type name, ↑/↓ select, enter assign, esc cancel"
+	case modeAttachURL:
+		return "attach url: paste link, tab title, enter attach, esc cancel"
+	case modeEditColumn:
+		return "edit column: tab next field, enter save, esc cancel"
+	case modeRecoverDraft:
+		return "recover unsaved task: enter recover, d discard, esc later or keep editing"
+	case modeDuplicateTitle:
+		return "similar task exists: enter create anyway, esc back to form"
+	case modeCatchUp:
+		return "while you were away: enter/esc dismiss, a activity log"
+	case modeDueDigest:
+		return "due today: any key dismisses"
 	case modeBootstrapSettings:
 		return "bootstrap settings: tab focus, r browse/add default path, d clear path, enter save"
 	case modeDependencyInspector:
@@ -14777,6 +16181,8 @@ func normalizeBoardGroupBy(groupBy string) string {
 		return "priority"
 	case "state":
 		return "state"
+	case "assignee":
+		return "assignee"
 	default:
 		return "none"
 	}
@@ -14811,7 +16217,7 @@ func (m Model) activityEventTargetDetails(entry activityEntry) (string, string)
 	}
 	node := fallbackText(strings.TrimSpace(entry.Target), "-")
 	if project, ok := m.currentProject(); ok {
-		projectLabel := projectDisplayName(project)
+		projectLabel := m.projectDisplayName(project)
 		if projectLabel == "" {
 			projectLabel = "(project)"
 		}
@@ -14842,7 +16248,7 @@ func (m Model) activityTaskPath(task domain.Task) string {
 	}
 	slices.Reverse(chain)
 	if project, ok := m.currentProject(); ok {
-		projectLabel := projectDisplayName(project)
+		projectLabel := m.projectDisplayName(project)
 		if projectLabel != "" {
 			chain = append([]string{projectLabel}, chain...)
 		}
@@ -14927,6 +16333,24 @@ func (m Model) formatActivityMetadata(entry activityEntry) []string {
 	return lines
 }
 
+// recoverableErrorStatus maps classified service errors to a status-line message, keeping the board usable.
+func recoverableErrorStatus(err error) (string, bool) {
+	switch app.ErrorCodeOf(err) {
+	case app.ErrorCodeNotFound:
+		return "not found: " + err.Error(), true
+	case app.ErrorCodeValidation:
+		return "invalid input: " + err.Error(), true
+	case app.ErrorCodeConflict:
+		return "blocked: " + err.Error(), true
+	case app.ErrorCodePermission:
+		return "not permitted: " + err.Error(), true
+	case app.ErrorCodeWIPLimit:
+		return "wip limit reached: " + err.Error(), true
+	default:
+		return "", false
+	}
+}
+
 // fallbackText returns fallback when value is blank.
 func fallbackText(value, fallback string) string {
 	if strings.TrimSpace(value) == "" {
@@ -14985,7 +16409,10 @@ func (m Model) columnWidthFor(boardWidth int) int {
 
 // noticesPanelWidth returns the right-panel width when the viewport can support it.
 func (m Model) noticesPanelWidth(totalWidth int) int {
-	if totalWidth <= 0 || len(m.columns) == 0 {
+	if totalWidth <= 0 || len(m.columns) == 0 || m.hideNoticesPanel {
+		return 0
+	}
+	if m.noticesPanelMinWidth > 0 && m.width < m.noticesPanelMinWidth {
 		return 0
 	}
 	// Preserve minimum readable column widths and the Done->Notices/right-gutter budget.
@@ -15025,26 +16452,10 @@ func (m Model) columnHeight() int {
 // boardFooterLines estimates non-board rows that should remain visible below the board panels.
 func (m Model) boardFooterLines() int {
 	lines := 0
-	if task, ok := m.selectedTaskInCurrentColumn(); ok {
-		if m.directChildCount(task.ID) > 0 || strings.TrimSpace(m.projectionRootTaskID) != "" {
-			lines++
-		}
-	} else if strings.TrimSpace(m.projectionRootTaskID) != "" {
-		lines++
-	}
 	if len(m.attentionItems) > 0 {
 		lines += 2
 	}
-	if strings.TrimSpace(m.projectionRootTaskID) != "" {
-		lines++
-	}
-	if len(m.selectedTaskIDs) > 0 {
-		lines++
-	}
-	if m.boardStatusText() != "" {
-		lines++
-	}
-	return lines
+	return lines + m.statusSegmentLineCount()
 }
 
 // headerMarkStyle returns the boxed brand style used at the top of board view.
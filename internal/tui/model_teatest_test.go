@@ -177,6 +177,54 @@ func TestModelGoldenHelpExpandedOutput(t *testing.T) {
 	teatest.RequireEqualOutput(t, captured.Bytes())
 }
 
+// TestModelGoldenFooterCategoryOutput verifies the footer switches to the navigation key category on H.
+func TestModelGoldenFooterCategoryOutput(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c1, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	task, _ := domain.NewTask(domain.TaskInput{
+		ID:        "t1",
+		ProjectID: p.ID,
+		ColumnID:  c1.ID,
+		Position:  0,
+		Title:     "Footer Golden Task",
+		Priority:  domain.PriorityLow,
+	}, now)
+
+	m := NewModel(newFakeService(
+		[]domain.Project{p},
+		[]domain.Column{c1},
+		[]domain.Task{task},
+	))
+	tm := teatest.NewTestModel(
+		t,
+		m,
+		teatest.WithInitialTermSize(96, 28),
+		teatest.WithProgramOptions(tea.WithEnvironment([]string{"TERM=dumb"})),
+	)
+	var captured bytes.Buffer
+	stream := io.TeeReader(tm.Output(), &captured)
+
+	// Each key is sent only once the previous frame is fully drawn, so the captured frames are the same every run.
+	teatest.WaitFor(t, stream, func(out []byte) bool {
+		return strings.Contains(string(out), "Footer Golden Task") && strings.Contains(string(out), "? help")
+	}, teatest.WithDuration(2*time.Second), teatest.WithCheckInterval(10*time.Millisecond))
+
+	tm.Send(tea.KeyPressMsg{Code: 'H', Text: "H"})
+	teatest.WaitFor(t, stream, func(out []byte) bool {
+		return strings.Contains(string(out), "footer keys: navigation") && strings.Contains(string(out), "h/l columns")
+	}, teatest.WithDuration(2*time.Second), teatest.WithCheckInterval(10*time.Millisecond))
+
+	tm.Send(tea.KeyPressMsg{Code: 'q', Text: "q"})
+	tm.WaitFinished(t, teatest.WithFinalTimeout(2*time.Second))
+
+	_, err := io.ReadAll(io.TeeReader(tm.FinalOutput(t, teatest.WithFinalTimeout(2*time.Second)), &captured))
+	if err != nil {
+		t.Fatalf("ReadAll(final output) error = %v", err)
+	}
+	teatest.RequireEqualOutput(t, captured.Bytes())
+}
+
 // TestModelWithTeatestWIPWarning verifies behavior for the covered scenario.
 func TestModelWithTeatestWIPWarning(t *testing.T) {
 	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
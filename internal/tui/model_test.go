@@ -8,6 +8,8 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"slices"
+	"sort"
 	"strings"
 	"testing"
 	"time"
@@ -19,6 +21,7 @@ import (
 	"charm.land/lipgloss/v2"
 	"github.com/hylla/tillsyn/internal/app"
 	"github.com/hylla/tillsyn/internal/domain"
+	"github.com/hylla/tillsyn/internal/platform"
 )
 
 // fakeService represents fake service data used by this package.
@@ -27,8 +30,12 @@ type fakeService struct {
 	columns               map[string][]domain.Column
 	tasks                 map[string][]domain.Task
 	lastSearchFilter      app.SearchTasksFilter
+	lastDeletePolicy      app.ParentDeletePolicy
 	lastCreateTask        app.CreateTaskInput
 	createTaskCalls       int
+	updateTaskCalls       int
+	bulkLabelCalls        int
+	bulkLabelErr          error
 	comments              map[string][]domain.Comment
 	lastCreateComment     app.CreateCommentInput
 	err                   error
@@ -39,6 +46,10 @@ type fakeService struct {
 	commentCreateErr      error
 	commentListErr        error
 	commentSeq            int
+	taskPageCalls         int
+	projectActivityCalls  int
+	lastTaskCardExport    app.ExportTaskCardInput
+	lastProjectsExport    []string
 }
 
 // newFakeService constructs fake service.
@@ -104,6 +115,263 @@ func (f *fakeService) ListTasks(_ context.Context, projectID string, includeArch
 	return out, nil
 }
 
+// ListDoneTasks lists unarchived tasks whose column name maps to the done lifecycle state.
+func (f *fakeService) ListDoneTasks(ctx context.Context, projectID string) ([]domain.Task, error) {
+	tasks, err := f.ListTasks(ctx, projectID, false)
+	if err != nil {
+		return nil, err
+	}
+	doneColumns := map[string]struct{}{}
+	for _, column := range f.columns[projectID] {
+		if lifecycleStateForColumnName(column.Name) == domain.StateDone {
+			doneColumns[column.ID] = struct{}{}
+		}
+	}
+	out := make([]domain.Task, 0)
+	for _, task := range tasks {
+		if _, ok := doneColumns[task.ColumnID]; ok {
+			out = append(out, task)
+		}
+	}
+	return out, nil
+}
+
+// ListTasksPage lists one position-ordered page of a column's board rows followed by their descendants.
+func (f *fakeService) ListTasksPage(ctx context.Context, in app.ListTasksPageInput) (app.TaskPage, error) {
+	f.taskPageCalls++
+	tasks, err := f.ListTasks(ctx, in.ProjectID, in.IncludeArchived)
+	if err != nil {
+		return app.TaskPage{}, err
+	}
+	known := make(map[string]struct{}, len(tasks))
+	for _, task := range tasks {
+		known[task.ID] = struct{}{}
+	}
+	rows := make([]domain.Task, 0)
+	count := app.TaskPageCount{}
+	for _, task := range tasks {
+		if _, hasParent := known[task.ParentID]; task.ColumnID != in.ColumnID || task.Kind == domain.WorkKindSubtask || hasParent {
+			continue
+		}
+		rows = append(rows, task)
+		count.Total++
+		if task.ArchivedAt == nil {
+			count.Active++
+		}
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		return rows[i].Position < rows[j].Position
+	})
+	start := min(in.Offset, len(rows))
+	end := min(start+in.Limit, len(rows))
+	page := append([]domain.Task(nil), rows[start:end]...)
+	inPage := map[string]struct{}{}
+	for _, task := range page {
+		inPage[task.ID] = struct{}{}
+	}
+	for grew := true; grew; {
+		grew = false
+		for _, task := range tasks {
+			_, parentIn := inPage[task.ParentID]
+			_, seen := inPage[task.ID]
+			if parentIn && !seen {
+				page = append(page, task)
+				inPage[task.ID] = struct{}{}
+				grew = true
+			}
+		}
+	}
+	return app.TaskPage{
+		Tasks:      page,
+		NextOffset: end,
+		HasMore:    end < len(rows),
+		Count:      count,
+	}, nil
+}
+
+// ListOrphanedTasks lists project tasks whose parent is missing from the fake task set.
+func (f *fakeService) ListOrphanedTasks(ctx context.Context, projectID string) ([]domain.Task, error) {
+	tasks, err := f.ListTasks(ctx, projectID, true)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]struct{}, len(tasks))
+	for _, task := range tasks {
+		byID[task.ID] = struct{}{}
+	}
+	out := make([]domain.Task, 0)
+	for _, task := range tasks {
+		if _, ok := byID[task.ParentID]; task.ParentID != "" && !ok {
+			out = append(out, task)
+		}
+	}
+	return out, nil
+}
+
+// VerifyResourceRefs flags relative local refs missing under root and stamps the ones that exist.
+func (f *fakeService) VerifyResourceRefs(_ context.Context, projectID, root string) (app.ResourceVerification, error) {
+	result := app.ResourceVerification{}
+	now := time.Now().UTC()
+	for idx := range f.tasks[projectID] {
+		task := &f.tasks[projectID][idx]
+		for refIdx := range task.Metadata.ResourceRefs {
+			ref := &task.Metadata.ResourceRefs[refIdx]
+			if ref.ResourceType != domain.ResourceTypeLocalFile || root == "" {
+				continue
+			}
+			result.Checked++
+			if _, err := os.Stat(filepath.Join(root, ref.Location)); err != nil {
+				ref.MissingSince = &now
+				result.Missing = append(result.Missing, app.MissingResourceRef{TaskID: task.ID, Location: ref.Location})
+				continue
+			}
+			result.Verified++
+			ref.LastVerifiedAt = &now
+			ref.MissingSince = nil
+		}
+	}
+	return result, nil
+}
+
+// ArchiveTaskSubtree archives one task and every active descendant.
+func (f *fakeService) ArchiveTaskSubtree(_ context.Context, taskID string) ([]domain.Task, error) {
+	return f.updateTaskSubtree(taskID, func(task *domain.Task) bool {
+		if task.ArchivedAt != nil {
+			return false
+		}
+		now := time.Now().UTC()
+		task.ArchivedAt = &now
+		return true
+	})
+}
+
+// RestoreTaskSubtree restores one task and every archived descendant.
+func (f *fakeService) RestoreTaskSubtree(_ context.Context, taskID string) ([]domain.Task, error) {
+	return f.updateTaskSubtree(taskID, func(task *domain.Task) bool {
+		if task.ArchivedAt == nil {
+			return false
+		}
+		task.ArchivedAt = nil
+		return true
+	})
+}
+
+// updateTaskSubtree applies one mutation to a task subtree and returns the tasks it changed.
+func (f *fakeService) updateTaskSubtree(taskID string, mutate func(*domain.Task) bool) ([]domain.Task, error) {
+	for projectID, tasks := range f.tasks {
+		if !slices.ContainsFunc(tasks, func(task domain.Task) bool { return task.ID == taskID }) {
+			continue
+		}
+		changed := make([]domain.Task, 0)
+		queue := []string{taskID}
+		for len(queue) > 0 {
+			id := queue[0]
+			queue = queue[1:]
+			for idx := range f.tasks[projectID] {
+				task := &f.tasks[projectID][idx]
+				if task.ID == id && mutate(task) {
+					changed = append(changed, *task)
+				}
+				if task.ParentID == id {
+					queue = append(queue, task.ID)
+				}
+			}
+		}
+		return changed, nil
+	}
+	return nil, app.ErrNotFound
+}
+
+// SetTaskLifecycleState sets or clears one explicit task lifecycle state.
+func (f *fakeService) SetTaskLifecycleState(_ context.Context, taskID string, state domain.LifecycleState) (domain.Task, error) {
+	for projectID := range f.tasks {
+		for idx := range f.tasks[projectID] {
+			task := &f.tasks[projectID][idx]
+			if task.ID != taskID {
+				continue
+			}
+			task.Metadata.LifecycleStateExplicit = state != ""
+			if state == "" {
+				state = domain.StateTodo
+			}
+			task.LifecycleState = state
+			return *task, nil
+		}
+	}
+	return domain.Task{}, app.ErrNotFound
+}
+
+// ExportTaskCard records one card export request and returns a one-line card.
+func (f *fakeService) ExportTaskCard(_ context.Context, in app.ExportTaskCardInput) ([]byte, error) {
+	f.lastTaskCardExport = in
+	return []byte("# card " + in.TaskID + "\n"), nil
+}
+
+// ExportProjectsSnapshot records one scoped export request and returns a snapshot of the requested projects.
+func (f *fakeService) ExportProjectsSnapshot(_ context.Context, projectIDs []string, _ bool) (app.Snapshot, error) {
+	f.lastProjectsExport = append([]string(nil), projectIDs...)
+	snap := app.Snapshot{Version: app.SnapshotVersion}
+	for _, project := range f.projects {
+		if slices.Contains(projectIDs, project.ID) {
+			snap.Projects = append(snap.Projects, app.SnapshotProject{ID: project.ID, Name: project.Name})
+		}
+	}
+	return snap, nil
+}
+
+// MoveColumn reorders one project's column slice so ListColumns reflects the new order.
+func (f *fakeService) MoveColumn(_ context.Context, columnID string, newPosition int) (domain.Column, error) {
+	for projectID, columns := range f.columns {
+		idx := slices.IndexFunc(columns, func(column domain.Column) bool { return column.ID == columnID })
+		if idx < 0 {
+			continue
+		}
+		if newPosition < 0 || newPosition >= len(columns) {
+			return domain.Column{}, domain.ErrInvalidPosition
+		}
+		column := columns[idx]
+		columns = slices.Insert(slices.Delete(columns, idx, idx+1), newPosition, column)
+		for pos := range columns {
+			columns[pos].Position = pos
+		}
+		f.columns[projectID] = columns
+		return columns[newPosition], nil
+	}
+	return domain.Column{}, app.ErrNotFound
+}
+
+// UpdateColumn renames one stored column and sets its WIP limit.
+func (f *fakeService) UpdateColumn(_ context.Context, in app.UpdateColumnInput) (domain.Column, error) {
+	for projectID := range f.columns {
+		for idx := range f.columns[projectID] {
+			if f.columns[projectID][idx].ID != in.ColumnID {
+				continue
+			}
+			f.columns[projectID][idx].Name = in.Name
+			f.columns[projectID][idx].WIPLimit = in.WIPLimit
+			return f.columns[projectID][idx], nil
+		}
+	}
+	return domain.Column{}, app.ErrNotFound
+}
+
+// ChangeTaskKind converts one stored task to the requested kind and its default scope.
+func (f *fakeService) ChangeTaskKind(_ context.Context, in app.ChangeTaskKindInput) (domain.Task, error) {
+	for projectID := range f.tasks {
+		for idx, task := range f.tasks[projectID] {
+			if task.ID != in.TaskID {
+				continue
+			}
+			if err := task.ChangeKind(in.Kind, domain.DefaultTaskScope(in.Kind, task.ParentID), time.Now()); err != nil {
+				return domain.Task{}, err
+			}
+			f.tasks[projectID][idx] = task
+			return task, nil
+		}
+	}
+	return domain.Task{}, app.ErrNotFound
+}
+
 // CreateComment creates one ownership-attributed comment.
 func (f *fakeService) CreateComment(_ context.Context, in app.CreateCommentInput) (domain.Comment, error) {
 	if f.commentCreateErr != nil {
@@ -151,6 +419,28 @@ func (f *fakeService) ListProjectChangeEvents(_ context.Context, projectID strin
 	return events, nil
 }
 
+// ListProjectActivity summarizes fake task counts and newest change events per project.
+func (f *fakeService) ListProjectActivity(context.Context) ([]app.ProjectActivity, error) {
+	f.projectActivityCalls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	out := make([]app.ProjectActivity, 0, len(f.projects))
+	for _, project := range f.projects {
+		activity := app.ProjectActivity{ProjectID: project.ID}
+		for _, task := range f.tasks[project.ID] {
+			if task.ArchivedAt == nil {
+				activity.TaskCount++
+			}
+		}
+		if events := f.changeEvents[project.ID]; len(events) > 0 {
+			activity.LastChangeAt = events[0].OccurredAt
+		}
+		out = append(out, activity)
+	}
+	return out, nil
+}
+
 // ListAttentionItems returns fake attention rows derived from blocked tasks.
 func (f *fakeService) ListAttentionItems(_ context.Context, in app.ListAttentionItemsInput) ([]domain.AttentionItem, error) {
 	if f.err != nil {
@@ -203,8 +493,10 @@ func (f *fakeService) GetProjectDependencyRollup(_ context.Context, projectID st
 	}
 	tasks := f.tasks[projectID]
 	rollup := domain.DependencyRollup{
-		ProjectID:  projectID,
-		TotalItems: len(tasks),
+		ProjectID:        projectID,
+		TotalItems:       len(tasks),
+		UnresolvedByTask: map[string]int{},
+		BlockingByTask:   map[string]int{},
 	}
 	stateByID := map[string]domain.LifecycleState{}
 	for _, task := range tasks {
@@ -213,6 +505,15 @@ func (f *fakeService) GetProjectDependencyRollup(_ context.Context, projectID st
 	for _, task := range tasks {
 		dependsOn := uniqueTrimmed(task.Metadata.DependsOn)
 		blockedBy := uniqueTrimmed(task.Metadata.BlockedBy)
+		// Archived and done tasks neither wait on nor block anything, matching the app rollup over active tasks.
+		if task.ArchivedAt == nil && task.LifecycleState != domain.StateDone {
+			for _, refID := range uniqueTrimmed(append(slices.Clone(dependsOn), blockedBy...)) {
+				rollup.BlockingByTask[refID]++
+				if state, ok := stateByID[refID]; !ok || state != domain.StateDone {
+					rollup.UnresolvedByTask[task.ID]++
+				}
+			}
+		}
 		if len(dependsOn) > 0 {
 			rollup.ItemsWithDependencies++
 			rollup.DependencyEdges += len(dependsOn)
@@ -308,9 +609,21 @@ func (f *fakeService) SearchTaskMatches(ctx context.Context, in app.SearchTasksF
 						}
 					}
 				}
+				commentMatch := false
+				if !matched && in.IncludeComments {
+					// Comment-only hits mirror the service and are flagged for the results list.
+					for _, comment := range f.comments[commentThreadKey(projectID, domain.CommentTargetTypeTask, task.ID)] {
+						if strings.Contains(strings.ToLower(comment.BodyMarkdown), query) {
+							matched, commentMatch = true, true
+							break
+						}
+					}
+				}
 				if !matched {
 					continue
 				}
+				out = append(out, app.TaskMatch{Project: project, Task: task, StateID: stateID, CommentMatch: commentMatch})
+				continue
 			}
 			out = append(out, app.TaskMatch{
 				Project: project,
@@ -332,6 +645,13 @@ func (f *fakeService) CreateProjectWithMetadata(_ context.Context, in app.Create
 		return domain.Project{}, err
 	}
 	f.projects = append(f.projects, project)
+	if _, ok := f.columns[project.ID]; !ok && len(in.Columns) > 0 {
+		now := time.Now().UTC()
+		for idx, name := range in.Columns {
+			column, _ := domain.NewColumn(fmt.Sprintf("c-new-%d", idx+1), project.ID, name, idx, 0, now)
+			f.columns[project.ID] = append(f.columns[project.ID], column)
+		}
+	}
 	if _, ok := f.columns[project.ID]; !ok {
 		now := time.Now().UTC()
 		c1, _ := domain.NewColumn("c-new-1", project.ID, "To Do", 0, 0, now)
@@ -437,6 +757,7 @@ func (f *fakeService) CreateTask(_ context.Context, in app.CreateTaskInput) (dom
 
 // UpdateTask updates state for the requested operation.
 func (f *fakeService) UpdateTask(_ context.Context, in app.UpdateTaskInput) (domain.Task, error) {
+	f.updateTaskCalls++
 	for projectID := range f.tasks {
 		for idx := range f.tasks[projectID] {
 			if f.tasks[projectID][idx].ID != in.TaskID {
@@ -456,6 +777,35 @@ func (f *fakeService) UpdateTask(_ context.Context, in app.UpdateTaskInput) (dom
 	return domain.Task{}, app.ErrNotFound
 }
 
+// BulkUpdateTaskLabels adds or removes one label on every listed task that needs it, or fails without writing.
+func (f *fakeService) BulkUpdateTaskLabels(_ context.Context, in app.BulkLabelInput) ([]domain.Task, error) {
+	if f.bulkLabelErr != nil {
+		return nil, f.bulkLabelErr
+	}
+	f.bulkLabelCalls++
+	updated := []domain.Task{}
+	for _, taskID := range in.TaskIDs {
+		task, ok := f.taskByID(taskID)
+		if !ok {
+			return nil, app.ErrNotFound
+		}
+		labels := slices.DeleteFunc(slices.Clone(task.Labels), func(label string) bool { return label == in.Label })
+		if !in.Remove {
+			labels = append(labels, in.Label)
+		}
+		if len(labels) == len(task.Labels) {
+			continue
+		}
+		for idx := range f.tasks[task.ProjectID] {
+			if f.tasks[task.ProjectID][idx].ID == taskID {
+				f.tasks[task.ProjectID][idx].Labels = labels
+				updated = append(updated, f.tasks[task.ProjectID][idx])
+			}
+		}
+	}
+	return updated, nil
+}
+
 // MoveTask moves task.
 func (f *fakeService) MoveTask(_ context.Context, taskID, toColumnID string, position int) (domain.Task, error) {
 	for projectID := range f.tasks {
@@ -489,7 +839,8 @@ func (f *fakeService) MoveTask(_ context.Context, taskID, toColumnID string, pos
 }
 
 // DeleteTask deletes task.
-func (f *fakeService) DeleteTask(_ context.Context, taskID string, mode app.DeleteMode) error {
+func (f *fakeService) DeleteTask(ctx context.Context, taskID string, mode app.DeleteMode) error {
+	f.lastDeletePolicy, _ = app.ParentDeletePolicyFromContext(ctx)
 	for projectID := range f.tasks {
 		for idx := range f.tasks[projectID] {
 			task := f.tasks[projectID][idx]
@@ -1697,6 +2048,64 @@ func TestModelEditTaskMetadataFieldsPrefillAndSubmit(t *testing.T) {
 	}
 }
 
+// TestModelTaskInfoDescriptionRawToggle verifies r switches task-info descriptions between rendered and raw markdown.
+func TestModelTaskInfoDescriptionRawToggle(t *testing.T) {
+	now := time.Date(2026, 3, 3, 12, 45, 0, 0, time.UTC)
+	project, _ := domain.NewProject("p1", "Inbox", "", now)
+	column, _ := domain.NewColumn("c1", project.ID, "To Do", 0, 0, now)
+	task, _ := domain.NewTask(domain.TaskInput{
+		ID:          "t1",
+		ProjectID:   project.ID,
+		ColumnID:    column.ID,
+		Position:    0,
+		Kind:        domain.WorkKindTask,
+		Title:       "markdown task",
+		Description: "# Plan\n\n- **first** step\n\n```go\nfmt.Println(1)\n```",
+		Priority:    domain.PriorityMedium,
+	}, now)
+
+	m := loadReadyModel(t, NewModel(newFakeService([]domain.Project{project}, []domain.Column{column}, []domain.Task{task})))
+	m = applyMsg(t, m, tea.WindowSizeMsg{Width: 100, Height: 40})
+	m = applyMsg(t, m, keyRune('i'))
+	if m.mode != modeTaskInfo {
+		t.Fatalf("expected task info mode, got %v", m.mode)
+	}
+
+	// Rendered markdown drops the literal heading and emphasis markers but keeps code fence contents.
+	rendered := stripANSI(m.taskInfoDetails.View())
+	if strings.Contains(rendered, "**first**") || strings.Contains(rendered, "# Plan") {
+		t.Fatalf("expected rendered markdown without literal markup, got %q", rendered)
+	}
+	if !strings.Contains(rendered, "first") || !strings.Contains(rendered, "fmt.Println(1)") {
+		t.Fatalf("expected rendered markdown to keep list and code text, got %q", rendered)
+	}
+
+	m = applyMsg(t, m, keyRune('r'))
+	if !m.taskInfoDescriptionRaw || m.status != "description: raw markdown" {
+		t.Fatalf("expected raw description toggle, raw=%t status=%q", m.taskInfoDescriptionRaw, m.status)
+	}
+	raw := stripANSI(m.taskInfoDetails.View())
+	for _, want := range []string{"# Plan", "- **first** step", "```go"} {
+		if !strings.Contains(raw, want) {
+			t.Fatalf("expected raw description to contain %q, got %q", want, raw)
+		}
+	}
+	if view := stripANSI(fmt.Sprint(m.View().Content)); !strings.Contains(view, "description (raw, r renders):") {
+		t.Fatalf("expected raw description label in task info view, got %q", view)
+	}
+
+	// The choice persists into the next task-info session until toggled back.
+	m = applyMsg(t, m, keyRune('i'))
+	m = applyMsg(t, m, keyRune('i'))
+	if !m.taskInfoDescriptionRaw {
+		t.Fatal("expected raw description mode to persist across task info sessions")
+	}
+	m = applyMsg(t, m, keyRune('r'))
+	if m.taskInfoDescriptionRaw || strings.Contains(stripANSI(m.taskInfoDetails.View()), "**first**") {
+		t.Fatalf("expected rendered description after second toggle, got %q", stripANSI(m.taskInfoDetails.View()))
+	}
+}
+
 // TestModelTaskInfoDetailsViewportScrolls verifies task-info markdown details are bounded and scrollable.
 func TestModelTaskInfoDetailsViewportScrolls(t *testing.T) {
 	now := time.Date(2026, 3, 3, 12, 45, 0, 0, time.UTC)
@@ -3204,7 +3613,7 @@ func TestModelHelpOverlayModeSpecific(t *testing.T) {
 	if !strings.Contains(out, "screen: due picker") {
 		t.Fatalf("expected due-picker-specific help title, got %q", out)
 	}
-	if !strings.Contains(out, "tab cycles include-time, date, time, and options list focus") {
+	if !strings.Contains(out, "tab cycles include-time, date, time, repeat, and options list focus") {
 		t.Fatalf("expected due-picker-specific help guidance, got %q", out)
 	}
 }
@@ -3241,6 +3650,7 @@ func TestHelpOverlayScreenTitleAndLinesCoverage(t *testing.T) {
 		modeBootstrapSettings,
 		modeDependencyInspector,
 		modeThread,
+		modeInbox,
 	}
 	for _, mode := range modes {
 		m.mode = mode
@@ -3360,7 +3770,12 @@ func TestModelQuickActionsDisabledOrderingAndBlocking(t *testing.T) {
 		t.Fatalf("expected enabled action before disabled entries, got %q", out)
 	}
 
-	m = applyMsg(t, m, keyRune('j')) // move from enabled Activity Log to first disabled row
+	// Move past the enabled rows (Edit Column, Activity Log) to the first disabled row.
+	for _, action := range m.quickActions() {
+		if action.Enabled {
+			m = applyMsg(t, m, keyRune('j'))
+		}
+	}
 	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
 	if m.mode != modeQuickActions {
 		t.Fatalf("expected disabled quick action to stay in quick-actions mode, got %v", m.mode)
@@ -3548,6 +3963,70 @@ func TestModelCommandPaletteReloadConfigError(t *testing.T) {
 	}
 }
 
+// TestModelHardDeleteUsesReloadedParentPolicy verifies hard deletes pass the current parent policy, including after a config reload.
+func TestModelHardDeleteUsesReloadedParentPolicy(t *testing.T) {
+	now := time.Date(2026, 2, 22, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	first, _ := domain.NewTask(domain.TaskInput{ID: "t1", ProjectID: p.ID, ColumnID: c.ID, Position: 0, Title: "First", Priority: domain.PriorityMedium}, now)
+	second, _ := domain.NewTask(domain.TaskInput{ID: "t2", ProjectID: p.ID, ColumnID: c.ID, Position: 1, Title: "Second", Priority: domain.PriorityMedium}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c}, []domain.Task{first, second})
+	m := loadReadyModel(t, NewModel(svc,
+		WithDefaultDeleteMode(app.DeleteModeHard),
+		WithConfirmConfig(ConfirmConfig{}),
+		WithReloadConfigCallback(func() (RuntimeConfig, error) {
+			return RuntimeConfig{DefaultDeleteMode: app.DeleteModeHard, ParentDeletePolicy: app.ParentDeletePolicyCascade}, nil
+		}),
+	))
+	if m.parentDeletePolicy != app.ParentDeletePolicyBlock {
+		t.Fatalf("expected block as the default policy, got %q", m.parentDeletePolicy)
+	}
+	updated, cmd := m.deleteSelectedTask(app.DeleteModeHard)
+	m = applyResult(t, updated, cmd)
+	if svc.lastDeletePolicy != app.ParentDeletePolicyBlock {
+		t.Fatalf("expected delete to carry block, got %q", svc.lastDeletePolicy)
+	}
+
+	updated, cmd = m.executeCommandPalette("reload-config")
+	m = applyResult(t, updated, cmd)
+	updated, cmd = m.deleteSelectedTask(app.DeleteModeHard)
+	m = applyResult(t, updated, cmd)
+	if svc.lastDeletePolicy != app.ParentDeletePolicyCascade {
+		t.Fatalf("expected delete after reload to carry cascade, got %q", svc.lastDeletePolicy)
+	}
+}
+
+// TestModelLabelColorGlyphReloads verifies first-label color glyphs render before titles and follow config reloads.
+func TestModelLabelColorGlyphReloads(t *testing.T) {
+	now := time.Date(2026, 2, 22, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	bug, _ := domain.NewTask(domain.TaskInput{ID: "t1", ProjectID: p.ID, ColumnID: c.ID, Position: 0, Title: "Crash", Priority: domain.PriorityMedium, Labels: []string{"bug", "ui"}}, now)
+	chore, _ := domain.NewTask(domain.TaskInput{ID: "t2", ProjectID: p.ID, ColumnID: c.ID, Position: 1, Title: "Tidy", Priority: domain.PriorityMedium, Labels: []string{"chore"}}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c}, []domain.Task{bug, chore})
+	reloaded := RuntimeConfig{Labels: LabelConfig{Colors: map[string]string{"chore": "#00AA00"}}}
+	m := loadReadyModel(t, NewModel(svc,
+		WithLabelConfig(LabelConfig{Colors: map[string]string{"Bug": "196", "ui": "not-a-color"}}),
+		WithReloadConfigCallback(func() (RuntimeConfig, error) { return reloaded, nil }),
+	))
+
+	rendered := stripANSI(fmt.Sprint(m.View().Content))
+	if !strings.Contains(rendered, labelColorGlyph+"Crash") {
+		t.Fatalf("expected colored glyph before the bug task title, got %q", rendered)
+	}
+	// Tasks whose first label has no color keep the plain layout.
+	if strings.Contains(rendered, labelColorGlyph+"Tidy") {
+		t.Fatalf("expected no glyph for an uncolored first label, got %q", rendered)
+	}
+
+	updated, cmd := m.executeCommandPalette("reload-config")
+	m = applyResult(t, updated, cmd)
+	rendered = stripANSI(fmt.Sprint(m.View().Content))
+	if strings.Contains(rendered, labelColorGlyph+"Crash") || !strings.Contains(rendered, labelColorGlyph+"Tidy") {
+		t.Fatalf("expected reloaded label colors to move the glyph, got %q", rendered)
+	}
+}
+
 // TestModelPathsRootsModalSaveAndClear verifies behavior for the covered scenario.
 func TestModelPathsRootsModalSaveAndClear(t *testing.T) {
 	now := time.Date(2026, 2, 22, 12, 0, 0, 0, time.UTC)
@@ -4710,6 +5189,45 @@ func TestNoticesPanelSpacingBudget(t *testing.T) {
 	}
 }
 
+// TestLayoutBreakpointsFollowConfig verifies configured breakpoints drop the notices panel and outer gutter.
+func TestLayoutBreakpointsFollowConfig(t *testing.T) {
+	base := Model{
+		width: 160,
+		columns: []domain.Column{
+			{ID: "c1"},
+			{ID: "c2"},
+			{ID: "c3"},
+		},
+	}
+	if !base.isNoticesPanelVisible() || base.outerHorizontalPadding() != tuiOuterHorizontalPadding {
+		t.Fatal("expected built-in layout to show the notices panel and outer gutter at width 160")
+	}
+
+	m := base
+	WithUIConfig(UIConfig{Layout: LayoutConfig{NoticesPanelMinWidth: 180, CompactPaddingBelow: 170}})(&m)
+	if m.isNoticesPanelVisible() {
+		t.Fatal("expected notices panel dropped below the configured minimum width")
+	}
+	if got := m.outerHorizontalPadding(); got != 0 {
+		t.Fatalf("expected outer gutter dropped below the compact breakpoint, got %d", got)
+	}
+	// Without the gutter the board gets the full terminal width.
+	if got := m.appInnerWidth(); got != 160 {
+		t.Fatalf("expected compact inner width 160, got %d", got)
+	}
+	m.width = 200
+	if !m.isNoticesPanelVisible() || m.outerHorizontalPadding() != tuiOuterHorizontalPadding {
+		t.Fatal("expected notices panel and gutter restored above the configured breakpoints")
+	}
+
+	hidden := base
+	WithUIConfig(UIConfig{Layout: LayoutConfig{HideNoticesPanel: true}})(&hidden)
+	hidden.width = 400
+	if hidden.isNoticesPanelVisible() || hidden.boardWidthFor(398) != 398 {
+		t.Fatal("expected hidden notices panel to leave the full width to the board")
+	}
+}
+
 // TestTaskEditParsing verifies behavior for the covered scenario.
 func TestTaskEditParsing(t *testing.T) {
 	now := time.Date(2026, 2, 21, 0, 0, 0, 0, time.UTC)
@@ -4849,13 +5367,172 @@ func TestDeleteUsesConfiguredDefaultMode(t *testing.T) {
 	}
 }
 
-// TestParseDueAndLabelsInput verifies behavior for the covered scenario.
-func TestParseDueAndLabelsInput(t *testing.T) {
-	now := time.Date(2026, 2, 21, 0, 0, 0, 0, time.UTC)
+// TestModelHardDeleteConfirmShowsSubtaskImpact verifies hard-delete confirmations describe the parent delete policy.
+func TestModelHardDeleteConfirmShowsSubtaskImpact(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	parent, _ := domain.NewTask(domain.TaskInput{
+		ID:        "parent",
+		ProjectID: p.ID,
+		ColumnID:  c.ID,
+		Position:  0,
+		Title:     "Parent",
+		Priority:  domain.PriorityLow,
+	}, now)
+	child, _ := domain.NewTask(domain.TaskInput{
+		ID:        "child",
+		ProjectID: p.ID,
+		ParentID:  parent.ID,
+		Kind:      domain.WorkKindSubtask,
+		Scope:     domain.KindAppliesToSubtask,
+		ColumnID:  c.ID,
+		Position:  1,
+		Title:     "Child",
+		Priority:  domain.PriorityLow,
+	}, now)
+	grandchild, _ := domain.NewTask(domain.TaskInput{
+		ID:        "grandchild",
+		ProjectID: p.ID,
+		ParentID:  child.ID,
+		Kind:      domain.WorkKindSubtask,
+		Scope:     domain.KindAppliesToSubtask,
+		ColumnID:  c.ID,
+		Position:  2,
+		Title:     "Grandchild",
+		Priority:  domain.PriorityLow,
+	}, now)
 
-	gotDue, err := parseDueInput("", &now)
-	if err != nil {
-		t.Fatalf("parseDueInput empty unexpected error: %v", err)
+	cases := []struct {
+		policy app.ParentDeletePolicy
+		want   string
+	}{
+		{policy: app.ParentDeletePolicyCascade, want: "also deletes 2 subtasks"},
+		{policy: app.ParentDeletePolicyReparent, want: "1 direct subtasks move up one level"},
+		{policy: app.ParentDeletePolicyBlock, want: "blocked: 2 subtasks must be moved or deleted first"},
+	}
+	for _, tc := range cases {
+		svc := newFakeService([]domain.Project{p}, []domain.Column{c}, []domain.Task{parent, child, grandchild})
+		m := loadReadyModel(t, NewModel(svc, WithDefaultDeleteMode(app.DeleteModeHard), WithParentDeletePolicy(tc.policy)))
+		m = applyMsg(t, m, keyRune('d'))
+		if m.mode != modeConfirmAction {
+			t.Fatalf("%s: expected confirm mode, got %v", tc.policy, m.mode)
+		}
+		rendered := stripANSI(fmt.Sprint(m.View().Content))
+		if !strings.Contains(rendered, tc.want) {
+			t.Fatalf("%s: expected confirm modal to contain %q, got\n%s", tc.policy, tc.want, rendered)
+		}
+	}
+
+	// Subtasks already selected for deletion are not reported as collateral.
+	m := loadReadyModel(t, NewModel(newFakeService([]domain.Project{p}, []domain.Column{c}, []domain.Task{parent, child, grandchild})))
+	if got := m.hardDeleteSubtaskImpact([]string{parent.ID, child.ID, grandchild.ID}); got != "" {
+		t.Fatalf("expected no impact when the whole subtree is selected, got %q", got)
+	}
+}
+
+// TestModelArchiveSubtreeIsOneUndoableAction verifies subtree archive, undo, and restore-subtree behavior.
+func TestModelArchiveSubtreeIsOneUndoableAction(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	inputs := []domain.TaskInput{
+		{ID: "epic", Title: "Epic"},
+		{ID: "child", ParentID: "epic", Kind: domain.WorkKindSubtask, Scope: domain.KindAppliesToSubtask, Title: "Child"},
+		{ID: "grandchild", ParentID: "child", Kind: domain.WorkKindSubtask, Scope: domain.KindAppliesToSubtask, Title: "Grandchild"},
+	}
+	tasks := make([]domain.Task, 0, len(inputs))
+	for idx, in := range inputs {
+		in.ProjectID = p.ID
+		in.ColumnID = c.ID
+		in.Position = idx
+		in.Priority = domain.PriorityLow
+		task, _ := domain.NewTask(in, now)
+		tasks = append(tasks, task)
+	}
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c}, tasks)
+	m := loadReadyModel(t, NewModel(svc, WithReloadDebounce(0)))
+
+	updated, _ := m.confirmArchiveSubtreeAction()
+	m = mustModelValue(t, updated)
+	if m.mode != modeConfirmAction || m.pendingConfirm.Kind != "archive-subtree" {
+		t.Fatalf("expected archive-subtree confirmation, got mode %v kind %q", m.mode, m.pendingConfirm.Kind)
+	}
+	if rendered := stripANSI(fmt.Sprint(m.View().Content)); !strings.Contains(rendered, "also archives 2 subtasks") {
+		t.Fatalf("expected subtree size in confirmation, got\n%s", rendered)
+	}
+	m.confirmChoice = 0
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	for _, task := range svc.tasks[p.ID] {
+		if task.ArchivedAt == nil {
+			t.Fatalf("expected %s archived with its subtree", task.ID)
+		}
+	}
+	// The whole subtree lands on the undo stack as one entry.
+	if len(m.undoStack) != 1 || len(m.undoStack[0].Steps) != 3 {
+		t.Fatalf("expected one undo entry with 3 steps, got %#v", m.undoStack)
+	}
+
+	updated, cmd := m.undoLastMutation()
+	m = applyCmd(t, mustModelValue(t, updated), cmd)
+	for _, task := range svc.tasks[p.ID] {
+		if task.ArchivedAt != nil {
+			t.Fatalf("expected undo to restore %s", task.ID)
+		}
+	}
+
+	// A plain restore of the parent points at restore-subtree for archived subtasks.
+	if _, err := svc.ArchiveTaskSubtree(context.Background(), "epic"); err != nil {
+		t.Fatalf("ArchiveTaskSubtree() error = %v", err)
+	}
+	m.lastArchivedTaskID = "epic"
+	updated, cmd = m.restoreTask()
+	m = applyCmd(t, mustModelValue(t, updated), cmd)
+	if !strings.Contains(m.status, "2 archived subtasks remain") {
+		t.Fatalf("expected restore hint for archived subtasks, got %q", m.status)
+	}
+	updated, cmd = m.restoreTaskSubtree()
+	m = applyCmd(t, mustModelValue(t, updated), cmd)
+	if m.status != "restored subtree (2 tasks)" {
+		t.Fatalf("expected restored subtree status, got %q", m.status)
+	}
+}
+
+// TestModelTaskInfoCyclesExplicitLifecycleState verifies the task-info state action cycles explicit states.
+func TestModelTaskInfoCyclesExplicitLifecycleState(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "Review", 0, 0, now)
+	task, _ := domain.NewTask(domain.TaskInput{
+		ID:        "t1",
+		ProjectID: p.ID,
+		ColumnID:  c.ID,
+		Title:     "Ship",
+		Priority:  domain.PriorityLow,
+	}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c}, []domain.Task{task})
+	m := loadReadyModel(t, NewModel(svc, WithReloadDebounce(0)))
+	m.openTaskInfo(task.ID, "task info")
+
+	want := []string{"state: todo (explicit)", "state: progress (explicit)", "state: done (explicit)", "state follows column: todo"}
+	for _, status := range want {
+		m = applyMsg(t, m, keyRune('t'))
+		if m.status != status {
+			t.Fatalf("expected status %q, got %q", status, m.status)
+		}
+	}
+	if got := svc.tasks[p.ID][0]; got.Metadata.LifecycleStateExplicit {
+		t.Fatalf("expected explicit state cleared after a full cycle, got %#v", got.Metadata)
+	}
+}
+
+// TestParseDueAndLabelsInput verifies behavior for the covered scenario.
+func TestParseDueAndLabelsInput(t *testing.T) {
+	now := time.Date(2026, 2, 21, 0, 0, 0, 0, time.UTC)
+
+	gotDue, err := parseDueInput("", &now)
+	if err != nil {
+		t.Fatalf("parseDueInput empty unexpected error: %v", err)
 	}
 	if gotDue == nil || !gotDue.Equal(now) {
 		t.Fatalf("expected current due date to be preserved, got %#v", gotDue)
@@ -5254,7 +5931,7 @@ func TestTaskDescriptionPreviewHeightMatchesBetweenInfoAndEdit(t *testing.T) {
 		t.Fatalf("expected edit-task mode, got %v", m.mode)
 	}
 
-	accent := projectAccentColor(project)
+	accent := m.projectAccentColor(project)
 	metrics := m.fullPageSurfaceMetrics(
 		accent,
 		lipgloss.Color("241"),
@@ -5285,7 +5962,7 @@ func TestFullPageSurfaceMetricsUseBoardMatchedOuterGaps(t *testing.T) {
 	}, now)
 	m := loadReadyModel(t, NewModel(newFakeService([]domain.Project{project}, []domain.Column{column}, []domain.Task{task})))
 
-	accent := projectAccentColor(project)
+	accent := m.projectAccentColor(project)
 	metrics := m.fullPageSurfaceMetrics(
 		accent,
 		lipgloss.Color("241"),
@@ -5321,7 +5998,7 @@ func TestModelFormValidationPaths(t *testing.T) {
 	m.formInputs[0].SetValue("Draft roadmap")
 	m.formInputs[2].SetValue("urgent")
 	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
-	if !strings.Contains(m.status, "priority must be low|medium|high") {
+	if !strings.Contains(m.status, "priority must be none|low|medium|high|critical") {
 		t.Fatalf("expected invalid priority status, got %q", m.status)
 	}
 
@@ -5346,7 +6023,7 @@ func TestModelFormValidationPaths(t *testing.T) {
 	m = applyMsg(t, m, keyRune('e'))
 	m.formInputs[2].SetValue("invalid")
 	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
-	if !strings.Contains(m.status, "priority must be low|medium|high") {
+	if !strings.Contains(m.status, "priority must be none|low|medium|high|critical") {
 		t.Fatalf("expected invalid edit priority status, got %q", m.status)
 	}
 }
@@ -5848,8 +6525,8 @@ func TestDuePickerTypedInputAndFocusControls(t *testing.T) {
 	if m.duePickerFocus != 3 {
 		t.Fatalf("expected focus to move from time input to list when time disabled, got %d", m.duePickerFocus)
 	}
-	if got := m.duePickerFocusSlots(); len(got) != 3 {
-		t.Fatalf("expected three focus slots without time input, got %#v", got)
+	if got := m.duePickerFocusSlots(); len(got) != 4 {
+		t.Fatalf("expected four focus slots without time input, got %#v", got)
 	}
 
 	if _, ok := resolveDuePickerDateToken("today", time.Now().In(time.Local)); !ok {
@@ -5961,6 +6638,40 @@ func TestProjectFormSavesRootPathOnCreate(t *testing.T) {
 	}
 }
 
+// TestProjectFormCreatesDeclaredColumns verifies the new-project columns field reaches the service in order and is
+// absent from the edit form.
+func TestProjectFormCreatesDeclaredColumns(t *testing.T) {
+	now := time.Date(2026, 2, 22, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c}, nil)
+	m := loadReadyModel(t, NewModel(svc))
+
+	m = applyMsg(t, m, keyRune('N'))
+	if m.mode != modeAddProject || len(m.projectFormInputs) <= projectFieldColumns {
+		t.Fatalf("expected add-project form with a columns field, got mode %v and %d inputs", m.mode, len(m.projectFormInputs))
+	}
+	m.projectFormInputs[projectFieldName].SetValue("Bugs")
+	m.projectFormInputs[projectFieldColumns].SetValue("Triage, Fixing,,Verified")
+	if !strings.Contains(stripANSI(fmt.Sprint(m.View().Content)), "columns:") {
+		t.Fatal("expected the add-project form to render the columns field")
+	}
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	got := make([]string, 0, 3)
+	for _, column := range svc.columns["p-new"] {
+		got = append(got, column.Name)
+	}
+	if want := []string{"Triage", "Fixing", "Verified"}; !slices.Equal(got, want) {
+		t.Fatalf("created columns = %#v, want %#v", got, want)
+	}
+
+	// Columns are managed on the board once a project exists, so editing leaves the field out.
+	_ = m.startProjectForm(&p)
+	if m.mode != modeEditProject || len(m.projectFormInputs) > projectFieldColumns {
+		t.Fatalf("expected edit-project form without a columns field, got mode %v and %d inputs", m.mode, len(m.projectFormInputs))
+	}
+}
+
 // TestSearchAndCommandPaletteFlow verifies behavior for the covered scenario.
 func TestSearchAndCommandPaletteFlow(t *testing.T) {
 	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
@@ -5991,6 +6702,8 @@ func TestSearchAndCommandPaletteFlow(t *testing.T) {
 	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
 	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyTab}) // archived
 	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyTab}) // comments
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
 	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyTab}) // apply
 	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
 	if !m.searchApplied {
@@ -5999,8 +6712,11 @@ func TestSearchAndCommandPaletteFlow(t *testing.T) {
 	if m.searchQuery != "road map" {
 		t.Fatalf("expected search query preserved, got %q", m.searchQuery)
 	}
-	if !m.searchCrossProject || !m.searchIncludeArchived {
-		t.Fatalf("expected scope+archived toggled, got cross=%t archived=%t", m.searchCrossProject, m.searchIncludeArchived)
+	if !m.searchCrossProject || !m.searchIncludeArchived || !m.searchIncludeComments {
+		t.Fatalf("expected scope+archived+comments toggled, got cross=%t archived=%t comments=%t", m.searchCrossProject, m.searchIncludeArchived, m.searchIncludeComments)
+	}
+	if !svc.lastSearchFilter.IncludeComments {
+		t.Fatalf("expected comment search passed to the service, got %#v", svc.lastSearchFilter)
 	}
 
 	m = applyMsg(t, m, keyRune(':'))
@@ -6166,6 +6882,281 @@ func TestModelMultiSelectBulkMoveUndoRedo(t *testing.T) {
 	}
 }
 
+// TestModelBulkLabelAddRemoveUndo verifies bulk label edits skip unchanged tasks and undo as one action.
+func TestModelBulkLabelAddRemoveUndo(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c1, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	t1, _ := domain.NewTask(domain.TaskInput{ID: "t1", ProjectID: p.ID, ColumnID: c1.ID, Position: 0, Title: "One", Priority: domain.PriorityMedium, Labels: []string{"bug"}}, now)
+	t2, _ := domain.NewTask(domain.TaskInput{ID: "t2", ProjectID: p.ID, ColumnID: c1.ID, Position: 1, Title: "Two", Priority: domain.PriorityMedium, Labels: []string{"ui"}}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c1}, []domain.Task{t1, t2})
+	m := loadReadyModel(t, NewModel(svc))
+	m = applyMsg(t, m, keyRune(' '))
+	m = applyMsg(t, m, keyRune('j'))
+	m = applyMsg(t, m, keyRune(' '))
+	pickLabel := func(m Model, command, query string) Model {
+		t.Helper()
+		updated, cmd := m.executeCommandPalette(command)
+		m = applyResult(t, updated, cmd)
+		if m.mode != modeLabelPicker {
+			t.Fatalf("expected label picker for %s, got %v (%q)", command, m.mode, m.status)
+		}
+		for _, r := range query {
+			m = applyMsg(t, m, keyRune(r))
+		}
+		return applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	}
+	labelsOf := func(taskID string) []string {
+		task, _ := svc.taskByID(taskID)
+		return task.Labels
+	}
+
+	// t1 already carries "bug", so only t2 changes.
+	m = pickLabel(m, "bulk-add-label", "bug")
+	if !slices.Equal(labelsOf("t1"), []string{"bug"}) || !slices.Equal(labelsOf("t2"), []string{"ui", "bug"}) {
+		t.Fatalf("unexpected labels after bulk add: t1=%v t2=%v", labelsOf("t1"), labelsOf("t2"))
+	}
+	if m.status != `added label "bug" to 1 tasks (1 unchanged)` {
+		t.Fatalf("unexpected bulk add status %q", m.status)
+	}
+	if svc.bulkLabelCalls != 1 || svc.updateTaskCalls != 0 {
+		t.Fatalf("expected one bulk label call, got %d bulk and %d single updates", svc.bulkLabelCalls, svc.updateTaskCalls)
+	}
+
+	// Repeating the add is a no-op and pushes no history.
+	m = pickLabel(m, "bulk-add-label", "bug")
+	if svc.bulkLabelCalls != 1 || !strings.Contains(m.status, "unchanged on 2 selected tasks") {
+		t.Fatalf("expected idempotent bulk add, got %d calls status %q", svc.bulkLabelCalls, m.status)
+	}
+
+	// A failed batch writes nothing, so it reports the error and pushes no history.
+	undoDepth := len(m.undoStack)
+	svc.bulkLabelErr = errors.New("batch failed")
+	m = pickLabel(m, "bulk-add-label", "ops")
+	if !errors.Is(m.err, svc.bulkLabelErr) || len(m.undoStack) != undoDepth {
+		t.Fatalf("expected failed bulk add surfaced without history, got err %v depth %d", m.err, len(m.undoStack))
+	}
+	if slices.Contains(labelsOf("t1"), "ops") || slices.Contains(labelsOf("t2"), "ops") {
+		t.Fatalf("expected failed bulk add to leave labels: t1=%v t2=%v", labelsOf("t1"), labelsOf("t2"))
+	}
+	svc.bulkLabelErr = nil
+
+	m = pickLabel(m, "bulk-remove-label", "bug")
+	if len(labelsOf("t1")) != 0 || !slices.Equal(labelsOf("t2"), []string{"ui"}) {
+		t.Fatalf("unexpected labels after bulk remove: t1=%v t2=%v", labelsOf("t1"), labelsOf("t2"))
+	}
+
+	// Undo restores the label on both tasks as one action.
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: 'z', Mod: tea.ModCtrl})
+	if !slices.Contains(labelsOf("t1"), "bug") || !slices.Contains(labelsOf("t2"), "bug") {
+		t.Fatalf("expected undo to restore bug label: t1=%v t2=%v", labelsOf("t1"), labelsOf("t2"))
+	}
+	if !strings.Contains(m.status, "undo complete") {
+		t.Fatalf("expected undo status, got %q", m.status)
+	}
+}
+
+// TestModelBulkAddLabelRespectsAllowedLabels verifies bulk add honors label enforcement.
+func TestModelBulkAddLabelRespectsAllowedLabels(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c1, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	t1, _ := domain.NewTask(domain.TaskInput{ID: "t1", ProjectID: p.ID, ColumnID: c1.ID, Position: 0, Title: "One", Priority: domain.PriorityMedium}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c1}, []domain.Task{t1})
+	m := loadReadyModel(t, NewModel(svc, WithLabelConfig(LabelConfig{Global: []string{"bug"}, EnforceAllowed: true})))
+	m = applyMsg(t, m, keyRune(' '))
+	updated, cmd := m.executeCommandPalette("bulk-add-label")
+	m = applyResult(t, updated, cmd)
+	// "zzz" matches no suggestion, so enter offers the typed label, which the allowlist rejects.
+	for _, r := range "zzz" {
+		m = applyMsg(t, m, keyRune(r))
+	}
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if svc.bulkLabelCalls != 0 || !strings.Contains(m.status, "labels not allowed for project \"inbox\": zzz") {
+		t.Fatalf("expected allowlist rejection, got %d calls status %q", svc.bulkLabelCalls, m.status)
+	}
+}
+
+// TestSameTaskEditComparesEveryField verifies no-op detection ignores nil/empty list differences but sees real changes.
+func TestSameTaskEditComparesEveryField(t *testing.T) {
+	due := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	base := taskEditSnapshot{
+		Title:    "Ship",
+		Labels:   []string{},
+		DueAt:    &due,
+		Metadata: domain.TaskMetadata{DependsOn: []string{}, ResourceRefs: []domain.ResourceRef{{Location: "a.go", Tags: []string{}}}},
+	}
+	// Empty and nil lists round-trip interchangeably, and the same instant in another zone is unchanged.
+	sameDue := due.In(time.FixedZone("X", 3600))
+	roundTripped := taskEditSnapshot{
+		Title:    "Ship",
+		DueAt:    &sameDue,
+		Metadata: domain.TaskMetadata{ResourceRefs: []domain.ResourceRef{{Location: "a.go"}}},
+	}
+	if !sameTaskEdit(base, roundTripped) {
+		t.Fatal("expected nil/empty lists and equal instants to compare equal")
+	}
+	changed := roundTripped
+	changed.Metadata = domain.TaskMetadata{ResourceRefs: []domain.ResourceRef{{Location: "b.go"}}}
+	if sameTaskEdit(base, changed) {
+		t.Fatal("expected a changed resource ref to differ")
+	}
+	changed = roundTripped
+	changed.DueAt = nil
+	if sameTaskEdit(base, changed) {
+		t.Fatal("expected a cleared due date to differ")
+	}
+}
+
+// TestModelTaskEditUndoRedo verifies edit-form saves restore prior field values on undo and reapply on redo.
+func TestModelTaskEditUndoRedo(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c1, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	task, _ := domain.NewTask(domain.TaskInput{
+		ID:          "t1",
+		ProjectID:   p.ID,
+		ColumnID:    c1.ID,
+		Position:    0,
+		Title:       "Ship release",
+		Description: "cut the tag",
+		Priority:    domain.PriorityMedium,
+		Labels:      []string{"release"},
+	}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c1}, []domain.Task{task})
+	m := loadReadyModel(t, NewModel(svc))
+
+	// Saving without changes leaves nothing to undo.
+	m = applyMsg(t, m, keyRune('e'))
+	if m.mode != modeEditTask {
+		t.Fatalf("expected edit-task mode, got %v", m.mode)
+	}
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if len(m.undoStack) != 0 {
+		t.Fatalf("expected no-op edit to skip undo history, got %#v", m.undoStack)
+	}
+
+	m = applyMsg(t, m, keyRune('e'))
+	m.formInputs[taskFieldTitle].SetValue("Shpi release")
+	m.cyclePriority(1)
+	m.formInputs[taskFieldLabels].SetValue("oops")
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if got, _ := svc.taskByID("t1"); got.Title != "Shpi release" || got.Priority != domain.PriorityHigh {
+		t.Fatalf("expected edit saved, got %#v", got)
+	}
+
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: 'z', Mod: tea.ModCtrl})
+	got, _ := svc.taskByID("t1")
+	if got.Title != "Ship release" || got.Priority != domain.PriorityMedium || !slices.Equal(got.Labels, []string{"release"}) || got.Description != "cut the tag" {
+		t.Fatalf("expected undo to restore pre-edit values, got %#v", got)
+	}
+	if m.status != "undo complete: edit task" {
+		t.Fatalf("unexpected undo status %q", m.status)
+	}
+
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: 'z', Mod: tea.ModCtrl | tea.ModShift})
+	if got, _ := svc.taskByID("t1"); got.Title != "Shpi release" || !slices.Equal(got.Labels, []string{"oops"}) {
+		t.Fatalf("expected redo to reapply the edit, got %#v", got)
+	}
+}
+
+// TestModelTaskEditUndoKeepsLaterChanges verifies undo restores only the fields the edit changed.
+func TestModelTaskEditUndoKeepsLaterChanges(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c1, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	task, _ := domain.NewTask(domain.TaskInput{
+		ID:        "t1",
+		ProjectID: p.ID,
+		ColumnID:  c1.ID,
+		Title:     "Ship release",
+		Priority:  domain.PriorityMedium,
+		Metadata:  domain.TaskMetadata{Objective: "cut the tag"},
+	}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c1}, []domain.Task{task})
+	m := loadReadyModel(t, NewModel(svc))
+
+	m = applyMsg(t, m, keyRune('e'))
+	m.formInputs[taskFieldTitle].SetValue("Shpi release")
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+
+	// A later change, made outside the edit form, adds a reminder and attaches a resource.
+	later, _ := svc.taskByID("t1")
+	metadata := later.Metadata
+	metadata.Reminders = []string{"1d"}
+	metadata.ResourceRefs = []domain.ResourceRef{{ID: "r1", ResourceType: domain.ResourceTypeURL, Location: "https://example.com/notes"}}
+	if _, err := svc.UpdateTask(context.Background(), app.UpdateTaskInput{
+		TaskID:   later.ID,
+		Title:    later.Title,
+		Priority: later.Priority,
+		Labels:   later.Labels,
+		Metadata: &metadata,
+	}); err != nil {
+		t.Fatalf("UpdateTask() error = %v", err)
+	}
+	m = applyMsg(t, m, m.loadData())
+
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: 'z', Mod: tea.ModCtrl})
+	got, _ := svc.taskByID("t1")
+	if got.Title != "Ship release" {
+		t.Fatalf("expected undo to restore the edited title, got %q", got.Title)
+	}
+	if len(got.Metadata.Reminders) != 1 || len(got.Metadata.ResourceRefs) != 1 || got.Metadata.Objective != "cut the tag" {
+		t.Fatalf("expected the later reminder and resource to survive undo, got %#v", got.Metadata)
+	}
+}
+
+// TestModelArchiveDoneConfirmsAndUndoes verifies archive-done archives only done-column tasks as one undoable batch.
+func TestModelArchiveDoneConfirmsAndUndoes(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	todo, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	done, _ := domain.NewColumn("c2", p.ID, "Done", 1, 0, now)
+	open, _ := domain.NewTask(domain.TaskInput{ID: "t1", ProjectID: p.ID, ColumnID: todo.ID, Position: 0, Title: "Open", Priority: domain.PriorityMedium}, now)
+	shipped, _ := domain.NewTask(domain.TaskInput{ID: "t2", ProjectID: p.ID, ColumnID: done.ID, Position: 0, Title: "Shipped", Priority: domain.PriorityMedium}, now)
+	closed, _ := domain.NewTask(domain.TaskInput{ID: "t3", ProjectID: p.ID, ColumnID: done.ID, Position: 1, Title: "Closed", Priority: domain.PriorityMedium}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{todo, done}, []domain.Task{open, shipped, closed})
+	m := loadReadyModel(t, NewModel(svc))
+
+	updated, cmd := m.executeCommandPalette("archive-done")
+	m = applyResult(t, updated, cmd)
+	if m.mode != modeConfirmAction || m.pendingConfirm.Kind != "archive-done" {
+		t.Fatalf("expected archive-done confirmation, got mode %v confirm %#v", m.mode, m.pendingConfirm)
+	}
+	if rendered := stripANSI(fmt.Sprint(m.View().Content)); !strings.Contains(rendered, "archive done: 2 tasks in done columns") {
+		t.Fatalf("expected done-task count in confirm modal, got %q", rendered)
+	}
+	m = applyMsg(t, m, keyRune('y'))
+	for _, id := range []string{"t2", "t3"} {
+		if task, ok := svc.taskByID(id); !ok || task.ArchivedAt == nil {
+			t.Fatalf("expected %s archived, got %#v ok=%t", id, task, ok)
+		}
+	}
+	if task, _ := svc.taskByID("t1"); task.ArchivedAt != nil {
+		t.Fatal("expected open task to stay active")
+	}
+	if m.status != "archived 2 done tasks" {
+		t.Fatalf("unexpected archive-done status %q", m.status)
+	}
+
+	// Running it again finds nothing left to archive.
+	updated, cmd = m.executeCommandPalette("archive-done")
+	m = applyResult(t, updated, cmd)
+	if m.mode == modeConfirmAction || m.status != "no done tasks to archive" {
+		t.Fatalf("expected nothing to archive, got mode %v status %q", m.mode, m.status)
+	}
+
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: 'z', Mod: tea.ModCtrl})
+	for _, id := range []string{"t2", "t3"} {
+		if task, ok := svc.taskByID(id); !ok || task.ArchivedAt != nil {
+			t.Fatalf("expected %s restored by one undo, got %#v ok=%t", id, task, ok)
+		}
+	}
+	if m.status != "undo complete: archive done" {
+		t.Fatalf("unexpected undo status %q", m.status)
+	}
+}
+
 // TestModelActivityLogOverlay verifies behavior for the covered scenario.
 func TestModelActivityLogOverlay(t *testing.T) {
 	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
@@ -6847,7 +7838,7 @@ func TestGlobalNoticesPanelItemFromAttentionCarriesStableIdentifiers(t *testing.
 		BodyMarkdown: "## Context\n\nNeeds a scoped response.",
 	}
 
-	row := globalNoticesPanelItemFromAttention(project, item)
+	row := NewModel(newFakeService(nil, nil, nil)).globalNoticesPanelItemFromAttention(project, item)
 	if row.StableKey == "" {
 		t.Fatal("expected non-empty stable key")
 	}
@@ -7864,8 +8855,15 @@ func TestModelMoveStepBuilderAndGroupingHelpers(t *testing.T) {
 	if got := m.groupLabelForTask(t3); got != "State: In Progress" {
 		t.Fatalf("unexpected state group label: %q", got)
 	}
-	if rank := taskGroupRank(t1, "priority"); rank != 0 {
-		t.Fatalf("expected high priority rank=0, got %d", rank)
+	// Critical sorts above high, and tasks without a priority sort after low.
+	if rank := taskGroupRank(domain.Task{Priority: domain.PriorityCritical}, "priority"); rank != 0 {
+		t.Fatalf("expected critical priority rank=0, got %d", rank)
+	}
+	if rank := taskGroupRank(t1, "priority"); rank != 1 {
+		t.Fatalf("expected high priority rank=1, got %d", rank)
+	}
+	if low, none := taskGroupRank(domain.Task{Priority: domain.PriorityLow}, "priority"), taskGroupRank(domain.Task{Priority: domain.PriorityNone}, "priority"); none <= low {
+		t.Fatalf("expected none priority to rank after low, got none=%d low=%d", none, low)
 	}
 	if rank := taskGroupRank(t2, "state"); rank != 2 {
 		t.Fatalf("expected done state rank=2, got %d", rank)
@@ -9170,10 +10168,10 @@ func TestRenderOverviewPanelOmitsLegacyNoticesFallbackWhenVisible(t *testing.T)
 	if strings.Contains(panel, "Notices") {
 		t.Fatalf("expected legacy single-panel notices title to be absent, got\n%s", panel)
 	}
-	if strings.Contains(panel, "project: "+projectDisplayName(project)) {
+	if strings.Contains(panel, "project: "+m.projectDisplayName(project)) {
 		t.Fatalf("expected legacy project fallback line to be absent, got\n%s", panel)
 	}
-	if strings.Contains(panel, "path: "+projectDisplayName(project)) {
+	if strings.Contains(panel, "path: "+m.projectDisplayName(project)) {
 		t.Fatalf("expected legacy path fallback line to be absent, got\n%s", panel)
 	}
 }
@@ -9292,6 +10290,8 @@ func TestModelViewShowsAttentionMarkersAndSummary(t *testing.T) {
 		[]domain.Column{todo, progress, done},
 		[]domain.Task{doneTask, blockedTask, waitingTask},
 	)))
+	// Widen the board so the blocked markers fit beside the full titles.
+	m = applyMsg(t, m, tea.WindowSizeMsg{Width: 140, Height: 40})
 	rendered := stripANSI(fmt.Sprint(m.View().Content))
 	if strings.Contains(rendered, "attention: 3") {
 		t.Fatalf("expected header to stay path-only (no attention token), got\n%s", rendered)
@@ -10041,55 +11041,292 @@ func TestModelSearchFocusNavigationWithJK(t *testing.T) {
 	}
 }
 
-// TestModelAutoRefreshTickReloadsExternalMutationsInBoardMode verifies board-mode auto-refresh pulls externally written tasks.
-func TestModelAutoRefreshTickReloadsExternalMutationsInBoardMode(t *testing.T) {
-	now := time.Date(2026, 2, 28, 9, 0, 0, 0, time.UTC)
+// TestModelSearchDueWindow verifies the search modal due inputs validate and forward a due window.
+func TestModelSearchDueWindow(t *testing.T) {
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
 	p, _ := domain.NewProject("p1", "Inbox", "", now)
 	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
-	existing, _ := domain.NewTask(domain.TaskInput{
-		ID:        "t-existing",
+	task, _ := domain.NewTask(domain.TaskInput{
+		ID:        "t1",
 		ProjectID: p.ID,
 		ColumnID:  c.ID,
-		Position:  0,
-		Title:     "Existing",
+		Title:     "Task",
 		Priority:  domain.PriorityLow,
 	}, now)
-	svc := newFakeService([]domain.Project{p}, []domain.Column{c}, []domain.Task{existing})
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c}, []domain.Task{task})
 	m := loadReadyModel(t, NewModel(svc))
-	m.autoRefreshInterval = time.Second
-
-	external, _ := domain.NewTask(domain.TaskInput{
-		ID:        "t-external",
-		ProjectID: p.ID,
-		ColumnID:  c.ID,
-		Position:  1,
-		Title:     "External",
-		Priority:  domain.PriorityMedium,
-	}, now.Add(time.Minute))
-	svc.tasks[p.ID] = append(svc.tasks[p.ID], external)
-
-	m, cmd := applyAutoRefreshTickMsg(t, m)
-	if !m.autoRefreshInFlight {
-		t.Fatal("expected auto-refresh load to be in flight after tick in board mode")
+	m = applyMsg(t, m, keyRune('/'))
+	for m.searchFocus != searchFocusDueFrom {
+		m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyTab})
 	}
-	m = applyAutoRefreshLoadResult(t, m, cmd)
-	if m.autoRefreshInFlight {
-		t.Fatal("expected auto-refresh load to complete")
+	typeText := func(text string) {
+		t.Helper()
+		for _, r := range text {
+			m = applyMsg(t, m, keyRune(r))
+		}
 	}
-	if len(m.tasks) != 2 {
-		t.Fatalf("expected 2 tasks after external refresh, got %d", len(m.tasks))
+	typeText("2026-03-09")
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyTab})
+	// Letter keys type into the due inputs instead of moving focus.
+	typeText("jk")
+	if m.searchFocus != searchFocusDueTo || m.searchDueToInput.Value() != "jk" {
+		t.Fatalf("expected j/k typed into due to, got focus %d value %q", m.searchFocus, m.searchDueToInput.Value())
 	}
-	if _, ok := m.taskByID(external.ID); !ok {
-		t.Fatalf("expected refreshed model to include %q", external.ID)
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.mode != modeSearch || m.searchFocus != searchFocusDueTo || !strings.HasPrefix(m.status, "due to:") {
+		t.Fatalf("expected bad due to kept in the modal, got mode %v focus %d status %q", m.mode, m.searchFocus, m.status)
 	}
-	if m.mode != modeNone {
-		t.Fatalf("expected board mode after refresh, got %v", m.mode)
+
+	m.searchDueToInput.SetValue("2026-03-02")
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.mode != modeSearch || m.status != "due to must be after due from" {
+		t.Fatalf("expected inverted window rejected, got mode %v status %q", m.mode, m.status)
+	}
+
+	m.searchDueFromInput.SetValue("2026-03-02")
+	m.searchDueToInput.SetValue("2026-03-08")
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.mode == modeSearch {
+		t.Fatalf("expected valid window to apply, status %q", m.status)
+	}
+	// A date-only due to includes the whole day, so the exclusive bound is the next local midnight.
+	wantAfter := time.Date(2026, 3, 2, 0, 0, 0, 0, time.Local).UTC()
+	wantBefore := time.Date(2026, 3, 9, 0, 0, 0, 0, time.Local).UTC()
+	if !svc.lastSearchFilter.DueAfter.Equal(wantAfter) || !svc.lastSearchFilter.DueBefore.Equal(wantBefore) {
+		t.Fatalf("expected due window %v..%v, got %v..%v", wantAfter, wantBefore, svc.lastSearchFilter.DueAfter, svc.lastSearchFilter.DueBefore)
+	}
+
+	// Resetting filters clears the due window.
+	m = applyMsg(t, m, keyRune('/'))
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyTab})
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: 'r', Mod: tea.ModCtrl})
+	if !m.searchDueAfter.IsZero() || !m.searchDueBefore.IsZero() || m.searchDueFromInput.Value() != "" {
+		t.Fatalf("expected reset to clear the due window, got %v..%v %q", m.searchDueAfter, m.searchDueBefore, m.searchDueFromInput.Value())
 	}
 }
 
-// TestModelAutoRefreshTickSkipsInputModes verifies auto-refresh defers while text-entry modals are active.
-func TestModelAutoRefreshTickSkipsInputModes(t *testing.T) {
-	now := time.Date(2026, 2, 28, 10, 0, 0, 0, time.UTC)
+// TestModelSearchCreatedWindow verifies the created from/to inputs validate like the due window and reach the search filter.
+func TestModelSearchCreatedWindow(t *testing.T) {
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	task, _ := domain.NewTask(domain.TaskInput{
+		ID:        "t1",
+		ProjectID: p.ID,
+		ColumnID:  c.ID,
+		Title:     "Task",
+		Priority:  domain.PriorityLow,
+	}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c}, []domain.Task{task})
+	m := loadReadyModel(t, NewModel(svc))
+	m = applyMsg(t, m, keyRune('/'))
+	for m.searchFocus != searchFocusCreatedFrom {
+		m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyTab})
+	}
+	m.searchCreatedFromInput.SetValue("2026-03-05")
+	m.searchCreatedToInput.SetValue("2026-03-01")
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.mode != modeSearch || m.searchFocus != searchFocusCreatedTo || m.status != "created to must be after created from" {
+		t.Fatalf("expected inverted created window rejected, got mode %v focus %d status %q", m.mode, m.searchFocus, m.status)
+	}
+
+	m.searchCreatedFromInput.SetValue("2026-03-01")
+	m.searchCreatedToInput.SetValue("2026-03-05")
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.mode == modeSearch {
+		t.Fatalf("expected valid created window to apply, status %q", m.status)
+	}
+	wantAfter := time.Date(2026, 3, 1, 0, 0, 0, 0, time.Local).UTC()
+	wantBefore := time.Date(2026, 3, 6, 0, 0, 0, 0, time.Local).UTC()
+	if !svc.lastSearchFilter.CreatedAfter.Equal(wantAfter) || !svc.lastSearchFilter.CreatedBefore.Equal(wantBefore) {
+		t.Fatalf("expected created window %v..%v, got %v..%v", wantAfter, wantBefore, svc.lastSearchFilter.CreatedAfter, svc.lastSearchFilter.CreatedBefore)
+	}
+	// The due window stays open when only the created window is set.
+	if !svc.lastSearchFilter.DueAfter.IsZero() || !svc.lastSearchFilter.DueBefore.IsZero() {
+		t.Fatalf("expected open due window, got %v..%v", svc.lastSearchFilter.DueAfter, svc.lastSearchFilter.DueBefore)
+	}
+}
+
+// TestModelSavedSearchSaveAndLoad verifies save-search captures the applied filters and load-search reapplies and runs them.
+func TestModelSavedSearchSaveAndLoad(t *testing.T) {
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	task, _ := domain.NewTask(domain.TaskInput{
+		ID:        "t1",
+		ProjectID: p.ID,
+		ColumnID:  c.ID,
+		Title:     "Login bug",
+		Priority:  domain.PriorityLow,
+	}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c}, []domain.Task{task})
+	var savedName string
+	var saved SavedSearch
+	m := loadReadyModel(t, NewModel(svc, WithSaveSavedSearchCallback(func(name string, search SavedSearch) error {
+		savedName = name
+		saved = search
+		return nil
+	})))
+	typeText := func(text string) {
+		t.Helper()
+		for _, r := range text {
+			m = applyMsg(t, m, keyRune(r))
+		}
+	}
+
+	updated, _ := m.executeCommandPalette("load-search")
+	m = mustModelValue(t, updated)
+	if m.mode == modeSavedSearch || m.status != "no saved searches" {
+		t.Fatalf("expected load-search to refuse with nothing saved, got mode %v status %q", m.mode, m.status)
+	}
+
+	m.searchInput.SetValue("login")
+	m.searchCrossProject = true
+	m.searchStates = []string{"todo"}
+	m.searchLevels = []string{"task"}
+	m.searchDueToInput.SetValue("2026-03-08")
+	m.mode = modeSearch
+	m = applyCmd(t, m, m.applySearchFilter())
+	// Window text typed after the last apply is not part of the applied search, so saving leaves it out.
+	m.searchCreatedFromInput.SetValue("2026-01-01")
+
+	updated, cmd := m.executeCommandPalette("save-search")
+	m = applyCmd(t, mustModelValue(t, updated), cmd)
+	if m.mode != modeSavedSearch || !m.savedSearchSaving {
+		t.Fatalf("expected save-search modal, got mode %v", m.mode)
+	}
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.mode != modeSavedSearch || m.status != "search name required" {
+		t.Fatalf("expected blank name rejected, got mode %v status %q", m.mode, m.status)
+	}
+	typeText("Triage")
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.mode != modeNone || savedName != "triage" {
+		t.Fatalf("expected search saved as triage, got mode %v name %q status %q", m.mode, savedName, m.status)
+	}
+	wantBefore := time.Date(2026, 3, 9, 0, 0, 0, 0, time.Local).UTC()
+	if saved.Query != "login" || !saved.CrossProject || !saved.DueBefore.Equal(wantBefore) ||
+		!saved.CreatedAfter.IsZero() || !slices.Equal(saved.States, []string{"todo"}) || !slices.Equal(saved.Levels, []string{"task"}) {
+		t.Fatalf("unexpected saved filters %#v", saved)
+	}
+
+	// Reset everything, then loading the saved search must restore and rerun the same filter set.
+	m = applyCmd(t, m, m.resetSearchFilters())
+	svc.lastSearchFilter = app.SearchTasksFilter{}
+	updated, cmd = m.executeCommandPalette("load-search")
+	m = applyCmd(t, mustModelValue(t, updated), cmd)
+	typeText("tri")
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	// A cross-project saved search runs like an applied cross-project search and lands in the results list.
+	if m.mode != modeSearchResults || !m.searchApplied {
+		t.Fatalf("expected saved search applied, got mode %v status %q", m.mode, m.status)
+	}
+	got := svc.lastSearchFilter
+	if got.Query != "login" || !got.CrossProject || !slices.Equal(got.States, []string{"todo"}) || !slices.Equal(got.Levels, []string{"task"}) {
+		t.Fatalf("expected saved filters sent to search, got %#v", got)
+	}
+	if !got.DueBefore.Equal(wantBefore) || !got.CreatedAfter.IsZero() {
+		t.Fatalf("expected due window before %v and no created window, got %v / %v", wantBefore, got.DueBefore, got.CreatedAfter)
+	}
+	// The modal shows the loaded bound the way it was typed, a whole day.
+	if value := m.searchDueToInput.Value(); value != "2026-03-08" {
+		t.Fatalf("expected loaded due-to input 2026-03-08, got %q", value)
+	}
+}
+
+// TestModelAutoRefreshTickReloadsExternalMutationsInBoardMode verifies board-mode auto-refresh pulls externally written tasks.
+func TestModelAutoRefreshTickReloadsExternalMutationsInBoardMode(t *testing.T) {
+	now := time.Date(2026, 2, 28, 9, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	existing, _ := domain.NewTask(domain.TaskInput{
+		ID:        "t-existing",
+		ProjectID: p.ID,
+		ColumnID:  c.ID,
+		Position:  0,
+		Title:     "Existing",
+		Priority:  domain.PriorityLow,
+	}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c}, []domain.Task{existing})
+	m := loadReadyModel(t, NewModel(svc))
+	m.autoRefreshInterval = time.Second
+
+	external, _ := domain.NewTask(domain.TaskInput{
+		ID:        "t-external",
+		ProjectID: p.ID,
+		ColumnID:  c.ID,
+		Position:  1,
+		Title:     "External",
+		Priority:  domain.PriorityMedium,
+	}, now.Add(time.Minute))
+	svc.tasks[p.ID] = append(svc.tasks[p.ID], external)
+
+	m, cmd := applyAutoRefreshTickMsg(t, m)
+	if !m.autoRefreshInFlight {
+		t.Fatal("expected auto-refresh load to be in flight after tick in board mode")
+	}
+	m = applyAutoRefreshLoadResult(t, m, cmd)
+	if m.autoRefreshInFlight {
+		t.Fatal("expected auto-refresh load to complete")
+	}
+	if len(m.tasks) != 2 {
+		t.Fatalf("expected 2 tasks after external refresh, got %d", len(m.tasks))
+	}
+	if _, ok := m.taskByID(external.ID); !ok {
+		t.Fatalf("expected refreshed model to include %q", external.ID)
+	}
+	if m.mode != modeNone {
+		t.Fatalf("expected board mode after refresh, got %v", m.mode)
+	}
+}
+
+// TestModelRefreshOnFocusReloadsExternalMutations verifies terminal focus events refresh the board only when enabled.
+func TestModelRefreshOnFocusReloadsExternalMutations(t *testing.T) {
+	now := time.Date(2026, 2, 28, 9, 30, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c}, nil)
+
+	off := loadReadyModel(t, NewModel(svc))
+	if off.View().ReportFocus {
+		t.Fatal("expected focus reporting disabled by default")
+	}
+	if _, cmd := off.Update(tea.FocusMsg{}); cmd != nil {
+		t.Fatal("expected focus event to be ignored when refresh_on_focus is off")
+	}
+
+	m := loadReadyModel(t, NewModel(svc, WithUIConfig(UIConfig{RefreshOnFocus: true})))
+	if !m.View().ReportFocus {
+		t.Fatal("expected focus reporting when refresh_on_focus is on")
+	}
+	if m.autoRefreshInterval != 0 {
+		t.Fatalf("expected polling to stay off without refresh_interval, got %v", m.autoRefreshInterval)
+	}
+	external, _ := domain.NewTask(domain.TaskInput{
+		ID:        "t-external",
+		ProjectID: p.ID,
+		ColumnID:  c.ID,
+		Title:     "External",
+		Priority:  domain.PriorityMedium,
+	}, now)
+	svc.tasks[p.ID] = append(svc.tasks[p.ID], external)
+
+	// Open input modals defer focus refreshes just like interval polling.
+	m.mode = modeAddTask
+	if _, cmd := m.Update(tea.FocusMsg{}); cmd != nil {
+		t.Fatal("expected focus refresh to be skipped while a form is open")
+	}
+	m.mode = modeNone
+	updated, cmd := m.Update(tea.FocusMsg{})
+	m = applyAutoRefreshLoadResult(t, mustModelValue(t, updated), cmd)
+	if _, ok := m.taskByID(external.ID); !ok {
+		t.Fatalf("expected focus refresh to load %q", external.ID)
+	}
+}
+
+// TestModelAutoRefreshTickSkipsInputModes verifies auto-refresh defers while text-entry modals are active.
+func TestModelAutoRefreshTickSkipsInputModes(t *testing.T) {
+	now := time.Date(2026, 2, 28, 10, 0, 0, 0, time.UTC)
 	p, _ := domain.NewProject("p1", "Inbox", "", now)
 	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
 	existing, _ := domain.NewTask(domain.TaskInput{
@@ -10494,7 +11731,11 @@ func TestTaskMetadataSchemaCoverageIsExplicit(t *testing.T) {
 		"RiskNotes":          {},
 		"DependsOn":          {},
 		"BlockedBy":          {},
+		"Reminders":          {},
+		"Assignee":           {},
 		"ResourceRefs":       {},
+		"RecurEvery":         {},
+		"Watched":            {},
 	}
 	readOnly := map[string]struct{}{
 		"CompletionContract": {},
@@ -10510,6 +11751,8 @@ func TestTaskMetadataSchemaCoverageIsExplicit(t *testing.T) {
 		"TransitionNotes":          {},
 		"ContextBlocks":            {},
 		"KindPayload":              {},
+		"ManuallyReopened":         {},
+		"LifecycleStateExplicit":   {},
 	}
 	assertExplicitFieldCoverage(t, reflect.TypeOf(domain.TaskMetadata{}), editable, readOnly, internal)
 }
@@ -10651,3 +11894,2937 @@ func assertExplicitFieldCoverage(
 		}
 	}
 }
+
+// TestModelActionErrorCodesKeepBoardUsable verifies classified service errors surface as status instead of the fatal error view.
+func TestModelActionErrorCodesKeepBoardUsable(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c1, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c1}, nil)
+	m := loadReadyModel(t, NewModel(svc))
+
+	m = applyMsg(t, m, actionMsg{err: fmt.Errorf("move task: %w", domain.ErrTransitionBlocked)})
+	if m.err != nil {
+		t.Fatalf("expected recoverable error to keep board view, got err=%v", m.err)
+	}
+	if !strings.HasPrefix(m.status, "blocked: ") {
+		t.Fatalf("expected blocked status, got %q", m.status)
+	}
+
+	m = applyMsg(t, m, actionMsg{err: fmt.Errorf("move task: %w", domain.ErrWIPLimitExceeded)})
+	if !strings.HasPrefix(m.status, "wip limit reached: ") {
+		t.Fatalf("expected wip limit status, got %q", m.status)
+	}
+
+	// Unclassified failures still use the fatal error view so storage problems are not hidden.
+	m = applyMsg(t, m, actionMsg{err: errors.New("disk full")})
+	if m.err == nil {
+		t.Fatal("expected unclassified error to set fatal model error")
+	}
+}
+
+// TestLoadControllerCancelsSupersededLoads verifies a new board load cancels the previous in-flight context.
+func TestLoadControllerCancelsSupersededLoads(t *testing.T) {
+	loads := newLoadController()
+	first, cancelFirst := loads.begin()
+	defer cancelFirst()
+	second, cancelSecond := loads.begin()
+	defer cancelSecond()
+
+	if !errors.Is(first.Err(), context.Canceled) {
+		t.Fatalf("expected first load context canceled, got %v", first.Err())
+	}
+	if second.Err() != nil {
+		t.Fatalf("expected latest load context active, got %v", second.Err())
+	}
+
+	// A canceled load result must not replace the board with the fatal error view.
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c1, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	m := loadReadyModel(t, NewModel(newFakeService([]domain.Project{p}, []domain.Column{c1}, nil)))
+	m = applyMsg(t, m, loadedMsg{err: fmt.Errorf("list tasks: %w", context.Canceled)})
+	if m.err != nil {
+		t.Fatalf("expected canceled load to be ignored, got err=%v", m.err)
+	}
+	if len(m.projects) != 1 {
+		t.Fatalf("expected existing projects retained, got %d", len(m.projects))
+	}
+}
+
+// TestModelReloadDebounceCoalescesBursts verifies only the newest reload request in a burst performs a load.
+func TestModelReloadDebounceCoalescesBursts(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c1, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	m := loadReadyModel(t, NewModel(newFakeService([]domain.Project{p}, []domain.Column{c1}, nil), WithReloadDebounce(time.Hour)))
+
+	if cmd := m.requestReload(); cmd == nil {
+		t.Fatal("expected debounced reload command")
+	}
+	if cmd := m.requestReload(); cmd == nil {
+		t.Fatal("expected second debounced reload command")
+	}
+	if _, cmd := m.handleReloadFlush(reloadFlushMsg{seq: m.reloadSeq - 1}); cmd != nil {
+		t.Fatal("expected superseded flush to skip loading")
+	}
+	_, cmd := m.handleReloadFlush(reloadFlushMsg{seq: m.reloadSeq})
+	if cmd == nil {
+		t.Fatal("expected newest flush to load")
+	}
+	if _, ok := cmd().(loadedMsg); !ok {
+		t.Fatal("expected newest flush to produce loadedMsg")
+	}
+}
+
+// TestModelAutoRefreshSkipsUnchangedBoard verifies identical auto-refresh payloads are not re-applied.
+func TestModelAutoRefreshSkipsUnchangedBoard(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c1, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	task, _ := domain.NewTask(domain.TaskInput{
+		ID:        "t1",
+		ProjectID: p.ID,
+		ColumnID:  c1.ID,
+		Title:     "Ship",
+		Priority:  domain.PriorityMedium,
+	}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c1}, []domain.Task{task})
+	m := loadReadyModel(t, NewModel(svc))
+
+	loaded, ok := m.loadData().(loadedMsg)
+	if !ok {
+		t.Fatal("expected loadedMsg")
+	}
+	if !m.autoRefreshUnchanged(loaded) {
+		t.Fatal("expected identical payload to be detected as unchanged")
+	}
+	loaded.tasks[0].Title = "Ship it"
+	if m.autoRefreshUnchanged(loaded) {
+		t.Fatal("expected title change to be detected")
+	}
+}
+
+// TestModelOptimisticApplyBeforeReconcile verifies mutation results patch the board before the reload lands.
+func TestModelOptimisticApplyBeforeReconcile(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c1, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	c2, _ := domain.NewColumn("c2", p.ID, "In Progress", 1, 0, now)
+	t1, _ := domain.NewTask(domain.TaskInput{
+		ID:        "t1",
+		ProjectID: p.ID,
+		ColumnID:  c1.ID,
+		Position:  0,
+		Title:     "Move me",
+		Priority:  domain.PriorityMedium,
+	}, now)
+	t2, _ := domain.NewTask(domain.TaskInput{
+		ID:        "t2",
+		ProjectID: p.ID,
+		ColumnID:  c1.ID,
+		Position:  1,
+		Title:     "Delete me",
+		Priority:  domain.PriorityLow,
+	}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c1, c2}, []domain.Task{t1, t2})
+	// A long debounce keeps the reconcile reload pending so only the optimistic patch is observable.
+	m := loadReadyModel(t, NewModel(svc, WithReloadDebounce(time.Hour)))
+
+	_, cmd := m.moveSelectedTask(1)
+	if cmd == nil {
+		t.Fatal("expected move command")
+	}
+	updated, reload := m.Update(cmd())
+	m = updated.(Model)
+	if reload == nil {
+		t.Fatal("expected reconcile reload to be scheduled")
+	}
+	moved, ok := m.taskByID("t1")
+	if !ok || moved.ColumnID != c2.ID {
+		t.Fatalf("expected t1 in %q before reload, got %#v", c2.ID, moved)
+	}
+	if m.selectedColumn != 1 {
+		t.Fatalf("expected focus to follow the moved task, got column %d", m.selectedColumn)
+	}
+
+	m.selectedColumn = 0
+	m.selectedTask = 0
+	_, cmd = m.deleteSelectedTask(app.DeleteModeHard)
+	if cmd == nil {
+		t.Fatal("expected delete command")
+	}
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if _, ok := m.taskByID("t2"); ok {
+		t.Fatal("expected hard-deleted task to leave the board before reload")
+	}
+}
+
+// TestModelInboxTriageMode verifies inbox mode lists undated first-column tasks and dispatches them.
+func TestModelInboxTriageMode(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	due := now.Add(48 * time.Hour)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c1, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	c2, _ := domain.NewColumn("c2", p.ID, "In Progress", 1, 0, now)
+	newTask := func(id, columnID string, position int, dueAt *time.Time) domain.Task {
+		task, _ := domain.NewTask(domain.TaskInput{
+			ID:        id,
+			ProjectID: p.ID,
+			ColumnID:  columnID,
+			Position:  position,
+			Title:     "Task " + id,
+			Priority:  domain.PriorityMedium,
+			DueAt:     dueAt,
+		}, now)
+		return task
+	}
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c1, c2}, []domain.Task{
+		newTask("t1", c1.ID, 0, nil),
+		newTask("t2", c1.ID, 1, &due),
+		newTask("t3", c1.ID, 2, nil),
+		newTask("t4", c2.ID, 0, nil),
+	})
+	m := loadReadyModel(t, NewModel(svc, WithReloadDebounce(time.Hour)))
+
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: 'I', Text: "I"})
+	if m.mode != modeInbox {
+		t.Fatalf("expected inbox mode, got %v", m.mode)
+	}
+	// Scheduled tasks and tasks outside the first column are already triaged.
+	if got := m.inboxTasks(); len(got) != 2 || got[0].ID != "t1" || got[1].ID != "t3" {
+		t.Fatalf("expected inbox [t1 t3], got %#v", got)
+	}
+	if view := stripANSI(fmt.Sprint(m.View().Content)); !strings.Contains(view, "2 to triage") {
+		t.Fatalf("expected inbox overlay in view, got %q", view)
+	}
+
+	// Each triage step persists and applies optimistically while the reconcile reload stays pending.
+	dispatch := func(code rune) {
+		t.Helper()
+		updated, cmd := m.Update(tea.KeyPressMsg{Code: code, Text: string(code)})
+		m = updated.(Model)
+		if cmd == nil {
+			t.Fatalf("expected command for key %q", string(code))
+		}
+		updated, _ = m.Update(cmd())
+		m = updated.(Model)
+	}
+	dispatch('p')
+	if task, _ := m.taskByID("t1"); task.Priority != domain.PriorityHigh {
+		t.Fatalf("expected t1 priority high, got %q", task.Priority)
+	}
+	dispatch('l')
+	if task, _ := m.taskByID("t1"); task.ColumnID != c2.ID {
+		t.Fatalf("expected t1 sent to %q, got %q", c2.ID, task.ColumnID)
+	}
+	if got := m.inboxTasks(); len(got) != 1 || got[0].ID != "t3" {
+		t.Fatalf("expected inbox [t3] after dispatch, got %#v", got)
+	}
+	dispatch('m')
+	if task, _ := m.taskByID("t3"); task.DueAt == nil {
+		t.Fatal("expected t3 to receive a due date")
+	}
+	if got := m.inboxTasks(); len(got) != 0 {
+		t.Fatalf("expected inbox zero, got %#v", got)
+	}
+	if m.mode != modeInbox {
+		t.Fatalf("expected inbox mode to persist across triage, got %v", m.mode)
+	}
+
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEscape})
+	if m.mode != modeNone {
+		t.Fatalf("expected esc to close inbox, got %v", m.mode)
+	}
+}
+
+// TestModelInboxNumberKeysDispatchAndUndo verifies digit keys send inbox tasks to column N, advance, and undo per action.
+func TestModelInboxNumberKeysDispatchAndUndo(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c1, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	c2, _ := domain.NewColumn("c2", p.ID, "In Progress", 1, 0, now)
+	c3, _ := domain.NewColumn("c3", p.ID, "Done", 2, 0, now)
+	tasks := make([]domain.Task, 0, 3)
+	for idx := range 3 {
+		task, _ := domain.NewTask(domain.TaskInput{
+			ID:        fmt.Sprintf("t%d", idx+1),
+			ProjectID: p.ID,
+			ColumnID:  c1.ID,
+			Position:  idx,
+			Title:     fmt.Sprintf("Task %d", idx+1),
+			Priority:  domain.PriorityMedium,
+		}, now)
+		tasks = append(tasks, task)
+	}
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c1, c2, c3}, tasks)
+	m := loadReadyModel(t, NewModel(svc, WithReloadDebounce(time.Hour)))
+	m.openInbox()
+
+	send := func(code rune) {
+		t.Helper()
+		updated, cmd := m.Update(tea.KeyPressMsg{Code: code, Text: string(code)})
+		m = updated.(Model)
+		if cmd == nil {
+			t.Fatalf("expected move command for key %q", string(code))
+		}
+		updated, _ = m.Update(cmd())
+		m = updated.(Model)
+	}
+	send('3')
+	if task, _ := m.taskByID("t1"); task.ColumnID != c3.ID {
+		t.Fatalf("expected t1 sent to %q, got %q", c3.ID, task.ColumnID)
+	}
+	// The dispatched row leaves the list, so the same index now highlights the next task.
+	if next, ok := m.selectedInboxTask(); !ok || next.ID != "t2" {
+		t.Fatalf("expected selection to advance to t2, got %#v", next)
+	}
+	send('2')
+	if task, _ := m.taskByID("t2"); task.ColumnID != c2.ID {
+		t.Fatalf("expected t2 sent to %q, got %q", c2.ID, task.ColumnID)
+	}
+	if len(m.undoStack) != 2 {
+		t.Fatalf("expected one undo entry per dispatch, got %d", len(m.undoStack))
+	}
+
+	// Column 1 is the inbox itself and out-of-range columns are rejected without a command.
+	updated, cmd := m.Update(tea.KeyPressMsg{Code: '1', Text: "1"})
+	m = updated.(Model)
+	if cmd != nil || !strings.Contains(m.status, "already in") {
+		t.Fatalf("expected no-op for current column, got status %q", m.status)
+	}
+	updated, cmd = m.Update(tea.KeyPressMsg{Code: '9', Text: "9"})
+	m = updated.(Model)
+	if cmd != nil || m.status != "no column 9" {
+		t.Fatalf("expected missing-column status, got %q", m.status)
+	}
+
+	updated, cmd = m.Update(tea.KeyPressMsg{Code: 'z', Mod: tea.ModCtrl})
+	m = updated.(Model)
+	if cmd == nil {
+		t.Fatal("expected undo command")
+	}
+	updated, _ = m.Update(cmd())
+	m = updated.(Model)
+	if len(m.undoStack) != 1 {
+		t.Fatalf("expected undo to pop only the last dispatch, got %d entries", len(m.undoStack))
+	}
+	for _, task := range svc.tasks[p.ID] {
+		if task.ID == "t2" && task.ColumnID != c1.ID {
+			t.Fatalf("expected undo to return t2 to %q, got %q", c1.ID, task.ColumnID)
+		}
+		if task.ID == "t1" && task.ColumnID != c3.ID {
+			t.Fatalf("expected t1 to stay in %q, got %q", c3.ID, task.ColumnID)
+		}
+	}
+}
+
+// TestModelEmptyStateCopyAndHints verifies configurable empty-state copy and contextual empty-column hints.
+func TestModelEmptyStateCopyAndHints(t *testing.T) {
+	emptyBoard := loadReadyModel(t, NewModel(
+		newFakeService(nil, nil, nil),
+		WithUIConfig(UIConfig{EmptyBoardMessage: "Welcome to the team board.\nAsk #ops for access."}),
+	))
+	// Startup opens the project picker when nothing exists; close it to see the board-level guidance.
+	emptyBoard.mode = modeNone
+	rendered := stripANSI(fmt.Sprint(emptyBoard.View().Content))
+	for _, want := range []string{"Welcome to the team board.", "Ask #ops for access.", "Press N to create your first project."} {
+		if !strings.Contains(rendered, want) {
+			t.Fatalf("expected %q in empty-board view, got %q", want, rendered)
+		}
+	}
+	if strings.Contains(rendered, defaultEmptyBoardMessage) {
+		t.Fatalf("expected configured message to replace the default, got %q", rendered)
+	}
+
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c1, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	c2, _ := domain.NewColumn("c2", p.ID, "Done", 1, 0, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c1, c2}, nil)
+	m := loadReadyModel(t, NewModel(svc, WithUIConfig(UIConfig{EmptyColumnText: "nothing here"})))
+	rendered = stripANSI(fmt.Sprint(m.View().Content))
+	if !strings.Contains(rendered, "nothing here") || strings.Contains(rendered, defaultEmptyColumnText) {
+		t.Fatalf("expected configured empty-column text, got %q", rendered)
+	}
+	// A project without tasks nudges toward the first task only in the first column.
+	if got := m.emptyColumnHint(0, true); got != "n adds your first task" {
+		t.Fatalf("expected first-task hint, got %q", got)
+	}
+	if got := m.emptyColumnHint(1, true); got != "" {
+		t.Fatalf("expected no hint for other columns on an empty board, got %q", got)
+	}
+
+	m.searchApplied = true
+	if got := m.emptyColumnHint(1, true); got != "no tasks match the active search" {
+		t.Fatalf("expected search hint, got %q", got)
+	}
+}
+
+// TestWrapTitle verifies card title wrapping breaks on words, hard-breaks long words, and truncates the last row.
+func TestWrapTitle(t *testing.T) {
+	cases := []struct {
+		name     string
+		title    string
+		width    int
+		maxLines int
+		want     []string
+	}{
+		{name: "fits", title: "Ship it", width: 10, maxLines: 2, want: []string{"Ship it"}},
+		{name: "word break", title: "Ship the release notes", width: 10, maxLines: 3, want: []string{"Ship the", "release", "notes"}},
+		{name: "space at boundary", title: "abcde fgh", width: 5, maxLines: 2, want: []string{"abcde", "fgh"}},
+		{name: "hard break", title: "abcdefghij", width: 4, maxLines: 2, want: []string{"abcd", "efg…"}},
+		{name: "truncate last row", title: "one two three four five", width: 8, maxLines: 2, want: []string{"one two", "three f…"}},
+		{name: "single line", title: "one two three", width: 8, maxLines: 1, want: []string{"one two…"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := wrapTitle(tc.title, tc.width, tc.maxLines); !slices.Equal(got, tc.want) {
+				t.Fatalf("wrapTitle(%q, %d, %d) = %q, want %q", tc.title, tc.width, tc.maxLines, got, tc.want)
+			}
+		})
+	}
+}
+
+// TestModelBoardTitleWrap verifies title_wrap renders long titles over several rows and keeps click mapping aligned.
+func TestModelBoardTitleWrap(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c1, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	long, _ := domain.NewTask(domain.TaskInput{
+		ID:        "t1",
+		ProjectID: p.ID,
+		ColumnID:  c1.ID,
+		Position:  0,
+		Title:     "Investigate flaky integration tests in the nightly pipeline before release",
+		Priority:  domain.PriorityMedium,
+	}, now)
+	short, _ := domain.NewTask(domain.TaskInput{
+		ID:        "t2",
+		ProjectID: p.ID,
+		ColumnID:  c1.ID,
+		Position:  1,
+		Title:     "Short",
+		Priority:  domain.PriorityMedium,
+	}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c1}, []domain.Task{long, short})
+	tasks := []domain.Task{long, short}
+
+	truncated := loadReadyModel(t, NewModel(svc, WithTaskFieldConfig(TaskFieldConfig{})))
+	if rendered := stripANSI(fmt.Sprint(truncated.View().Content)); strings.Contains(rendered, "release") {
+		t.Fatalf("expected truncated title by default, got %q", rendered)
+	}
+
+	wrapped := loadReadyModel(t, NewModel(svc,
+		WithTaskFieldConfig(TaskFieldConfig{}),
+		WithBoardConfig(BoardConfig{TitleWrap: true, TitleMaxLines: 20}),
+	))
+	rendered := stripANSI(fmt.Sprint(wrapped.View().Content))
+	if !strings.Contains(rendered, "release") {
+		t.Fatalf("expected wrapped title to render in full, got %q", rendered)
+	}
+	rows := len(wrapped.boardTitleLines(long.Title, max(1, wrapped.columnWidth()-10)))
+	if rows < 2 {
+		t.Fatalf("expected the long title to wrap over several rows, got %d", rows)
+	}
+	// The short card starts after every wrapped title row plus the blank separator.
+	if idx := wrapped.taskIndexAtRow(tasks, rows-1); idx != 0 {
+		t.Fatalf("expected last wrapped row to map to the long card, got %d", idx)
+	}
+	if idx := wrapped.taskIndexAtRow(tasks, rows+1); idx != 1 {
+		t.Fatalf("expected row after the separator to map to the short card, got %d", idx)
+	}
+}
+
+// TestModelCardTitleWidthCountsRowGlyphs verifies hit-testing and the board share one title width that caps
+// the depth indent and subtracts every glyph on the title row.
+func TestModelCardTitleWidthCountsRowGlyphs(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c1, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	long, _ := domain.NewTask(domain.TaskInput{
+		ID:        "t1",
+		ProjectID: p.ID,
+		ColumnID:  c1.ID,
+		Position:  0,
+		Title:     "Critical watched work that needs a long title to wrap across rows of the column",
+		Priority:  domain.PriorityCritical,
+		Labels:    []string{"bug"},
+		Metadata:  domain.TaskMetadata{Watched: true, DependsOn: []string{"missing"}},
+	}, now)
+	short, _ := domain.NewTask(domain.TaskInput{ID: "t2", ProjectID: p.ID, ColumnID: c1.ID, Position: 1, Title: "Short", Priority: domain.PriorityMedium}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c1}, []domain.Task{long, short})
+	m := loadReadyModel(t, NewModel(svc,
+		WithBoardConfig(BoardConfig{TitleWrap: true, TitleMaxLines: 20, DependencyBadges: []DependencyBadge{DependencyBadgeBlockedBy}}),
+		WithLabelConfig(LabelConfig{Colors: map[string]string{"bug": "196"}}),
+	))
+	taskByID := m.tasksByID()
+
+	// Glyphs: " !1" attention, the " ⛔1" dependency badge, " !!" critical, the blocked and watched markers, and the label glyph.
+	badges := m.taskDependencyBadges(long)
+	if badges != " ⛔1" {
+		t.Fatalf("expected the missing dependency badged, got %q", badges)
+	}
+	glyphs := lipgloss.Width(" !1" + badges + m.criticalPriorityMarker(long) + m.blockedTaskMarker(long) + watchedTaskMarker(long) + labelColorGlyph)
+	if got, want := m.cardTitleWidth(long, 0, 60, taskByID), 60-10-glyphs; got != want {
+		t.Fatalf("cardTitleWidth() = %d, want %d", got, want)
+	}
+	// The indent stops growing past depth four.
+	if deep, capped := m.cardTitleWidth(long, 9, 60, taskByID), m.cardTitleWidth(long, 4, 60, taskByID); deep != capped {
+		t.Fatalf("expected depth to cap at four levels, got %d and %d", deep, capped)
+	}
+
+	rows := len(m.boardTitleLines(long.Title, m.cardTitleWidth(long, 0, m.columnWidth(), taskByID)))
+	if m.taskListSecondary(long) != "" {
+		rows++
+	}
+	// The long card spans its wrapped and secondary rows plus the blank separator, so the short card starts right after.
+	if idx := m.taskIndexAtRow([]domain.Task{long, short}, rows-1); idx != 0 {
+		t.Fatalf("expected last wrapped row to map to the long card, got %d", idx)
+	}
+	if idx := m.taskIndexAtRow([]domain.Task{long, short}, rows+1); idx != 1 {
+		t.Fatalf("expected row after the separator to map to the short card, got %d", idx)
+	}
+}
+
+// TestModelStatusSegmentsFollowConfig verifies status_segments selects and orders the summary lines below the board.
+func TestModelStatusSegmentsFollowConfig(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c1, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	due := now.Add(-time.Hour)
+	task, _ := domain.NewTask(domain.TaskInput{
+		ID:        "t1",
+		ProjectID: p.ID,
+		ColumnID:  c1.ID,
+		Title:     "Late",
+		Priority:  domain.PriorityMedium,
+		DueAt:     &due,
+	}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c1}, []domain.Task{task})
+	render := func(opts ...Option) string {
+		t.Helper()
+		m := loadReadyModel(t, NewModel(svc, opts...))
+		m.status = "saved draft"
+		return stripANSI(fmt.Sprint(m.View().Content))
+	}
+
+	// Defaults keep the historical footer: status shown, due summary not.
+	if rendered := render(); !strings.Contains(rendered, "saved draft") || strings.Contains(rendered, "overdue: 1 • due soon: 0") {
+		t.Fatalf("expected default footer with status only, got %q", rendered)
+	}
+
+	rendered := render(WithUIConfig(UIConfig{ShowDueSummary: true, StatusSegments: []StatusSegment{"DUE", StatusSegmentStatus}}))
+	dueIdx := strings.Index(rendered, "overdue: 1 • due soon: 0")
+	statusIdx := strings.Index(rendered, "saved draft")
+	if dueIdx < 0 || statusIdx < 0 || dueIdx > statusIdx {
+		t.Fatalf("expected due summary before status, got %q", rendered)
+	}
+
+	if rendered := render(WithUIConfig(UIConfig{ShowDueSummary: true, StatusSegments: []StatusSegment{}})); strings.Contains(rendered, "saved draft") || strings.Contains(rendered, "overdue: 1 • due soon") {
+		t.Fatalf("expected empty status segments to hide every summary line, got %q", rendered)
+	}
+}
+
+// TestModelSelectedRowHighlightStyles verifies configured highlight treatments render on the focused row.
+func TestModelSelectedRowHighlightStyles(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c1, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	task, _ := domain.NewTask(domain.TaskInput{
+		ID:        "t1",
+		ProjectID: p.ID,
+		ColumnID:  c1.ID,
+		Title:     "Focused row",
+		Priority:  domain.PriorityMedium,
+	}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c1}, []domain.Task{task})
+
+	m := loadReadyModel(t, NewModel(svc))
+	if m.highlightStyle != "" || normalizeHighlightStyle(m.highlightStyle) != HighlightStyleColor {
+		t.Fatalf("expected color highlight by default, got %q", m.highlightStyle)
+	}
+	if got := m.renderSelectedTaskTitle("│  Focused row", false); !strings.Contains(got, "Focused row") || stripANSI(got) != "│  Focused row" {
+		t.Fatalf("expected color style to keep the cursor bar, got %q", stripANSI(got))
+	}
+
+	bar := loadReadyModel(t, NewModel(svc, WithUIConfig(UIConfig{HighlightStyle: "BAR"})))
+	if rendered := stripANSI(fmt.Sprint(bar.View().Content)); !strings.Contains(rendered, "▌  Focused row") {
+		t.Fatalf("expected left-bar marker on focused row, got %q", rendered)
+	}
+
+	reverse := loadReadyModel(t, NewModel(svc, WithUIConfig(UIConfig{HighlightStyle: HighlightStyleReverse})))
+	if !reverse.selectedTaskRowStyle(false).GetReverse() {
+		t.Fatal("expected reverse highlight style to enable reverse video")
+	}
+	bold := loadReadyModel(t, NewModel(svc, WithUIConfig(UIConfig{HighlightStyle: HighlightStyleBold})))
+	if style := bold.selectedTaskRowStyle(true); !style.GetBold() || !style.GetUnderline() {
+		t.Fatal("expected bold style with multi-select underline cue")
+	}
+}
+
+// TestModelPreviousProjectToggle verifies the previous-project binding alternates between the last two projects.
+func TestModelPreviousProjectToggle(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p1, _ := domain.NewProject("p1", "Alpha", "", now)
+	p2, _ := domain.NewProject("p2", "Beta", "", now)
+	p3, _ := domain.NewProject("p3", "Gamma", "", now)
+	c1, _ := domain.NewColumn("c1", p1.ID, "To Do", 0, 0, now)
+	c2, _ := domain.NewColumn("c2", p2.ID, "To Do", 0, 0, now)
+	c3, _ := domain.NewColumn("c3", p3.ID, "To Do", 0, 0, now)
+	svc := newFakeService([]domain.Project{p1, p2, p3}, []domain.Column{c1, c2, c3}, nil)
+	// Immediate reloads let each switch land within applyCmd.
+	m := loadReadyModel(t, NewModel(svc, WithReloadDebounce(0)))
+
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: '`', Text: "`"})
+	if m.status != "no previous project" {
+		t.Fatalf("expected no-previous status before any switch, got %q", m.status)
+	}
+
+	m.selectedProject = 2
+	m = applyCmd(t, m, m.requestReload())
+	currentID := func() string {
+		project, _ := m.currentProject()
+		return project.ID
+	}
+	if currentID() != p3.ID || m.previousProjectID != p1.ID {
+		t.Fatalf("expected current p3 with previous p1, got current %q previous %q", currentID(), m.previousProjectID)
+	}
+
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: '`', Text: "`"})
+	if currentID() != p1.ID {
+		t.Fatalf("expected toggle back to p1, got %q", currentID())
+	}
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: '`', Text: "`"})
+	if currentID() != p3.ID {
+		t.Fatalf("expected toggle forward to p3, got %q", currentID())
+	}
+
+	// Plain reloads of the same project keep the previous-project reference.
+	m = applyCmd(t, m, m.requestReload())
+	if m.previousProjectID != p1.ID {
+		t.Fatalf("expected previous project to survive reload, got %q", m.previousProjectID)
+	}
+}
+
+// TestModelJumpToTaskByID verifies the jump binding focuses tasks by ID, switching projects when needed.
+func TestModelJumpToTaskByID(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p1, _ := domain.NewProject("p1", "Alpha", "", now)
+	p2, _ := domain.NewProject("p2", "Beta", "", now)
+	c1, _ := domain.NewColumn("c1", p1.ID, "To Do", 0, 0, now)
+	c2, _ := domain.NewColumn("c2", p1.ID, "Done", 1, 0, now)
+	c3, _ := domain.NewColumn("c3", p2.ID, "To Do", 0, 0, now)
+	newTask := func(id, projectID, columnID, title string) domain.Task {
+		task, _ := domain.NewTask(domain.TaskInput{
+			ID:        id,
+			ProjectID: projectID,
+			ColumnID:  columnID,
+			Title:     title,
+			Priority:  domain.PriorityMedium,
+		}, now)
+		return task
+	}
+	svc := newFakeService(
+		[]domain.Project{p1, p2},
+		[]domain.Column{c1, c2, c3},
+		[]domain.Task{
+			newTask("alpha-1", p1.ID, c1.ID, "First"),
+			newTask("alpha-2", p1.ID, c2.ID, "Second"),
+			newTask("beta-42", p2.ID, c3.ID, "Remote"),
+		},
+	)
+	m := loadReadyModel(t, NewModel(svc, WithReloadDebounce(0)))
+	jump := func(m Model, id string) Model {
+		t.Helper()
+		m = applyMsg(t, m, keyRune('#'))
+		if m.mode != modeJumpToTask {
+			t.Fatalf("expected jump-to-task mode, got %v", m.mode)
+		}
+		for _, r := range id {
+			m = applyMsg(t, m, keyRune(r))
+		}
+		return applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	}
+
+	m = jump(m, "alpha-2")
+	if task, ok := m.selectedTaskInCurrentColumn(); !ok || task.ID != "alpha-2" {
+		t.Fatalf("expected alpha-2 focused in the current project, got %#v ok=%t", task, ok)
+	}
+
+	// A unique prefix resolves across projects and switches the board to the owning project.
+	m = jump(m, "beta")
+	project, _ := m.currentProject()
+	if project.ID != p2.ID {
+		t.Fatalf("expected jump to switch to project p2, got %q", project.ID)
+	}
+	if task, ok := m.selectedTaskInCurrentColumn(); !ok || task.ID != "beta-42" {
+		t.Fatalf("expected beta-42 focused after project switch, got %#v ok=%t", task, ok)
+	}
+
+	// Ambiguous prefixes and unknown IDs both surface a not-found warning.
+	m = jump(m, "alpha")
+	if m.mode != modeWarning || !strings.Contains(m.warningBody, `"alpha"`) {
+		t.Fatalf("expected not-found warning for ambiguous prefix, got mode %v body %q", m.mode, m.warningBody)
+	}
+	m.closeWarningModal()
+	m = jump(m, "missing")
+	if m.mode != modeWarning || m.status != "task not found" {
+		t.Fatalf("expected not-found warning, got mode %v status %q", m.mode, m.status)
+	}
+}
+
+// TestModelGoToColumnFuzzyMatchesName verifies the go-to-column command focuses a fuzzy-matched column.
+func TestModelGoToColumnFuzzyMatchesName(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Alpha", "", now)
+	c1, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	c2, _ := domain.NewColumn("c2", p.ID, "Review", 1, 0, now)
+	c3, _ := domain.NewColumn("c3", p.ID, "Blocked", 2, 0, now)
+	first, _ := domain.NewTask(domain.TaskInput{ID: "t1", ProjectID: p.ID, ColumnID: c3.ID, Title: "First", Priority: domain.PriorityMedium, Position: 0}, now)
+	second, _ := domain.NewTask(domain.TaskInput{ID: "t2", ProjectID: p.ID, ColumnID: c3.ID, Title: "Second", Priority: domain.PriorityMedium, Position: 1}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c1, c2, c3}, []domain.Task{first, second})
+	m := loadReadyModel(t, NewModel(svc, WithReloadDebounce(0)))
+	if !m.focusTaskByID("t2") {
+		t.Fatal("expected t2 on the board")
+	}
+	goTo := func(m Model, query string) Model {
+		t.Helper()
+		updated, cmd := m.executeCommandPalette("go-to-column")
+		m = applyResult(t, updated, cmd)
+		if m.mode != modeGoToColumn {
+			t.Fatalf("expected go-to-column mode, got %v", m.mode)
+		}
+		for _, r := range query {
+			m = applyMsg(t, m, keyRune(r))
+		}
+		return m
+	}
+
+	// "rvw" is a subsequence of Review only.
+	m = goTo(m, "rvw")
+	if rendered := stripANSI(fmt.Sprint(m.View().Content)); !strings.Contains(rendered, "Go To Column") || !strings.Contains(rendered, "› Review") {
+		t.Fatalf("expected ranked column matches in overlay, got %q", rendered)
+	}
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.mode != modeNone || m.selectedColumn != 1 || m.status != "column: Review" {
+		t.Fatalf("expected Review focused, got mode %v column %d status %q", m.mode, m.selectedColumn, m.status)
+	}
+
+	// Jumping resets task selection even when landing back on a populated column.
+	m.selectedColumn = 2
+	m.selectedTask = 1
+	m = goTo(m, "blo")
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.selectedColumn != 2 || m.selectedTask != 0 {
+		t.Fatalf("expected Blocked focused with selection reset, got column %d task %d", m.selectedColumn, m.selectedTask)
+	}
+
+	m = goTo(m, "zzz")
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.mode != modeGoToColumn || !strings.Contains(m.status, "no column matches") {
+		t.Fatalf("expected unmatched query to keep the modal open, got mode %v status %q", m.mode, m.status)
+	}
+}
+
+// TestModelNewFromTemplatePrefillsTaskForm verifies templates only pre-fill the form and user edits win on submit.
+func TestModelNewFromTemplatePrefillsTaskForm(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Alpha", "", now)
+	c1, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c1}, nil)
+	m := loadReadyModel(t, NewModel(svc, WithTaskTemplates(map[string]TaskTemplate{
+		"Bug": {
+			TitlePrefix: "bug: ",
+			Priority:    domain.PriorityHigh,
+			Labels:      []string{"bug", "triage"},
+			Description: "## Steps to reproduce",
+		},
+		"chore": {TitlePrefix: "chore: "},
+	})))
+
+	updated, cmd := m.executeCommandPalette("new-from-template")
+	m = applyResult(t, updated, cmd)
+	if m.mode != modeTemplatePicker {
+		t.Fatalf("expected template picker mode, got %v", m.mode)
+	}
+	for _, r := range "bg" {
+		m = applyMsg(t, m, keyRune(r))
+	}
+	if rendered := stripANSI(fmt.Sprint(m.View().Content)); !strings.Contains(rendered, "New From Template") || !strings.Contains(rendered, "› bug") {
+		t.Fatalf("expected ranked template matches in overlay, got %q", rendered)
+	}
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.mode != modeAddTask {
+		t.Fatalf("expected add-task mode, got %v", m.mode)
+	}
+	if got := m.formInputs[taskFieldTitle].Value(); got != "bug: " {
+		t.Fatalf("expected title prefix pre-filled, got %q", got)
+	}
+	if got := m.formInputs[taskFieldPriority].Value(); got != string(domain.PriorityHigh) {
+		t.Fatalf("expected template priority pre-filled, got %q", got)
+	}
+	if got := m.formInputs[taskFieldLabels].Value(); got != "bug,triage" {
+		t.Fatalf("expected template labels pre-filled, got %q", got)
+	}
+	if m.taskFormDescription != "## Steps to reproduce" {
+		t.Fatalf("expected template description pre-filled, got %q", m.taskFormDescription)
+	}
+
+	// The title input keeps focus with its cursor after the prefix, so typing appends.
+	for _, r := range "crash" {
+		m = applyMsg(t, m, keyRune(r))
+	}
+	m.cyclePriority(-1)
+	m.formInputs[taskFieldLabels].SetValue("bug")
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if svc.createTaskCalls != 1 {
+		t.Fatalf("expected one create-task call, got %d", svc.createTaskCalls)
+	}
+	got := svc.lastCreateTask
+	if got.Title != "bug: crash" || got.Priority != domain.PriorityMedium || !slices.Equal(got.Labels, []string{"bug"}) {
+		t.Fatalf("expected user edits to win over template values, got %#v", got)
+	}
+	if got.Description != "## Steps to reproduce" {
+		t.Fatalf("expected untouched template description to be kept, got %q", got.Description)
+	}
+}
+
+// TestModelNewFromTemplateWithoutTemplates verifies the palette entry reports missing configuration.
+func TestModelNewFromTemplateWithoutTemplates(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Alpha", "", now)
+	c1, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	m := loadReadyModel(t, NewModel(newFakeService([]domain.Project{p}, []domain.Column{c1}, nil)))
+	updated, cmd := m.executeCommandPalette("template")
+	m = applyResult(t, updated, cmd)
+	if m.mode != modeNone || m.status != "no task templates configured" {
+		t.Fatalf("expected missing-template status, got mode %v status %q", m.mode, m.status)
+	}
+}
+
+// TestModelOpenDataDirCommand verifies the palette command opens the data dir and falls back to its path.
+func TestModelOpenDataDirCommand(t *testing.T) {
+	orig := openPathInOS
+	t.Cleanup(func() { openPathInOS = orig })
+	opened := ""
+	openPathInOS = func(path string) error {
+		opened = path
+		return nil
+	}
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Alpha", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	m := loadReadyModel(t, NewModel(newFakeService([]domain.Project{p}, []domain.Column{c}, nil), WithDataDir("/data/tillsyn")))
+
+	updated, cmd := m.executeCommandPalette("open-data-dir")
+	m = applyResult(t, updated, cmd)
+	if opened != "/data/tillsyn" || m.status != "opened data dir /data/tillsyn" {
+		t.Fatalf("expected data dir opened, got opened=%q status %q", opened, m.status)
+	}
+
+	// Headless sessions show the path instead of failing.
+	openPathInOS = func(string) error { return platform.ErrOpenUnavailable }
+	updated, cmd = m.executeCommandPalette("data-dir")
+	m = applyResult(t, updated, cmd)
+	if m.status != "data dir: /data/tillsyn" {
+		t.Fatalf("expected headless fallback status, got %q", m.status)
+	}
+}
+
+// TestModelTaskFormDraftAutosaveAndRecovery verifies open task forms autosave per form and recover on relaunch.
+func TestModelTaskFormDraftAutosaveAndRecovery(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Alpha", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c}, nil)
+	dir := t.TempDir()
+	launch := func() Model {
+		t.Helper()
+		m := loadReadyModel(t, NewModel(svc, WithReloadDebounce(0)))
+		m.draftDir = dir
+		m.draftAutosaveInterval = time.Minute
+		return m
+	}
+	draftPath := filepath.Join(dir, p.ID, newTaskDraftName+".json")
+	typeText := func(m Model, text string) Model {
+		for _, r := range text {
+			m = applyMsg(t, m, keyRune(r))
+		}
+		return m
+	}
+
+	m := launch()
+	m = applyMsg(t, m, keyRune('n'))
+	m = typeText(m, "Unsaved plan")
+	if cmd := m.autosaveTaskFormDraftCmd(); cmd == nil {
+		t.Fatal("expected open task form to autosave")
+	} else if msg, ok := cmd().(taskFormDraftSavedMsg); !ok || msg.err != nil {
+		t.Fatalf("expected draft write to succeed, got %#v", msg)
+	}
+	// Unchanged forms are not rewritten on the next tick.
+	if cmd := m.autosaveTaskFormDraftCmd(); cmd != nil {
+		t.Fatal("expected unchanged draft to skip autosave")
+	}
+	if _, err := os.Stat(draftPath); err != nil {
+		t.Fatalf("expected draft file at %q, got %v", draftPath, err)
+	}
+
+	// A fresh launch offers the leftover draft and restores it into the new-task form.
+	m = launch()
+	m = applyCmd(t, m, m.checkTaskFormDraftCmd())
+	if m.mode != modeRecoverDraft {
+		t.Fatalf("expected draft recovery prompt, got mode %v", m.mode)
+	}
+	if rendered := stripANSI(fmt.Sprint(m.View().Content)); !strings.Contains(rendered, "Recover Unsaved Task?") || !strings.Contains(rendered, "Unsaved plan") {
+		t.Fatalf("expected recovery overlay, got %q", rendered)
+	}
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.mode != modeAddTask || m.formInputs[taskFieldTitle].Value() != "Unsaved plan" {
+		t.Fatalf("expected recovered new-task form, got mode %v", m.mode)
+	}
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if len(svc.tasks[p.ID]) != 1 {
+		t.Fatalf("expected recovered draft to create one task, got %d", len(svc.tasks[p.ID]))
+	}
+	if _, err := os.Stat(draftPath); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected successful submit to clear the draft, got %v", err)
+	}
+
+	// Cancelling the form is an explicit discard.
+	m = applyMsg(t, m, keyRune('n'))
+	m = typeText(m, "Throwaway")
+	if cmd := m.autosaveTaskFormDraftCmd(); cmd != nil {
+		cmd()
+	}
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEscape})
+	if _, err := os.Stat(draftPath); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected cancelled form to discard the draft, got %v", err)
+	}
+}
+
+// TestModelTaskFormDraftDiscardCancelsPendingAutosave verifies an autosave scheduled before submit cannot recreate the draft.
+func TestModelTaskFormDraftDiscardCancelsPendingAutosave(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Alpha", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c}, nil)
+	dir := t.TempDir()
+	m := loadReadyModel(t, NewModel(svc, WithReloadDebounce(0)))
+	m.draftDir = dir
+	m.draftAutosaveInterval = time.Minute
+	draftPath := filepath.Join(dir, p.ID, newTaskDraftName+".json")
+
+	m = applyMsg(t, m, keyRune('n'))
+	m.formInputs[taskFieldTitle].SetValue("Racing draft")
+	pending := m.autosaveTaskFormDraftCmd()
+	if pending == nil {
+		t.Fatal("expected changed form to autosave")
+	}
+	// Submit runs its removal first; the autosave command scheduled before it then runs late.
+	updated, submit := m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	m = applyResult(t, updated, submit)
+	if msg, ok := pending().(taskFormDraftSavedMsg); !ok || msg.err != nil {
+		t.Fatalf("expected stale autosave to report no error, got %#v", msg)
+	}
+	if _, err := os.Stat(draftPath); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected stale autosave to skip its write, got %v", err)
+	}
+}
+
+// TestModelReloadStartsDraftAutosaveTick verifies enabling autosave through a config reload starts the tick once.
+func TestModelReloadStartsDraftAutosaveTick(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Alpha", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c}, nil)
+	m := loadReadyModel(t, NewModel(svc, WithReloadDebounce(0), WithDraftDir(t.TempDir())))
+	if m.draftTickArmed {
+		t.Fatal("expected no autosave tick while the interval is off")
+	}
+	updated, _ := m.Update(configReloadedMsg{config: RuntimeConfig{UI: UIConfig{DraftAutosaveInterval: time.Minute}}})
+	m = mustModelValue(t, updated)
+	if !m.draftTickArmed {
+		t.Fatal("expected reload to start the autosave tick")
+	}
+	// Scheduling again while the tick is pending does not start another.
+	if cmd := m.scheduleTaskFormDraftTickCmd(); cmd != nil {
+		t.Fatal("expected no second tick while one is pending")
+	}
+}
+
+// TestModelTaskFormDraftKeyedPerTaskAndOfferedOnOpen verifies edit and new-task drafts live apart and reopening a form offers its draft.
+func TestModelTaskFormDraftKeyedPerTaskAndOfferedOnOpen(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Alpha", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	task, _ := domain.NewTask(domain.TaskInput{
+		ID:        "t1",
+		ProjectID: p.ID,
+		ColumnID:  c.ID,
+		Position:  0,
+		Title:     "Existing",
+		Priority:  domain.PriorityMedium,
+	}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c}, []domain.Task{task})
+	dir := t.TempDir()
+	m := loadReadyModel(t, NewModel(svc, WithReloadDebounce(0)))
+	m.draftDir = dir
+	m.draftAutosaveInterval = time.Minute
+	autosave := func(m Model) Model {
+		t.Helper()
+		cmd := m.autosaveTaskFormDraftCmd()
+		if cmd == nil {
+			t.Fatal("expected open task form to autosave")
+		}
+		if msg, ok := cmd().(taskFormDraftSavedMsg); !ok || msg.err != nil {
+			t.Fatalf("expected draft write to succeed, got %#v", msg)
+		}
+		return m
+	}
+
+	// An edit draft and a new-task draft are written side by side.
+	_ = m.startTaskForm(&task)
+	m.formInputs[taskFieldTitle].SetValue("Existing, renamed")
+	m = autosave(m)
+	_ = m.startTaskForm(nil)
+	m.formInputs[taskFieldTitle].SetValue("Fresh idea")
+	m = autosave(m)
+	editPath := filepath.Join(dir, p.ID, task.ID+".json")
+	for _, path := range []string{editPath, filepath.Join(dir, p.ID, newTaskDraftName+".json")} {
+		if _, err := os.Stat(path); err != nil {
+			t.Fatalf("expected draft file at %q, got %v", path, err)
+		}
+	}
+
+	// Reopening the edit form offers its own draft rather than the new-task one.
+	m.mode = modeNone
+	m = applyCmd(t, m, m.checkOpenTaskFormDraftCmd())
+	if m.mode != modeNone {
+		t.Fatalf("expected no offer without an open form, got mode %v", m.mode)
+	}
+	_ = m.startTaskForm(&task)
+	m = applyCmd(t, m, m.checkOpenTaskFormDraftCmd())
+	if m.mode != modeRecoverDraft || m.recoverDraft.Fields["title"] != "Existing, renamed" {
+		t.Fatalf("expected edit draft offer, got mode %v draft %#v", m.mode, m.recoverDraft)
+	}
+	if rendered := stripANSI(fmt.Sprint(m.View().Content)); !strings.Contains(rendered, "esc keep editing") {
+		t.Fatalf("expected form-open recovery hint, got %q", rendered)
+	}
+	// Putting the draft off returns to the untouched form and keeps the file.
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEscape})
+	if m.mode != modeEditTask || m.formInputs[taskFieldTitle].Value() != "Existing" {
+		t.Fatalf("expected untouched edit form after esc, got mode %v title %q", m.mode, m.formInputs[taskFieldTitle].Value())
+	}
+	if _, err := os.Stat(editPath); err != nil {
+		t.Fatalf("expected esc to keep the draft, got %v", err)
+	}
+	// The untouched form does not autosave over the put-off draft, and cancelling it keeps the draft.
+	if cmd := m.autosaveTaskFormDraftCmd(); cmd != nil {
+		t.Fatal("expected untouched form to skip autosave")
+	}
+	m = applyCmd(t, m, func() tea.Msg { return tea.KeyPressMsg{Code: tea.KeyEscape} })
+	if m.mode != modeNone {
+		t.Fatalf("expected cancel to close the form, got mode %v", m.mode)
+	}
+	if _, err := os.Stat(editPath); err != nil {
+		t.Fatalf("expected cancel after a put-off recovery to keep the draft, got %v", err)
+	}
+	_ = m.startTaskForm(&task)
+
+	// Recovering fills the form, and the follow-up check sees the draft already shown and stays quiet.
+	m = applyCmd(t, m, m.checkOpenTaskFormDraftCmd())
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.mode != modeEditTask || m.formInputs[taskFieldTitle].Value() != "Existing, renamed" {
+		t.Fatalf("expected recovered edit form, got mode %v title %q", m.mode, m.formInputs[taskFieldTitle].Value())
+	}
+	m = applyCmd(t, m, m.checkOpenTaskFormDraftCmd())
+	if m.mode != modeEditTask {
+		t.Fatalf("expected recovered form to stay open, got mode %v", m.mode)
+	}
+
+	// Discarding from a form-open prompt removes only that form's draft and returns to the form.
+	_ = m.startTaskForm(&task)
+	m = applyCmd(t, m, m.checkOpenTaskFormDraftCmd())
+	m = applyMsg(t, m, keyRune('d'))
+	if m.mode != modeEditTask {
+		t.Fatalf("expected discard to return to the edit form, got mode %v", m.mode)
+	}
+	if _, err := os.Stat(editPath); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected discarded edit draft to be removed, got %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, p.ID, newTaskDraftName+".json")); err != nil {
+		t.Fatalf("expected new-task draft to survive, got %v", err)
+	}
+}
+
+// TestModelRecentTasksTracksTaskInfoAndJumpsBack verifies task-info views feed the recent list and its picker jumps back.
+func TestModelRecentTasksTracksTaskInfoAndJumpsBack(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Alpha", "", now)
+	c1, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	c2, _ := domain.NewColumn("c2", p.ID, "Done", 1, 0, now)
+	first, _ := domain.NewTask(domain.TaskInput{ID: "t1", ProjectID: p.ID, ColumnID: c1.ID, Title: "First", Priority: domain.PriorityMedium}, now)
+	second, _ := domain.NewTask(domain.TaskInput{ID: "t2", ProjectID: p.ID, ColumnID: c2.ID, Title: "Second", Priority: domain.PriorityMedium}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c1, c2}, []domain.Task{first, second})
+	m := loadReadyModel(t, NewModel(svc, WithReloadDebounce(0)))
+
+	m.openRecentTasks()
+	if m.mode == modeRecentTasks || m.status != "no recently viewed tasks" {
+		t.Fatalf("expected empty recent list to stay on the board, got mode %v status %q", m.mode, m.status)
+	}
+	recentIDs := func() []string {
+		ids := make([]string, 0, len(m.recentTasks))
+		for _, entry := range m.recentTasks {
+			ids = append(ids, entry.TaskID)
+		}
+		return ids
+	}
+	view := func(taskID string) {
+		t.Helper()
+		m.mode = modeNone
+		if !m.focusTaskByID(taskID) {
+			t.Fatalf("expected task %q on the board", taskID)
+		}
+		m = applyMsg(t, m, keyRune('i'))
+		m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEscape})
+	}
+	view("t1")
+	view("t2")
+	view("t1")
+	// Re-viewing a task moves it to the front instead of duplicating it.
+	if got := recentIDs(); !slices.Equal(got, []string{"t1", "t2"}) {
+		t.Fatalf("expected recent tasks [t1 t2], got %v", got)
+	}
+
+	m = applyCmd(t, m, m.requestReload())
+	if got := recentIDs(); !slices.Equal(got, []string{"t1", "t2"}) {
+		t.Fatalf("expected recent tasks to survive reload, got %v", got)
+	}
+
+	m.openRecentTasks()
+	if m.mode != modeRecentTasks {
+		t.Fatalf("expected recent tasks picker, got mode %v", m.mode)
+	}
+	if rendered := stripANSI(fmt.Sprint(m.View().Content)); !strings.Contains(rendered, "Recently Viewed") || !strings.Contains(rendered, "Second • Alpha") {
+		t.Fatalf("expected recent tasks overlay, got %q", rendered)
+	}
+	m = applyMsg(t, m, keyRune('j'))
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if task, ok := m.selectedTaskInCurrentColumn(); !ok || task.ID != "t2" {
+		t.Fatalf("expected picker to jump back to t2, got %#v ok=%t", task, ok)
+	}
+}
+
+// TestModelProjectProfilesApplyOnProjectSwitch verifies profiles overlay global view settings per active project.
+func TestModelProjectProfilesApplyOnProjectSwitch(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p1, _ := domain.NewProject("p1", "Alpha", "", now)
+	p2, _ := domain.NewProject("p2", "Beta", "", now)
+	c1, _ := domain.NewColumn("c1", p1.ID, "To Do", 0, 0, now)
+	c2, _ := domain.NewColumn("c2", p2.ID, "To Do", 0, 0, now)
+	svc := newFakeService([]domain.Project{p1, p2}, []domain.Column{c1, c2}, nil)
+	showDescription := true
+	m := loadReadyModel(t, NewModel(svc,
+		WithReloadDebounce(0),
+		WithBoardConfig(BoardConfig{GroupBy: "none"}),
+		WithUIConfig(UIConfig{HighlightStyle: HighlightStyleColor}),
+		WithProjectProfiles(map[string]ProjectProfile{
+			" BETA ": {GroupBy: "priority", HighlightStyle: HighlightStyleBar, ShowDescription: &showDescription},
+		}),
+	))
+	if m.boardGroupBy != "none" || m.highlightStyle != HighlightStyleColor || m.taskFields.ShowDescription {
+		t.Fatalf("expected global settings for project without profile, got group=%q style=%q fields=%#v", m.boardGroupBy, m.highlightStyle, m.taskFields)
+	}
+
+	m.selectedProject = 1
+	m = applyCmd(t, m, m.requestReload())
+	if m.boardGroupBy != "priority" || m.highlightStyle != HighlightStyleBar || !m.taskFields.ShowDescription {
+		t.Fatalf("expected beta profile settings, got group=%q style=%q fields=%#v", m.boardGroupBy, m.highlightStyle, m.taskFields)
+	}
+	// Fields the profile leaves unset keep the global value.
+	if !m.taskFields.ShowPriority {
+		t.Fatalf("expected unset profile field to keep global value, got %#v", m.taskFields)
+	}
+
+	// Switching back restores the global defaults instead of leaking the profile.
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: '`', Text: "`"})
+	if m.boardGroupBy != "none" || m.highlightStyle != HighlightStyleColor || m.taskFields.ShowDescription {
+		t.Fatalf("expected global settings after switching back, got group=%q style=%q fields=%#v", m.boardGroupBy, m.highlightStyle, m.taskFields)
+	}
+}
+
+// TestModelProjectProfilePageSizeShapesFirstLoad verifies the first load after a switch pages with the new project's size.
+func TestModelProjectProfilePageSizeShapesFirstLoad(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p1, _ := domain.NewProject("p1", "Alpha", "", now)
+	p2, _ := domain.NewProject("p2", "Beta", "", now)
+	c1, _ := domain.NewColumn("c1", p1.ID, "To Do", 0, 0, now)
+	c2, _ := domain.NewColumn("c2", p2.ID, "To Do", 0, 0, now)
+	tasks := make([]domain.Task, 0, 8)
+	for _, column := range []domain.Column{c1, c2} {
+		for idx := range 4 {
+			task, _ := domain.NewTask(domain.TaskInput{
+				ID:        fmt.Sprintf("%s-t%d", column.ProjectID, idx+1),
+				ProjectID: column.ProjectID,
+				ColumnID:  column.ID,
+				Position:  idx,
+				Title:     fmt.Sprintf("Task %d", idx+1),
+				Priority:  domain.PriorityMedium,
+			}, now)
+			tasks = append(tasks, task)
+		}
+	}
+	svc := newFakeService([]domain.Project{p1, p2}, []domain.Column{c1, c2}, tasks)
+	alphaSize, betaSize := 1, 3
+	m := loadReadyModel(t, NewModel(svc,
+		WithReloadDebounce(0),
+		WithBoardConfig(BoardConfig{ColumnPageSize: 2}),
+		WithProjectProfiles(map[string]ProjectProfile{
+			"alpha": {ColumnPageSize: &alphaSize},
+			"beta":  {ColumnPageSize: &betaSize},
+		}),
+	))
+	if len(m.tasks) != alphaSize {
+		t.Fatalf("expected alpha's first load to page %d tasks, got %d", alphaSize, len(m.tasks))
+	}
+
+	m.selectedProject = 1
+	m = applyCmd(t, m, m.requestReload())
+	if project, _ := m.currentProject(); project.ID != p2.ID || len(m.tasks) != betaSize {
+		t.Fatalf("expected beta's first load to page %d tasks, got project %q with %d", betaSize, project.ID, len(m.tasks))
+	}
+
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: '`', Text: "`"})
+	if project, _ := m.currentProject(); project.ID != p1.ID || len(m.tasks) != alphaSize {
+		t.Fatalf("expected switching back to page %d alpha tasks, got project %q with %d", alphaSize, project.ID, len(m.tasks))
+	}
+}
+
+// TestModelSurfacesOrphanedSubtasksAsWarnings verifies orphaned subtasks appear in the warnings panel.
+func TestModelSurfacesOrphanedSubtasksAsWarnings(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c1, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	root, _ := domain.NewTask(domain.TaskInput{
+		ID:        "t-root",
+		ProjectID: p.ID,
+		ColumnID:  c1.ID,
+		Title:     "Root task",
+		Priority:  domain.PriorityMedium,
+	}, now)
+	// The orphan's parent was hard-deleted, so it never renders under any board row.
+	orphan, _ := domain.NewTask(domain.TaskInput{
+		ID:        "t-orphan",
+		ProjectID: p.ID,
+		ParentID:  "t-deleted",
+		Kind:      domain.WorkKindSubtask,
+		ColumnID:  c1.ID,
+		Position:  1,
+		Title:     "Stranded subtask",
+		Priority:  domain.PriorityMedium,
+	}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c1}, []domain.Task{root, orphan})
+
+	m := loadReadyModel(t, NewModel(svc))
+	if len(m.orphanedTasks) != 1 || m.orphanedTasks[0].ID != orphan.ID {
+		t.Fatalf("expected one orphaned task, got %#v", m.orphanedTasks)
+	}
+	if got := strings.Join(m.warnings, " | "); !strings.Contains(got, "1 orphaned subtasks reference a missing parent") {
+		t.Fatalf("expected orphan warning summary, got %q", got)
+	}
+	found := false
+	for _, item := range m.noticesWarningPanelItems() {
+		if item.Label == "orphaned: Stranded subtask" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected orphan row in warnings panel, got %#v", m.noticesWarningPanelItems())
+	}
+}
+
+// TestModelLazyColumnPagesLoadOnScroll verifies paged columns load more rows as selection reaches the loaded end.
+func TestModelLazyColumnPagesLoadOnScroll(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c1, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	tasks := make([]domain.Task, 0, 5)
+	for idx := range 5 {
+		task, _ := domain.NewTask(domain.TaskInput{
+			ID:        fmt.Sprintf("t%d", idx+1),
+			ProjectID: p.ID,
+			ColumnID:  c1.ID,
+			Position:  idx,
+			Title:     fmt.Sprintf("Task %d", idx+1),
+			Priority:  domain.PriorityMedium,
+		}, now)
+		tasks = append(tasks, task)
+	}
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c1}, tasks)
+	m := loadReadyModel(t, NewModel(svc, WithBoardConfig(BoardConfig{ColumnPageSize: 2})))
+
+	if len(m.tasks) != 2 || !m.columnHasMore[c1.ID] {
+		t.Fatalf("expected first page of 2 tasks with more pending, got %d tasks more=%v", len(m.tasks), m.columnHasMore[c1.ID])
+	}
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyDown})
+	if len(m.tasks) != 4 {
+		t.Fatalf("expected second page after reaching loaded end, got %d tasks", len(m.tasks))
+	}
+	if m.selectedTask != 1 {
+		t.Fatalf("expected selection to stay on the moved-to row, got %d", m.selectedTask)
+	}
+
+	// Reloads keep scrolled depth, and pending focus targets page in on demand.
+	m.pendingFocusTaskID = "t5"
+	loaded, ok := m.loadData().(loadedMsg)
+	if !ok {
+		t.Fatal("expected loadedMsg")
+	}
+	if len(loaded.tasks) != 5 || loaded.columnHasMore[c1.ID] {
+		t.Fatalf("expected reload to page through to focus target, got %d tasks more=%v", len(loaded.tasks), loaded.columnHasMore[c1.ID])
+	}
+}
+
+// TestModelLazyColumnPagesSkipHiddenSubtasks verifies hidden subtasks take no page slots and headers count unloaded rows.
+func TestModelLazyColumnPagesSkipHiddenSubtasks(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c1, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 2, now)
+	inputs := []domain.TaskInput{
+		{ID: "s1", ParentID: "t1", Kind: domain.WorkKindSubtask, Title: "Step 1"},
+		{ID: "s2", ParentID: "t1", Kind: domain.WorkKindSubtask, Title: "Step 2"},
+		{ID: "t1", Title: "Task 1"},
+		{ID: "t2", Title: "Task 2"},
+		{ID: "t3", Title: "Task 3"},
+	}
+	tasks := make([]domain.Task, 0, len(inputs))
+	for idx, in := range inputs {
+		in.ProjectID = p.ID
+		in.ColumnID = c1.ID
+		in.Position = idx
+		in.Priority = domain.PriorityMedium
+		task, _ := domain.NewTask(in, now)
+		tasks = append(tasks, task)
+	}
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c1}, tasks)
+	m := loadReadyModel(t, NewModel(svc, WithBoardConfig(BoardConfig{ColumnPageSize: 1})))
+
+	rows := m.boardTasksForColumn(c1.ID)
+	if len(rows) != 1 || rows[0].ID != "t1" || !m.columnHasMore[c1.ID] {
+		t.Fatalf("expected first page to show t1 with more pending, got %#v more=%v", rows, m.columnHasMore[c1.ID])
+	}
+	if done, total := m.subtaskProgress("t1"); done != 0 || total != 2 {
+		t.Fatalf("expected t1 subtasks to load with their parent, got %d/%d", done, total)
+	}
+	if total, active := m.columnHeaderCounts(c1.ID, rows); total != 3 || active != 3 {
+		t.Fatalf("expected header to count all 3 board rows, got %d/%d", total, active)
+	}
+	if got := stripANSI(fmt.Sprint(m.View().Content)); !strings.Contains(got, "To Do (3/2)") {
+		t.Fatalf("expected WIP header from the full column count, got\n%s", got)
+	}
+}
+
+// TestResolveThemeRoles verifies the resolved theme falls back to the built-in highlight and lists label glyphs last.
+func TestResolveThemeRoles(t *testing.T) {
+	theme := ResolveTheme("", map[string]string{" UX ": "33", "bug": "#ff8800"})
+	if theme.Highlight != defaultHighlightColor {
+		t.Fatalf("highlight = %q, want the built-in %q", theme.Highlight, defaultHighlightColor)
+	}
+	roles := theme.Roles()
+	got := roles[len(roles)-2:]
+	want := []ThemeRole{{Name: "label glyph: bug", Color: "#ff8800"}, {Name: "label glyph: ux", Color: "33"}}
+	if !slices.Equal(got, want) {
+		t.Fatalf("label roles = %#v, want %#v", got, want)
+	}
+	if theme := ResolveTheme(" 33 ", nil); theme.Highlight != "33" {
+		t.Fatalf("highlight = %q, want 33", theme.Highlight)
+	}
+}
+
+// TestRenderThemePreviewUsesBoardView verifies the preview is the board's own View styled by the configured theme.
+func TestRenderThemePreviewUsesBoardView(t *testing.T) {
+	cfg := RuntimeConfig{
+		Board:  BoardConfig{ShowWIPWarnings: true},
+		UI:     UIConfig{HighlightColor: "33"},
+		Labels: LabelConfig{Colors: map[string]string{"Bug": "#ff8800"}},
+	}
+	board, modal := RenderThemePreview(cfg, 96, 24)
+	plain := stripANSI(board)
+	for _, want := range []string{"To Do (3)", "In Progress (3/2)", "WIP limit exceeded: 3/2", "Draft release notes", "Old spike notes"} {
+		if !strings.Contains(plain, want) {
+			t.Fatalf("expected %q in preview board:\n%s", want, plain)
+		}
+	}
+	// 38;5;33 is the configured highlight, 38;2;255;136;0 the bug label glyph, and 38;5;62 the theme accent.
+	for _, want := range []string{"38;5;33", "38;2;255;136;0", "38;5;" + builtinTheme.Accent} {
+		if !strings.Contains(board, want) {
+			t.Fatalf("expected %q in styled preview board", want)
+		}
+	}
+	if !strings.Contains(stripANSI(modal), "archive task: Draft release notes") {
+		t.Fatalf("expected archive confirm modal, got:\n%s", stripANSI(modal))
+	}
+}
+
+// TestModelReadOnlyBlocksMutations verifies read-only mode keeps browsing keys working while refusing board mutations.
+func TestModelReadOnlyBlocksMutations(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Alpha", "", now)
+	c1, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	c2, _ := domain.NewColumn("c2", p.ID, "Done", 1, 0, now)
+	task, _ := domain.NewTask(domain.TaskInput{
+		ID:        "t1",
+		ProjectID: p.ID,
+		ColumnID:  c1.ID,
+		Title:     "Look only",
+		Priority:  domain.PriorityMedium,
+	}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c1, c2}, []domain.Task{task})
+	m := loadReadyModel(t, NewModel(svc, WithReloadDebounce(0), WithReadOnly(true)))
+
+	if !strings.Contains(stripANSI(fmt.Sprint(m.View().Content)), readOnlyIndicatorText) {
+		t.Fatal("expected read-only indicator in the header")
+	}
+	cases := []struct {
+		key    rune
+		status string
+	}{
+		{key: 'n', status: readOnlyStatus("task form")},
+		{key: 'e', status: readOnlyStatus("task form")},
+		{key: ']', status: readOnlyStatus("move task")},
+		{key: 'd', status: readOnlyStatus("delete task")},
+		{key: 'N', status: readOnlyStatus("project form")},
+	}
+	for _, tc := range cases {
+		m = applyMsg(t, m, keyRune(tc.key))
+		if m.mode != modeNone {
+			t.Fatalf("key %q: expected board mode, got %v", tc.key, m.mode)
+		}
+		if m.status != tc.status {
+			t.Fatalf("key %q: expected status %q, got %q", tc.key, tc.status, m.status)
+		}
+	}
+	if got := svc.tasks[p.ID][0].ColumnID; got != c1.ID {
+		t.Fatalf("expected task to stay in %q, got %q", c1.ID, got)
+	}
+
+	// Task info stays readable, but its edit shortcuts are refused too.
+	m = applyMsg(t, m, keyRune('i'))
+	if m.mode != modeTaskInfo {
+		t.Fatalf("expected task info mode, got %v", m.mode)
+	}
+	m = applyMsg(t, m, keyRune('t'))
+	if m.status != readOnlyStatus("lifecycle state") {
+		t.Fatalf("expected lifecycle change refused, got %q", m.status)
+	}
+}
+
+// TestModelTaskInfoExportsTaskCard verifies the task-info export modal requests the chosen card format and scope.
+func TestModelTaskInfoExportsTaskCard(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Alpha", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	task, _ := domain.NewTask(domain.TaskInput{
+		ID:        "t1",
+		ProjectID: p.ID,
+		ColumnID:  c.ID,
+		Title:     "Share me",
+		Priority:  domain.PriorityMedium,
+	}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c}, []domain.Task{task})
+	m := loadReadyModel(t, NewModel(svc, WithReloadDebounce(0)))
+
+	m = applyMsg(t, m, keyRune('i'))
+	m = applyMsg(t, m, keyRune('x'))
+	if m.mode != modeExportTask {
+		t.Fatalf("expected export modal, got %v", m.mode)
+	}
+	if rendered := stripANSI(fmt.Sprint(m.View().Content)); !strings.Contains(rendered, "Export Task Card") || !strings.Contains(rendered, "format:   markdown") {
+		t.Fatalf("expected export modal with markdown default, got %q", rendered)
+	}
+	m = applyMsg(t, m, keyRune('f'))
+	m = applyMsg(t, m, keyRune('s'))
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.mode != modeTaskInfo {
+		t.Fatalf("expected export to return to task info, got %v", m.mode)
+	}
+	want := app.ExportTaskCardInput{TaskID: task.ID, IncludeSubtasks: true, Format: app.TaskCardFormatJSON}
+	if svc.lastTaskCardExport != want {
+		t.Fatalf("expected export request %#v, got %#v", want, svc.lastTaskCardExport)
+	}
+	// Headless test runs may lack a clipboard, so either outcome must be reported in the status.
+	if !strings.HasPrefix(m.status, "copied task card (json)") && !strings.HasPrefix(m.status, "copy task card failed") {
+		t.Fatalf("expected copy status, got %q", m.status)
+	}
+}
+
+// TestModelTaskInfoCopiesIDAndPath verifies y and Y in task info copy the task ID and its hierarchy path.
+func TestModelTaskInfoCopiesIDAndPath(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Alpha", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	parent, _ := domain.NewTask(domain.TaskInput{
+		ID:        "t-parent",
+		ProjectID: p.ID,
+		ColumnID:  c.ID,
+		Title:     "Auth",
+		Priority:  domain.PriorityMedium,
+	}, now)
+	child, _ := domain.NewTask(domain.TaskInput{
+		ID:        "t-child",
+		ProjectID: p.ID,
+		ColumnID:  c.ID,
+		Title:     "Fix login",
+		Priority:  domain.PriorityMedium,
+		Kind:      domain.WorkKindSubtask,
+		ParentID:  parent.ID,
+	}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c}, []domain.Task{parent, child})
+	m := loadReadyModel(t, NewModel(svc))
+
+	if got, want := m.taskHierarchyPath(child), "Alpha | task:Auth | subtask:Fix login"; got != want {
+		t.Fatalf("expected hierarchy path %q, got %q", want, got)
+	}
+	m = applyMsg(t, m, keyRune('i'))
+	if m.mode != modeTaskInfo {
+		t.Fatalf("expected task info, got %v", m.mode)
+	}
+	// Headless test runs may lack a clipboard, so either outcome must be reported in the status.
+	m = applyMsg(t, m, keyRune('y'))
+	if !strings.HasPrefix(m.status, "copied task id: t-parent") && !strings.HasPrefix(m.status, "copy task id failed") {
+		t.Fatalf("expected copy id status, got %q", m.status)
+	}
+	m = applyMsg(t, m, keyRune('Y'))
+	if !strings.HasPrefix(m.status, "copied task path: Alpha | task:Auth") && !strings.HasPrefix(m.status, "copy task path failed") {
+		t.Fatalf("expected copy path status, got %q", m.status)
+	}
+	if m.mode != modeTaskInfo {
+		t.Fatalf("expected copying to keep task info open, got %v", m.mode)
+	}
+}
+
+// TestModelDuplicateTitleWarning verifies similar titles pause creation until the user proceeds or returns to the form.
+func TestModelDuplicateTitleWarning(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Alpha", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	existing, _ := domain.NewTask(domain.TaskInput{ID: "t1", ProjectID: p.ID, ColumnID: c.ID, Title: "Fix login redirect", Priority: domain.PriorityMedium, Position: 0}, now)
+	archived, _ := domain.NewTask(domain.TaskInput{ID: "t2", ProjectID: p.ID, ColumnID: c.ID, Title: "Write release notes", Priority: domain.PriorityMedium, Position: 1}, now)
+	archived.Archive(now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c}, []domain.Task{existing, archived})
+	m := loadReadyModel(t, NewModel(svc, WithReloadDebounce(0), WithBoardConfig(BoardConfig{WarnDuplicateTitles: true})))
+	submitTitle := func(m Model, title string) Model {
+		t.Helper()
+		m = applyMsg(t, m, keyRune('n'))
+		for _, r := range title {
+			m = applyMsg(t, m, keyRune(r))
+		}
+		return applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	}
+
+	// A one-letter typo of an existing title still counts as similar.
+	m = submitTitle(m, "Fix logn redirect")
+	if m.mode != modeDuplicateTitle {
+		t.Fatalf("expected duplicate warning, got mode %v", m.mode)
+	}
+	if rendered := stripANSI(fmt.Sprint(m.View().Content)); !strings.Contains(rendered, "Similar Task Exists") || !strings.Contains(rendered, "Fix login redirect (To Do)") {
+		t.Fatalf("expected similar task listed in overlay, got %q", rendered)
+	}
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEscape})
+	if m.mode != modeAddTask || m.formInputs[taskFieldTitle].Value() != "Fix logn redirect" {
+		t.Fatalf("expected esc to return to the filled form, got mode %v", m.mode)
+	}
+	if len(svc.tasks[p.ID]) != 2 {
+		t.Fatalf("expected cancel to skip creation, got %d tasks", len(svc.tasks[p.ID]))
+	}
+
+	// Proceeding creates the task without asking again.
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.mode != modeDuplicateTitle {
+		t.Fatalf("expected warning on resubmit, got mode %v", m.mode)
+	}
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.mode != modeNone || len(svc.tasks[p.ID]) != 3 {
+		t.Fatalf("expected confirmed create, got mode %v with %d tasks", m.mode, len(svc.tasks[p.ID]))
+	}
+
+	// Archived tasks and unrelated titles do not trigger the warning.
+	m = submitTitle(m, "Write release notes")
+	if m.mode != modeNone || len(svc.tasks[p.ID]) != 4 {
+		t.Fatalf("expected archived match to be ignored, got mode %v with %d tasks", m.mode, len(svc.tasks[p.ID]))
+	}
+
+	// Turning the setting off creates matching titles directly.
+	m.warnDuplicateTitles = false
+	m = submitTitle(m, "Fix login redirect")
+	if m.mode != modeNone || len(svc.tasks[p.ID]) != 5 {
+		t.Fatalf("expected disabled warning to create directly, got mode %v with %d tasks", m.mode, len(svc.tasks[p.ID]))
+	}
+}
+
+// TestModelProjectSortOrdersPickerAndTabs verifies each configured project sort and that reordering keeps the active project.
+func TestModelProjectSortOrdersPickerAndTabs(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	zeta, _ := domain.NewProject("p1", "Zeta", "", now)
+	alpha, _ := domain.NewProject("p2", "alpha", "", now)
+	mid, _ := domain.NewProject("p3", "Mid", "", now)
+	var columns []domain.Column
+	var tasks []domain.Task
+	for idx, project := range []domain.Project{zeta, alpha, mid} {
+		column, _ := domain.NewColumn(fmt.Sprintf("c%d", idx), project.ID, "To Do", 0, 0, now)
+		columns = append(columns, column)
+	}
+	// Mid holds the most tasks, then Zeta; alpha has none.
+	for idx, projectIdx := range []int{2, 2, 0} {
+		column := columns[projectIdx]
+		task, _ := domain.NewTask(domain.TaskInput{ID: fmt.Sprintf("t%d", idx), ProjectID: column.ProjectID, ColumnID: column.ID, Title: fmt.Sprintf("Task %d", idx), Priority: domain.PriorityMedium, Position: idx}, now)
+		tasks = append(tasks, task)
+	}
+	svc := newFakeService([]domain.Project{zeta, alpha, mid}, columns, tasks)
+	svc.changeEvents[alpha.ID] = []domain.ChangeEvent{{ProjectID: alpha.ID, Operation: domain.ChangeOperationUpdate, OccurredAt: now.Add(time.Hour)}}
+	order := func(m Model) []string {
+		names := make([]string, 0, len(m.projects))
+		for _, project := range m.projects {
+			names = append(names, project.Name)
+		}
+		return names
+	}
+	resort := func(m Model, cfg ProjectsConfig) Model {
+		t.Helper()
+		WithProjectsConfig(cfg)(&m)
+		return applyMsg(t, m, m.loadData())
+	}
+
+	m := loadReadyModel(t, NewModel(svc, WithReloadDebounce(0), WithProjectsConfig(ProjectsConfig{Sort: "Alphabetical"})))
+	if got := order(m); !slices.Equal(got, []string{"alpha", "Mid", "Zeta"}) {
+		t.Fatalf("expected alphabetical order, got %v", got)
+	}
+	tabs := stripANSI(m.renderProjectTabs(lipgloss.Color("62"), lipgloss.Color("239")))
+	if !(strings.Index(tabs, "alpha") < strings.Index(tabs, "Mid") && strings.Index(tabs, "Mid") < strings.Index(tabs, "Zeta")) {
+		t.Fatalf("expected tabs in picker order, got %q", tabs)
+	}
+
+	// Re-sorting moves Zeta, but the active project follows it by id.
+	m.selectedProject = 2
+	activityCalls := svc.projectActivityCalls
+	m = resort(m, ProjectsConfig{Sort: "task_count"})
+	if got := order(m); !slices.Equal(got, []string{"Mid", "Zeta", "alpha"}) {
+		t.Fatalf("expected task-count order, got %v", got)
+	}
+	// Every project's count comes from one aggregate lookup per load.
+	if calls := svc.projectActivityCalls - activityCalls; calls != 1 {
+		t.Fatalf("expected one activity lookup per load, got %d", calls)
+	}
+	if m.selectedProject != 1 {
+		t.Fatalf("expected Zeta to stay active at index 1, got %d", m.selectedProject)
+	}
+
+	// Projects without recorded changes follow the changed ones by name.
+	m = resort(m, ProjectsConfig{Sort: "recent"})
+	if got := order(m); !slices.Equal(got, []string{"alpha", "Mid", "Zeta"}) {
+		t.Fatalf("expected the most recently changed project first, got %v", got)
+	}
+
+	m = resort(m, ProjectsConfig{Sort: "pinned", Pinned: []string{" ZETA ", "missing"}})
+	if got := order(m); !slices.Equal(got, []string{"Zeta", "alpha", "Mid"}) {
+		t.Fatalf("expected pinned project first then alphabetical, got %v", got)
+	}
+
+	m = resort(m, ProjectsConfig{Sort: "bogus"})
+	if got := order(m); !slices.Equal(got, []string{"Zeta", "alpha", "Mid"}) {
+		t.Fatalf("expected unknown sort to keep load order, got %v", got)
+	}
+}
+
+// TestModelTaskReminders verifies the reminders form field, its config default, and notices rows for fired reminders.
+func TestModelTaskReminders(t *testing.T) {
+	now := time.Now().UTC()
+	p, _ := domain.NewProject("p1", "Alpha", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	soon := now.Add(12 * time.Hour)
+	later := now.Add(5 * 24 * time.Hour)
+	// Only the first task's one-day reminder has fired; the second fires in four days, the third is already done.
+	fired, _ := domain.NewTask(domain.TaskInput{ID: "t1", ProjectID: p.ID, ColumnID: c.ID, Title: "Prep demo", Priority: domain.PriorityMedium, DueAt: &soon, Metadata: domain.TaskMetadata{Reminders: []string{"1d"}}}, now)
+	pending, _ := domain.NewTask(domain.TaskInput{ID: "t2", ProjectID: p.ID, ColumnID: c.ID, Title: "File taxes", Priority: domain.PriorityMedium, Position: 1, DueAt: &later, Metadata: domain.TaskMetadata{Reminders: []string{"1d"}}}, now)
+	done, _ := domain.NewTask(domain.TaskInput{ID: "t3", ProjectID: p.ID, ColumnID: c.ID, Title: "Book venue", Priority: domain.PriorityMedium, Position: 2, DueAt: &soon, LifecycleState: domain.StateDone, Metadata: domain.TaskMetadata{Reminders: []string{"1d"}}}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c}, []domain.Task{fired, pending, done})
+	m := loadReadyModel(t, NewModel(svc, WithReloadDebounce(0), WithUIConfig(UIConfig{DefaultReminders: []string{"2h"}})))
+
+	reminderRows := []noticesPanelItem{}
+	for _, item := range m.noticesWarningPanelItems() {
+		if strings.HasPrefix(item.Label, "reminder:") {
+			reminderRows = append(reminderRows, item)
+		}
+	}
+	if len(reminderRows) != 1 || reminderRows[0].TaskID != fired.ID || !strings.Contains(reminderRows[0].Label, "Prep demo") {
+		t.Fatalf("expected one reminder row for the fired task, got %#v", reminderRows)
+	}
+
+	m = applyMsg(t, m, keyRune('n'))
+	if got := m.formInputs[taskFieldReminders].Value(); got != "2h" {
+		t.Fatalf("expected configured default reminder prefilled, got %q", got)
+	}
+	for _, r := range "Launch" {
+		m = applyMsg(t, m, keyRune(r))
+	}
+	m.formInputs[taskFieldReminders].SetValue("soon")
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.mode != modeAddTask || !strings.Contains(m.status, "invalid reminder offset") {
+		t.Fatalf("expected invalid reminder to keep the form open, got mode %v status %q", m.mode, m.status)
+	}
+
+	// The field takes typed text directly, like the title.
+	m.formInputs[taskFieldReminders].SetValue("")
+	_ = m.focusTaskFormField(taskFieldReminders)
+	for _, r := range "1w, 48h" {
+		m = applyMsg(t, m, keyRune(r))
+	}
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.mode != modeNone {
+		t.Fatalf("expected task created, got mode %v status %q", m.mode, m.status)
+	}
+	if got := svc.lastCreateTask.Metadata.Reminders; !slices.Equal(got, []string{"1w", "2d"}) {
+		t.Fatalf("expected reminders [1w 2d] on create, got %#v", got)
+	}
+
+	// Editing shows the stored reminders, and "-" clears them.
+	m = applyMsg(t, m, m.loadData())
+	task, _ := m.taskByID(fired.ID)
+	_ = m.startTaskForm(&task)
+	if got := m.formInputs[taskFieldReminders].Value(); got != "1d" {
+		t.Fatalf("expected stored reminders in edit form, got %q", got)
+	}
+	m.formInputs[taskFieldReminders].SetValue("-")
+	updated, cmd := m.submitInputMode()
+	m = applyResult(t, updated, cmd)
+	if updated, _ := m.taskByID(fired.ID); len(updated.Metadata.Reminders) != 0 {
+		t.Fatalf("expected reminders cleared, got %#v", updated.Metadata.Reminders)
+	}
+}
+
+// TestModelBlockedTasksMarkedAndCounted verifies blocked cards get a marker, the footer counts them, and dim_blocked fades them.
+func TestModelBlockedTasksMarkedAndCounted(t *testing.T) {
+	now := time.Date(2026, 3, 3, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	todo, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	done, _ := domain.NewColumn("c2", p.ID, "Done", 1, 0, now)
+	newTask := func(id, columnID, title string, position int, state domain.LifecycleState, meta domain.TaskMetadata) domain.Task {
+		task, _ := domain.NewTask(domain.TaskInput{
+			ID:             id,
+			ProjectID:      p.ID,
+			ColumnID:       columnID,
+			Position:       position,
+			Title:          title,
+			Priority:       domain.PriorityMedium,
+			Kind:           domain.WorkKindTask,
+			LifecycleState: state,
+			Metadata:       meta,
+		}, now)
+		return task
+	}
+	tasks := []domain.Task{
+		newTask("t1", todo.ID, "Free", 0, domain.StateTodo, domain.TaskMetadata{}),
+		newTask("t2", todo.ID, "Waits", 1, domain.StateTodo, domain.TaskMetadata{BlockedBy: []string{"t1"}}),
+		newTask("t3", todo.ID, "Stuck", 2, domain.StateTodo, domain.TaskMetadata{BlockedReason: "vendor outage"}),
+		newTask("t4", done.ID, "Shipped", 0, domain.StateDone, domain.TaskMetadata{BlockedBy: []string{"t1"}}),
+	}
+	svc := newFakeService([]domain.Project{p}, []domain.Column{todo, done}, tasks)
+	// The blocked count is an opt-in footer segment.
+	if rendered := stripANSI(fmt.Sprint(loadReadyModel(t, NewModel(svc)).View().Content)); strings.Contains(rendered, "⊘ blocked:") {
+		t.Fatalf("expected no blocked count in the default footer, got\n%s", rendered)
+	}
+	m := loadReadyModel(t, NewModel(svc, WithUIConfig(UIConfig{StatusSegments: []StatusSegment{StatusSegmentBlocked, StatusSegmentStatus}})))
+
+	raw := fmt.Sprint(m.View().Content)
+	rendered := stripANSI(raw)
+	// Blocked tasks also raise attention items, so the marker follows the !N count.
+	for _, want := range []string{"Waits !1 ⊘", "Stuck !1 ⊘", "⊘ blocked: 2"} {
+		if !strings.Contains(rendered, want) {
+			t.Fatalf("expected %q on the board, got\n%s", want, rendered)
+		}
+	}
+	// Free tasks and done tasks with stale blockers carry no marker.
+	if strings.Contains(rendered, "Free ⊘") || strings.Contains(rendered, "Shipped ⊘") {
+		t.Fatalf("expected only open blocked tasks marked, got\n%s", rendered)
+	}
+	if strings.Contains(raw, blockedDimStyle.Render("   Stuck !1")) {
+		t.Fatal("expected blocked cards undimmed without board.dim_blocked")
+	}
+
+	WithBoardConfig(BoardConfig{DimBlocked: true, TitleMaxLines: 1})(&m)
+	raw = fmt.Sprint(m.View().Content)
+	if !strings.Contains(raw, blockedDimStyle.Render("   Stuck !1")) {
+		t.Fatalf("expected unselected blocked card dimmed with board.dim_blocked, got\n%q", raw)
+	}
+}
+
+// TestModelDependencyBadges verifies blocked-by and blocks badges render on cards and skip done and archived tasks.
+func TestModelDependencyBadges(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Alpha", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	newTask := func(id, title string, position int, state domain.LifecycleState, meta domain.TaskMetadata) domain.Task {
+		task, _ := domain.NewTask(domain.TaskInput{ID: id, ProjectID: p.ID, ColumnID: c.ID, Title: title, Priority: domain.PriorityMedium, Position: position, LifecycleState: state, Metadata: meta}, now)
+		return task
+	}
+	// Base blocks Api and Cli; Api also waits on the already-done Spec, which does not count.
+	base := newTask("t-base", "Base", 0, domain.StateTodo, domain.TaskMetadata{})
+	spec := newTask("t-spec", "Spec", 1, domain.StateDone, domain.TaskMetadata{})
+	api := newTask("t-api", "Api", 2, domain.StateTodo, domain.TaskMetadata{DependsOn: []string{base.ID, spec.ID}})
+	cli := newTask("t-cli", "Cli", 3, domain.StateProgress, domain.TaskMetadata{BlockedBy: []string{base.ID}})
+	// Finished and archived dependents neither show badges nor count toward Base.
+	docs := newTask("t-docs", "Docs", 4, domain.StateDone, domain.TaskMetadata{DependsOn: []string{base.ID}})
+	old := newTask("t-old", "Old", 5, domain.StateTodo, domain.TaskMetadata{DependsOn: []string{base.ID}})
+	old.Archive(now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c}, []domain.Task{base, spec, api, cli, docs, old})
+	m := loadReadyModel(t, NewModel(svc))
+	byID := m.tasksByID()
+	// Badges are opt-in.
+	if got := m.taskDependencyBadges(byID[base.ID]); got != "" {
+		t.Fatalf("expected no badges by default, got %q", got)
+	}
+
+	m = loadReadyModel(t, NewModel(svc, WithBoardConfig(BoardConfig{DependencyBadges: []DependencyBadge{DependencyBadgeBlockedBy, DependencyBadgeBlocks}})))
+	want := map[string]string{
+		base.ID: " →2",
+		api.ID:  " ⛔1",
+		cli.ID:  " ⛔1",
+		spec.ID: "",
+		docs.ID: "",
+		old.ID:  "",
+	}
+	for id, badge := range want {
+		if got := m.taskDependencyBadges(byID[id]); got != badge {
+			t.Fatalf("badges for %s = %q, want %q", id, got, badge)
+		}
+	}
+	rendered := stripANSI(fmt.Sprint(m.View().Content))
+	for _, row := range []string{"Base →2", "Api !1 ⛔1"} {
+		if !strings.Contains(rendered, row) {
+			t.Fatalf("expected card row %q, got\n%s", row, rendered)
+		}
+	}
+
+	// Configuring a subset keeps only the named badges; an empty list hides them all.
+	WithBoardConfig(BoardConfig{DependencyBadges: []DependencyBadge{"BLOCKS", "unknown"}})(&m)
+	if got := m.taskDependencyBadges(byID[api.ID]); got != "" {
+		t.Fatalf("expected blocked_by badge hidden, got %q", got)
+	}
+	if got := m.taskDependencyBadges(byID[base.ID]); got != " →2" {
+		t.Fatalf("expected blocks badge kept, got %q", got)
+	}
+	WithBoardConfig(BoardConfig{DependencyBadges: []DependencyBadge{}})(&m)
+	if got := m.taskDependencyBadges(byID[base.ID]); got != "" {
+		t.Fatalf("expected badges hidden, got %q", got)
+	}
+}
+
+// TestModelStartupDueDigest verifies overdue and due-today tasks from every project are listed once at launch.
+func TestModelStartupDueDigest(t *testing.T) {
+	now := time.Date(2026, 3, 3, 12, 0, 0, 0, time.Local)
+	p1, _ := domain.NewProject("p1", "Alpha", "", now)
+	p2, _ := domain.NewProject("p2", "Beta", "", now)
+	c1, _ := domain.NewColumn("c1", p1.ID, "To Do", 0, 0, now)
+	c2, _ := domain.NewColumn("c2", p2.ID, "To Do", 0, 0, now)
+	dueTask := func(id, projectID, columnID, title string, due time.Time, state domain.LifecycleState) domain.Task {
+		task, _ := domain.NewTask(domain.TaskInput{
+			ID:             id,
+			ProjectID:      projectID,
+			ColumnID:       columnID,
+			Kind:           domain.WorkKindTask,
+			Title:          title,
+			Priority:       domain.PriorityMedium,
+			DueAt:          &due,
+			LifecycleState: state,
+		}, now)
+		return task
+	}
+	tasks := []domain.Task{
+		dueTask("t1", p1.ID, c1.ID, "later today", now.Add(6*time.Hour), domain.StateTodo),
+		dueTask("t2", p2.ID, c2.ID, "late report", now.Add(-48*time.Hour), domain.StateProgress),
+		dueTask("t3", p1.ID, c1.ID, "tomorrow", now.Add(13*time.Hour), domain.StateTodo),
+		dueTask("t4", p2.ID, c2.ID, "already shipped", now.Add(-time.Hour), domain.StateDone),
+	}
+	svc := newFakeService([]domain.Project{p1, p2}, []domain.Column{c1, c2}, tasks)
+
+	// Tasks due after local midnight and finished tasks stay out; the rest sort by due time.
+	summary, err := loadDueDigest(context.Background(), svc, now)
+	if err != nil {
+		t.Fatalf("loadDueDigest() error = %v", err)
+	}
+	if summary.Overdue != 1 || summary.DueToday != 1 || len(summary.Items) != 2 {
+		t.Fatalf("unexpected digest counts %#v", summary)
+	}
+	if first := summary.Items[0]; first.Task.ID != "t2" || first.ProjectName != "Beta" || !first.Overdue {
+		t.Fatalf("expected overdue Beta task first, got %#v", first)
+	}
+	if second := summary.Items[1]; second.Task.ID != "t1" || second.Overdue {
+		t.Fatalf("expected due-today Alpha task second, got %#v", second)
+	}
+
+	// The digest is off by default.
+	m := loadReadyModel(t, NewModel(svc, WithReloadDebounce(0)))
+	if m.mode == modeDueDigest {
+		t.Fatal("expected no due digest without [ui].startup_due_digest")
+	}
+
+	m = loadReadyModel(t, NewModel(svc, WithReloadDebounce(0), WithUIConfig(UIConfig{StartupDueDigest: true})))
+	m = applyCmd(t, m, m.startupDueDigestCmd())
+	if m.mode != modeDueDigest {
+		t.Fatalf("expected due digest after the first board load, got mode %v", m.mode)
+	}
+	rendered := stripANSI(fmt.Sprint(m.View().Content))
+	for _, want := range []string{"Due Today", "Beta", "late report", "press any key to continue"} {
+		if !strings.Contains(rendered, want) {
+			t.Fatalf("expected %q in due digest overlay, got %q", want, rendered)
+		}
+	}
+	m = applyMsg(t, m, keyRune('x'))
+	if m.mode != modeNone || len(m.dueDigest.Items) != 0 {
+		t.Fatalf("expected any key to dismiss the digest, got mode %v", m.mode)
+	}
+
+	// Later board loads in the same session stay quiet.
+	m = applyCmd(t, m, m.loadData)
+	if cmd := m.startupDueDigestCmd(); cmd != nil || m.mode != modeNone {
+		t.Fatalf("expected the digest only once per session, got mode %v", m.mode)
+	}
+}
+
+// TestModelCatchUpSummary verifies other actors' changes since the last visit are summarized on launch and refocus.
+func TestModelCatchUpSummary(t *testing.T) {
+	now := time.Now().UTC()
+	p, _ := domain.NewProject("p1", "Alpha", "", now)
+	todo, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	done, _ := domain.NewColumn("c2", p.ID, "Done", 1, 0, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{todo, done}, nil)
+	agentEvent := func(id int64, op domain.ChangeOperation, metadata map[string]string, at time.Time) domain.ChangeEvent {
+		return domain.ChangeEvent{ID: id, ProjectID: p.ID, WorkItemID: "t1", Operation: op, ActorID: "agent-1", ActorName: "Builder bot", ActorType: domain.ActorTypeAgent, Metadata: metadata, OccurredAt: at}
+	}
+	// Events are newest-first, matching the repository order.
+	svc.changeEvents[p.ID] = []domain.ChangeEvent{
+		{ID: 4, ProjectID: p.ID, WorkItemID: "t2", Operation: domain.ChangeOperationCreate, ActorID: "tillsyn-user", Metadata: map[string]string{"title": "Mine"}, OccurredAt: now.Add(-20 * time.Minute)},
+		agentEvent(3, domain.ChangeOperationMove, map[string]string{"title": "Ship it", "to_column_id": done.ID}, now.Add(-30*time.Minute)),
+		agentEvent(2, domain.ChangeOperationCreate, map[string]string{"title": "Ship it"}, now.Add(-time.Hour)),
+		agentEvent(1, domain.ChangeOperationCreate, map[string]string{"title": "Old news"}, now.Add(-3*time.Hour)),
+	}
+	path := filepath.Join(t.TempDir(), "last_seen.json")
+	if err := writeLastSeen(path, p.ID, now.Add(-2*time.Hour)); err != nil {
+		t.Fatalf("writeLastSeen() error = %v", err)
+	}
+	// Timestamps never move backwards.
+	if err := writeLastSeen(path, p.ID, now.Add(-5*time.Hour)); err != nil {
+		t.Fatalf("writeLastSeen() error = %v", err)
+	}
+	if seen, err := readLastSeen(path); err != nil || !seen[p.ID].Equal(now.Add(-2*time.Hour)) {
+		t.Fatalf("expected stored last-seen time to stay, got %v (err %v)", seen, err)
+	}
+
+	m := loadReadyModel(t, NewModel(svc, WithReloadDebounce(0), WithLastSeenPath(path)))
+	if m.mode != modeCatchUp {
+		t.Fatalf("expected catch-up summary after the launch picker, got mode %v", m.mode)
+	}
+	// Only the agent's two changes after the last visit count; the user's own create is skipped.
+	if m.catchUp.Created != 1 || m.catchUp.Completed != 1 || m.catchUp.Moved != 0 || len(m.catchUp.Entries) != 2 {
+		t.Fatalf("unexpected catch-up summary %#v", m.catchUp)
+	}
+	rendered := stripANSI(fmt.Sprint(m.View().Content))
+	for _, want := range []string{"While You Were Away", "1 created • 1 completed", "by Builder bot", "Ship it"} {
+		if !strings.Contains(rendered, want) {
+			t.Fatalf("expected %q in catch-up overlay, got %q", want, rendered)
+		}
+	}
+	if strings.Contains(rendered, "Old news") || strings.Contains(rendered, "Mine") {
+		t.Fatalf("expected seen and own changes left out, got %q", rendered)
+	}
+	// The stored time only advances once the summary is acknowledged.
+	if seen, err := readLastSeen(path); err != nil || !seen[p.ID].Equal(now.Add(-2*time.Hour)) {
+		t.Fatalf("expected stored last-seen time untouched while the summary is open, got %v (err %v)", seen, err)
+	}
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEscape})
+	if m.mode != modeNone {
+		t.Fatalf("expected esc to dismiss the summary, got mode %v", m.mode)
+	}
+	if !m.lastSeen[p.ID].After(now) {
+		t.Fatalf("expected the visit to advance last seen, got %v", m.lastSeen[p.ID])
+	}
+	if seen, err := readLastSeen(path); err != nil || !seen[p.ID].Equal(m.lastSeen[p.ID]) {
+		t.Fatalf("expected dismissal to store the visit, got %v (err %v)", seen, err)
+	}
+
+	// Later reloads in the same session update quietly.
+	m = applyCmd(t, m, m.loadData)
+	if m.mode != modeNone {
+		t.Fatalf("expected reload without refocus to stay quiet, got mode %v", m.mode)
+	}
+
+	// Regaining terminal focus summarizes only what changed while away.
+	m.refreshOnFocus = true
+	m = applyMsg(t, m, tea.BlurMsg{})
+	svc.changeEvents[p.ID] = append([]domain.ChangeEvent{
+		agentEvent(5, domain.ChangeOperationMove, map[string]string{"title": "Ship it", "to_column_id": todo.ID}, time.Now().UTC().Add(time.Second)),
+	}, svc.changeEvents[p.ID]...)
+	m = applyMsg(t, m, tea.FocusMsg{})
+	if m.mode != modeCatchUp || m.catchUp.Moved != 1 || len(m.catchUp.Entries) != 1 {
+		t.Fatalf("expected refocus summary of one move, got mode %v summary %#v", m.mode, m.catchUp)
+	}
+	m = applyMsg(t, m, keyRune('a'))
+	if m.mode != modeActivityLog {
+		t.Fatalf("expected a to open the activity log, got mode %v", m.mode)
+	}
+}
+
+// TestModelCatchUpCountsPastActivityLog verifies the summary counts every change since the last visit, not just the activity log's window.
+func TestModelCatchUpCountsPastActivityLog(t *testing.T) {
+	now := time.Now().UTC()
+	p, _ := domain.NewProject("p1", "Alpha", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c}, nil)
+	total := activityLogMaxItems + 50
+	for i := range total {
+		svc.changeEvents[p.ID] = append(svc.changeEvents[p.ID], domain.ChangeEvent{
+			ID: int64(total - i), ProjectID: p.ID, WorkItemID: "t1", Operation: domain.ChangeOperationCreate,
+			ActorID: "agent-1", ActorName: "Builder bot", ActorType: domain.ActorTypeAgent, OccurredAt: now.Add(-time.Duration(i+1) * time.Second),
+		})
+	}
+	path := filepath.Join(t.TempDir(), "last_seen.json")
+	if err := writeLastSeen(path, p.ID, now.Add(-time.Hour)); err != nil {
+		t.Fatalf("writeLastSeen() error = %v", err)
+	}
+
+	m := loadReadyModel(t, NewModel(svc, WithReloadDebounce(0), WithLastSeenPath(path)))
+	if m.mode != modeCatchUp || m.catchUp.Created != total || m.catchUp.Truncated {
+		t.Fatalf("expected all %d creates summarized, got mode %v summary created %d truncated %t", total, m.mode, m.catchUp.Created, m.catchUp.Truncated)
+	}
+	if len(m.catchUp.Entries) != catchUpLineLimit || m.catchUp.Entries[0].At.Before(m.catchUp.Entries[1].At) {
+		t.Fatalf("expected the newest %d changes listed first, got %#v", catchUpLineLimit, m.catchUp.Entries)
+	}
+}
+
+// TestModelConfirmDefaultChoice verifies confirm dialogs highlight cancel by default and proceed when configured.
+func TestModelConfirmDefaultChoice(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	task, _ := domain.NewTask(domain.TaskInput{ID: "t1", ProjectID: p.ID, ColumnID: c.ID, Title: "Keep me", Priority: domain.PriorityLow}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c}, []domain.Task{task})
+
+	// A reflexive enter on the default highlight cancels the hard delete.
+	m := loadReadyModel(t, NewModel(svc))
+	m = applyMsg(t, m, keyRune('D'))
+	if m.mode != modeConfirmAction || m.confirmChoice != 1 {
+		t.Fatalf("expected confirm dialog with cancel highlighted, got mode %v choice %d", m.mode, m.confirmChoice)
+	}
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.status != "cancelled" || len(svc.tasks[p.ID]) != 1 {
+		t.Fatalf("expected enter to cancel, got status %q and %d tasks", m.status, len(svc.tasks[p.ID]))
+	}
+
+	// With default_cancel off, enter applies the action.
+	m = loadReadyModel(t, NewModel(svc, WithConfirmConfig(ConfirmConfig{Delete: true, Archive: true, HardDelete: true})))
+	m = applyMsg(t, m, keyRune('D'))
+	if m.mode != modeConfirmAction || m.confirmChoice != 0 {
+		t.Fatalf("expected confirm dialog with confirm highlighted, got mode %v choice %d", m.mode, m.confirmChoice)
+	}
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if len(svc.tasks[p.ID]) != 0 {
+		t.Fatalf("expected enter to hard delete, got %d tasks", len(svc.tasks[p.ID]))
+	}
+}
+
+// TestModelProjectPickerExportsMarkedProjects verifies picker marks drive one scoped snapshot export under the data dir.
+func TestModelProjectPickerExportsMarkedProjects(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p1, _ := domain.NewProject("p1", "Alpha", "", now)
+	p2, _ := domain.NewProject("p2", "Beta", "", now)
+	p3, _ := domain.NewProject("p3", "Gamma", "", now)
+	c, _ := domain.NewColumn("c1", p1.ID, "To Do", 0, 0, now)
+	svc := newFakeService([]domain.Project{p1, p2, p3}, []domain.Column{c}, nil)
+	dataDir := t.TempDir()
+	m := loadReadyModel(t, NewModel(svc, WithDataDir(dataDir)))
+
+	m = applyMsg(t, m, keyRune('p'))
+	if m.mode != modeProjectPicker {
+		t.Fatalf("expected project picker, got mode %v", m.mode)
+	}
+	// Mark Alpha and Gamma, skipping Beta.
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeySpace, Text: " "})
+	m = applyMsg(t, m, keyRune('j'))
+	m = applyMsg(t, m, keyRune('j'))
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeySpace, Text: " "})
+	if rendered := stripANSI(fmt.Sprint(m.View().Content)); !strings.Contains(rendered, "✓ Alpha") || !strings.Contains(rendered, "✓ Gamma") || strings.Contains(rendered, "✓ Beta") {
+		t.Fatalf("expected marked projects in picker, got %q", rendered)
+	}
+
+	m = applyMsg(t, m, keyRune('x'))
+	if !slices.Equal(svc.lastProjectsExport, []string{p1.ID, p3.ID}) {
+		t.Fatalf("expected marked projects exported in picker order, got %#v", svc.lastProjectsExport)
+	}
+	if !strings.HasPrefix(m.status, "exported 2 projects to ") || len(m.projectPickerMarked) != 0 {
+		t.Fatalf("expected export status and cleared marks, got %q marks=%d", m.status, len(m.projectPickerMarked))
+	}
+	path := strings.TrimPrefix(m.status, "exported 2 projects to ")
+	if filepath.Dir(path) != filepath.Join(dataDir, "exports") {
+		t.Fatalf("expected export under the data dir, got %q", path)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil || !strings.Contains(string(content), `"name": "Gamma"`) || strings.Contains(string(content), "Beta") {
+		t.Fatalf("expected snapshot of the marked projects, got %q (err %v)", content, err)
+	}
+
+	// Without marks the highlighted project is exported alone.
+	m = applyMsg(t, m, keyRune('x'))
+	if !slices.Equal(svc.lastProjectsExport, []string{p3.ID}) || !strings.HasPrefix(m.status, "exported 1 project to ") {
+		t.Fatalf("expected highlighted project export, got %#v status %q", svc.lastProjectsExport, m.status)
+	}
+}
+
+// TestModelConvertTaskKindCommands verifies the palette converts the selected task in place and reports no-op conversions.
+func TestModelConvertTaskKindCommands(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	task, _ := domain.NewTask(domain.TaskInput{ID: "t1", ProjectID: p.ID, ColumnID: c.ID, Title: "Grows up", Priority: domain.PriorityLow}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c}, []domain.Task{task})
+	m := loadReadyModel(t, NewModel(svc))
+
+	updated, cmd := m.executeCommandPalette("convert-to-branch")
+	m = applyResult(t, updated, cmd)
+	converted, _ := svc.taskByID(task.ID)
+	if converted.Kind != "branch" || converted.Scope != domain.KindAppliesToBranch {
+		t.Fatalf("expected task converted to branch, got kind %q scope %q", converted.Kind, converted.Scope)
+	}
+	if m.status != `converted "Grows up" to branch` {
+		t.Fatalf("unexpected convert status %q", m.status)
+	}
+
+	updated, cmd = m.executeCommandPalette("promote-branch")
+	m = applyResult(t, updated, cmd)
+	if m.status != "already a branch" {
+		t.Fatalf("expected no-op status for same kind, got %q", m.status)
+	}
+
+	updated, cmd = m.executeCommandPalette("convert-to-task")
+	m = applyResult(t, updated, cmd)
+	if converted, _ := svc.taskByID(task.ID); converted.Kind != domain.WorkKindTask || converted.Scope != domain.KindAppliesToTask {
+		t.Fatalf("expected branch demoted to task, got kind %q scope %q", converted.Kind, converted.Scope)
+	}
+}
+
+// TestModelFooterHelpCategories verifies H cycles footer key categories and the choice survives a restart.
+func TestModelFooterHelpCategories(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	statePath := filepath.Join(t.TempDir(), "view_state.json")
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c}, nil)
+	m := loadReadyModel(t, NewModel(svc, WithViewStatePath(statePath)))
+	footer := func(m Model) string {
+		lines := strings.Split(stripANSI(fmt.Sprint(m.View().Content)), "\n")
+		return lines[len(lines)-1]
+	}
+	if got := footer(m); !strings.Contains(got, "n new task") || strings.Contains(got, "h/l columns") {
+		t.Fatalf("expected basics footer by default, got %q", got)
+	}
+
+	m = applyMsg(t, m, keyRune('H'))
+	if m.status != "footer keys: navigation" {
+		t.Fatalf("unexpected footer status %q", m.status)
+	}
+	if got := footer(m); !strings.Contains(got, "h/l columns") || !strings.Contains(got, "H tasks keys") {
+		t.Fatalf("expected navigation footer naming the next category, got %q", got)
+	}
+
+	// A fresh model restores the persisted category.
+	restored := loadReadyModel(t, NewModel(svc, WithViewStatePath(statePath)))
+	if restored.footerHelp != footerHelpNavigation {
+		t.Fatalf("expected navigation footer restored, got %q", restored.footerHelp)
+	}
+
+	// The cycle wraps from the last category back to basics.
+	for range len(footerHelpLevelNames) - 1 {
+		m = applyMsg(t, m, keyRune('H'))
+	}
+	if m.footerHelp != footerHelpBasics {
+		t.Fatalf("expected footer cycle to wrap to basics, got %q", m.footerHelp)
+	}
+}
+
+// TestModelRenderIconsToggle verifies disabling icon rendering drops emoji icons but keeps ASCII markers.
+func TestModelRenderIconsToggle(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	emoji, _ := domain.NewProject("p1", "Rocket", "", now)
+	emoji.Metadata.Icon = "🚀"
+	ascii, _ := domain.NewProject("p2", "Work", "", now)
+	ascii.Metadata.Icon = "[W]"
+	svc := newFakeService([]domain.Project{emoji, ascii}, nil, nil)
+
+	m := NewModel(svc)
+	if got := m.projectDisplayName(emoji); got != "🚀 Rocket" {
+		t.Fatalf("expected emoji icon by default, got %q", got)
+	}
+
+	m = NewModel(svc, WithUIConfig(UIConfig{RenderIcons: false}))
+	if got := m.projectDisplayName(emoji); got != "Rocket" {
+		t.Fatalf("expected emoji icon dropped, got %q", got)
+	}
+	if got := m.projectDisplayLabel(ascii); got != "[W] Work" {
+		t.Fatalf("expected ascii icon kept, got %q", got)
+	}
+}
+
+// TestModelHighlightColorPersists verifies valid highlight colors reach the save callback and invalid ones are rejected.
+func TestModelHighlightColorPersists(t *testing.T) {
+	now := time.Date(2026, 2, 23, 11, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c}, nil)
+
+	var saved []string
+	m := loadReadyModel(t, NewModel(svc,
+		WithUIConfig(UIConfig{HighlightColor: "#00ff00"}),
+		WithSaveHighlightColorCallback(func(color string) error {
+			saved = append(saved, color)
+			return nil
+		}),
+	))
+	// The configured color is restored on launch.
+	if m.highlightColor != "#00ff00" {
+		t.Fatalf("expected configured highlight color restored, got %q", m.highlightColor)
+	}
+
+	updated, cmd := m.executeCommandPalette("highlight-color")
+	m = applyResult(t, updated, cmd)
+	m.highlightColorInput.SetValue("#12zz34")
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.mode != modeHighlightColor || m.highlightColor != "#00ff00" || len(saved) != 0 {
+		t.Fatalf("expected invalid color rejected in place, got mode %v color %q saved %#v", m.mode, m.highlightColor, saved)
+	}
+
+	m.highlightColorInput.SetValue("33")
+	updated, cmd = m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	m = applyResult(t, updated, cmd)
+	if m.highlightColor != "33" || len(saved) != 1 || saved[0] != "33" {
+		t.Fatalf("expected color 33 applied and saved, got %q saved %#v", m.highlightColor, saved)
+	}
+	if m.status != "highlight color saved" {
+		t.Fatalf("expected saved status, got %q", m.status)
+	}
+}
+
+// TestModelCriticalAndNonePriorities verifies the priority cycle covers all levels and cards mark critical but not none.
+func TestModelCriticalAndNonePriorities(t *testing.T) {
+	now := time.Date(2026, 2, 23, 11, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	critical, _ := domain.NewTask(domain.TaskInput{ID: "t1", ProjectID: p.ID, ColumnID: c.ID, Title: "Outage", Priority: domain.PriorityCritical}, now)
+	none, _ := domain.NewTask(domain.TaskInput{ID: "t2", ProjectID: p.ID, ColumnID: c.ID, Position: 1, Title: "Someday", Priority: domain.PriorityNone}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c}, []domain.Task{critical, none})
+	m := loadReadyModel(t, NewModel(svc))
+
+	if got := m.cardMeta(none); strings.Contains(got, "none") {
+		t.Fatalf("expected no priority marker for none, got %q", got)
+	}
+	if got := m.cardMeta(critical); !strings.Contains(got, "critical") {
+		t.Fatalf("expected critical in card meta, got %q", got)
+	}
+	if got := stripANSI(m.criticalPriorityMarker(critical)); got != " !!" {
+		t.Fatalf("expected critical title marker, got %q", got)
+	}
+	if m.criticalPriorityMarker(none) != "" {
+		t.Fatal("expected no title marker for non-critical tasks")
+	}
+	if rendered := stripANSI(fmt.Sprint(m.View().Content)); !strings.Contains(rendered, "Outage !!") {
+		t.Fatalf("expected critical marker after the card title, got\n%s", rendered)
+	}
+
+	// The form cycle walks none → low → medium → high → critical and wraps.
+	m.startTaskForm(nil)
+	if got := priorityOptions[m.priorityIdx]; got != domain.PriorityMedium {
+		t.Fatalf("expected new tasks to start at medium, got %q", got)
+	}
+	m.cyclePriority(2)
+	if got := priorityOptions[m.priorityIdx]; got != domain.PriorityCritical {
+		t.Fatalf("expected cycle to reach critical, got %q", got)
+	}
+	m.cyclePriority(1)
+	if got := priorityOptions[m.priorityIdx]; got != domain.PriorityNone {
+		t.Fatalf("expected cycle to wrap to none, got %q", got)
+	}
+}
+
+// TestModelMoveColumnUndoRedo verifies column moves keep focus on the moved column, stop at the edges, and undo/redo.
+func TestModelMoveColumnUndoRedo(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c1, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	c2, _ := domain.NewColumn("c2", p.ID, "Doing", 1, 0, now)
+	c3, _ := domain.NewColumn("c3", p.ID, "Done", 2, 0, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c1, c2, c3}, nil)
+	m := loadReadyModel(t, NewModel(svc))
+	columnOrder := func() string {
+		ids := make([]string, 0, len(m.columns))
+		for _, column := range m.columns {
+			ids = append(ids, column.ID)
+		}
+		return strings.Join(ids, ",")
+	}
+
+	m = applyMsg(t, m, keyRune('<'))
+	if m.status != "column already at the edge" || columnOrder() != "c1,c2,c3" {
+		t.Fatalf("expected the first column to stay put, got status %q order %s", m.status, columnOrder())
+	}
+
+	m = applyMsg(t, m, keyRune('>'))
+	if columnOrder() != "c2,c1,c3" {
+		t.Fatalf("expected c1 moved right, got %s", columnOrder())
+	}
+	if m.columns[m.selectedColumn].ID != c1.ID {
+		t.Fatalf("expected focus to follow the moved column, got %q", m.columns[m.selectedColumn].ID)
+	}
+	if m.status != "column moved right" {
+		t.Fatalf("expected move status, got %q", m.status)
+	}
+
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: 'z', Mod: tea.ModCtrl})
+	if columnOrder() != "c1,c2,c3" || m.columns[m.selectedColumn].ID != c1.ID {
+		t.Fatalf("expected undo to restore the order with c1 focused, got %s focus %d", columnOrder(), m.selectedColumn)
+	}
+
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: 'z', Mod: tea.ModCtrl | tea.ModShift})
+	if columnOrder() != "c2,c1,c3" {
+		t.Fatalf("expected redo to move c1 right again, got %s", columnOrder())
+	}
+
+	// Read-only sessions block the move before it reaches the service.
+	m.readOnly = true
+	m = applyMsg(t, m, keyRune('>'))
+	if columnOrder() != "c2,c1,c3" {
+		t.Fatalf("expected read-only mode to block column moves, got %s", columnOrder())
+	}
+}
+
+// TestModelEditColumnFromQuickActions verifies the column-edit modal validates input and refreshes the header.
+func TestModelEditColumnFromQuickActions(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c1, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	c2, _ := domain.NewColumn("c2", p.ID, "Done", 1, 0, now)
+	task, _ := domain.NewTask(domain.TaskInput{ID: "t1", ProjectID: p.ID, ColumnID: c1.ID, Title: "One", Priority: domain.PriorityMedium}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c1, c2}, []domain.Task{task})
+	m := loadReadyModel(t, NewModel(svc))
+
+	m = applyMsg(t, m, keyRune('.'))
+	idx := slices.IndexFunc(m.quickActions(), func(action quickActionItem) bool { return action.ID == "edit-column" })
+	if idx < 0 || !m.quickActions()[idx].Enabled {
+		t.Fatalf("expected enabled edit-column quick action, got %#v", m.quickActions())
+	}
+	m.quickActionIndex = idx
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.mode != modeEditColumn || m.columnEditInputs[columnEditNameField].Value() != "To Do" {
+		t.Fatalf("expected column edit modal prefilled with the focused column, got mode %v", m.mode)
+	}
+
+	// Duplicate names and bad limits keep the modal open without saving.
+	m.columnEditInputs[columnEditNameField].SetValue("done")
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.mode != modeEditColumn || !strings.Contains(m.status, "already exists") {
+		t.Fatalf("expected duplicate name rejected, got mode %v status %q", m.mode, m.status)
+	}
+	m.columnEditInputs[columnEditNameField].SetValue("Backlog")
+	m.columnEditInputs[columnEditWIPField].SetValue("-2")
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.mode != modeEditColumn || m.status != "wip limit must be a non-negative integer" || m.columnEditFocus != columnEditWIPField {
+		t.Fatalf("expected negative limit rejected with wip field focused, got mode %v status %q focus %d", m.mode, m.status, m.columnEditFocus)
+	}
+	m.columnEditInputs[columnEditNameField].SetValue("")
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.mode != modeEditColumn || m.status != "column name is required" {
+		t.Fatalf("expected empty name rejected, got mode %v status %q", m.mode, m.status)
+	}
+
+	m.columnEditInputs[columnEditNameField].SetValue("Backlog")
+	m.columnEditInputs[columnEditWIPField].SetValue("3")
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.mode != modeNone || m.status != "column updated" {
+		t.Fatalf("expected column saved, got mode %v status %q", m.mode, m.status)
+	}
+	if m.columns[0].Name != "Backlog" || m.columns[0].WIPLimit != 3 {
+		t.Fatalf("expected reloaded column, got %#v", m.columns[0])
+	}
+	if rendered := stripANSI(fmt.Sprint(m.View().Content)); !strings.Contains(rendered, "Backlog (1/3)") {
+		t.Fatalf("expected header to show the new WIP limit, got\n%s", rendered)
+	}
+}
+
+// TestModelDuePickerRepeatInterval verifies the due-picker repeat input validates, stages, and saves the recurrence.
+func TestModelDuePickerRepeatInterval(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Alpha", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c}, nil)
+	m := loadReadyModel(t, NewModel(svc, WithReloadDebounce(0)))
+
+	m = applyMsg(t, m, keyRune('n'))
+	for _, r := range "Water plants" {
+		m = applyMsg(t, m, keyRune(r))
+	}
+	m.formFocus = taskFieldDue
+	m.startDuePicker()
+	// The picker opens on the date input, and repeat is the next slot without a time input.
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyTab})
+	if m.duePickerFocus != 4 {
+		t.Fatalf("expected repeat input focused, got slot %d", m.duePickerFocus)
+	}
+	for _, r := range "2x" {
+		m = applyMsg(t, m, keyRune(r))
+	}
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.mode != modeDuePicker || !strings.Contains(m.status, "repeat must be") {
+		t.Fatalf("expected invalid repeat to keep the picker open, got mode %v status %q", m.mode, m.status)
+	}
+
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: 'u', Mod: tea.ModCtrl})
+	for _, r := range " 1W " {
+		m = applyMsg(t, m, keyRune(r))
+	}
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.mode != modeAddTask || m.taskFormRecurEvery != "1w" {
+		t.Fatalf("expected normalized repeat staged on the form, got mode %v repeat %q", m.mode, m.taskFormRecurEvery)
+	}
+	if got := m.taskFormActionFieldSummary(taskFieldDue); !strings.Contains(got, "every 1w") {
+		t.Fatalf("expected due summary to show the repeat, got %q", got)
+	}
+
+	updated, cmd := m.submitInputMode()
+	m = applyResult(t, updated, cmd)
+	if got := svc.lastCreateTask.Metadata.RecurEvery; got != "1w" {
+		t.Fatalf("expected recurrence saved on create, got %q", got)
+	}
+	task, ok := m.taskByID("t-new")
+	if !ok {
+		t.Fatal("expected created task on the board")
+	}
+	_ = m.startTaskForm(&task)
+	if m.taskFormRecurEvery != "1w" {
+		t.Fatalf("expected stored recurrence in edit form, got %q", m.taskFormRecurEvery)
+	}
+}
+
+// TestModelSearchResultsMarkCommentMatches verifies comment-only search hits are labeled in the results list.
+func TestModelSearchResultsMarkCommentMatches(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Alpha", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	titled, _ := domain.NewTask(domain.TaskInput{ID: "t1", ProjectID: p.ID, ColumnID: c.ID, Title: "Hotfix rollout", Priority: domain.PriorityMedium}, now)
+	commented, _ := domain.NewTask(domain.TaskInput{ID: "t2", ProjectID: p.ID, ColumnID: c.ID, Position: 1, Title: "Release notes", Priority: domain.PriorityMedium}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c}, []domain.Task{titled, commented})
+	comment, _ := domain.NewComment(domain.CommentInput{ID: "cm1", ProjectID: p.ID, TargetType: domain.CommentTargetTypeTask, TargetID: commented.ID, BodyMarkdown: "mention the hotfix here"}, now)
+	key := commentThreadKey(p.ID, domain.CommentTargetTypeTask, commented.ID)
+	svc.comments[key] = append(svc.comments[key], comment)
+	m := loadReadyModel(t, NewModel(svc))
+
+	m.searchQuery = "hotfix"
+	m.searchCrossProject = true
+	m = applyMsg(t, m, m.loadSearchMatches())
+	if len(m.searchMatches) != 1 {
+		t.Fatalf("expected only the title match without comment search, got %#v", m.searchMatches)
+	}
+
+	m.searchIncludeComments = true
+	m = applyMsg(t, m, m.loadSearchMatches())
+	if m.mode != modeSearchResults || len(m.searchMatches) != 2 {
+		t.Fatalf("expected two results with comment search, got mode %v matches %#v", m.mode, m.searchMatches)
+	}
+	rendered := stripANSI(fmt.Sprint(m.View().Content))
+	if !strings.Contains(rendered, "[in comments]") {
+		t.Fatalf("expected a comment match marker in results, got %q", rendered)
+	}
+	// Only the row that surfaced through its comments carries the marker.
+	for _, line := range strings.Split(rendered, "\n") {
+		switch {
+		case strings.Contains(line, "Release notes") && !strings.Contains(line, "[in comments]"):
+			t.Fatalf("expected comment match marker on %q", line)
+		case strings.Contains(line, "Hotfix rollout") && strings.Contains(line, "[in comments]"):
+			t.Fatalf("expected no comment marker on title match %q", line)
+		}
+	}
+}
+
+// TestModelGroupsBoardByAssignee verifies assignee swimlanes sort owners by name, trail unassigned work, and follow form edits.
+func TestModelGroupsBoardByAssignee(t *testing.T) {
+	now := time.Date(2026, 3, 3, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	newTask := func(id, title string, position int, assignee string) domain.Task {
+		task, _ := domain.NewTask(domain.TaskInput{ID: id, ProjectID: p.ID, ColumnID: c.ID, Title: title, Priority: domain.PriorityMedium, Position: position, Metadata: domain.TaskMetadata{Assignee: assignee}}, now)
+		return task
+	}
+	tasks := []domain.Task{
+		newTask("t1", "Loose end", 0, ""),
+		newTask("t2", "Review PR", 1, "bob"),
+		newTask("t3", "Write spec", 2, "Alice"),
+		newTask("t4", "Fix CI", 3, "bob"),
+	}
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c}, tasks)
+	m := loadReadyModel(t, NewModel(svc, WithReloadDebounce(0), WithBoardConfig(BoardConfig{GroupBy: "assignee"})))
+
+	if got := normalizeBoardGroupBy(" Assignee "); got != "assignee" {
+		t.Fatalf("unexpected normalizeBoardGroupBy assignee result: %q", got)
+	}
+	// Board position breaks ties within one owner's lane.
+	ids := []string{}
+	for _, task := range m.tasksForColumn(c.ID) {
+		ids = append(ids, task.ID)
+	}
+	if want := []string{"t3", "t2", "t4", "t1"}; !slices.Equal(ids, want) {
+		t.Fatalf("expected assignee lane order %v, got %v", want, ids)
+	}
+	rendered := stripANSI(fmt.Sprint(m.View().Content))
+	alice := strings.Index(rendered, "Assignee: Alice")
+	bob := strings.Index(rendered, "Assignee: bob")
+	unassigned := strings.Index(rendered, "Assignee: (unassigned)")
+	if alice < 0 || bob < alice || unassigned < bob {
+		t.Fatalf("expected Alice, bob, then unassigned lanes, got\n%s", rendered)
+	}
+
+	// The form field prefills the owner, and "-" moves the task to the unassigned lane.
+	task, _ := m.taskByID("t3")
+	_ = m.startTaskForm(&task)
+	if got := m.formInputs[taskFieldAssignee].Value(); got != "Alice" {
+		t.Fatalf("expected stored assignee in edit form, got %q", got)
+	}
+	m.formInputs[taskFieldAssignee].SetValue("-")
+	updated, cmd := m.submitInputMode()
+	m = applyResult(t, updated, cmd)
+	if got, _ := m.taskByID("t3"); got.Metadata.Assignee != "" {
+		t.Fatalf("expected assignee cleared, got %q", got.Metadata.Assignee)
+	}
+	if got := m.groupLabelForTask(tasks[1]); got != "Assignee: bob" {
+		t.Fatalf("unexpected assignee group label: %q", got)
+	}
+
+	// New tasks take typed owner text directly.
+	m = applyMsg(t, m, keyRune('n'))
+	for _, r := range "Triage" {
+		m = applyMsg(t, m, keyRune(r))
+	}
+	_ = m.focusTaskFormField(taskFieldAssignee)
+	for _, r := range " carol " {
+		m = applyMsg(t, m, keyRune(r))
+	}
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.mode != modeNone {
+		t.Fatalf("expected task created, got mode %v status %q", m.mode, m.status)
+	}
+	if got := svc.lastCreateTask.Metadata.Assignee; got != "carol" {
+		t.Fatalf("expected assignee carol on create, got %q", got)
+	}
+}
+
+// TestModelQuickAssignSelectedTasks verifies the assign picker offers known owners and assigns a selection as one undo step.
+func TestModelQuickAssignSelectedTasks(t *testing.T) {
+	now := time.Date(2026, 3, 3, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	t1, _ := domain.NewTask(domain.TaskInput{ID: "t1", ProjectID: p.ID, ColumnID: c.ID, Position: 0, Title: "One", Priority: domain.PriorityMedium}, now)
+	t2, _ := domain.NewTask(domain.TaskInput{ID: "t2", ProjectID: p.ID, ColumnID: c.ID, Position: 1, Title: "Two", Priority: domain.PriorityMedium, Metadata: domain.TaskMetadata{Assignee: "bob"}}, now)
+	t3, _ := domain.NewTask(domain.TaskInput{ID: "t3", ProjectID: p.ID, ColumnID: c.ID, Position: 2, Title: "Three", Priority: domain.PriorityMedium, Metadata: domain.TaskMetadata{Assignee: "Dana"}}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c}, []domain.Task{t1, t2, t3})
+	m := loadReadyModel(t, NewModel(svc, WithIdentityConfig(IdentityConfig{DisplayName: "Alice"})))
+	assigneeOf := func(taskID string) string {
+		task, _ := svc.taskByID(taskID)
+		return task.Metadata.Assignee
+	}
+
+	m = applyMsg(t, m, keyRune(' '))
+	m = applyMsg(t, m, keyRune('j'))
+	m = applyMsg(t, m, keyRune(' '))
+	m = applyMsg(t, m, keyRune('o'))
+	if m.mode != modeAssignPicker {
+		t.Fatalf("expected assign picker, got %v (%q)", m.mode, m.status)
+	}
+	names := []string{}
+	for _, item := range m.assignPicker.matches() {
+		names = append(names, item.Name)
+	}
+	// The identity name leads, known owners follow by name, and the clear entry closes the list.
+	if want := []string{"Alice", "bob", "Dana", ""}; !slices.Equal(names, want) {
+		t.Fatalf("expected picker owners %q, got %q", want, names)
+	}
+
+	for _, r := range "dan" {
+		m = applyMsg(t, m, keyRune(r))
+	}
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if assigneeOf("t1") != "Dana" || assigneeOf("t2") != "Dana" || assigneeOf("t3") != "Dana" {
+		t.Fatalf("expected selected tasks assigned to Dana, got t1=%q t2=%q", assigneeOf("t1"), assigneeOf("t2"))
+	}
+	if m.status != "assigned 2 tasks to Dana" {
+		t.Fatalf("unexpected assign status %q", m.status)
+	}
+
+	// Undo restores each task's previous owner as one action.
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: 'z', Mod: tea.ModCtrl})
+	if assigneeOf("t1") != "" || assigneeOf("t2") != "bob" {
+		t.Fatalf("expected undo to restore owners, got t1=%q t2=%q", assigneeOf("t1"), assigneeOf("t2"))
+	}
+	if !strings.Contains(m.status, "undo complete") {
+		t.Fatalf("expected undo status, got %q", m.status)
+	}
+
+	// A typed name nobody uses yet is offered after the fuzzy matches.
+	m = applyMsg(t, m, keyRune('o'))
+	for _, r := range "Erin" {
+		m = applyMsg(t, m, keyRune(r))
+	}
+	matches := m.assignPicker.matches()
+	if len(matches) != 2 || matches[0].Name != "Erin" || matches[0].Source != "new" {
+		t.Fatalf("expected new-owner entry for Erin, got %#v", matches)
+	}
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEscape})
+	if m.mode != modeNone || assigneeOf("t1") != "" {
+		t.Fatalf("expected esc to cancel without assigning, got mode %v t1=%q", m.mode, assigneeOf("t1"))
+	}
+}
+
+// TestParseResourceURL verifies attach-url validation accepts only absolute http(s) links.
+func TestParseResourceURL(t *testing.T) {
+	cases := []struct {
+		raw     string
+		want    string
+		wantErr string
+	}{
+		{raw: "  https://example.com/docs  ", want: "https://example.com/docs"},
+		{raw: "HTTP://example.com", want: "HTTP://example.com"},
+		{raw: "", wantErr: "url is required"},
+		{raw: "example.com/docs", wantErr: "http:// or https://"},
+		{raw: "ftp://example.com/file", wantErr: "http:// or https://"},
+		{raw: "https:///docs", wantErr: "must include a host"},
+		{raw: "https://exa mple.com", wantErr: "invalid url"},
+	}
+	for _, tc := range cases {
+		got, err := parseResourceURL(tc.raw)
+		if tc.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("parseResourceURL(%q) expected error containing %q, got %v", tc.raw, tc.wantErr, err)
+			}
+			continue
+		}
+		if err != nil || got != tc.want {
+			t.Fatalf("parseResourceURL(%q) = %q, %v; want %q", tc.raw, got, err, tc.want)
+		}
+	}
+}
+
+// TestModelAttachURLResource verifies URL refs are staged from the task form, saved from task info, and deduped.
+func TestModelAttachURLResource(t *testing.T) {
+	now := time.Date(2026, 3, 3, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	task, _ := domain.NewTask(domain.TaskInput{ID: "t1", ProjectID: p.ID, ColumnID: c.ID, Position: 0, Title: "One", Priority: domain.PriorityMedium}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c}, []domain.Task{task})
+	m := loadReadyModel(t, NewModel(svc))
+	typeText := func(m Model, text string) Model {
+		for _, r := range text {
+			m = applyMsg(t, m, keyRune(r))
+		}
+		return m
+	}
+
+	m = applyMsg(t, m, keyRune('e'))
+	m.formFocus = taskFieldResources
+	m = applyMsg(t, m, keyRune('u'))
+	if m.mode != modeAttachURL {
+		t.Fatalf("expected attach url modal from task form, got %v (%q)", m.mode, m.status)
+	}
+	// A link without a scheme is rejected and keeps the modal open.
+	m = typeText(m, "example.com")
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.mode != modeAttachURL || !strings.Contains(m.status, "http://") {
+		t.Fatalf("expected invalid url to stay in modal, got mode %v status %q", m.mode, m.status)
+	}
+	m.attachURLInputs[attachURLLinkField].SetValue("")
+	m = typeText(m, "https://example.com/spec/")
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.mode != modeEditTask || m.status != "url staged" {
+		t.Fatalf("expected url staged back in edit form, got mode %v status %q", m.mode, m.status)
+	}
+	if len(m.taskFormResourceRefs) != 1 || m.taskFormResourceRefs[0].ResourceType != domain.ResourceTypeURL || m.taskFormResourceRefs[0].Title != "example.com/spec" {
+		t.Fatalf("expected one staged url ref titled from its host, got %#v", m.taskFormResourceRefs)
+	}
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEscape})
+
+	// From task info the ref is saved immediately with its title.
+	m = applyMsg(t, m, keyRune('i'))
+	if m.mode != modeTaskInfo {
+		t.Fatalf("expected task info, got %v", m.mode)
+	}
+	m = applyMsg(t, m, keyRune('u'))
+	m = typeText(m, "https://example.com/design")
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyTab})
+	m = typeText(m, "Design doc")
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	saved, _ := svc.taskByID(task.ID)
+	if len(saved.Metadata.ResourceRefs) != 1 {
+		t.Fatalf("expected url ref persisted, got %#v", saved.Metadata.ResourceRefs)
+	}
+	if ref := saved.Metadata.ResourceRefs[0]; ref.Location != "https://example.com/design" || ref.Title != "Design doc" || ref.PathMode != domain.PathModeAbsolute {
+		t.Fatalf("unexpected persisted url ref %#v", ref)
+	}
+	if m.mode != modeTaskInfo || m.status != "url attached" {
+		t.Fatalf("expected task info with attached status, got mode %v status %q", m.mode, m.status)
+	}
+	if body := stripANSI(fmt.Sprint(m.View().Content)); !strings.Contains(body, "link Design doc") {
+		t.Fatalf("expected task info to render the url ref as a link, got %q", body)
+	}
+
+	// The same link with different casing is skipped.
+	m = applyMsg(t, m, keyRune('u'))
+	m = typeText(m, "HTTPS://EXAMPLE.COM/design")
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	saved, _ = svc.taskByID(task.ID)
+	if len(saved.Metadata.ResourceRefs) != 1 || m.status != "resource already attached" {
+		t.Fatalf("expected duplicate url skipped, got %d refs status %q", len(saved.Metadata.ResourceRefs), m.status)
+	}
+}
+
+// TestModelOpenTaskResource verifies task info opens the highlighted resource and guards missing or out-of-root paths.
+func TestModelOpenTaskResource(t *testing.T) {
+	orig := openPathInOS
+	t.Cleanup(func() { openPathInOS = orig })
+	opened := ""
+	openPathInOS = func(path string) error {
+		opened = path
+		return nil
+	}
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "docs"), 0o755); err != nil {
+		t.Fatalf("mkdir docs: %v", err)
+	}
+	planPath := filepath.Join(root, "docs", "plan.md")
+	if err := os.WriteFile(planPath, []byte("plan"), 0o644); err != nil {
+		t.Fatalf("write plan: %v", err)
+	}
+	now := time.Date(2026, 3, 3, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	task, _ := domain.NewTask(domain.TaskInput{
+		ID:        "t1",
+		ProjectID: p.ID,
+		ColumnID:  c.ID,
+		Position:  0,
+		Title:     "One",
+		Priority:  domain.PriorityMedium,
+		Metadata: domain.TaskMetadata{ResourceRefs: []domain.ResourceRef{
+			{ResourceType: domain.ResourceTypeLocalFile, PathMode: domain.PathModeRelative, BaseAlias: "project_root", Location: "docs/plan.md"},
+			{ResourceType: domain.ResourceTypeURL, PathMode: domain.PathModeAbsolute, Location: "https://example.com/spec"},
+			{ResourceType: domain.ResourceTypeLocalFile, PathMode: domain.PathModeRelative, Location: "docs/gone.md"},
+			{ResourceType: domain.ResourceTypeLocalFile, PathMode: domain.PathModeRelative, Location: "../outside.md"},
+		}},
+	}, now)
+	m := loadReadyModel(t, NewModel(newFakeService([]domain.Project{p}, []domain.Column{c}, []domain.Task{task})))
+	m.projectRoots = map[string]string{"inbox": root}
+
+	m = applyMsg(t, m, keyRune('i'))
+	m = applyMsg(t, m, keyRune('o'))
+	if opened != planPath || m.status != "opened "+planPath {
+		t.Fatalf("expected relative ref resolved against project root, got opened=%q status %q", opened, m.status)
+	}
+
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyTab})
+	m = applyMsg(t, m, keyRune('o'))
+	if opened != "https://example.com/spec" {
+		t.Fatalf("expected url ref opened as-is, got %q", opened)
+	}
+
+	// Missing and out-of-root paths never reach the opener.
+	opened = ""
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyTab})
+	m = applyMsg(t, m, keyRune('o'))
+	if opened != "" || !strings.Contains(m.status, "no longer exists") {
+		t.Fatalf("expected missing path status, got opened=%q status %q", opened, m.status)
+	}
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyTab})
+	m = applyMsg(t, m, keyRune('o'))
+	if opened != "" || !strings.Contains(m.status, "outside allowed root") {
+		t.Fatalf("expected out-of-root status, got opened=%q status %q", opened, m.status)
+	}
+
+	// Shift+tab wraps back, and headless sessions report the target instead of failing.
+	openPathInOS = func(string) error { return platform.ErrOpenUnavailable }
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyTab, Mod: tea.ModShift})
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyTab, Mod: tea.ModShift})
+	m = applyMsg(t, m, keyRune('o'))
+	if m.status != "no opener available; resource: https://example.com/spec" {
+		t.Fatalf("expected headless status for url ref, got %q", m.status)
+	}
+
+	// Without a project root local refs are refused.
+	m.projectRoots = nil
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyTab, Mod: tea.ModShift})
+	m = applyMsg(t, m, keyRune('o'))
+	if !strings.Contains(m.status, "project root is not configured") {
+		t.Fatalf("expected missing root status, got %q", m.status)
+	}
+}
+
+// TestModelVerifyResourcesFlagsStaleRefs verifies the quick action flags missing refs in task info and the footer.
+func TestModelVerifyResourcesFlagsStaleRefs(t *testing.T) {
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "plan.md"), []byte("plan"), 0o644); err != nil {
+		t.Fatalf("write plan: %v", err)
+	}
+	now := time.Date(2026, 3, 3, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Inbox", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	task, _ := domain.NewTask(domain.TaskInput{
+		ID:        "t1",
+		ProjectID: p.ID,
+		ColumnID:  c.ID,
+		Position:  0,
+		Title:     "One",
+		Priority:  domain.PriorityMedium,
+		Metadata: domain.TaskMetadata{ResourceRefs: []domain.ResourceRef{
+			{ResourceType: domain.ResourceTypeLocalFile, PathMode: domain.PathModeRelative, Location: "plan.md"},
+			{ResourceType: domain.ResourceTypeLocalFile, PathMode: domain.PathModeRelative, Location: "moved.md"},
+		}},
+	}, now)
+	svc := newFakeService([]domain.Project{p}, []domain.Column{c}, []domain.Task{task})
+	m := loadReadyModel(t, NewModel(svc, WithUIConfig(UIConfig{StatusSegments: []StatusSegment{StatusSegmentResources, StatusSegmentStatus}})))
+	m.projectRoots = map[string]string{"inbox": root}
+	if lines := strings.Join(m.statusSegmentLines(p, lipgloss.NewStyle(), lipgloss.Color("241"), 0, 0), "\n"); strings.Contains(lines, "stale resources") {
+		t.Fatalf("expected no stale warning before verification, got %q", lines)
+	}
+
+	m = applyMsg(t, m, keyRune('.'))
+	for idx, action := range m.quickActions() {
+		if action.ID == "verify-resources" {
+			m.quickActionIndex = idx
+		}
+	}
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if m.status != "verified 1 of 2 local resources, 1 missing" {
+		t.Fatalf("unexpected verify status %q", m.status)
+	}
+	if lines := strings.Join(m.statusSegmentLines(p, lipgloss.NewStyle(), lipgloss.Color("241"), 0, 0), "\n"); !strings.Contains(stripANSI(lines), "stale resources: 1") {
+		t.Fatalf("expected stale footer warning, got %q", lines)
+	}
+
+	m = applyMsg(t, m, keyRune('i'))
+	body := stripANSI(fmt.Sprint(m.View().Content))
+	if !strings.Contains(body, "1 stale") || !strings.Contains(body, "moved.md (missing)") {
+		t.Fatalf("expected task info to flag the stale ref, got %q", body)
+	}
+}
+
+// TestModelWatchedTasksToggleListAndJump verifies the watch key flags the focused task, the watched view lists
+// watched tasks across projects, and enter jumps to one.
+func TestModelWatchedTasksToggleListAndJump(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p1, _ := domain.NewProject("p1", "Alpha", "", now)
+	p2, _ := domain.NewProject("p2", "Beta", "", now)
+	c1, _ := domain.NewColumn("c1", p1.ID, "To Do", 0, 0, now)
+	c2, _ := domain.NewColumn("c2", p2.ID, "To Do", 0, 0, now)
+	c3, _ := domain.NewColumn("c3", p2.ID, "Review", 1, 0, now)
+	local, _ := domain.NewTask(domain.TaskInput{ID: "t1", ProjectID: p1.ID, ColumnID: c1.ID, Title: "Local", Priority: domain.PriorityMedium}, now)
+	quiet, _ := domain.NewTask(domain.TaskInput{ID: "t2", ProjectID: p2.ID, ColumnID: c2.ID, Title: "Quiet", Priority: domain.PriorityMedium}, now)
+	remote, _ := domain.NewTask(domain.TaskInput{
+		ID:        "t3",
+		ProjectID: p2.ID,
+		ColumnID:  c3.ID,
+		Title:     "Remote",
+		Priority:  domain.PriorityMedium,
+		Metadata:  domain.TaskMetadata{Watched: true},
+	}, now)
+	svc := newFakeService([]domain.Project{p1, p2}, []domain.Column{c1, c2, c3}, []domain.Task{local, quiet, remote})
+	m := loadReadyModel(t, NewModel(svc, WithReloadDebounce(0)))
+
+	m = applyMsg(t, m, keyRune('w'))
+	if !svc.tasks[p1.ID][0].Metadata.Watched {
+		t.Fatalf("expected w to watch the focused task, got %#v", svc.tasks[p1.ID][0].Metadata)
+	}
+	if rendered := stripANSI(fmt.Sprint(m.View().Content)); !strings.Contains(rendered, "Local "+watchedMarkerGlyph) {
+		t.Fatalf("expected the watched card to show the watched marker, got %q", rendered)
+	}
+
+	updated, cmd := m.executeCommandPalette("watched")
+	m = applyCmd(t, mustModelValue(t, updated), cmd)
+	if m.mode != modeWatchedTasks || len(m.watchedTasks) != 2 {
+		t.Fatalf("expected watched view with 2 tasks, got mode %v and %d tasks", m.mode, len(m.watchedTasks))
+	}
+	rendered := stripANSI(fmt.Sprint(m.View().Content))
+	for _, want := range []string{"Local • Alpha / To Do", "Remote • Beta / Review"} {
+		if !strings.Contains(rendered, want) {
+			t.Fatalf("expected watched view row %q, got %q", want, rendered)
+		}
+	}
+	m = applyMsg(t, m, keyRune('j'))
+	m = applyMsg(t, m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	if task, ok := m.selectedTaskInCurrentColumn(); !ok || task.ID != remote.ID {
+		t.Fatalf("expected watched view to jump to %q, got %#v ok=%t", remote.ID, task, ok)
+	}
+
+	// Unwatching from the view drops the row and clears the flag.
+	updated, cmd = m.executeCommandPalette("watched")
+	m = applyCmd(t, mustModelValue(t, updated), cmd)
+	m = applyMsg(t, m, keyRune('w'))
+	if len(m.watchedTasks) != 1 || m.watchedTasks[0].Task.ID != remote.ID {
+		t.Fatalf("expected only the remote task left watched, got %#v", m.watchedTasks)
+	}
+	if svc.tasks[p1.ID][0].Metadata.Watched {
+		t.Fatal("expected unwatching from the view to clear the flag")
+	}
+}
+
+// TestModelConfirmQuitGuardsUnsavedModalInput verifies confirm.quit asks before ctrl+c drops typed form input only.
+func TestModelConfirmQuitGuardsUnsavedModalInput(t *testing.T) {
+	now := time.Date(2026, 2, 21, 12, 0, 0, 0, time.UTC)
+	p, _ := domain.NewProject("p1", "Alpha", "", now)
+	c, _ := domain.NewColumn("c1", p.ID, "To Do", 0, 0, now)
+	task, _ := domain.NewTask(domain.TaskInput{ID: "t1", ProjectID: p.ID, ColumnID: c.ID, Title: "Existing", Priority: domain.PriorityMedium}, now)
+	m := loadReadyModel(t, NewModel(
+		newFakeService([]domain.Project{p}, []domain.Column{c}, []domain.Task{task}),
+		WithConfirmConfig(ConfirmConfig{Quit: true}),
+	))
+	ctrlC := tea.KeyPressMsg{Code: 'c', Mod: tea.ModCtrl}
+	pressCtrlC := func() (Model, bool) {
+		t.Helper()
+		updated, cmd := m.Update(ctrlC)
+		if cmd == nil {
+			return mustModelValue(t, updated), false
+		}
+		_, quit := cmd().(tea.QuitMsg)
+		return mustModelValue(t, updated), quit
+	}
+
+	// An untouched edit form has nothing to lose, so ctrl+c still quits at once.
+	_ = m.startTaskForm(&task)
+	if _, quit := pressCtrlC(); !quit {
+		t.Fatal("expected ctrl+c over an untouched edit form to quit")
+	}
+
+	_ = m.startTaskForm(nil)
+	for _, r := range "Draft idea" {
+		m = applyMsg(t, m, keyRune(r))
+	}
+	next, quit := pressCtrlC()
+	if quit || next.mode != modeConfirmAction {
+		t.Fatalf("expected ctrl+c over typed input to ask first, got mode %v quit=%t", next.mode, quit)
+	}
+	if rendered := stripANSI(fmt.Sprint(next.View().Content)); !strings.Contains(rendered, "quit and discard: unsaved task form") {
+		t.Fatalf("expected quit confirmation to name the unsaved form, got %q", rendered)
+	}
+	m = applyMsg(t, next, tea.KeyPressMsg{Code: tea.KeyEscape})
+	if m.mode != modeAddTask || m.formInputs[taskFieldTitle].Value() != "Draft idea" {
+		t.Fatalf("expected cancel to return to the form with its input, got mode %v title %q", m.mode, m.formInputs[taskFieldTitle].Value())
+	}
+
+	// A second ctrl+c on the confirmation is the emergency exit.
+	m, _ = pressCtrlC()
+	if _, quit := pressCtrlC(); !quit {
+		t.Fatal("expected a second ctrl+c to quit from the confirmation")
+	}
+}
@@ -0,0 +1,107 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/hylla/tillsyn/internal/domain"
+	"github.com/hylla/tillsyn/internal/platform"
+)
+
+// openPathInOS opens one path with the OS default handler; tests replace it to avoid launching one.
+var openPathInOS = platform.OpenPath
+
+// openDataDirCmd opens the resolved data directory, falling back to showing its path when headless.
+func (m Model) openDataDirCmd() tea.Cmd {
+	dataDir := strings.TrimSpace(m.dataDir)
+	if dataDir == "" {
+		return func() tea.Msg {
+			return actionMsg{status: "data dir unknown"}
+		}
+	}
+	return func() tea.Msg {
+		if err := openPathInOS(dataDir); err != nil {
+			if errors.Is(err, platform.ErrOpenUnavailable) {
+				return actionMsg{status: "data dir: " + dataDir}
+			}
+			return actionMsg{status: "open data dir failed: " + err.Error()}
+		}
+		return actionMsg{status: "opened data dir " + dataDir}
+	}
+}
+
+// openResourceRefCmd opens one task resource ref with the OS default handler.
+// Local refs must still exist inside the task's project root; links open as-is.
+func (m Model) openResourceRefCmd(task domain.Task, ref domain.ResourceRef) tea.Cmd {
+	target, err := m.resolveResourceRefTarget(task, ref)
+	if err != nil {
+		return func() tea.Msg {
+			return actionMsg{status: "open resource failed: " + err.Error()}
+		}
+	}
+	return func() tea.Msg {
+		if err := openPathInOS(target); err != nil {
+			if errors.Is(err, platform.ErrOpenUnavailable) {
+				return actionMsg{status: "no opener available; resource: " + target}
+			}
+			return actionMsg{status: "open resource failed: " + err.Error()}
+		}
+		return actionMsg{status: "opened " + target}
+	}
+}
+
+// resolveResourceRefTarget resolves the path or URL the OS opener should receive for one resource ref.
+func (m Model) resolveResourceRefTarget(task domain.Task, ref domain.ResourceRef) (string, error) {
+	location := strings.TrimSpace(ref.Location)
+	switch ref.ResourceType {
+	case domain.ResourceTypeLocalFile, domain.ResourceTypeLocalDir:
+	default:
+		// Non-local refs are only opened when they hold a web link.
+		link, err := parseResourceURL(location)
+		if err != nil {
+			return "", fmt.Errorf("%s resource is not an openable link: %w", ref.ResourceType, err)
+		}
+		return link, nil
+	}
+	root := m.projectRootForProjectID(task.ProjectID)
+	if root == "" {
+		return "", errors.New("project root is not configured")
+	}
+	path := filepath.FromSlash(location)
+	if ref.PathMode == domain.PathModeRelative {
+		path = filepath.Join(root, path)
+	}
+	path, err := normalizeAttachmentPathWithinRoot(root, path)
+	if err != nil {
+		return "", err
+	}
+	if _, err := os.Stat(path); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("%s no longer exists", path)
+		}
+		return "", err
+	}
+	return path, nil
+}
+
+// projectRootForProjectID returns the configured root path for one project, or "" when none is mapped.
+func (m Model) projectRootForProjectID(projectID string) string {
+	for _, project := range m.projects {
+		if project.ID != projectID {
+			continue
+		}
+		root := strings.TrimSpace(m.projectRoots[strings.TrimSpace(strings.ToLower(project.Slug))])
+		if root == "" {
+			return ""
+		}
+		if abs, err := filepath.Abs(root); err == nil {
+			return abs
+		}
+		return root
+	}
+	return ""
+}
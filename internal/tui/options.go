@@ -29,28 +29,106 @@ type ConfirmConfig struct {
 	Archive    bool
 	HardDelete bool
 	Restore    bool
+	// Quit asks before ctrl+c quits while a form or modal holds unsaved input.
+	Quit bool
+	// DefaultCancel highlights cancel instead of confirm when a confirmation modal opens.
+	DefaultCancel bool
 }
 
 // BoardConfig holds board rendering behavior settings.
 type BoardConfig struct {
-	ShowWIPWarnings bool
-	GroupBy         string
+	ShowWIPWarnings     bool
+	GroupBy             string
+	ColumnPageSize      int
+	TitleWrap           bool
+	TitleMaxLines       int
+	WarnDuplicateTitles bool
+	// DependencyBadges lists card dependency badges in order; none are shown unless configured.
+	DependencyBadges []DependencyBadge
+	// DimBlocked fades unselected cards that list blockers or a blocked reason.
+	DimBlocked bool
+}
+
+// ProjectsConfig holds project picker and tab ordering settings.
+type ProjectsConfig struct {
+	// Sort is one of none, alphabetical, recent, task_count, or pinned.
+	Sort string
+	// Pinned lists project slugs that lead the pinned sort, in order.
+	Pinned []string
 }
 
 // UIConfig holds general UI behavior settings.
 type UIConfig struct {
 	DueSoonWindows []time.Duration
 	ShowDueSummary bool
+	// StartupDueDigest lists overdue and due-today tasks across all projects once the first board loads.
+	StartupDueDigest bool
+	// DefaultReminders prefills new task forms with reminder lead times such as "1d".
+	DefaultReminders  []string
+	EmptyColumnText   string
+	EmptyBoardMessage string
+	HighlightStyle    HighlightStyle
+	// HighlightColor is the focused-row highlight color; empty keeps the built-in default.
+	HighlightColor string
+	// RenderIcons shows emoji project icons; when false only plain-ASCII icons are drawn.
+	RenderIcons           bool
+	StatusSegments        []StatusSegment
+	RefreshOnFocus        bool
+	RefreshInterval       time.Duration
+	Layout                LayoutConfig
+	DraftAutosaveInterval time.Duration
+}
+
+// LayoutConfig holds terminal-width breakpoints for the responsive board layout.
+// Zero widths keep the built-in behavior.
+type LayoutConfig struct {
+	HideNoticesPanel     bool
+	NoticesPanelMinWidth int
+	CompactPaddingBelow  int
 }
 
 // KeyConfig holds configurable keybinding settings.
+// Each value is one key or a comma-separated list such as "h,left"; blank values keep the built-in keys.
+// Fields mirror config.KeyConfig in order so the two convert directly.
 type KeyConfig struct {
-	CommandPalette string
-	QuickActions   string
-	MultiSelect    string
-	ActivityLog    string
-	Undo           string
-	Redo           string
+	CommandPalette   string
+	QuickActions     string
+	MultiSelect      string
+	ActivityLog      string
+	Undo             string
+	Redo             string
+	Quit             string
+	Reload           string
+	ToggleHelp       string
+	FooterHelp       string
+	MoveLeft         string
+	MoveRight        string
+	MoveUp           string
+	MoveDown         string
+	AddTask          string
+	TaskInfo         string
+	EditTask         string
+	NewProject       string
+	EditProject      string
+	DeleteTask       string
+	ArchiveTask      string
+	MoveTaskLeft     string
+	MoveTaskRight    string
+	MoveColumnLeft   string
+	MoveColumnRight  string
+	HardDeleteTask   string
+	RestoreTask      string
+	Search           string
+	Projects         string
+	ToggleArchived   string
+	ToggleSelectMode string
+	FocusSubtree     string
+	ClearFocus       string
+	Inbox            string
+	PreviousProject  string
+	JumpToTask       string
+	QuickAssign      string
+	ToggleWatch      string
 }
 
 // IdentityConfig holds identity defaults used for ownership-attributed actions.
@@ -65,21 +143,27 @@ type LabelConfig struct {
 	Global         []string
 	Projects       map[string][]string
 	EnforceAllowed bool
+	Colors         map[string]string
 }
 
 // RuntimeConfig holds TUI runtime settings that can be applied live.
 type RuntimeConfig struct {
-	DefaultDeleteMode app.DeleteMode
-	TaskFields        TaskFieldConfig
-	Search            SearchConfig
-	SearchRoots       []string
-	Confirm           ConfirmConfig
-	Board             BoardConfig
-	UI                UIConfig
-	Labels            LabelConfig
-	ProjectRoots      map[string]string
-	Keys              KeyConfig
-	Identity          IdentityConfig
+	DefaultDeleteMode  app.DeleteMode
+	ParentDeletePolicy app.ParentDeletePolicy
+	TaskFields         TaskFieldConfig
+	Search             SearchConfig
+	SearchRoots        []string
+	Confirm            ConfirmConfig
+	Board              BoardConfig
+	Projects           ProjectsConfig
+	UI                 UIConfig
+	Labels             LabelConfig
+	ProjectRoots       map[string]string
+	ProjectProfiles    map[string]ProjectProfile
+	Templates          map[string]TaskTemplate
+	SavedSearches      map[string]SavedSearch
+	Keys               KeyConfig
+	Identity           IdentityConfig
 }
 
 // BootstrapConfig holds first-run bootstrap identity and global root settings.
@@ -99,6 +183,9 @@ type SaveProjectRootFunc func(projectSlug, rootPath string) error
 // SaveBootstrapConfigFunc persists startup bootstrap identity and global root settings.
 type SaveBootstrapConfigFunc func(cfg BootstrapConfig) error
 
+// SaveHighlightColorFunc persists the focused-row highlight color.
+type SaveHighlightColorFunc func(color string) error
+
 // SaveLabelsConfigFunc persists label defaults for global and current-project scopes.
 type SaveLabelsConfigFunc func(projectSlug string, globalLabels, projectLabels []string) error
 
@@ -119,6 +206,7 @@ func DefaultTaskFieldConfig() TaskFieldConfig {
 func WithTaskFieldConfig(cfg TaskFieldConfig) Option {
 	return func(m *Model) {
 		m.taskFields = cfg
+		m.globalView.taskFields = cfg
 	}
 }
 
@@ -132,6 +220,18 @@ func WithDefaultDeleteMode(mode app.DeleteMode) Option {
 	}
 }
 
+// WithParentDeletePolicy returns an option that sets the subtask policy hard deletes apply and their confirmations describe.
+func WithParentDeletePolicy(policy app.ParentDeletePolicy) Option {
+	return func(m *Model) {
+		switch policy {
+		case app.ParentDeletePolicyCascade, app.ParentDeletePolicyReparent:
+			m.parentDeletePolicy = policy
+		default:
+			m.parentDeletePolicy = app.ParentDeletePolicyBlock
+		}
+	}
+}
+
 // WithSearchConfig returns an option that sets search config.
 func WithSearchConfig(cfg SearchConfig) Option {
 	return func(m *Model) {
@@ -170,6 +270,13 @@ func WithLaunchProjectPicker(enabled bool) Option {
 	}
 }
 
+// WithReadOnly returns an option that disables every mutating key, command, and form in the TUI.
+func WithReadOnly(enabled bool) Option {
+	return func(m *Model) {
+		m.readOnly = enabled
+	}
+}
+
 // WithStartupBootstrap returns an option that toggles startup bootstrap gating before project picker.
 func WithStartupBootstrap(enabled bool) Option {
 	return func(m *Model) {
@@ -184,6 +291,21 @@ func WithConfirmConfig(cfg ConfirmConfig) Option {
 		m.confirmArchive = cfg.Archive
 		m.confirmHardDelete = cfg.HardDelete
 		m.confirmRestore = cfg.Restore
+		m.confirmQuit = cfg.Quit
+		m.confirmDefaultCancel = cfg.DefaultCancel
+	}
+}
+
+// WithProjectsConfig returns an option that sets project picker and tab ordering.
+func WithProjectsConfig(cfg ProjectsConfig) Option {
+	return func(m *Model) {
+		m.projectSort = normalizeProjectSort(cfg.Sort)
+		m.pinnedProjects = make([]string, 0, len(cfg.Pinned))
+		for _, slug := range cfg.Pinned {
+			if slug = strings.TrimSpace(strings.ToLower(slug)); slug != "" {
+				m.pinnedProjects = append(m.pinnedProjects, slug)
+			}
+		}
 	}
 }
 
@@ -191,12 +313,23 @@ func WithConfirmConfig(cfg ConfirmConfig) Option {
 func WithBoardConfig(cfg BoardConfig) Option {
 	return func(m *Model) {
 		m.showWIPWarnings = cfg.ShowWIPWarnings
+		m.dimBlocked = cfg.DimBlocked
+		m.warnDuplicateTitles = cfg.WarnDuplicateTitles
 		switch normalizeBoardGroupBy(cfg.GroupBy) {
-		case "priority", "state":
+		case "priority", "state", "assignee":
 			m.boardGroupBy = normalizeBoardGroupBy(cfg.GroupBy)
 		default:
 			m.boardGroupBy = "none"
 		}
+		m.columnPageSize = max(0, cfg.ColumnPageSize)
+		m.titleWrap = cfg.TitleWrap
+		m.titleMaxLines = cfg.TitleMaxLines
+		if m.titleMaxLines < 1 {
+			m.titleMaxLines = defaultTitleMaxLines
+		}
+		m.dependencyBadges = normalizeDependencyBadges(cfg.DependencyBadges)
+		m.globalView.boardGroupBy = m.boardGroupBy
+		m.globalView.columnPageSize = m.columnPageSize
 	}
 }
 
@@ -207,6 +340,29 @@ func WithUIConfig(cfg UIConfig) Option {
 			m.dueSoonWindows = append([]time.Duration(nil), cfg.DueSoonWindows...)
 		}
 		m.showDueSummary = cfg.ShowDueSummary
+		m.startupDueDigest = cfg.StartupDueDigest
+		m.defaultReminders = append([]string(nil), cfg.DefaultReminders...)
+		m.emptyColumnText = strings.TrimSpace(cfg.EmptyColumnText)
+		m.emptyBoardMessage = strings.TrimSpace(cfg.EmptyBoardMessage)
+		m.highlightStyle = normalizeHighlightStyle(cfg.HighlightStyle)
+		m.globalView.highlightStyle = m.highlightStyle
+		m.highlightColor = defaultHighlightColor
+		if color := strings.TrimSpace(cfg.HighlightColor); color != "" {
+			m.highlightColor = color
+		}
+		m.renderIcons = cfg.RenderIcons
+		if cfg.StatusSegments != nil {
+			m.statusSegments = normalizeStatusSegments(cfg.StatusSegments)
+		}
+		m.refreshOnFocus = cfg.RefreshOnFocus
+		WithAutoRefreshInterval(cfg.RefreshInterval)(m)
+		m.hideNoticesPanel = cfg.Layout.HideNoticesPanel
+		m.noticesPanelMinWidth = max(0, cfg.Layout.NoticesPanelMinWidth)
+		m.compactPaddingBelow = max(0, cfg.Layout.CompactPaddingBelow)
+		m.draftAutosaveInterval = 0
+		if cfg.DraftAutosaveInterval > 0 {
+			m.draftAutosaveInterval = cfg.DraftAutosaveInterval
+		}
 	}
 }
 
@@ -223,6 +379,51 @@ func WithAutoRefreshInterval(interval time.Duration) Option {
 	}
 }
 
+// WithDataDir returns an option that sets the resolved data directory shown by open-data-dir.
+func WithDataDir(dir string) Option {
+	return func(m *Model) {
+		m.dataDir = strings.TrimSpace(dir)
+	}
+}
+
+// WithDraftDir returns an option that sets where per-project task-form drafts are stored.
+func WithDraftDir(dir string) Option {
+	return func(m *Model) {
+		m.draftDir = strings.TrimSpace(dir)
+	}
+}
+
+// WithLastSeenPath returns an option that sets where per-project last-seen times for the catch-up summary are stored.
+func WithLastSeenPath(path string) Option {
+	return func(m *Model) {
+		m.lastSeenPath = strings.TrimSpace(path)
+	}
+}
+
+// WithViewStatePath returns an option that sets where UI view state is persisted and restores the saved footer category.
+func WithViewStatePath(path string) Option {
+	return func(m *Model) {
+		m.viewStatePath = strings.TrimSpace(path)
+		if m.viewStatePath == "" {
+			return
+		}
+		// A missing or corrupt file only loses the remembered footer category.
+		if state, err := readViewState(m.viewStatePath); err == nil {
+			m.footerHelp = parseFooterHelpLevel(state.FooterHelp)
+		}
+	}
+}
+
+// WithReloadDebounce returns an option that coalesces reload bursts within the given window.
+func WithReloadDebounce(window time.Duration) Option {
+	return func(m *Model) {
+		if window < 0 {
+			window = 0
+		}
+		m.reloadDebounce = window
+	}
+}
+
 // WithLabelConfig returns an option that sets label config behavior.
 func WithLabelConfig(cfg LabelConfig) Option {
 	return func(m *Model) {
@@ -232,6 +433,7 @@ func WithLabelConfig(cfg LabelConfig) Option {
 			m.allowedLabelProject[project] = append([]string(nil), labels...)
 		}
 		m.enforceAllowedLabels = cfg.EnforceAllowed
+		m.labelColors = normalizeLabelColors(cfg.Colors)
 	}
 }
 
@@ -251,9 +453,10 @@ func WithProjectRoots(projectRoots map[string]string) Option {
 }
 
 // WithKeyConfig returns an option that configures keybindings.
+// The key map is rebuilt from the built-in keys, so dropping an override on reload restores the default.
 func WithKeyConfig(cfg KeyConfig) Option {
 	return func(m *Model) {
-		m.keys.applyConfig(cfg)
+		m.keys = newKeyMap(cfg)
 	}
 }
 
@@ -272,14 +475,19 @@ func WithIdentityConfig(cfg IdentityConfig) Option {
 func WithRuntimeConfig(cfg RuntimeConfig) Option {
 	return func(m *Model) {
 		WithDefaultDeleteMode(cfg.DefaultDeleteMode)(m)
+		WithParentDeletePolicy(cfg.ParentDeletePolicy)(m)
 		WithTaskFieldConfig(cfg.TaskFields)(m)
 		WithSearchConfig(cfg.Search)(m)
 		WithSearchRoots(cfg.SearchRoots)(m)
 		WithConfirmConfig(cfg.Confirm)(m)
 		WithBoardConfig(cfg.Board)(m)
+		WithProjectsConfig(cfg.Projects)(m)
 		WithUIConfig(cfg.UI)(m)
 		WithLabelConfig(cfg.Labels)(m)
 		WithProjectRoots(cfg.ProjectRoots)(m)
+		WithProjectProfiles(cfg.ProjectProfiles)(m)
+		WithTaskTemplates(cfg.Templates)(m)
+		WithSavedSearches(cfg.SavedSearches)(m)
 		WithKeyConfig(cfg.Keys)(m)
 		WithIdentityConfig(cfg.Identity)(m)
 	}
@@ -306,6 +514,13 @@ func WithSaveBootstrapConfigCallback(cb SaveBootstrapConfigFunc) Option {
 	}
 }
 
+// WithSaveHighlightColorCallback returns an option that sets highlight-color persistence behavior.
+func WithSaveHighlightColorCallback(cb SaveHighlightColorFunc) Option {
+	return func(m *Model) {
+		m.saveHighlightColor = cb
+	}
+}
+
 // WithSaveLabelsConfigCallback returns an option that sets labels-config persistence behavior.
 func WithSaveLabelsConfigCallback(cb SaveLabelsConfigFunc) Option {
 	return func(m *Model) {
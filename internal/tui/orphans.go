@@ -0,0 +1,47 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// orphanTaskLister is the optional service extension used to flag subtasks whose parent no longer exists.
+type orphanTaskLister interface {
+	ListOrphanedTasks(context.Context, string) ([]domain.Task, error)
+}
+
+// loadOrphanedTasks lists orphaned subtasks for one project when the service supports it.
+func (m Model) loadOrphanedTasks(ctx context.Context, projectID string) []domain.Task {
+	lister, ok := m.svc.(orphanTaskLister)
+	if !ok {
+		return nil
+	}
+	orphans, err := lister.ListOrphanedTasks(ctx, projectID)
+	if err != nil {
+		// Orphan detection is advisory, so a failure must not block the board load.
+		return nil
+	}
+	return orphans
+}
+
+// orphanedTaskWarning returns the warnings-panel summary for orphaned subtasks, or "" when there are none.
+func orphanedTaskWarning(count int) string {
+	if count <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d orphaned subtasks reference a missing parent (till doctor --fix reparent|delete)", count)
+}
+
+// orphanedTaskPanelItems returns one warnings-panel row per orphaned subtask; enter opens its task info.
+func (m Model) orphanedTaskPanelItems() []noticesPanelItem {
+	out := make([]noticesPanelItem, 0, len(m.orphanedTasks))
+	for _, task := range m.orphanedTasks {
+		out = append(out, noticesPanelItem{
+			Label:  "orphaned: " + truncate(task.Title, 40),
+			TaskID: task.ID,
+		})
+	}
+	return out
+}
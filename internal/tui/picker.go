@@ -0,0 +1,220 @@
+package tui
+
+import (
+	"cmp"
+	"image/color"
+	"slices"
+	"strings"
+
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+// picker is a fuzzy-filtered modal list: typing ranks the items by label, ↑/↓ moves the highlight, and enter picks one.
+// Each modal that offers a choice from a list embeds one picker and supplies its items and callbacks.
+type picker[T any] struct {
+	input textinput.Model
+	index int
+	// items holds the candidates in their listing order; equal fuzzy scores keep this order.
+	items []T
+	// limit caps how many ranked matches are listed.
+	limit int
+	// label names one item; the typed query is matched against it.
+	label func(T) string
+	// keywords lists extra text the query may match, such as a template's title prefix.
+	keywords func(T) []string
+	// extras appends unranked entries after the matches, given the query and whether it equals some item's label.
+	extras func(query string, exact bool) []T
+	// onSelect runs with the highlighted item when enter picks it; the picker is already closed.
+	onSelect func(*Model, T) tea.Cmd
+	// onCancel runs when esc closes the picker.
+	onCancel func(*Model)
+	// noMatch prefixes the typed query in the status shown when enter finds nothing to pick.
+	noMatch string
+}
+
+// newPicker constructs a picker whose input shows prompt and placeholder.
+func newPicker[T any](prompt, placeholder string, charLimit, limit int, label func(T) string) picker[T] {
+	in := textinput.New()
+	in.Prompt = prompt
+	in.Placeholder = placeholder
+	in.CharLimit = charLimit
+	configureTextInputClipboardBindings(&in)
+	return picker[T]{input: in, limit: limit, label: label}
+}
+
+// open resets the picker to an empty query over items and focuses its input.
+func (p *picker[T]) open(items []T) tea.Cmd {
+	p.items = items
+	p.index = 0
+	p.input.SetValue("")
+	return p.input.Focus()
+}
+
+// close blurs the input and drops the items.
+func (p *picker[T]) close() {
+	p.input.Blur()
+	p.items = nil
+}
+
+// query returns the trimmed typed text.
+func (p picker[T]) query() string {
+	return strings.TrimSpace(p.input.Value())
+}
+
+// matches ranks the items against the typed query, best fuzzy score first, followed by any extras.
+func (p picker[T]) matches() []T {
+	type scoredItem struct {
+		item  T
+		score int
+	}
+	query := p.query()
+	scored := make([]scoredItem, 0, len(p.items))
+	exact := false
+	for _, item := range p.items {
+		label := p.label(item)
+		candidates := []string{label}
+		if p.keywords != nil {
+			candidates = append(candidates, p.keywords(item)...)
+		}
+		score, ok := bestFuzzyScore(query, candidates...)
+		if !ok {
+			continue
+		}
+		exact = exact || strings.EqualFold(label, query)
+		scored = append(scored, scoredItem{item: item, score: score})
+	}
+	slices.SortStableFunc(scored, func(a, b scoredItem) int {
+		return cmp.Compare(b.score, a.score)
+	})
+	out := make([]T, 0, min(len(scored), p.limit))
+	for _, entry := range scored[:min(len(scored), p.limit)] {
+		out = append(out, entry.item)
+	}
+	if p.extras != nil {
+		out = append(out, p.extras(query, exact)...)
+	}
+	return out
+}
+
+// highlighted returns the highlighted match, if any.
+func (p picker[T]) highlighted() (T, bool) {
+	matches := p.matches()
+	if len(matches) == 0 {
+		var zero T
+		return zero, false
+	}
+	return matches[clamp(p.index, 0, len(matches)-1)], true
+}
+
+// updatePicker handles one key press for the picker p, which lives on m.
+// Esc closes the picker and returns to the board, enter hands the highlighted item to onSelect, and other keys edit the query.
+func updatePicker[T any](m *Model, p *picker[T], msg tea.KeyPressMsg) tea.Cmd {
+	if handled, status := applyClipboardShortcutToInput(msg, &p.input); handled {
+		m.status = status
+		p.index = 0
+		return nil
+	}
+	matches := p.matches()
+	p.index = clamp(p.index, 0, max(0, len(matches)-1))
+	switch {
+	case msg.Code == tea.KeyEscape || msg.String() == "esc":
+		p.close()
+		m.mode = modeNone
+		m.status = "cancelled"
+		if p.onCancel != nil {
+			p.onCancel(m)
+		}
+		return nil
+	case msg.Code == tea.KeyDown:
+		if p.index < len(matches)-1 {
+			p.index++
+		}
+		return nil
+	case msg.Code == tea.KeyUp:
+		if p.index > 0 {
+			p.index--
+		}
+		return nil
+	case msg.Code == tea.KeyEnter || msg.String() == "enter":
+		if len(matches) == 0 {
+			m.status = p.noMatch + p.query()
+			return nil
+		}
+		item := matches[p.index]
+		p.close()
+		m.mode = modeNone
+		return p.onSelect(m, item)
+	default:
+		var cmd tea.Cmd
+		p.input, cmd = p.input.Update(msg)
+		_ = scrubTextInputTerminalArtifacts(&p.input)
+		// Typing changes the ranking, so start again from the best match.
+		p.index = 0
+		return cmd
+	}
+}
+
+// pickerLayout holds the text and sizes one picker modal renders with.
+type pickerLayout[T any] struct {
+	title string
+	// header lines sit between the input and the matches.
+	header []string
+	// empty is shown in place of the matches when nothing matches; blank shows nothing.
+	empty string
+	// detail describes one item in muted text after its label.
+	detail func(T) string
+	hint   string
+	// boxWidth caps the modal width; labelWidth and detailWidth truncate each row's parts.
+	boxWidth    int
+	labelWidth  int
+	detailWidth int
+}
+
+// render draws the picker modal: title, input, header, ranked matches with the highlight, and the key hint.
+func (p picker[T]) render(accent, muted color.Color, maxWidth int, layout pickerLayout[T]) string {
+	style := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(accent).
+		Padding(0, 1)
+	inputWidth := 40
+	if maxWidth > 0 {
+		boxWidth := clamp(maxWidth, 36, layout.boxWidth)
+		style = style.Width(boxWidth)
+		inputWidth = max(18, boxWidth-10)
+	}
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(accent)
+	hintStyle := lipgloss.NewStyle().Foreground(muted)
+	selectedStyle := lipgloss.NewStyle().Bold(true).Foreground(accent)
+
+	in := p.input
+	in.SetWidth(inputWidth)
+	lines := []string{titleStyle.Render(layout.title), in.View(), ""}
+	for _, line := range layout.header {
+		lines = append(lines, hintStyle.Render(line))
+	}
+	matches := p.matches()
+	if len(matches) == 0 && layout.empty != "" {
+		lines = append(lines, hintStyle.Render(layout.empty))
+	}
+	selected := clamp(p.index, 0, max(0, len(matches)-1))
+	for pos, item := range matches {
+		row := truncate(p.label(item), layout.labelWidth)
+		if layout.detail != nil {
+			if detail := layout.detail(item); detail != "" {
+				if layout.detailWidth > 0 {
+					detail = truncate(detail, layout.detailWidth)
+				}
+				row += hintStyle.Render("  " + detail)
+			}
+		}
+		if pos == selected {
+			lines = append(lines, selectedStyle.Render("› ")+row)
+			continue
+		}
+		lines = append(lines, "  "+row)
+	}
+	lines = append(lines, hintStyle.Render(layout.hint))
+	return style.Render(strings.Join(lines, "\n"))
+}
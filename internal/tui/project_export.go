@@ -0,0 +1,114 @@
+package tui
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/hylla/tillsyn/internal/app"
+)
+
+// projectsSnapshotExporter is the optional service extension used to export selected projects as one snapshot.
+type projectsSnapshotExporter interface {
+	ExportProjectsSnapshot(context.Context, []string, bool) (app.Snapshot, error)
+}
+
+// projectsExportedMsg reports one finished picker export.
+type projectsExportedMsg struct {
+	path  string
+	count int
+}
+
+// toggleProjectPickerMark marks or unmarks the highlighted picker project for export.
+func (m *Model) toggleProjectPickerMark() {
+	if len(m.projects) == 0 {
+		return
+	}
+	projectID := m.projects[clamp(m.projectPickerIndex, 0, len(m.projects)-1)].ID
+	if m.projectPickerMarked == nil {
+		m.projectPickerMarked = map[string]struct{}{}
+	}
+	if _, ok := m.projectPickerMarked[projectID]; ok {
+		delete(m.projectPickerMarked, projectID)
+	} else {
+		m.projectPickerMarked[projectID] = struct{}{}
+	}
+	m.status = fmt.Sprintf("%d projects marked for export", len(m.projectPickerMarked))
+}
+
+// projectPickerExportIDs returns the marked projects in picker order, or the highlighted one when none are marked.
+func (m Model) projectPickerExportIDs() []string {
+	if len(m.projects) == 0 {
+		return nil
+	}
+	if len(m.projectPickerMarked) == 0 {
+		return []string{m.projects[clamp(m.projectPickerIndex, 0, len(m.projects)-1)].ID}
+	}
+	ids := make([]string, 0, len(m.projectPickerMarked))
+	for _, project := range m.projects {
+		if _, ok := m.projectPickerMarked[project.ID]; ok {
+			ids = append(ids, project.ID)
+		}
+	}
+	return ids
+}
+
+// exportPickerProjectsCmd writes the marked projects to one snapshot file under the data directory.
+func (m *Model) exportPickerProjectsCmd() tea.Cmd {
+	exporter, ok := m.svc.(projectsSnapshotExporter)
+	if !ok {
+		m.status = "project export unavailable"
+		return nil
+	}
+	if m.dataDir == "" {
+		m.status = "project export unavailable: data dir unknown"
+		return nil
+	}
+	ids := m.projectPickerExportIDs()
+	if len(ids) == 0 {
+		m.status = "no projects to export"
+		return nil
+	}
+	path := filepath.Join(m.dataDir, "exports", "projects-"+time.Now().UTC().Format("20060102-150405")+".json")
+	m.status = "exporting projects..."
+	return func() tea.Msg {
+		// Archived rows travel too, matching the `till export` default, so the hand-off is complete.
+		snap, err := exporter.ExportProjectsSnapshot(context.Background(), ids, true)
+		if err != nil {
+			return actionMsg{err: fmt.Errorf("export projects: %w", err)}
+		}
+		if err := writeSnapshotFile(path, snap); err != nil {
+			return actionMsg{err: err}
+		}
+		return projectsExportedMsg{path: path, count: len(ids)}
+	}
+}
+
+// writeSnapshotFile writes one snapshot as indented JSON, creating its directory as needed.
+func writeSnapshotFile(path string, snap app.Snapshot) error {
+	content, err := json.MarshalIndent(snap, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode snapshot json: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("create export dir: %w", err)
+	}
+	if err := os.WriteFile(path, append(content, '\n'), 0o644); err != nil {
+		return fmt.Errorf("write snapshot: %w", err)
+	}
+	return nil
+}
+
+// applyProjectsExported clears the export marks and reports where the snapshot landed.
+func (m *Model) applyProjectsExported(msg projectsExportedMsg) {
+	m.projectPickerMarked = nil
+	noun := "projects"
+	if msg.count == 1 {
+		noun = "project"
+	}
+	m.status = fmt.Sprintf("exported %d %s to %s", msg.count, noun, msg.path)
+}
@@ -0,0 +1,93 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// ProjectProfile holds per-project view overrides; nil or empty fields keep the global setting.
+type ProjectProfile struct {
+	GroupBy         string
+	ColumnPageSize  *int
+	HighlightStyle  HighlightStyle
+	ShowPriority    *bool
+	ShowDueDate     *bool
+	ShowLabels      *bool
+	ShowDescription *bool
+}
+
+// projectViewSettings captures the view settings a project profile may override.
+type projectViewSettings struct {
+	taskFields     TaskFieldConfig
+	boardGroupBy   string
+	columnPageSize int
+	highlightStyle HighlightStyle
+}
+
+// WithProjectProfiles returns an option that sets per-project view profiles keyed by project slug.
+func WithProjectProfiles(profiles map[string]ProjectProfile) Option {
+	return func(m *Model) {
+		m.projectProfiles = map[string]ProjectProfile{}
+		for rawSlug, profile := range profiles {
+			slug := strings.TrimSpace(strings.ToLower(rawSlug))
+			if slug == "" {
+				continue
+			}
+			m.projectProfiles[slug] = profile
+		}
+	}
+}
+
+// overlay returns the settings with one profile's non-empty fields applied on top.
+func (v projectViewSettings) overlay(profile ProjectProfile) projectViewSettings {
+	if strings.TrimSpace(profile.GroupBy) != "" {
+		v.boardGroupBy = normalizeBoardGroupBy(profile.GroupBy)
+	}
+	if profile.ColumnPageSize != nil {
+		v.columnPageSize = max(0, *profile.ColumnPageSize)
+	}
+	if strings.TrimSpace(string(profile.HighlightStyle)) != "" {
+		v.highlightStyle = normalizeHighlightStyle(profile.HighlightStyle)
+	}
+	if profile.ShowPriority != nil {
+		v.taskFields.ShowPriority = *profile.ShowPriority
+	}
+	if profile.ShowDueDate != nil {
+		v.taskFields.ShowDueDate = *profile.ShowDueDate
+	}
+	if profile.ShowLabels != nil {
+		v.taskFields.ShowLabels = *profile.ShowLabels
+	}
+	if profile.ShowDescription != nil {
+		v.taskFields.ShowDescription = *profile.ShowDescription
+	}
+	return v
+}
+
+// applyProjectProfile restores global view settings and overlays the active project's profile, if any.
+func (m *Model) applyProjectProfile() {
+	project, ok := m.currentProject()
+	m.applyViewSettings(m.viewSettingsFor(project, ok))
+}
+
+// viewSettingsFor returns the global view settings with one project's profile overlaid, if it has one.
+func (m Model) viewSettingsFor(project domain.Project, ok bool) projectViewSettings {
+	settings := m.globalView
+	if !ok {
+		return settings
+	}
+	slug := strings.TrimSpace(strings.ToLower(project.Slug))
+	if profile, found := m.projectProfiles[slug]; found && slug != "" {
+		settings = settings.overlay(profile)
+	}
+	return settings
+}
+
+// applyViewSettings installs one resolved set of view settings.
+func (m *Model) applyViewSettings(settings projectViewSettings) {
+	m.taskFields = settings.taskFields
+	m.boardGroupBy = settings.boardGroupBy
+	m.columnPageSize = settings.columnPageSize
+	m.highlightStyle = settings.highlightStyle
+}
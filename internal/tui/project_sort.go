@@ -0,0 +1,101 @@
+package tui
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hylla/tillsyn/internal/app"
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// Project ordering modes for the project picker and tabs.
+const (
+	projectSortNone         = "none"
+	projectSortAlphabetical = "alphabetical"
+	projectSortRecent       = "recent"
+	projectSortTaskCount    = "task_count"
+	projectSortPinned       = "pinned"
+)
+
+// normalizeProjectSort canonicalizes one configured project sort, falling back to load order.
+func normalizeProjectSort(raw string) string {
+	switch value := strings.TrimSpace(strings.ToLower(raw)); value {
+	case projectSortAlphabetical, projectSortRecent, projectSortTaskCount, projectSortPinned:
+		return value
+	default:
+		return projectSortNone
+	}
+}
+
+// compareProjectNames orders projects by case-insensitive name, then slug for stable ties.
+func compareProjectNames(a, b domain.Project) int {
+	if c := cmp.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name)); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.Slug, b.Slug)
+}
+
+// sortProjects orders loaded projects by the configured project sort.
+// Recent and task-count sorts read one activity summary for all projects; projects without changes rank last under
+// recent, and a failed lookup leaves both sorts in name order.
+func (m Model) sortProjects(ctx context.Context, projects []domain.Project) []domain.Project {
+	if len(projects) < 2 || m.projectSort == projectSortNone {
+		return projects
+	}
+	out := slices.Clone(projects)
+	switch m.projectSort {
+	case projectSortAlphabetical:
+		slices.SortStableFunc(out, compareProjectNames)
+	case projectSortRecent:
+		activity := m.projectActivityByID(ctx)
+		slices.SortStableFunc(out, func(a, b domain.Project) int {
+			// Zero times sort last because every recorded change is after them.
+			if c := activity[b.ID].LastChangeAt.Compare(activity[a.ID].LastChangeAt); c != 0 {
+				return c
+			}
+			return compareProjectNames(a, b)
+		})
+	case projectSortTaskCount:
+		activity := m.projectActivityByID(ctx)
+		slices.SortStableFunc(out, func(a, b domain.Project) int {
+			if c := cmp.Compare(activity[b.ID].TaskCount, activity[a.ID].TaskCount); c != 0 {
+				return c
+			}
+			return compareProjectNames(a, b)
+		})
+	case projectSortPinned:
+		// Pinned projects lead in their configured order; the rest follow alphabetically.
+		rank := make(map[string]int, len(m.pinnedProjects))
+		for idx, slug := range m.pinnedProjects {
+			rank[slug] = idx
+		}
+		pinnedRank := func(project domain.Project) int {
+			if idx, ok := rank[strings.ToLower(project.Slug)]; ok {
+				return idx
+			}
+			return len(rank)
+		}
+		slices.SortStableFunc(out, func(a, b domain.Project) int {
+			if c := cmp.Compare(pinnedRank(a), pinnedRank(b)); c != 0 {
+				return c
+			}
+			return compareProjectNames(a, b)
+		})
+	}
+	return out
+}
+
+// projectActivityByID indexes the project activity summaries by project id; a failed lookup yields an empty index.
+func (m Model) projectActivityByID(ctx context.Context) map[string]app.ProjectActivity {
+	summaries, err := m.svc.ListProjectActivity(ctx)
+	if err != nil {
+		return map[string]app.ProjectActivity{}
+	}
+	out := make(map[string]app.ProjectActivity, len(summaries))
+	for _, activity := range summaries {
+		out[activity.ProjectID] = activity
+	}
+	return out
+}
@@ -0,0 +1,49 @@
+package tui
+
+import (
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// trackActiveProject records the prior project whenever a load lands on a different one.
+func (m *Model) trackActiveProject() {
+	project, ok := m.currentProject()
+	if !ok {
+		return
+	}
+	if m.activeProjectID != "" && m.activeProjectID != project.ID {
+		m.previousProjectID = m.activeProjectID
+	}
+	m.activeProjectID = project.ID
+}
+
+// previousProject returns the most recently active other project when it is still listed.
+func (m Model) previousProject() (domain.Project, int, bool) {
+	previousID := strings.TrimSpace(m.previousProjectID)
+	if previousID == "" {
+		return domain.Project{}, 0, false
+	}
+	for idx, project := range m.projects {
+		if project.ID == previousID {
+			return project, idx, true
+		}
+	}
+	return domain.Project{}, 0, false
+}
+
+// switchToPreviousProject toggles between the current and most recently active project.
+func (m Model) switchToPreviousProject() (tea.Model, tea.Cmd) {
+	project, idx, ok := m.previousProject()
+	if !ok {
+		m.status = "no previous project"
+		return m, nil
+	}
+	m.selectedProject = idx
+	m.selectedColumn = 0
+	m.selectedTask = 0
+	m.status = "switched to " + project.Name
+	cmd := m.requestReload()
+	return m, cmd
+}
@@ -0,0 +1,71 @@
+package tui
+
+import (
+	"maps"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// quitConfirmKind marks the confirmation opened by ctrl+c over unsaved modal input.
+const quitConfirmKind = "quit"
+
+// unsavedModalInput names the open modal whose typed input quitting would lose, or returns "" when nothing is at stake.
+// Forms compare against the values they opened with, so an untouched edit form never counts as unsaved.
+func (m Model) unsavedModalInput() string {
+	switch m.mode {
+	case modeAddTask, modeEditTask:
+		if !maps.Equal(m.taskFormValues(), m.taskFormBaseline) {
+			return "task form"
+		}
+	case modeAddProject, modeEditProject:
+		if !maps.Equal(m.projectFormValues(), m.projectFormBaseline) {
+			return "project form"
+		}
+	case modeDescriptionEditor:
+		if len(m.descriptionEditorUndo) > 0 {
+			return "description"
+		}
+		// The editor covers the form or thread it was opened from, which may hold edits of its own.
+		if m.descriptionEditorBack != modeDescriptionEditor {
+			behind := m
+			behind.mode = m.descriptionEditorBack
+			return behind.unsavedModalInput()
+		}
+	case modeThread:
+		if strings.TrimSpace(m.threadInput.Value()) != "" {
+			return "comment"
+		}
+	}
+	return ""
+}
+
+// interruptQuit handles ctrl+c: with confirm.quit set and unsaved modal input it asks first, otherwise it quits.
+// A second ctrl+c on the confirmation quits at once, so the interrupt still always gets out.
+func (m Model) interruptQuit() (tea.Model, tea.Cmd) {
+	if !m.confirmQuit || m.mode == modeConfirmAction {
+		return m, tea.Quit
+	}
+	what := m.unsavedModalInput()
+	if what == "" {
+		return m, tea.Quit
+	}
+	m.quitConfirmBack = m.mode
+	m.openConfirmAction(confirmAction{
+		Kind:   quitConfirmKind,
+		Label:  "quit and discard",
+		Detail: "unsaved " + what,
+	})
+	return m, nil
+}
+
+// cancelConfirmAction closes the confirmation modal; a cancelled quit returns to the modal it interrupted.
+func (m *Model) cancelConfirmAction() {
+	m.mode = modeNone
+	if m.pendingConfirm.Kind == quitConfirmKind {
+		m.mode = m.quitConfirmBack
+	}
+	m.quitConfirmBack = modeNone
+	m.pendingConfirm = confirmAction{}
+	m.status = "cancelled"
+}
@@ -0,0 +1,37 @@
+package tui
+
+import (
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+// readOnlyIndicatorText labels the header badge shown while the TUI runs read-only.
+const readOnlyIndicatorText = "READ-ONLY"
+
+// readOnlyStatus returns the status shown when one mutating action is attempted in read-only mode.
+func readOnlyStatus(action string) string {
+	return "read-only mode: " + action + " disabled"
+}
+
+// readOnlyBlocked reports one blocked mutation attempt and leaves the model otherwise unchanged.
+func (m Model) readOnlyBlocked(action string) (tea.Model, tea.Cmd) {
+	m.status = readOnlyStatus(action)
+	return m, nil
+}
+
+// readOnlyBlockedCmd reports one blocked mutation attempt from a command-producing path.
+func readOnlyBlockedCmd(action string) tea.Cmd {
+	return func() tea.Msg {
+		return actionMsg{status: readOnlyStatus(action)}
+	}
+}
+
+// renderReadOnlyBadge renders the header badge shown while the TUI runs read-only.
+func renderReadOnlyBadge() string {
+	return lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("0")).
+		Background(lipgloss.Color("214")).
+		Padding(0, 1).
+		Render(readOnlyIndicatorText)
+}
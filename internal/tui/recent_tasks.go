@@ -0,0 +1,131 @@
+package tui
+
+import (
+	"fmt"
+	"image/color"
+	"slices"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// recentTaskLimit caps how many recently viewed tasks the session keeps.
+const recentTaskLimit = 15
+
+// recentTaskEntry stores one recently viewed task, newest first in Model.recentTasks.
+type recentTaskEntry struct {
+	TaskID    string
+	ProjectID string
+	Title     string
+}
+
+// recordRecentTask moves one viewed task to the front of the recently viewed list.
+func (m *Model) recordRecentTask(task domain.Task) {
+	if strings.TrimSpace(task.ID) == "" {
+		return
+	}
+	m.forgetRecentTask(task.ID)
+	entry := recentTaskEntry{TaskID: task.ID, ProjectID: task.ProjectID, Title: task.Title}
+	m.recentTasks = append([]recentTaskEntry{entry}, m.recentTasks...)
+	if len(m.recentTasks) > recentTaskLimit {
+		m.recentTasks = m.recentTasks[:recentTaskLimit]
+	}
+}
+
+// forgetRecentTask drops one task from the recently viewed list.
+func (m *Model) forgetRecentTask(taskID string) {
+	m.recentTasks = slices.DeleteFunc(m.recentTasks, func(entry recentTaskEntry) bool {
+		return entry.TaskID == taskID
+	})
+}
+
+// openRecentTasks opens the recently viewed task picker.
+func (m *Model) openRecentTasks() {
+	if len(m.recentTasks) == 0 {
+		m.status = "no recently viewed tasks"
+		return
+	}
+	m.mode = modeRecentTasks
+	m.recentTaskIndex = 0
+	m.help.ShowAll = false
+	m.status = fmt.Sprintf("%d recently viewed", len(m.recentTasks))
+}
+
+// handleRecentTasksKey handles input while the recently viewed picker is open.
+func (m Model) handleRecentTasksKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	m.recentTaskIndex = clamp(m.recentTaskIndex, 0, max(0, len(m.recentTasks)-1))
+	switch {
+	case msg.Code == tea.KeyEscape || msg.String() == "esc":
+		m.mode = modeNone
+		m.status = "ready"
+		return m, nil
+	case key.Matches(msg, m.keys.moveDown):
+		if m.recentTaskIndex < len(m.recentTasks)-1 {
+			m.recentTaskIndex++
+		}
+		return m, nil
+	case key.Matches(msg, m.keys.moveUp):
+		if m.recentTaskIndex > 0 {
+			m.recentTaskIndex--
+		}
+		return m, nil
+	case msg.Code == tea.KeyEnter || msg.String() == "enter":
+		if len(m.recentTasks) == 0 {
+			m.mode = modeNone
+			m.status = "no recently viewed tasks"
+			return m, nil
+		}
+		entry := m.recentTasks[m.recentTaskIndex]
+		m.mode = modeNone
+		m.status = "looking up task..."
+		// Resolve through the jump flow so moved, archived, or deleted tasks are handled on fresh data.
+		return m, m.resolveJumpTask(entry.TaskID, entry.ProjectID)
+	default:
+		return m, nil
+	}
+}
+
+// renderRecentTasksOverlay renders the recently viewed task picker.
+func (m Model) renderRecentTasksOverlay(accent, muted color.Color, maxWidth int) string {
+	style := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(accent).
+		Padding(0, 1)
+	titleWidth := 48
+	if maxWidth > 0 {
+		boxWidth := clamp(maxWidth, 44, 96)
+		style = style.Width(boxWidth)
+		titleWidth = max(16, boxWidth-24)
+	}
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(accent)
+	hintStyle := lipgloss.NewStyle().Foreground(muted)
+	selectedStyle := lipgloss.NewStyle().Bold(true).Foreground(accent)
+
+	projectNames := make(map[string]string, len(m.projects))
+	for _, project := range m.projects {
+		projectNames[project.ID] = project.Name
+	}
+	lines := []string{titleStyle.Render("Recently Viewed")}
+	if len(m.recentTasks) == 0 {
+		lines = append(lines, hintStyle.Render("(empty)"))
+	}
+	selected := clamp(m.recentTaskIndex, 0, max(0, len(m.recentTasks)-1))
+	for idx, entry := range m.recentTasks {
+		title := entry.Title
+		// Prefer the loaded title so renames show up without reopening the task.
+		if task, ok := m.taskByID(entry.TaskID); ok {
+			title = task.Title
+		}
+		row := fmt.Sprintf("%s • %s", truncate(title, titleWidth), truncate(projectNames[entry.ProjectID], 20))
+		if idx == selected {
+			lines = append(lines, selectedStyle.Render("› "+row))
+			continue
+		}
+		lines = append(lines, "  "+row)
+	}
+	lines = append(lines, hintStyle.Render("j/k select • enter jump • esc close"))
+	return style.Render(strings.Join(lines, "\n"))
+}
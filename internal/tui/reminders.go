@@ -0,0 +1,66 @@
+package tui
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// parseRemindersInput parses comma-separated reminder lead times from the task form.
+// Empty input keeps current reminders and "-" clears them, matching the other task-form fields.
+func parseRemindersInput(raw string, current []string) ([]string, error) {
+	text := strings.TrimSpace(raw)
+	switch text {
+	case "":
+		return append([]string(nil), current...), nil
+	case "-":
+		return nil, nil
+	}
+	out := make([]string, 0)
+	for _, part := range strings.Split(text, ",") {
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+		offset, err := domain.ParseReminderOffset(part)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, domain.FormatReminderOffset(offset))
+	}
+	return out, nil
+}
+
+// reminderPanelItems builds one notices row per open task whose reminder has fired but which is not yet due.
+func (m Model) reminderPanelItems(now time.Time) []noticesPanelItem {
+	type firedReminder struct {
+		task domain.Task
+		at   time.Time
+	}
+	fired := make([]firedReminder, 0)
+	for _, task := range m.tasks {
+		if task.ArchivedAt != nil || m.lifecycleStateForTask(task) == domain.StateDone {
+			continue
+		}
+		if at, ok := task.ActiveReminder(now); ok {
+			fired = append(fired, firedReminder{task: task, at: at})
+		}
+	}
+	// Nearest deadline first, since that reminder is the most pressing.
+	slices.SortStableFunc(fired, func(a, b firedReminder) int {
+		return a.task.DueAt.Compare(*b.task.DueAt)
+	})
+	projectID, _ := m.currentProjectID()
+	out := make([]noticesPanelItem, 0, len(fired))
+	for _, entry := range fired {
+		out = append(out, noticesPanelItem{
+			Label:     fmt.Sprintf("reminder: %s • due %s", entry.task.Title, formatDueValue(entry.task.DueAt)),
+			TaskID:    entry.task.ID,
+			ProjectID: cmp.Or(entry.task.ProjectID, projectID),
+		})
+	}
+	return out
+}
@@ -0,0 +1,80 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/hylla/tillsyn/internal/app"
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// staleResourceGlyph prefixes stale resource warnings in task info and the board footer.
+const staleResourceGlyph = "⚠"
+
+// staleResourceStyle colors stale resource warnings.
+var staleResourceStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+
+// resourceVerifier is the optional service extension used to re-check local resource refs.
+type resourceVerifier interface {
+	VerifyResourceRefs(context.Context, string, string) (app.ResourceVerification, error)
+}
+
+// verifyProjectResources re-checks every local resource ref in the current project against its configured root.
+func (m Model) verifyProjectResources() (tea.Model, tea.Cmd) {
+	if m.readOnly {
+		return m.readOnlyBlocked("verify resources")
+	}
+	verifier, ok := m.svc.(resourceVerifier)
+	if !ok {
+		m.status = "verify resources unavailable"
+		return m, nil
+	}
+	project, ok := m.currentProject()
+	if !ok {
+		m.status = "no project selected"
+		return m, nil
+	}
+	root := m.projectRootForProjectID(project.ID)
+	m.status = "verifying resources..."
+	return m, func() tea.Msg {
+		result, err := verifier.VerifyResourceRefs(context.Background(), project.ID, root)
+		if err != nil {
+			return actionMsg{err: fmt.Errorf("verify resources: %w", err)}
+		}
+		return actionMsg{status: resourceVerificationStatus(result), reload: true}
+	}
+}
+
+// resourceVerificationStatus summarizes one verification pass for the status line.
+func resourceVerificationStatus(result app.ResourceVerification) string {
+	status := fmt.Sprintf("verified %d of %d local resources", result.Verified, result.Checked)
+	if missing := len(result.Missing); missing > 0 {
+		status += fmt.Sprintf(", %d missing", missing)
+	}
+	if result.Unresolved > 0 {
+		status += fmt.Sprintf(", %d skipped without a project root", result.Unresolved)
+	}
+	return status
+}
+
+// staleResourceCount counts the task's refs that the last verification could not find.
+func staleResourceCount(task domain.Task) int {
+	count := 0
+	for _, ref := range task.Metadata.ResourceRefs {
+		if ref.IsStale() {
+			count++
+		}
+	}
+	return count
+}
+
+// staleResourceTotal counts stale refs across the loaded board tasks for the footer.
+func (m Model) staleResourceTotal() int {
+	total := 0
+	for _, task := range m.tasks {
+		total += staleResourceCount(task)
+	}
+	return total
+}
@@ -0,0 +1,247 @@
+package tui
+
+import (
+	"fmt"
+	"image/color"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// savedSearchMatchLimit caps how many saved search names the saved-search picker lists.
+const savedSearchMatchLimit = 8
+
+// SavedSearch holds one named search filter set as applied; zero window bounds leave that side open.
+// Before bounds are exclusive, so a window typed as a whole day ends at the next midnight.
+type SavedSearch struct {
+	Query           string
+	CrossProject    bool
+	IncludeArchived bool
+	IncludeComments bool
+	States          []string
+	Levels          []string
+	DueAfter        time.Time
+	DueBefore       time.Time
+	CreatedAfter    time.Time
+	CreatedBefore   time.Time
+}
+
+// SaveSavedSearchFunc persists one named search filter set.
+type SaveSavedSearchFunc func(name string, search SavedSearch) error
+
+// WithSavedSearches returns an option that sets the named searches offered by load-search.
+func WithSavedSearches(searches map[string]SavedSearch) Option {
+	return func(m *Model) {
+		m.savedSearches = map[string]SavedSearch{}
+		for rawName, search := range searches {
+			name := strings.TrimSpace(strings.ToLower(rawName))
+			if name == "" {
+				continue
+			}
+			m.savedSearches[name] = cloneSavedSearch(search)
+		}
+	}
+}
+
+// WithSaveSavedSearchCallback returns an option that sets saved-search persistence behavior.
+func WithSaveSavedSearchCallback(cb SaveSavedSearchFunc) Option {
+	return func(m *Model) {
+		m.saveSavedSearch = cb
+	}
+}
+
+// cloneSavedSearch copies a saved search so the model never shares filter slices with its caller.
+func cloneSavedSearch(search SavedSearch) SavedSearch {
+	search.States = append([]string(nil), search.States...)
+	search.Levels = append([]string(nil), search.Levels...)
+	return search
+}
+
+// currentSavedSearch captures the applied search filters, or the defaults when no search is applied.
+// Window text typed into the search modal but not applied is left out.
+func (m Model) currentSavedSearch() SavedSearch {
+	return SavedSearch{
+		Query:           m.searchQuery,
+		CrossProject:    m.searchCrossProject,
+		IncludeArchived: m.searchIncludeArchived,
+		IncludeComments: m.searchIncludeComments,
+		States:          canonicalSearchStates(m.searchStates),
+		Levels:          canonicalSearchLevels(m.searchLevels),
+		DueAfter:        m.searchDueAfter,
+		DueBefore:       m.searchDueBefore,
+		CreatedAfter:    m.searchCreatedAfter,
+		CreatedBefore:   m.searchCreatedBefore,
+	}
+}
+
+// namedSavedSearch pairs one saved search with its lowercased name for the saved-search picker.
+type namedSavedSearch struct {
+	Name   string
+	Search SavedSearch
+}
+
+// newSavedSearchPicker constructs the saved-search picker; picking a name loads that search.
+func newSavedSearchPicker() picker[namedSavedSearch] {
+	p := newPicker("search name: ", "saved search name", 80, savedSearchMatchLimit, func(item namedSavedSearch) string {
+		return item.Name
+	})
+	p.keywords = func(item namedSavedSearch) []string {
+		return []string{item.Search.Query}
+	}
+	p.onSelect = func(m *Model, item namedSavedSearch) tea.Cmd {
+		return m.loadSavedSearch(item.Name)
+	}
+	p.noMatch = "no saved search matches "
+	return p
+}
+
+// startSavedSearchMode opens the saved-search modal; saving names the current filters, loading picks a saved set.
+// Searches are listed by name, so an empty query shows them alphabetically.
+func (m *Model) startSavedSearchMode(saving bool) tea.Cmd {
+	if !saving && len(m.savedSearches) == 0 {
+		m.status = "no saved searches"
+		return nil
+	}
+	names := slices.Sorted(maps.Keys(m.savedSearches))
+	items := make([]namedSavedSearch, 0, len(names))
+	for _, name := range names {
+		items = append(items, namedSavedSearch{Name: name, Search: m.savedSearches[name]})
+	}
+	m.mode = modeSavedSearch
+	m.help.ShowAll = false
+	m.savedSearchSaving = saving
+	m.status = "load search"
+	if saving {
+		m.status = "save search"
+	}
+	return m.savedSearchPicker.open(items)
+}
+
+// saveCurrentSearch stores the current filters under name, replacing any saved search with the same name.
+func (m *Model) saveCurrentSearch(name string) tea.Cmd {
+	search := m.currentSavedSearch()
+	if m.savedSearches == nil {
+		m.savedSearches = map[string]SavedSearch{}
+	}
+	m.savedSearches[name] = search
+	m.mode = modeNone
+	m.savedSearchPicker.close()
+	m.status = "saved search " + name
+	if m.saveSavedSearch == nil {
+		return nil
+	}
+	save := m.saveSavedSearch
+	return func() tea.Msg {
+		if err := save(name, cloneSavedSearch(search)); err != nil {
+			return actionMsg{err: fmt.Errorf("save search %s: %w", name, err)}
+		}
+		return actionMsg{status: "saved search " + name}
+	}
+}
+
+// loadSavedSearch applies the named filter set and runs it the way applying the search modal does.
+// The window bounds are written back into the modal's inputs so reopening the modal shows them.
+func (m *Model) loadSavedSearch(name string) tea.Cmd {
+	search, ok := m.savedSearches[name]
+	if !ok {
+		m.status = "unknown saved search " + name
+		return nil
+	}
+	m.savedSearchPicker.close()
+	m.searchInput.SetValue(search.Query)
+	m.searchInput.CursorEnd()
+	m.searchCrossProject = search.CrossProject
+	m.searchIncludeArchived = search.IncludeArchived
+	m.searchIncludeComments = search.IncludeComments
+	m.searchStates = canonicalSearchStates(search.States)
+	m.searchLevels = canonicalSearchLevels(search.Levels)
+	m.searchDueFromInput.SetValue(formatSearchBound(search.DueAfter, false))
+	m.searchDueToInput.SetValue(formatSearchBound(search.DueBefore, true))
+	m.searchCreatedFromInput.SetValue(formatSearchBound(search.CreatedAfter, false))
+	m.searchCreatedToInput.SetValue(formatSearchBound(search.CreatedBefore, true))
+	m.mode = modeSearch
+	cmd := m.applySearchFilter()
+	if m.mode == modeNone {
+		m.status = "loaded search " + name
+	}
+	return cmd
+}
+
+// handleSavedSearchKey handles input while the saved-search modal is open.
+// While saving, enter names the current filters with the typed text instead of picking a match.
+func (m Model) handleSavedSearchKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case msg.Code == tea.KeyTab || msg.String() == "tab":
+		// Tab copies the highlighted name into the input, so saving over an existing search needs no retyping.
+		if item, ok := m.savedSearchPicker.highlighted(); ok {
+			m.savedSearchPicker.input.SetValue(item.Name)
+			m.savedSearchPicker.input.CursorEnd()
+		}
+		return m, nil
+	case m.savedSearchSaving && (msg.Code == tea.KeyEnter || msg.String() == "enter"):
+		name := strings.ToLower(m.savedSearchPicker.query())
+		if name == "" {
+			m.status = "search name required"
+			return m, nil
+		}
+		return m, m.saveCurrentSearch(name)
+	}
+	cmd := updatePicker(&m, &m.savedSearchPicker, msg)
+	return m, cmd
+}
+
+// renderSavedSearchOverlay renders the saved-search modal with its ranked names and a summary of each filter set.
+func (m Model) renderSavedSearchOverlay(accent, muted color.Color, maxWidth int) string {
+	layout := pickerLayout[namedSavedSearch]{
+		title:       "Load Search",
+		empty:       "no matching saved searches",
+		detail:      func(item namedSavedSearch) string { return savedSearchSummary(item.Search) },
+		hint:        "type to filter • ↑/↓ select • enter load • esc cancel",
+		boxWidth:    72,
+		labelWidth:  24,
+		detailWidth: 40,
+	}
+	if m.savedSearchSaving {
+		layout.title = "Save Search"
+		layout.header = []string{"saves: " + truncate(savedSearchSummary(m.currentSavedSearch()), 56), ""}
+		layout.empty = ""
+		layout.hint = "type a name • tab reuse selected name • enter save • esc cancel"
+	}
+	return m.savedSearchPicker.render(accent, muted, maxWidth, layout)
+}
+
+// savedSearchSummary describes one filter set, e.g. `"login" • all projects • todo,progress • due ..2026-06-30`.
+func savedSearchSummary(search SavedSearch) string {
+	parts := make([]string, 0, 8)
+	if search.Query != "" {
+		parts = append(parts, `"`+search.Query+`"`)
+	}
+	if search.CrossProject {
+		parts = append(parts, "all projects")
+	}
+	if len(search.States) > 0 {
+		parts = append(parts, strings.Join(search.States, ","))
+	}
+	if len(search.Levels) > 0 {
+		parts = append(parts, strings.Join(search.Levels, ","))
+	}
+	if search.IncludeArchived {
+		parts = append(parts, "archived")
+	}
+	if search.IncludeComments {
+		parts = append(parts, "comments")
+	}
+	if !search.DueAfter.IsZero() || !search.DueBefore.IsZero() {
+		parts = append(parts, "due "+formatSearchBound(search.DueAfter, false)+".."+formatSearchBound(search.DueBefore, true))
+	}
+	if !search.CreatedAfter.IsZero() || !search.CreatedBefore.IsZero() {
+		parts = append(parts, "created "+formatSearchBound(search.CreatedAfter, false)+".."+formatSearchBound(search.CreatedBefore, true))
+	}
+	if len(parts) == 0 {
+		return "no filters"
+	}
+	return strings.Join(parts, " • ")
+}
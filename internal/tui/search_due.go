@@ -0,0 +1,147 @@
+package tui
+
+import (
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+)
+
+// Search modal focus slots after the comments toggle: the due- and created-window inputs, then the apply button.
+const (
+	searchFocusDueFrom     = 6
+	searchFocusDueTo       = 7
+	searchFocusCreatedFrom = 8
+	searchFocusCreatedTo   = 9
+	searchFocusApply       = 10
+	searchFocusSlots       = 11
+)
+
+// searchDueDateOnlyLayout matches window input that names a whole day rather than a time.
+const searchDueDateOnlyLayout = "2006-01-02"
+
+// newSearchDateInput builds one date-window text input for the search modal.
+func newSearchDateInput() textinput.Model {
+	in := textinput.New()
+	in.Prompt = ""
+	in.Placeholder = "YYYY-MM-DD or -"
+	in.CharLimit = 32
+	configureTextInputClipboardBindings(&in)
+	return in
+}
+
+// searchFocusedTextInput returns the search modal text input under focus, if the focused slot takes text.
+func (m *Model) searchFocusedTextInput() *textinput.Model {
+	switch m.searchFocus {
+	case 0:
+		return &m.searchInput
+	case searchFocusDueFrom:
+		return &m.searchDueFromInput
+	case searchFocusDueTo:
+		return &m.searchDueToInput
+	case searchFocusCreatedFrom:
+		return &m.searchCreatedFromInput
+	case searchFocusCreatedTo:
+		return &m.searchCreatedToInput
+	default:
+		return nil
+	}
+}
+
+// searchFocusTakesText reports whether the focused search slot is a text input, so letter keys type instead of navigate.
+func (m Model) searchFocusTakesText() bool {
+	return m.searchFocusedTextInput() != nil
+}
+
+// syncSearchInputFocus focuses the text input under the current search slot and blurs the others.
+func (m *Model) syncSearchInputFocus() tea.Cmd {
+	m.blurSearchInputs()
+	in := m.searchFocusedTextInput()
+	if in == nil {
+		return nil
+	}
+	if m.searchFocus != 0 {
+		in.CursorEnd()
+	}
+	return in.Focus()
+}
+
+// blurSearchInputs blurs every search modal text input.
+func (m *Model) blurSearchInputs() {
+	m.searchInput.Blur()
+	m.searchDueFromInput.Blur()
+	m.searchDueToInput.Blur()
+	m.searchCreatedFromInput.Blur()
+	m.searchCreatedToInput.Blur()
+}
+
+// applySearchDateWindows parses the due- and created-window inputs into the applied search bounds.
+// On bad input it leaves the modal open on the offending field and returns false with its focus command.
+func (m *Model) applySearchDateWindows() (bool, tea.Cmd) {
+	dueAfter, dueBefore, ok, cmd := m.parseSearchWindow("due", m.searchDueFromInput, m.searchDueToInput, searchFocusDueFrom, searchFocusDueTo)
+	if !ok {
+		return false, cmd
+	}
+	createdAfter, createdBefore, ok, cmd := m.parseSearchWindow("created", m.searchCreatedFromInput, m.searchCreatedToInput, searchFocusCreatedFrom, searchFocusCreatedTo)
+	if !ok {
+		return false, cmd
+	}
+	m.searchDueAfter, m.searchDueBefore = dueAfter, dueBefore
+	m.searchCreatedAfter, m.searchCreatedBefore = createdAfter, createdBefore
+	return true, nil
+}
+
+// parseSearchWindow parses one from/to input pair, refocusing the offending slot with a status naming the window on error.
+func (m *Model) parseSearchWindow(name string, from, to textinput.Model, fromSlot, toSlot int) (time.Time, time.Time, bool, tea.Cmd) {
+	refocus := func(slot int, status string) (time.Time, time.Time, bool, tea.Cmd) {
+		m.searchFocus = slot
+		m.status = status
+		return time.Time{}, time.Time{}, false, m.syncSearchInputFocus()
+	}
+	after, err := parseSearchDueBound(from.Value(), false)
+	if err != nil {
+		return refocus(fromSlot, name+" from: "+err.Error())
+	}
+	before, err := parseSearchDueBound(to.Value(), true)
+	if err != nil {
+		return refocus(toSlot, name+" to: "+err.Error())
+	}
+	if !after.IsZero() && !before.IsZero() && !after.Before(before) {
+		return refocus(toSlot, name+" to must be after "+name+" from")
+	}
+	return after, before, true, nil
+}
+
+// parseSearchDueBound parses one window bound with the due-date formats the task form accepts.
+// Blank or "-" leaves the bound open. For an end bound, a date without a time covers that whole day.
+func parseSearchDueBound(raw string, end bool) (time.Time, error) {
+	parsed, err := parseDueInput(raw, nil)
+	if err != nil || parsed == nil {
+		return time.Time{}, err
+	}
+	bound := *parsed
+	if _, dateErr := time.Parse(searchDueDateOnlyLayout, strings.TrimSpace(raw)); end && dateErr == nil {
+		bound = bound.In(time.Local).AddDate(0, 0, 1).UTC()
+	}
+	return bound, nil
+}
+
+// formatSearchBound renders one applied window bound as search modal input that parses back to the same bound.
+// A local-midnight bound reads as a date; an end bound at midnight names the day before, which a date-only end covers.
+func formatSearchBound(bound time.Time, end bool) string {
+	if bound.IsZero() {
+		return ""
+	}
+	local := bound.In(time.Local)
+	if local.Hour() == 0 && local.Minute() == 0 && local.Second() == 0 && local.Nanosecond() == 0 {
+		if end {
+			local = local.AddDate(0, 0, -1)
+		}
+		return local.Format(searchDueDateOnlyLayout)
+	}
+	if local.Second() == 0 && local.Nanosecond() == 0 {
+		return local.Format("2006-01-02T15:04")
+	}
+	return bound.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,137 @@
+package tui
+
+import (
+	"fmt"
+	"image/color"
+	"slices"
+	"strings"
+	"time"
+
+	"charm.land/lipgloss/v2"
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// StatusSegment names one summary line rendered below the board.
+type StatusSegment string
+
+// StatusSegmentInfo and related constants define supported status-bar segments.
+const (
+	// StatusSegmentInfo shows child counts and focus hints for the selected task.
+	StatusSegmentInfo StatusSegment = "info"
+	// StatusSegmentFocus shows the subtree focus banner while focus is active.
+	StatusSegmentFocus StatusSegment = "focus"
+	// StatusSegmentSelection shows the multi-select count.
+	StatusSegmentSelection StatusSegment = "selection"
+	// StatusSegmentAttention shows unresolved and blocking attention totals for the current scope.
+	StatusSegmentAttention StatusSegment = "attention"
+	// StatusSegmentDue shows overdue and due-soon counts when the due summary is enabled.
+	StatusSegmentDue StatusSegment = "due"
+	// StatusSegmentBlocked shows how many loaded tasks are blocked.
+	StatusSegmentBlocked StatusSegment = "blocked"
+	// StatusSegmentResources warns how many loaded resource refs were missing at their last verification.
+	StatusSegmentResources StatusSegment = "resources"
+	// StatusSegmentStatus shows the latest action status message.
+	StatusSegmentStatus StatusSegment = "status"
+)
+
+// defaultStatusSegments keeps the historical board footer; the other segments are opt-in.
+var defaultStatusSegments = []StatusSegment{StatusSegmentInfo, StatusSegmentFocus, StatusSegmentSelection, StatusSegmentStatus}
+
+// normalizeStatusSegments lowercases, dedupes, and drops unknown segment names while keeping order.
+func normalizeStatusSegments(raw []StatusSegment) []StatusSegment {
+	out := make([]StatusSegment, 0, len(raw))
+	for _, segment := range raw {
+		segment = StatusSegment(strings.ToLower(strings.TrimSpace(string(segment))))
+		switch segment {
+		case StatusSegmentInfo, StatusSegmentFocus, StatusSegmentSelection, StatusSegmentAttention, StatusSegmentDue, StatusSegmentBlocked, StatusSegmentResources, StatusSegmentStatus:
+		default:
+			continue
+		}
+		if !slices.Contains(out, segment) {
+			out = append(out, segment)
+		}
+	}
+	return out
+}
+
+// statusSegmentLineCount counts the status-bar lines statusSegmentLines would render, for board height sizing.
+func (m Model) statusSegmentLineCount() int {
+	lines := 0
+	for _, segment := range m.statusSegments {
+		visible := false
+		switch segment {
+		case StatusSegmentInfo:
+			if task, ok := m.selectedTaskInCurrentColumn(); ok {
+				visible = m.directChildCount(task.ID) > 0 || strings.TrimSpace(m.projectionRootTaskID) != ""
+			} else {
+				visible = strings.TrimSpace(m.projectionRootTaskID) != ""
+			}
+		case StatusSegmentFocus:
+			visible = strings.TrimSpace(m.projectionRootTaskID) != ""
+		case StatusSegmentSelection:
+			visible = len(m.selectedTaskIDs) > 0
+		case StatusSegmentAttention:
+			_, total, _, _ := m.scopeAttentionSummary(m.tasksByID())
+			visible = total > 0
+		case StatusSegmentDue:
+			overdue, dueSoon := m.dueCounts(time.Now().UTC())
+			visible = m.showDueSummary && overdue+dueSoon > 0
+		case StatusSegmentBlocked:
+			visible = m.blockedTaskCount() > 0
+		case StatusSegmentResources:
+			visible = m.staleResourceTotal() > 0
+		case StatusSegmentStatus:
+			visible = m.boardStatusText() != ""
+		}
+		if visible {
+			lines++
+		}
+	}
+	return lines
+}
+
+// statusSegmentLines renders the configured status-bar segments, skipping segments with nothing to show.
+func (m Model) statusSegmentLines(project domain.Project, statusStyle lipgloss.Style, muted color.Color, attentionTotal, attentionBlocked int) []string {
+	lines := make([]string, 0, len(m.statusSegments))
+	for _, segment := range m.statusSegments {
+		line := ""
+		switch segment {
+		case StatusSegmentInfo:
+			line = m.renderInfoLine(project, muted)
+		case StatusSegmentFocus:
+			if strings.TrimSpace(m.projectionRootTaskID) != "" {
+				line = statusStyle.Render(fmt.Sprintf("subtree focus active • %s full board", m.keys.clearFocus.Help().Key))
+			}
+		case StatusSegmentSelection:
+			if count := len(m.selectedTaskIDs); count > 0 {
+				line = statusStyle.Render(fmt.Sprintf("%d tasks selected • %s toggle • esc clear", count, m.keys.multiSelect.Help().Key))
+			}
+		case StatusSegmentAttention:
+			if attentionTotal > 0 {
+				line = statusStyle.Render(fmt.Sprintf("attention: %d unresolved • %d blocked", attentionTotal, attentionBlocked))
+			}
+		case StatusSegmentDue:
+			if m.showDueSummary {
+				if overdue, dueSoon := m.dueCounts(time.Now().UTC()); overdue+dueSoon > 0 {
+					line = statusStyle.Render(fmt.Sprintf("overdue: %d • due soon: %d", overdue, dueSoon))
+				}
+			}
+		case StatusSegmentBlocked:
+			if count := m.blockedTaskCount(); count > 0 {
+				line = statusStyle.Render(fmt.Sprintf("%s blocked: %d", blockedMarkerGlyph, count))
+			}
+		case StatusSegmentResources:
+			if count := m.staleResourceTotal(); count > 0 {
+				line = staleResourceStyle.Render(fmt.Sprintf("%s stale resources: %d (verify-resources to recheck)", staleResourceGlyph, count))
+			}
+		case StatusSegmentStatus:
+			if status := m.boardStatusText(); status != "" {
+				line = statusStyle.Render(status)
+			}
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
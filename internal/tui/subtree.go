@@ -0,0 +1,181 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// taskSubtreeArchiver is the optional service extension used to archive or restore a task with all its subtasks.
+type taskSubtreeArchiver interface {
+	ArchiveTaskSubtree(context.Context, string) ([]domain.Task, error)
+	RestoreTaskSubtree(context.Context, string) ([]domain.Task, error)
+}
+
+// descendantTaskIDs returns every loaded subtask below one task, children before grandchildren.
+func (m Model) descendantTaskIDs(taskID string) []string {
+	out := make([]string, 0)
+	queue := []string{taskID}
+	for len(queue) > 0 {
+		parentID := queue[0]
+		queue = queue[1:]
+		for _, task := range m.tasks {
+			if task.ParentID == parentID {
+				out = append(out, task.ID)
+				queue = append(queue, task.ID)
+			}
+		}
+	}
+	return out
+}
+
+// confirmArchiveSubtreeAction opens a confirmation for archiving the focused task subtree, or archives it directly.
+func (m Model) confirmArchiveSubtreeAction() (tea.Model, tea.Cmd) {
+	if m.readOnly {
+		return m.readOnlyBlocked("archive subtree")
+	}
+	task, ok := m.selectedTaskInCurrentColumn()
+	if !ok {
+		m.status = "no task selected"
+		return m, nil
+	}
+	if !m.confirmArchive {
+		return m.archiveTaskSubtree(task.ID)
+	}
+	m.openConfirmAction(confirmAction{
+		Kind:    "archive-subtree",
+		Task:    task,
+		TaskIDs: []string{task.ID},
+		Label:   "archive subtree",
+	})
+	return m, nil
+}
+
+// archiveTaskSubtree archives one task and its subtasks as a single undoable history entry.
+func (m Model) archiveTaskSubtree(taskID string) (tea.Model, tea.Cmd) {
+	if m.readOnly {
+		return m.readOnlyBlocked("archive subtree")
+	}
+	archiver, ok := m.svc.(taskSubtreeArchiver)
+	if !ok {
+		m.status = "archive subtree unavailable"
+		return m, nil
+	}
+	taskID = strings.TrimSpace(taskID)
+	if taskID == "" {
+		m.status = "no task selected"
+		return m, nil
+	}
+	m.lastArchivedTaskID = taskID
+	return m, func() tea.Msg {
+		archived, err := archiver.ArchiveTaskSubtree(context.Background(), taskID)
+		if err != nil {
+			return actionMsg{err: err}
+		}
+		if len(archived) == 0 {
+			return actionMsg{status: "subtree already archived"}
+		}
+		ids := make([]string, 0, len(archived))
+		steps := make([]historyStep, 0, len(archived))
+		for _, task := range archived {
+			ids = append(ids, task.ID)
+			steps = append(steps, historyStep{Kind: historyStepArchive, TaskID: task.ID})
+		}
+		return subtreeActionMsg("archive subtree", fmt.Sprintf("archived subtree (%d tasks)", len(ids)), steps, actionMsg{
+			clearTaskIDs:    ids,
+			archivedTaskIDs: ids,
+		})
+	}
+}
+
+// restoreTaskSubtree restores one archived task and its archived subtasks as a single undoable history entry.
+func (m Model) restoreTaskSubtree() (tea.Model, tea.Cmd) {
+	if m.readOnly {
+		return m.readOnlyBlocked("restore subtree")
+	}
+	archiver, ok := m.svc.(taskSubtreeArchiver)
+	if !ok {
+		m.status = "restore subtree unavailable"
+		return m, nil
+	}
+	taskID := m.lastArchivedTaskID
+	if task, ok := m.selectedTaskInCurrentColumn(); ok && task.ArchivedAt != nil {
+		taskID = task.ID
+	}
+	if strings.TrimSpace(taskID) == "" {
+		m.status = "nothing to restore"
+		return m, nil
+	}
+	return m, func() tea.Msg {
+		restored, err := archiver.RestoreTaskSubtree(context.Background(), taskID)
+		if err != nil {
+			return actionMsg{err: err}
+		}
+		if len(restored) == 0 {
+			return actionMsg{status: "nothing to restore"}
+		}
+		steps := make([]historyStep, 0, len(restored))
+		for _, task := range restored {
+			steps = append(steps, historyStep{Kind: historyStepRestore, TaskID: task.ID})
+		}
+		return subtreeActionMsg("restore subtree", fmt.Sprintf("restored subtree (%d tasks)", len(restored)), steps, actionMsg{
+			upsertTasks: restored,
+		})
+	}
+}
+
+// subtreeActionMsg completes one subtree mutation result with its history and activity entries.
+func subtreeActionMsg(label, status string, steps []historyStep, msg actionMsg) actionMsg {
+	history := historyActionSet{
+		Label:    label,
+		Summary:  status,
+		Target:   fmt.Sprintf("%d tasks", len(steps)),
+		Steps:    steps,
+		Undoable: true,
+		At:       time.Now().UTC(),
+	}
+	msg.status = status
+	msg.reload = true
+	msg.historyPush = &history
+	msg.activityItem = &activityEntry{
+		At:      history.At,
+		Summary: label,
+		Target:  history.Target,
+	}
+	return msg
+}
+
+// archivedSubtaskRestoreHint counts archived subtasks left below one restored task and suggests restoring them too.
+func (m Model) archivedSubtaskRestoreHint(ctx context.Context, task domain.Task) string {
+	if _, ok := m.svc.(taskSubtreeArchiver); !ok {
+		return ""
+	}
+	tasks, err := m.svc.ListTasks(ctx, task.ProjectID, true)
+	if err != nil {
+		return ""
+	}
+	childrenByParent := map[string][]domain.Task{}
+	for _, candidate := range tasks {
+		childrenByParent[candidate.ParentID] = append(childrenByParent[candidate.ParentID], candidate)
+	}
+	count := 0
+	queue := []string{task.ID}
+	for len(queue) > 0 {
+		parentID := queue[0]
+		queue = queue[1:]
+		for _, child := range childrenByParent[parentID] {
+			if child.ArchivedAt != nil {
+				count++
+			}
+			queue = append(queue, child.ID)
+		}
+	}
+	if count == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d archived subtasks remain (restore-subtree restores them)", count)
+}
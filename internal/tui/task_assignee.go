@@ -0,0 +1,39 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// unassignedLabel names the swimlane and detail value for tasks without an owner.
+const unassignedLabel = "(unassigned)"
+
+// taskAssigneeLabel returns the task owner, or unassignedLabel when none is set.
+func taskAssigneeLabel(task domain.Task) string {
+	if assignee := strings.TrimSpace(task.Metadata.Assignee); assignee != "" {
+		return assignee
+	}
+	return unassignedLabel
+}
+
+// compareAssignees orders owners case-insensitively, falling back to exact text so differently cased names stay apart.
+func compareAssignees(a, b string) int {
+	a, b = strings.TrimSpace(a), strings.TrimSpace(b)
+	if cmp := strings.Compare(strings.ToLower(a), strings.ToLower(b)); cmp != 0 {
+		return cmp
+	}
+	return strings.Compare(a, b)
+}
+
+// parseAssigneeInput resolves the task-form assignee value: blank keeps current, "-" clears.
+func parseAssigneeInput(raw, current string) string {
+	switch value := strings.TrimSpace(raw); value {
+	case "":
+		return strings.TrimSpace(current)
+	case "-":
+		return ""
+	default:
+		return value
+	}
+}
@@ -0,0 +1,118 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"image/color"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/hylla/tillsyn/internal/app"
+)
+
+// taskCardExporter is the optional service extension used to export one task as a shareable card.
+type taskCardExporter interface {
+	ExportTaskCard(context.Context, app.ExportTaskCardInput) ([]byte, error)
+}
+
+// startTaskCardExport opens the export-card modal for one task.
+func (m *Model) startTaskCardExport(taskID string, back inputMode) {
+	if _, ok := m.svc.(taskCardExporter); !ok {
+		m.status = "task export unavailable"
+		return
+	}
+	taskID = strings.TrimSpace(taskID)
+	if taskID == "" {
+		m.status = "no task selected"
+		return
+	}
+	m.exportTaskID = taskID
+	m.exportTaskBack = back
+	if m.exportCardFormat == "" {
+		m.exportCardFormat = app.TaskCardFormatMarkdown
+	}
+	m.mode = modeExportTask
+	m.help.ShowAll = false
+	m.status = "export task card"
+}
+
+// handleExportTaskKey handles input while the export-card modal is open.
+func (m Model) handleExportTaskKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case msg.Code == tea.KeyEscape || msg.String() == "esc":
+		m.mode = m.exportTaskBack
+		m.status = "export cancelled"
+		return m, nil
+	case msg.String() == "f" || msg.Code == tea.KeyTab || msg.String() == "tab":
+		if m.exportCardFormat == app.TaskCardFormatJSON {
+			m.exportCardFormat = app.TaskCardFormatMarkdown
+		} else {
+			m.exportCardFormat = app.TaskCardFormatJSON
+		}
+		return m, nil
+	case msg.String() == "s" || msg.String() == " " || msg.String() == "space":
+		m.exportCardSubtasks = !m.exportCardSubtasks
+		return m, nil
+	case msg.Code == tea.KeyEnter || msg.String() == "enter":
+		m.mode = m.exportTaskBack
+		m.status = "exporting task card..."
+		return m, m.exportTaskCardCmd(app.ExportTaskCardInput{
+			TaskID:          m.exportTaskID,
+			IncludeSubtasks: m.exportCardSubtasks,
+			Format:          m.exportCardFormat,
+		})
+	default:
+		return m, nil
+	}
+}
+
+// exportTaskCardCmd exports one task card and copies it to the system clipboard.
+func (m Model) exportTaskCardCmd(in app.ExportTaskCardInput) tea.Cmd {
+	exporter, ok := m.svc.(taskCardExporter)
+	if !ok {
+		return nil
+	}
+	return func() tea.Msg {
+		card, err := exporter.ExportTaskCard(context.Background(), in)
+		if err != nil {
+			return actionMsg{err: err}
+		}
+		if err := copyTextToClipboard(string(card)); err != nil {
+			return actionMsg{status: "copy task card failed: " + err.Error()}
+		}
+		return actionMsg{status: fmt.Sprintf("copied task card (%s) to clipboard", in.Format)}
+	}
+}
+
+// renderExportTaskOverlay renders the export-card modal.
+func (m Model) renderExportTaskOverlay(accent, muted color.Color, maxWidth int) string {
+	style := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(accent).
+		Padding(0, 1)
+	if maxWidth > 0 {
+		style = style.Width(clamp(maxWidth, 36, 64))
+	}
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(accent)
+	hintStyle := lipgloss.NewStyle().Foreground(muted)
+
+	title := m.exportTaskID
+	if task, ok := m.taskByID(m.exportTaskID); ok {
+		title = task.Title
+	}
+	subtasks := "no"
+	if m.exportCardSubtasks {
+		subtasks = "yes"
+	}
+	lines := []string{
+		titleStyle.Render("Export Task Card"),
+		truncate(title, 56),
+		"",
+		"format:   " + string(m.exportCardFormat),
+		"subtasks: " + subtasks,
+		"",
+		hintStyle.Render("f/tab format • s/space subtasks • enter copy • esc cancel"),
+	}
+	return style.Render(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,35 @@
+package tui
+
+import (
+	tea "charm.land/bubbletea/v2"
+	"github.com/hylla/tillsyn/internal/app"
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// copyTaskTextCmd copies one task reference to the system clipboard and reports the outcome in the status line.
+// Headless sessions often have no clipboard, so the failure is a status message rather than an error.
+func copyTaskTextCmd(what, text string) tea.Cmd {
+	return func() tea.Msg {
+		if err := copyTextToClipboard(text); err != nil {
+			return actionMsg{status: "copy " + what + " failed: " + err.Error()}
+		}
+		return actionMsg{status: "copied " + what + ": " + truncate(text, 60)}
+	}
+}
+
+// taskHierarchyPath returns the task's project and ancestor path in the dependency picker's
+// "Project | branch:Title | task:Title" form.
+func (m Model) taskHierarchyPath(task domain.Task) string {
+	tasksByID := make(map[string]domain.Task, len(m.tasks))
+	for _, item := range m.tasks {
+		tasksByID[item.ID] = item
+	}
+	project := domain.Project{ID: task.ProjectID}
+	for _, candidate := range m.projects {
+		if candidate.ID == task.ProjectID {
+			project = candidate
+			break
+		}
+	}
+	return buildDependencyTaskPath(app.TaskMatch{Project: project, Task: task}, tasksByID)
+}
@@ -0,0 +1,163 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/hylla/tillsyn/internal/app"
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// taskEditSnapshot captures the task fields an edit can change, so undo and redo can write them back.
+type taskEditSnapshot struct {
+	Title       string
+	Description string
+	Priority    domain.Priority
+	DueAt       *time.Time
+	Labels      []string
+	Metadata    domain.TaskMetadata
+}
+
+// snapshotTaskEdit copies the editable fields of one task.
+func snapshotTaskEdit(task domain.Task) taskEditSnapshot {
+	snapshot := taskEditSnapshot{
+		Title:       task.Title,
+		Description: task.Description,
+		Priority:    task.Priority,
+		Labels:      slices.Clone(task.Labels),
+		Metadata:    task.Metadata,
+	}
+	if task.DueAt != nil {
+		due := *task.DueAt
+		snapshot.DueAt = &due
+	}
+	return snapshot
+}
+
+// updateInput builds the UpdateTask call that restores this snapshot onto the task.
+func (s taskEditSnapshot) updateInput(taskID string) app.UpdateTaskInput {
+	metadata := s.Metadata
+	return app.UpdateTaskInput{
+		TaskID:      taskID,
+		Title:       s.Title,
+		Description: s.Description,
+		Priority:    s.Priority,
+		DueAt:       s.DueAt,
+		Labels:      slices.Clone(s.Labels),
+		Metadata:    &metadata,
+	}
+}
+
+// taskEditHistory builds the undoable history set for one successful edit.
+// It returns nil when the update left every editable field unchanged, so no-op saves do not clutter undo.
+func taskEditHistory(label string, before, after domain.Task) *historyActionSet {
+	beforeSnapshot := snapshotTaskEdit(before)
+	afterSnapshot := snapshotTaskEdit(after)
+	if sameTaskEdit(beforeSnapshot, afterSnapshot) {
+		return nil
+	}
+	return &historyActionSet{
+		Label:   label,
+		Summary: label,
+		Target:  after.Title,
+		Steps: []historyStep{{
+			Kind:       historyStepEdit,
+			TaskID:     after.ID,
+			EditBefore: &beforeSnapshot,
+			EditAfter:  &afterSnapshot,
+		}},
+		Undoable: true,
+		At:       time.Now().UTC(),
+	}
+}
+
+// sameTaskEdit reports whether two snapshots hold the same values.
+func sameTaskEdit(a, b taskEditSnapshot) bool {
+	return sameEditValue(reflect.ValueOf(a), reflect.ValueOf(b))
+}
+
+// timeType identifies time values, which compare by instant rather than by representation.
+var timeType = reflect.TypeFor[time.Time]()
+
+// sameEditValue compares two values of one type field by field, so fields added later are covered without listing them.
+// Updates round-trip empty lists as nil and times in any zone, so lists compare by length and elements and
+// times by instant.
+func sameEditValue(a, b reflect.Value) bool {
+	if a.Type() == timeType {
+		return a.Interface().(time.Time).Equal(b.Interface().(time.Time))
+	}
+	switch a.Kind() {
+	case reflect.Slice, reflect.Array:
+		if a.Len() != b.Len() {
+			return false
+		}
+		for idx := range a.Len() {
+			if !sameEditValue(a.Index(idx), b.Index(idx)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Pointer:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+		return sameEditValue(a.Elem(), b.Elem())
+	case reflect.Struct:
+		for idx := range a.NumField() {
+			if !sameEditValue(a.Field(idx), b.Field(idx)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Map:
+		if a.Len() != b.Len() {
+			return false
+		}
+		for _, key := range a.MapKeys() {
+			other := b.MapIndex(key)
+			if !other.IsValid() || !sameEditValue(a.MapIndex(key), other) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(a.Interface(), b.Interface())
+	}
+}
+
+// overlayEditChanges sets each field of current that differs between before and after to target's value,
+// descending into nested structs so only the changed leaves are written.
+func overlayEditChanges(current, before, after, target reflect.Value) {
+	if current.Kind() == reflect.Struct && current.Type() != timeType {
+		for idx := range current.NumField() {
+			overlayEditChanges(current.Field(idx), before.Field(idx), after.Field(idx), target.Field(idx))
+		}
+		return
+	}
+	if !sameEditValue(before, after) {
+		current.Set(target)
+	}
+}
+
+// replayEditStep writes back only the fields the edit changed: their pre-edit values on undo, post-edit ones on redo.
+// Every other field keeps its current value, so later watch, resource, or lifecycle changes survive the replay.
+func (m Model) replayEditStep(step historyStep, undo bool) error {
+	if step.EditBefore == nil || step.EditAfter == nil {
+		return nil
+	}
+	target := *step.EditAfter
+	if undo {
+		target = *step.EditBefore
+	}
+	task, ok := m.taskByID(step.TaskID)
+	if !ok {
+		return fmt.Errorf("task %s is not loaded", step.TaskID)
+	}
+	current := snapshotTaskEdit(task)
+	overlayEditChanges(reflect.ValueOf(&current).Elem(), reflect.ValueOf(*step.EditBefore), reflect.ValueOf(*step.EditAfter), reflect.ValueOf(target))
+	_, err := m.svc.UpdateTask(context.Background(), current.updateInput(step.TaskID))
+	return err
+}
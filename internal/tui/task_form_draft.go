@@ -0,0 +1,454 @@
+package tui
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image/color"
+	"maps"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// newTaskDraftName names the draft file of a project's new-task form; edit drafts are named after their task id.
+const newTaskDraftName = "new"
+
+// taskFormDraft stores one in-progress task form so it can be recovered after a crash.
+type taskFormDraft struct {
+	ProjectID string               `json:"project_id"`
+	TaskID    string               `json:"task_id,omitempty"`
+	ParentID  string               `json:"parent_id,omitempty"`
+	Kind      domain.WorkKind      `json:"kind"`
+	Scope     domain.KindAppliesTo `json:"scope"`
+	Fields    map[string]string    `json:"fields"`
+	SavedAt   time.Time            `json:"saved_at"`
+}
+
+// taskFormDraftGate orders draft file writes and removals, which run as concurrent commands.
+// Every discard bumps the generation, so an autosave scheduled before it finds itself stale and skips its write
+// instead of recreating a draft that was just submitted or thrown away.
+type taskFormDraftGate struct {
+	mu         sync.Mutex
+	generation uint64
+}
+
+// current returns the generation new autosaves are scheduled under.
+func (g *taskFormDraftGate) current() uint64 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.generation
+}
+
+// invalidate makes every autosave scheduled so far stale.
+func (g *taskFormDraftGate) invalidate() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.generation++
+}
+
+// write runs one draft write unless a discard was scheduled after it.
+func (g *taskFormDraftGate) write(generation uint64, path string, draft taskFormDraft) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if generation != g.generation {
+		return nil
+	}
+	return writeTaskFormDraft(path, draft)
+}
+
+// remove deletes one draft file while no write is in flight.
+func (g *taskFormDraftGate) remove(path string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// taskFormDraftTickMsg triggers one periodic task-form autosave attempt.
+type taskFormDraftTickMsg struct{}
+
+// taskFormDraftSavedMsg reports the result of one draft write.
+type taskFormDraftSavedMsg struct {
+	err error
+}
+
+// taskFormDraftLoadedMsg carries the draft found for one project at startup, or for a task form as it opens.
+type taskFormDraftLoadedMsg struct {
+	draft    taskFormDraft
+	found    bool
+	openForm bool
+	err      error
+}
+
+// taskFormDraftDirFor returns the directory holding one project's drafts, or "" when drafts are disabled.
+func (m Model) taskFormDraftDirFor(projectID string) string {
+	projectID = strings.TrimSpace(projectID)
+	if m.draftDir == "" || m.draftAutosaveInterval <= 0 || projectID == "" {
+		return ""
+	}
+	return filepath.Join(m.draftDir, url.PathEscape(projectID))
+}
+
+// taskFormDraftPathFor returns the draft file path for one task form, or "" when drafts are disabled.
+// Each edited task keeps its own draft and new-task forms share one, so drafts of different forms never overwrite each other.
+func (m Model) taskFormDraftPathFor(projectID, taskID string) string {
+	dir := m.taskFormDraftDirFor(projectID)
+	if dir == "" {
+		return ""
+	}
+	name := cmp.Or(strings.TrimSpace(taskID), newTaskDraftName)
+	return filepath.Join(dir, url.PathEscape(name)+".json")
+}
+
+// taskFormDraftPath returns the draft file path for the open task form in the active project.
+func (m Model) taskFormDraftPath() string {
+	projectID, _ := m.currentProjectID()
+	return m.taskFormDraftPathFor(projectID, m.editingTaskID)
+}
+
+// taskFormDraftActive reports whether a task form, or its description editor, is open.
+func (m Model) taskFormDraftActive() bool {
+	if len(m.formInputs) == 0 {
+		return false
+	}
+	switch m.mode {
+	case modeAddTask, modeEditTask:
+		return true
+	case modeDescriptionEditor:
+		return m.descriptionEditorBack == modeAddTask || m.descriptionEditorBack == modeEditTask
+	default:
+		return false
+	}
+}
+
+// scheduleTaskFormDraftTickCmd schedules the next autosave tick when drafts are enabled and no tick is pending.
+func (m *Model) scheduleTaskFormDraftTickCmd() tea.Cmd {
+	if m.draftDir == "" || m.draftAutosaveInterval <= 0 || m.readOnly || m.draftTickArmed {
+		return nil
+	}
+	m.draftTickArmed = true
+	return tea.Tick(m.draftAutosaveInterval, func(time.Time) tea.Msg {
+		return taskFormDraftTickMsg{}
+	})
+}
+
+// autosaveTaskFormDraftCmd writes the open task form to its project draft when it changed since the last save.
+// A form still matching its opening values is not saved, so it never overwrites a draft whose recovery was put off.
+func (m *Model) autosaveTaskFormDraftCmd() tea.Cmd {
+	if !m.taskFormDraftActive() {
+		return nil
+	}
+	projectID, ok := m.currentProjectID()
+	path := m.taskFormDraftPathFor(projectID, m.editingTaskID)
+	if !ok || path == "" {
+		return nil
+	}
+	fields := m.taskFormValues()
+	// The description editor holds unsaved text until it returns to the form.
+	if m.mode == modeDescriptionEditor {
+		fields["description"] = m.descriptionEditorInput.Value()
+	}
+	if maps.Equal(fields, m.taskFormBaseline) {
+		return nil
+	}
+	draft := taskFormDraft{
+		ProjectID: projectID,
+		TaskID:    strings.TrimSpace(m.editingTaskID),
+		ParentID:  m.taskFormParentID,
+		Kind:      m.taskFormKind,
+		Scope:     m.taskFormScope,
+		Fields:    fields,
+	}
+	content, err := json.Marshal(draft)
+	if err != nil {
+		return nil
+	}
+	if string(content) == m.taskFormDraftSaved {
+		return nil
+	}
+	m.taskFormDraftSaved = string(content)
+	draft.SavedAt = time.Now().UTC()
+	gate, generation := m.draftGate, m.draftGate.current()
+	return func() tea.Msg {
+		return taskFormDraftSavedMsg{err: gate.write(generation, path, draft)}
+	}
+}
+
+// writeTaskFormDraft writes one draft file through a temp file so a crash never leaves it half-written.
+func writeTaskFormDraft(path string, draft taskFormDraft) error {
+	content, err := json.MarshalIndent(draft, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode task draft: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("create draft dir: %w", err)
+	}
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, content, 0o600); err != nil {
+		return fmt.Errorf("write task draft: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("replace task draft: %w", err)
+	}
+	return nil
+}
+
+// readTaskFormDraft reads one draft file; a missing file reports found=false.
+func readTaskFormDraft(path string) (taskFormDraft, bool, error) {
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return taskFormDraft{}, false, nil
+	}
+	if err != nil {
+		return taskFormDraft{}, false, fmt.Errorf("read task draft: %w", err)
+	}
+	var draft taskFormDraft
+	if err := json.Unmarshal(content, &draft); err != nil {
+		return taskFormDraft{}, false, fmt.Errorf("decode task draft %q: %w", path, err)
+	}
+	return draft, true, nil
+}
+
+// readNewestTaskFormDraft reads every draft in one project directory and returns the most recently saved one.
+func readNewestTaskFormDraft(dir string) (taskFormDraft, bool, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return taskFormDraft{}, false, fmt.Errorf("list task drafts: %w", err)
+	}
+	var newest taskFormDraft
+	found := false
+	for _, path := range paths {
+		draft, ok, err := readTaskFormDraft(path)
+		if err != nil {
+			return taskFormDraft{}, false, err
+		}
+		if ok && (!found || draft.SavedAt.After(newest.SavedAt)) {
+			newest, found = draft, true
+		}
+	}
+	return newest, found, nil
+}
+
+// discardTaskFormDraftCmd removes one task form draft file and cancels autosaves still pending.
+func (m *Model) discardTaskFormDraftCmd(path string) tea.Cmd {
+	m.taskFormDraftSaved = ""
+	m.draftGate.invalidate()
+	if path == "" {
+		return nil
+	}
+	gate := m.draftGate
+	return func() tea.Msg {
+		if err := gate.remove(path); err != nil {
+			return taskFormDraftSavedMsg{err: fmt.Errorf("discard task draft: %w", err)}
+		}
+		return nil
+	}
+}
+
+// discardTaskFormDraftOnSuccess cancels pending autosaves and removes one draft file after cmd reports a successful save.
+func (m *Model) discardTaskFormDraftOnSuccess(path string, cmd tea.Cmd) tea.Cmd {
+	m.taskFormDraftSaved = ""
+	m.draftGate.invalidate()
+	if path == "" || cmd == nil {
+		return cmd
+	}
+	gate := m.draftGate
+	return func() tea.Msg {
+		msg := cmd()
+		if action, ok := msg.(actionMsg); ok && action.err == nil {
+			_ = gate.remove(path)
+		}
+		return msg
+	}
+}
+
+// checkTaskFormDraftCmd looks for leftover drafts once per project and session and offers the newest one.
+// The check waits for an idle board so the launch project picker does not swallow the prompt.
+func (m *Model) checkTaskFormDraftCmd() tea.Cmd {
+	if m.readOnly || m.mode != modeNone {
+		return nil
+	}
+	projectID, ok := m.currentProjectID()
+	dir := m.taskFormDraftDirFor(projectID)
+	if !ok || dir == "" {
+		return nil
+	}
+	if _, checked := m.taskFormDraftChecked[projectID]; checked {
+		return nil
+	}
+	if m.taskFormDraftChecked == nil {
+		m.taskFormDraftChecked = map[string]struct{}{}
+	}
+	m.taskFormDraftChecked[projectID] = struct{}{}
+	return func() tea.Msg {
+		draft, found, err := readNewestTaskFormDraft(dir)
+		return taskFormDraftLoadedMsg{draft: draft, found: found, err: err}
+	}
+}
+
+// checkOpenTaskFormDraftCmd looks for a draft left by the task form that just opened, so opening the form again
+// offers the unsaved values even when the launch prompt was put off or a different draft was offered.
+func (m Model) checkOpenTaskFormDraftCmd() tea.Cmd {
+	projectID, ok := m.currentProjectID()
+	path := m.taskFormDraftPathFor(projectID, m.editingTaskID)
+	if !ok || path == "" {
+		return nil
+	}
+	return func() tea.Msg {
+		draft, found, err := readTaskFormDraft(path)
+		return taskFormDraftLoadedMsg{draft: draft, found: found, openForm: true, err: err}
+	}
+}
+
+// applyTaskFormDraftLoaded offers recovery for a leftover draft when the board is idle, or over the
+// matching task form while it is still untouched.
+func (m Model) applyTaskFormDraftLoaded(msg taskFormDraftLoadedMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		m.status = "task draft unreadable: " + msg.err.Error()
+		return m, nil
+	}
+	if !msg.found {
+		return m, nil
+	}
+	if projectID, ok := m.currentProjectID(); !ok || projectID != msg.draft.ProjectID {
+		return m, nil
+	}
+	m.recoverDraftBack = modeNone
+	if msg.openForm {
+		if m.mode != modeAddTask && m.mode != modeEditTask || msg.draft.TaskID != strings.TrimSpace(m.editingTaskID) {
+			return m, nil
+		}
+		// Typing already started, or the form already shows the draft (it was just recovered), so there is nothing to offer.
+		values := m.taskFormValues()
+		if !maps.Equal(values, m.taskFormBaseline) || maps.Equal(values, msg.draft.Fields) {
+			return m, nil
+		}
+		m.recoverDraftBack = m.mode
+	} else if m.mode != modeNone {
+		return m, nil
+	}
+	m.recoverDraft = msg.draft
+	m.mode = modeRecoverDraft
+	m.help.ShowAll = false
+	m.status = "recover unsaved task?"
+	return m, nil
+}
+
+// handleRecoverDraftKey handles input while the draft recovery prompt is open.
+// A prompt raised over an opening task form returns to that form when the draft is put off or discarded.
+func (m Model) handleRecoverDraftKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	back := m.recoverDraftBack
+	switch {
+	case msg.Code == tea.KeyEscape || msg.String() == "esc":
+		m.mode = back
+		m.recoverDraft = taskFormDraft{}
+		m.recoverDraftBack = modeNone
+		m.status = "draft kept for next launch"
+		if back != modeNone {
+			m.taskFormDraftDeferred = true
+			m.status = "draft kept until this form saves over it"
+		}
+		return m, nil
+	case msg.String() == "d":
+		path := m.taskFormDraftPathFor(m.recoverDraft.ProjectID, m.recoverDraft.TaskID)
+		m.mode = back
+		m.recoverDraft = taskFormDraft{}
+		m.recoverDraftBack = modeNone
+		m.status = "draft discarded"
+		return m, m.discardTaskFormDraftCmd(path)
+	case msg.Code == tea.KeyEnter || msg.String() == "enter" || msg.String() == "y":
+		draft := m.recoverDraft
+		m.mode = modeNone
+		m.recoverDraft = taskFormDraft{}
+		m.recoverDraftBack = modeNone
+		cmd := m.restoreTaskFormDraft(draft)
+		return m, cmd
+	default:
+		return m, nil
+	}
+}
+
+// restoreTaskFormDraft reopens the task form with one recovered draft's values.
+func (m *Model) restoreTaskFormDraft(draft taskFormDraft) tea.Cmd {
+	var cmd tea.Cmd
+	status := "recovered unsaved task"
+	task, ok := m.taskByID(draft.TaskID)
+	switch {
+	case draft.TaskID != "" && ok:
+		cmd = m.startTaskForm(&task)
+		status = "recovered unsaved edit of " + task.Title
+	default:
+		cmd = m.startTaskForm(nil)
+		m.taskFormParentID = draft.ParentID
+		m.taskFormKind = draft.Kind
+		m.taskFormScope = draft.Scope
+		if draft.TaskID != "" {
+			status = "draft task not found; recovered as new task"
+		}
+	}
+	if len(m.formInputs) == 0 {
+		return cmd
+	}
+	for idx, field := range taskFormFields {
+		if value, ok := draft.Fields[field]; ok && idx < len(m.formInputs) {
+			m.formInputs[idx].SetValue(value)
+		}
+	}
+	if priority, ok := draft.Fields["priority"]; ok && priority != "" {
+		m.priorityIdx = priorityIndex(domain.Priority(priority))
+	}
+	m.taskFormDescription = draft.Fields["description"]
+	m.taskFormRecurEvery = draft.Fields["recur_every"]
+	m.syncTaskFormDescriptionDisplay()
+	m.refreshTaskFormLabelSuggestions()
+	m.status = status
+	return cmd
+}
+
+// renderRecoverDraftOverlay renders the draft recovery prompt.
+func (m Model) renderRecoverDraftOverlay(accent, muted color.Color, maxWidth int) string {
+	style := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(accent).
+		Padding(0, 1)
+	if maxWidth > 0 {
+		style = style.Width(clamp(maxWidth, 36, 64))
+	}
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(accent)
+	hintStyle := lipgloss.NewStyle().Foreground(muted)
+
+	draft := m.recoverDraft
+	title := strings.TrimSpace(draft.Fields["title"])
+	if title == "" {
+		title = "(untitled)"
+	}
+	kind := "new task"
+	if draft.TaskID != "" {
+		kind = "edit of existing task"
+	}
+	lines := []string{
+		titleStyle.Render("Recover Unsaved Task?"),
+		truncate(title, 56),
+		hintStyle.Render(kind),
+	}
+	if !draft.SavedAt.IsZero() {
+		lines = append(lines, hintStyle.Render("saved "+draft.SavedAt.Local().Format("2006-01-02 15:04")))
+	}
+	later := "esc later"
+	if m.recoverDraftBack != modeNone {
+		later = "esc keep editing"
+	}
+	lines = append(lines, "", hintStyle.Render("enter recover • d discard • "+later))
+	return style.Render(strings.Join(lines, "\n"))
+}
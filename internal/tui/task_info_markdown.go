@@ -0,0 +1,46 @@
+package tui
+
+import (
+	"strings"
+
+	"charm.land/bubbles/v2/viewport"
+	"charm.land/lipgloss/v2"
+)
+
+// toggleTaskInfoDescriptionRaw switches the task-info description between rendered markdown and its literal source.
+// The choice sticks across task-info sessions so reviewing raw markup on several tasks needs one toggle.
+func (m *Model) toggleTaskInfoDescriptionRaw() {
+	m.taskInfoDescriptionRaw = !m.taskInfoDescriptionRaw
+	m.taskInfoDetails.SetYOffset(0)
+	if m.taskInfoDescriptionRaw {
+		m.status = "description: raw markdown"
+		return
+	}
+	m.status = "description: rendered markdown"
+}
+
+// taskInfoDescriptionLabel names the description section, flagging when the raw source is shown.
+func (m Model) taskInfoDescriptionLabel() string {
+	if m.taskInfoDescriptionRaw {
+		return "description (raw, r renders):"
+	}
+	return "description:"
+}
+
+// rawDescriptionPreviewViewport builds the bounded description viewport with the markdown source shown verbatim.
+// Lines are wrapped to the box width but otherwise untouched, so fences, list markers, and emphasis stay visible.
+func (m Model) rawDescriptionPreviewViewport(markdown string, boxWidth int) viewport.Model {
+	contentWidth := max(24, boxWidth-4)
+	content := "(no description)"
+	if strings.TrimSpace(markdown) != "" {
+		content = lipgloss.Wrap(strings.Trim(markdown, "\n"), contentWidth, "")
+	}
+	vp := viewport.New()
+	vp.SoftWrap = true
+	vp.MouseWheelEnabled = false
+	vp.SetWidth(contentWidth)
+	vp.SetHeight(max(1, m.markdownPreviewHeight(content)))
+	vp.SetContent(content)
+	vp.SetYOffset(0)
+	return vp
+}
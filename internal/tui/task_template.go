@@ -0,0 +1,146 @@
+package tui
+
+import (
+	"image/color"
+	"maps"
+	"slices"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// templatePickerMatchLimit caps how many ranked template matches the template picker lists.
+const templatePickerMatchLimit = 8
+
+// TaskTemplate holds new-task form defaults; empty fields keep the regular new-task defaults.
+type TaskTemplate struct {
+	TitlePrefix string
+	Priority    domain.Priority
+	Labels      []string
+	Description string
+}
+
+// WithTaskTemplates returns an option that sets the named task templates offered by new-from-template.
+func WithTaskTemplates(templates map[string]TaskTemplate) Option {
+	return func(m *Model) {
+		m.taskTemplates = map[string]TaskTemplate{}
+		for rawName, template := range templates {
+			name := strings.TrimSpace(strings.ToLower(rawName))
+			if name == "" {
+				continue
+			}
+			template.Labels = append([]string(nil), template.Labels...)
+			m.taskTemplates[name] = template
+		}
+	}
+}
+
+// namedTaskTemplate pairs one configured template with its lowercased name for the template picker.
+type namedTaskTemplate struct {
+	Name     string
+	Template TaskTemplate
+}
+
+// newTemplatePicker constructs the template picker; picking a template opens the new-task form from it.
+func newTemplatePicker() picker[namedTaskTemplate] {
+	p := newPicker("template: ", "template name", 80, templatePickerMatchLimit, func(item namedTaskTemplate) string {
+		return item.Name
+	})
+	p.keywords = func(item namedTaskTemplate) []string {
+		return []string{item.Template.TitlePrefix}
+	}
+	p.onSelect = func(m *Model, item namedTaskTemplate) tea.Cmd {
+		return m.startTaskFormFromTemplate(item.Name)
+	}
+	p.noMatch = "no template matches "
+	return p
+}
+
+// startTemplatePickerMode opens a modal that fuzzy-matches configured task template names.
+// Templates are listed by name, so an empty query shows them alphabetically.
+func (m *Model) startTemplatePickerMode() tea.Cmd {
+	if m.readOnly {
+		m.status = readOnlyStatus("task form")
+		return nil
+	}
+	if len(m.taskTemplates) == 0 {
+		m.status = "no task templates configured"
+		return nil
+	}
+	names := slices.Sorted(maps.Keys(m.taskTemplates))
+	items := make([]namedTaskTemplate, 0, len(names))
+	for _, name := range names {
+		items = append(items, namedTaskTemplate{Name: name, Template: m.taskTemplates[name]})
+	}
+	m.mode = modeTemplatePicker
+	m.help.ShowAll = false
+	m.status = "new from template"
+	return m.templatePicker.open(items)
+}
+
+// startTaskFormFromTemplate opens the new-task form with the named template's values filled in.
+// The values are only initial form contents, so anything edited before submitting wins.
+func (m *Model) startTaskFormFromTemplate(name string) tea.Cmd {
+	template, ok := m.taskTemplates[name]
+	if !ok {
+		m.status = "unknown template " + name
+		return nil
+	}
+	cmd := m.startTaskForm(nil)
+	if m.mode != modeAddTask {
+		return cmd
+	}
+	if template.TitlePrefix != "" {
+		m.formInputs[taskFieldTitle].SetValue(template.TitlePrefix)
+		m.formInputs[taskFieldTitle].CursorEnd()
+	}
+	if template.Priority != "" {
+		m.priorityIdx = priorityIndex(template.Priority)
+		m.formInputs[taskFieldPriority].SetValue(string(priorityOptions[m.priorityIdx]))
+	}
+	if len(template.Labels) > 0 {
+		m.formInputs[taskFieldLabels].SetValue(strings.Join(template.Labels, ","))
+		m.refreshTaskFormLabelSuggestions()
+	}
+	if template.Description != "" {
+		m.taskFormDescription = template.Description
+		m.syncTaskFormDescriptionDisplay()
+	}
+	m.status = "new task from template: " + name
+	return cmd
+}
+
+// handleTemplatePickerKey handles input while the template picker modal is open.
+func (m Model) handleTemplatePickerKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	cmd := updatePicker(&m, &m.templatePicker, msg)
+	return m, cmd
+}
+
+// renderTemplatePickerOverlay renders the template picker modal with its ranked matches and a summary of each template.
+func (m Model) renderTemplatePickerOverlay(accent, muted color.Color, maxWidth int) string {
+	return m.templatePicker.render(accent, muted, maxWidth, pickerLayout[namedTaskTemplate]{
+		title:       "New From Template",
+		empty:       "no matching templates",
+		detail:      func(item namedTaskTemplate) string { return taskTemplateSummary(item.Template) },
+		hint:        "type to filter • ↑/↓ select • enter open form • esc cancel",
+		boxWidth:    72,
+		labelWidth:  24,
+		detailWidth: 40,
+	})
+}
+
+// taskTemplateSummary describes the defaults a template fills in, e.g. `"bug: " • high • bug,triage`.
+func taskTemplateSummary(template TaskTemplate) string {
+	parts := make([]string, 0, 3)
+	if template.TitlePrefix != "" {
+		parts = append(parts, `"`+template.TitlePrefix+`"`)
+	}
+	if template.Priority != "" {
+		parts = append(parts, string(template.Priority))
+	}
+	if len(template.Labels) > 0 {
+		parts = append(parts, strings.Join(template.Labels, ","))
+	}
+	return strings.Join(parts, " • ")
+}
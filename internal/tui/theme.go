@@ -0,0 +1,104 @@
+package tui
+
+import (
+	"cmp"
+	"image/color"
+	"slices"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+)
+
+// Theme holds the foreground colors the board draws on the terminal background once config is applied.
+// Colors are ANSI indexes (0-255) or #RRGGBB values.
+type Theme struct {
+	Text     string
+	Accent   string
+	Muted    string
+	Dim      string
+	Archived string
+	Warning  string
+	Critical string
+	Blocked  string
+	// BlockedDim fades unselected blocked cards when board.dim_blocked is set.
+	BlockedDim string
+	Watched    string
+	Highlight  string
+	// LabelColors maps lowercase labels to the color of their board glyph.
+	LabelColors map[string]string
+}
+
+// builtinTheme holds the board colors config cannot change; Highlight and LabelColors come from config.
+var builtinTheme = Theme{
+	Text:     "252",
+	Accent:   "62",
+	Muted:    "241",
+	Dim:      "239",
+	Archived: "243",
+	Warning:  "203",
+	// Card markers keep their own colors so they survive the selection highlight.
+	Critical:   "196",
+	Blocked:    "208",
+	BlockedDim: "240",
+	Watched:    "45",
+}
+
+// ThemeRole names one board text role and the color it renders with.
+type ThemeRole struct {
+	Name  string
+	Color string
+}
+
+// ResolveTheme returns the board theme for the configured highlight color and label colors.
+// An empty highlight color keeps the built-in one, matching the board's own fallback.
+func ResolveTheme(highlightColor string, labelColors map[string]string) Theme {
+	theme := builtinTheme
+	theme.Highlight = cmp.Or(strings.TrimSpace(highlightColor), defaultHighlightColor)
+	theme.LabelColors = normalizeLabelColors(labelColors)
+	return theme
+}
+
+// theme returns the board theme for the model's configured highlight and label colors.
+func (m Model) theme() Theme {
+	theme := builtinTheme
+	theme.Highlight = cmp.Or(strings.TrimSpace(m.highlightColor), defaultHighlightColor)
+	theme.LabelColors = m.labelColors
+	return theme
+}
+
+// surfaceColors returns the accent, muted, and dim colors full-screen surfaces draw with.
+// The current project's color, when set, replaces the theme accent.
+func (m Model) surfaceColors() (accent, muted, dim color.Color) {
+	theme := m.theme()
+	accent = lipgloss.Color(theme.Accent)
+	if project, ok := m.currentProject(); ok {
+		accent = m.projectAccentColor(project)
+	}
+	return accent, lipgloss.Color(theme.Muted), lipgloss.Color(theme.Dim)
+}
+
+// Roles lists every color in the theme with the board text it styles, label glyphs last in label order.
+func (t Theme) Roles() []ThemeRole {
+	roles := []ThemeRole{
+		{Name: "card text", Color: t.Text},
+		{Name: "column title / accent", Color: t.Accent},
+		{Name: "selected card (highlight)", Color: t.Highlight},
+		{Name: "help / muted text", Color: t.Muted},
+		{Name: "archived / empty placeholder", Color: t.Archived},
+		{Name: "status / dim text", Color: t.Dim},
+		{Name: "warning text", Color: t.Warning},
+		{Name: "critical marker", Color: t.Critical},
+		{Name: "blocked marker", Color: t.Blocked},
+		{Name: "dimmed blocked card", Color: t.BlockedDim},
+		{Name: "watched marker", Color: t.Watched},
+	}
+	labels := make([]string, 0, len(t.LabelColors))
+	for label := range t.LabelColors {
+		labels = append(labels, label)
+	}
+	slices.Sort(labels)
+	for _, label := range labels {
+		roles = append(roles, ThemeRole{Name: "label glyph: " + label, Color: t.LabelColors[label]})
+	}
+	return roles
+}
@@ -0,0 +1,86 @@
+package tui
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/hylla/tillsyn/internal/app"
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// previewProjectID identifies the sample project theme previews render.
+const previewProjectID = "theme-preview"
+
+// RenderThemePreview renders a sample board, then the same board under an archive confirm modal, through the
+// board's own View with cfg applied. The sample project lives only in memory; no service is read or written.
+func RenderThemePreview(cfg RuntimeConfig, width, height int) (board, modal string) {
+	m := NewModel(nil, WithRuntimeConfig(cfg))
+	m.ready = true
+	m.width = width
+	m.height = height
+	m.applyLoadedMsg(themePreviewLoadedMsg(m.labelColors, time.Now().UTC()))
+	// Selection starts on the first card; the second In Progress card is multi-selected.
+	m.selectedTaskIDs = map[string]struct{}{"preview-refactor": {}}
+	board = fmt.Sprint(m.View().Content)
+
+	if task, ok := m.selectedTaskInCurrentColumn(); ok {
+		m.openConfirmAction(confirmAction{
+			Kind:    "delete",
+			Task:    task,
+			TaskIDs: []string{task.ID},
+			Mode:    app.DeleteModeArchive,
+			Label:   "archive task",
+		})
+	}
+	modal = fmt.Sprint(m.View().Content)
+	return board, modal
+}
+
+// themePreviewLoadedMsg builds a sample project covering every card state the board styles.
+// Cards carry the first configured labels so label glyph colors show up too.
+func themePreviewLoadedMsg(labelColors map[string]string, now time.Time) loadedMsg {
+	project, _ := domain.NewProject(previewProjectID, "Theme preview", "", now)
+	todo, _ := domain.NewColumn("preview-todo", project.ID, "To Do", 0, 0, now)
+	doing, _ := domain.NewColumn("preview-doing", project.ID, "In Progress", 1, 2, now)
+	done, _ := domain.NewColumn("preview-done", project.ID, "Done", 2, 0, now)
+
+	labels := slices.Sorted(maps.Keys(labelColors))
+	label := func(idx int, fallback string) []string {
+		if idx < len(labels) {
+			return []string{labels[idx]}
+		}
+		return []string{fallback}
+	}
+	overdue := now.Add(-48 * time.Hour)
+	inputs := []domain.TaskInput{
+		{ID: "preview-notes", ColumnID: todo.ID, Title: "Draft release notes", Priority: domain.PriorityMedium, Labels: label(0, "docs")},
+		{ID: "preview-login", ColumnID: todo.ID, Title: "Fix login redirect", Priority: domain.PriorityCritical, DueAt: &overdue, Labels: label(1, "bug")},
+		{ID: "preview-webhook", ColumnID: todo.ID, Title: "Wire billing webhook", Priority: domain.PriorityMedium, Metadata: domain.TaskMetadata{BlockedReason: "waiting on keys"}},
+		{ID: "preview-refactor", ColumnID: doing.ID, Title: "Refactor board loader", Priority: domain.PriorityHigh},
+		{ID: "preview-contrast", ColumnID: doing.ID, Title: "Theme contrast pass", Priority: domain.PriorityLow, Labels: label(2, "ux"), Metadata: domain.TaskMetadata{Watched: true}},
+		{ID: "preview-index", ColumnID: doing.ID, Title: "Search index rebuild", Priority: domain.PriorityMedium},
+		{ID: "preview-seed", ColumnID: done.ID, Title: "Ship dev seed command", Priority: domain.PriorityLow},
+		{ID: "preview-spike", ColumnID: done.ID, Title: "Old spike notes", Priority: domain.PriorityLow},
+	}
+	tasks := make([]domain.Task, 0, len(inputs))
+	for idx, in := range inputs {
+		in.ProjectID = project.ID
+		in.Position = idx
+		task, err := domain.NewTask(in, now)
+		if err != nil {
+			continue
+		}
+		if task.ID == "preview-spike" {
+			task.Archive(now)
+		}
+		tasks = append(tasks, task)
+	}
+	return loadedMsg{
+		projects: []domain.Project{project},
+		columns:  []domain.Column{todo, doing, done},
+		tasks:    tasks,
+		loadedAt: now,
+	}
+}
@@ -15,12 +15,7 @@ import (
 
 // renderThreadModeView renders the full-screen project/work-item thread view.
 func (m Model) renderThreadModeView() tea.View {
-	accent := lipgloss.Color("62")
-	if project, ok := m.currentProject(); ok {
-		accent = projectAccentColor(project)
-	}
-	muted := lipgloss.Color("241")
-	dim := lipgloss.Color("239")
+	accent, muted, dim := m.surfaceColors()
 
 	hintStyle := lipgloss.NewStyle().Foreground(muted)
 	sectionTitleStyle := threadSectionStyle(accent)
@@ -294,6 +289,7 @@ func (m Model) renderThreadDescriptionEditorView(accent, muted, dim color.Color,
 	v := tea.NewView(content)
 	v.MouseMode = m.activeMouseMode()
 	v.AltScreen = true
+	v.ReportFocus = m.refreshOnFocus
 	return v
 }
 
@@ -447,6 +443,9 @@ func (m Model) threadDescriptionForTarget(target domain.CommentTarget, descripti
 
 // startThreadEditFlow transitions thread read mode into the matching project/task edit flow.
 func (m Model) startThreadEditFlow() (tea.Model, tea.Cmd) {
+	if m.readOnly {
+		return m.readOnlyBlocked("edit description")
+	}
 	target := m.threadTarget
 	switch target.TargetType {
 	case domain.CommentTargetTypeProject:
@@ -500,6 +499,9 @@ func (m Model) loadThreadCommentsCmd(target domain.CommentTarget) tea.Cmd {
 
 // createThreadCommentCmd persists one new thread comment with identity defaults.
 func (m Model) createThreadCommentCmd(body string) tea.Cmd {
+	if m.readOnly {
+		return readOnlyBlockedCmd("comment")
+	}
 	target := m.threadTarget
 	actorID := m.threadActorID()
 	actorName := m.threadActorName()
@@ -525,6 +527,9 @@ func (m Model) createThreadCommentCmd(body string) tea.Cmd {
 
 // updateThreadDescriptionCmd updates one thread target's backing markdown details from the thread details editor.
 func (m Model) updateThreadDescriptionCmd(description string) tea.Cmd {
+	if m.readOnly {
+		return readOnlyBlockedCmd("edit description")
+	}
 	target := m.threadTarget
 	description = strings.TrimSpace(description)
 	actorID := m.threadActorID()
@@ -0,0 +1,48 @@
+package tui
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// defaultTitleMaxLines caps wrapped card titles when no explicit line limit is configured.
+const defaultTitleMaxLines = 2
+
+// boardTitleLines returns the card rows for one task title: a single truncated row by default,
+// or up to titleMaxLines word-wrapped rows when title wrapping is enabled.
+func (m Model) boardTitleLines(title string, width int) []string {
+	if !m.titleWrap || m.titleMaxLines <= 1 {
+		return []string{truncate(title, width)}
+	}
+	return wrapTitle(title, width, m.titleMaxLines)
+}
+
+// wrapTitle word-wraps one title into at most maxLines rows of width runes.
+// Words longer than a row are hard-broken, and the final row is truncated when text remains.
+func wrapTitle(title string, width, maxLines int) []string {
+	remaining := strings.Join(strings.Fields(title), " ")
+	if width <= 0 || maxLines <= 1 || remaining == "" {
+		return []string{truncate(remaining, width)}
+	}
+	lines := make([]string, 0, maxLines)
+	for remaining != "" {
+		if len(lines) == maxLines-1 {
+			lines = append(lines, truncate(remaining, width))
+			break
+		}
+		runes := []rune(remaining)
+		if len(runes) <= width {
+			lines = append(lines, remaining)
+			break
+		}
+		cut := width
+		// Break at the last space that fits, including a space right after the row boundary.
+		window := string(runes[:width+1])
+		if space := strings.LastIndex(window, " "); space > 0 {
+			cut = utf8.RuneCountInString(window[:space])
+		}
+		lines = append(lines, strings.TrimRight(string(runes[:cut]), " "))
+		remaining = strings.TrimLeft(string(runes[cut:]), " ")
+	}
+	return lines
+}
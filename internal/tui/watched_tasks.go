@@ -0,0 +1,255 @@
+package tui
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"image/color"
+	"slices"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/hylla/tillsyn/internal/app"
+	"github.com/hylla/tillsyn/internal/domain"
+)
+
+// watchedMarkerGlyph follows the title of a watched board card; it is an eye-like mark without emoji width problems.
+const watchedMarkerGlyph = "◉"
+
+// watchedMarkerStyle colors the watched marker apart from the priority and blocked markers.
+var watchedMarkerStyle = lipgloss.NewStyle().Foreground(lipgloss.Color(builtinTheme.Watched))
+
+// watchedTaskItem is one watched task with the names of the project and column holding it.
+type watchedTaskItem struct {
+	ProjectName string
+	ColumnName  string
+	Task        domain.Task
+}
+
+// watchedTasksLoadedMsg carries the watched tasks gathered from every active project.
+type watchedTasksLoadedMsg struct {
+	items []watchedTaskItem
+	err   error
+}
+
+// watchedTaskMarker renders the marker appended after a watched card title, or "" for unwatched tasks.
+func watchedTaskMarker(task domain.Task) string {
+	if !task.Metadata.Watched {
+		return ""
+	}
+	return " " + watchedMarkerStyle.Render(watchedMarkerGlyph)
+}
+
+// setTaskWatched writes the watch flag onto the task's current fields.
+func (m Model) setTaskWatched(task domain.Task, watched bool) (domain.Task, error) {
+	metadata := task.Metadata
+	metadata.Watched = watched
+	return m.svc.UpdateTask(context.Background(), app.UpdateTaskInput{
+		TaskID:      task.ID,
+		Title:       task.Title,
+		Description: task.Description,
+		Priority:    task.Priority,
+		DueAt:       task.DueAt,
+		Labels:      task.Labels,
+		Metadata:    &metadata,
+	})
+}
+
+// watchStatus describes one watch toggle for the status line.
+func watchStatus(task domain.Task, watched bool) string {
+	if watched {
+		return fmt.Sprintf("watching %q", truncate(task.Title, 28))
+	}
+	return fmt.Sprintf("stopped watching %q", truncate(task.Title, 28))
+}
+
+// toggleFocusedTaskWatch flips the watch flag on the focused task. Watching is a personal focus list,
+// so it always applies to the focused task alone and leaves multi-select untouched.
+func (m Model) toggleFocusedTaskWatch() (tea.Model, tea.Cmd) {
+	if m.readOnly {
+		return m.readOnlyBlocked("watch task")
+	}
+	task, ok := m.selectedTaskInCurrentColumn()
+	if !ok {
+		m.status = "no task selected"
+		return m, nil
+	}
+	watched := !task.Metadata.Watched
+	status := watchStatus(task, watched)
+	return m, func() tea.Msg {
+		updated, err := m.setTaskWatched(task, watched)
+		if err != nil {
+			return actionMsg{err: fmt.Errorf("watch task: %w", err)}
+		}
+		return actionMsg{status: status, reload: true, upsertTasks: []domain.Task{updated}}
+	}
+}
+
+// openWatchedTasks gathers watched tasks across projects and opens the watched view once they load.
+func (m *Model) openWatchedTasks() tea.Cmd {
+	m.status = "loading watched tasks..."
+	svc := m.svc
+	return func() tea.Msg {
+		items, err := loadWatchedTasks(context.Background(), svc)
+		return watchedTasksLoadedMsg{items: items, err: err}
+	}
+}
+
+// loadWatchedTasks collects unarchived watched tasks from every active project, in project order and then board order.
+func loadWatchedTasks(ctx context.Context, svc Service) ([]watchedTaskItem, error) {
+	projects, err := svc.ListProjects(ctx, false)
+	if err != nil {
+		return nil, fmt.Errorf("list projects: %w", err)
+	}
+	items := make([]watchedTaskItem, 0)
+	for _, project := range projects {
+		if project.ArchivedAt != nil {
+			continue
+		}
+		tasks, err := svc.ListTasks(ctx, project.ID, false)
+		if err != nil {
+			return nil, fmt.Errorf("list tasks for project %s: %w", project.Name, err)
+		}
+		watched := slices.DeleteFunc(tasks, func(task domain.Task) bool {
+			return task.ArchivedAt != nil || !task.Metadata.Watched
+		})
+		if len(watched) == 0 {
+			continue
+		}
+		columns, err := svc.ListColumns(ctx, project.ID, true)
+		if err != nil {
+			return nil, fmt.Errorf("list columns for project %s: %w", project.Name, err)
+		}
+		columnByID := make(map[string]domain.Column, len(columns))
+		for _, column := range columns {
+			columnByID[column.ID] = column
+		}
+		slices.SortStableFunc(watched, func(a, b domain.Task) int {
+			return cmp.Or(
+				cmp.Compare(columnByID[a.ColumnID].Position, columnByID[b.ColumnID].Position),
+				cmp.Compare(a.Position, b.Position),
+			)
+		})
+		for _, task := range watched {
+			items = append(items, watchedTaskItem{
+				ProjectName: project.Name,
+				ColumnName:  columnByID[task.ColumnID].Name,
+				Task:        task,
+			})
+		}
+	}
+	return items, nil
+}
+
+// applyWatchedTasksLoaded opens the watched view with freshly loaded tasks.
+func (m Model) applyWatchedTasksLoaded(msg watchedTasksLoadedMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		m.status = "watched tasks failed: " + msg.err.Error()
+		return m, nil
+	}
+	if m.mode != modeNone {
+		return m, nil
+	}
+	if len(msg.items) == 0 {
+		m.status = "no watched tasks"
+		return m, nil
+	}
+	m.watchedTasks = msg.items
+	m.watchedTaskIndex = 0
+	m.mode = modeWatchedTasks
+	m.help.ShowAll = false
+	m.status = fmt.Sprintf("%d watched", len(msg.items))
+	return m, nil
+}
+
+// handleWatchedTasksKey handles input while the watched view is open.
+func (m Model) handleWatchedTasksKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	m.watchedTaskIndex = clamp(m.watchedTaskIndex, 0, max(0, len(m.watchedTasks)-1))
+	switch {
+	case msg.Code == tea.KeyEscape || msg.String() == "esc":
+		m.mode = modeNone
+		m.watchedTasks = nil
+		m.status = "ready"
+		return m, nil
+	case key.Matches(msg, m.keys.moveDown):
+		if m.watchedTaskIndex < len(m.watchedTasks)-1 {
+			m.watchedTaskIndex++
+		}
+		return m, nil
+	case key.Matches(msg, m.keys.moveUp):
+		if m.watchedTaskIndex > 0 {
+			m.watchedTaskIndex--
+		}
+		return m, nil
+	case key.Matches(msg, m.keys.toggleWatch):
+		if len(m.watchedTasks) == 0 {
+			return m, nil
+		}
+		if m.readOnly {
+			return m.readOnlyBlocked("unwatch task")
+		}
+		// Unwatching drops the row right away so the list stays a live view of what is still watched.
+		task := m.watchedTasks[m.watchedTaskIndex].Task
+		m.watchedTasks = slices.Delete(slices.Clone(m.watchedTasks), m.watchedTaskIndex, m.watchedTaskIndex+1)
+		m.watchedTaskIndex = clamp(m.watchedTaskIndex, 0, max(0, len(m.watchedTasks)-1))
+		if len(m.watchedTasks) == 0 {
+			m.mode = modeNone
+		}
+		status := watchStatus(task, false)
+		return m, func() tea.Msg {
+			if _, err := m.setTaskWatched(task, false); err != nil {
+				return actionMsg{err: fmt.Errorf("unwatch task: %w", err)}
+			}
+			return actionMsg{status: status, reload: true}
+		}
+	case msg.Code == tea.KeyEnter || msg.String() == "enter":
+		if len(m.watchedTasks) == 0 {
+			m.mode = modeNone
+			m.status = "no watched tasks"
+			return m, nil
+		}
+		task := m.watchedTasks[m.watchedTaskIndex].Task
+		m.mode = modeNone
+		m.watchedTasks = nil
+		m.status = "looking up task..."
+		// Resolve through the jump flow so the board switches project and focuses the task's column.
+		return m, m.resolveJumpTask(task.ID, task.ProjectID)
+	default:
+		return m, nil
+	}
+}
+
+// renderWatchedTasksOverlay renders the watched view.
+func (m Model) renderWatchedTasksOverlay(accent, muted color.Color, maxWidth int) string {
+	style := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(accent).
+		Padding(0, 1)
+	titleWidth := 40
+	if maxWidth > 0 {
+		boxWidth := clamp(maxWidth, 48, 104)
+		style = style.Width(boxWidth)
+		titleWidth = max(16, boxWidth-40)
+	}
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(accent)
+	hintStyle := lipgloss.NewStyle().Foreground(muted)
+	selectedStyle := lipgloss.NewStyle().Bold(true).Foreground(accent)
+
+	lines := []string{titleStyle.Render("Watched")}
+	if len(m.watchedTasks) == 0 {
+		lines = append(lines, hintStyle.Render("(empty)"))
+	}
+	selected := clamp(m.watchedTaskIndex, 0, max(0, len(m.watchedTasks)-1))
+	for idx, item := range m.watchedTasks {
+		row := fmt.Sprintf("%s • %s / %s", truncate(item.Task.Title, titleWidth), truncate(item.ProjectName, 20), truncate(item.ColumnName, 14))
+		if idx == selected {
+			lines = append(lines, selectedStyle.Render("› "+row))
+			continue
+		}
+		lines = append(lines, "  "+row)
+	}
+	lines = append(lines, hintStyle.Render("j/k select • enter jump • "+m.keys.toggleWatch.Help().Key+" unwatch • esc close"))
+	return style.Render(strings.Join(lines, "\n"))
+}